@@ -74,6 +74,10 @@ const (
 	ErrorReason_SESSION_EXPIRED ErrorReason = 106
 	// 账号已在其他地方登录
 	ErrorReason_ACCOUNT_LOGGED_IN_ELSEWHERE ErrorReason = 107
+	// 需要提供图形验证码
+	ErrorReason_AUTH_CAPTCHA_REQUIRED ErrorReason = 108
+	// 图形验证码错误或已失效
+	ErrorReason_AUTH_CAPTCHA_INCORRECT ErrorReason = 109
 	// =======================================
 	// 用户管理错误 (200-299)
 	// =======================================
@@ -111,6 +115,10 @@ const (
 	ErrorReason_USER_INVALID_PHONE_FORMAT ErrorReason = 215
 	// 用户社交登录失败
 	ErrorReason_USER_SOCIAL_LOGIN_FAILED ErrorReason = 216
+	// 用户邮箱已被其他用户使用
+	ErrorReason_USER_EMAIL_ALREADY_EXISTS ErrorReason = 217
+	// 用户手机号已被其他用户使用
+	ErrorReason_USER_PHONE_ALREADY_EXISTS ErrorReason = 218
 	// =======================================
 	// 角色管理错误 (300-399)
 	// =======================================
@@ -177,6 +185,8 @@ const (
 	ErrorReason_DEPT_CANNOT_DELETE_WITH_CHILDREN ErrorReason = 604
 	// 无法删除包含用户的部门
 	ErrorReason_DEPT_CANNOT_DELETE_WITH_USERS ErrorReason = 605
+	// 上级部门无效，不存在或为自身及其下级部门
+	ErrorReason_DEPT_PARENT_INVALID ErrorReason = 606
 	// =======================================
 	// 数据层错误 (700-799)
 	// =======================================
@@ -238,6 +248,45 @@ const (
 	ErrorReason_THIRD_PARTY_TIMEOUT ErrorReason = 1101
 	// 调用第三方服务时未获得授权
 	ErrorReason_THIRD_PARTY_UNAUTHORIZED ErrorReason = 1102
+	// =======================================
+	// 策略管理错误 (1200-1299)
+	// =======================================
+	// 策略格式无效
+	ErrorReason_POLICY_INVALID ErrorReason = 1200
+	// 当前授权引擎不支持策略管理
+	ErrorReason_POLICY_NOT_SUPPORTED ErrorReason = 1201
+	// =======================================
+	// 数据导出错误 (1300-1399)
+	// =======================================
+	// 资源不支持导出
+	ErrorReason_EXPORT_RESOURCE_NOT_SUPPORTED ErrorReason = 1300
+	// 导出的字段不存在或不允许导出
+	ErrorReason_EXPORT_FIELD_NOT_SUPPORTED ErrorReason = 1301
+	// 导出行数超过上限
+	ErrorReason_EXPORT_TOO_LARGE ErrorReason = 1302
+	// 导出任务不存在或已过期
+	ErrorReason_EXPORT_JOB_NOT_FOUND ErrorReason = 1303
+	// 导出任务尚未完成
+	ErrorReason_EXPORT_JOB_NOT_READY ErrorReason = 1304
+	// =======================================
+	// 全文搜索错误 (1400-1499)
+	// =======================================
+	// 索引不支持搜索
+	ErrorReason_SEARCH_INDEX_NOT_SUPPORTED ErrorReason = 1400
+	// 搜索服务不可用
+	ErrorReason_SEARCH_UNAVAILABLE ErrorReason = 1401
+	// =======================================
+	// 回收站错误 (1500-1599)
+	// =======================================
+	// 资源不支持回收站
+	ErrorReason_TRASH_RESOURCE_NOT_SUPPORTED ErrorReason = 1500
+	// 回收站中不存在该记录
+	ErrorReason_TRASH_NOT_FOUND ErrorReason = 1501
+	// =======================================
+	// 并发控制错误 (1600-1699)
+	// =======================================
+	// 版本冲突，数据已被他人修改
+	ErrorReason_VERSION_CONFLICT ErrorReason = 1600
 )
 
 // Enum value maps for ErrorReason.
@@ -264,6 +313,8 @@ var (
 		105:  "PERMISSION_DENIED",
 		106:  "SESSION_EXPIRED",
 		107:  "ACCOUNT_LOGGED_IN_ELSEWHERE",
+		108:  "AUTH_CAPTCHA_REQUIRED",
+		109:  "AUTH_CAPTCHA_INCORRECT",
 		200:  "USER_NOT_FOUND",
 		201:  "USER_NOT_EXIST",
 		202:  "USER_INCORRECT_PASSWORD",
@@ -281,6 +332,8 @@ var (
 		214:  "USER_INVALID_EMAIL_FORMAT",
 		215:  "USER_INVALID_PHONE_FORMAT",
 		216:  "USER_SOCIAL_LOGIN_FAILED",
+		217:  "USER_EMAIL_ALREADY_EXISTS",
+		218:  "USER_PHONE_ALREADY_EXISTS",
 		300:  "ROLE_NOT_FOUND",
 		301:  "ROLE_INVALID_ID",
 		302:  "ROLE_ALREADY_EXISTS",
@@ -308,6 +361,7 @@ var (
 		603:  "DEPT_NAME_CANNOT_BE_EMPTY",
 		604:  "DEPT_CANNOT_DELETE_WITH_CHILDREN",
 		605:  "DEPT_CANNOT_DELETE_WITH_USERS",
+		606:  "DEPT_PARENT_INVALID",
 		700:  "DB_CONNECTION_ERROR",
 		701:  "DB_QUERY_ERROR",
 		702:  "DB_INSERT_ERROR",
@@ -331,6 +385,18 @@ var (
 		1100: "THIRD_PARTY_SERVICE_ERROR",
 		1101: "THIRD_PARTY_TIMEOUT",
 		1102: "THIRD_PARTY_UNAUTHORIZED",
+		1200: "POLICY_INVALID",
+		1201: "POLICY_NOT_SUPPORTED",
+		1300: "EXPORT_RESOURCE_NOT_SUPPORTED",
+		1301: "EXPORT_FIELD_NOT_SUPPORTED",
+		1302: "EXPORT_TOO_LARGE",
+		1303: "EXPORT_JOB_NOT_FOUND",
+		1304: "EXPORT_JOB_NOT_READY",
+		1400: "SEARCH_INDEX_NOT_SUPPORTED",
+		1401: "SEARCH_UNAVAILABLE",
+		1500: "TRASH_RESOURCE_NOT_SUPPORTED",
+		1501: "TRASH_NOT_FOUND",
+		1600: "VERSION_CONFLICT",
 	}
 	ErrorReason_value = map[string]int32{
 		"RESERVED_DEFAULT":                 0,
@@ -354,6 +420,8 @@ var (
 		"PERMISSION_DENIED":                105,
 		"SESSION_EXPIRED":                  106,
 		"ACCOUNT_LOGGED_IN_ELSEWHERE":      107,
+		"AUTH_CAPTCHA_REQUIRED":            108,
+		"AUTH_CAPTCHA_INCORRECT":           109,
 		"USER_NOT_FOUND":                   200,
 		"USER_NOT_EXIST":                   201,
 		"USER_INCORRECT_PASSWORD":          202,
@@ -371,6 +439,8 @@ var (
 		"USER_INVALID_EMAIL_FORMAT":        214,
 		"USER_INVALID_PHONE_FORMAT":        215,
 		"USER_SOCIAL_LOGIN_FAILED":         216,
+		"USER_EMAIL_ALREADY_EXISTS":        217,
+		"USER_PHONE_ALREADY_EXISTS":        218,
 		"ROLE_NOT_FOUND":                   300,
 		"ROLE_INVALID_ID":                  301,
 		"ROLE_ALREADY_EXISTS":              302,
@@ -398,6 +468,7 @@ var (
 		"DEPT_NAME_CANNOT_BE_EMPTY":        603,
 		"DEPT_CANNOT_DELETE_WITH_CHILDREN": 604,
 		"DEPT_CANNOT_DELETE_WITH_USERS":    605,
+		"DEPT_PARENT_INVALID":              606,
 		"DB_CONNECTION_ERROR":              700,
 		"DB_QUERY_ERROR":                   701,
 		"DB_INSERT_ERROR":                  702,
@@ -421,6 +492,18 @@ var (
 		"THIRD_PARTY_SERVICE_ERROR":        1100,
 		"THIRD_PARTY_TIMEOUT":              1101,
 		"THIRD_PARTY_UNAUTHORIZED":         1102,
+		"POLICY_INVALID":                   1200,
+		"POLICY_NOT_SUPPORTED":             1201,
+		"EXPORT_RESOURCE_NOT_SUPPORTED":    1300,
+		"EXPORT_FIELD_NOT_SUPPORTED":       1301,
+		"EXPORT_TOO_LARGE":                 1302,
+		"EXPORT_JOB_NOT_FOUND":             1303,
+		"EXPORT_JOB_NOT_READY":             1304,
+		"SEARCH_INDEX_NOT_SUPPORTED":       1400,
+		"SEARCH_UNAVAILABLE":               1401,
+		"TRASH_RESOURCE_NOT_SUPPORTED":     1500,
+		"TRASH_NOT_FOUND":                  1501,
+		"VERSION_CONFLICT":                 1600,
 	}
 )
 
@@ -458,7 +541,7 @@ var file_avmc_admin_v1_error_reason_proto_rawDesc = string([]byte{
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x0d, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
 	0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xa3, 0x16, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xe6, 0x1a, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72,
 	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x10, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56,
 	0x45, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45,
 	0xf4, 0x03, 0x12, 0x15, 0x0a, 0x0b, 0x42, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
@@ -497,157 +580,193 @@ var file_avmc_admin_v1_error_reason_proto_rawDesc = string([]byte{
 	0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x6a, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x25,
 	0x0a, 0x1b, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x47, 0x45, 0x44,
 	0x5f, 0x49, 0x4e, 0x5f, 0x45, 0x4c, 0x53, 0x45, 0x57, 0x48, 0x45, 0x52, 0x45, 0x10, 0x6b, 0x1a,
-	0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x19, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
-	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xc8, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03,
-	0x12, 0x19, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49,
-	0x53, 0x54, 0x10, 0xc9, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x22, 0x0a, 0x17, 0x55,
-	0x53, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x50, 0x41,
-	0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0xca, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12,
-	0x16, 0x0a, 0x0b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0xcb,
-	0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0xcc, 0x01, 0x1a, 0x04, 0xa8,
-	0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x13, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x52, 0x45,
-	0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0xcd, 0x01, 0x1a, 0x04, 0xa8,
-	0x45, 0x90, 0x03, 0x12, 0x20, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x4d, 0x41, 0x49,
-	0x4c, 0x5f, 0x55, 0x4e, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0xce, 0x01, 0x1a,
-	0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x20, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x50, 0x48,
-	0x4f, 0x4e, 0x45, 0x5f, 0x55, 0x4e, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0xcf,
-	0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x1e, 0x0a, 0x13, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0xd0,
-	0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x27, 0x0a, 0x1c, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x41,
-	0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x53, 0x10, 0xd1, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03,
-	0x12, 0x20, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52,
-	0x44, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xd2, 0x01, 0x1a, 0x04, 0xa8, 0x45,
-	0x93, 0x03, 0x12, 0x23, 0x0a, 0x18, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4d, 0x55, 0x53, 0x54, 0x5f,
-	0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0xd3,
-	0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x18, 0x0a, 0x0d, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0xd4, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93,
-	0x03, 0x12, 0x25, 0x0a, 0x1a, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54,
-	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10,
-	0xd5, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x55, 0x53, 0x45, 0x52,
-	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x46,
-	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0xd6, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x24,
-	0x0a, 0x19, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50,
-	0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0xd7, 0x01, 0x1a, 0x04,
-	0xa8, 0x45, 0x90, 0x03, 0x12, 0x23, 0x0a, 0x18, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x43,
-	0x49, 0x41, 0x4c, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
-	0x10, 0xd8, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x19, 0x0a, 0x0e, 0x52, 0x4f, 0x4c,
-	0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xac, 0x02, 0x1a, 0x04,
-	0xa8, 0x45, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0xad, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03,
-	0x12, 0x1e, 0x0a, 0x13, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
-	0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0xae, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03,
-	0x12, 0x25, 0x0a, 0x1a, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f,
-	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x54, 0x49, 0x4e, 0x10, 0xaf,
-	0x02, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
-	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45,
-	0x4d, 0x50, 0x54, 0x59, 0x10, 0xb0, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x2b, 0x0a,
-	0x20, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54,
-	0x59, 0x10, 0xb1, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x22, 0x0a, 0x17, 0x52, 0x4f,
-	0x4c, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0xb2, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x19,
-	0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
-	0x10, 0x90, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x50, 0x4f, 0x53,
-	0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0x91, 0x03, 0x1a,
-	0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x13, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x41, 0x4c,
-	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x92, 0x03, 0x1a,
-	0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x41,
-	0x4d, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50,
-	0x54, 0x59, 0x10, 0x93, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x2b, 0x0a, 0x20, 0x50,
-	0x4f, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10,
-	0x94, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x19, 0x0a, 0x0e, 0x4d, 0x45, 0x4e, 0x55,
-	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xf4, 0x03, 0x1a, 0x04, 0xa8,
-	0x45, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x49, 0x4e, 0x56, 0x41,
-	0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0xf5, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12,
-	0x1e, 0x0a, 0x13, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
-	0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0xf6, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12,
-	0x24, 0x0a, 0x19, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x41, 0x4e,
-	0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0xf7, 0x03, 0x1a,
-	0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x50, 0x41,
-	0x54, 0x48, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50,
-	0x54, 0x59, 0x10, 0xf8, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x29, 0x0a, 0x1e, 0x4d,
-	0x45, 0x4e, 0x55, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41,
-	0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0xf9, 0x03,
-	0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x2b, 0x0a, 0x20, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x43,
-	0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x52, 0x45, 0x4e, 0x10, 0xfa, 0x03, 0x1a, 0x04, 0xa8,
-	0x45, 0x93, 0x03, 0x12, 0x23, 0x0a, 0x18, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x50, 0x41, 0x54, 0x48,
+	0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x1f, 0x0a, 0x15, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43, 0x41,
+	0x50, 0x54, 0x43, 0x48, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x6c,
+	0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x20, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43,
+	0x41, 0x50, 0x54, 0x43, 0x48, 0x41, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
+	0x10, 0x6d, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x19, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xc8, 0x01, 0x1a, 0x04, 0xa8,
+	0x45, 0x94, 0x03, 0x12, 0x19, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0xc9, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x22,
+	0x0a, 0x17, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
+	0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0xca, 0x01, 0x1a, 0x04, 0xa8, 0x45,
+	0x90, 0x03, 0x12, 0x16, 0x0a, 0x0b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45,
+	0x4e, 0x10, 0xcb, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0xcc, 0x01,
+	0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x13, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41,
+	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0xcd, 0x01,
+	0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x20, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45,
+	0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x55, 0x4e, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0xce, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x20, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x55, 0x4e, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0xcf, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x1e, 0x0a, 0x13, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45,
+	0x44, 0x10, 0xd0, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x27, 0x0a, 0x1c, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x4c, 0x4f, 0x47, 0x49,
+	0x4e, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x53, 0x10, 0xd1, 0x01, 0x1a, 0x04, 0xa8,
+	0x45, 0x93, 0x03, 0x12, 0x20, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x53, 0x53,
+	0x57, 0x4f, 0x52, 0x44, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xd2, 0x01, 0x1a,
+	0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x23, 0x0a, 0x18, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4d, 0x55,
+	0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52,
+	0x44, 0x10, 0xd3, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x18, 0x0a, 0x0d, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0xd4, 0x01, 0x1a, 0x04,
+	0xa8, 0x45, 0x93, 0x03, 0x12, 0x25, 0x0a, 0x1a, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x47,
+	0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c,
+	0x45, 0x44, 0x10, 0xd5, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x45, 0x4d, 0x41, 0x49,
+	0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0xd6, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x90,
+	0x03, 0x12, 0x24, 0x0a, 0x19, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0xd7,
+	0x01, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x23, 0x0a, 0x18, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x53, 0x4f, 0x43, 0x49, 0x41, 0x4c, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0xd8, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x24, 0x0a, 0x19,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0xd9, 0x01, 0x1a, 0x04, 0xa8, 0x45,
+	0x90, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45,
 	0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10,
-	0xfb, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x23, 0x0a, 0x18, 0x4d, 0x45, 0x4e, 0x55,
-	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58,
-	0x49, 0x53, 0x54, 0x53, 0x10, 0xfc, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x19, 0x0a,
-	0x0e, 0x44, 0x45, 0x50, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
-	0xd8, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x44, 0x45, 0x50, 0x54,
-	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0xd9, 0x04, 0x1a, 0x04,
-	0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x13, 0x44, 0x45, 0x50, 0x54, 0x5f, 0x41, 0x4c, 0x52,
-	0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0xda, 0x04, 0x1a, 0x04,
-	0xa8, 0x45, 0x90, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x44, 0x45, 0x50, 0x54, 0x5f, 0x4e, 0x41, 0x4d,
+	0xda, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x19, 0x0a, 0x0e, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xac, 0x02, 0x1a, 0x04, 0xa8,
+	0x45, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0xad, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12,
+	0x1e, 0x0a, 0x13, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0xae, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12,
+	0x25, 0x0a, 0x1a, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x54, 0x49, 0x4e, 0x10, 0xaf, 0x02,
+	0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d,
+	0x50, 0x54, 0x59, 0x10, 0xb0, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x2b, 0x0a, 0x20,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59,
+	0x10, 0xb1, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x22, 0x0a, 0x17, 0x52, 0x4f, 0x4c,
+	0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x10, 0xb2, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x19, 0x0a,
+	0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x90, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x50, 0x4f, 0x53, 0x54,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0x91, 0x03, 0x1a, 0x04,
+	0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x13, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x41, 0x4c, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x92, 0x03, 0x1a, 0x04,
+	0xa8, 0x45, 0x90, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x41, 0x4d,
 	0x45, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54,
-	0x59, 0x10, 0xdb, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x2b, 0x0a, 0x20, 0x44, 0x45,
-	0x50, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x52, 0x45, 0x4e, 0x10, 0xdc,
-	0x04, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x28, 0x0a, 0x1d, 0x44, 0x45, 0x50, 0x54, 0x5f,
-	0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x53, 0x10, 0xdd, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x93,
-	0x03, 0x12, 0x1e, 0x0a, 0x13, 0x44, 0x42, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xbc, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0xf4,
-	0x03, 0x12, 0x19, 0x0a, 0x0e, 0x44, 0x42, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0xbd, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1a, 0x0a, 0x0f,
-	0x44, 0x42, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0xbe, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x44, 0x42, 0x5f, 0x55,
-	0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xbf, 0x05, 0x1a, 0x04,
-	0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x44, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
-	0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xc0, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03,
-	0x12, 0x1f, 0x0a, 0x14, 0x44, 0x42, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xc1, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0xf4,
-	0x03, 0x12, 0x20, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xc2, 0x05, 0x1a, 0x04, 0xa8,
-	0x45, 0x90, 0x03, 0x12, 0x21, 0x0a, 0x16, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x43, 0x4f, 0x4e,
-	0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa0, 0x06,
-	0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f,
-	0x47, 0x45, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa1, 0x06, 0x1a, 0x04, 0xa8, 0x45,
-	0xf4, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa2, 0x06, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1d,
-	0x0a, 0x12, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0xa3, 0x06, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1a, 0x0a,
-	0x0f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x10, 0x84, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1b, 0x0a, 0x10, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x85, 0x07,
-	0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1c, 0x0a, 0x11, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x44,
-	0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x86, 0x07, 0x1a, 0x04,
-	0xa8, 0x45, 0xf4, 0x03, 0x12, 0x19, 0x0a, 0x0e, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x87, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12,
-	0x1d, 0x0a, 0x12, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x45, 0x58, 0x43,
-	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x88, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x24,
-	0x0a, 0x19, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e, 0x4f,
-	0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x89, 0x07, 0x1a, 0x04,
-	0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x13, 0x4d, 0x51, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xe8, 0x07, 0x1a, 0x04,
-	0xa8, 0x45, 0xf4, 0x03, 0x12, 0x18, 0x0a, 0x0d, 0x4d, 0x51, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xe9, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1b,
-	0x0a, 0x10, 0x4d, 0x51, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0xea, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x54,
-	0x48, 0x49, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49,
-	0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xcc, 0x08, 0x1a, 0x04, 0xa8, 0x45, 0xf4,
-	0x03, 0x12, 0x1e, 0x0a, 0x13, 0x54, 0x48, 0x49, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x59,
-	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0xcd, 0x08, 0x1a, 0x04, 0xa8, 0x45, 0xf8,
-	0x03, 0x12, 0x23, 0x0a, 0x18, 0x54, 0x48, 0x49, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x59,
-	0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0xce, 0x08,
-	0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0xa1, 0x01, 0x0a,
-	0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x42, 0x10, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2d,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x76, 0x6d, 0x63,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41,
-	0x41, 0x58, 0xaa, 0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x19, 0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0f, 0x41, 0x76, 0x6d, 0x63, 0x3a, 0x3a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x59, 0x10, 0x93, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x2b, 0x0a, 0x20, 0x50, 0x4f,
+	0x53, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43,
+	0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x94,
+	0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x19, 0x0a, 0x0e, 0x4d, 0x45, 0x4e, 0x55, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xf4, 0x03, 0x1a, 0x04, 0xa8, 0x45,
+	0x94, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0xf5, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e,
+	0x0a, 0x13, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45,
+	0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0xf6, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x24,
+	0x0a, 0x19, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x4e,
+	0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0xf7, 0x03, 0x1a, 0x04,
+	0xa8, 0x45, 0x90, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x50, 0x41, 0x54,
+	0x48, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54,
+	0x59, 0x10, 0xf8, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x29, 0x0a, 0x1e, 0x4d, 0x45,
+	0x4e, 0x55, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x4e,
+	0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0xf9, 0x03, 0x1a,
+	0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x2b, 0x0a, 0x20, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x43, 0x41,
+	0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x52, 0x45, 0x4e, 0x10, 0xfa, 0x03, 0x1a, 0x04, 0xa8, 0x45,
+	0x93, 0x03, 0x12, 0x23, 0x0a, 0x18, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x5f,
+	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0xfb,
+	0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x23, 0x0a, 0x18, 0x4d, 0x45, 0x4e, 0x55, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49,
+	0x53, 0x54, 0x53, 0x10, 0xfc, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x19, 0x0a, 0x0e,
+	0x44, 0x45, 0x50, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xd8,
+	0x04, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x44, 0x45, 0x50, 0x54, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x10, 0xd9, 0x04, 0x1a, 0x04, 0xa8,
+	0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x13, 0x44, 0x45, 0x50, 0x54, 0x5f, 0x41, 0x4c, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0xda, 0x04, 0x1a, 0x04, 0xa8,
+	0x45, 0x90, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x44, 0x45, 0x50, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59,
+	0x10, 0xdb, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x2b, 0x0a, 0x20, 0x44, 0x45, 0x50,
+	0x54, 0x5f, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x52, 0x45, 0x4e, 0x10, 0xdc, 0x04,
+	0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x28, 0x0a, 0x1d, 0x44, 0x45, 0x50, 0x54, 0x5f, 0x43,
+	0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x53, 0x10, 0xdd, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03,
+	0x12, 0x1e, 0x0a, 0x13, 0x44, 0x45, 0x50, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x45, 0x4e, 0x54, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0xde, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03,
+	0x12, 0x1e, 0x0a, 0x13, 0x44, 0x42, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xbc, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03,
+	0x12, 0x19, 0x0a, 0x0e, 0x44, 0x42, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0xbd, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x44,
+	0x42, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xbe,
+	0x05, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x44, 0x42, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xbf, 0x05, 0x1a, 0x04, 0xa8,
+	0x45, 0xf4, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x44, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xc0, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12,
+	0x1f, 0x0a, 0x14, 0x44, 0x42, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xc1, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03,
+	0x12, 0x20, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xc2, 0x05, 0x1a, 0x04, 0xa8, 0x45,
+	0x90, 0x03, 0x12, 0x21, 0x0a, 0x16, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa0, 0x06, 0x1a,
+	0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x47,
+	0x45, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa1, 0x06, 0x1a, 0x04, 0xa8, 0x45, 0xf4,
+	0x03, 0x12, 0x1a, 0x0a, 0x0f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0xa2, 0x06, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1d, 0x0a,
+	0x12, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0xa3, 0x06, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1a, 0x0a, 0x0f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x84, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1b, 0x0a, 0x10, 0x46, 0x49, 0x4c, 0x45,
+	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x85, 0x07, 0x1a,
+	0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1c, 0x0a, 0x11, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x86, 0x07, 0x1a, 0x04, 0xa8,
+	0x45, 0xf4, 0x03, 0x12, 0x19, 0x0a, 0x0e, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x87, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1d,
+	0x0a, 0x12, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x45,
+	0x45, 0x44, 0x45, 0x44, 0x10, 0x88, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x24, 0x0a,
+	0x19, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x89, 0x07, 0x1a, 0x04, 0xa8,
+	0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x13, 0x4d, 0x51, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xe8, 0x07, 0x1a, 0x04, 0xa8,
+	0x45, 0xf4, 0x03, 0x12, 0x18, 0x0a, 0x0d, 0x4d, 0x51, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0xe9, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1b, 0x0a,
+	0x10, 0x4d, 0x51, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0xea, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x24, 0x0a, 0x19, 0x54, 0x48,
+	0x49, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43,
+	0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xcc, 0x08, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03,
+	0x12, 0x1e, 0x0a, 0x13, 0x54, 0x48, 0x49, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x59, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0xcd, 0x08, 0x1a, 0x04, 0xa8, 0x45, 0xf8, 0x03,
+	0x12, 0x23, 0x0a, 0x18, 0x54, 0x48, 0x49, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x59, 0x5f,
+	0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0xce, 0x08, 0x1a,
+	0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x19, 0x0a, 0x0e, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0xb0, 0x09, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03,
+	0x12, 0x1f, 0x0a, 0x14, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53,
+	0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0xb1, 0x09, 0x1a, 0x04, 0xa8, 0x45, 0xf5,
+	0x03, 0x12, 0x28, 0x0a, 0x1d, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x94, 0x0a, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x25, 0x0a, 0x1a, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x95, 0x0a, 0x1a, 0x04, 0xa8, 0x45,
+	0x90, 0x03, 0x12, 0x1b, 0x0a, 0x10, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x4f, 0x4f,
+	0x5f, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x10, 0x96, 0x0a, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12,
+	0x1f, 0x0a, 0x14, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x97, 0x0a, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03,
+	0x12, 0x1f, 0x0a, 0x14, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x98, 0x0a, 0x1a, 0x04, 0xa8, 0x45, 0x99,
+	0x03, 0x12, 0x25, 0x0a, 0x1a, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x49, 0x4e, 0x44, 0x45,
+	0x58, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10,
+	0xf8, 0x0a, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1d, 0x0a, 0x12, 0x53, 0x45, 0x41, 0x52,
+	0x43, 0x48, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xf9,
+	0x0a, 0x1a, 0x04, 0xa8, 0x45, 0xf7, 0x03, 0x12, 0x27, 0x0a, 0x1c, 0x54, 0x52, 0x41, 0x53, 0x48,
+	0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55,
+	0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0xdc, 0x0b, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03,
+	0x12, 0x1a, 0x0a, 0x0f, 0x54, 0x52, 0x41, 0x53, 0x48, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0xdd, 0x0b, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1b, 0x0a, 0x10,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
+	0x10, 0xc0, 0x0c, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42,
+	0xa1, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x76, 0x6d, 0x63, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x0f, 0x41, 0x76, 0x6d, 0x63, 0x3a, 0x3a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -317,6 +317,34 @@ func ErrorAccountLoggedInElsewhere(format string, args ...interface{}) *errors.E
 	return errors.New(401, ErrorReason_ACCOUNT_LOGGED_IN_ELSEWHERE.String(), fmt.Sprintf(format, args...))
 }
 
+// 需要提供图形验证码
+func IsAuthCaptchaRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_AUTH_CAPTCHA_REQUIRED.String() && e.Code == 400
+}
+
+// 需要提供图形验证码
+func ErrorAuthCaptchaRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_AUTH_CAPTCHA_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
+// 图形验证码错误或已失效
+func IsAuthCaptchaIncorrect(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_AUTH_CAPTCHA_INCORRECT.String() && e.Code == 400
+}
+
+// 图形验证码错误或已失效
+func ErrorAuthCaptchaIncorrect(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_AUTH_CAPTCHA_INCORRECT.String(), fmt.Sprintf(format, args...))
+}
+
 // =======================================
 // 用户管理错误 (200-299)
 // =======================================
@@ -561,6 +589,34 @@ func ErrorUserSocialLoginFailed(format string, args ...interface{}) *errors.Erro
 	return errors.New(400, ErrorReason_USER_SOCIAL_LOGIN_FAILED.String(), fmt.Sprintf(format, args...))
 }
 
+// 用户邮箱已被其他用户使用
+func IsUserEmailAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_USER_EMAIL_ALREADY_EXISTS.String() && e.Code == 400
+}
+
+// 用户邮箱已被其他用户使用
+func ErrorUserEmailAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_USER_EMAIL_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+// 用户手机号已被其他用户使用
+func IsUserPhoneAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_USER_PHONE_ALREADY_EXISTS.String() && e.Code == 400
+}
+
+// 用户手机号已被其他用户使用
+func ErrorUserPhoneAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_USER_PHONE_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
 // =======================================
 // 角色管理错误 (300-399)
 // =======================================
@@ -963,6 +1019,20 @@ func ErrorDeptCannotDeleteWithUsers(format string, args ...interface{}) *errors.
 	return errors.New(403, ErrorReason_DEPT_CANNOT_DELETE_WITH_USERS.String(), fmt.Sprintf(format, args...))
 }
 
+// 上级部门无效，不存在或为自身及其下级部门
+func IsDeptParentInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_DEPT_PARENT_INVALID.String() && e.Code == 400
+}
+
+// 上级部门无效，不存在或为自身及其下级部门
+func ErrorDeptParentInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_DEPT_PARENT_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
 // =======================================
 // 数据层错误 (700-799)
 // =======================================
@@ -1314,3 +1384,201 @@ func IsThirdPartyUnauthorized(err error) bool {
 func ErrorThirdPartyUnauthorized(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, ErrorReason_THIRD_PARTY_UNAUTHORIZED.String(), fmt.Sprintf(format, args...))
 }
+
+// =======================================
+// 策略管理错误 (1200-1299)
+// =======================================
+// 策略格式无效
+func IsPolicyInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_POLICY_INVALID.String() && e.Code == 400
+}
+
+// =======================================
+// 策略管理错误 (1200-1299)
+// =======================================
+// 策略格式无效
+func ErrorPolicyInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_POLICY_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// 当前授权引擎不支持策略管理
+func IsPolicyNotSupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_POLICY_NOT_SUPPORTED.String() && e.Code == 501
+}
+
+// 当前授权引擎不支持策略管理
+func ErrorPolicyNotSupported(format string, args ...interface{}) *errors.Error {
+	return errors.New(501, ErrorReason_POLICY_NOT_SUPPORTED.String(), fmt.Sprintf(format, args...))
+}
+
+// =======================================
+// 数据导出错误 (1300-1399)
+// =======================================
+// 资源不支持导出
+func IsExportResourceNotSupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EXPORT_RESOURCE_NOT_SUPPORTED.String() && e.Code == 400
+}
+
+// =======================================
+// 数据导出错误 (1300-1399)
+// =======================================
+// 资源不支持导出
+func ErrorExportResourceNotSupported(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_EXPORT_RESOURCE_NOT_SUPPORTED.String(), fmt.Sprintf(format, args...))
+}
+
+// 导出的字段不存在或不允许导出
+func IsExportFieldNotSupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EXPORT_FIELD_NOT_SUPPORTED.String() && e.Code == 400
+}
+
+// 导出的字段不存在或不允许导出
+func ErrorExportFieldNotSupported(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_EXPORT_FIELD_NOT_SUPPORTED.String(), fmt.Sprintf(format, args...))
+}
+
+// 导出行数超过上限
+func IsExportTooLarge(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EXPORT_TOO_LARGE.String() && e.Code == 400
+}
+
+// 导出行数超过上限
+func ErrorExportTooLarge(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_EXPORT_TOO_LARGE.String(), fmt.Sprintf(format, args...))
+}
+
+// 导出任务不存在或已过期
+func IsExportJobNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EXPORT_JOB_NOT_FOUND.String() && e.Code == 404
+}
+
+// 导出任务不存在或已过期
+func ErrorExportJobNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_EXPORT_JOB_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// 导出任务尚未完成
+func IsExportJobNotReady(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EXPORT_JOB_NOT_READY.String() && e.Code == 409
+}
+
+// 导出任务尚未完成
+func ErrorExportJobNotReady(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_EXPORT_JOB_NOT_READY.String(), fmt.Sprintf(format, args...))
+}
+
+// =======================================
+// 全文搜索错误 (1400-1499)
+// =======================================
+// 索引不支持搜索
+func IsSearchIndexNotSupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SEARCH_INDEX_NOT_SUPPORTED.String() && e.Code == 400
+}
+
+// =======================================
+// 全文搜索错误 (1400-1499)
+// =======================================
+// 索引不支持搜索
+func ErrorSearchIndexNotSupported(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_SEARCH_INDEX_NOT_SUPPORTED.String(), fmt.Sprintf(format, args...))
+}
+
+// 搜索服务不可用
+func IsSearchUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SEARCH_UNAVAILABLE.String() && e.Code == 503
+}
+
+// 搜索服务不可用
+func ErrorSearchUnavailable(format string, args ...interface{}) *errors.Error {
+	return errors.New(503, ErrorReason_SEARCH_UNAVAILABLE.String(), fmt.Sprintf(format, args...))
+}
+
+// =======================================
+// 回收站错误 (1500-1599)
+// =======================================
+// 资源不支持回收站
+func IsTrashResourceNotSupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TRASH_RESOURCE_NOT_SUPPORTED.String() && e.Code == 400
+}
+
+// =======================================
+// 回收站错误 (1500-1599)
+// =======================================
+// 资源不支持回收站
+func ErrorTrashResourceNotSupported(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_TRASH_RESOURCE_NOT_SUPPORTED.String(), fmt.Sprintf(format, args...))
+}
+
+// 回收站中不存在该记录
+func IsTrashNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TRASH_NOT_FOUND.String() && e.Code == 404
+}
+
+// 回收站中不存在该记录
+func ErrorTrashNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_TRASH_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// =======================================
+// 并发控制错误 (1600-1699)
+// =======================================
+// 版本冲突，数据已被他人修改
+func IsVersionConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_VERSION_CONFLICT.String() && e.Code == 409
+}
+
+// =======================================
+// 并发控制错误 (1600-1699)
+// =======================================
+// 版本冲突，数据已被他人修改
+func ErrorVersionConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_VERSION_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
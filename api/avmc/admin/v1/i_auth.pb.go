@@ -155,18 +155,72 @@ func (x *Auth) GetPermissions() []string {
 	return nil
 }
 
+// 图形验证码
+type LoginCaptcha struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // 验证码ID
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // 验证码答案
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginCaptcha) Reset() {
+	*x = LoginCaptcha{}
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginCaptcha) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginCaptcha) ProtoMessage() {}
+
+func (x *LoginCaptcha) ProtoReflect() protoreflect.Message {
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginCaptcha.ProtoReflect.Descriptor instead.
+func (*LoginCaptcha) Descriptor() ([]byte, []int) {
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoginCaptcha) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoginCaptcha) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
 // 登录密码
 type LoginPassword struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 用户名
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // 密码
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`     // 用户名
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`     // 密码
+	Captcha       *LoginCaptcha          `protobuf:"bytes,3,opt,name=captcha,proto3,oneof" json:"captcha,omitempty"` // 图形验证码
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoginPassword) Reset() {
 	*x = LoginPassword{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[1]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -178,7 +232,7 @@ func (x *LoginPassword) String() string {
 func (*LoginPassword) ProtoMessage() {}
 
 func (x *LoginPassword) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[1]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,7 +245,7 @@ func (x *LoginPassword) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginPassword.ProtoReflect.Descriptor instead.
 func (*LoginPassword) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{1}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *LoginPassword) GetUsername() string {
@@ -208,6 +262,13 @@ func (x *LoginPassword) GetPassword() string {
 	return ""
 }
 
+func (x *LoginPassword) GetCaptcha() *LoginCaptcha {
+	if x != nil {
+		return x.Captcha
+	}
+	return nil
+}
+
 // 登录验证码
 type LoginCode struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -219,7 +280,7 @@ type LoginCode struct {
 
 func (x *LoginCode) Reset() {
 	*x = LoginCode{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[2]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -231,7 +292,7 @@ func (x *LoginCode) String() string {
 func (*LoginCode) ProtoMessage() {}
 
 func (x *LoginCode) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[2]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,7 +305,7 @@ func (x *LoginCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginCode.ProtoReflect.Descriptor instead.
 func (*LoginCode) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{2}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *LoginCode) GetPhone() string {
@@ -274,7 +335,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[3]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +347,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[3]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +360,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{3}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *LoginRequest) GetPassword() *LoginPassword {
@@ -345,7 +406,7 @@ type LoginResponse struct {
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[4]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +418,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[4]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +431,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{4}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LoginResponse) GetId() uint32 {
@@ -415,6 +476,120 @@ func (x *LoginResponse) GetExpiresIn() string {
 	return ""
 }
 
+// 图形验证码 - 请求
+type CaptchaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      *string                `protobuf:"bytes,1,opt,name=username,proto3,oneof" json:"username,omitempty"` // 登录用户名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptchaRequest) Reset() {
+	*x = CaptchaRequest{}
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptchaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptchaRequest) ProtoMessage() {}
+
+func (x *CaptchaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptchaRequest.ProtoReflect.Descriptor instead.
+func (*CaptchaRequest) Descriptor() ([]byte, []int) {
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CaptchaRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+// 图形验证码 - 回应
+type CaptchaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaptchaId     string                 `protobuf:"bytes,1,opt,name=captcha_id,json=captchaId,proto3" json:"captcha_id,omitempty"`       // 验证码ID
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`                                // 验证码图片
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`                         // 是否需要校验
+	ExpiresIn     *string                `protobuf:"bytes,4,opt,name=expires_in,json=expiresIn,proto3,oneof" json:"expires_in,omitempty"` // 验证码过期时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptchaResponse) Reset() {
+	*x = CaptchaResponse{}
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptchaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptchaResponse) ProtoMessage() {}
+
+func (x *CaptchaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptchaResponse.ProtoReflect.Descriptor instead.
+func (*CaptchaResponse) Descriptor() ([]byte, []int) {
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CaptchaResponse) GetCaptchaId() string {
+	if x != nil {
+		return x.CaptchaId
+	}
+	return ""
+}
+
+func (x *CaptchaResponse) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CaptchaResponse) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CaptchaResponse) GetExpiresIn() string {
+	if x != nil && x.ExpiresIn != nil {
+		return *x.ExpiresIn
+	}
+	return ""
+}
+
 // 请求 - 刷新令牌
 type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -425,7 +600,7 @@ type RefreshTokenRequest struct {
 
 func (x *RefreshTokenRequest) Reset() {
 	*x = RefreshTokenRequest{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[5]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +612,7 @@ func (x *RefreshTokenRequest) String() string {
 func (*RefreshTokenRequest) ProtoMessage() {}
 
 func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[5]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +625,7 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{5}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RefreshTokenRequest) GetRefreshToken() string {
@@ -472,7 +647,7 @@ type RefreshTokenResponse struct {
 
 func (x *RefreshTokenResponse) Reset() {
 	*x = RefreshTokenResponse{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[6]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -484,7 +659,7 @@ func (x *RefreshTokenResponse) String() string {
 func (*RefreshTokenResponse) ProtoMessage() {}
 
 func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[6]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,7 +672,7 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
 func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{6}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RefreshTokenResponse) GetAccessToken() string {
@@ -530,7 +705,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[7]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -542,7 +717,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[7]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,7 +730,7 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{7}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{10}
 }
 
 // 用户后台登出 - 回应
@@ -567,7 +742,7 @@ type LogoutResponse struct {
 
 func (x *LogoutResponse) Reset() {
 	*x = LogoutResponse{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[8]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -579,7 +754,7 @@ func (x *LogoutResponse) String() string {
 func (*LogoutResponse) ProtoMessage() {}
 
 func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[8]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +767,7 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
 func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{8}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{11}
 }
 
 // 登录用户简介信息 - 请求
@@ -604,7 +779,7 @@ type ProfileRequest struct {
 
 func (x *ProfileRequest) Reset() {
 	*x = ProfileRequest{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[9]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -616,7 +791,7 @@ func (x *ProfileRequest) String() string {
 func (*ProfileRequest) ProtoMessage() {}
 
 func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[9]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +804,7 @@ func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileRequest.ProtoReflect.Descriptor instead.
 func (*ProfileRequest) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{9}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{12}
 }
 
 // 登录用户简介信息 - 回应
@@ -646,7 +821,7 @@ type ProfileResponse struct {
 
 func (x *ProfileResponse) Reset() {
 	*x = ProfileResponse{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[10]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -658,7 +833,7 @@ func (x *ProfileResponse) String() string {
 func (*ProfileResponse) ProtoMessage() {}
 
 func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[10]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +846,7 @@ func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileResponse.ProtoReflect.Descriptor instead.
 func (*ProfileResponse) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{10}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ProfileResponse) GetUser() *v1.User {
@@ -718,7 +893,7 @@ type VbenProfileRequest struct {
 
 func (x *VbenProfileRequest) Reset() {
 	*x = VbenProfileRequest{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[11]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -730,7 +905,7 @@ func (x *VbenProfileRequest) String() string {
 func (*VbenProfileRequest) ProtoMessage() {}
 
 func (x *VbenProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[11]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +918,7 @@ func (x *VbenProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VbenProfileRequest.ProtoReflect.Descriptor instead.
 func (*VbenProfileRequest) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{11}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{14}
 }
 
 // 登录用户Vben简介信息 - 回应
@@ -763,7 +938,7 @@ type VbenProfileResponse struct {
 
 func (x *VbenProfileResponse) Reset() {
 	*x = VbenProfileResponse{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[12]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -775,7 +950,7 @@ func (x *VbenProfileResponse) String() string {
 func (*VbenProfileResponse) ProtoMessage() {}
 
 func (x *VbenProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[12]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +963,7 @@ func (x *VbenProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VbenProfileResponse.ProtoReflect.Descriptor instead.
 func (*VbenProfileResponse) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{12}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *VbenProfileResponse) GetUserId() uint32 {
@@ -856,7 +1031,7 @@ type CodesRequest struct {
 
 func (x *CodesRequest) Reset() {
 	*x = CodesRequest{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[13]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -868,7 +1043,7 @@ func (x *CodesRequest) String() string {
 func (*CodesRequest) ProtoMessage() {}
 
 func (x *CodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[13]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,7 +1056,7 @@ func (x *CodesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CodesRequest.ProtoReflect.Descriptor instead.
 func (*CodesRequest) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{13}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{16}
 }
 
 // 登录用户权限码 - 回应
@@ -894,7 +1069,7 @@ type CodesResponse struct {
 
 func (x *CodesResponse) Reset() {
 	*x = CodesResponse{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[14]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -906,7 +1081,7 @@ func (x *CodesResponse) String() string {
 func (*CodesResponse) ProtoMessage() {}
 
 func (x *CodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[14]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +1094,7 @@ func (x *CodesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CodesResponse.ProtoReflect.Descriptor instead.
 func (*CodesResponse) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{14}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CodesResponse) GetCodes() []string {
@@ -938,7 +1113,7 @@ type MenusRequest struct {
 
 func (x *MenusRequest) Reset() {
 	*x = MenusRequest{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[15]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -950,7 +1125,7 @@ func (x *MenusRequest) String() string {
 func (*MenusRequest) ProtoMessage() {}
 
 func (x *MenusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[15]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +1138,7 @@ func (x *MenusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenusRequest.ProtoReflect.Descriptor instead.
 func (*MenusRequest) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{15}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{18}
 }
 
 // 登录用户菜单 - 回应
@@ -976,7 +1151,7 @@ type MenusResponse struct {
 
 func (x *MenusResponse) Reset() {
 	*x = MenusResponse{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[16]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -988,7 +1163,7 @@ func (x *MenusResponse) String() string {
 func (*MenusResponse) ProtoMessage() {}
 
 func (x *MenusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[16]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1001,7 +1176,7 @@ func (x *MenusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenusResponse.ProtoReflect.Descriptor instead.
 func (*MenusResponse) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{16}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *MenusResponse) GetItems() []*v1.Menu {
@@ -1025,7 +1200,7 @@ type RouteResponse struct {
 
 func (x *RouteResponse) Reset() {
 	*x = RouteResponse{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[17]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1037,7 +1212,7 @@ func (x *RouteResponse) String() string {
 func (*RouteResponse) ProtoMessage() {}
 
 func (x *RouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[17]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,7 +1225,7 @@ func (x *RouteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteResponse.ProtoReflect.Descriptor instead.
 func (*RouteResponse) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{17}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RouteResponse) GetName() string {
@@ -1130,7 +1305,7 @@ type MenuMetaResponse struct {
 
 func (x *MenuMetaResponse) Reset() {
 	*x = MenuMetaResponse{}
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[18]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1142,7 +1317,7 @@ func (x *MenuMetaResponse) String() string {
 func (*MenuMetaResponse) ProtoMessage() {}
 
 func (x *MenuMetaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[18]
+	mi := &file_avmc_admin_v1_i_auth_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,7 +1330,7 @@ func (x *MenuMetaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuMetaResponse.ProtoReflect.Descriptor instead.
 func (*MenuMetaResponse) Descriptor() ([]byte, []int) {
-	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{18}
+	return file_avmc_admin_v1_i_auth_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MenuMetaResponse) GetActiveIcon() string {
@@ -1379,480 +1554,532 @@ var file_avmc_admin_v1_i_auth_proto_rawDesc = string([]byte{
 	0x06, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x2e,
 	0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
 	0x03, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x47, 0x09, 0x92, 0x02, 0x06, 0xe6, 0x9d, 0x83, 0xe9, 0x99,
-	0x90, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x84,
-	0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x3a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1e, 0xba, 0x47, 0x12, 0x92, 0x02, 0x0f, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95,
-	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0x8d, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01,
-	0x18, 0x0a, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b,
-	0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe5, 0xaf, 0x86, 0xe7,
-	0xa0, 0x81, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x06, 0x18, 0x19, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x75, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1e, 0xba, 0x47, 0x12, 0x92, 0x02, 0x0f, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe6,
-	0x89, 0x8b, 0xe6, 0x9c, 0xba, 0xe5, 0x8f, 0xb7, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18,
-	0x0a, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x47, 0x12, 0x92, 0x02, 0x0f, 0xe7, 0x99,
-	0xbb, 0xe5, 0xbd, 0x95, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0xba, 0x48, 0x06,
-	0x72, 0x04, 0x10, 0x01, 0x18, 0x0a, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xa2, 0x02, 0x0a,
-	0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x19, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c,
-	0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0x2f, 0xe5, 0x9f, 0x9f, 0x49, 0x44, 0xba, 0x48, 0x04, 0x2a,
-	0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x50, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65,
-	0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe6, 0x8e, 0x88, 0xe6, 0x9d, 0x83, 0xe7, 0xb1,
-	0xbb, 0xe5, 0x9e, 0x8b, 0x48, 0x01, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70,
-	0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
-	0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0xec, 0x02, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
-	0x0e, 0xba, 0x47, 0x0b, 0x92, 0x02, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0f, 0xba, 0x47, 0x0c, 0x92, 0x02, 0x09, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5,
-	0x90, 0x8d, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a,
-	0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe7,
-	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x48, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f,
-	0x92, 0x02, 0x0c, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x37, 0x0a, 0x0d,
-	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe5, 0x88, 0xb7, 0xe6, 0x96,
-	0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
-	0x5f, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02,
-	0x18, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe8, 0xbf, 0x87,
-	0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x48, 0x02, 0x52, 0x09, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e,
-	0x22, 0x4e, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12,
-	0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7,
-	0x89, 0x8c, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0xe5, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xba, 0x47, 0x15, 0x92, 0x02, 0x12, 0xe6, 0x96, 0xb0, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xbf,
-	0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3d, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xba,
-	0x47, 0x15, 0x92, 0x02, 0x12, 0xe6, 0x96, 0xb0, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0xb7, 0xe6, 0x96,
-	0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
-	0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02,
-	0x18, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe8, 0xbf, 0x87,
-	0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x6f,
-	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xef, 0x02,
-	0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x49, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe7, 0x99,
-	0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0x80, 0xe4, 0xbb, 0x8b,
-	0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x04,
-	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c,
-	0x65, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7,
-	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
-	0xaf, 0x48, 0x00, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x05,
-	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x24, 0xba, 0x47, 0x21,
-	0x92, 0x02, 0x1e, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8,
-	0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
-	0xaf, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x24, 0xba,
-	0x47, 0x21, 0x92, 0x02, 0x1e, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
-	0xb7, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe4, 0xbf, 0xa1,
-	0xe6, 0x81, 0xaf, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x34, 0x0a, 0x05, 0x6d, 0x65, 0x6e, 0x75, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8,
-	0xe6, 0x88, 0xb7, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52,
-	0x05, 0x6d, 0x65, 0x6e, 0x75, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x22,
-	0x14, 0x0a, 0x12, 0x56, 0x62, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x80, 0x04, 0x0a, 0x13, 0x56, 0x62, 0x65, 0x6e, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e,
-	0xba, 0x47, 0x0b, 0x92, 0x02, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x49, 0x44, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xba, 0x47, 0x0c, 0x92, 0x02, 0x09,
-	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0x8d, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x6c,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f,
-	0x92, 0x02, 0x0c, 0xe7, 0x9c, 0x9f, 0xe5, 0xae, 0x9e, 0xe5, 0xa7, 0x93, 0xe5, 0x90, 0x8d, 0x48,
-	0x01, 0x52, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29,
-	0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xba, 0x47, 0x09, 0x92, 0x02, 0x06, 0xe5, 0xa4, 0xb4, 0xe5, 0x83, 0x8f, 0x48, 0x02, 0x52, 0x06,
-	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x09, 0x68, 0x6f, 0x6d,
-	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47,
-	0x0f, 0x92, 0x02, 0x0c, 0xe9, 0xa6, 0x96, 0xe9, 0xa1, 0xb5, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84,
-	0x48, 0x03, 0x52, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12,
-	0x25, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba,
-	0x47, 0x09, 0x92, 0x02, 0x06, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x48, 0x04, 0x52, 0x04, 0x64,
-	0x65, 0x73, 0x63, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x1e, 0xba, 0x47, 0x1b,
-	0x92, 0x02, 0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8,
-	0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x48, 0x05, 0x52, 0x04, 0x72,
-	0x6f, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x24, 0xba, 0x47, 0x21, 0x92, 0x02, 0x1e, 0xe7, 0x99, 0xbb,
-	0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5,
-	0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x05, 0x72, 0x6f, 0x6c,
-	0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x68, 0x6f, 0x6d,
-	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x43, 0x6f, 0x64, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x0d, 0x43, 0x6f, 0x64, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x6f, 0x64,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x47, 0x18, 0x92, 0x02, 0x15,
-	0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x9d, 0x83, 0xe9,
-	0x99, 0x90, 0xe7, 0xa0, 0x81, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x0e, 0x0a, 0x0c,
-	0x4d, 0x65, 0x6e, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x0d,
-	0x4d, 0x65, 0x6e, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x6e, 0x75, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd,
-	0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe5, 0x88, 0x97,
-	0xe8, 0xa1, 0xa8, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x94, 0x03, 0x0a, 0x0d, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92,
-	0x02, 0x0c, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1,
-	0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x08,
-	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15,
-	0xba, 0x47, 0x12, 0x92, 0x02, 0x0f, 0xe9, 0x87, 0x8d, 0xe5, 0xae, 0x9a, 0xe5, 0x90, 0x91, 0xe8,
-	0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe8,
-	0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe7, 0xbb, 0x84, 0xe4, 0xbb, 0xb6, 0x48, 0x01, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x04, 0x6d,
-	0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x76, 0x6d, 0x63,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x65,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0xba, 0x47, 0x12, 0x92,
-	0x02, 0x0f, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe5, 0x85, 0x83, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
-	0xae, 0x48, 0x02, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x08,
-	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0xba, 0x47,
-	0x12, 0x92, 0x02, 0x0f, 0xe5, 0xad, 0x90, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x88, 0x97,
-	0xe8, 0xa1, 0xa8, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x22, 0xc2, 0x13, 0x0a, 0x10, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x47, 0x1b,
-	0x92, 0x02, 0x18, 0xe6, 0xbf, 0x80, 0xe6, 0xb4, 0xbb, 0xe5, 0x9b, 0xbe, 0xe6, 0xa0, 0x87, 0xef,
-	0xbc, 0x88, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xef, 0xbc, 0x89, 0x48, 0x00, 0x52, 0x0a, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x89, 0x01, 0x0a,
-	0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x63, 0xba, 0x47, 0x60, 0x92, 0x02, 0x5d, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d,
-	0xe6, 0xbf, 0x80, 0xe6, 0xb4, 0xbb, 0xe7, 0x9a, 0x84, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xef,
-	0xbc, 0x8c, 0xe6, 0x9c, 0x89, 0xe6, 0x97, 0xb6, 0xe5, 0x80, 0x99, 0xe4, 0xb8, 0x8d, 0xe6, 0x83,
-	0xb3, 0xe6, 0xbf, 0x80, 0xe6, 0xb4, 0xbb, 0xe7, 0x8e, 0xb0, 0xe6, 0x9c, 0x89, 0xe8, 0x8f, 0x9c,
-	0xe5, 0x8d, 0x95, 0xef, 0xbc, 0x8c, 0xe9, 0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe6, 0xbf, 0x80, 0xe6,
-	0xb4, 0xbb, 0xe7, 0x88, 0xb6, 0xe7, 0xba, 0xa7, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe6, 0x97,
-	0xb6, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x09, 0x61, 0x66, 0x66, 0x69,
-	0x78, 0x5f, 0x74, 0x61, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1b, 0xba, 0x47, 0x18,
-	0x92, 0x02, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x9b, 0xba, 0xe5, 0xae, 0x9a, 0xe6,
-	0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe9, 0xa1, 0xb5, 0x48, 0x02, 0x52, 0x08, 0x61, 0x66, 0x66, 0x69,
-	0x78, 0x54, 0x61, 0x62, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0f, 0x61, 0x66, 0x66, 0x69, 0x78,
-	0x5f, 0x74, 0x61, 0x62, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe5, 0x9b, 0xba, 0xe5, 0xae, 0x9a, 0xe6, 0xa0,
-	0x87, 0xe7, 0xad, 0xbe, 0xe9, 0xa1, 0xb5, 0xe7, 0x9a, 0x84, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f,
-	0x48, 0x03, 0x52, 0x0d, 0x61, 0x66, 0x66, 0x69, 0x78, 0x54, 0x61, 0x62, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x30, 0xba, 0x47, 0x2d, 0x92, 0x02, 0x2a, 0xe9,
-	0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe7, 0x89, 0xb9, 0xe5, 0xae, 0x9a, 0xe7, 0x9a, 0x84, 0xe8, 0xa7,
-	0x92, 0xe8, 0x89, 0xb2, 0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0x86, 0xe6, 0x89, 0x8d, 0xe5, 0x8f, 0xaf,
-	0xe4, 0xbb, 0xa5, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x47, 0x09, 0x92, 0x02, 0x06, 0xe5, 0xbe, 0xbd, 0xe6, 0xa0,
-	0x87, 0x48, 0x04, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a,
-	0x0a, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x23, 0xba, 0x47, 0x20, 0x92, 0x02, 0x1d, 0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87, 0xe7,
-	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x20, 0x27, 0x64, 0x6f, 0x74, 0x27, 0x20, 0x7c, 0x20, 0x27, 0x6e,
-	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x27, 0x48, 0x05, 0x52, 0x09, 0x62, 0x61, 0x64, 0x67, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x84, 0x01, 0x0a, 0x0e, 0x62, 0x61, 0x64, 0x67, 0x65,
-	0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x58, 0xba, 0x47, 0x55, 0x92, 0x02, 0x52, 0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87, 0xe9, 0xa2, 0x9c,
-	0xe8, 0x89, 0xb2, 0x20, 0x7c, 0x20, 0x27, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x27, 0x7c,
-	0x20, 0x27, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x76, 0x65, 0x27, 0x7c, 0x20,
-	0x27, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x27, 0x7c, 0x20, 0x27, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x27, 0x7c, 0x20, 0x27, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x27, 0x20,
-	0x7c, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x48, 0x06, 0x52, 0x0d, 0x62, 0x61, 0x64,
-	0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a,
-	0x0d, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x34, 0xba, 0x47, 0x31, 0x92, 0x02, 0x2e, 0xe8, 0xb7, 0xaf, 0xe7,
-	0x94, 0xb1, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x8c, 0xe6, 0x95, 0xb4, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe,
-	0x84, 0xe4, 0xbd, 0x9c, 0xe4, 0xb8, 0xba, 0x6b, 0x65, 0x79, 0xef, 0xbc, 0x88, 0xe9, 0xbb, 0x98,
-	0xe8, 0xae, 0xa4, 0x74, 0x72, 0x75, 0x65, 0xef, 0xbc, 0x89, 0x48, 0x07, 0x52, 0x0b, 0x66, 0x75,
-	0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x68, 0x0a, 0x15,
-	0x68, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5f, 0x69, 0x6e,
-	0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x30, 0xba, 0x47, 0x2d,
-	0x92, 0x02, 0x2a, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe7,
-	0x9a, 0x84, 0xe5, 0xad, 0x90, 0xe7, 0xba, 0xa7, 0xe5, 0x9c, 0xa8, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d,
-	0x95, 0xe4, 0xb8, 0xad, 0xe4, 0xb8, 0x8d, 0xe5, 0xb1, 0x95, 0xe7, 0x8e, 0xb0, 0x48, 0x08, 0x52,
-	0x12, 0x68, 0x69, 0x64, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x49, 0x6e, 0x4d,
-	0x65, 0x6e, 0x75, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x12, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69,
-	0x6e, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x2a, 0xba, 0x47, 0x27, 0x92, 0x02, 0x24, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d,
-	0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x9c, 0xa8, 0xe9, 0x9d, 0xa2, 0xe5, 0x8c, 0x85, 0xe5,
-	0xb1, 0x91, 0xe4, 0xb8, 0xad, 0xe4, 0xb8, 0x8d, 0xe5, 0xb1, 0x95, 0xe7, 0x8e, 0xb0, 0x48, 0x09,
-	0x52, 0x10, 0x68, 0x69, 0x64, 0x65, 0x49, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75,
-	0x6d, 0x62, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0c, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e,
-	0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x27, 0xba, 0x47, 0x24,
-	0x92, 0x02, 0x21, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5,
-	0x9c, 0xa8, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe4, 0xb8, 0xad, 0xe4, 0xb8, 0x8d, 0xe5, 0xb1,
-	0x95, 0xe7, 0x8e, 0xb0, 0x48, 0x0a, 0x52, 0x0a, 0x68, 0x69, 0x64, 0x65, 0x49, 0x6e, 0x4d, 0x65,
-	0x6e, 0x75, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x0b, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e,
-	0x5f, 0x74, 0x61, 0x62, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x42, 0x27, 0xba, 0x47, 0x24, 0x92,
-	0x02, 0x21, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x9c,
-	0xa8, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe9, 0xa1, 0xb5, 0xe4, 0xb8, 0x8d, 0xe5, 0xb1, 0x95,
-	0xe7, 0x8e, 0xb0, 0x48, 0x0b, 0x52, 0x09, 0x68, 0x69, 0x64, 0x65, 0x49, 0x6e, 0x54, 0x61, 0x62,
-	0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1c, 0xba, 0x47, 0x19, 0x92, 0x02, 0x16, 0xe5, 0x9b, 0xbe, 0xe6, 0xa0, 0x87, 0xef,
-	0xbc, 0x88, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0x2f, 0x74, 0x61, 0x62, 0xef, 0xbc, 0x89, 0x48,
-	0x0c, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0a, 0x69, 0x66,
-	0x72, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13,
-	0xba, 0x47, 0x10, 0x92, 0x02, 0x0d, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x20, 0xe5, 0x9c, 0xb0,
-	0xe5, 0x9d, 0x80, 0x48, 0x0d, 0x52, 0x09, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x72, 0x63,
-	0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x42, 0x27, 0xba, 0x47, 0x24, 0x92,
-	0x02, 0x21, 0xe5, 0xbf, 0xbd, 0xe7, 0x95, 0xa5, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xef, 0xbc,
-	0x8c, 0xe7, 0x9b, 0xb4, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xaf, 0xe4, 0xbb, 0xa5, 0xe8, 0xae, 0xbf,
-	0xe9, 0x97, 0xae, 0x48, 0x0e, 0x52, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x61,
-	0x6c, 0x69, 0x76, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1b, 0xba, 0x47, 0x18, 0x92,
-	0x02, 0x15, 0xe5, 0xbc, 0x80, 0xe5, 0x90, 0xaf, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76,
-	0x65, 0xe7, 0xbc, 0x93, 0xe5, 0xad, 0x98, 0x48, 0x0f, 0x52, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x41,
-	0x6c, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xba, 0x47, 0x16, 0x92, 0x02, 0x13, 0xe5, 0xa4, 0x96,
-	0xe9, 0x93, 0xbe, 0x2d, 0xe8, 0xb7, 0xb3, 0xe8, 0xbd, 0xac, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84,
-	0x48, 0x10, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x06, 0x6c,
-	0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x42, 0x21, 0xba, 0x47, 0x1e,
-	0x92, 0x02, 0x1b, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5,
-	0xb7, 0xb2, 0xe7, 0xbb, 0x8f, 0xe5, 0x8a, 0xa0, 0xe8, 0xbd, 0xbd, 0xe8, 0xbf, 0x87, 0x48, 0x11,
-	0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x13, 0x6d,
-	0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74,
-	0x61, 0x62, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x42, 0x21, 0xba, 0x47, 0x1e, 0x92, 0x02, 0x1b,
-	0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe9, 0xa1, 0xb5, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe6,
-	0x89, 0x93, 0xe5, 0xbc, 0x80, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x48, 0x12, 0x52, 0x0f, 0x6d,
-	0x61, 0x78, 0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x61, 0x62, 0x88, 0x01,
-	0x01, 0x12, 0x80, 0x01, 0x0a, 0x1b, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62,
-	0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
-	0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3c, 0xba, 0x47, 0x39, 0x92, 0x02, 0x36, 0xe8,
-	0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe5, 0x8f, 0xaf, 0xe4, 0xbb, 0xa5, 0xe7, 0x9c, 0x8b, 0xe5, 0x88,
-	0xb0, 0xef, 0xbc, 0x8c, 0xe4, 0xbd, 0x86, 0xe6, 0x98, 0xaf, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae,
-	0xe4, 0xbc, 0x9a, 0xe8, 0xa2, 0xab, 0xe9, 0x87, 0x8d, 0xe5, 0xae, 0x9a, 0xe5, 0x90, 0x91, 0xe5,
-	0x88, 0xb0, 0x34, 0x30, 0x33, 0x48, 0x13, 0x52, 0x18, 0x6d, 0x65, 0x6e, 0x75, 0x56, 0x69, 0x73,
-	0x69, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
-	0x6e, 0x88, 0x01, 0x01, 0x12, 0x6c, 0x0a, 0x0f, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63,
-	0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3f, 0xba,
-	0x47, 0x3c, 0x92, 0x02, 0x39, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xb7, 0xaf, 0xe7, 0x94,
-	0xb1, 0xe4, 0xb8, 0x8d, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe5, 0x9f, 0xba, 0xe7, 0xa1, 0x80,
-	0xe5, 0xb8, 0x83, 0xe5, 0xb1, 0x80, 0xef, 0xbc, 0x88, 0xe4, 0xbb, 0x85, 0xe5, 0x9c, 0xa8, 0xe9,
-	0xa1, 0xb6, 0xe7, 0xba, 0xa7, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xef, 0xbc, 0x89, 0x48, 0x14,
-	0x52, 0x0d, 0x6e, 0x6f, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x4a, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6e, 0x65,
-	0x77, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x42, 0x18,
-	0xba, 0x47, 0x15, 0x92, 0x02, 0x12, 0xe5, 0x9c, 0xa8, 0xe6, 0x96, 0xb0, 0xe7, 0xaa, 0x97, 0xe5,
-	0x8f, 0xa3, 0xe6, 0x89, 0x93, 0xe5, 0xbc, 0x80, 0x48, 0x15, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e,
-	0x49, 0x6e, 0x4e, 0x65, 0x77, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x88, 0x01, 0x01, 0x12, 0x3b,
-	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x42, 0x20, 0xba,
-	0x47, 0x1d, 0x92, 0x02, 0x1a, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe8, 0xb7, 0xaf, 0xe7, 0x94,
-	0xb1, 0x2d, 0x3e, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0x48,
-	0x16, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92,
-	0x02, 0x18, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe6, 0x89, 0x80, 0xe6, 0x90, 0xba, 0xe5, 0xb8,
-	0xa6, 0xe7, 0x9a, 0x84, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x48, 0x17, 0x52, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x1a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe6, 0xa0, 0x87,
-	0xe9, 0xa2, 0x98, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x63, 0x6f, 0x6e,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x74, 0x61, 0x62, 0x42, 0x12,
-	0x0a, 0x10, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x74, 0x61, 0x62, 0x5f, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x62, 0x61, 0x64, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
-	0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x42, 0x10,
-	0x0a, 0x0e, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79,
-	0x42, 0x18, 0x0a, 0x16, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72,
-	0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x68,
-	0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d,
-	0x62, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65,
-	0x6e, 0x75, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x74,
-	0x61, 0x62, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69,
-	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42,
-	0x16, 0x0a, 0x14, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x6f,
-	0x70, 0x65, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x6d, 0x65, 0x6e, 0x75,
-	0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66, 0x6f,
-	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x6f, 0x5f, 0x62,
-	0x61, 0x73, 0x69, 0x63, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f,
-	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x77, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06,
-	0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2a, 0x55, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x64, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x52, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x17, 0x0a, 0x13, 0x47, 0x52, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41,
-	0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x52, 0x41, 0x4e,
-	0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x02, 0x32, 0xd1, 0x0c,
-	0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xcd, 0x01,
-	0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x1b, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61,
+	0x90, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5c,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x21,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xba, 0x47, 0x0e, 0x92,
+	0x02, 0x0b, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x29, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x15, 0xba, 0x47, 0x12, 0x92, 0x02, 0x0f, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81,
+	0xe7, 0xad, 0x94, 0xe6, 0xa1, 0x88, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xe3, 0x01, 0x0a,
+	0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1e, 0xba, 0x47, 0x12, 0x92, 0x02, 0x0f, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94,
+	0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0x8d, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x0a,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x47,
+	0x0f, 0x92, 0x02, 0x0c, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81,
+	0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x06, 0x18, 0x19, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x51, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68,
+	0x61, 0x42, 0x15, 0xba, 0x47, 0x12, 0x92, 0x02, 0x0f, 0xe5, 0x9b, 0xbe, 0xe5, 0xbd, 0xa2, 0xe9,
+	0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0x48, 0x00, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74,
+	0x63, 0x68, 0x61, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x22, 0x75, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x34, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
+	0xba, 0x47, 0x12, 0x92, 0x02, 0x0f, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe6, 0x89, 0x8b, 0xe6,
+	0x9c, 0xba, 0xe5, 0x8f, 0xb7, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x0a, 0x52, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x47, 0x12, 0x92, 0x02, 0x0f, 0xe7, 0x99, 0xbb, 0xe5, 0xbd,
+	0x95, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10,
+	0x01, 0x18, 0x0a, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x0c, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
 	0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0xba, 0x47, 0x4e,
-	0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x12,
-	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0xe7, 0x99, 0xbb, 0xe5,
-	0xbd, 0x95, 0x1a, 0x2a, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
-	0xe5, 0x90, 0x8d, 0xe5, 0x92, 0x8c, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0xe7, 0x99, 0xbb, 0xe5,
-	0xbd, 0x95, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x29, 0x3a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1d,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6c,
-	0x6f, 0x67, 0x69, 0x6e, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0xc6, 0x01,
-	0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x76,
-	0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e, 0xba, 0x47, 0x54, 0x0a, 0x0c, 0xe8, 0xae, 0xa4,
-	0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x15, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
-	0xb7, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95,
-	0x1a, 0x2d, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe6, 0x89, 0x8b, 0xe6, 0x9c, 0xba, 0xe5, 0x8f,
-	0xb7, 0xe5, 0x92, 0x8c, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0xe7, 0x99, 0xbb,
-	0xe5, 0xbd, 0x95, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x19, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6c, 0x6f, 0x67, 0x69,
-	0x6e, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0xde, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61,
+	0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x19, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe7, 0xa7, 0x9f,
+	0xe6, 0x88, 0xb7, 0x2f, 0xe5, 0x9f, 0x9f, 0x49, 0x44, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x28, 0x00,
+	0x48, 0x00, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x50, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x42, 0x12, 0xba,
+	0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe6, 0x8e, 0x88, 0xe6, 0x9d, 0x83, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e,
+	0x8b, 0x48, 0x01, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xec,
+	0x02, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xba, 0x47,
+	0x0b, 0x92, 0x02, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f,
+	0xba, 0x47, 0x0c, 0x92, 0x02, 0x09, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0x8d, 0x48,
+	0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12,
+	0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe7, 0xb1, 0xbb, 0xe5,
+	0x9e, 0x8b, 0x48, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x35, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c,
+	0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x37, 0x0a, 0x0d, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb,
+	0xa4, 0xe7, 0x89, 0x8c, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe8, 0xae,
+	0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f,
+	0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x48, 0x02, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x49, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x22, 0x7f, 0x0a,
+	0x0e, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x60, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x3f, 0xba, 0x47, 0x3c, 0x92, 0x02, 0x39, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7,
+	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0x8d, 0xef, 0xbc, 0x8c, 0xe7, 0x94, 0xa8, 0xe4, 0xba,
+	0x8e, 0xe5, 0x88, 0xa4, 0xe6, 0x96, 0xad, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe9, 0x9c, 0x80,
+	0xe8, 0xa6, 0x81, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7,
+	0xa0, 0x81, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9d,
+	0x02, 0x0a, 0x0f, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xba, 0x47, 0x0e, 0x92, 0x02, 0x0b, 0xe9, 0xaa,
+	0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0x49, 0x44, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2a, 0xba, 0x47, 0x27, 0x92, 0x02, 0x24, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf,
+	0x81, 0xe7, 0xa0, 0x81, 0xe5, 0x9b, 0xbe, 0xe7, 0x89, 0x87, 0xef, 0xbc, 0x88, 0x62, 0x61, 0x73,
+	0x65, 0x36, 0x34, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x55, 0x52, 0x49, 0xef, 0xbc, 0x89, 0x52,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2a, 0xba, 0x47, 0x27, 0x92, 0x02, 0x24,
+	0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe6, 0x97, 0xb6, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe9,
+	0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf,
+	0x81, 0xe7, 0xa0, 0x81, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x3f,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1b, 0xba, 0x47, 0x18, 0x92, 0x02, 0x15, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81,
+	0xe7, 0xa0, 0x81, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x48,
+	0x00, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x88, 0x01, 0x01, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x22, 0x4e,
+	0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47,
+	0x0f, 0x92, 0x02, 0x0c, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c,
+	0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe5,
+	0x01, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xba,
+	0x47, 0x15, 0x92, 0x02, 0x12, 0xe6, 0x96, 0xb0, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xbf, 0xe9, 0x97,
+	0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3d, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xba, 0x47, 0x15,
+	0x92, 0x02, 0x12, 0xe6, 0x96, 0xb0, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4,
+	0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe8,
+	0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe8, 0xbf, 0x87, 0xe6, 0x9c,
+	0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x49, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xef, 0x02, 0x0a, 0x0f,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x49, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe7, 0x99, 0xbb, 0xe5,
+	0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0x80, 0xe4, 0xbb, 0x8b, 0xe4, 0xbf,
+	0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8,
+	0xe6, 0x88, 0xb7, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x48,
+	0x00, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x24, 0xba, 0x47, 0x21, 0x92, 0x02,
+	0x1e, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8, 0xa7, 0x92,
+	0xe8, 0x89, 0xb2, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x24, 0xba, 0x47, 0x21,
+	0x92, 0x02, 0x1e, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6,
+	0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
+	0xaf, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34,
+	0x0a, 0x05, 0x6d, 0x65, 0x6e, 0x75, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1e, 0xba,
+	0x47, 0x1b, 0x92, 0x02, 0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+	0xb7, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x05, 0x6d,
+	0x65, 0x6e, 0x75, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x14, 0x0a,
+	0x12, 0x56, 0x62, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x80, 0x04, 0x0a, 0x13, 0x56, 0x62, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xba, 0x47,
+	0x0b, 0x92, 0x02, 0x08, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xba, 0x47, 0x0c, 0x92, 0x02, 0x09, 0xe7, 0x94,
+	0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0x8d, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02,
+	0x0c, 0xe7, 0x9c, 0x9f, 0xe5, 0xae, 0x9e, 0xe5, 0xa7, 0x93, 0xe5, 0x90, 0x8d, 0x48, 0x01, 0x52,
+	0x08, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x06,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x47,
+	0x09, 0x92, 0x02, 0x06, 0xe5, 0xa4, 0xb4, 0xe5, 0x83, 0x8f, 0x48, 0x02, 0x52, 0x06, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x09, 0x68, 0x6f, 0x6d, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92,
+	0x02, 0x0c, 0xe9, 0xa6, 0x96, 0xe9, 0xa1, 0xb5, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0x48, 0x03,
+	0x52, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x47, 0x09,
+	0x92, 0x02, 0x06, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x48, 0x04, 0x52, 0x04, 0x64, 0x65, 0x73,
+	0x63, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02,
+	0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8, 0xa7, 0x92,
+	0xe8, 0x89, 0xb2, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x48, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x24, 0xba, 0x47, 0x21, 0x92, 0x02, 0x1e, 0xe7, 0x99, 0xbb, 0xe5, 0xbd,
+	0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0x88, 0x97,
+	0xe8, 0xa1, 0xa8, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x0d, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1b, 0xba, 0x47, 0x18, 0x92, 0x02, 0x15, 0xe7, 0x99,
+	0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90,
+	0xe7, 0xa0, 0x81, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x4d, 0x65,
+	0x6e, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x0d, 0x4d, 0x65,
+	0x6e, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e,
+	0x75, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7,
+	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe5, 0x88, 0x97, 0xe8, 0xa1,
+	0xa8, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x94, 0x03, 0x0a, 0x0d, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c,
+	0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe8, 0xb7,
+	0xaf, 0xe5, 0xbe, 0x84, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xba, 0x47,
+	0x12, 0x92, 0x02, 0x0f, 0xe9, 0x87, 0x8d, 0xe5, 0xae, 0x9a, 0xe5, 0x90, 0x91, 0xe8, 0xb7, 0xaf,
+	0xe5, 0xbe, 0x84, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe8, 0xb7, 0xaf,
+	0xe7, 0x94, 0xb1, 0xe7, 0xbb, 0x84, 0xe4, 0xbb, 0xb6, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x65, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0xba, 0x47, 0x12, 0x92, 0x02, 0x0f,
+	0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe5, 0x85, 0x83, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x48,
+	0x02, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x08, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
+	0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0xba, 0x47, 0x12, 0x92,
+	0x02, 0x0f, 0xe5, 0xad, 0x90, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x88, 0x97, 0xe8, 0xa1,
+	0xa8, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x22,
+	0xc2, 0x13, 0x0a, 0x10, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69,
+	0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02,
+	0x18, 0xe6, 0xbf, 0x80, 0xe6, 0xb4, 0xbb, 0xe5, 0x9b, 0xbe, 0xe6, 0xa0, 0x87, 0xef, 0xbc, 0x88,
+	0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xef, 0xbc, 0x89, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x89, 0x01, 0x0a, 0x0b, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x63, 0xba, 0x47, 0x60, 0x92, 0x02, 0x5d, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe6, 0xbf,
+	0x80, 0xe6, 0xb4, 0xbb, 0xe7, 0x9a, 0x84, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xef, 0xbc, 0x8c,
+	0xe6, 0x9c, 0x89, 0xe6, 0x97, 0xb6, 0xe5, 0x80, 0x99, 0xe4, 0xb8, 0x8d, 0xe6, 0x83, 0xb3, 0xe6,
+	0xbf, 0x80, 0xe6, 0xb4, 0xbb, 0xe7, 0x8e, 0xb0, 0xe6, 0x9c, 0x89, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d,
+	0x95, 0xef, 0xbc, 0x8c, 0xe9, 0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe6, 0xbf, 0x80, 0xe6, 0xb4, 0xbb,
+	0xe7, 0x88, 0xb6, 0xe7, 0xba, 0xa7, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe6, 0x97, 0xb6, 0xe4,
+	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x09, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f,
+	0x74, 0x61, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1b, 0xba, 0x47, 0x18, 0x92, 0x02,
+	0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x9b, 0xba, 0xe5, 0xae, 0x9a, 0xe6, 0xa0, 0x87,
+	0xe7, 0xad, 0xbe, 0xe9, 0xa1, 0xb5, 0x48, 0x02, 0x52, 0x08, 0x61, 0x66, 0x66, 0x69, 0x78, 0x54,
+	0x61, 0x62, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0f, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x74,
+	0x61, 0x62, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1e,
+	0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe5, 0x9b, 0xba, 0xe5, 0xae, 0x9a, 0xe6, 0xa0, 0x87, 0xe7,
+	0xad, 0xbe, 0xe9, 0xa1, 0xb5, 0xe7, 0x9a, 0x84, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0x48, 0x03,
+	0x52, 0x0d, 0x61, 0x66, 0x66, 0x69, 0x78, 0x54, 0x61, 0x62, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x4e, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x30, 0xba, 0x47, 0x2d, 0x92, 0x02, 0x2a, 0xe9, 0x9c, 0x80,
+	0xe8, 0xa6, 0x81, 0xe7, 0x89, 0xb9, 0xe5, 0xae, 0x9a, 0xe7, 0x9a, 0x84, 0xe8, 0xa7, 0x92, 0xe8,
+	0x89, 0xb2, 0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0x86, 0xe6, 0x89, 0x8d, 0xe5, 0x8f, 0xaf, 0xe4, 0xbb,
+	0xa5, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xba, 0x47, 0x09, 0x92, 0x02, 0x06, 0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87, 0x48,
+	0x04, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0a, 0x62,
+	0x61, 0x64, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x23, 0xba, 0x47, 0x20, 0x92, 0x02, 0x1d, 0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87, 0xe7, 0xb1, 0xbb,
+	0xe5, 0x9e, 0x8b, 0x20, 0x27, 0x64, 0x6f, 0x74, 0x27, 0x20, 0x7c, 0x20, 0x27, 0x6e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x27, 0x48, 0x05, 0x52, 0x09, 0x62, 0x61, 0x64, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x84, 0x01, 0x0a, 0x0e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x58, 0xba,
+	0x47, 0x55, 0x92, 0x02, 0x52, 0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87, 0xe9, 0xa2, 0x9c, 0xe8, 0x89,
+	0xb2, 0x20, 0x7c, 0x20, 0x27, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x27, 0x7c, 0x20, 0x27,
+	0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x76, 0x65, 0x27, 0x7c, 0x20, 0x27, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x27, 0x7c, 0x20, 0x27, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x27, 0x7c, 0x20, 0x27, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x27, 0x20, 0x7c, 0x20,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x48, 0x06, 0x52, 0x0d, 0x62, 0x61, 0x64, 0x67, 0x65,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x66,
+	0x75, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x34, 0xba, 0x47, 0x31, 0x92, 0x02, 0x2e, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1,
+	0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x8c, 0xe6, 0x95, 0xb4, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0xe4,
+	0xbd, 0x9c, 0xe4, 0xb8, 0xba, 0x6b, 0x65, 0x79, 0xef, 0xbc, 0x88, 0xe9, 0xbb, 0x98, 0xe8, 0xae,
+	0xa4, 0x74, 0x72, 0x75, 0x65, 0xef, 0xbc, 0x89, 0x48, 0x07, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c,
+	0x50, 0x61, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x68, 0x0a, 0x15, 0x68, 0x69,
+	0x64, 0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6d,
+	0x65, 0x6e, 0x75, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x30, 0xba, 0x47, 0x2d, 0x92, 0x02,
+	0x2a, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe7, 0x9a, 0x84,
+	0xe5, 0xad, 0x90, 0xe7, 0xba, 0xa7, 0xe5, 0x9c, 0xa8, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe4,
+	0xb8, 0xad, 0xe4, 0xb8, 0x8d, 0xe5, 0xb1, 0x95, 0xe7, 0x8e, 0xb0, 0x48, 0x08, 0x52, 0x12, 0x68,
+	0x69, 0x64, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x49, 0x6e, 0x4d, 0x65, 0x6e,
+	0x75, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x12, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f,
+	0x62, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x2a, 0xba, 0x47, 0x27, 0x92, 0x02, 0x24, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xb7,
+	0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x9c, 0xa8, 0xe9, 0x9d, 0xa2, 0xe5, 0x8c, 0x85, 0xe5, 0xb1, 0x91,
+	0xe4, 0xb8, 0xad, 0xe4, 0xb8, 0x8d, 0xe5, 0xb1, 0x95, 0xe7, 0x8e, 0xb0, 0x48, 0x09, 0x52, 0x10,
+	0x68, 0x69, 0x64, 0x65, 0x49, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62,
+	0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0c, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d,
+	0x65, 0x6e, 0x75, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x27, 0xba, 0x47, 0x24, 0x92, 0x02,
+	0x21, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x9c, 0xa8,
+	0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe4, 0xb8, 0xad, 0xe4, 0xb8, 0x8d, 0xe5, 0xb1, 0x95, 0xe7,
+	0x8e, 0xb0, 0x48, 0x0a, 0x52, 0x0a, 0x68, 0x69, 0x64, 0x65, 0x49, 0x6e, 0x4d, 0x65, 0x6e, 0x75,
+	0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x0b, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x74,
+	0x61, 0x62, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x42, 0x27, 0xba, 0x47, 0x24, 0x92, 0x02, 0x21,
+	0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x9c, 0xa8, 0xe6,
+	0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe9, 0xa1, 0xb5, 0xe4, 0xb8, 0x8d, 0xe5, 0xb1, 0x95, 0xe7, 0x8e,
+	0xb0, 0x48, 0x0b, 0x52, 0x09, 0x68, 0x69, 0x64, 0x65, 0x49, 0x6e, 0x54, 0x61, 0x62, 0x88, 0x01,
+	0x01, 0x12, 0x35, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1c, 0xba, 0x47, 0x19, 0x92, 0x02, 0x16, 0xe5, 0x9b, 0xbe, 0xe6, 0xa0, 0x87, 0xef, 0xbc, 0x88,
+	0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0x2f, 0x74, 0x61, 0x62, 0xef, 0xbc, 0x89, 0x48, 0x0c, 0x52,
+	0x04, 0x69, 0x63, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0a, 0x69, 0x66, 0x72, 0x61,
+	0x6d, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xba, 0x47,
+	0x10, 0x92, 0x02, 0x0d, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x20, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d,
+	0x80, 0x48, 0x0d, 0x52, 0x09, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x72, 0x63, 0x88, 0x01,
+	0x01, 0x12, 0x51, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x42, 0x27, 0xba, 0x47, 0x24, 0x92, 0x02, 0x21,
+	0xe5, 0xbf, 0xbd, 0xe7, 0x95, 0xa5, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xef, 0xbc, 0x8c, 0xe7,
+	0x9b, 0xb4, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xaf, 0xe4, 0xbb, 0xa5, 0xe8, 0xae, 0xbf, 0xe9, 0x97,
+	0xae, 0x48, 0x0e, 0x52, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x61, 0x6c, 0x69,
+	0x76, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1b, 0xba, 0x47, 0x18, 0x92, 0x02, 0x15,
+	0xe5, 0xbc, 0x80, 0xe5, 0x90, 0xaf, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0xe7,
+	0xbc, 0x93, 0xe5, 0xad, 0x98, 0x48, 0x0f, 0x52, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69,
+	0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x19, 0xba, 0x47, 0x16, 0x92, 0x02, 0x13, 0xe5, 0xa4, 0x96, 0xe9, 0x93,
+	0xbe, 0x2d, 0xe8, 0xb7, 0xb3, 0xe8, 0xbd, 0xac, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0x48, 0x10,
+	0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x06, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x42, 0x21, 0xba, 0x47, 0x1e, 0x92, 0x02,
+	0x1b, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xb7, 0xb2,
+	0xe7, 0xbb, 0x8f, 0xe5, 0x8a, 0xa0, 0xe8, 0xbd, 0xbd, 0xe8, 0xbf, 0x87, 0x48, 0x11, 0x52, 0x06,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x13, 0x6d, 0x61, 0x78,
+	0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x61, 0x62,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x42, 0x21, 0xba, 0x47, 0x1e, 0x92, 0x02, 0x1b, 0xe6, 0xa0,
+	0x87, 0xe7, 0xad, 0xbe, 0xe9, 0xa1, 0xb5, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe6, 0x89, 0x93,
+	0xe5, 0xbc, 0x80, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x48, 0x12, 0x52, 0x0f, 0x6d, 0x61, 0x78,
+	0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x61, 0x62, 0x88, 0x01, 0x01, 0x12,
+	0x80, 0x01, 0x0a, 0x1b, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3c, 0xba, 0x47, 0x39, 0x92, 0x02, 0x36, 0xe8, 0x8f, 0x9c,
+	0xe5, 0x8d, 0x95, 0xe5, 0x8f, 0xaf, 0xe4, 0xbb, 0xa5, 0xe7, 0x9c, 0x8b, 0xe5, 0x88, 0xb0, 0xef,
+	0xbc, 0x8c, 0xe4, 0xbd, 0x86, 0xe6, 0x98, 0xaf, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbc,
+	0x9a, 0xe8, 0xa2, 0xab, 0xe9, 0x87, 0x8d, 0xe5, 0xae, 0x9a, 0xe5, 0x90, 0x91, 0xe5, 0x88, 0xb0,
+	0x34, 0x30, 0x33, 0x48, 0x13, 0x52, 0x18, 0x6d, 0x65, 0x6e, 0x75, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x6c, 0x0a, 0x0f, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x6c,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3f, 0xba, 0x47, 0x3c,
+	0x92, 0x02, 0x39, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe4,
+	0xb8, 0x8d, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe5, 0x9f, 0xba, 0xe7, 0xa1, 0x80, 0xe5, 0xb8,
+	0x83, 0xe5, 0xb1, 0x80, 0xef, 0xbc, 0x88, 0xe4, 0xbb, 0x85, 0xe5, 0x9c, 0xa8, 0xe9, 0xa1, 0xb6,
+	0xe7, 0xba, 0xa7, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xef, 0xbc, 0x89, 0x48, 0x14, 0x52, 0x0d,
+	0x6e, 0x6f, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x4a, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6e, 0x65, 0x77, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x42, 0x18, 0xba, 0x47,
+	0x15, 0x92, 0x02, 0x12, 0xe5, 0x9c, 0xa8, 0xe6, 0x96, 0xb0, 0xe7, 0xaa, 0x97, 0xe5, 0x8f, 0xa3,
+	0xe6, 0x89, 0x93, 0xe5, 0xbc, 0x80, 0x48, 0x15, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x6e,
+	0x4e, 0x65, 0x77, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x42, 0x20, 0xba, 0x47, 0x1d,
+	0x92, 0x02, 0x1a, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0x2d,
+	0x3e, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0x48, 0x16, 0x52,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18,
+	0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe6, 0x89, 0x80, 0xe6, 0x90, 0xba, 0xe5, 0xb8, 0xa6, 0xe7,
+	0x9a, 0x84, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x48, 0x17, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe6, 0xa0, 0x87, 0xe9, 0xa2,
+	0x98, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x74, 0x61, 0x62, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x74, 0x61, 0x62, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x62, 0x61, 0x64, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x62,
+	0x61, 0x64, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x62, 0x61,
+	0x64, 0x67, 0x65, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x18,
+	0x0a, 0x16, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x68, 0x69, 0x64,
+	0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6e, 0x75,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x61, 0x62,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6b,
+	0x65, 0x65, 0x70, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x16, 0x0a,
+	0x14, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x74, 0x61, 0x62, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66, 0x6f, 0x72, 0x62,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73,
+	0x69, 0x63, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6f, 0x70,
+	0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2a, 0x55, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x52, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a,
+	0x13, 0x47, 0x52, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x53,
+	0x57, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x52, 0x41, 0x4e, 0x44, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x02, 0x32, 0xaf, 0x0e, 0x0a, 0x0b,
+	0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xcd, 0x01, 0x0a, 0x0d,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x2e,
+	0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x76, 0x6d,
+	0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0xba, 0x47, 0x4e, 0x0a, 0x0c,
+	0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x12, 0xe7, 0x94,
+	0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95,
+	0x1a, 0x2a, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x90,
+	0x8d, 0xe5, 0x92, 0x8c, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95,
+	0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x29, 0x3a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1d, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0xc6, 0x01, 0x0a, 0x09,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x76, 0x6d, 0x63,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e, 0xba, 0x47, 0x54, 0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf,
+	0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x15, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe9,
+	0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0x1a, 0x2d,
+	0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe6, 0x89, 0x8b, 0xe6, 0x9c, 0xba, 0xe5, 0x8f, 0xb7, 0xe5,
+	0x92, 0x8c, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0xe7, 0x99, 0xbb, 0xe5, 0xbd,
+	0x95, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x3a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x19, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2f,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0xdb, 0x01, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61,
+	0x12, 0x1d, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x90, 0x01, 0xba, 0x47, 0x6f, 0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d,
+	0xe5, 0x8a, 0xa1, 0x12, 0x15, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0x9b, 0xbe, 0xe5, 0xbd,
+	0xa2, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0x1a, 0x48, 0xe7, 0x94, 0x9f, 0xe6,
+	0x88, 0x90, 0xe5, 0x9b, 0xbe, 0xe5, 0xbd, 0xa2, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0,
+	0x81, 0xef, 0xbc, 0x8c, 0xe5, 0xb9, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0xbd, 0x93,
+	0xe5, 0x89, 0x8d, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe9,
+	0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf,
+	0x81, 0xe7, 0xa0, 0x81, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x12, 0xde, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x22, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61,
 	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x76,
-	0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x84, 0x01, 0xba, 0x47, 0x5a, 0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c,
-	0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x0c, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7,
-	0x89, 0x8c, 0x1a, 0x2a, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0,
-	0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0xb0, 0xe7,
-	0x9a, 0x84, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x5a, 0x10,
-	0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0xbb, 0x01, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f,
-	0x75, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x74, 0xba, 0x47, 0x51, 0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5,
-	0x8a, 0xa1, 0x12, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x99, 0xbb, 0xe5, 0x87, 0xba,
-	0x1a, 0x21, 0xe9, 0x80, 0x80, 0xe5, 0x87, 0xba, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe5, 0xb9,
-	0xb6, 0xe5, 0xa4, 0xb1, 0xe6, 0x95, 0x88, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe4, 0xbb, 0xa4,
-	0xe7, 0x89, 0x8c, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6c,
-	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0xbf, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x1d, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x75, 0xba, 0x47, 0x54, 0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d,
-	0xe5, 0x8a, 0xa1, 0x12, 0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
-	0xb7, 0xe7, 0xae, 0x80, 0xe4, 0xbb, 0x8b, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x1a, 0x18, 0xe7,
-	0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0x80, 0xe4, 0xbb,
-	0x8b, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61,
-	0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12,
-	0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0xd9, 0x01, 0x0a, 0x0b, 0x56, 0x62, 0x65, 0x6e,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x62, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x76, 0x6d,
-	0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x62, 0x65, 0x6e, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82,
-	0x01, 0xba, 0x47, 0x5c, 0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5,
-	0x8a, 0xa1, 0x12, 0x1c, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
-	0x56, 0x62, 0x65, 0x6e, 0xe7, 0xae, 0x80, 0xe4, 0xbb, 0x8b, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf,
-	0x1a, 0x1c, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x56, 0x62,
-	0x65, 0x6e, 0xe7, 0xae, 0x80, 0xe4, 0xbb, 0x8b, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x5a, 0x10,
-	0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x62, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x12, 0xb1, 0x01, 0x0a, 0x05, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1b, 0x2e,
-	0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x76, 0x6d,
-	0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d, 0xba, 0x47, 0x4e, 0x0a, 0x0c, 0xe8,
-	0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x15, 0xe7, 0x99, 0xbb,
-	0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7,
-	0xa0, 0x81, 0x1a, 0x15, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
-	0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7, 0xa0, 0x81, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42,
-	0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x12, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0xb7, 0x01, 0x0a, 0x05, 0x4d, 0x65, 0x6e, 0x75,
-	0x73, 0x12, 0x1b, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x6e, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0xba, 0x47,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0xba,
+	0x47, 0x5a, 0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1,
+	0x12, 0x0c, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x1a, 0x2a,
+	0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7,
+	0x89, 0x8c, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0xb0, 0xe7, 0x9a, 0x84, 0xe8, 0xae,
+	0xbf, 0xe9, 0x97, 0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a,
+	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2d, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0xbb, 0x01, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x1c,
+	0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
+	0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74, 0xba, 0x47, 0x51,
+	0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x0c,
+	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x99, 0xbb, 0xe5, 0x87, 0xba, 0x1a, 0x21, 0xe9, 0x80,
+	0x80, 0xe5, 0x87, 0xba, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe5, 0xb9, 0xb6, 0xe5, 0xa4, 0xb1,
+	0xe6, 0x95, 0x88, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x5a,
+	0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6c, 0x6f, 0x67, 0x6f, 0x75,
+	0x74, 0x12, 0xbf, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x2e,
+	0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61,
+	0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0xba, 0x47,
 	0x54, 0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12,
-	0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8, 0x8f, 0x9c,
-	0xe5, 0x8d, 0x95, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x1a, 0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd,
-	0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe4, 0xbf, 0xa1,
+	0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0x80,
+	0xe4, 0xbb, 0x8b, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x1a, 0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd,
+	0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0x80, 0xe4, 0xbb, 0x8b, 0xe4, 0xbf, 0xa1,
 	0xe6, 0x81, 0xaf, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6d, 0x65, 0x6e, 0x75,
-	0x73, 0x42, 0xae, 0x04, 0xba, 0x47, 0x8f, 0x03, 0x12, 0x81, 0x02, 0x0a, 0x13, 0x41, 0x56, 0x4d,
-	0x43, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x41, 0x75, 0x74, 0x68, 0x20, 0x41, 0x50, 0x49,
-	0x12, 0x2d, 0x41, 0x56, 0x4d, 0x43, 0x20, 0xe5, 0x90, 0x8e, 0xe5, 0x8f, 0xb0, 0xe7, 0xae, 0xa1,
-	0xe7, 0x90, 0x86, 0xe8, 0x84, 0x9a, 0xe6, 0x89, 0x8b, 0xe6, 0x9e, 0xb6, 0xe7, 0xb3, 0xbb, 0xe7,
-	0xbb, 0x9f, 0x2d, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x22,
-	0x54, 0x0a, 0x10, 0x41, 0x56, 0x4d, 0x43, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0xe6, 0x9e, 0xb6,
-	0xe6, 0x9e, 0x84, 0x12, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x65, 0x63, 0x6f,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x1a, 0x10, 0x37, 0x33, 0x37, 0x30, 0x34, 0x33, 0x39, 0x38, 0x30, 0x40, 0x71,
-	0x71, 0x2e, 0x63, 0x6f, 0x6d, 0x2a, 0x5e, 0x0a, 0x14, 0x42, 0x53, 0x44, 0x20, 0x33, 0x2d, 0x43,
-	0x6c, 0x61, 0x75, 0x73, 0x65, 0x20, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x68,
-	0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x62, 0x6c,
-	0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53,
-	0x45, 0x2e, 0x74, 0x78, 0x74, 0x32, 0x05, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x1a, 0x2f, 0x0a, 0x1a,
-	0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x31, 0x12, 0x11, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2a, 0x58, 0x3a,
-	0x56, 0x0a, 0x54, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12,
-	0x46, 0x0a, 0x44, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x2f, 0x4a, 0x57, 0x54, 0x20, 0x42,
-	0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20,
-	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x2e, 0x2e, 0x2a, 0x06, 0x62, 0x65, 0x61, 0x72,
-	0x65, 0x72, 0x32, 0x03, 0x4a, 0x57, 0x54, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x76, 0x6d,
-	0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x49, 0x41, 0x75, 0x74,
-	0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x76,
-	0x6d, 0x63, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x0f, 0x41, 0x76, 0x6d, 0x63, 0x3a, 0x3a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0xd9, 0x01, 0x0a, 0x0b, 0x56, 0x62, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x62, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x62, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x01, 0xba, 0x47, 0x5c,
+	0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x1c,
+	0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x56, 0x62, 0x65, 0x6e,
+	0xe7, 0xae, 0x80, 0xe4, 0xbb, 0x8b, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x1a, 0x1c, 0xe7, 0x99,
+	0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x56, 0x62, 0x65, 0x6e, 0xe7, 0xae,
+	0x80, 0xe4, 0xbb, 0x8b, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a,
+	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75,
+	0x74, 0x68, 0x2f, 0x76, 0x62, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0xb1, 0x01, 0x0a, 0x05, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x76, 0x6d, 0x63,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d, 0xba, 0x47, 0x4e, 0x0a, 0x0c, 0xe8, 0xae, 0xa4, 0xe8, 0xaf,
+	0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x15, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7,
+	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7, 0xa0, 0x81, 0x1a, 0x15,
+	0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x9d, 0x83, 0xe9,
+	0x99, 0x90, 0xe7, 0xa0, 0x81, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65,
+	0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x63, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0xb7, 0x01, 0x0a, 0x05, 0x4d, 0x65, 0x6e, 0x75, 0x73, 0x12, 0x1b, 0x2e,
+	0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x6e, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x76, 0x6d,
+	0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0xba, 0x47, 0x54, 0x0a, 0x0c, 0xe8,
+	0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x18, 0xe7, 0x99, 0xbb,
+	0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe4,
+	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x1a, 0x18, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0xe7, 0x94, 0xa8,
+	0xe6, 0x88, 0xb7, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x5a,
+	0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6d, 0x65, 0x6e, 0x75, 0x73, 0x42, 0xae, 0x04,
+	0xba, 0x47, 0x8f, 0x03, 0x12, 0x81, 0x02, 0x0a, 0x13, 0x41, 0x56, 0x4d, 0x43, 0x20, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x20, 0x41, 0x75, 0x74, 0x68, 0x20, 0x41, 0x50, 0x49, 0x12, 0x2d, 0x41, 0x56,
+	0x4d, 0x43, 0x20, 0xe5, 0x90, 0x8e, 0xe5, 0x8f, 0xb0, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe8,
+	0x84, 0x9a, 0xe6, 0x89, 0x8b, 0xe6, 0x9e, 0xb6, 0xe7, 0xb3, 0xbb, 0xe7, 0xbb, 0x9f, 0x2d, 0xe8,
+	0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x22, 0x54, 0x0a, 0x10, 0x41,
+	0x56, 0x4d, 0x43, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0xe6, 0x9e, 0xb6, 0xe6, 0x9e, 0x84, 0x12,
+	0x2e, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x1a,
+	0x10, 0x37, 0x33, 0x37, 0x30, 0x34, 0x33, 0x39, 0x38, 0x30, 0x40, 0x71, 0x71, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2a, 0x5e, 0x0a, 0x14, 0x42, 0x53, 0x44, 0x20, 0x33, 0x2d, 0x43, 0x6c, 0x61, 0x75, 0x73,
+	0x65, 0x20, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x68, 0x74, 0x74, 0x70, 0x73,
+	0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2d, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x2e, 0x74, 0x78,
+	0x74, 0x32, 0x05, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x1a, 0x2f, 0x0a, 0x1a, 0x68, 0x74, 0x74, 0x70,
+	0x73, 0x3a, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x31, 0x12, 0x11, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2a, 0x58, 0x3a, 0x56, 0x0a, 0x54, 0x0a,
+	0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x46, 0x0a, 0x44, 0x0a,
+	0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x2f, 0x4a, 0x57, 0x54, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65,
+	0x72, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x2e, 0x2e, 0x2a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x32, 0x03,
+	0x4a, 0x57, 0x54, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x49, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x76, 0x6d, 0x63, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58,
+	0xaa, 0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x19, 0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x41,
+	0x76, 0x6d, 0x63, 0x3a, 0x3a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1868,63 +2095,69 @@ func file_avmc_admin_v1_i_auth_proto_rawDescGZIP() []byte {
 }
 
 var file_avmc_admin_v1_i_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_avmc_admin_v1_i_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_avmc_admin_v1_i_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_avmc_admin_v1_i_auth_proto_goTypes = []any{
 	(GrandType)(0),               // 0: avmc.admin.v1.GrandType
 	(*Auth)(nil),                 // 1: avmc.admin.v1.Auth
-	(*LoginPassword)(nil),        // 2: avmc.admin.v1.LoginPassword
-	(*LoginCode)(nil),            // 3: avmc.admin.v1.LoginCode
-	(*LoginRequest)(nil),         // 4: avmc.admin.v1.LoginRequest
-	(*LoginResponse)(nil),        // 5: avmc.admin.v1.LoginResponse
-	(*RefreshTokenRequest)(nil),  // 6: avmc.admin.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil), // 7: avmc.admin.v1.RefreshTokenResponse
-	(*LogoutRequest)(nil),        // 8: avmc.admin.v1.LogoutRequest
-	(*LogoutResponse)(nil),       // 9: avmc.admin.v1.LogoutResponse
-	(*ProfileRequest)(nil),       // 10: avmc.admin.v1.ProfileRequest
-	(*ProfileResponse)(nil),      // 11: avmc.admin.v1.ProfileResponse
-	(*VbenProfileRequest)(nil),   // 12: avmc.admin.v1.VbenProfileRequest
-	(*VbenProfileResponse)(nil),  // 13: avmc.admin.v1.VbenProfileResponse
-	(*CodesRequest)(nil),         // 14: avmc.admin.v1.CodesRequest
-	(*CodesResponse)(nil),        // 15: avmc.admin.v1.CodesResponse
-	(*MenusRequest)(nil),         // 16: avmc.admin.v1.MenusRequest
-	(*MenusResponse)(nil),        // 17: avmc.admin.v1.MenusResponse
-	(*RouteResponse)(nil),        // 18: avmc.admin.v1.RouteResponse
-	(*MenuMetaResponse)(nil),     // 19: avmc.admin.v1.MenuMetaResponse
-	(*v1.User)(nil),              // 20: core.service.v1.User
-	(*v1.Role)(nil),              // 21: core.service.v1.Role
-	(*v1.Menu)(nil),              // 22: core.service.v1.Menu
+	(*LoginCaptcha)(nil),         // 2: avmc.admin.v1.LoginCaptcha
+	(*LoginPassword)(nil),        // 3: avmc.admin.v1.LoginPassword
+	(*LoginCode)(nil),            // 4: avmc.admin.v1.LoginCode
+	(*LoginRequest)(nil),         // 5: avmc.admin.v1.LoginRequest
+	(*LoginResponse)(nil),        // 6: avmc.admin.v1.LoginResponse
+	(*CaptchaRequest)(nil),       // 7: avmc.admin.v1.CaptchaRequest
+	(*CaptchaResponse)(nil),      // 8: avmc.admin.v1.CaptchaResponse
+	(*RefreshTokenRequest)(nil),  // 9: avmc.admin.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil), // 10: avmc.admin.v1.RefreshTokenResponse
+	(*LogoutRequest)(nil),        // 11: avmc.admin.v1.LogoutRequest
+	(*LogoutResponse)(nil),       // 12: avmc.admin.v1.LogoutResponse
+	(*ProfileRequest)(nil),       // 13: avmc.admin.v1.ProfileRequest
+	(*ProfileResponse)(nil),      // 14: avmc.admin.v1.ProfileResponse
+	(*VbenProfileRequest)(nil),   // 15: avmc.admin.v1.VbenProfileRequest
+	(*VbenProfileResponse)(nil),  // 16: avmc.admin.v1.VbenProfileResponse
+	(*CodesRequest)(nil),         // 17: avmc.admin.v1.CodesRequest
+	(*CodesResponse)(nil),        // 18: avmc.admin.v1.CodesResponse
+	(*MenusRequest)(nil),         // 19: avmc.admin.v1.MenusRequest
+	(*MenusResponse)(nil),        // 20: avmc.admin.v1.MenusResponse
+	(*RouteResponse)(nil),        // 21: avmc.admin.v1.RouteResponse
+	(*MenuMetaResponse)(nil),     // 22: avmc.admin.v1.MenuMetaResponse
+	(*v1.User)(nil),              // 23: core.service.v1.User
+	(*v1.Role)(nil),              // 24: core.service.v1.Role
+	(*v1.Menu)(nil),              // 25: core.service.v1.Menu
 }
 var file_avmc_admin_v1_i_auth_proto_depIdxs = []int32{
-	2,  // 0: avmc.admin.v1.LoginRequest.password:type_name -> avmc.admin.v1.LoginPassword
-	3,  // 1: avmc.admin.v1.LoginRequest.code:type_name -> avmc.admin.v1.LoginCode
-	0,  // 2: avmc.admin.v1.LoginRequest.grand_type:type_name -> avmc.admin.v1.GrandType
-	20, // 3: avmc.admin.v1.ProfileResponse.user:type_name -> core.service.v1.User
-	21, // 4: avmc.admin.v1.ProfileResponse.role:type_name -> core.service.v1.Role
-	21, // 5: avmc.admin.v1.VbenProfileResponse.role:type_name -> core.service.v1.Role
-	22, // 6: avmc.admin.v1.MenusResponse.items:type_name -> core.service.v1.Menu
-	19, // 7: avmc.admin.v1.RouteResponse.meta:type_name -> avmc.admin.v1.MenuMetaResponse
-	18, // 8: avmc.admin.v1.RouteResponse.children:type_name -> avmc.admin.v1.RouteResponse
-	4,  // 9: avmc.admin.v1.AuthService.LoginPassword:input_type -> avmc.admin.v1.LoginRequest
-	4,  // 10: avmc.admin.v1.AuthService.LoginCode:input_type -> avmc.admin.v1.LoginRequest
-	6,  // 11: avmc.admin.v1.AuthService.RefreshToken:input_type -> avmc.admin.v1.RefreshTokenRequest
-	8,  // 12: avmc.admin.v1.AuthService.Logout:input_type -> avmc.admin.v1.LogoutRequest
-	10, // 13: avmc.admin.v1.AuthService.Profile:input_type -> avmc.admin.v1.ProfileRequest
-	12, // 14: avmc.admin.v1.AuthService.VbenProfile:input_type -> avmc.admin.v1.VbenProfileRequest
-	14, // 15: avmc.admin.v1.AuthService.Codes:input_type -> avmc.admin.v1.CodesRequest
-	16, // 16: avmc.admin.v1.AuthService.Menus:input_type -> avmc.admin.v1.MenusRequest
-	5,  // 17: avmc.admin.v1.AuthService.LoginPassword:output_type -> avmc.admin.v1.LoginResponse
-	5,  // 18: avmc.admin.v1.AuthService.LoginCode:output_type -> avmc.admin.v1.LoginResponse
-	7,  // 19: avmc.admin.v1.AuthService.RefreshToken:output_type -> avmc.admin.v1.RefreshTokenResponse
-	9,  // 20: avmc.admin.v1.AuthService.Logout:output_type -> avmc.admin.v1.LogoutResponse
-	11, // 21: avmc.admin.v1.AuthService.Profile:output_type -> avmc.admin.v1.ProfileResponse
-	13, // 22: avmc.admin.v1.AuthService.VbenProfile:output_type -> avmc.admin.v1.VbenProfileResponse
-	15, // 23: avmc.admin.v1.AuthService.Codes:output_type -> avmc.admin.v1.CodesResponse
-	17, // 24: avmc.admin.v1.AuthService.Menus:output_type -> avmc.admin.v1.MenusResponse
-	17, // [17:25] is the sub-list for method output_type
-	9,  // [9:17] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	2,  // 0: avmc.admin.v1.LoginPassword.captcha:type_name -> avmc.admin.v1.LoginCaptcha
+	3,  // 1: avmc.admin.v1.LoginRequest.password:type_name -> avmc.admin.v1.LoginPassword
+	4,  // 2: avmc.admin.v1.LoginRequest.code:type_name -> avmc.admin.v1.LoginCode
+	0,  // 3: avmc.admin.v1.LoginRequest.grand_type:type_name -> avmc.admin.v1.GrandType
+	23, // 4: avmc.admin.v1.ProfileResponse.user:type_name -> core.service.v1.User
+	24, // 5: avmc.admin.v1.ProfileResponse.role:type_name -> core.service.v1.Role
+	24, // 6: avmc.admin.v1.VbenProfileResponse.role:type_name -> core.service.v1.Role
+	25, // 7: avmc.admin.v1.MenusResponse.items:type_name -> core.service.v1.Menu
+	22, // 8: avmc.admin.v1.RouteResponse.meta:type_name -> avmc.admin.v1.MenuMetaResponse
+	21, // 9: avmc.admin.v1.RouteResponse.children:type_name -> avmc.admin.v1.RouteResponse
+	5,  // 10: avmc.admin.v1.AuthService.LoginPassword:input_type -> avmc.admin.v1.LoginRequest
+	5,  // 11: avmc.admin.v1.AuthService.LoginCode:input_type -> avmc.admin.v1.LoginRequest
+	7,  // 12: avmc.admin.v1.AuthService.Captcha:input_type -> avmc.admin.v1.CaptchaRequest
+	9,  // 13: avmc.admin.v1.AuthService.RefreshToken:input_type -> avmc.admin.v1.RefreshTokenRequest
+	11, // 14: avmc.admin.v1.AuthService.Logout:input_type -> avmc.admin.v1.LogoutRequest
+	13, // 15: avmc.admin.v1.AuthService.Profile:input_type -> avmc.admin.v1.ProfileRequest
+	15, // 16: avmc.admin.v1.AuthService.VbenProfile:input_type -> avmc.admin.v1.VbenProfileRequest
+	17, // 17: avmc.admin.v1.AuthService.Codes:input_type -> avmc.admin.v1.CodesRequest
+	19, // 18: avmc.admin.v1.AuthService.Menus:input_type -> avmc.admin.v1.MenusRequest
+	6,  // 19: avmc.admin.v1.AuthService.LoginPassword:output_type -> avmc.admin.v1.LoginResponse
+	6,  // 20: avmc.admin.v1.AuthService.LoginCode:output_type -> avmc.admin.v1.LoginResponse
+	8,  // 21: avmc.admin.v1.AuthService.Captcha:output_type -> avmc.admin.v1.CaptchaResponse
+	10, // 22: avmc.admin.v1.AuthService.RefreshToken:output_type -> avmc.admin.v1.RefreshTokenResponse
+	12, // 23: avmc.admin.v1.AuthService.Logout:output_type -> avmc.admin.v1.LogoutResponse
+	14, // 24: avmc.admin.v1.AuthService.Profile:output_type -> avmc.admin.v1.ProfileResponse
+	16, // 25: avmc.admin.v1.AuthService.VbenProfile:output_type -> avmc.admin.v1.VbenProfileResponse
+	18, // 26: avmc.admin.v1.AuthService.Codes:output_type -> avmc.admin.v1.CodesResponse
+	20, // 27: avmc.admin.v1.AuthService.Menus:output_type -> avmc.admin.v1.MenusResponse
+	19, // [19:28] is the sub-list for method output_type
+	10, // [10:19] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_avmc_admin_v1_i_auth_proto_init() }
@@ -1932,20 +2165,23 @@ func file_avmc_admin_v1_i_auth_proto_init() {
 	if File_avmc_admin_v1_i_auth_proto != nil {
 		return
 	}
-	file_avmc_admin_v1_i_auth_proto_msgTypes[3].OneofWrappers = []any{}
+	file_avmc_admin_v1_i_auth_proto_msgTypes[2].OneofWrappers = []any{}
 	file_avmc_admin_v1_i_auth_proto_msgTypes[4].OneofWrappers = []any{}
+	file_avmc_admin_v1_i_auth_proto_msgTypes[5].OneofWrappers = []any{}
 	file_avmc_admin_v1_i_auth_proto_msgTypes[6].OneofWrappers = []any{}
-	file_avmc_admin_v1_i_auth_proto_msgTypes[10].OneofWrappers = []any{}
-	file_avmc_admin_v1_i_auth_proto_msgTypes[12].OneofWrappers = []any{}
-	file_avmc_admin_v1_i_auth_proto_msgTypes[17].OneofWrappers = []any{}
-	file_avmc_admin_v1_i_auth_proto_msgTypes[18].OneofWrappers = []any{}
+	file_avmc_admin_v1_i_auth_proto_msgTypes[7].OneofWrappers = []any{}
+	file_avmc_admin_v1_i_auth_proto_msgTypes[9].OneofWrappers = []any{}
+	file_avmc_admin_v1_i_auth_proto_msgTypes[13].OneofWrappers = []any{}
+	file_avmc_admin_v1_i_auth_proto_msgTypes[15].OneofWrappers = []any{}
+	file_avmc_admin_v1_i_auth_proto_msgTypes[20].OneofWrappers = []any{}
+	file_avmc_admin_v1_i_auth_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_avmc_admin_v1_i_auth_proto_rawDesc), len(file_avmc_admin_v1_i_auth_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
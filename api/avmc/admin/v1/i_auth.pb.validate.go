@@ -139,6 +139,109 @@ var _ interface {
 	ErrorName() string
 } = AuthValidationError{}
 
+// Validate checks the field values on LoginCaptcha with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginCaptcha) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginCaptcha with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginCaptchaMultiError, or
+// nil if none found.
+func (m *LoginCaptcha) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginCaptcha) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return LoginCaptchaMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoginCaptchaMultiError is an error wrapping multiple validation errors
+// returned by LoginCaptcha.ValidateAll() if the designated constraints aren't met.
+type LoginCaptchaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginCaptchaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginCaptchaMultiError) AllErrors() []error { return m }
+
+// LoginCaptchaValidationError is the validation error returned by
+// LoginCaptcha.Validate if the designated constraints aren't met.
+type LoginCaptchaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginCaptchaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginCaptchaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginCaptchaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginCaptchaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginCaptchaValidationError) ErrorName() string { return "LoginCaptchaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginCaptchaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginCaptcha.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginCaptchaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginCaptchaValidationError{}
+
 // Validate checks the field values on LoginPassword with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -165,6 +268,39 @@ func (m *LoginPassword) validate(all bool) error {
 
 	// no validation rules for Password
 
+	if m.Captcha != nil {
+
+		if all {
+			switch v := interface{}(m.GetCaptcha()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LoginPasswordValidationError{
+						field:  "Captcha",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LoginPasswordValidationError{
+						field:  "Captcha",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCaptcha()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LoginPasswordValidationError{
+					field:  "Captcha",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return LoginPasswordMultiError(errors)
 	}
@@ -629,6 +765,220 @@ var _ interface {
 	ErrorName() string
 } = LoginResponseValidationError{}
 
+// Validate checks the field values on CaptchaRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CaptchaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CaptchaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CaptchaRequestMultiError,
+// or nil if none found.
+func (m *CaptchaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CaptchaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if len(errors) > 0 {
+		return CaptchaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CaptchaRequestMultiError is an error wrapping multiple validation errors
+// returned by CaptchaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CaptchaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CaptchaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CaptchaRequestMultiError) AllErrors() []error { return m }
+
+// CaptchaRequestValidationError is the validation error returned by
+// CaptchaRequest.Validate if the designated constraints aren't met.
+type CaptchaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaptchaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaptchaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaptchaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaptchaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaptchaRequestValidationError) ErrorName() string { return "CaptchaRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CaptchaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaptchaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaptchaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaptchaRequestValidationError{}
+
+// Validate checks the field values on CaptchaResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CaptchaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CaptchaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CaptchaResponseMultiError, or nil if none found.
+func (m *CaptchaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CaptchaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CaptchaId
+
+	// no validation rules for Image
+
+	// no validation rules for Required
+
+	if m.ExpiresIn != nil {
+		// no validation rules for ExpiresIn
+	}
+
+	if len(errors) > 0 {
+		return CaptchaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CaptchaResponseMultiError is an error wrapping multiple validation errors
+// returned by CaptchaResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CaptchaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CaptchaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CaptchaResponseMultiError) AllErrors() []error { return m }
+
+// CaptchaResponseValidationError is the validation error returned by
+// CaptchaResponse.Validate if the designated constraints aren't met.
+type CaptchaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaptchaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaptchaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaptchaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaptchaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaptchaResponseValidationError) ErrorName() string { return "CaptchaResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CaptchaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaptchaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaptchaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaptchaResponseValidationError{}
+
 // Validate checks the field values on RefreshTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
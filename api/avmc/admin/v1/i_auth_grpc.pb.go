@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	AuthService_LoginPassword_FullMethodName = "/avmc.admin.v1.AuthService/LoginPassword"
 	AuthService_LoginCode_FullMethodName     = "/avmc.admin.v1.AuthService/LoginCode"
+	AuthService_Captcha_FullMethodName       = "/avmc.admin.v1.AuthService/Captcha"
 	AuthService_RefreshToken_FullMethodName  = "/avmc.admin.v1.AuthService/RefreshToken"
 	AuthService_Logout_FullMethodName        = "/avmc.admin.v1.AuthService/Logout"
 	AuthService_Profile_FullMethodName       = "/avmc.admin.v1.AuthService/Profile"
@@ -37,6 +38,8 @@ const (
 type AuthServiceClient interface {
 	LoginPassword(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	LoginCode(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// 获取图形验证码
+	Captcha(ctx context.Context, in *CaptchaRequest, opts ...grpc.CallOption) (*CaptchaResponse, error)
 	// 刷新令牌
 	// @param RefreshTokenRequest 请求参数，包含刷新令牌
 	// @return RefreshTokenResponse 响应结果，包含新的访问令牌和刷新令牌
@@ -81,6 +84,16 @@ func (c *authServiceClient) LoginCode(ctx context.Context, in *LoginRequest, opt
 	return out, nil
 }
 
+func (c *authServiceClient) Captcha(ctx context.Context, in *CaptchaRequest, opts ...grpc.CallOption) (*CaptchaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaptchaResponse)
+	err := c.cc.Invoke(ctx, AuthService_Captcha_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RefreshTokenResponse)
@@ -149,6 +162,8 @@ func (c *authServiceClient) Menus(ctx context.Context, in *MenusRequest, opts ..
 type AuthServiceServer interface {
 	LoginPassword(context.Context, *LoginRequest) (*LoginResponse, error)
 	LoginCode(context.Context, *LoginRequest) (*LoginResponse, error)
+	// 获取图形验证码
+	Captcha(context.Context, *CaptchaRequest) (*CaptchaResponse, error)
 	// 刷新令牌
 	// @param RefreshTokenRequest 请求参数，包含刷新令牌
 	// @return RefreshTokenResponse 响应结果，包含新的访问令牌和刷新令牌
@@ -179,6 +194,9 @@ func (UnimplementedAuthServiceServer) LoginPassword(context.Context, *LoginReque
 func (UnimplementedAuthServiceServer) LoginCode(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginCode not implemented")
 }
+func (UnimplementedAuthServiceServer) Captcha(context.Context, *CaptchaRequest) (*CaptchaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Captcha not implemented")
+}
 func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
 }
@@ -254,6 +272,24 @@ func _AuthService_LoginCode_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_Captcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptchaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Captcha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Captcha_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Captcha(ctx, req.(*CaptchaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefreshTokenRequest)
 	if err := dec(in); err != nil {
@@ -377,6 +413,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LoginCode",
 			Handler:    _AuthService_LoginCode_Handler,
 		},
+		{
+			MethodName: "Captcha",
+			Handler:    _AuthService_Captcha_Handler,
+		},
 		{
 			MethodName: "RefreshToken",
 			Handler:    _AuthService_RefreshToken_Handler,
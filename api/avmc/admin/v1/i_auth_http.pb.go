@@ -19,6 +19,7 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthServiceCaptcha = "/avmc.admin.v1.AuthService/Captcha"
 const OperationAuthServiceCodes = "/avmc.admin.v1.AuthService/Codes"
 const OperationAuthServiceLoginCode = "/avmc.admin.v1.AuthService/LoginCode"
 const OperationAuthServiceLoginPassword = "/avmc.admin.v1.AuthService/LoginPassword"
@@ -29,6 +30,8 @@ const OperationAuthServiceRefreshToken = "/avmc.admin.v1.AuthService/RefreshToke
 const OperationAuthServiceVbenProfile = "/avmc.admin.v1.AuthService/VbenProfile"
 
 type AuthServiceHTTPServer interface {
+	// Captcha 获取图形验证码
+	Captcha(context.Context, *CaptchaRequest) (*CaptchaResponse, error)
 	// Codes 登录用户权限码
 	Codes(context.Context, *CodesRequest) (*CodesResponse, error)
 	LoginCode(context.Context, *LoginRequest) (*LoginResponse, error)
@@ -51,6 +54,7 @@ func RegisterAuthServiceHTTPServer(s *http.Server, srv AuthServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/admin/v1/auth/login/password", _AuthService_LoginPassword0_HTTP_Handler(srv))
 	r.POST("/admin/v1/auth/login/code", _AuthService_LoginCode0_HTTP_Handler(srv))
+	r.GET("/admin/v1/auth/captcha", _AuthService_Captcha0_HTTP_Handler(srv))
 	r.POST("/admin/v1/auth/refresh-token", _AuthService_RefreshToken0_HTTP_Handler(srv))
 	r.POST("/admin/v1/auth/logout", _AuthService_Logout0_HTTP_Handler(srv))
 	r.GET("/admin/v1/auth/profile", _AuthService_Profile0_HTTP_Handler(srv))
@@ -103,6 +107,25 @@ func _AuthService_LoginCode0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx ht
 	}
 }
 
+func _AuthService_Captcha0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CaptchaRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceCaptcha)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Captcha(ctx, req.(*CaptchaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CaptchaResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _AuthService_RefreshToken0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in RefreshTokenRequest
@@ -224,6 +247,7 @@ func _AuthService_Menus0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.C
 }
 
 type AuthServiceHTTPClient interface {
+	Captcha(ctx context.Context, req *CaptchaRequest, opts ...http.CallOption) (rsp *CaptchaResponse, err error)
 	Codes(ctx context.Context, req *CodesRequest, opts ...http.CallOption) (rsp *CodesResponse, err error)
 	LoginCode(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
 	LoginPassword(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
@@ -242,6 +266,19 @@ func NewAuthServiceHTTPClient(client *http.Client) AuthServiceHTTPClient {
 	return &AuthServiceHTTPClientImpl{client}
 }
 
+func (c *AuthServiceHTTPClientImpl) Captcha(ctx context.Context, in *CaptchaRequest, opts ...http.CallOption) (*CaptchaResponse, error) {
+	var out CaptchaResponse
+	pattern := "/admin/v1/auth/captcha"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceCaptcha))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthServiceHTTPClientImpl) Codes(ctx context.Context, in *CodesRequest, opts ...http.CallOption) (*CodesResponse, error) {
 	var out CodesResponse
 	pattern := "/admin/v1/auth/codes"
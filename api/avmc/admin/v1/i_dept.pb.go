@@ -40,107 +40,148 @@ var file_avmc_admin_v1_i_dept_proto_rawDesc = string([]byte{
 	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
 	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xad, 0x07, 0x0a,
-	0x0b, 0x44, 0x65, 0x70, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xb2, 0x01, 0x0a,
-	0x08, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0xba, 0x47, 0x4e, 0x0a, 0x12, 0xe9, 0x83,
-	0xa8, 0xe9, 0x97, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1,
-	0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe5, 0x88,
-	0x97, 0xe8, 0xa1, 0xa8, 0x1a, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe9, 0x83, 0xa8, 0xe9,
-	0x97, 0xa8, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65,
-	0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
-	0x12, 0x0f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x74,
-	0x73, 0x12, 0xb0, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x12, 0x1f, 0x2e,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xc0, 0x0b, 0x0a,
+	0x0b, 0x44, 0x65, 0x70, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xf7, 0x01, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x24, 0x2e,
 	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x74, 0x54, 0x72,
+	0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x01, 0xba, 0x47, 0x7a,
+	0x0a, 0x12, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x0f, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe9, 0x83, 0xa8, 0xe9,
+	0x97, 0xa8, 0xe6, 0xa0, 0x91, 0x1a, 0x41, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe9, 0x83, 0xa8,
+	0xe9, 0x97, 0xa8, 0xe6, 0xa0, 0x91, 0xef, 0xbc, 0x8c, 0xe4, 0xbc, 0xa0, 0xe5, 0x85, 0xa5, 0xe4,
+	0xb8, 0x8a, 0xe7, 0xba, 0xa7, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x49, 0x44, 0xe6, 0x97, 0xb6,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0x85, 0xb6, 0xe4, 0xb8, 0x8b, 0xe7, 0xba, 0xa7, 0xe9,
+	0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe6, 0xa0, 0x91, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x12, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x74,
+	0x73, 0x2f, 0x74, 0x72, 0x65, 0x65, 0x12, 0xb2, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x70, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
 	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x70, 0x74, 0x22, 0x6d, 0xba, 0x47, 0x4e, 0x0a, 0x12, 0xe9, 0x83, 0xa8, 0xe9,
-	0x97, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x12,
-	0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe6, 0x95, 0xb0, 0xe6,
-	0x8d, 0xae, 0x1a, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8,
-	0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72,
-	0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x74, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb9, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
-	0x65, 0x70, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0xba, 0x47,
-	0x42, 0x0a, 0x12, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6,
-	0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe9, 0x83, 0xa8,
-	0xe9, 0x97, 0xa8, 0x1a, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe9, 0x83, 0xa8, 0xe9, 0x97,
-	0xa8, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74,
-	0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x04, 0x64, 0x65, 0x70, 0x74, 0x22,
-	0x0f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x74, 0x73,
-	0x12, 0xbe, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x12,
-	0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0xba, 0x47, 0x42, 0x0a, 0x12, 0xe9,
-	0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a,
-	0xa1, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x1a,
-	0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x5a, 0x10, 0x0a,
-	0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x04, 0x64, 0x65, 0x70, 0x74, 0x1a, 0x14, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64,
-	0x7d, 0x12, 0xb8, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74,
-	0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x70,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0xba, 0x47, 0x42, 0x0a, 0x12,
-	0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5,
-	0x8a, 0xa1, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8,
-	0x1a, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x5a, 0x10,
-	0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76,
-	0x31, 0x2f, 0x64, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0x9b, 0x01, 0x0a,
-	0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x42, 0x0a, 0x49, 0x44, 0x65, 0x70, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x24, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x76, 0x6d, 0x63, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x41,
-	0x76, 0x6d, 0x63, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x41,
-	0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x41,
-	0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x41, 0x76, 0x6d, 0x63, 0x3a,
-	0x3a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x68, 0xba, 0x47, 0x4e, 0x0a, 0x12, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5,
+	0x8f, 0x96, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x1a, 0x12,
+	0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe5, 0x88, 0x97, 0xe8,
+	0xa1, 0xa8, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x74, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x74, 0x22,
+	0x6d, 0xba, 0x47, 0x4e, 0x0a, 0x12, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7, 0xae, 0xa1, 0xe7,
+	0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
+	0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x1a, 0x12, 0xe8, 0x8e,
+	0xb7, 0xe5, 0x8f, 0x96, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae,
+	0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb9,
+	0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x12, 0x22, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0xba, 0x47, 0x42, 0x0a, 0x12, 0xe9, 0x83, 0xa8,
+	0xe9, 0x97, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12,
+	0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x1a, 0x0c, 0xe5,
+	0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x5a, 0x10, 0x0a, 0x0e, 0x0a,
+	0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x3a, 0x04, 0x64, 0x65, 0x70, 0x74, 0x22, 0x0f, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x74, 0x73, 0x12, 0xbe, 0x01, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x67, 0xba, 0x47, 0x42, 0x0a, 0x12, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x0c, 0xe6, 0x9b, 0xb4,
+	0xe6, 0x96, 0xb0, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x1a, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96,
+	0xb0, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a,
+	0x04, 0x64, 0x65, 0x70, 0x74, 0x1a, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb8, 0x01, 0x0a, 0x0a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x61, 0xba, 0x47, 0x42, 0x0a, 0x12, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8,
+	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x0c, 0xe5, 0x88,
+	0xa0, 0xe9, 0x99, 0xa4, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x1a, 0x0c, 0xe5, 0x88, 0xa0, 0xe9,
+	0x99, 0xa4, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x2a, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x74,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x96, 0x02, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x44,
+	0x65, 0x70, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc4, 0x01, 0xba, 0x47, 0x9c, 0x01, 0x0a,
+	0x12, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d,
+	0xe5, 0x8a, 0xa1, 0x12, 0x0c, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe9, 0x83, 0xa8, 0xe9, 0x97,
+	0xa8, 0x1a, 0x66, 0xe5, 0xb0, 0x86, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe5, 0x8f, 0x8a, 0xe5,
+	0x85, 0xb6, 0xe4, 0xb8, 0x8b, 0xe7, 0xba, 0xa7, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7, 0xa7,
+	0xbb, 0xe5, 0x8a, 0xa8, 0xe5, 0x88, 0xb0, 0xe6, 0x96, 0xb0, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0x8a,
+	0xe7, 0xba, 0xa7, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe4, 0xb8, 0x8b, 0xef, 0xbc, 0x8c, 0xe4,
+	0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe5, 0x88, 0xb0, 0xe8, 0x87,
+	0xaa, 0xe8, 0xba, 0xab, 0xe6, 0x88, 0x96, 0xe5, 0x85, 0xb6, 0xe4, 0xb8, 0x8b, 0xe7, 0xba, 0xa7,
+	0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe4, 0xb8, 0x8b, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42,
+	0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x64, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x42,
+	0x9b, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x49, 0x44, 0x65, 0x70, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x24, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x76, 0x6d, 0x63, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa,
+	0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x19, 0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x41, 0x76,
+	0x6d, 0x63, 0x3a, 0x3a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var file_avmc_admin_v1_i_dept_proto_goTypes = []any{
-	(*pagination.PagingRequest)(nil), // 0: pagination.PagingRequest
-	(*v1.GetDeptRequest)(nil),        // 1: core.service.v1.GetDeptRequest
-	(*v1.CreateDeptRequest)(nil),     // 2: core.service.v1.CreateDeptRequest
-	(*v1.UpdateDeptRequest)(nil),     // 3: core.service.v1.UpdateDeptRequest
-	(*v1.DeleteDeptRequest)(nil),     // 4: core.service.v1.DeleteDeptRequest
-	(*v1.ListDeptResponse)(nil),      // 5: core.service.v1.ListDeptResponse
-	(*v1.Dept)(nil),                  // 6: core.service.v1.Dept
-	(*v1.CreateDeptResponse)(nil),    // 7: core.service.v1.CreateDeptResponse
-	(*v1.UpdateDeptResponse)(nil),    // 8: core.service.v1.UpdateDeptResponse
-	(*v1.DeleteDeptResponse)(nil),    // 9: core.service.v1.DeleteDeptResponse
+	(*v1.ListDeptTreeRequest)(nil),   // 0: core.service.v1.ListDeptTreeRequest
+	(*pagination.PagingRequest)(nil), // 1: pagination.PagingRequest
+	(*v1.GetDeptRequest)(nil),        // 2: core.service.v1.GetDeptRequest
+	(*v1.CreateDeptRequest)(nil),     // 3: core.service.v1.CreateDeptRequest
+	(*v1.UpdateDeptRequest)(nil),     // 4: core.service.v1.UpdateDeptRequest
+	(*v1.DeleteDeptRequest)(nil),     // 5: core.service.v1.DeleteDeptRequest
+	(*v1.MoveDeptRequest)(nil),       // 6: core.service.v1.MoveDeptRequest
+	(*v1.ListDeptTreeResponse)(nil),  // 7: core.service.v1.ListDeptTreeResponse
+	(*v1.ListDeptResponse)(nil),      // 8: core.service.v1.ListDeptResponse
+	(*v1.Dept)(nil),                  // 9: core.service.v1.Dept
+	(*v1.CreateDeptResponse)(nil),    // 10: core.service.v1.CreateDeptResponse
+	(*v1.UpdateDeptResponse)(nil),    // 11: core.service.v1.UpdateDeptResponse
+	(*v1.DeleteDeptResponse)(nil),    // 12: core.service.v1.DeleteDeptResponse
+	(*v1.MoveDeptResponse)(nil),      // 13: core.service.v1.MoveDeptResponse
 }
 var file_avmc_admin_v1_i_dept_proto_depIdxs = []int32{
-	0, // 0: avmc.admin.v1.DeptService.ListDept:input_type -> pagination.PagingRequest
-	1, // 1: avmc.admin.v1.DeptService.GetDept:input_type -> core.service.v1.GetDeptRequest
-	2, // 2: avmc.admin.v1.DeptService.CreateDept:input_type -> core.service.v1.CreateDeptRequest
-	3, // 3: avmc.admin.v1.DeptService.UpdateDept:input_type -> core.service.v1.UpdateDeptRequest
-	4, // 4: avmc.admin.v1.DeptService.DeleteDept:input_type -> core.service.v1.DeleteDeptRequest
-	5, // 5: avmc.admin.v1.DeptService.ListDept:output_type -> core.service.v1.ListDeptResponse
-	6, // 6: avmc.admin.v1.DeptService.GetDept:output_type -> core.service.v1.Dept
-	7, // 7: avmc.admin.v1.DeptService.CreateDept:output_type -> core.service.v1.CreateDeptResponse
-	8, // 8: avmc.admin.v1.DeptService.UpdateDept:output_type -> core.service.v1.UpdateDeptResponse
-	9, // 9: avmc.admin.v1.DeptService.DeleteDept:output_type -> core.service.v1.DeleteDeptResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: avmc.admin.v1.DeptService.ListDeptTree:input_type -> core.service.v1.ListDeptTreeRequest
+	1,  // 1: avmc.admin.v1.DeptService.ListDept:input_type -> pagination.PagingRequest
+	2,  // 2: avmc.admin.v1.DeptService.GetDept:input_type -> core.service.v1.GetDeptRequest
+	3,  // 3: avmc.admin.v1.DeptService.CreateDept:input_type -> core.service.v1.CreateDeptRequest
+	4,  // 4: avmc.admin.v1.DeptService.UpdateDept:input_type -> core.service.v1.UpdateDeptRequest
+	5,  // 5: avmc.admin.v1.DeptService.DeleteDept:input_type -> core.service.v1.DeleteDeptRequest
+	6,  // 6: avmc.admin.v1.DeptService.MoveDept:input_type -> core.service.v1.MoveDeptRequest
+	7,  // 7: avmc.admin.v1.DeptService.ListDeptTree:output_type -> core.service.v1.ListDeptTreeResponse
+	8,  // 8: avmc.admin.v1.DeptService.ListDept:output_type -> core.service.v1.ListDeptResponse
+	9,  // 9: avmc.admin.v1.DeptService.GetDept:output_type -> core.service.v1.Dept
+	10, // 10: avmc.admin.v1.DeptService.CreateDept:output_type -> core.service.v1.CreateDeptResponse
+	11, // 11: avmc.admin.v1.DeptService.UpdateDept:output_type -> core.service.v1.UpdateDeptResponse
+	12, // 12: avmc.admin.v1.DeptService.DeleteDept:output_type -> core.service.v1.DeleteDeptResponse
+	13, // 13: avmc.admin.v1.DeptService.MoveDept:output_type -> core.service.v1.MoveDeptResponse
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_avmc_admin_v1_i_dept_proto_init() }
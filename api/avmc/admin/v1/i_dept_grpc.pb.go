@@ -21,11 +21,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DeptService_ListDept_FullMethodName   = "/avmc.admin.v1.DeptService/ListDept"
-	DeptService_GetDept_FullMethodName    = "/avmc.admin.v1.DeptService/GetDept"
-	DeptService_CreateDept_FullMethodName = "/avmc.admin.v1.DeptService/CreateDept"
-	DeptService_UpdateDept_FullMethodName = "/avmc.admin.v1.DeptService/UpdateDept"
-	DeptService_DeleteDept_FullMethodName = "/avmc.admin.v1.DeptService/DeleteDept"
+	DeptService_ListDeptTree_FullMethodName = "/avmc.admin.v1.DeptService/ListDeptTree"
+	DeptService_ListDept_FullMethodName     = "/avmc.admin.v1.DeptService/ListDept"
+	DeptService_GetDept_FullMethodName      = "/avmc.admin.v1.DeptService/GetDept"
+	DeptService_CreateDept_FullMethodName   = "/avmc.admin.v1.DeptService/CreateDept"
+	DeptService_UpdateDept_FullMethodName   = "/avmc.admin.v1.DeptService/UpdateDept"
+	DeptService_DeleteDept_FullMethodName   = "/avmc.admin.v1.DeptService/DeleteDept"
+	DeptService_MoveDept_FullMethodName     = "/avmc.admin.v1.DeptService/MoveDept"
 )
 
 // DeptServiceClient is the client API for DeptService service.
@@ -34,6 +36,8 @@ const (
 //
 // 部门管理服务
 type DeptServiceClient interface {
+	// 获取部门树
+	ListDeptTree(ctx context.Context, in *v1.ListDeptTreeRequest, opts ...grpc.CallOption) (*v1.ListDeptTreeResponse, error)
 	// 获取部门列表
 	ListDept(ctx context.Context, in *pagination.PagingRequest, opts ...grpc.CallOption) (*v1.ListDeptResponse, error)
 	// 获取部门数据
@@ -44,6 +48,8 @@ type DeptServiceClient interface {
 	UpdateDept(ctx context.Context, in *v1.UpdateDeptRequest, opts ...grpc.CallOption) (*v1.UpdateDeptResponse, error)
 	// 删除部门
 	DeleteDept(ctx context.Context, in *v1.DeleteDeptRequest, opts ...grpc.CallOption) (*v1.DeleteDeptResponse, error)
+	// 移动部门
+	MoveDept(ctx context.Context, in *v1.MoveDeptRequest, opts ...grpc.CallOption) (*v1.MoveDeptResponse, error)
 }
 
 type deptServiceClient struct {
@@ -54,6 +60,16 @@ func NewDeptServiceClient(cc grpc.ClientConnInterface) DeptServiceClient {
 	return &deptServiceClient{cc}
 }
 
+func (c *deptServiceClient) ListDeptTree(ctx context.Context, in *v1.ListDeptTreeRequest, opts ...grpc.CallOption) (*v1.ListDeptTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ListDeptTreeResponse)
+	err := c.cc.Invoke(ctx, DeptService_ListDeptTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deptServiceClient) ListDept(ctx context.Context, in *pagination.PagingRequest, opts ...grpc.CallOption) (*v1.ListDeptResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(v1.ListDeptResponse)
@@ -104,12 +120,24 @@ func (c *deptServiceClient) DeleteDept(ctx context.Context, in *v1.DeleteDeptReq
 	return out, nil
 }
 
+func (c *deptServiceClient) MoveDept(ctx context.Context, in *v1.MoveDeptRequest, opts ...grpc.CallOption) (*v1.MoveDeptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.MoveDeptResponse)
+	err := c.cc.Invoke(ctx, DeptService_MoveDept_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeptServiceServer is the server API for DeptService service.
 // All implementations must embed UnimplementedDeptServiceServer
 // for forward compatibility.
 //
 // 部门管理服务
 type DeptServiceServer interface {
+	// 获取部门树
+	ListDeptTree(context.Context, *v1.ListDeptTreeRequest) (*v1.ListDeptTreeResponse, error)
 	// 获取部门列表
 	ListDept(context.Context, *pagination.PagingRequest) (*v1.ListDeptResponse, error)
 	// 获取部门数据
@@ -120,6 +148,8 @@ type DeptServiceServer interface {
 	UpdateDept(context.Context, *v1.UpdateDeptRequest) (*v1.UpdateDeptResponse, error)
 	// 删除部门
 	DeleteDept(context.Context, *v1.DeleteDeptRequest) (*v1.DeleteDeptResponse, error)
+	// 移动部门
+	MoveDept(context.Context, *v1.MoveDeptRequest) (*v1.MoveDeptResponse, error)
 	mustEmbedUnimplementedDeptServiceServer()
 }
 
@@ -130,6 +160,9 @@ type DeptServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedDeptServiceServer struct{}
 
+func (UnimplementedDeptServiceServer) ListDeptTree(context.Context, *v1.ListDeptTreeRequest) (*v1.ListDeptTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeptTree not implemented")
+}
 func (UnimplementedDeptServiceServer) ListDept(context.Context, *pagination.PagingRequest) (*v1.ListDeptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDept not implemented")
 }
@@ -145,6 +178,9 @@ func (UnimplementedDeptServiceServer) UpdateDept(context.Context, *v1.UpdateDept
 func (UnimplementedDeptServiceServer) DeleteDept(context.Context, *v1.DeleteDeptRequest) (*v1.DeleteDeptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDept not implemented")
 }
+func (UnimplementedDeptServiceServer) MoveDept(context.Context, *v1.MoveDeptRequest) (*v1.MoveDeptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveDept not implemented")
+}
 func (UnimplementedDeptServiceServer) mustEmbedUnimplementedDeptServiceServer() {}
 func (UnimplementedDeptServiceServer) testEmbeddedByValue()                     {}
 
@@ -166,6 +202,24 @@ func RegisterDeptServiceServer(s grpc.ServiceRegistrar, srv DeptServiceServer) {
 	s.RegisterService(&DeptService_ServiceDesc, srv)
 }
 
+func _DeptService_ListDeptTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ListDeptTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeptServiceServer).ListDeptTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeptService_ListDeptTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeptServiceServer).ListDeptTree(ctx, req.(*v1.ListDeptTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeptService_ListDept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pagination.PagingRequest)
 	if err := dec(in); err != nil {
@@ -256,6 +310,24 @@ func _DeptService_DeleteDept_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeptService_MoveDept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.MoveDeptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeptServiceServer).MoveDept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeptService_MoveDept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeptServiceServer).MoveDept(ctx, req.(*v1.MoveDeptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeptService_ServiceDesc is the grpc.ServiceDesc for DeptService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -263,6 +335,10 @@ var DeptService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "avmc.admin.v1.DeptService",
 	HandlerType: (*DeptServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDeptTree",
+			Handler:    _DeptService_ListDeptTree_Handler,
+		},
 		{
 			MethodName: "ListDept",
 			Handler:    _DeptService_ListDept_Handler,
@@ -283,6 +359,10 @@ var DeptService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDept",
 			Handler:    _DeptService_DeleteDept_Handler,
 		},
+		{
+			MethodName: "MoveDept",
+			Handler:    _DeptService_MoveDept_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "avmc/admin/v1/i_dept.proto",
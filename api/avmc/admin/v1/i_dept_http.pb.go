@@ -25,6 +25,8 @@ const OperationDeptServiceCreateDept = "/avmc.admin.v1.DeptService/CreateDept"
 const OperationDeptServiceDeleteDept = "/avmc.admin.v1.DeptService/DeleteDept"
 const OperationDeptServiceGetDept = "/avmc.admin.v1.DeptService/GetDept"
 const OperationDeptServiceListDept = "/avmc.admin.v1.DeptService/ListDept"
+const OperationDeptServiceListDeptTree = "/avmc.admin.v1.DeptService/ListDeptTree"
+const OperationDeptServiceMoveDept = "/avmc.admin.v1.DeptService/MoveDept"
 const OperationDeptServiceUpdateDept = "/avmc.admin.v1.DeptService/UpdateDept"
 
 type DeptServiceHTTPServer interface {
@@ -36,17 +38,42 @@ type DeptServiceHTTPServer interface {
 	GetDept(context.Context, *v1.GetDeptRequest) (*v1.Dept, error)
 	// ListDept 获取部门列表
 	ListDept(context.Context, *pagination.PagingRequest) (*v1.ListDeptResponse, error)
+	// ListDeptTree 获取部门树
+	ListDeptTree(context.Context, *v1.ListDeptTreeRequest) (*v1.ListDeptTreeResponse, error)
+	// MoveDept 移动部门
+	MoveDept(context.Context, *v1.MoveDeptRequest) (*v1.MoveDeptResponse, error)
 	// UpdateDept 更新部门
 	UpdateDept(context.Context, *v1.UpdateDeptRequest) (*v1.UpdateDeptResponse, error)
 }
 
 func RegisterDeptServiceHTTPServer(s *http.Server, srv DeptServiceHTTPServer) {
 	r := s.Route("/")
+	r.GET("/admin/v1/depts/tree", _DeptService_ListDeptTree0_HTTP_Handler(srv))
 	r.GET("/admin/v1/depts", _DeptService_ListDept0_HTTP_Handler(srv))
 	r.GET("/admin/v1/depts/{id}", _DeptService_GetDept0_HTTP_Handler(srv))
 	r.POST("/admin/v1/depts", _DeptService_CreateDept0_HTTP_Handler(srv))
 	r.PUT("/admin/v1/depts/{id}", _DeptService_UpdateDept0_HTTP_Handler(srv))
 	r.DELETE("/admin/v1/depts/{id}", _DeptService_DeleteDept0_HTTP_Handler(srv))
+	r.POST("/admin/v1/depts/{id}/move", _DeptService_MoveDept0_HTTP_Handler(srv))
+}
+
+func _DeptService_ListDeptTree0_HTTP_Handler(srv DeptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ListDeptTreeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDeptServiceListDeptTree)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDeptTree(ctx, req.(*v1.ListDeptTreeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ListDeptTreeResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
 func _DeptService_ListDept0_HTTP_Handler(srv DeptServiceHTTPServer) func(ctx http.Context) error {
@@ -159,11 +186,38 @@ func _DeptService_DeleteDept0_HTTP_Handler(srv DeptServiceHTTPServer) func(ctx h
 	}
 }
 
+func _DeptService_MoveDept0_HTTP_Handler(srv DeptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.MoveDeptRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDeptServiceMoveDept)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MoveDept(ctx, req.(*v1.MoveDeptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.MoveDeptResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type DeptServiceHTTPClient interface {
 	CreateDept(ctx context.Context, req *v1.CreateDeptRequest, opts ...http.CallOption) (rsp *v1.CreateDeptResponse, err error)
 	DeleteDept(ctx context.Context, req *v1.DeleteDeptRequest, opts ...http.CallOption) (rsp *v1.DeleteDeptResponse, err error)
 	GetDept(ctx context.Context, req *v1.GetDeptRequest, opts ...http.CallOption) (rsp *v1.Dept, err error)
 	ListDept(ctx context.Context, req *pagination.PagingRequest, opts ...http.CallOption) (rsp *v1.ListDeptResponse, err error)
+	ListDeptTree(ctx context.Context, req *v1.ListDeptTreeRequest, opts ...http.CallOption) (rsp *v1.ListDeptTreeResponse, err error)
+	MoveDept(ctx context.Context, req *v1.MoveDeptRequest, opts ...http.CallOption) (rsp *v1.MoveDeptResponse, err error)
 	UpdateDept(ctx context.Context, req *v1.UpdateDeptRequest, opts ...http.CallOption) (rsp *v1.UpdateDeptResponse, err error)
 }
 
@@ -227,6 +281,32 @@ func (c *DeptServiceHTTPClientImpl) ListDept(ctx context.Context, in *pagination
 	return &out, nil
 }
 
+func (c *DeptServiceHTTPClientImpl) ListDeptTree(ctx context.Context, in *v1.ListDeptTreeRequest, opts ...http.CallOption) (*v1.ListDeptTreeResponse, error) {
+	var out v1.ListDeptTreeResponse
+	pattern := "/admin/v1/depts/tree"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDeptServiceListDeptTree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DeptServiceHTTPClientImpl) MoveDept(ctx context.Context, in *v1.MoveDeptRequest, opts ...http.CallOption) (*v1.MoveDeptResponse, error) {
+	var out v1.MoveDeptResponse
+	pattern := "/admin/v1/depts/{id}/move"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDeptServiceMoveDept))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *DeptServiceHTTPClientImpl) UpdateDept(ctx context.Context, in *v1.UpdateDeptRequest, opts ...http.CallOption) (*v1.UpdateDeptResponse, error) {
 	var out v1.UpdateDeptResponse
 	pattern := "/admin/v1/depts/{id}"
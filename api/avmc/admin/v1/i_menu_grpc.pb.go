@@ -31,6 +31,7 @@ const (
 	MenuService_DeleteMenu_FullMethodName      = "/avmc.admin.v1.MenuService/DeleteMenu"
 	MenuService_ExistMenuByPath_FullMethodName = "/avmc.admin.v1.MenuService/ExistMenuByPath"
 	MenuService_ExistMenuByName_FullMethodName = "/avmc.admin.v1.MenuService/ExistMenuByName"
+	MenuService_ListMenuHistory_FullMethodName = "/avmc.admin.v1.MenuService/ListMenuHistory"
 )
 
 // MenuServiceClient is the client API for MenuService service.
@@ -57,6 +58,8 @@ type MenuServiceClient interface {
 	ExistMenuByPath(ctx context.Context, in *v1.ExistMenuByPathRequest, opts ...grpc.CallOption) (*v1.ExistMenuByPathResponse, error)
 	// 判断菜单名是否存在
 	ExistMenuByName(ctx context.Context, in *v1.ExistMenuByNameRequest, opts ...grpc.CallOption) (*v1.ExistMenuByNameResponse, error)
+	// 查询菜单变更历史
+	ListMenuHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error)
 }
 
 type menuServiceClient struct {
@@ -157,6 +160,16 @@ func (c *menuServiceClient) ExistMenuByName(ctx context.Context, in *v1.ExistMen
 	return out, nil
 }
 
+func (c *menuServiceClient) ListMenuHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHistoryResponse)
+	err := c.cc.Invoke(ctx, MenuService_ListMenuHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MenuServiceServer is the server API for MenuService service.
 // All implementations must embed UnimplementedMenuServiceServer
 // for forward compatibility.
@@ -181,6 +194,8 @@ type MenuServiceServer interface {
 	ExistMenuByPath(context.Context, *v1.ExistMenuByPathRequest) (*v1.ExistMenuByPathResponse, error)
 	// 判断菜单名是否存在
 	ExistMenuByName(context.Context, *v1.ExistMenuByNameRequest) (*v1.ExistMenuByNameResponse, error)
+	// 查询菜单变更历史
+	ListMenuHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error)
 	mustEmbedUnimplementedMenuServiceServer()
 }
 
@@ -218,6 +233,9 @@ func (UnimplementedMenuServiceServer) ExistMenuByPath(context.Context, *v1.Exist
 func (UnimplementedMenuServiceServer) ExistMenuByName(context.Context, *v1.ExistMenuByNameRequest) (*v1.ExistMenuByNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExistMenuByName not implemented")
 }
+func (UnimplementedMenuServiceServer) ListMenuHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMenuHistory not implemented")
+}
 func (UnimplementedMenuServiceServer) mustEmbedUnimplementedMenuServiceServer() {}
 func (UnimplementedMenuServiceServer) testEmbeddedByValue()                     {}
 
@@ -401,6 +419,24 @@ func _MenuService_ExistMenuByName_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MenuService_ListMenuHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).ListMenuHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_ListMenuHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).ListMenuHistory(ctx, req.(*ListHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MenuService_ServiceDesc is the grpc.ServiceDesc for MenuService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -444,6 +480,10 @@ var MenuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExistMenuByName",
 			Handler:    _MenuService_ExistMenuByName_Handler,
 		},
+		{
+			MethodName: "ListMenuHistory",
+			Handler:    _MenuService_ListMenuHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "avmc/admin/v1/i_menu.proto",
@@ -29,6 +29,7 @@ const OperationMenuServiceExistMenuByPath = "/avmc.admin.v1.MenuService/ExistMen
 const OperationMenuServiceGetMenu = "/avmc.admin.v1.MenuService/GetMenu"
 const OperationMenuServiceListMenu = "/avmc.admin.v1.MenuService/ListMenu"
 const OperationMenuServiceListMenuAll = "/avmc.admin.v1.MenuService/ListMenuAll"
+const OperationMenuServiceListMenuHistory = "/avmc.admin.v1.MenuService/ListMenuHistory"
 const OperationMenuServiceListMenuTree = "/avmc.admin.v1.MenuService/ListMenuTree"
 const OperationMenuServiceUpdateMenu = "/avmc.admin.v1.MenuService/UpdateMenu"
 
@@ -47,6 +48,8 @@ type MenuServiceHTTPServer interface {
 	ListMenu(context.Context, *pagination.PagingRequest) (*v1.ListMenuResponse, error)
 	// ListMenuAll 获取所有菜单
 	ListMenuAll(context.Context, *emptypb.Empty) (*v1.ListMenuResponse, error)
+	// ListMenuHistory 查询菜单变更历史
+	ListMenuHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error)
 	// ListMenuTree 获取菜单树
 	ListMenuTree(context.Context, *v1.ListMenuTreeRequest) (*v1.ListMenuTreeResponse, error)
 	// UpdateMenu 更新菜单
@@ -67,6 +70,7 @@ func RegisterMenuServiceHTTPServer(s *http.Server, srv MenuServiceHTTPServer) {
 	r.GET("/admin/v1/menus/path-exists", _MenuService_ExistMenuByPath1_HTTP_Handler(srv))
 	r.GET("/admin/v1/menus/name-exists/{name}", _MenuService_ExistMenuByName0_HTTP_Handler(srv))
 	r.GET("/admin/v1/menus/name-exists", _MenuService_ExistMenuByName1_HTTP_Handler(srv))
+	r.GET("/admin/v1/menus/{id}/history", _MenuService_ListMenuHistory0_HTTP_Handler(srv))
 }
 
 func _MenuService_ListMenuAll0_HTTP_Handler(srv MenuServiceHTTPServer) func(ctx http.Context) error {
@@ -321,6 +325,28 @@ func _MenuService_ExistMenuByName1_HTTP_Handler(srv MenuServiceHTTPServer) func(
 	}
 }
 
+func _MenuService_ListMenuHistory0_HTTP_Handler(srv MenuServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMenuServiceListMenuHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMenuHistory(ctx, req.(*ListHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type MenuServiceHTTPClient interface {
 	CreateMenu(ctx context.Context, req *v1.CreateMenuRequest, opts ...http.CallOption) (rsp *v1.CreateMenuResponse, err error)
 	DeleteMenu(ctx context.Context, req *v1.DeleteMenuRequest, opts ...http.CallOption) (rsp *v1.DeleteMenuResponse, err error)
@@ -329,6 +355,7 @@ type MenuServiceHTTPClient interface {
 	GetMenu(ctx context.Context, req *v1.GetMenuRequest, opts ...http.CallOption) (rsp *v1.Menu, err error)
 	ListMenu(ctx context.Context, req *pagination.PagingRequest, opts ...http.CallOption) (rsp *v1.ListMenuResponse, err error)
 	ListMenuAll(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v1.ListMenuResponse, err error)
+	ListMenuHistory(ctx context.Context, req *ListHistoryRequest, opts ...http.CallOption) (rsp *ListHistoryResponse, err error)
 	ListMenuTree(ctx context.Context, req *v1.ListMenuTreeRequest, opts ...http.CallOption) (rsp *v1.ListMenuTreeResponse, err error)
 	UpdateMenu(ctx context.Context, req *v1.UpdateMenuRequest, opts ...http.CallOption) (rsp *v1.UpdateMenuResponse, err error)
 }
@@ -432,6 +459,19 @@ func (c *MenuServiceHTTPClientImpl) ListMenuAll(ctx context.Context, in *emptypb
 	return &out, nil
 }
 
+func (c *MenuServiceHTTPClientImpl) ListMenuHistory(ctx context.Context, in *ListHistoryRequest, opts ...http.CallOption) (*ListHistoryResponse, error) {
+	var out ListHistoryResponse
+	pattern := "/admin/v1/menus/{id}/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMenuServiceListMenuHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *MenuServiceHTTPClientImpl) ListMenuTree(ctx context.Context, in *v1.ListMenuTreeRequest, opts ...http.CallOption) (*v1.ListMenuTreeResponse, error) {
 	var out v1.ListMenuTreeResponse
 	pattern := "/admin/v1/menus/tree/{pid}"
@@ -30,88 +30,109 @@ var File_avmc_admin_v1_i_role_proto protoreflect.FileDescriptor
 var file_avmc_admin_v1_i_role_proto_rawDesc = string([]byte{
 	0x0a, 0x1a, 0x61, 0x76, 0x6d, 0x63, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
 	0x69, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x61, 0x76,
-	0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x22, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1a, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x63, 0x6f, 0x72,
-	0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
-	0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xad, 0x07, 0x0a, 0x0b, 0x52, 0x6f, 0x6c,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xb2, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x68, 0xba, 0x47, 0x4e, 0x0a, 0x12, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2,
-	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x12, 0xe8, 0x8e,
-	0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8,
-	0x1a, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0x88,
-	0x97, 0xe8, 0xa1, 0xa8, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
-	0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0xb0, 0x01,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c,
-	0x65, 0x22, 0x6d, 0xba, 0x47, 0x4e, 0x0a, 0x12, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe7, 0xae,
-	0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5,
-	0x8f, 0x96, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x1a, 0x12,
-	0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe6, 0x95, 0xb0, 0xe6,
-	0x8d, 0xae, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75,
-	0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x12, 0xb9, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12,
-	0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0xba, 0x47, 0x42, 0x0a, 0x12, 0xe8,
-	0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a,
-	0xa1, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x1a,
-	0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x5a, 0x10, 0x0a,
-	0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x0f, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0xbe, 0x01, 0x0a,
-	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0xba, 0x47, 0x42, 0x0a, 0x12, 0xe8, 0xa7, 0x92, 0xe8, 0x89,
-	0xb2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x0c, 0xe6,
-	0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x1a, 0x0c, 0xe6, 0x9b, 0xb4,
-	0xe6, 0x96, 0xb0, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42,
-	0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1c, 0x3a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x1a, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
-	0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb8, 0x01,
-	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x61, 0x76, 0x6d,
+	0x63, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x2f, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xd5, 0x09, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xb2, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x68, 0xba, 0x47, 0x4e, 0x0a, 0x12, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f,
+	0x96, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x1a, 0x12, 0xe8,
+	0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0x88, 0x97, 0xe8, 0xa1,
+	0xa8, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74,
+	0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x6d,
+	0xba, 0x47, 0x4e, 0x0a, 0x12, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
+	0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8,
+	0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x1a, 0x12, 0xe8, 0x8e, 0xb7,
+	0xe5, 0x8f, 0x96, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x5a,
+	0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb9, 0x01,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0xba, 0x47, 0x42, 0x0a, 0x12, 0xe8, 0xa7, 0x92, 0xe8,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0xba, 0x47, 0x42, 0x0a, 0x12, 0xe8, 0xa7, 0x92, 0xe8,
 	0x89, 0xb2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x0c,
-	0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x1a, 0x0c, 0xe5, 0x88,
-	0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a,
+	0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x1a, 0x0c, 0xe5, 0x88,
+	0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a,
 	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x16, 0x2a, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0x9b, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
-	0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0a,
-	0x49, 0x52, 0x6f, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x76, 0x6d, 0x63, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x41, 0x76, 0x6d, 0x63, 0x5c,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x41, 0x76, 0x6d, 0x63, 0x5c,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x41, 0x76, 0x6d, 0x63, 0x3a, 0x3a, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x17, 0x3a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x0f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0xbe, 0x01, 0x0a, 0x0a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x67, 0xba, 0x47, 0x42, 0x0a, 0x12, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6,
+	0x96, 0xb0, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x1a, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0,
+	0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x1a, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb8, 0x01, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x61, 0xba, 0x47, 0x42, 0x0a, 0x12, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x0c, 0xe5, 0x88, 0xa0,
+	0xe9, 0x99, 0xa4, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x1a, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
+	0xa4, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a,
+	0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa5, 0x02, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x61, 0x76, 0x6d, 0x63,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61,
+	0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xca, 0x01, 0xba, 0x47, 0xa2, 0x01, 0x0a, 0x12, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x12, 0x18, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe5,
+	0x8e, 0x86, 0xe5, 0x8f, 0xb2, 0x1a, 0x60, 0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe7, 0x9a, 0x84, 0xe5, 0xad, 0x97, 0xe6,
+	0xae, 0xb5, 0xe7, 0xba, 0xa7, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe8, 0xae, 0xb0, 0xe5, 0xbd,
+	0x95, 0xef, 0xbc, 0x8c, 0xe4, 0xbc, 0xa0, 0xe5, 0x85, 0xa5, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0xe7, 0x82, 0xb9, 0xe6, 0x97, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe8, 0xaf, 0xa5, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe7, 0x82, 0xb9, 0xe7, 0x9a, 0x84, 0xe8, 0xa7, 0x92, 0xe8, 0x89,
+	0xb2, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x5a, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x9b, 0x01,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x76, 0x6d, 0x63, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x49, 0x52, 0x6f, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x24, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x76, 0x6d, 0x63, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0d,
+	0x41, 0x76, 0x6d, 0x63, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d,
+	0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19,
+	0x41, 0x76, 0x6d, 0x63, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x41, 0x76, 0x6d, 0x63,
+	0x3a, 0x3a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 })
 
 var file_avmc_admin_v1_i_role_proto_goTypes = []any{
@@ -120,28 +141,32 @@ var file_avmc_admin_v1_i_role_proto_goTypes = []any{
 	(*v1.CreateRoleRequest)(nil),     // 2: core.service.v1.CreateRoleRequest
 	(*v1.UpdateRoleRequest)(nil),     // 3: core.service.v1.UpdateRoleRequest
 	(*v1.DeleteRoleRequest)(nil),     // 4: core.service.v1.DeleteRoleRequest
-	(*v1.ListRoleResponse)(nil),      // 5: core.service.v1.ListRoleResponse
-	(*v1.Role)(nil),                  // 6: core.service.v1.Role
-	(*v1.CreateRoleResponse)(nil),    // 7: core.service.v1.CreateRoleResponse
-	(*v1.UpdateRoleResponse)(nil),    // 8: core.service.v1.UpdateRoleResponse
-	(*v1.DeleteRoleResponse)(nil),    // 9: core.service.v1.DeleteRoleResponse
+	(*ListHistoryRequest)(nil),       // 5: avmc.admin.v1.ListHistoryRequest
+	(*v1.ListRoleResponse)(nil),      // 6: core.service.v1.ListRoleResponse
+	(*v1.Role)(nil),                  // 7: core.service.v1.Role
+	(*v1.CreateRoleResponse)(nil),    // 8: core.service.v1.CreateRoleResponse
+	(*v1.UpdateRoleResponse)(nil),    // 9: core.service.v1.UpdateRoleResponse
+	(*v1.DeleteRoleResponse)(nil),    // 10: core.service.v1.DeleteRoleResponse
+	(*ListHistoryResponse)(nil),      // 11: avmc.admin.v1.ListHistoryResponse
 }
 var file_avmc_admin_v1_i_role_proto_depIdxs = []int32{
-	0, // 0: avmc.admin.v1.RoleService.ListRole:input_type -> pagination.PagingRequest
-	1, // 1: avmc.admin.v1.RoleService.GetRole:input_type -> core.service.v1.GetRoleRequest
-	2, // 2: avmc.admin.v1.RoleService.CreateRole:input_type -> core.service.v1.CreateRoleRequest
-	3, // 3: avmc.admin.v1.RoleService.UpdateRole:input_type -> core.service.v1.UpdateRoleRequest
-	4, // 4: avmc.admin.v1.RoleService.DeleteRole:input_type -> core.service.v1.DeleteRoleRequest
-	5, // 5: avmc.admin.v1.RoleService.ListRole:output_type -> core.service.v1.ListRoleResponse
-	6, // 6: avmc.admin.v1.RoleService.GetRole:output_type -> core.service.v1.Role
-	7, // 7: avmc.admin.v1.RoleService.CreateRole:output_type -> core.service.v1.CreateRoleResponse
-	8, // 8: avmc.admin.v1.RoleService.UpdateRole:output_type -> core.service.v1.UpdateRoleResponse
-	9, // 9: avmc.admin.v1.RoleService.DeleteRole:output_type -> core.service.v1.DeleteRoleResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: avmc.admin.v1.RoleService.ListRole:input_type -> pagination.PagingRequest
+	1,  // 1: avmc.admin.v1.RoleService.GetRole:input_type -> core.service.v1.GetRoleRequest
+	2,  // 2: avmc.admin.v1.RoleService.CreateRole:input_type -> core.service.v1.CreateRoleRequest
+	3,  // 3: avmc.admin.v1.RoleService.UpdateRole:input_type -> core.service.v1.UpdateRoleRequest
+	4,  // 4: avmc.admin.v1.RoleService.DeleteRole:input_type -> core.service.v1.DeleteRoleRequest
+	5,  // 5: avmc.admin.v1.RoleService.ListRoleHistory:input_type -> avmc.admin.v1.ListHistoryRequest
+	6,  // 6: avmc.admin.v1.RoleService.ListRole:output_type -> core.service.v1.ListRoleResponse
+	7,  // 7: avmc.admin.v1.RoleService.GetRole:output_type -> core.service.v1.Role
+	8,  // 8: avmc.admin.v1.RoleService.CreateRole:output_type -> core.service.v1.CreateRoleResponse
+	9,  // 9: avmc.admin.v1.RoleService.UpdateRole:output_type -> core.service.v1.UpdateRoleResponse
+	10, // 10: avmc.admin.v1.RoleService.DeleteRole:output_type -> core.service.v1.DeleteRoleResponse
+	11, // 11: avmc.admin.v1.RoleService.ListRoleHistory:output_type -> avmc.admin.v1.ListHistoryResponse
+	6,  // [6:12] is the sub-list for method output_type
+	0,  // [0:6] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_avmc_admin_v1_i_role_proto_init() }
@@ -149,6 +174,7 @@ func file_avmc_admin_v1_i_role_proto_init() {
 	if File_avmc_admin_v1_i_role_proto != nil {
 		return
 	}
+	file_avmc_admin_v1_history_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
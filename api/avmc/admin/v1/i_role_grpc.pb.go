@@ -21,11 +21,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RoleService_ListRole_FullMethodName   = "/avmc.admin.v1.RoleService/ListRole"
-	RoleService_GetRole_FullMethodName    = "/avmc.admin.v1.RoleService/GetRole"
-	RoleService_CreateRole_FullMethodName = "/avmc.admin.v1.RoleService/CreateRole"
-	RoleService_UpdateRole_FullMethodName = "/avmc.admin.v1.RoleService/UpdateRole"
-	RoleService_DeleteRole_FullMethodName = "/avmc.admin.v1.RoleService/DeleteRole"
+	RoleService_ListRole_FullMethodName        = "/avmc.admin.v1.RoleService/ListRole"
+	RoleService_GetRole_FullMethodName         = "/avmc.admin.v1.RoleService/GetRole"
+	RoleService_CreateRole_FullMethodName      = "/avmc.admin.v1.RoleService/CreateRole"
+	RoleService_UpdateRole_FullMethodName      = "/avmc.admin.v1.RoleService/UpdateRole"
+	RoleService_DeleteRole_FullMethodName      = "/avmc.admin.v1.RoleService/DeleteRole"
+	RoleService_ListRoleHistory_FullMethodName = "/avmc.admin.v1.RoleService/ListRoleHistory"
 )
 
 // RoleServiceClient is the client API for RoleService service.
@@ -44,6 +45,8 @@ type RoleServiceClient interface {
 	UpdateRole(ctx context.Context, in *v1.UpdateRoleRequest, opts ...grpc.CallOption) (*v1.UpdateRoleResponse, error)
 	// 删除角色
 	DeleteRole(ctx context.Context, in *v1.DeleteRoleRequest, opts ...grpc.CallOption) (*v1.DeleteRoleResponse, error)
+	// 查询角色变更历史
+	ListRoleHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error)
 }
 
 type roleServiceClient struct {
@@ -104,6 +107,16 @@ func (c *roleServiceClient) DeleteRole(ctx context.Context, in *v1.DeleteRoleReq
 	return out, nil
 }
 
+func (c *roleServiceClient) ListRoleHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHistoryResponse)
+	err := c.cc.Invoke(ctx, RoleService_ListRoleHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleServiceServer is the server API for RoleService service.
 // All implementations must embed UnimplementedRoleServiceServer
 // for forward compatibility.
@@ -120,6 +133,8 @@ type RoleServiceServer interface {
 	UpdateRole(context.Context, *v1.UpdateRoleRequest) (*v1.UpdateRoleResponse, error)
 	// 删除角色
 	DeleteRole(context.Context, *v1.DeleteRoleRequest) (*v1.DeleteRoleResponse, error)
+	// 查询角色变更历史
+	ListRoleHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error)
 	mustEmbedUnimplementedRoleServiceServer()
 }
 
@@ -145,6 +160,9 @@ func (UnimplementedRoleServiceServer) UpdateRole(context.Context, *v1.UpdateRole
 func (UnimplementedRoleServiceServer) DeleteRole(context.Context, *v1.DeleteRoleRequest) (*v1.DeleteRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
 }
+func (UnimplementedRoleServiceServer) ListRoleHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleHistory not implemented")
+}
 func (UnimplementedRoleServiceServer) mustEmbedUnimplementedRoleServiceServer() {}
 func (UnimplementedRoleServiceServer) testEmbeddedByValue()                     {}
 
@@ -256,6 +274,24 @@ func _RoleService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_ListRoleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ListRoleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ListRoleHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ListRoleHistory(ctx, req.(*ListHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -283,6 +319,10 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRole",
 			Handler:    _RoleService_DeleteRole_Handler,
 		},
+		{
+			MethodName: "ListRoleHistory",
+			Handler:    _RoleService_ListRoleHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "avmc/admin/v1/i_role.proto",
@@ -25,6 +25,7 @@ const OperationRoleServiceCreateRole = "/avmc.admin.v1.RoleService/CreateRole"
 const OperationRoleServiceDeleteRole = "/avmc.admin.v1.RoleService/DeleteRole"
 const OperationRoleServiceGetRole = "/avmc.admin.v1.RoleService/GetRole"
 const OperationRoleServiceListRole = "/avmc.admin.v1.RoleService/ListRole"
+const OperationRoleServiceListRoleHistory = "/avmc.admin.v1.RoleService/ListRoleHistory"
 const OperationRoleServiceUpdateRole = "/avmc.admin.v1.RoleService/UpdateRole"
 
 type RoleServiceHTTPServer interface {
@@ -36,6 +37,8 @@ type RoleServiceHTTPServer interface {
 	GetRole(context.Context, *v1.GetRoleRequest) (*v1.Role, error)
 	// ListRole 获取角色列表
 	ListRole(context.Context, *pagination.PagingRequest) (*v1.ListRoleResponse, error)
+	// ListRoleHistory 查询角色变更历史
+	ListRoleHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error)
 	// UpdateRole 更新角色
 	UpdateRole(context.Context, *v1.UpdateRoleRequest) (*v1.UpdateRoleResponse, error)
 }
@@ -47,6 +50,7 @@ func RegisterRoleServiceHTTPServer(s *http.Server, srv RoleServiceHTTPServer) {
 	r.POST("/admin/v1/roles", _RoleService_CreateRole0_HTTP_Handler(srv))
 	r.PUT("/admin/v1/roles/{id}", _RoleService_UpdateRole0_HTTP_Handler(srv))
 	r.DELETE("/admin/v1/roles/{id}", _RoleService_DeleteRole0_HTTP_Handler(srv))
+	r.GET("/admin/v1/roles/{id}/history", _RoleService_ListRoleHistory0_HTTP_Handler(srv))
 }
 
 func _RoleService_ListRole0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
@@ -159,11 +163,34 @@ func _RoleService_DeleteRole0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx h
 	}
 }
 
+func _RoleService_ListRoleHistory0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceListRoleHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRoleHistory(ctx, req.(*ListHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type RoleServiceHTTPClient interface {
 	CreateRole(ctx context.Context, req *v1.CreateRoleRequest, opts ...http.CallOption) (rsp *v1.CreateRoleResponse, err error)
 	DeleteRole(ctx context.Context, req *v1.DeleteRoleRequest, opts ...http.CallOption) (rsp *v1.DeleteRoleResponse, err error)
 	GetRole(ctx context.Context, req *v1.GetRoleRequest, opts ...http.CallOption) (rsp *v1.Role, err error)
 	ListRole(ctx context.Context, req *pagination.PagingRequest, opts ...http.CallOption) (rsp *v1.ListRoleResponse, err error)
+	ListRoleHistory(ctx context.Context, req *ListHistoryRequest, opts ...http.CallOption) (rsp *ListHistoryResponse, err error)
 	UpdateRole(ctx context.Context, req *v1.UpdateRoleRequest, opts ...http.CallOption) (rsp *v1.UpdateRoleResponse, err error)
 }
 
@@ -227,6 +254,19 @@ func (c *RoleServiceHTTPClientImpl) ListRole(ctx context.Context, in *pagination
 	return &out, nil
 }
 
+func (c *RoleServiceHTTPClientImpl) ListRoleHistory(ctx context.Context, in *ListHistoryRequest, opts ...http.CallOption) (*ListHistoryResponse, error) {
+	var out ListHistoryResponse
+	pattern := "/admin/v1/roles/{id}/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRoleServiceListRoleHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RoleServiceHTTPClientImpl) UpdateRole(ctx context.Context, in *v1.UpdateRoleRequest, opts ...http.CallOption) (*v1.UpdateRoleResponse, error) {
 	var out v1.UpdateRoleResponse
 	pattern := "/admin/v1/roles/{id}"
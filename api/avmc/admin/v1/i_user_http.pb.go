@@ -25,6 +25,7 @@ const OperationUserServiceCreateUser = "/avmc.admin.v1.UserService/CreateUser"
 const OperationUserServiceDeleteUser = "/avmc.admin.v1.UserService/DeleteUser"
 const OperationUserServiceGetUser = "/avmc.admin.v1.UserService/GetUser"
 const OperationUserServiceListUser = "/avmc.admin.v1.UserService/ListUser"
+const OperationUserServiceListUserHistory = "/avmc.admin.v1.UserService/ListUserHistory"
 const OperationUserServiceListUserSimple = "/avmc.admin.v1.UserService/ListUserSimple"
 const OperationUserServiceUpdateUser = "/avmc.admin.v1.UserService/UpdateUser"
 
@@ -37,6 +38,8 @@ type UserServiceHTTPServer interface {
 	GetUser(context.Context, *v1.GetUserRequest) (*v1.User, error)
 	// ListUser 获取用户列表
 	ListUser(context.Context, *pagination.PagingRequest) (*v1.ListUserResponse, error)
+	// ListUserHistory 查询用户变更历史
+	ListUserHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error)
 	// ListUserSimple 获取用户简单列表
 	ListUserSimple(context.Context, *pagination.PagingRequest) (*v1.ListUserResponse, error)
 	// UpdateUser 更新用户
@@ -51,6 +54,7 @@ func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
 	r.POST("/admin/v1/users", _UserService_CreateUser0_HTTP_Handler(srv))
 	r.PUT("/admin/v1/users/{id}", _UserService_UpdateUser0_HTTP_Handler(srv))
 	r.DELETE("/admin/v1/users/{id}", _UserService_DeleteUser0_HTTP_Handler(srv))
+	r.GET("/admin/v1/users/{id}/history", _UserService_ListUserHistory0_HTTP_Handler(srv))
 }
 
 func _UserService_ListUserSimple0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
@@ -182,11 +186,34 @@ func _UserService_DeleteUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx h
 	}
 }
 
+func _UserService_ListUserHistory0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceListUserHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserHistory(ctx, req.(*ListHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserServiceHTTPClient interface {
 	CreateUser(ctx context.Context, req *v1.CreateUserRequest, opts ...http.CallOption) (rsp *v1.CreateUserResponse, err error)
 	DeleteUser(ctx context.Context, req *v1.DeleteUserRequest, opts ...http.CallOption) (rsp *v1.DeleteUserResponse, err error)
 	GetUser(ctx context.Context, req *v1.GetUserRequest, opts ...http.CallOption) (rsp *v1.User, err error)
 	ListUser(ctx context.Context, req *pagination.PagingRequest, opts ...http.CallOption) (rsp *v1.ListUserResponse, err error)
+	ListUserHistory(ctx context.Context, req *ListHistoryRequest, opts ...http.CallOption) (rsp *ListHistoryResponse, err error)
 	ListUserSimple(ctx context.Context, req *pagination.PagingRequest, opts ...http.CallOption) (rsp *v1.ListUserResponse, err error)
 	UpdateUser(ctx context.Context, req *v1.UpdateUserRequest, opts ...http.CallOption) (rsp *v1.UpdateUserResponse, err error)
 }
@@ -251,6 +278,19 @@ func (c *UserServiceHTTPClientImpl) ListUser(ctx context.Context, in *pagination
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) ListUserHistory(ctx context.Context, in *ListHistoryRequest, opts ...http.CallOption) (*ListHistoryResponse, error) {
+	var out ListHistoryResponse
+	pattern := "/admin/v1/users/{id}/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceListUserHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) ListUserSimple(ctx context.Context, in *pagination.PagingRequest, opts ...http.CallOption) (*v1.ListUserResponse, error) {
 	var out v1.ListUserResponse
 	pattern := "/admin/v1/users/simple"
@@ -106,6 +106,18 @@ func (m *PagingRequest) validate(all bool) error {
 		// no validation rules for NoPaging
 	}
 
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if m.UseCursor != nil {
+		// no validation rules for UseCursor
+	}
+
+	if m.WithCount != nil {
+		// no validation rules for WithCount
+	}
+
 	if len(errors) > 0 {
 		return PagingRequestMultiError(errors)
 	}
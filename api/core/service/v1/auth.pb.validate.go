@@ -347,11 +347,38 @@ func (m *IsAuthorizedRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Subject
+	if utf8.RuneCountInString(m.GetSubject()) < 1 {
+		err := IsAuthorizedRequestValidationError{
+			field:  "Subject",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Action
+	if utf8.RuneCountInString(m.GetAction()) < 1 {
+		err := IsAuthorizedRequestValidationError{
+			field:  "Action",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Resource
+	if utf8.RuneCountInString(m.GetResource()) < 1 {
+		err := IsAuthorizedRequestValidationError{
+			field:  "Resource",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Project
 
@@ -457,6 +484,8 @@ func (m *IsAuthorizedResponse) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Allowed
+
 	if len(errors) > 0 {
 		return IsAuthorizedResponseMultiError(errors)
 	}
@@ -62,6 +62,40 @@ func (m *Dept) validate(all bool) error {
 
 	// no validation rules for Id
 
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeptValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeptValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeptValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.Name != nil {
 		// no validation rules for Name
 	}
@@ -94,6 +128,18 @@ func (m *Dept) validate(all bool) error {
 		// no validation rules for UpdatedAt
 	}
 
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.UpdatedBy != nil {
+		// no validation rules for UpdatedBy
+	}
+
 	if len(errors) > 0 {
 		return DeptMultiError(errors)
 	}
@@ -1358,3 +1404,453 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListDeptResponseValidationError{}
+
+// Validate checks the field values on ListDeptTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeptTreeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeptTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeptTreeRequestMultiError, or nil if none found.
+func (m *ListDeptTreeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeptTreeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.ParentId != nil {
+		// no validation rules for ParentId
+	}
+
+	if len(errors) > 0 {
+		return ListDeptTreeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeptTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeptTreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeptTreeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeptTreeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeptTreeRequestMultiError) AllErrors() []error { return m }
+
+// ListDeptTreeRequestValidationError is the validation error returned by
+// ListDeptTreeRequest.Validate if the designated constraints aren't met.
+type ListDeptTreeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeptTreeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeptTreeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeptTreeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeptTreeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeptTreeRequestValidationError) ErrorName() string {
+	return "ListDeptTreeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeptTreeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeptTreeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeptTreeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeptTreeRequestValidationError{}
+
+// Validate checks the field values on ListDeptTreeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeptTreeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeptTreeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeptTreeResponseMultiError, or nil if none found.
+func (m *ListDeptTreeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeptTreeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeptTreeResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeptTreeResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeptTreeResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDeptTreeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeptTreeResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeptTreeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeptTreeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeptTreeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeptTreeResponseMultiError) AllErrors() []error { return m }
+
+// ListDeptTreeResponseValidationError is the validation error returned by
+// ListDeptTreeResponse.Validate if the designated constraints aren't met.
+type ListDeptTreeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeptTreeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeptTreeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeptTreeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeptTreeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeptTreeResponseValidationError) ErrorName() string {
+	return "ListDeptTreeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeptTreeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeptTreeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeptTreeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeptTreeResponseValidationError{}
+
+// Validate checks the field values on MoveDeptRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveDeptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveDeptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveDeptRequestMultiError, or nil if none found.
+func (m *MoveDeptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveDeptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ParentId
+
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if len(errors) > 0 {
+		return MoveDeptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveDeptRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveDeptRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveDeptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveDeptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveDeptRequestMultiError) AllErrors() []error { return m }
+
+// MoveDeptRequestValidationError is the validation error returned by
+// MoveDeptRequest.Validate if the designated constraints aren't met.
+type MoveDeptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveDeptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveDeptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveDeptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveDeptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveDeptRequestValidationError) ErrorName() string { return "MoveDeptRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveDeptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveDeptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveDeptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveDeptRequestValidationError{}
+
+// Validate checks the field values on MoveDeptResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveDeptResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveDeptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveDeptResponseMultiError, or nil if none found.
+func (m *MoveDeptResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveDeptResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MoveDeptResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveDeptResponseMultiError is an error wrapping multiple validation errors
+// returned by MoveDeptResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MoveDeptResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveDeptResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveDeptResponseMultiError) AllErrors() []error { return m }
+
+// MoveDeptResponseValidationError is the validation error returned by
+// MoveDeptResponse.Validate if the designated constraints aren't met.
+type MoveDeptResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveDeptResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveDeptResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveDeptResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveDeptResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveDeptResponseValidationError) ErrorName() string { return "MoveDeptResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveDeptResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveDeptResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveDeptResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveDeptResponseValidationError{}
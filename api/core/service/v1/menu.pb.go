@@ -230,7 +230,13 @@ type Menu struct {
 	// 创建时间
 	CreatedAt *string `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
 	// 更新时间
-	UpdatedAt     *string `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	UpdatedAt *string `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	// 版本号，每次更新自增
+	Version *uint32 `protobuf:"varint,14,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// 创建人ID，由认证用户自动填充
+	CreatedBy *uint32 `protobuf:"varint,15,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	// 更新人ID，由认证用户自动填充
+	UpdatedBy     *uint32 `protobuf:"varint,16,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -356,6 +362,27 @@ func (x *Menu) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Menu) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *Menu) GetCreatedBy() uint32 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Menu) GetUpdatedBy() uint32 {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return 0
+}
+
 // 菜单元数据
 type MenuMeta struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1380,7 +1407,7 @@ var file_core_service_v1_menu_proto_rawDesc = string([]byte{
 	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x06, 0x0a,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x09, 0x0a,
 	0x04, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0d, 0x42, 0x15, 0xba, 0x47, 0x0b, 0x92, 0x02, 0x08, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0x49,
 	0x44, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04,
@@ -1429,296 +1456,314 @@ var file_core_service_v1_menu_proto_rawDesc = string([]byte{
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6,
 	0xe9, 0x97, 0xb4, 0x48, 0x08, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d,
-	0x65, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22,
-	0xb3, 0x0e, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x4b, 0x0a, 0x0b,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x25, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe6, 0xbf, 0x80, 0xe6, 0xb4, 0xbb, 0xe6,
-	0x97, 0xb6, 0xe6, 0x98, 0xbe, 0xe7, 0xa4, 0xba, 0xe7, 0x9a, 0x84, 0xe5, 0x9b, 0xbe, 0xe6, 0xa0,
-	0x87, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x32, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x62, 0x0a, 0x0b, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c,
-	0xba, 0x47, 0x31, 0x92, 0x02, 0x2e, 0xe4, 0xbd, 0x9c, 0xe4, 0xb8, 0xba, 0xe8, 0xb7, 0xaf, 0xe7,
-	0x94, 0xb1, 0xe6, 0x97, 0xb6, 0xef, 0xbc, 0x8c, 0xe9, 0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe6, 0xbf,
-	0x80, 0xe6, 0xb4, 0xbb, 0xe7, 0x9a, 0x84, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe7, 0x9a, 0x84,
-	0x50, 0x61, 0x74, 0x68, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xc8, 0x01, 0x48, 0x01, 0x52, 0x0a,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a,
-	0x09, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x74, 0x61, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x18, 0xba, 0x47, 0x15, 0x92, 0x02, 0x12, 0xe5, 0x9b, 0xba, 0xe5, 0xae, 0x9a, 0xe5, 0x9c,
-	0xa8, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe6, 0xa0, 0x8f, 0x48, 0x02, 0x52, 0x08, 0x61, 0x66,
-	0x66, 0x69, 0x78, 0x54, 0x61, 0x62, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0f, 0x61, 0x66, 0x66,
-	0x69, 0x78, 0x5f, 0x74, 0x61, 0x62, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x21, 0xba, 0x47, 0x1e, 0x92, 0x02, 0x1b, 0xe5, 0x9c, 0xa8, 0xe6, 0xa0, 0x87,
-	0xe7, 0xad, 0xbe, 0xe6, 0xa0, 0x8f, 0xe5, 0x9b, 0xba, 0xe5, 0xae, 0x9a, 0xe7, 0x9a, 0x84, 0xe9,
-	0xa1, 0xba, 0xe5, 0xba, 0x8f, 0x48, 0x03, 0x52, 0x0d, 0x61, 0x66, 0x66, 0x69, 0x78, 0x54, 0x61,
-	0x62, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x05, 0x62, 0x61, 0x64,
-	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xba, 0x47, 0x32, 0x92, 0x02, 0x2f,
-	0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x28, 0xe5, 0xbd, 0x93,
-	0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe4, 0xb8, 0xba, 0x6e,
-	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0xe6, 0x97, 0xb6, 0xe6, 0x9c, 0x89, 0xe6, 0x95, 0x88, 0x29, 0xba,
-	0x48, 0x04, 0x72, 0x02, 0x18, 0x14, 0x48, 0x04, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe5, 0xbe,
-	0xbd, 0xe6, 0xa0, 0x87, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x48, 0x05, 0x52, 0x09, 0x62, 0x61,
-	0x64, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0e, 0x62, 0x61,
-	0x64, 0x67, 0x65, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87,
-	0xe9, 0xa2, 0x9c, 0xe8, 0x89, 0xb2, 0x48, 0x06, 0x52, 0x0d, 0x62, 0x61, 0x64, 0x67, 0x65, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x56, 0x0a, 0x15, 0x68, 0x69,
-	0x64, 0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6d,
-	0x65, 0x6e, 0x75, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02,
-	0x18, 0xe5, 0x9c, 0xa8, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe4, 0xb8, 0xad, 0xe9, 0x9a, 0x90,
-	0xe8, 0x97, 0x8f, 0xe4, 0xb8, 0x8b, 0xe7, 0xba, 0xa7, 0x48, 0x07, 0x52, 0x12, 0x68, 0x69, 0x64,
-	0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x49, 0x6e, 0x4d, 0x65, 0x6e, 0x75, 0x88,
-	0x01, 0x01, 0x12, 0x4e, 0x0a, 0x12, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x72,
-	0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1b,
-	0xba, 0x47, 0x18, 0x92, 0x02, 0x15, 0xe5, 0x9c, 0xa8, 0xe9, 0x9d, 0xa2, 0xe5, 0x8c, 0x85, 0xe5,
-	0xb1, 0x91, 0xe4, 0xb8, 0xad, 0xe9, 0x9a, 0x90, 0xe8, 0x97, 0x8f, 0x48, 0x08, 0x52, 0x10, 0x68,
-	0x69, 0x64, 0x65, 0x49, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x88,
-	0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0c, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65,
-	0x6e, 0x75, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x18, 0xba, 0x47, 0x15, 0x92, 0x02, 0x12,
-	0xe5, 0x9c, 0xa8, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe4, 0xb8, 0xad, 0xe9, 0x9a, 0x90, 0xe8,
-	0x97, 0x8f, 0x48, 0x09, 0x52, 0x0a, 0x68, 0x69, 0x64, 0x65, 0x49, 0x6e, 0x4d, 0x65, 0x6e, 0x75,
-	0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x74,
-	0x61, 0x62, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1b, 0xba, 0x47, 0x18, 0x92, 0x02, 0x15,
-	0xe5, 0x9c, 0xa8, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe6, 0xa0, 0x8f, 0xe4, 0xb8, 0xad, 0xe9,
-	0x9a, 0x90, 0xe8, 0x97, 0x8f, 0x48, 0x0a, 0x52, 0x09, 0x68, 0x69, 0x64, 0x65, 0x49, 0x6e, 0x54,
-	0x61, 0x62, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x19, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d,
-	0x95, 0xe5, 0x9b, 0xbe, 0xe6, 0xa0, 0x87, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x32, 0x48, 0x0b,
-	0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x0a, 0x69, 0x66, 0x72,
-	0x61, 0x6d, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xba,
-	0x47, 0x15, 0x92, 0x02, 0x12, 0xe5, 0x86, 0x85, 0xe5, 0xb5, 0x8c, 0x49, 0x66, 0x72, 0x61, 0x6d,
-	0x65, 0xe7, 0x9a, 0x84, 0x55, 0x52, 0x4c, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xc8, 0x01, 0x48,
-	0x0c, 0x52, 0x09, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x72, 0x63, 0x88, 0x01, 0x01, 0x12,
-	0x3c, 0x0a, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x08, 0x42, 0x18, 0xba, 0x47, 0x15, 0x92, 0x02, 0x12, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
-	0xa6, 0xe7, 0xbc, 0x93, 0xe5, 0xad, 0x98, 0xe9, 0xa1, 0xb5, 0xe9, 0x9d, 0xa2, 0x48, 0x0d, 0x52,
-	0x09, 0x6b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
-	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xba, 0x47, 0x15,
-	0x92, 0x02, 0x12, 0xe5, 0xa4, 0x96, 0xe9, 0x93, 0xbe, 0xe9, 0xa1, 0xb5, 0xe9, 0x9d, 0xa2, 0xe7,
-	0x9a, 0x84, 0x55, 0x52, 0x4c, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xc8, 0x01, 0x48, 0x0e, 0x52,
-	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x60, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f,
-	0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x05, 0x42, 0x2d, 0xba, 0x47, 0x2a, 0x92, 0x02, 0x27, 0xe5, 0x90, 0x8c,
-	0xe4, 0xb8, 0x80, 0xe4, 0xb8, 0xaa, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe6, 0x9c, 0x80, 0xe5,
-	0xa4, 0xa7, 0xe6, 0x89, 0x93, 0xe5, 0xbc, 0x80, 0xe7, 0x9a, 0x84, 0xe6, 0xa0, 0x87, 0xe7, 0xad,
-	0xbe, 0xe6, 0x95, 0xb0, 0x48, 0x0f, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x4f, 0x66,
-	0x4f, 0x70, 0x65, 0x6e, 0x54, 0x61, 0x62, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0f, 0x6e, 0x6f,
-	0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x08, 0x42, 0x18, 0xba, 0x47, 0x15, 0x92, 0x02, 0x12, 0xe6, 0x97, 0xa0, 0xe9, 0x9c,
-	0x80, 0xe5, 0x9f, 0xba, 0xe7, 0xa1, 0x80, 0xe5, 0xb8, 0x83, 0xe5, 0xb1, 0x80, 0x48, 0x10, 0x52,
-	0x0d, 0x6e, 0x6f, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x50, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6e, 0x65, 0x77,
-	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1e, 0xba,
-	0x47, 0x1b, 0x92, 0x02, 0x18, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x9c, 0xa8, 0xe6, 0x96,
-	0xb0, 0xe7, 0xaa, 0x97, 0xe5, 0x8f, 0xa3, 0xe6, 0x89, 0x93, 0xe5, 0xbc, 0x80, 0x48, 0x11, 0x52,
-	0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x4e, 0x65, 0x77, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x8a, 0x02, 0x09, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00,
-	0x00, 0x24, 0x40, 0x92, 0x02, 0x0c, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe6, 0x8e, 0x92, 0xe5,
-	0xba, 0x8f, 0x48, 0x12, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e,
-	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xba,
-	0x47, 0x18, 0x92, 0x02, 0x15, 0xe9, 0xa2, 0x9d, 0xe5, 0xa4, 0x96, 0xe7, 0x9a, 0x84, 0xe8, 0xb7,
-	0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18,
-	0xc8, 0x01, 0x48, 0x13, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x34,
-	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xba,
-	0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe6, 0xa0, 0x87, 0xe9, 0xa2,
-	0x98, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x14, 0x48, 0x14, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x69, 0x63, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x70, 0x61, 0x74, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x74,
-	0x61, 0x62, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x74, 0x61, 0x62,
-	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x62, 0x61, 0x64, 0x67, 0x65,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42,
-	0x11, 0x0a, 0x0f, 0x5f, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
-	0x74, 0x73, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x72, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x42, 0x15, 0x0a, 0x13,
-	0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72,
-	0x75, 0x6d, 0x62, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f,
-	0x6d, 0x65, 0x6e, 0x75, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e,
-	0x5f, 0x74, 0x61, 0x62, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d,
-	0x5f, 0x6f, 0x66, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x42, 0x12, 0x0a, 0x10,
-	0x5f, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74,
-	0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6e, 0x65, 0x77,
-	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x6d,
-	0x65, 0x6e, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75,
-	0x42, 0x12, 0xba, 0x47, 0x09, 0x92, 0x02, 0x06, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0x39, 0x0a, 0x0b, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42,
-	0x18, 0xba, 0x47, 0x0e, 0x92, 0x02, 0x0b, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe4, 0xba, 0xba,
-	0x49, 0x44, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x11,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba,
-	0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x6d, 0x65,
-	0x6e, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x42,
-	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0x28, 0x0a,
-	0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a,
-	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07,
-	0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0b, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
-	0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02,
-	0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e,
-	0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x65, 0x6e,
-	0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x04,
-	0x6d, 0x65, 0x6e, 0x75, 0x22, 0xc6, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e,
-	0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
-	0x18, 0x14, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01,
-	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x55, 0x0a,
-	0x10, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x88, 0x01, 0x01, 0x12, 0x7e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x5f, 0xba, 0x47, 0x5c, 0x92, 0x02, 0x59, 0xe7, 0x89, 0x88, 0xe6,
+	0x9c, 0xac, 0xe5, 0x8f, 0xb7, 0xef, 0xbc, 0x8c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97,
+	0xb6, 0xe9, 0xa1, 0xbb, 0xe4, 0xbc, 0xa0, 0xe5, 0x85, 0xa5, 0xe8, 0xaf, 0xbb, 0xe5, 0x8f, 0x96,
+	0xe5, 0x88, 0xb0, 0xe7, 0x9a, 0x84, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0x8f, 0xb7, 0xef,
+	0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe4, 0xb8, 0x80, 0xe8, 0x87, 0xb4, 0xe6, 0x97, 0xb6, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0x20, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x4c, 0x49, 0x43, 0x54, 0x48, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x11, 0xba, 0x47, 0x0e, 0x92, 0x02, 0x0b, 0xe5,
+	0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0xba, 0x49, 0x44, 0x48, 0x0a, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x11,
+	0xba, 0x47, 0x0e, 0x92, 0x02, 0x0b, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe4, 0xba, 0xba, 0x49,
+	0x44, 0x48, 0x0b, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x70, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x22, 0xb3, 0x0e, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69,
+	0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xba, 0x47, 0x1b, 0x92, 0x02,
+	0x18, 0xe6, 0xbf, 0x80, 0xe6, 0xb4, 0xbb, 0xe6, 0x97, 0xb6, 0xe6, 0x98, 0xbe, 0xe7, 0xa4, 0xba,
+	0xe7, 0x9a, 0x84, 0xe5, 0x9b, 0xbe, 0xe6, 0xa0, 0x87, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x32,
+	0x48, 0x00, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x62, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xba, 0x47, 0x31, 0x92, 0x02, 0x2e, 0xe4, 0xbd,
+	0x9c, 0xe4, 0xb8, 0xba, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe6, 0x97, 0xb6, 0xef, 0xbc, 0x8c,
+	0xe9, 0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe6, 0xbf, 0x80, 0xe6, 0xb4, 0xbb, 0xe7, 0x9a, 0x84, 0xe8,
+	0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe7, 0x9a, 0x84, 0x50, 0x61, 0x74, 0x68, 0xba, 0x48, 0x05, 0x72,
+	0x03, 0x18, 0xc8, 0x01, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x09, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x74,
+	0x61, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x18, 0xba, 0x47, 0x15, 0x92, 0x02, 0x12,
+	0xe5, 0x9b, 0xba, 0xe5, 0xae, 0x9a, 0xe5, 0x9c, 0xa8, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe6,
+	0xa0, 0x8f, 0x48, 0x02, 0x52, 0x08, 0x61, 0x66, 0x66, 0x69, 0x78, 0x54, 0x61, 0x62, 0x88, 0x01,
+	0x01, 0x12, 0x4e, 0x0a, 0x0f, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x74, 0x61, 0x62, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x21, 0xba, 0x47, 0x1e, 0x92,
+	0x02, 0x1b, 0xe5, 0x9c, 0xa8, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe6, 0xa0, 0x8f, 0xe5, 0x9b,
+	0xba, 0xe5, 0xae, 0x9a, 0xe7, 0x9a, 0x84, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0x48, 0x03, 0x52,
+	0x0d, 0x61, 0x66, 0x66, 0x69, 0x78, 0x54, 0x61, 0x62, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x57, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x3c, 0xba, 0x47, 0x32, 0x92, 0x02, 0x2f, 0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87, 0xe5, 0x86,
+	0x85, 0xe5, 0xae, 0xb9, 0x28, 0xe5, 0xbd, 0x93, 0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87, 0xe7, 0xb1,
+	0xbb, 0xe5, 0x9e, 0x8b, 0xe4, 0xb8, 0xba, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0xe6, 0x97, 0xb6,
+	0xe6, 0x9c, 0x89, 0xe6, 0x95, 0x88, 0x29, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x14, 0x48, 0x04,
+	0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x62, 0x61,
+	0x64, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x12,
+	0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87, 0xe7, 0xb1, 0xbb, 0xe5,
+	0x9e, 0x8b, 0x48, 0x05, 0x52, 0x09, 0x62, 0x61, 0x64, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x12, 0xba, 0x47, 0x0f, 0x92,
+	0x02, 0x0c, 0xe5, 0xbe, 0xbd, 0xe6, 0xa0, 0x87, 0xe9, 0xa2, 0x9c, 0xe8, 0x89, 0xb2, 0x48, 0x06,
+	0x52, 0x0d, 0x62, 0x61, 0x64, 0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x56, 0x0a, 0x15, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe5, 0x9c, 0xa8, 0xe8, 0x8f, 0x9c, 0xe5,
+	0x8d, 0x95, 0xe4, 0xb8, 0xad, 0xe9, 0x9a, 0x90, 0xe8, 0x97, 0x8f, 0xe4, 0xb8, 0x8b, 0xe7, 0xba,
+	0xa7, 0x48, 0x07, 0x52, 0x12, 0x68, 0x69, 0x64, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x49, 0x6e, 0x4d, 0x65, 0x6e, 0x75, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x12, 0x68, 0x69,
+	0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1b, 0xba, 0x47, 0x18, 0x92, 0x02, 0x15, 0xe5, 0x9c,
+	0xa8, 0xe9, 0x9d, 0xa2, 0xe5, 0x8c, 0x85, 0xe5, 0xb1, 0x91, 0xe4, 0xb8, 0xad, 0xe9, 0x9a, 0x90,
+	0xe8, 0x97, 0x8f, 0x48, 0x08, 0x52, 0x10, 0x68, 0x69, 0x64, 0x65, 0x49, 0x6e, 0x42, 0x72, 0x65,
+	0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0c, 0x68, 0x69,
+	0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x18, 0xba, 0x47, 0x15, 0x92, 0x02, 0x12, 0xe5, 0x9c, 0xa8, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d,
+	0x95, 0xe4, 0xb8, 0xad, 0xe9, 0x9a, 0x90, 0xe8, 0x97, 0x8f, 0x48, 0x09, 0x52, 0x0a, 0x68, 0x69,
+	0x64, 0x65, 0x49, 0x6e, 0x4d, 0x65, 0x6e, 0x75, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x68,
+	0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x1b, 0xba, 0x47, 0x18, 0x92, 0x02, 0x15, 0xe5, 0x9c, 0xa8, 0xe6, 0xa0, 0x87, 0xe7, 0xad,
+	0xbe, 0xe6, 0xa0, 0x8f, 0xe4, 0xb8, 0xad, 0xe9, 0x9a, 0x90, 0xe8, 0x97, 0x8f, 0x48, 0x0a, 0x52,
+	0x09, 0x68, 0x69, 0x64, 0x65, 0x49, 0x6e, 0x54, 0x61, 0x62, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a,
+	0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xba, 0x47, 0x0f,
+	0x92, 0x02, 0x0c, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe5, 0x9b, 0xbe, 0xe6, 0xa0, 0x87, 0xba,
+	0x48, 0x04, 0x72, 0x02, 0x18, 0x32, 0x48, 0x0b, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x44, 0x0a, 0x0a, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xba, 0x47, 0x15, 0x92, 0x02, 0x12, 0xe5, 0x86, 0x85,
+	0xe5, 0xb5, 0x8c, 0x49, 0x66, 0x72, 0x61, 0x6d, 0x65, 0xe7, 0x9a, 0x84, 0x55, 0x52, 0x4c, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0x18, 0xc8, 0x01, 0x48, 0x0c, 0x52, 0x09, 0x69, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x53, 0x72, 0x63, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x5f,
+	0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x42, 0x18, 0xba, 0x47, 0x15,
+	0x92, 0x02, 0x12, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0xbc, 0x93, 0xe5, 0xad, 0x98, 0xe9,
+	0xa1, 0xb5, 0xe9, 0x9d, 0xa2, 0x48, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69,
+	0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x20, 0xba, 0x47, 0x15, 0x92, 0x02, 0x12, 0xe5, 0xa4, 0x96, 0xe9, 0x93,
+	0xbe, 0xe9, 0xa1, 0xb5, 0xe9, 0x9d, 0xa2, 0xe7, 0x9a, 0x84, 0x55, 0x52, 0x4c, 0xba, 0x48, 0x05,
+	0x72, 0x03, 0x18, 0xc8, 0x01, 0x48, 0x0e, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01,
+	0x12, 0x60, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x42, 0x2d, 0xba,
+	0x47, 0x2a, 0x92, 0x02, 0x27, 0xe5, 0x90, 0x8c, 0xe4, 0xb8, 0x80, 0xe4, 0xb8, 0xaa, 0xe8, 0xb7,
+	0xaf, 0xe7, 0x94, 0xb1, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe6, 0x89, 0x93, 0xe5, 0xbc, 0x80,
+	0xe7, 0x9a, 0x84, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe6, 0x95, 0xb0, 0x48, 0x0f, 0x52, 0x0f,
+	0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x61, 0x62, 0x88,
+	0x01, 0x01, 0x12, 0x45, 0x0a, 0x0f, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x6c,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x42, 0x18, 0xba, 0x47, 0x15,
+	0x92, 0x02, 0x12, 0xe6, 0x97, 0xa0, 0xe9, 0x9c, 0x80, 0xe5, 0x9f, 0xba, 0xe7, 0xa1, 0x80, 0xe5,
+	0xb8, 0x83, 0xe5, 0xb1, 0x80, 0x48, 0x10, 0x52, 0x0d, 0x6e, 0x6f, 0x42, 0x61, 0x73, 0x69, 0x63,
+	0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x12, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe6, 0x98, 0xaf,
+	0xe5, 0x90, 0xa6, 0xe5, 0x9c, 0xa8, 0xe6, 0x96, 0xb0, 0xe7, 0xaa, 0x97, 0xe5, 0x8f, 0xa3, 0xe6,
+	0x89, 0x93, 0xe5, 0xbc, 0x80, 0x48, 0x11, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x4e,
+	0x65, 0x77, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x8a,
+	0x02, 0x09, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40, 0x92, 0x02, 0x0c, 0xe8, 0x8f,
+	0x9c, 0xe5, 0x8d, 0x95, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0x48, 0x12, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xba, 0x47, 0x18, 0x92, 0x02, 0x15, 0xe9, 0xa2, 0x9d,
+	0xe5, 0xa4, 0x96, 0xe7, 0x9a, 0x84, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x8f, 0x82, 0xe6,
+	0x95, 0xb0, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xc8, 0x01, 0x48, 0x13, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe8, 0x8f, 0x9c,
+	0xe5, 0x8d, 0x95, 0xe6, 0xa0, 0x87, 0xe9, 0xa2, 0x98, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x14,
+	0x48, 0x14, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x61, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x74, 0x61, 0x62, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61,
+	0x66, 0x66, 0x69, 0x78, 0x5f, 0x74, 0x61, 0x62, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x62, 0x61, 0x64, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x62, 0x61, 0x64,
+	0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x62, 0x61, 0x64, 0x67,
+	0x65, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x68,
+	0x69, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x5f,
+	0x6d, 0x65, 0x6e, 0x75, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e,
+	0x5f, 0x62, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x5f, 0x73, 0x72, 0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x61, 0x6c,
+	0x69, 0x76, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x16, 0x0a, 0x14,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x6f, 0x70, 0x65, 0x6e,
+	0x5f, 0x74, 0x61, 0x62, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x69,
+	0x63, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x8d, 0x01,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x12, 0xba, 0x47, 0x09, 0x92, 0x02, 0x06,
+	0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6d, 0x65,
+	0x6e, 0x75, 0x12, 0x39, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x18, 0xba, 0x47, 0x0e, 0x92, 0x02, 0x0b, 0xe6,
+	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe4, 0xba, 0xba, 0x49, 0x44, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20,
+	0x00, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x14, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x22, 0x4b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75,
-	0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x70,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x15, 0xba, 0x47, 0x0b, 0x92, 0x02, 0x08,
-	0xe7, 0x88, 0xb6, 0xe7, 0xba, 0xa7, 0x49, 0x44, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x28, 0x00, 0x48,
-	0x00, 0x52, 0x03, 0x70, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x69,
-	0x64, 0x22, 0x54, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x72, 0x65,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x04, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0x28, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a,
+	0x02, 0x20, 0x00, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22,
+	0x14, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20,
+	0x00, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x14, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x22, 0xc6, 0x01, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x41, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x14, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e,
+	0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65,
 	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x42,
-	0x0f, 0xba, 0x47, 0x0c, 0x92, 0x02, 0x09, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe6, 0xa0, 0x91,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x7b, 0x0a, 0x16, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x15, 0xba,
-	0x47, 0x0b, 0x92, 0x02, 0x08, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0x49, 0x44, 0xba, 0x48, 0x04,
-	0x2a, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x47, 0x0f,
-	0x92, 0x02, 0x0c, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0xba,
-	0x48, 0x05, 0x72, 0x03, 0x18, 0xc8, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x42, 0x05, 0x0a,
-	0x03, 0x5f, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x17, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e,
-	0x75, 0x42, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x34, 0x0a, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1e,
-	0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe8, 0xb7, 0xaf, 0xe5,
-	0xbe, 0x84, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0x52, 0x05,
-	0x65, 0x78, 0x69, 0x73, 0x74, 0x22, 0x77, 0x0a, 0x16, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65,
-	0x6e, 0x75, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x15, 0xba, 0x47, 0x0b,
-	0x92, 0x02, 0x08, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0x49, 0x44, 0xba, 0x48, 0x04, 0x2a, 0x02,
-	0x20, 0x00, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xba, 0x47, 0x0c, 0x92, 0x02,
-	0x09, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe5, 0x90, 0x8d, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18,
-	0x14, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x4c,
-	0x0a, 0x17, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x78, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1b, 0xba, 0x47, 0x18, 0x92, 0x02, 0x15,
-	0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe5, 0x90, 0x8d, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5,
-	0xad, 0x98, 0xe5, 0x9c, 0xa8, 0x52, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x2a, 0x62, 0x0a, 0x08,
-	0x4d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x4e, 0x55,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x44, 0x49, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x4e, 0x55, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x45,
-	0x4e, 0x55, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x55, 0x54, 0x54, 0x4f, 0x4e, 0x10, 0x03,
-	0x2a, 0x52, 0x0a, 0x09, 0x42, 0x61, 0x64, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
-	0x16, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x41, 0x44,
-	0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a,
-	0x11, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d,
-	0x41, 0x4c, 0x10, 0x02, 0x2a, 0xbf, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x64, 0x67, 0x65, 0x56, 0x61,
-	0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f,
-	0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f,
-	0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x53, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
-	0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f, 0x56, 0x41, 0x52, 0x49,
-	0x41, 0x4e, 0x54, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x49, 0x56, 0x45,
-	0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f, 0x56, 0x41, 0x52, 0x49,
-	0x41, 0x4e, 0x54, 0x53, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x03, 0x12, 0x1a,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x4b, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x15, 0xba, 0x47, 0x0b, 0x92, 0x02, 0x08, 0xe7, 0x88, 0xb6, 0xe7, 0xba, 0xa7, 0x49, 0x44,
+	0xba, 0x48, 0x04, 0x2a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x03, 0x70, 0x69, 0x64, 0x88, 0x01,
+	0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x0f, 0xba, 0x47, 0x0c, 0x92, 0x02, 0x09, 0xe8,
+	0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe6, 0xa0, 0x91, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x7b, 0x0a, 0x16, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x15, 0xba, 0x47, 0x0b, 0x92, 0x02, 0x08, 0xe8, 0x8f, 0x9c,
+	0xe5, 0x8d, 0x95, 0x49, 0x44, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x47, 0x0f, 0x92, 0x02, 0x0c, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d,
+	0x95, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xc8, 0x01, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x17,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1e, 0xba, 0x47, 0x1b, 0x92, 0x02, 0x18, 0xe8, 0x8f,
+	0x9c, 0xe5, 0x8d, 0x95, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
+	0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0x52, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x22, 0x77, 0x0a,
+	0x16, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x15, 0xba, 0x47, 0x0b, 0x92, 0x02, 0x08, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d,
+	0x95, 0x49, 0x44, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x16, 0xba, 0x47, 0x0c, 0x92, 0x02, 0x09, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe5,
+	0x90, 0x8d, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x14, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42,
+	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x17, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x6e, 0x75, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x1b, 0xba, 0x47, 0x18, 0x92, 0x02, 0x15, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0xe5, 0x90,
+	0x8d, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0x52, 0x05, 0x65,
+	0x78, 0x69, 0x73, 0x74, 0x2a, 0x62, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4d,
+	0x45, 0x4e, 0x55, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x4e, 0x55,
+	0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x42, 0x55, 0x54, 0x54, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x52, 0x0a, 0x09, 0x42, 0x61, 0x64, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x44, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x02, 0x2a, 0xbf, 0x01, 0x0a,
+	0x0d, 0x42, 0x61, 0x64, 0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1e,
+	0x0a, 0x1a, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a,
 	0x0a, 0x16, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x53,
-	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41,
-	0x44, 0x47, 0x45, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x53, 0x5f, 0x57, 0x41, 0x52,
-	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x32, 0xd3, 0x05, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4d, 0x65, 0x6e, 0x75, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e,
-	0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
-	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x22, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
-	0x6e, 0x75, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
-	0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e,
-	0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x19, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x54,
-	0x72, 0x65, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x72,
-	0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x65, 0x6e, 0x75, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x64, 0x0a, 0x0f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42,
-	0x79, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d,
-	0x65, 0x6e, 0x75, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa6, 0x01, 0x0a,
-	0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x4d, 0x65, 0x6e, 0x75, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x26, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa,
-	0x02, 0x0f, 0x43, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x41,
+	0x44, 0x47, 0x45, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x53, 0x5f, 0x44, 0x45, 0x53,
+	0x54, 0x52, 0x55, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41,
+	0x44, 0x47, 0x45, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x53, 0x5f, 0x50, 0x52, 0x49,
+	0x4d, 0x41, 0x52, 0x59, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f,
+	0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x44, 0x47, 0x45, 0x5f, 0x56, 0x41, 0x52, 0x49,
+	0x41, 0x4e, 0x54, 0x53, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x32, 0xd3,
+	0x05, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x22, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x6e, 0x75, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x1f,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x19, 0x2e,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x72, 0x65, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x27, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75,
+	0x42, 0x79, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64,
+	0x0a, 0x0f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa6, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x4d, 0x65,
+	0x6e, 0x75, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x72, 0x65, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x72, 0x65,
+	0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x43, 0x6f,
+	0x72, 0x65, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x72, 0x65,
+	0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
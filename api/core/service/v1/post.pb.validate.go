@@ -86,6 +86,18 @@ func (m *Post) validate(all bool) error {
 		// no validation rules for UpdatedAt
 	}
 
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.UpdatedBy != nil {
+		// no validation rules for UpdatedBy
+	}
+
 	if len(errors) > 0 {
 		return PostMultiError(errors)
 	}
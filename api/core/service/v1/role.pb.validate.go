@@ -102,6 +102,18 @@ func (m *Role) validate(all bool) error {
 		// no validation rules for UpdatedAt
 	}
 
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.UpdatedBy != nil {
+		// no validation rules for UpdatedBy
+	}
+
 	if len(errors) > 0 {
 		return RoleMultiError(errors)
 	}
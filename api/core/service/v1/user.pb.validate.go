@@ -114,6 +114,22 @@ func (m *User) validate(all bool) error {
 		// no validation rules for Description
 	}
 
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.UpdatedBy != nil {
+		// no validation rules for UpdatedBy
+	}
+
+	if m.DeptId != nil {
+		// no validation rules for DeptId
+	}
+
 	if len(errors) > 0 {
 		return UserMultiError(errors)
 	}
@@ -1368,6 +1384,14 @@ func (m *ListUserResponse) validate(all bool) error {
 
 	// no validation rules for Total
 
+	if m.NextCursor != nil {
+		// no validation rules for NextCursor
+	}
+
+	if m.PrevCursor != nil {
+		// no validation rules for PrevCursor
+	}
+
 	if len(errors) > 0 {
 		return ListUserResponseMultiError(errors)
 	}
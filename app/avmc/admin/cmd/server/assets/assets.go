@@ -7,3 +7,8 @@ import (
 //go:embed swagger-ui/*
 //go:embed openapi.yaml
 var OpenApiData embed.FS
+
+// I18nData 错误消息多语言资源，键为错误原因
+//
+//go:embed i18n/*.toml
+var I18nData embed.FS
@@ -24,39 +24,84 @@ import (
 
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
-	client := data.NewEntClient(confData, logger)
+	translator, err := server.NewTranslator()
+	if err != nil {
+		return nil, nil, err
+	}
+	authSecurity := data.NewAuthSecurity(logger)
+	authenticator := data.NewAuthenticator(confServer, logger, authSecurity)
+	indexer := data.NewSearchIndexer(confData, logger)
+	client := data.NewEntClient(confData, indexer, logger)
 	redisClient := data.NewRedisClient(confData, logger)
 	node := data.NewSnowflake(logger)
 	dataData, cleanup, err := data.NewData(confData, client, redisClient, node, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	authSecurity := data.NewAuthSecurity(logger)
-	authenticator := data.NewAuthenticator(confServer, logger, authSecurity)
+	authorizer, cleanup2 := data.NewAuthorizer(confServer, confData, dataData, logger)
+	responseShaper, err := server.NewResponseShaper(confServer, authorizer)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	authTokenRepo := data.NewAuthTokenRepo(dataData, authenticator, logger)
 	authRepo := data.NewAuthRepo(dataData, authTokenRepo, logger)
-	authUsecase := biz.NewAuthUsecase(logger, authRepo)
+	captchaRepo := data.NewCaptchaRepo(confServer, dataData, logger)
+	captchaUsecase := biz.NewCaptchaUsecase(captchaRepo, logger)
+	authUsecase := biz.NewAuthUsecase(logger, authRepo, captchaUsecase)
 	userRepo := data.NewUserRepo(dataData, logger)
 	userUsecase := biz.NewUserUsecase(userRepo, logger)
-	authServiceService := service.NewAuthServiceService(authUsecase, userUsecase, logger)
-	userServiceService := service.NewUserServiceService(userUsecase, logger)
+	authServiceService := service.NewAuthServiceService(authUsecase, userUsecase, captchaUsecase, logger)
+	historyRepo := data.NewHistoryRepo(dataData, logger)
+	historyShaper, err := server.NewHistoryShaper(confServer, authorizer)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	historyUsecase := biz.NewHistoryUsecase(historyRepo, historyShaper, logger)
+	userServiceService := service.NewUserServiceService(userUsecase, historyUsecase, logger)
 	deptRepo := data.NewDeptRepo(dataData, logger)
 	deptUsecase := biz.NewDeptUsecase(deptRepo, logger)
 	deptServiceService := service.NewDeptServiceService(deptUsecase, logger)
 	menuRepo := data.NewMenuRepo(dataData, logger)
 	menuUsecase := biz.NewMenuUsecase(menuRepo, logger)
-	menuServiceService := service.NewMenuServiceService(menuUsecase, logger)
+	menuServiceService := service.NewMenuServiceService(menuUsecase, historyUsecase, logger)
 	roleRepo := data.NewRoleRepo(dataData, logger)
 	roleUsecase := biz.NewRoleUsecase(roleRepo, logger)
-	roleServiceService := service.NewRoleServiceService(roleUsecase, logger)
-	grpcServer := server.NewGRPCServer(confServer, authServiceService, userServiceService, deptServiceService, menuServiceService, roleServiceService, logger)
-	authorizer := data.NewAuthorizer(confData, logger)
+	roleServiceService := service.NewRoleServiceService(roleUsecase, historyUsecase, logger)
 	postRepo := data.NewPostRepo(dataData, logger)
 	postUsecase := biz.NewPostUsecase(postRepo, logger)
 	postServiceService := service.NewPostServiceService(postUsecase, logger)
-	httpServer := server.NewHTTPServer(confServer, logger, authenticator, authorizer, authServiceService, userServiceService, deptServiceService, menuServiceService, roleServiceService, postServiceService)
-	app := newApp(logger, grpcServer, httpServer)
+	policyRepo := data.NewPolicyRepo(dataData, authorizer, logger)
+	policyUsecase := biz.NewPolicyUsecase(policyRepo, roleRepo, logger)
+	catalog, err := server.NewPermissionCatalog()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	permissionUsecase := biz.NewPermissionUsecase(catalog, menuRepo, logger)
+	policyServiceService := service.NewPolicyServiceService(policyUsecase, permissionUsecase, logger)
+	exportRepo := data.NewExportRepo(confData, dataData, logger)
+	exportShaper := server.NewExportShaper(responseShaper)
+	exportUsecase := biz.NewExportUsecase(exportRepo, exportShaper, userUsecase, roleUsecase, deptUsecase, menuUsecase, postUsecase, logger)
+	exportServiceService := service.NewExportServiceService(exportUsecase, logger)
+	searchRepo := data.NewSearchRepo(dataData, indexer, logger)
+	searchUsecase := biz.NewSearchUsecase(searchRepo, logger)
+	searchServiceService := service.NewSearchServiceService(searchUsecase, logger)
+	trashRepo := data.NewTrashRepo(confData, dataData, authorizer, logger)
+	trashUsecase := biz.NewTrashUsecase(trashRepo, logger)
+	trashServiceService := service.NewTrashServiceService(trashUsecase, logger)
+	coreAuthServiceService := service.NewCoreAuthServiceService(policyUsecase, logger)
+	grpcServer := server.NewGRPCServer(confServer, logger, translator, authenticator, authorizer, responseShaper, authServiceService, userServiceService, deptServiceService, menuServiceService, roleServiceService, postServiceService, policyServiceService, exportServiceService, searchServiceService, trashServiceService, coreAuthServiceService)
+	httpServer := server.NewHTTPServer(confServer, logger, translator, authenticator, authorizer, responseShaper, authServiceService, userServiceService, deptServiceService, menuServiceService, roleServiceService, postServiceService, policyServiceService, exportServiceService, searchServiceService, trashServiceService)
+	trashPurger := server.NewTrashPurger(trashUsecase, logger)
+	exportRunner := server.NewExportRunner(exportUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, trashPurger, exportRunner)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -4,7 +4,6 @@ import (
 	v1 "backend-service/api/avmc/admin/v1"
 	"backend-service/pkg/auth/authn"
 	"context"
-	"errors"
 	"fmt"
 
 	pbCore "backend-service/api/core/service/v1"
@@ -15,8 +14,9 @@ import (
 // 预定义错误
 var (
 	// ErrUnknown 未知错误
-	ErrUnknown           = errors.New("unknown authentication error")
-	ErrPasswordIncorrect = errors.New("auth failed: incorrect password")
+	ErrUnknown = v1.ErrorAuthFailed("未知认证错误")
+	// ErrPasswordIncorrect 用户名或密码错误，不区分用户不存在与密码错误，避免枚举账号
+	ErrPasswordIncorrect = v1.ErrorAuthFailed("用户名或密码错误")
 )
 
 // UserRepo is a Greater repo.
@@ -39,26 +39,38 @@ type AuthRepo interface {
 // 包含日志记录器
 type AuthUsecase struct {
 	repo AuthRepo
+	cuc  *CaptchaUsecase
 	log  *log.Helper
 }
 
 // NewAuthUsecase 创建新的用户业务用例实例
-// 参数：logger 日志记录器
+// 参数：logger 日志记录器，repo 认证数据仓库，cuc 验证码业务用例
 // 返回值：用户业务用例实例指针
-func NewAuthUsecase(logger log.Logger, repo AuthRepo) *AuthUsecase {
+func NewAuthUsecase(logger log.Logger, repo AuthRepo, cuc *CaptchaUsecase) *AuthUsecase {
 	return &AuthUsecase{
 		log:  log.NewHelper(logger),
 		repo: repo,
+		cuc:  cuc,
 	}
 }
 
 // Login 处理后台登录业务逻辑
-// 参数：ctx 上下文，name 用户名，password 密码
+// 参数：ctx 上下文，name 用户名，password 密码，domainID 域ID，captcha 图形验证码
 // 返回值：登录响应结构体，错误信息
-func (uc *AuthUsecase) Login(ctx context.Context, name, password string, domainID uint32) (*v1.LoginResponse, error) {
+func (uc *AuthUsecase) Login(ctx context.Context, name, password string, domainID uint32, captcha *v1.LoginCaptcha) (*v1.LoginResponse, error) {
 	// 这里实现具体的登录业务逻辑
 	uc.log.Infof("尝试登录，用户名：%s", name)
-	return uc.repo.Login(ctx, name, password, domainID)
+	if err := uc.cuc.Verify(ctx, name, captcha); err != nil {
+		uc.log.Errorf("登录验证码校验失败，用户名：%s，错误：%v", name, err)
+		return nil, err
+	}
+	resp, err := uc.repo.Login(ctx, name, password, domainID)
+	if err != nil {
+		uc.cuc.Failed(ctx, name)
+		return nil, err
+	}
+	uc.cuc.Succeeded(ctx, name)
+	return resp, nil
 }
 
 // RefreshToken 处理刷新令牌业务逻辑
@@ -2,6 +2,9 @@ package biz
 
 import (
 	"context"
+	"strconv"
+
+	v1 "backend-service/api/avmc/admin/v1"
 
 	"github.com/google/wire"
 )
@@ -14,8 +17,23 @@ var ProviderSet = wire.NewSet(
 	NewPostUsecase,
 	NewMenuUsecase,
 	NewDeptUsecase,
+	NewCaptchaUsecase,
+	NewPolicyUsecase,
+	NewPermissionUsecase,
+	NewExportUsecase,
+	NewSearchUsecase,
+	NewTrashUsecase,
+	NewHistoryUsecase,
 )
 
 type Transaction interface {
 	InTx(context.Context, func(ctx context.Context) error) error
 }
+
+// NewVersionConflictError 创建版本冲突错误，数据已被他人修改
+// 参数：latest 数据的最新版本号，写入错误元数据 version，客户端据此重新读取后再提交
+// 返回值：版本冲突错误
+func NewVersionConflictError(latest uint32) error {
+	return v1.ErrorVersionConflict("数据已被修改，最新版本为 %d", latest).
+		WithMetadata(map[string]string{"version": strconv.FormatUint(uint64(latest), 10)})
+}
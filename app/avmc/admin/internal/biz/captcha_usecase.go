@@ -0,0 +1,157 @@
+package biz
+
+import (
+	"context"
+	"net"
+	"time"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/pkg/utils/convert"
+	"backend-service/pkg/utils/ip"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// CaptchaMode 验证码校验模式
+type CaptchaMode string
+
+const (
+	// CaptchaModeAlways 始终校验验证码
+	CaptchaModeAlways CaptchaMode = "always"
+	// CaptchaModeFailure 失败次数达到阈值后校验验证码
+	CaptchaModeFailure CaptchaMode = "failure"
+)
+
+// CaptchaOptions 验证码校验策略
+type CaptchaOptions struct {
+	// Enable 是否启用
+	Enable bool
+	// Mode 校验模式
+	Mode CaptchaMode
+	// MaxFailures 失败次数阈值
+	MaxFailures int64
+	// Expires 验证码有效期
+	Expires time.Duration
+	// TrustedProxies 可信代理，按IP统计失败次数时仅采信其转发头
+	TrustedProxies []*net.IPNet
+}
+
+// CaptchaRepo 验证码数据仓库接口
+type CaptchaRepo interface {
+	// Options 获取验证码校验策略
+	Options() CaptchaOptions
+	// Generate 生成验证码，返回验证码ID与base64图片
+	Generate(context.Context) (string, string, error)
+	// Verify 校验验证码，无论成功与否验证码均失效
+	Verify(ctx context.Context, id, code string) bool
+	// Failures 获取失败次数，多个键时返回最大值
+	Failures(ctx context.Context, keys ...string) (int64, error)
+	// IncrFailures 累加失败次数
+	IncrFailures(ctx context.Context, keys ...string) error
+	// ResetFailures 重置失败次数
+	ResetFailures(ctx context.Context, keys ...string) error
+}
+
+// CaptchaUsecase 验证码业务用例结构体
+type CaptchaUsecase struct {
+	repo CaptchaRepo
+	log  *log.Helper
+}
+
+// NewCaptchaUsecase 创建新的验证码业务用例实例
+// 参数：repo 验证码数据仓库，logger 日志记录器
+// 返回值：验证码业务用例实例指针
+func NewCaptchaUsecase(repo CaptchaRepo, logger log.Logger) *CaptchaUsecase {
+	return &CaptchaUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// failureKeys 失败次数统计键，分别按客户端IP与用户名统计，客户端IP取传输层对端地址，仅可信代理的转发头被采信
+func (uc *CaptchaUsecase) failureKeys(ctx context.Context, username string) []string {
+	keys := []string{"ip:" + ip.ClientIP(ctx, uc.repo.Options().TrustedProxies)}
+	if username != "" {
+		keys = append(keys, "user:"+username)
+	}
+	return keys
+}
+
+// Generate 生成图形验证码
+// 参数：ctx 上下文，username 登录用户名（可为空）
+// 返回值：验证码响应结构体，错误信息
+func (uc *CaptchaUsecase) Generate(ctx context.Context, username string) (*v1.CaptchaResponse, error) {
+	id, image, err := uc.repo.Generate(ctx)
+	if err != nil {
+		uc.log.Errorf("生成验证码失败: %v", err)
+		return nil, v1.ErrorCacheSetError("生成验证码失败")
+	}
+	expires := time.Now().Add(uc.repo.Options().Expires)
+	return &v1.CaptchaResponse{
+		CaptchaId: id,
+		Image:     image,
+		Required:  uc.Required(ctx, username),
+		ExpiresIn: convert.TimeValueToString(&expires, time.RFC3339),
+	}, nil
+}
+
+// Required 判断当前请求是否需要校验验证码
+// 参数：ctx 上下文，username 登录用户名
+// 返回值：是否需要校验
+func (uc *CaptchaUsecase) Required(ctx context.Context, username string) bool {
+	opts := uc.repo.Options()
+	if !opts.Enable {
+		return false
+	}
+	if opts.Mode != CaptchaModeFailure {
+		return true
+	}
+	failures, err := uc.repo.Failures(ctx, uc.failureKeys(ctx, username)...)
+	if err != nil {
+		// 无法获取失败次数时按需要校验处理
+		uc.log.Errorf("获取登录失败次数失败: %v", err)
+		return true
+	}
+	return failures >= opts.MaxFailures
+}
+
+// Verify 按校验策略校验验证码
+// 参数：ctx 上下文，username 登录用户名，captcha 验证码
+// 返回值：错误信息
+func (uc *CaptchaUsecase) Verify(ctx context.Context, username string, captcha *v1.LoginCaptcha) error {
+	if !uc.Required(ctx, username) {
+		return nil
+	}
+	if captcha.GetId() == "" || captcha.GetCode() == "" {
+		return v1.ErrorAuthCaptchaRequired("请输入图形验证码")
+	}
+	if !uc.repo.Verify(ctx, captcha.GetId(), captcha.GetCode()) {
+		return v1.ErrorAuthCaptchaIncorrect("图形验证码错误或已失效")
+	}
+	return nil
+}
+
+// Failed 记录一次失败
+// 参数：ctx 上下文，username 登录用户名
+func (uc *CaptchaUsecase) Failed(ctx context.Context, username string) {
+	opts := uc.repo.Options()
+	if !opts.Enable || opts.Mode != CaptchaModeFailure {
+		return
+	}
+	if err := uc.repo.IncrFailures(ctx, uc.failureKeys(ctx, username)...); err != nil {
+		uc.log.Errorf("记录登录失败次数失败: %v", err)
+	}
+}
+
+// Succeeded 记录一次成功，重置该用户名的失败次数
+// 参数：ctx 上下文，username 登录用户名
+func (uc *CaptchaUsecase) Succeeded(ctx context.Context, username string) {
+	opts := uc.repo.Options()
+	if !opts.Enable || opts.Mode != CaptchaModeFailure || username == "" {
+		return
+	}
+	// IP 维度的失败次数不在成功时重置，避免借助单个有效账号绕过限制
+	if err := uc.repo.ResetFailures(ctx, "user:"+username); err != nil {
+		uc.log.Errorf("重置登录失败次数失败: %v", err)
+	}
+}
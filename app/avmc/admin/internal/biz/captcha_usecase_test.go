@@ -0,0 +1,128 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	v1 "backend-service/api/avmc/admin/v1"
+)
+
+// fakeCaptchaRepo 内存验证码数据仓库，失败次数按统计窗口过期
+type fakeCaptchaRepo struct {
+	options  CaptchaOptions
+	window   time.Duration
+	now      time.Time
+	answers  map[string]string
+	failures map[string]int64
+	expires  map[string]time.Time
+}
+
+func newFakeCaptchaRepo(opts CaptchaOptions, window time.Duration) *fakeCaptchaRepo {
+	return &fakeCaptchaRepo{
+		options:  opts,
+		window:   window,
+		now:      time.Now(),
+		answers:  map[string]string{},
+		failures: map[string]int64{},
+		expires:  map[string]time.Time{},
+	}
+}
+
+func (r *fakeCaptchaRepo) Options() CaptchaOptions { return r.options }
+
+func (r *fakeCaptchaRepo) Generate(context.Context) (string, string, error) {
+	id := time.Now().Format(time.RFC3339Nano)
+	r.answers[id] = "42"
+	r.expires["answer:"+id] = r.now.Add(r.options.Expires)
+	return id, "image", nil
+}
+
+func (r *fakeCaptchaRepo) Verify(_ context.Context, id, code string) bool {
+	answer, ok := r.answers[id]
+	delete(r.answers, id)
+	return ok && r.now.Before(r.expires["answer:"+id]) && answer == code
+}
+
+func (r *fakeCaptchaRepo) Failures(_ context.Context, keys ...string) (int64, error) {
+	var n int64
+	for _, key := range keys {
+		if r.now.Before(r.expires[key]) {
+			n = max(n, r.failures[key])
+		}
+	}
+	return n, nil
+}
+
+func (r *fakeCaptchaRepo) IncrFailures(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		if !r.now.Before(r.expires[key]) {
+			r.failures[key] = 0
+			r.expires[key] = r.now.Add(r.window)
+		}
+		r.failures[key]++
+	}
+	return nil
+}
+
+func (r *fakeCaptchaRepo) ResetFailures(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		delete(r.failures, key)
+		delete(r.expires, key)
+	}
+	return nil
+}
+
+func TestCaptchaFailureThreshold(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeCaptchaRepo(CaptchaOptions{Enable: true, Mode: CaptchaModeFailure, MaxFailures: 3, Expires: time.Minute}, 15*time.Minute)
+	uc := NewCaptchaUsecase(repo, log.DefaultLogger)
+
+	// 未达到阈值前不要求验证码
+	for i := 0; i < 2; i++ {
+		assert.False(t, uc.Required(ctx, "alice"))
+		assert.NoError(t, uc.Verify(ctx, "alice", nil))
+		uc.Failed(ctx, "alice")
+	}
+	assert.False(t, uc.Required(ctx, "alice"))
+	uc.Failed(ctx, "alice")
+
+	// 达到阈值后必须提供正确的验证码
+	assert.True(t, uc.Required(ctx, "alice"))
+	assert.True(t, v1.IsAuthCaptchaRequired(uc.Verify(ctx, "alice", nil)))
+	id, _, _ := repo.Generate(ctx)
+	assert.True(t, v1.IsAuthCaptchaIncorrect(uc.Verify(ctx, "alice", &v1.LoginCaptcha{Id: id, Code: "41"})))
+	// 验证码只能使用一次，答错后正确答案也失效
+	assert.True(t, v1.IsAuthCaptchaIncorrect(uc.Verify(ctx, "alice", &v1.LoginCaptcha{Id: id, Code: "42"})))
+	id, _, _ = repo.Generate(ctx)
+	assert.NoError(t, uc.Verify(ctx, "alice", &v1.LoginCaptcha{Id: id, Code: "42"}))
+
+	// 验证码过期后失效
+	id, _, _ = repo.Generate(ctx)
+	repo.now = repo.now.Add(2 * time.Minute)
+	assert.True(t, v1.IsAuthCaptchaIncorrect(uc.Verify(ctx, "alice", &v1.LoginCaptcha{Id: id, Code: "42"})))
+
+	// 登录成功只重置用户名维度，IP 维度的失败次数仍然生效
+	uc.Succeeded(ctx, "alice")
+	assert.True(t, uc.Required(ctx, "alice"))
+	assert.True(t, uc.Required(ctx, "bob"))
+
+	// 统计窗口过期后不再要求验证码
+	repo.now = repo.now.Add(15 * time.Minute)
+	assert.False(t, uc.Required(ctx, "alice"))
+	assert.False(t, uc.Required(ctx, "bob"))
+}
+
+func TestCaptchaModes(t *testing.T) {
+	ctx := context.Background()
+	disabled := NewCaptchaUsecase(newFakeCaptchaRepo(CaptchaOptions{Mode: CaptchaModeAlways}, time.Minute), log.DefaultLogger)
+	assert.False(t, disabled.Required(ctx, "alice"))
+	assert.NoError(t, disabled.Verify(ctx, "alice", nil))
+
+	always := NewCaptchaUsecase(newFakeCaptchaRepo(CaptchaOptions{Enable: true, Mode: CaptchaModeAlways, Expires: time.Minute}, time.Minute), log.DefaultLogger)
+	assert.True(t, always.Required(ctx, "alice"))
+	assert.True(t, v1.IsAuthCaptchaRequired(always.Verify(ctx, "alice", &v1.LoginCaptcha{Id: "x"})))
+}
@@ -3,24 +3,37 @@ package biz
 import (
 	"context"
 
+	v1 "backend-service/api/avmc/admin/v1"
 	pbPagination "backend-service/api/common/pagination"
 	pbCore "backend-service/api/core/service/v1"
+	"backend-service/pkg/utils/convert"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 var (
-// ErrDeptNotFound is user not found.
-// ErrDeptNotFound = errors.NotFound(v1.ErrorReason_USER_NOT_FOUND.String(), "user not found")
+	// ErrDeptNotFound 部门不存在
+	ErrDeptNotFound = v1.ErrorDeptNotFound("部门不存在")
+	// ErrDeptAlreadyExists 部门名称已存在
+	ErrDeptAlreadyExists = v1.ErrorDeptAlreadyExists("部门名称已存在")
+	// ErrDeptParentInvalid 上级部门不存在，或为部门自身及其下级部门
+	ErrDeptParentInvalid = v1.ErrorDeptParentInvalid("上级部门不存在，或为部门自身及其下级部门")
+	// ErrDeptHasChildren 存在下级部门时不允许删除
+	ErrDeptHasChildren = v1.ErrorDeptCannotDeleteWithChildren("存在子部门，不允许删除")
+	// ErrDeptHasUsers 部门下存在用户时不允许删除
+	ErrDeptHasUsers = v1.ErrorDeptCannotDeleteWithUsers("部门下存在用户，不允许删除")
 )
 
 // DeptRepo is a Greater repo.
+// 部门的祖级列表由仓库按上级部门维护
 type DeptRepo interface {
 	Save(context.Context, *pbCore.Dept) (*pbCore.Dept, error)
+	// Update 更新部门，传入上级部门时连同下级部门一起移动并重写祖级列表
 	Update(context.Context, *pbCore.Dept) (*pbCore.Dept, error)
 	FindByID(context.Context, uint32) (*pbCore.Dept, error)
 	ListAll(context.Context) ([]*pbCore.Dept, error)
 	ListPage(context.Context, *pbPagination.PagingRequest) (*pbCore.ListDeptResponse, error) // 新增的方法用于分页查询
+	// Delete 删除部门，存在下级部门或部门下存在用户时不允许删除
 	Delete(context.Context, uint32) error
 }
 
@@ -72,6 +85,36 @@ func (uc *DeptUsecase) ListSimple(ctx context.Context, pageNum, pageSize int64)
 	return uc.repo.ListAll(ctx)
 }
 
+// ListTree 处理获取部门树请求
+// 参数：ctx 上下文，parentID 上级部门ID，为 0 时返回完整部门树
+// 返回值：部门树响应，错误信息
+func (uc *DeptUsecase) ListTree(ctx context.Context, parentID uint32) (*pbCore.ListDeptTreeResponse, error) {
+	depts, err := uc.repo.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := convert.ToTree(depts, parentID, func(parent *pbCore.Dept, children ...*pbCore.Dept) error {
+		parent.Children = append(parent.Children, children...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbCore.ListDeptTreeResponse{Items: tree}, nil
+}
+
+// Move 处理移动部门请求，部门及其下级部门一起移动到新的上级部门下
+// 参数：ctx 上下文，req 移动部门请求
+// 返回值：移动后的部门信息，错误信息
+func (uc *DeptUsecase) Move(ctx context.Context, req *pbCore.MoveDeptRequest) (*pbCore.Dept, error) {
+	uc.log.WithContext(ctx).Infof("MoveDept: %v -> %v", req.GetId(), req.GetParentId())
+	_, err := uc.repo.FindByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.Update(ctx, &pbCore.Dept{Id: req.GetId(), ParentId: &req.ParentId, Version: req.Version})
+}
+
 // ListPage 处理部门分页列表请求
 // 参数：ctx 上下文，pagination 分页请求
 // 返回值：部门列表响应，错误信息
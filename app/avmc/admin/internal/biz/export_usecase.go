@@ -0,0 +1,683 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	pbPagination "backend-service/api/common/pagination"
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/pkg/auth/authn"
+	"backend-service/pkg/export"
+	"backend-service/pkg/middleware/localize"
+	"backend-service/pkg/utils/trans"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/iancoleman/strcase"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	// ErrExportResourceNotSupported 资源不支持导出
+	ErrExportResourceNotSupported = v1.ErrorExportResourceNotSupported("资源不支持导出")
+	// ErrExportFormatNotSupported 不支持的文件格式
+	ErrExportFormatNotSupported = v1.ErrorFileFormatNotSupported("不支持的文件格式")
+	// ErrExportJobNotFound 导出任务不存在或已过期
+	ErrExportJobNotFound = v1.ErrorExportJobNotFound("导出任务不存在或已过期")
+	// ErrExportJobNotReady 导出任务尚未完成
+	ErrExportJobNotReady = v1.ErrorExportJobNotReady("导出任务尚未完成")
+	// ErrExportStopped 服务正在停止，不再接受导出任务
+	ErrExportStopped = v1.ErrorServiceUnavailable("服务正在停止，请稍后重试")
+)
+
+const (
+	// exportDownloadURL 导出文件的下载链接
+	exportDownloadURL = "/admin/v1/exports/%s/file"
+	// exportJobTimeout 导出任务的最长执行时间
+	exportJobTimeout = time.Hour
+	// exportLeaseTTL 导出任务执行租约的有效期，执行期间按三分之一有效期续期
+	exportLeaseTTL = time.Second * 30
+	// exportInterruptedMessage 执行中断的导出任务的失败原因
+	exportInterruptedMessage = "服务停止，导出任务已中断"
+)
+
+// ExportOptions 数据导出配置
+type ExportOptions struct {
+	// BatchSize 每批查询的行数
+	BatchSize int
+	// SyncLimit 同步导出的最大行数
+	SyncLimit int
+	// MaxRows 导出任务的最大行数
+	MaxRows int
+	// Concurrency 同时执行的导出任务数
+	Concurrency int
+}
+
+// ExportRepo 导出任务数据仓库接口，任务与文件在保留时长后过期
+type ExportRepo interface {
+	// Options 获取导出配置
+	Options() ExportOptions
+	// NextID 生成导出任务ID
+	NextID() string
+	// SaveJob 保存导出任务
+	SaveJob(ctx context.Context, job *v1.ExportJob) error
+	// GetJob 获取导出任务，不存在时返回 ErrExportJobNotFound
+	GetJob(ctx context.Context, id string) (*v1.ExportJob, error)
+	// CreateFile 创建导出任务的文件
+	CreateFile(ctx context.Context, job *v1.ExportJob) (io.WriteCloser, error)
+	// OpenFile 打开导出任务的文件，返回文件内容与大小，不存在时返回 ErrExportJobNotFound
+	OpenFile(ctx context.Context, job *v1.ExportJob) (io.ReadCloser, int64, error)
+	// RemoveFile 删除导出任务的文件
+	RemoveFile(ctx context.Context, job *v1.ExportJob) error
+	// ListJobs 列出未过期的导出任务
+	ListJobs(ctx context.Context) ([]*v1.ExportJob, error)
+	// RenewLease 续期导出任务的执行租约，租约过期的未完成任务视为执行已中断
+	RenewLease(ctx context.Context, id string, ttl time.Duration) error
+	// HasLease 判断导出任务的执行租约是否有效
+	HasLease(ctx context.Context, id string) (bool, error)
+}
+
+// ExportShaper 导出前处理每批列表数据，使导出文件与列表接口遵循相同的字段可见性规则
+type ExportShaper func(ctx context.Context, req *pbPagination.PagingRequest, reply proto.Message) (proto.Message, error)
+
+// ExportFile 导出文件信息
+type ExportFile struct {
+	// Name 文件名
+	Name string
+	// ContentType MIME 类型
+	ContentType string
+	// Size 文件大小，同步导出时未知，为 0
+	Size int64
+}
+
+// exportResource 可导出的资源
+type exportResource struct {
+	// list 分页查询，与列表接口使用相同的过滤与排序翻译
+	list func(context.Context, *pbPagination.PagingRequest) (proto.Message, error)
+	// item 列表项的消息描述
+	item protoreflect.MessageDescriptor
+	// columns 默认导出的字段
+	columns []string
+	// extra 默认不导出但允许指定的字段
+	extra []string
+	// cursor 是否支持游标分页，支持时按游标逐批查询，避免深分页
+	cursor bool
+	// nullable 可为空的排序字段，游标分页不支持按这些字段排序，按其排序时按页码翻页
+	nullable []string
+}
+
+// allowed 判断字段是否允许导出
+func (r exportResource) allowed(name protoreflect.Name) bool {
+	for _, column := range append(r.columns, r.extra...) {
+		if column == string(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// nullableOrder 判断排序条件是否包含可为空的字段，字段名与列表接口一样不区分命名风格
+func (r exportResource) nullableOrder(orderBy []string) bool {
+	for _, term := range orderBy {
+		name := strcase.ToSnake(strings.TrimPrefix(strings.TrimSpace(term), "-"))
+		if slices.Contains(r.nullable, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// exportList 将列表用例转换为导出资源的分页查询
+func exportList[R proto.Message](list func(context.Context, *pbPagination.PagingRequest) (R, error)) func(context.Context, *pbPagination.PagingRequest) (proto.Message, error) {
+	return func(ctx context.Context, req *pbPagination.PagingRequest) (proto.Message, error) {
+		reply, err := list(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return reply, nil
+	}
+}
+
+// exportPlan 经过校验的导出请求
+type exportPlan struct {
+	name     string
+	resource exportResource
+	format   export.Format
+	paging   *pbPagination.PagingRequest
+	encoder  *export.Encoder
+	header   []string
+}
+
+// file 导出文件信息，文件名为 资源_时间.扩展名
+func (p *exportPlan) file(now time.Time) ExportFile {
+	return ExportFile{
+		Name:        fmt.Sprintf("%s_%s.%s", p.name, now.Format("20060102150405"), p.format.Extension()),
+		ContentType: p.format.ContentType(),
+	}
+}
+
+// limit 行数上限，不超过文件格式支持的行数
+func (p *exportPlan) limit(limit int) int {
+	if p.format == export.FormatXLSX && limit > export.MaxXLSXRows-1 {
+		return export.MaxXLSXRows - 1
+	}
+	return limit
+}
+
+// ExportUsecase 数据导出业务用例
+// 导出复用各资源的列表用例逐批查询并写出，不会一次加载全部数据
+// 导出任务在后台执行，随应用停止取消，由 Close 等待执行结束
+type ExportUsecase struct {
+	repo      ExportRepo
+	shaper    ExportShaper
+	resources map[string]exportResource
+	slots     chan struct{}
+	log       *log.Helper
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	mu     sync.Mutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewExportUsecase 创建数据导出业务用例
+// 参数：repo 导出任务数据仓库，shaper 字段可见性处理，uuc/ruc/duc/muc/puc 各资源的业务用例，logger 日志记录器
+// 返回值：数据导出业务用例实例指针
+func NewExportUsecase(repo ExportRepo, shaper ExportShaper,
+	uuc *UserUsecase, ruc *RoleUsecase, duc *DeptUsecase, muc *MenuUsecase, puc *PostUsecase,
+	logger log.Logger,
+) *ExportUsecase {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ExportUsecase{
+		repo:   repo,
+		shaper: shaper,
+		resources: map[string]exportResource{
+			"user": {
+				list:     exportList(uuc.ListPage),
+				item:     (&pbCore.User{}).ProtoReflect().Descriptor(),
+				columns:  []string{"id", "name", "nickname", "realname", "gender", "phone", "email", "status", "created_at", "updated_at"},
+				extra:    []string{"birthday", "avatar", "description"},
+				cursor:   true,
+				nullable: []string{"nickname", "realname", "birthday"},
+			},
+			"role": {
+				list:    exportList(ruc.ListPage),
+				item:    (&pbCore.Role{}).ProtoReflect().Descriptor(),
+				columns: []string{"id", "name", "status", "sort", "remark", "default_router", "created_at", "updated_at"},
+			},
+			"dept": {
+				list:    exportList(duc.ListPage),
+				item:    (&pbCore.Dept{}).ProtoReflect().Descriptor(),
+				columns: []string{"id", "name", "parent_id", "leader_id", "sort", "status", "remark", "created_at", "updated_at"},
+			},
+			"menu": {
+				list:    exportList(muc.ListPage),
+				item:    (&pbCore.Menu{}).ProtoReflect().Descriptor(),
+				columns: []string{"id", "name", "path", "auth_code", "component", "pid", "redirect", "type", "status", "created_at", "updated_at"},
+			},
+			"post": {
+				list:    exportList(puc.ListPage),
+				item:    (&pbCore.Post{}).ProtoReflect().Descriptor(),
+				columns: []string{"id", "name", "status", "sort", "remark", "created_at", "updated_at"},
+			},
+		},
+		slots:  make(chan struct{}, max(repo.Options().Concurrency, 1)),
+		log:    log.NewHelper(log.With(logger, "module", "export/biz")),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Export 同步导出数据，行数超过同步导出上限时返回错误
+// 参数：ctx 上下文，req 导出请求，open 校验通过后、写入数据前调用，用于设置响应头并返回输出
+// 返回值：错误信息，开始写入后的错误意味着输出不完整
+func (uc *ExportUsecase) Export(ctx context.Context, req *v1.ExportRequest, open func(ExportFile) (io.Writer, error)) error {
+	plan, err := uc.plan(ctx, req)
+	if err != nil {
+		return err
+	}
+	limit := plan.limit(uc.repo.Options().SyncLimit)
+	total, err := uc.count(ctx, plan)
+	if err != nil {
+		return err
+	}
+	if total > limit {
+		return v1.ErrorExportTooLarge("导出数据超过%d行，请创建导出任务", limit)
+	}
+	w, err := open(plan.file(time.Now()))
+	if err != nil {
+		return err
+	}
+	_, err = uc.write(ctx, plan, w, limit, nil)
+	return err
+}
+
+// CreateJob 创建导出任务，任务在后台执行
+// 参数：ctx 上下文，req 导出请求
+// 返回值：导出任务，错误信息
+func (uc *ExportUsecase) CreateJob(ctx context.Context, req *v1.ExportRequest) (*v1.ExportJob, error) {
+	plan, err := uc.plan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	limit := plan.limit(uc.repo.Options().MaxRows)
+	total, err := uc.count(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+	if total > limit {
+		return nil, v1.ErrorExportTooLarge("导出数据超过%d行，请缩小导出范围", limit)
+	}
+	if uc.stopped() {
+		return nil, ErrExportStopped
+	}
+
+	now := time.Now()
+	job := &v1.ExportJob{
+		Id:        uc.repo.NextID(),
+		Resource:  plan.name,
+		Format:    string(plan.format),
+		Status:    v1.ExportJob_STATUS_PENDING,
+		Total:     int64(total),
+		FileName:  plan.file(now).Name,
+		Creator:   exportCreator(ctx),
+		CreatedAt: now.Format(time.DateTime),
+	}
+	// 先取得执行租约再保存任务，避免其它实例启动时将等待执行的任务视为已中断
+	if err := uc.repo.RenewLease(ctx, job.Id, exportLeaseTTL); err != nil {
+		uc.log.Errorf("续期导出任务租约失败，任务ID：%s，错误：%v", job.Id, err)
+		return nil, v1.ErrorCacheSetError("保存导出任务失败")
+	}
+	if err := uc.repo.SaveJob(ctx, job); err != nil {
+		uc.log.Errorf("保存导出任务失败，任务ID：%s，错误：%v", job.Id, err)
+		return nil, v1.ErrorCacheSetError("保存导出任务失败")
+	}
+	reply := proto.Clone(job).(*v1.ExportJob)
+	// 任务在请求结束后继续执行，只保留认证信息，用于按创建人的角色应用字段可见性规则
+	claims, _ := authn.AuthClaimsFromContext(ctx)
+	if !uc.spawn(func(ctx context.Context) { uc.run(authn.ContextWithAuthClaims(ctx, claims), plan, job) }) {
+		uc.fail(context.WithoutCancel(ctx), job, exportInterruptedMessage)
+		return nil, ErrExportStopped
+	}
+	uc.log.WithContext(ctx).Infof("创建导出任务，任务ID：%s，资源：%s，行数：%d", job.Id, plan.name, total)
+	return reply, nil
+}
+
+// GetJob 获取导出任务，只能获取本人创建的任务
+// 参数：ctx 上下文，id 任务ID
+// 返回值：导出任务，错误信息
+func (uc *ExportUsecase) GetJob(ctx context.Context, id string) (*v1.ExportJob, error) {
+	job, err := uc.repo.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.GetCreator() != exportCreator(ctx) {
+		return nil, ErrExportJobNotFound
+	}
+	return job, nil
+}
+
+// OpenJob 打开已完成的导出任务的文件，只能下载本人创建的任务
+// 参数：ctx 上下文，id 任务ID
+// 返回值：文件信息，文件内容，错误信息
+func (uc *ExportUsecase) OpenJob(ctx context.Context, id string) (ExportFile, io.ReadCloser, error) {
+	job, err := uc.GetJob(ctx, id)
+	if err != nil {
+		return ExportFile{}, nil, err
+	}
+	if job.GetStatus() != v1.ExportJob_STATUS_SUCCEEDED {
+		return ExportFile{}, nil, ErrExportJobNotReady
+	}
+	rc, size, err := uc.repo.OpenFile(ctx, job)
+	if err != nil {
+		return ExportFile{}, nil, err
+	}
+	format, _ := export.ParseFormat(job.GetFormat())
+	return ExportFile{Name: job.GetFileName(), ContentType: format.ContentType(), Size: size}, rc, nil
+}
+
+// Recover 将执行已中断的导出任务标记为失败，应用启动时调用
+// 任务保存在共享缓存中，只处理执行租约已过期的任务，不影响其它实例正在执行的任务
+// 参数：ctx 上下文
+// 返回值：标记为失败的任务数，错误信息
+func (uc *ExportUsecase) Recover(ctx context.Context) (int, error) {
+	jobs, err := uc.repo.ListJobs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, job := range jobs {
+		if job.GetStatus() != v1.ExportJob_STATUS_PENDING && job.GetStatus() != v1.ExportJob_STATUS_RUNNING {
+			continue
+		}
+		alive, err := uc.repo.HasLease(ctx, job.GetId())
+		if err != nil {
+			return n, err
+		}
+		if alive {
+			continue
+		}
+		uc.fail(ctx, job, exportInterruptedMessage)
+		n++
+	}
+	return n, nil
+}
+
+// Close 停止接受导出任务，取消执行中的任务并等待其结束
+// 参数：ctx 上下文，超时后不再等待
+// 返回值：错误信息
+func (uc *ExportUsecase) Close(ctx context.Context) error {
+	uc.mu.Lock()
+	uc.closed = true
+	uc.mu.Unlock()
+	uc.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		uc.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// stopped 判断是否已停止接受导出任务
+func (uc *ExportUsecase) stopped() bool {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return uc.closed
+}
+
+// spawn 在后台执行导出任务，已停止接受导出任务时返回 false
+func (uc *ExportUsecase) spawn(fn func(context.Context)) bool {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if uc.closed {
+		return false
+	}
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		fn(uc.ctx)
+	}()
+	return true
+}
+
+// run 执行导出任务，同时执行的任务数受 Concurrency 限制
+// 执行期间持续续期执行租约；应用停止时任务被取消，状态仍会保存为失败
+func (uc *ExportUsecase) run(ctx context.Context, plan *exportPlan, job *v1.ExportJob) {
+	// 任务取消后仍需保存最终状态
+	saveCtx := context.WithoutCancel(ctx)
+	stop := uc.keepLease(ctx, job.Id)
+	defer stop()
+
+	select {
+	case uc.slots <- struct{}{}:
+	case <-ctx.Done():
+		uc.fail(saveCtx, job, exportInterruptedMessage)
+		return
+	}
+	defer func() { <-uc.slots }()
+
+	job.Status = v1.ExportJob_STATUS_RUNNING
+	uc.save(ctx, job)
+
+	runCtx, cancel := context.WithTimeout(ctx, exportJobTimeout)
+	err := uc.runJob(runCtx, plan, job)
+	cancel()
+
+	if err != nil {
+		uc.log.Errorf("导出任务失败，任务ID：%s，错误：%v", job.Id, err)
+		message := exportErrorMessage(err)
+		if ctx.Err() != nil {
+			message = exportInterruptedMessage
+		}
+		uc.fail(saveCtx, job, message)
+		return
+	}
+	job.FinishedAt = trans.String(time.Now().Format(time.DateTime))
+	job.Status = v1.ExportJob_STATUS_SUCCEEDED
+	job.DownloadUrl = trans.String(fmt.Sprintf(exportDownloadURL, job.Id))
+	uc.save(saveCtx, job)
+}
+
+// keepLease 定期续期导出任务的执行租约，直到任务结束或被取消，返回停止续期的函数
+func (uc *ExportUsecase) keepLease(ctx context.Context, id string) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(exportLeaseTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := uc.repo.RenewLease(ctx, id, exportLeaseTTL); err != nil && ctx.Err() == nil {
+					uc.log.Errorf("续期导出任务租约失败，任务ID：%s，错误：%v", id, err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// fail 将导出任务标记为失败并删除已写出的文件
+func (uc *ExportUsecase) fail(ctx context.Context, job *v1.ExportJob, message string) {
+	if err := uc.repo.RemoveFile(ctx, job); err != nil {
+		uc.log.Errorf("删除导出文件失败，任务ID：%s，错误：%v", job.Id, err)
+	}
+	job.Status = v1.ExportJob_STATUS_FAILED
+	job.Error = trans.String(message)
+	job.FinishedAt = trans.String(time.Now().Format(time.DateTime))
+	uc.save(ctx, job)
+}
+
+// runJob 写出导出任务的文件，每批写入后更新进度
+func (uc *ExportUsecase) runJob(ctx context.Context, plan *exportPlan, job *v1.ExportJob) error {
+	f, err := uc.repo.CreateFile(ctx, job)
+	if err != nil {
+		return err
+	}
+	_, err = uc.write(ctx, plan, f, plan.limit(uc.repo.Options().MaxRows), func(rows int) {
+		job.Rows = int64(rows)
+		uc.save(ctx, job)
+	})
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// save 保存导出任务状态，失败时只记录日志
+func (uc *ExportUsecase) save(ctx context.Context, job *v1.ExportJob) {
+	if err := uc.repo.SaveJob(ctx, job); err != nil {
+		uc.log.Errorf("保存导出任务失败，任务ID：%s，错误：%v", job.Id, err)
+	}
+}
+
+// plan 校验导出请求并确定导出的字段与表头
+func (uc *ExportUsecase) plan(ctx context.Context, req *v1.ExportRequest) (*exportPlan, error) {
+	name := strings.ToLower(strings.TrimSpace(req.GetResource()))
+	resource, ok := uc.resources[name]
+	if !ok {
+		return nil, ErrExportResourceNotSupported
+	}
+	format, err := export.ParseFormat(req.GetFormat())
+	if err != nil {
+		return nil, ErrExportFormatNotSupported
+	}
+
+	columns := req.GetFields()
+	if len(columns) == 0 {
+		columns = resource.columns
+	}
+	// 表头与枚举值按请求的语言翻译，导出任务在请求结束后执行，这里直接持有本地化器
+	localizer := localize.FromContext(ctx)
+	encoder, err := export.NewEncoder(resource.item, columns, export.WithEnumFormatter(func(v protoreflect.EnumValueDescriptor) string {
+		return localizeMessage(localizer, string(v.Parent().FullName())+"."+string(v.Name()), string(v.Name()))
+	}))
+	if err != nil {
+		return nil, v1.ErrorExportFieldNotSupported("导出字段不存在：%v", columns)
+	}
+	header := make([]string, 0, len(columns))
+	for _, fd := range encoder.Fields() {
+		if !resource.allowed(fd.Name()) {
+			return nil, v1.ErrorExportFieldNotSupported("字段 %s 不允许导出", fd.Name())
+		}
+		header = append(header, localizeMessage(localizer, "export."+name+"."+string(fd.Name()), string(fd.Name())))
+	}
+
+	return &exportPlan{
+		name:     name,
+		resource: resource,
+		format:   format,
+		paging: &pbPagination.PagingRequest{
+			Query:   req.Query,
+			OrQuery: req.OrQuery,
+			OrderBy: req.GetOrderBy(),
+		},
+		encoder: encoder,
+		header:  header,
+	}, nil
+}
+
+// count 统计导出请求匹配的行数
+func (uc *ExportUsecase) count(ctx context.Context, plan *exportPlan) (int, error) {
+	req := proto.Clone(plan.paging).(*pbPagination.PagingRequest)
+	req.Page, req.PageSize = trans.Int32(1), trans.Int32(1)
+	reply, err := plan.resource.list(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	m := reply.ProtoReflect()
+	return int(m.Get(m.Descriptor().Fields().ByName("total")).Int()), nil
+}
+
+// write 写出表头与全部数据，progress 在每批写入后以已写入的行数调用
+func (uc *ExportUsecase) write(ctx context.Context, plan *exportPlan, w io.Writer, limit int, progress func(int)) (int, error) {
+	ew, err := export.NewWriter(w, plan.format)
+	if err != nil {
+		return 0, err
+	}
+	if err := ew.Write(plan.header); err != nil {
+		return 0, err
+	}
+	rows := 0
+	err = uc.each(ctx, plan, func(items protoreflect.List) error {
+		for i := 0; i < items.Len(); i++ {
+			// 统计行数后数据仍可能增加
+			if rows >= limit {
+				return v1.ErrorExportTooLarge("导出数据超过%d行", limit)
+			}
+			if err := ew.Write(plan.encoder.Encode(items.Get(i).Message())); err != nil {
+				return err
+			}
+			rows++
+		}
+		if progress != nil {
+			progress(rows)
+		}
+		return nil
+	})
+	if err != nil {
+		return rows, err
+	}
+	return rows, ew.Close()
+}
+
+// each 逐批查询导出数据
+// 支持游标分页、排序字段不超过一个且不可为空时按游标翻页，否则按页码翻页；排序均以唯一键补充，批次之间不会重复或遗漏
+func (uc *ExportUsecase) each(ctx context.Context, plan *exportPlan, fn func(protoreflect.List) error) error {
+	size := uc.repo.Options().BatchSize
+	req := proto.Clone(plan.paging).(*pbPagination.PagingRequest)
+	req.PageSize = trans.Int32(int32(size))
+
+	terms := 0
+	for _, term := range req.GetOrderBy() {
+		if strings.TrimSpace(term) != "" {
+			terms++
+		}
+	}
+	cursor := plan.resource.cursor && terms <= 1 && !plan.resource.nullableOrder(req.GetOrderBy())
+	if cursor {
+		req.UseCursor = trans.Bool(true)
+	}
+
+	for page := int32(1); ; page++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !cursor {
+			req.Page = trans.Int32(page)
+		}
+		reply, err := plan.resource.list(ctx, req)
+		if err != nil {
+			return err
+		}
+		if uc.shaper != nil {
+			if reply, err = uc.shaper(ctx, req, reply); err != nil {
+				return err
+			}
+		}
+		m := reply.ProtoReflect()
+		fields := m.Descriptor().Fields()
+		items := m.Get(fields.ByName("items")).List()
+		if err := fn(items); err != nil {
+			return err
+		}
+
+		if cursor {
+			next := m.Get(fields.ByName("next_cursor")).String()
+			if next == "" {
+				return nil
+			}
+			req.Cursor = trans.String(next)
+		} else if items.Len() < size {
+			return nil
+		}
+	}
+}
+
+// exportCreator 当前用户，作为导出任务的创建人
+func exportCreator(ctx context.Context) string {
+	claims, _ := authn.AuthClaimsFromContext(ctx)
+	return claims.GetSubject()
+}
+
+// exportErrorMessage 导出任务的失败原因，非业务错误不暴露细节
+func exportErrorMessage(err error) string {
+	if se := new(errors.Error); errors.As(err, &se) && se.Code < 500 {
+		return se.Message
+	}
+	return "导出失败，请稍后重试"
+}
+
+// localizeMessage 翻译消息，本地化器为空或未找到消息时返回 fallback
+func localizeMessage(localizer *i18n.Localizer, id, fallback string) string {
+	if localizer == nil {
+		return fallback
+	}
+	message, err := localizer.Localize(&i18n.LocalizeConfig{MessageID: id})
+	if err != nil || message == "" {
+		return fallback
+	}
+	return message
+}
@@ -0,0 +1,180 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	pbPagination "backend-service/api/common/pagination"
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/pkg/auth/authn"
+)
+
+// fakeExportRepo 内存导出任务数据仓库
+type fakeExportRepo struct {
+	mu     sync.Mutex
+	seq    int
+	jobs   map[string]*v1.ExportJob
+	leases map[string]bool
+}
+
+func newFakeExportRepo() *fakeExportRepo {
+	return &fakeExportRepo{jobs: map[string]*v1.ExportJob{}, leases: map[string]bool{}}
+}
+
+func (r *fakeExportRepo) Options() ExportOptions {
+	return ExportOptions{BatchSize: 10, SyncLimit: 10, MaxRows: 100, Concurrency: 1}
+}
+
+func (r *fakeExportRepo) NextID() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	return strconv.Itoa(r.seq)
+}
+
+func (r *fakeExportRepo) SaveJob(_ context.Context, job *v1.ExportJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs[job.GetId()] = proto.Clone(job).(*v1.ExportJob)
+	return nil
+}
+
+func (r *fakeExportRepo) GetJob(_ context.Context, id string) (*v1.ExportJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, ErrExportJobNotFound
+	}
+	return proto.Clone(job).(*v1.ExportJob), nil
+}
+
+func (r *fakeExportRepo) CreateFile(context.Context, *v1.ExportJob) (io.WriteCloser, error) {
+	return nopWriteCloser{io.Discard}, nil
+}
+
+func (r *fakeExportRepo) OpenFile(context.Context, *v1.ExportJob) (io.ReadCloser, int64, error) {
+	return nil, 0, ErrExportJobNotFound
+}
+
+func (r *fakeExportRepo) RemoveFile(context.Context, *v1.ExportJob) error { return nil }
+
+func (r *fakeExportRepo) ListJobs(context.Context) ([]*v1.ExportJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	jobs := make([]*v1.ExportJob, 0, len(r.jobs))
+	for _, job := range r.jobs {
+		jobs = append(jobs, proto.Clone(job).(*v1.ExportJob))
+	}
+	return jobs, nil
+}
+
+func (r *fakeExportRepo) RenewLease(_ context.Context, id string, _ time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.leases[id] = true
+	return nil
+}
+
+func (r *fakeExportRepo) HasLease(_ context.Context, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.leases[id], nil
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
+
+func TestExportRecover(t *testing.T) {
+	repo := newFakeExportRepo()
+	uc := NewExportUsecase(repo, nil, nil, nil, nil, nil, nil, log.DefaultLogger)
+	ctx := context.Background()
+
+	for id, status := range map[string]v1.ExportJob_Status{
+		"pending":   v1.ExportJob_STATUS_PENDING,
+		"running":   v1.ExportJob_STATUS_RUNNING,
+		"alive":     v1.ExportJob_STATUS_RUNNING,
+		"succeeded": v1.ExportJob_STATUS_SUCCEEDED,
+	} {
+		require.NoError(t, repo.SaveJob(ctx, &v1.ExportJob{Id: id, Status: status}))
+	}
+	// 其它实例正在执行的任务持有租约
+	require.NoError(t, repo.RenewLease(ctx, "alive", exportLeaseTTL))
+
+	n, err := uc.Recover(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	for id, status := range map[string]v1.ExportJob_Status{
+		"pending":   v1.ExportJob_STATUS_FAILED,
+		"running":   v1.ExportJob_STATUS_FAILED,
+		"alive":     v1.ExportJob_STATUS_RUNNING,
+		"succeeded": v1.ExportJob_STATUS_SUCCEEDED,
+	} {
+		job, err := repo.GetJob(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, status, job.GetStatus(), id)
+	}
+	job, _ := repo.GetJob(ctx, "running")
+	assert.Equal(t, exportInterruptedMessage, job.GetError())
+	assert.NotEmpty(t, job.GetFinishedAt())
+}
+
+func TestExportClose(t *testing.T) {
+	repo := newFakeExportRepo()
+	uc := NewExportUsecase(repo, nil, nil, nil, nil, nil, nil, log.DefaultLogger)
+	// 统计行数后，逐批查询阻塞到任务被取消
+	uc.resources = map[string]exportResource{
+		"user": {
+			list: func(ctx context.Context, req *pbPagination.PagingRequest) (proto.Message, error) {
+				if req.GetPageSize() == 1 {
+					return &pbCore.ListUserResponse{Total: 1}, nil
+				}
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+			item:    (&pbCore.User{}).ProtoReflect().Descriptor(),
+			columns: []string{"id", "name"},
+		},
+	}
+	ctx := authn.ContextWithAuthClaims(context.Background(), &authn.AuthClaims{"sub": "1"})
+
+	job, err := uc.CreateJob(ctx, &v1.ExportRequest{Resource: "user", Format: "csv"})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		got, _ := repo.GetJob(ctx, job.GetId())
+		return got.GetStatus() == v1.ExportJob_STATUS_RUNNING
+	}, time.Second, time.Millisecond*10)
+
+	closeCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, uc.Close(closeCtx))
+
+	// Close 返回时任务已结束，状态保存为失败
+	got, err := repo.GetJob(ctx, job.GetId())
+	require.NoError(t, err)
+	assert.Equal(t, v1.ExportJob_STATUS_FAILED, got.GetStatus())
+	assert.Equal(t, exportInterruptedMessage, got.GetError())
+
+	_, err = uc.CreateJob(ctx, &v1.ExportRequest{Resource: "user", Format: "csv"})
+	assert.ErrorIs(t, err, ErrExportStopped)
+}
+
+func TestExportNullableOrder(t *testing.T) {
+	r := exportResource{cursor: true, nullable: []string{"birthday"}}
+	assert.False(t, r.nullableOrder(nil))
+	assert.False(t, r.nullableOrder([]string{"-created_at"}))
+	assert.True(t, r.nullableOrder([]string{"-birthday"}))
+	assert.True(t, r.nullableOrder([]string{" Birthday "}))
+}
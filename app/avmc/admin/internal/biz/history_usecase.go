@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/pkg/utils/pagination"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// HistoryResourceUser 用户
+	HistoryResourceUser = "user"
+	// HistoryResourceRole 角色
+	HistoryResourceRole = "role"
+	// HistoryResourceMenu 菜单
+	HistoryResourceMenu = "menu"
+)
+
+// HistoryRepo 实体变更历史数据仓库接口，变更历史由数据层的钩子写入
+type HistoryRepo interface {
+	// List 分页查询实体的变更记录，按变更时间倒序排列，before 非空时只查询该时间点及之前的记录，返回数据与总数
+	List(ctx context.Context, resource string, id uint32, before *time.Time, offset, limit int) ([]*v1.HistoryRecord, int, error)
+	// Snapshot 查询实体在时间点的数据快照，该时间点数据不存在时返回空
+	Snapshot(ctx context.Context, resource string, id uint32, at time.Time) (*structpb.Struct, error)
+}
+
+// historyMessages 各资源对应的消息，变更历史按消息的字段可见性规则处理
+var historyMessages = map[string]protoreflect.FullName{
+	HistoryResourceUser: (&pbCore.User{}).ProtoReflect().Descriptor().FullName(),
+	HistoryResourceRole: (&pbCore.Role{}).ProtoReflect().Descriptor().FullName(),
+	HistoryResourceMenu: (&pbCore.Menu{}).ProtoReflect().Descriptor().FullName(),
+}
+
+// HistoryShaper 按字段可见性规则处理变更历史中的字段值，使变更历史与详情接口遵循相同的规则
+// 按消息全名返回单个字段值的处理函数，处理函数返回 false 表示隐藏该字段
+type HistoryShaper func(ctx context.Context, message protoreflect.FullName) func(field string, value *structpb.Value) (*structpb.Value, bool)
+
+// HistoryUsecase 实体变更历史业务用例
+type HistoryUsecase struct {
+	repo   HistoryRepo
+	shaper HistoryShaper
+	log    *log.Helper
+}
+
+// NewHistoryUsecase 创建实体变更历史业务用例
+// 参数：repo 变更历史数据仓库，shaper 字段可见性处理，logger 日志记录器
+// 返回值：变更历史业务用例实例指针
+func NewHistoryUsecase(repo HistoryRepo, shaper HistoryShaper, logger log.Logger) *HistoryUsecase {
+	return &HistoryUsecase{repo: repo, shaper: shaper, log: log.NewHelper(log.With(logger, "module", "history/biz"))}
+}
+
+// List 分页查询实体的变更历史，传入时间点时同时返回该时间点的数据快照
+// 参数：ctx 上下文，resource 资源名称，req 查询变更历史请求
+// 返回值：查询变更历史响应，错误信息
+func (uc *HistoryUsecase) List(ctx context.Context, resource string, req *v1.ListHistoryRequest) (*v1.ListHistoryResponse, error) {
+	if req.GetId() == 0 {
+		return nil, v1.ErrorBadRequest("实体ID不能为空")
+	}
+	var before *time.Time
+	if asOf := strings.TrimSpace(req.GetAsOf()); asOf != "" {
+		at, err := time.ParseInLocation(time.DateTime, asOf, time.Local)
+		if err != nil {
+			return nil, v1.ErrorBadRequest("时间点格式错误，应为 %s", time.DateTime)
+		}
+		before = &at
+	}
+	page, size := req.GetPage(), req.GetPageSize()
+	if page <= 0 {
+		page = pagination.PAGE
+	}
+	if size <= 0 {
+		size = pagination.PAGE_SIZE
+	}
+	size = min(size, pagination.MAX_PAGE_SIZE)
+	items, total, err := uc.repo.List(ctx, resource, req.GetId(), before, pagination.GetPageOffset(page, size), int(size))
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListHistoryResponse{Items: items, Total: int32(total)}
+	if before != nil {
+		if resp.Snapshot, err = uc.repo.Snapshot(ctx, resource, req.GetId(), *before); err != nil {
+			return nil, err
+		}
+	}
+	uc.shape(ctx, resource, resp)
+	return resp, nil
+}
+
+// shape 按字段可见性规则处理字段变更与快照，隐藏的字段从快照中移除，其字段变更只保留字段名
+func (uc *HistoryUsecase) shape(ctx context.Context, resource string, resp *v1.ListHistoryResponse) {
+	message, ok := historyMessages[resource]
+	if uc.shaper == nil || !ok {
+		return
+	}
+	shape := uc.shaper(ctx, message)
+	value := func(field string, v *structpb.Value) *structpb.Value {
+		if v, ok := shape(field, v); ok {
+			return v
+		}
+		return nil
+	}
+	for _, item := range resp.GetItems() {
+		for _, change := range item.GetChanges() {
+			change.OldValue = value(change.GetField(), change.GetOldValue())
+			change.NewValue = value(change.GetField(), change.GetNewValue())
+		}
+	}
+	if resp.Snapshot == nil {
+		return
+	}
+	for name, v := range resp.Snapshot.GetFields() {
+		if v, ok := shape(name, v); ok {
+			resp.Snapshot.Fields[name] = v
+		} else {
+			delete(resp.Snapshot.Fields, name)
+		}
+	}
+}
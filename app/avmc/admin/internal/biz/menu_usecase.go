@@ -3,6 +3,7 @@ package biz
 import (
 	"context"
 
+	v1 "backend-service/api/avmc/admin/v1"
 	pbPagination "backend-service/api/common/pagination"
 	pbCore "backend-service/api/core/service/v1"
 
@@ -10,8 +11,12 @@ import (
 )
 
 var (
-// ErrMenuNotFound is user not found.
-// ErrMenuNotFound = errors.NotFound(v1.ErrorReason_USER_NOT_FOUND.String(), "user not found")
+	// ErrMenuNotFound 菜单不存在
+	ErrMenuNotFound = v1.ErrorMenuNotFound("菜单不存在")
+	// ErrMenuNameAlreadyExists 菜单名称已存在
+	ErrMenuNameAlreadyExists = v1.ErrorMenuNameAlreadyExists("菜单名称已存在")
+	// ErrMenuCannotDeleteWithChildren 存在子菜单时不允许删除
+	ErrMenuCannotDeleteWithChildren = v1.ErrorMenuCannotDeleteWithChildren("存在子菜单，不允许删除")
 )
 
 // MenuRepo is a Greater repo.
@@ -0,0 +1,82 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/pkg/auth/authz/catalog"
+	"backend-service/pkg/utils/pagination"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PermissionFilter 权限目录过滤条件，空字段表示不限制
+type PermissionFilter struct {
+	Tag string
+	// Keyword 匹配操作名、摘要与路由路径，不区分大小写
+	Keyword string
+}
+
+// match 判断权限项是否匹配过滤条件
+func (f PermissionFilter) match(p catalog.Permission) bool {
+	if f.Tag != "" && !slices.Contains(p.Tags, f.Tag) {
+		return false
+	}
+	if f.Keyword == "" {
+		return true
+	}
+	keyword := strings.ToLower(f.Keyword)
+	if strings.Contains(strings.ToLower(p.Operation), keyword) || strings.Contains(strings.ToLower(p.Summary), keyword) {
+		return true
+	}
+	return slices.ContainsFunc(p.Routes, func(r catalog.Route) bool { return strings.Contains(strings.ToLower(r.Path), keyword) })
+}
+
+// PermissionUsecase 权限目录业务用例结构体
+type PermissionUsecase struct {
+	catalog  *catalog.Catalog
+	menuRepo MenuRepo
+	log      *log.Helper
+}
+
+// NewPermissionUsecase 创建新的权限目录业务用例实例
+// 参数：catalog 启动时由已注册接口生成的权限目录，menuRepo 菜单仓库，logger 日志记录器
+// 返回值：权限目录业务用例实例指针
+func NewPermissionUsecase(catalog *catalog.Catalog, menuRepo MenuRepo, logger log.Logger) *PermissionUsecase {
+	return &PermissionUsecase{catalog: catalog, menuRepo: menuRepo, log: log.NewHelper(logger)}
+}
+
+// List 按条件分页查询权限目录
+// 参数：filter 过滤条件，page 页码（为 0 时不分页），pageSize 每页行数
+// 返回值：当前页权限项，满足条件的总数
+func (uc *PermissionUsecase) List(filter PermissionFilter, page, pageSize int) ([]catalog.Permission, int) {
+	var permissions []catalog.Permission
+	for _, p := range uc.catalog.Permissions() {
+		if filter.match(p) {
+			permissions = append(permissions, p)
+		}
+	}
+	return pagination.Slice(permissions, page, pageSize), len(permissions)
+}
+
+// ListStaleMenus 查询路径不在权限目录中的按钮菜单
+// 参数：ctx 上下文
+// 返回值：失效的按钮菜单，错误信息
+func (uc *PermissionUsecase) ListStaleMenus(ctx context.Context) ([]*pbCore.Menu, error) {
+	menus, err := uc.menuRepo.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var stale []*pbCore.Menu
+	for _, m := range menus {
+		if m.GetType() == int32(pbCore.MenuType_MENU_TYPE_BUTTON) && m.GetPath() != "" && !uc.catalog.Contains(m.GetPath()) {
+			stale = append(stale, m)
+		}
+	}
+	if len(stale) > 0 {
+		uc.log.WithContext(ctx).Warnf("ListStaleMenus: %d button menus reference unknown operations", len(stale))
+	}
+	return stale, nil
+}
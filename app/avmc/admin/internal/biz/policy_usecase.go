@@ -0,0 +1,292 @@
+package biz
+
+import (
+	"context"
+	"slices"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/pkg/auth/authz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrPolicyNotSupported 当前授权引擎不支持策略管理
+	ErrPolicyNotSupported = v1.ErrorPolicyNotSupported("当前授权引擎不支持策略管理")
+)
+
+// PolicyRepo 策略数据仓库接口，管理授权引擎中的策略（p 规则）与角色绑定（g 规则）
+type PolicyRepo interface {
+	// ListPolicies 按条件分页获取策略，page 为 0 时返回全部，同时返回满足条件的总数
+	ListPolicies(ctx context.Context, filter PolicyFilter, page, pageSize int) ([]authz.Policy, int, error)
+	// ListRoleBindings 按条件分页获取角色绑定，page 为 0 时返回全部，同时返回满足条件的总数
+	ListRoleBindings(ctx context.Context, filter RoleBindingFilter, page, pageSize int) ([]authz.RoleBinding, int, error)
+	// Apply 应用策略差异，任一步骤失败时整体回滚
+	Apply(ctx context.Context, diff *PolicyDiff) error
+	// Explain 绕过决策缓存执行授权检查并返回决策依据
+	Explain(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (*authz.Explanation, error)
+	// Enforce 执行授权检查，未授权时不返回错误
+	Enforce(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (bool, error)
+}
+
+// PolicyFilter 策略过滤条件，空字段表示不限制
+type PolicyFilter struct {
+	Domain  authz.Domain
+	Subject authz.Subject
+	// Object 以 * 结尾时按前缀匹配
+	Object authz.Object
+	Action authz.Action
+	Effect authz.Effect
+}
+
+// RoleBindingFilter 角色绑定过滤条件，空字段表示不限制
+type RoleBindingFilter struct {
+	Domain authz.Domain
+	User   authz.Subject
+	Role   authz.Subject
+}
+
+// PolicyDiff 策略差异
+type PolicyDiff struct {
+	AddedPolicies       []authz.Policy
+	RemovedPolicies     []authz.Policy
+	AddedRoleBindings   []authz.RoleBinding
+	RemovedRoleBindings []authz.RoleBinding
+}
+
+// Empty 是否无差异
+func (d *PolicyDiff) Empty() bool {
+	return len(d.AddedPolicies) == 0 && len(d.RemovedPolicies) == 0 &&
+		len(d.AddedRoleBindings) == 0 && len(d.RemovedRoleBindings) == 0
+}
+
+// dataScopeRank 数据范围从小到大的排序（0：未指定 2：本人 3：本部门 5：自定部门 4：本部门及以下 1：全部）
+var dataScopeRank = map[int32]int{0: 0, 2: 1, 3: 2, 5: 3, 4: 4, 1: 5}
+
+// PolicyExplanation 授权决策解释，附带角色链中的角色数据与生效的数据范围
+type PolicyExplanation struct {
+	*authz.Explanation
+	// Roles 角色链中的角色，与角色数据同名时附带角色数据
+	Roles []ExplainedRole
+	// DataScope 生效的数据范围，取角色链中范围最大者
+	DataScope int32
+}
+
+// ExplainedRole 角色链中的角色
+type ExplainedRole struct {
+	Name authz.Subject
+	// Role 同名的角色数据，未找到时为 nil
+	Role *pbCore.Role
+}
+
+// PolicyUsecase 策略管理业务用例结构体
+type PolicyUsecase struct {
+	repo     PolicyRepo
+	roleRepo RoleRepo
+	log      *log.Helper
+}
+
+// NewPolicyUsecase 创建新的策略管理业务用例实例
+// 参数：repo 策略数据仓库，roleRepo 角色数据仓库，logger 日志记录器
+// 返回值：策略管理业务用例实例指针
+func NewPolicyUsecase(repo PolicyRepo, roleRepo RoleRepo, logger log.Logger) *PolicyUsecase {
+	return &PolicyUsecase{repo: repo, roleRepo: roleRepo, log: log.NewHelper(logger)}
+}
+
+// ListPolicies 按条件分页查询策略
+// 参数：ctx 上下文，filter 过滤条件，page 页码（为 0 时不分页），pageSize 每页行数
+// 返回值：当前页策略，满足条件的总数，错误信息
+func (uc *PolicyUsecase) ListPolicies(ctx context.Context, filter PolicyFilter, page, pageSize int) ([]authz.Policy, int, error) {
+	return uc.repo.ListPolicies(ctx, filter, page, pageSize)
+}
+
+// ListRoleBindings 按条件分页查询角色绑定
+// 参数：ctx 上下文，filter 过滤条件，page 页码（为 0 时不分页），pageSize 每页行数
+// 返回值：当前页角色绑定，满足条件的总数，错误信息
+func (uc *PolicyUsecase) ListRoleBindings(ctx context.Context, filter RoleBindingFilter, page, pageSize int) ([]authz.RoleBinding, int, error) {
+	return uc.repo.ListRoleBindings(ctx, filter, page, pageSize)
+}
+
+// Add 添加策略与角色绑定
+// 参数：ctx 上下文，policies 策略，bindings 角色绑定
+// 返回值：错误信息
+func (uc *PolicyUsecase) Add(ctx context.Context, policies []authz.Policy, bindings []authz.RoleBinding) error {
+	if err := validatePolicies(policies, bindings); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("AddPolicy: %d policies, %d role bindings", len(policies), len(bindings))
+	return uc.repo.Apply(ctx, &PolicyDiff{AddedPolicies: normalizePolicies(policies), AddedRoleBindings: bindings})
+}
+
+// Remove 移除策略与角色绑定
+// 参数：ctx 上下文，policies 策略，bindings 角色绑定
+// 返回值：错误信息
+func (uc *PolicyUsecase) Remove(ctx context.Context, policies []authz.Policy, bindings []authz.RoleBinding) error {
+	if err := validatePolicies(policies, bindings); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("RemovePolicy: %d policies, %d role bindings", len(policies), len(bindings))
+	return uc.repo.Apply(ctx, &PolicyDiff{RemovedPolicies: normalizePolicies(policies), RemovedRoleBindings: bindings})
+}
+
+// Import 导入 casbin CSV 格式的策略
+// 参数：ctx 上下文，data CSV 内容，domain 导入范围（非空时只允许该域的规则），replace 是否移除范围内未出现在 CSV 中的规则，dryRun 是否仅计算差异
+// 返回值：策略差异，错误信息
+func (uc *PolicyUsecase) Import(ctx context.Context, data []byte, domain authz.Domain, replace, dryRun bool) (*PolicyDiff, error) {
+	policies, bindings, err := authz.ParseCSV(data)
+	if err != nil {
+		return nil, v1.ErrorPolicyInvalid("策略格式无效：%s", err.Error())
+	}
+	if domain != "" {
+		for _, p := range policies {
+			if p.Domain != domain {
+				return nil, v1.ErrorPolicyInvalid("策略 %s, %s, %s 不属于域 %s", p.Subject, p.Object, p.Action, domain)
+			}
+		}
+		for _, b := range bindings {
+			if b.Domain != domain {
+				return nil, v1.ErrorPolicyInvalid("角色绑定 %s, %s 不属于域 %s", b.User, b.Role, domain)
+			}
+		}
+	}
+
+	currentPolicies, _, err := uc.repo.ListPolicies(ctx, PolicyFilter{Domain: domain}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	currentBindings, _, err := uc.repo.ListRoleBindings(ctx, RoleBindingFilter{Domain: domain}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	diff := &PolicyDiff{
+		AddedPolicies:     difference(policies, currentPolicies),
+		AddedRoleBindings: difference(bindings, currentBindings),
+	}
+	if replace {
+		diff.RemovedPolicies = difference(currentPolicies, policies)
+		diff.RemovedRoleBindings = difference(currentBindings, bindings)
+	}
+
+	uc.log.WithContext(ctx).Infof("ImportPolicy: domain=%q replace=%v dryRun=%v +%d/-%d policies +%d/-%d role bindings",
+		domain, replace, dryRun,
+		len(diff.AddedPolicies), len(diff.RemovedPolicies), len(diff.AddedRoleBindings), len(diff.RemovedRoleBindings))
+	if dryRun || diff.Empty() {
+		return diff, nil
+	}
+	return diff, uc.repo.Apply(ctx, diff)
+}
+
+// Export 导出 casbin CSV 格式的策略
+// 参数：ctx 上下文，domain 域（为空时导出全部域）
+// 返回值：CSV 内容，错误信息
+func (uc *PolicyUsecase) Export(ctx context.Context, domain authz.Domain) ([]byte, error) {
+	policies, _, err := uc.repo.ListPolicies(ctx, PolicyFilter{Domain: domain}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	bindings, _, err := uc.repo.ListRoleBindings(ctx, RoleBindingFilter{Domain: domain}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return authz.MarshalCSV(policies, bindings), nil
+}
+
+// IsAuthorized 使用本地授权器判断主体能否在域内对对象执行操作，供其它服务远程委托授权决策
+// 参数：ctx 上下文，sub 主体，obj 对象，act 操作，domain 域
+// 返回值：是否授权，错误信息
+func (uc *PolicyUsecase) IsAuthorized(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (bool, error) {
+	if sub == "" || obj == "" || act == "" {
+		return false, v1.ErrorPolicyInvalid("主体、对象与操作不能为空")
+	}
+	return uc.repo.Enforce(ctx, sub, obj, act, domain)
+}
+
+// Explain 解释授权决策，返回命中的策略、角色链与生效的数据范围
+// 授权引擎中的角色按名称关联到角色数据以确定数据范围
+// 参数：ctx 上下文，sub 主体，obj 对象，act 操作，domain 域
+// 返回值：授权决策解释，错误信息
+func (uc *PolicyUsecase) Explain(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (*PolicyExplanation, error) {
+	if sub == "" || obj == "" || act == "" {
+		return nil, v1.ErrorPolicyInvalid("主体、对象与操作不能为空")
+	}
+	explanation, err := uc.repo.Explain(ctx, sub, obj, act, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, b := range explanation.RoleChain {
+		if !slices.Contains(names, string(b.Role)) {
+			names = append(names, string(b.Role))
+		}
+	}
+	roles, err := uc.roleRepo.ListByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PolicyExplanation{Explanation: explanation}
+	for _, name := range names {
+		role := ExplainedRole{Name: authz.Subject(name)}
+		if i := slices.IndexFunc(roles, func(r *pbCore.Role) bool { return r.GetName() == name }); i >= 0 {
+			role.Role = roles[i]
+			if scope := roles[i].GetDataScope(); dataScopeRank[scope] > dataScopeRank[result.DataScope] {
+				result.DataScope = scope
+			}
+		}
+		result.Roles = append(result.Roles, role)
+	}
+	uc.log.WithContext(ctx).Infof("ExplainPolicy: %s %s %s %q allowed=%v roles=%v dataScope=%d",
+		sub, obj, act, domain, explanation.Allowed, names, result.DataScope)
+	return result, nil
+}
+
+// validatePolicies 检查策略与角色绑定的必填字段
+func validatePolicies(policies []authz.Policy, bindings []authz.RoleBinding) error {
+	if len(policies) == 0 && len(bindings) == 0 {
+		return v1.ErrorPolicyInvalid("策略与角色绑定不能同时为空")
+	}
+	for _, p := range policies {
+		if p.Subject == "" || p.Object == "" || p.Action == "" {
+			return v1.ErrorPolicyInvalid("策略的主体、对象与操作不能为空")
+		}
+		if p.Effect != "" && p.Effect != authz.EffectAllow && p.Effect != authz.EffectDeny {
+			return v1.ErrorPolicyInvalid("策略效果只能为 allow 或 deny")
+		}
+	}
+	for _, b := range bindings {
+		if b.User == "" || b.Role == "" {
+			return v1.ErrorPolicyInvalid("角色绑定的用户与角色不能为空")
+		}
+	}
+	return nil
+}
+
+// normalizePolicies 补齐策略的默认效果
+func normalizePolicies(policies []authz.Policy) []authz.Policy {
+	result := make([]authz.Policy, len(policies))
+	for i, p := range policies {
+		if p.Effect == "" {
+			p.Effect = authz.EffectAllow
+		}
+		result[i] = p
+	}
+	return result
+}
+
+// difference 返回 a 中不在 b 中的元素（去重）
+func difference[T comparable](a, b []T) []T {
+	seen := make(map[T]struct{}, len(b))
+	for _, v := range b {
+		seen[v] = struct{}{}
+	}
+	var result []T
+	for _, v := range a {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -3,6 +3,7 @@ package biz
 import (
 	"context"
 
+	v1 "backend-service/api/avmc/admin/v1"
 	pbPagination "backend-service/api/common/pagination"
 	pbCore "backend-service/api/core/service/v1"
 
@@ -10,8 +11,10 @@ import (
 )
 
 var (
-// ErrPostNotFound is user not found.
-// ErrPostNotFound = errors.NotFound(v1.ErrorReason_USER_NOT_FOUND.String(), "user not found")
+	// ErrPostNotFound 岗位不存在
+	ErrPostNotFound = v1.ErrorPostNotFound("岗位不存在")
+	// ErrPostAlreadyExists 岗位名称已存在
+	ErrPostAlreadyExists = v1.ErrorPostAlreadyExists("岗位名称已存在")
 )
 
 // PostRepo is a Greater repo.
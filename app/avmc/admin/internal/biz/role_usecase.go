@@ -3,6 +3,7 @@ package biz
 import (
 	"context"
 
+	v1 "backend-service/api/avmc/admin/v1"
 	pbPagination "backend-service/api/common/pagination"
 	pbCore "backend-service/api/core/service/v1"
 
@@ -10,8 +11,10 @@ import (
 )
 
 var (
-// ErrRoleNotFound is user not found.
-// ErrRoleNotFound = errors.NotFound(v1.ErrorReason_USER_NOT_FOUND.String(), "user not found")
+	// ErrRoleNotFound 角色不存在
+	ErrRoleNotFound = v1.ErrorRoleNotFound("角色不存在")
+	// ErrRoleAlreadyExists 角色名称已存在
+	ErrRoleAlreadyExists = v1.ErrorRoleAlreadyExists("角色名称已存在")
 )
 
 // RoleRepo is a Greater repo.
@@ -22,6 +25,7 @@ type RoleRepo interface {
 	ListAll(context.Context) ([]*pbCore.Role, error)
 	ListPage(context.Context, *pbPagination.PagingRequest) (*pbCore.ListRoleResponse, error) // 新增的方法用于分页查询
 	Delete(context.Context, uint32) error
+	ListByNames(context.Context, []string) ([]*pbCore.Role, error) // 按名称精确查询，用于将授权引擎中的角色关联到角色数据
 }
 
 // RoleUsecase is a Role usecase.
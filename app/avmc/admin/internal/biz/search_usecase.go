@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"context"
+	"strings"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	pbCore "backend-service/api/core/service/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrSearchIndexNotSupported 索引不支持搜索
+	ErrSearchIndexNotSupported = v1.ErrorSearchIndexNotSupported("索引不支持搜索")
+	// ErrSearchUnavailable 搜索服务不可用
+	ErrSearchUnavailable = v1.ErrorSearchUnavailable("搜索服务不可用")
+)
+
+const (
+	// SearchIndexUsers 用户索引
+	SearchIndexUsers = "users"
+	// SearchIndexMenus 菜单索引
+	SearchIndexMenus = "menus"
+
+	// searchDefaultLimit 搜索默认返回的结果数
+	searchDefaultLimit = 20
+	// searchMaxLimit 搜索最多返回的结果数
+	searchMaxLimit = 100
+)
+
+// SearchRepo 全文搜索数据仓库接口，结果按相关度从高到低排列
+type SearchRepo interface {
+	// SearchUsers 按用户名、昵称搜索用户
+	SearchUsers(ctx context.Context, keyword string, limit int) ([]*pbCore.User, error)
+	// SearchMenus 按菜单标题、名称搜索菜单
+	SearchMenus(ctx context.Context, keyword string, limit int) ([]*pbCore.Menu, error)
+	// Rebuild 清空索引后从数据库重新写入全部数据，返回写入的行数
+	Rebuild(ctx context.Context, index string) (int, error)
+}
+
+// SearchUsecase 全文搜索业务用例
+type SearchUsecase struct {
+	repo SearchRepo
+	log  *log.Helper
+}
+
+// NewSearchUsecase 创建全文搜索业务用例
+// 参数：repo 全文搜索数据仓库，logger 日志记录器
+// 返回值：全文搜索业务用例实例指针
+func NewSearchUsecase(repo SearchRepo, logger log.Logger) *SearchUsecase {
+	return &SearchUsecase{repo: repo, log: log.NewHelper(log.With(logger, "module", "search/biz"))}
+}
+
+// SearchUsers 搜索用户
+// 参数：ctx 上下文，keyword 搜索关键字，limit 返回的结果数
+// 返回值：用户列表响应，错误信息
+func (uc *SearchUsecase) SearchUsers(ctx context.Context, keyword string, limit int32) (*pbCore.ListUserResponse, error) {
+	items, err := uc.repo.SearchUsers(ctx, strings.TrimSpace(keyword), searchLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	return &pbCore.ListUserResponse{Items: items, Total: int32(len(items))}, nil
+}
+
+// SearchMenus 搜索菜单
+// 参数：ctx 上下文，keyword 搜索关键字，limit 返回的结果数
+// 返回值：菜单列表响应，错误信息
+func (uc *SearchUsecase) SearchMenus(ctx context.Context, keyword string, limit int32) (*pbCore.ListMenuResponse, error) {
+	items, err := uc.repo.SearchMenus(ctx, strings.TrimSpace(keyword), searchLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	return &pbCore.ListMenuResponse{Items: items, Total: int32(len(items))}, nil
+}
+
+// Rebuild 重建搜索索引
+// 参数：ctx 上下文，index 索引名称
+// 返回值：写入的行数，错误信息
+func (uc *SearchUsecase) Rebuild(ctx context.Context, index string) (int, error) {
+	if index != SearchIndexUsers && index != SearchIndexMenus {
+		return 0, ErrSearchIndexNotSupported
+	}
+	total, err := uc.repo.Rebuild(ctx, index)
+	if err != nil {
+		return 0, err
+	}
+	uc.log.WithContext(ctx).Infof("重建搜索索引完成，索引：%s，行数：%d", index, total)
+	return total, nil
+}
+
+// searchLimit 搜索返回的结果数，不大于 0 时为默认值，不超过上限
+func searchLimit(limit int32) int {
+	switch {
+	case limit <= 0:
+		return searchDefaultLimit
+	case limit > searchMaxLimit:
+		return searchMaxLimit
+	}
+	return int(limit)
+}
@@ -0,0 +1,172 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/pkg/utils/pagination"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrTrashResourceNotSupported 资源不支持回收站
+	ErrTrashResourceNotSupported = v1.ErrorTrashResourceNotSupported("资源不支持回收站")
+	// ErrTrashNotFound 回收站中不存在该记录
+	ErrTrashNotFound = v1.ErrorTrashNotFound("回收站中不存在该记录")
+)
+
+const (
+	// TrashResourceUser 用户
+	TrashResourceUser = "user"
+	// TrashResourceRole 角色
+	TrashResourceRole = "role"
+	// TrashResourceDept 部门
+	TrashResourceDept = "dept"
+	// TrashResourceMenu 菜单
+	TrashResourceMenu = "menu"
+	// TrashResourcePost 岗位
+	TrashResourcePost = "post"
+)
+
+// TrashResources 支持回收站的资源，定时清理按此顺序执行
+var TrashResources = []string{TrashResourceUser, TrashResourceRole, TrashResourceDept, TrashResourceMenu, TrashResourcePost}
+
+// TrashOptions 回收站配置
+type TrashOptions struct {
+	// Retention 已删除数据的保留时长
+	Retention time.Duration
+	// PurgeInterval 清理过期数据的间隔
+	PurgeInterval time.Duration
+	// BatchSize 每批彻底删除的行数
+	BatchSize int
+}
+
+// TrashRepo 回收站数据仓库接口，只操作已软删除的数据
+type TrashRepo interface {
+	// Options 获取回收站配置
+	Options() TrashOptions
+	// List 分页查询资源已删除的数据，按删除时间倒序排列，返回数据与总数
+	List(ctx context.Context, resource, keyword string, offset, limit int) ([]*v1.TrashItem, int, error)
+	// Restore 恢复已删除的数据，不存在时返回 ErrTrashNotFound，唯一字段与现有数据重复时返回对应的已存在错误
+	Restore(ctx context.Context, resource string, id uint32) error
+	// Purge 彻底删除已删除的数据，不存在时返回 ErrTrashNotFound
+	Purge(ctx context.Context, resource string, id uint32) error
+	// PurgeExpired 彻底删除在 before 之前删除的数据，每次最多 limit 行，返回删除的行数
+	PurgeExpired(ctx context.Context, resource string, before time.Time, limit int) (int, error)
+}
+
+// TrashUsecase 回收站业务用例
+type TrashUsecase struct {
+	repo TrashRepo
+	log  *log.Helper
+}
+
+// NewTrashUsecase 创建回收站业务用例
+// 参数：repo 回收站数据仓库，logger 日志记录器
+// 返回值：回收站业务用例实例指针
+func NewTrashUsecase(repo TrashRepo, logger log.Logger) *TrashUsecase {
+	return &TrashUsecase{repo: repo, log: log.NewHelper(log.With(logger, "module", "trash/biz"))}
+}
+
+// Options 获取回收站配置
+func (uc *TrashUsecase) Options() TrashOptions {
+	return uc.repo.Options()
+}
+
+// List 分页查询回收站
+// 参数：ctx 上下文，req 查询回收站请求
+// 返回值：查询回收站响应，错误信息
+func (uc *TrashUsecase) List(ctx context.Context, req *v1.ListTrashRequest) (*v1.ListTrashResponse, error) {
+	resource, err := trashResource(req.GetResource())
+	if err != nil {
+		return nil, err
+	}
+	page, size := req.GetPage(), req.GetPageSize()
+	if page <= 0 {
+		page = pagination.PAGE
+	}
+	if size <= 0 {
+		size = pagination.PAGE_SIZE
+	}
+	size = min(size, pagination.MAX_PAGE_SIZE)
+	items, total, err := uc.repo.List(ctx, resource, strings.TrimSpace(req.GetKeyword()), pagination.GetPageOffset(page, size), int(size))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListTrashResponse{Items: items, Total: int32(total)}, nil
+}
+
+// Restore 恢复已删除的数据
+// 参数：ctx 上下文，req 恢复数据请求
+// 返回值：错误信息
+func (uc *TrashUsecase) Restore(ctx context.Context, req *v1.TrashRequest) error {
+	resource, err := trashResource(req.GetResource())
+	if err != nil {
+		return err
+	}
+	if req.GetId() == 0 {
+		return ErrTrashNotFound
+	}
+	if err := uc.repo.Restore(ctx, resource, req.GetId()); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("恢复已删除的数据，资源：%s，ID：%d", resource, req.GetId())
+	return nil
+}
+
+// Purge 彻底删除已删除的数据
+// 参数：ctx 上下文，req 彻底删除数据请求
+// 返回值：错误信息
+func (uc *TrashUsecase) Purge(ctx context.Context, req *v1.TrashRequest) error {
+	resource, err := trashResource(req.GetResource())
+	if err != nil {
+		return err
+	}
+	if req.GetId() == 0 {
+		return ErrTrashNotFound
+	}
+	if err := uc.repo.Purge(ctx, resource, req.GetId()); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("彻底删除数据，资源：%s，ID：%d", resource, req.GetId())
+	return nil
+}
+
+// PurgeExpired 彻底删除超过保留时长的数据，按批执行直到没有过期数据
+// 参数：ctx 上下文
+// 返回值：删除的行数，错误信息
+func (uc *TrashUsecase) PurgeExpired(ctx context.Context) (int, error) {
+	opts := uc.repo.Options()
+	before := time.Now().Add(-opts.Retention)
+	var total int
+	for _, resource := range TrashResources {
+		for {
+			n, err := uc.repo.PurgeExpired(ctx, resource, before, opts.BatchSize)
+			if err != nil {
+				return total, err
+			}
+			total += n
+			if n < opts.BatchSize {
+				break
+			}
+		}
+	}
+	if total > 0 {
+		uc.log.WithContext(ctx).Infof("清理回收站过期数据，删除时间早于：%s，行数：%d", before.Format(time.DateTime), total)
+	}
+	return total, nil
+}
+
+// trashResource 规范化资源名称，不支持回收站时返回 ErrTrashResourceNotSupported
+func trashResource(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, resource := range TrashResources {
+		if resource == name {
+			return resource, nil
+		}
+	}
+	return "", ErrTrashResourceNotSupported
+}
@@ -1,15 +1,28 @@
 package biz
 
 import (
+	v1 "backend-service/api/avmc/admin/v1"
 	pbPagination "backend-service/api/common/pagination"
 	"context"
 
 	pbCore "backend-service/api/core/service/v1"
 
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+var (
+	// ErrUserNotFound 用户不存在
+	ErrUserNotFound = v1.ErrorUserNotFound("用户不存在")
+	// ErrUserInvalidID 用户ID不能为空
+	ErrUserInvalidID = v1.ErrorUserInvalidId("用户ID不能为空")
+	// ErrUserAlreadyExists 用户名已存在
+	ErrUserAlreadyExists = v1.ErrorUserAlreadyExists("用户名已存在")
+	// ErrUserEmailAlreadyExists 邮箱已存在
+	ErrUserEmailAlreadyExists = v1.ErrorUserEmailAlreadyExists("邮箱已存在")
+	// ErrUserPhoneAlreadyExists 手机号已存在
+	ErrUserPhoneAlreadyExists = v1.ErrorUserPhoneAlreadyExists("手机号已存在")
+)
+
 // UserRepo is a User repo.
 type UserRepo interface {
 	Save(context.Context, *pbCore.User) (*pbCore.User, error)
@@ -52,7 +65,7 @@ func (uc *UserUsecase) Create(ctx context.Context, g *pbCore.User) (*pbCore.User
 func (uc *UserUsecase) Get(ctx context.Context, id uint32) (*pbCore.User, error) {
 	uc.log.WithContext(ctx).Infof("GetUser: %v", id)
 	if id == 0 {
-		return nil, errors.New(1001, "用户ID不能为空", "user id is required")
+		return nil, ErrUserInvalidID
 	}
 	return uc.repo.FindByID(ctx, id)
 }
@@ -62,7 +75,7 @@ func (uc *UserUsecase) Get(ctx context.Context, id uint32) (*pbCore.User, error)
 // 返回值：更新用户响应，错误信息
 func (uc *UserUsecase) Update(ctx context.Context, g *pbCore.User) (*pbCore.User, error) {
 	if g.GetId() == 0 {
-		return nil, errors.New(1001, "用户ID不能为空", "user id is required")
+		return nil, ErrUserInvalidID
 	}
 	uc.log.WithContext(ctx).Infof("UpdateUser: %v", g.GetId())
 	return uc.repo.Update(ctx, g)
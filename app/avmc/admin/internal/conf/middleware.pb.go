@@ -23,17 +23,19 @@ const (
 )
 
 type Middleware struct {
-	state                protoimpl.MessageState  `protogen:"open.v1"`
-	EnableLogging        bool                    `protobuf:"varint,1,opt,name=enable_logging,json=enableLogging,proto3" json:"enable_logging,omitempty"`                        // 日志开关
-	EnableRecovery       bool                    `protobuf:"varint,2,opt,name=enable_recovery,json=enableRecovery,proto3" json:"enable_recovery,omitempty"`                     // 异常恢复
-	EnableTracing        bool                    `protobuf:"varint,3,opt,name=enable_tracing,json=enableTracing,proto3" json:"enable_tracing,omitempty"`                        // 链路追踪开关
-	EnableValidate       bool                    `protobuf:"varint,4,opt,name=enable_validate,json=enableValidate,proto3" json:"enable_validate,omitempty"`                     // 参数校验开关
-	EnableCircuitBreaker bool                    `protobuf:"varint,5,opt,name=enable_circuit_breaker,json=enableCircuitBreaker,proto3" json:"enable_circuit_breaker,omitempty"` // 熔断器
-	Limiter              *Middleware_RateLimiter `protobuf:"bytes,6,opt,name=limiter,proto3" json:"limiter,omitempty"`
-	Metrics              *Middleware_Metrics     `protobuf:"bytes,7,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	Auth                 *Middleware_Auth        `protobuf:"bytes,8,opt,name=auth,proto3" json:"auth,omitempty"`
-	Authorizer           *Middleware_Authorizer  `protobuf:"bytes,9,opt,name=authorizer,proto3" json:"authorizer,omitempty"`
-	Localize             *Middleware_Localize    `protobuf:"bytes,10,opt,name=localize,proto3" json:"localize,omitempty"`
+	state                protoimpl.MessageState      `protogen:"open.v1"`
+	EnableLogging        bool                        `protobuf:"varint,1,opt,name=enable_logging,json=enableLogging,proto3" json:"enable_logging,omitempty"`                        // 日志开关
+	EnableRecovery       bool                        `protobuf:"varint,2,opt,name=enable_recovery,json=enableRecovery,proto3" json:"enable_recovery,omitempty"`                     // 异常恢复
+	EnableTracing        bool                        `protobuf:"varint,3,opt,name=enable_tracing,json=enableTracing,proto3" json:"enable_tracing,omitempty"`                        // 链路追踪开关
+	EnableValidate       bool                        `protobuf:"varint,4,opt,name=enable_validate,json=enableValidate,proto3" json:"enable_validate,omitempty"`                     // 参数校验开关
+	EnableCircuitBreaker bool                        `protobuf:"varint,5,opt,name=enable_circuit_breaker,json=enableCircuitBreaker,proto3" json:"enable_circuit_breaker,omitempty"` // 熔断器
+	Limiter              *Middleware_RateLimiter     `protobuf:"bytes,6,opt,name=limiter,proto3" json:"limiter,omitempty"`
+	Metrics              *Middleware_Metrics         `protobuf:"bytes,7,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Auth                 *Middleware_Auth            `protobuf:"bytes,8,opt,name=auth,proto3" json:"auth,omitempty"`
+	Authorizer           *Middleware_Authorizer      `protobuf:"bytes,9,opt,name=authorizer,proto3" json:"authorizer,omitempty"`
+	Localize             *Middleware_Localize        `protobuf:"bytes,10,opt,name=localize,proto3" json:"localize,omitempty"`
+	Captcha              *Middleware_Captcha         `protobuf:"bytes,11,opt,name=captcha,proto3" json:"captcha,omitempty"`
+	FieldVisibility      *Middleware_FieldVisibility `protobuf:"bytes,12,opt,name=field_visibility,json=fieldVisibility,proto3" json:"field_visibility,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -138,6 +140,20 @@ func (x *Middleware) GetLocalize() *Middleware_Localize {
 	return nil
 }
 
+func (x *Middleware) GetCaptcha() *Middleware_Captcha {
+	if x != nil {
+		return x.Captcha
+	}
+	return nil
+}
+
+func (x *Middleware) GetFieldVisibility() *Middleware_FieldVisibility {
+	if x != nil {
+		return x.FieldVisibility
+	}
+	return nil
+}
+
 // JWT校验
 type Middleware_Auth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -382,18 +398,139 @@ func (x *Middleware_Localize) GetDefault() string {
 	return ""
 }
 
+// 图形验证码
+type Middleware_Captcha struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Enable         bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`                                       // 是否启用
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                            // 验证码类型，支持：math、string、digit
+	Mode           string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`                                            // 校验模式，支持：always（始终校验）、failure（失败次数达到阈值后校验）
+	MaxFailures    int32                  `protobuf:"varint,4,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`          // 失败次数阈值（按IP或用户名统计），mode=failure时生效
+	FailureWindow  *durationpb.Duration   `protobuf:"bytes,5,opt,name=failure_window,json=failureWindow,proto3" json:"failure_window,omitempty"`     // 失败次数统计窗口
+	ExpiresTime    *durationpb.Duration   `protobuf:"bytes,6,opt,name=expires_time,json=expiresTime,proto3" json:"expires_time,omitempty"`           // 验证码有效期
+	Length         int32                  `protobuf:"varint,7,opt,name=length,proto3" json:"length,omitempty"`                                       // 验证码长度
+	Width          int32                  `protobuf:"varint,8,opt,name=width,proto3" json:"width,omitempty"`                                         // 图片宽度
+	Height         int32                  `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`                                       // 图片高度
+	TrustedProxies []string               `protobuf:"bytes,10,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"` // 可信代理的 IP 或 CIDR，按IP统计失败次数时仅对端属于可信代理才采信 X-Forwarded-For 与 X-Real-IP
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Middleware_Captcha) Reset() {
+	*x = Middleware_Captcha{}
+	mi := &file_common_conf_middleware_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Middleware_Captcha) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Captcha) ProtoMessage() {}
+
+func (x *Middleware_Captcha) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_middleware_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Captcha.ProtoReflect.Descriptor instead.
+func (*Middleware_Captcha) Descriptor() ([]byte, []int) {
+	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *Middleware_Captcha) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Middleware_Captcha) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Middleware_Captcha) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Middleware_Captcha) GetMaxFailures() int32 {
+	if x != nil {
+		return x.MaxFailures
+	}
+	return 0
+}
+
+func (x *Middleware_Captcha) GetFailureWindow() *durationpb.Duration {
+	if x != nil {
+		return x.FailureWindow
+	}
+	return nil
+}
+
+func (x *Middleware_Captcha) GetExpiresTime() *durationpb.Duration {
+	if x != nil {
+		return x.ExpiresTime
+	}
+	return nil
+}
+
+func (x *Middleware_Captcha) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Middleware_Captcha) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Middleware_Captcha) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Middleware_Captcha) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
 // 鉴权
 type Middleware_Authorizer struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Type          string                        `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Casbin        *Middleware_Authorizer_Casbin `protobuf:"bytes,2,opt,name=casbin,proto3" json:"casbin,omitempty"`
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Type          string                          `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // 授权引擎，支持：casbin（默认）、opa、zanzibar、remote
+	Casbin        *Middleware_Authorizer_Casbin   `protobuf:"bytes,2,opt,name=casbin,proto3" json:"casbin,omitempty"`
+	Cache         *Middleware_Authorizer_Cache    `protobuf:"bytes,3,opt,name=cache,proto3" json:"cache,omitempty"`
+	Opa           *Middleware_Authorizer_Opa      `protobuf:"bytes,4,opt,name=opa,proto3" json:"opa,omitempty"`
+	Zanzibar      *Middleware_Authorizer_Zanzibar `protobuf:"bytes,5,opt,name=zanzibar,proto3" json:"zanzibar,omitempty"`
+	Remote        *Middleware_Authorizer_Remote   `protobuf:"bytes,6,opt,name=remote,proto3" json:"remote,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Middleware_Authorizer) Reset() {
 	*x = Middleware_Authorizer{}
-	mi := &file_common_conf_middleware_proto_msgTypes[5]
+	mi := &file_common_conf_middleware_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -405,7 +542,7 @@ func (x *Middleware_Authorizer) String() string {
 func (*Middleware_Authorizer) ProtoMessage() {}
 
 func (x *Middleware_Authorizer) ProtoReflect() protoreflect.Message {
-	mi := &file_common_conf_middleware_proto_msgTypes[5]
+	mi := &file_common_conf_middleware_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +555,7 @@ func (x *Middleware_Authorizer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Middleware_Authorizer.ProtoReflect.Descriptor instead.
 func (*Middleware_Authorizer) Descriptor() ([]byte, []int) {
-	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 4}
+	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 5}
 }
 
 func (x *Middleware_Authorizer) GetType() string {
@@ -435,17 +572,95 @@ func (x *Middleware_Authorizer) GetCasbin() *Middleware_Authorizer_Casbin {
 	return nil
 }
 
-type Middleware_Authorizer_Casbin struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ModelPath     string                 `protobuf:"bytes,1,opt,name=model_path,json=modelPath,proto3" json:"model_path,omitempty"`
-	PolicyPath    string                 `protobuf:"bytes,2,opt,name=policy_path,json=policyPath,proto3" json:"policy_path,omitempty"`
+func (x *Middleware_Authorizer) GetCache() *Middleware_Authorizer_Cache {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *Middleware_Authorizer) GetOpa() *Middleware_Authorizer_Opa {
+	if x != nil {
+		return x.Opa
+	}
+	return nil
+}
+
+func (x *Middleware_Authorizer) GetZanzibar() *Middleware_Authorizer_Zanzibar {
+	if x != nil {
+		return x.Zanzibar
+	}
+	return nil
+}
+
+func (x *Middleware_Authorizer) GetRemote() *Middleware_Authorizer_Remote {
+	if x != nil {
+		return x.Remote
+	}
+	return nil
+}
+
+// 字段可见性，按角色隐藏或脱敏响应中的字段
+type Middleware_FieldVisibility struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Rules         []*Middleware_FieldVisibility_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *Middleware_FieldVisibility) Reset() {
+	*x = Middleware_FieldVisibility{}
+	mi := &file_common_conf_middleware_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Middleware_FieldVisibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_FieldVisibility) ProtoMessage() {}
+
+func (x *Middleware_FieldVisibility) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_middleware_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_FieldVisibility.ProtoReflect.Descriptor instead.
+func (*Middleware_FieldVisibility) Descriptor() ([]byte, []int) {
+	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *Middleware_FieldVisibility) GetRules() []*Middleware_FieldVisibility_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type Middleware_Authorizer_Casbin struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ModelPath      string                 `protobuf:"bytes,1,opt,name=model_path,json=modelPath,proto3" json:"model_path,omitempty"`
+	PolicyPath     string                 `protobuf:"bytes,2,opt,name=policy_path,json=policyPath,proto3" json:"policy_path,omitempty"`
+	Adapter        string                 `protobuf:"bytes,3,opt,name=adapter,proto3" json:"adapter,omitempty"`                                     // 策略适配器，支持：ent（默认，与业务数据同库）、mysql、postgres、redis、file、memory
+	Dsn            string                 `protobuf:"bytes,4,opt,name=dsn,proto3" json:"dsn,omitempty"`                                             // 适配器数据源，mysql/postgres 为空时使用业务数据库连接，redis 为空时使用业务 Redis 客户端
+	Watcher        string                 `protobuf:"bytes,5,opt,name=watcher,proto3" json:"watcher,omitempty"`                                     // 策略变更观察者，支持：redis（使用业务 Redis 客户端），为空时不启用
+	WatcherChannel string                 `protobuf:"bytes,6,opt,name=watcher_channel,json=watcherChannel,proto3" json:"watcher_channel,omitempty"` // 观察者频道
+	ReloadInterval *durationpb.Duration   `protobuf:"bytes,7,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"` // 定期全量重新加载策略的间隔，为空时不启用
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *Middleware_Authorizer_Casbin) Reset() {
 	*x = Middleware_Authorizer_Casbin{}
-	mi := &file_common_conf_middleware_proto_msgTypes[6]
+	mi := &file_common_conf_middleware_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +672,7 @@ func (x *Middleware_Authorizer_Casbin) String() string {
 func (*Middleware_Authorizer_Casbin) ProtoMessage() {}
 
 func (x *Middleware_Authorizer_Casbin) ProtoReflect() protoreflect.Message {
-	mi := &file_common_conf_middleware_proto_msgTypes[6]
+	mi := &file_common_conf_middleware_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +685,7 @@ func (x *Middleware_Authorizer_Casbin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Middleware_Authorizer_Casbin.ProtoReflect.Descriptor instead.
 func (*Middleware_Authorizer_Casbin) Descriptor() ([]byte, []int) {
-	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 4, 0}
+	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 5, 0}
 }
 
 func (x *Middleware_Authorizer_Casbin) GetModelPath() string {
@@ -487,6 +702,438 @@ func (x *Middleware_Authorizer_Casbin) GetPolicyPath() string {
 	return ""
 }
 
+func (x *Middleware_Authorizer_Casbin) GetAdapter() string {
+	if x != nil {
+		return x.Adapter
+	}
+	return ""
+}
+
+func (x *Middleware_Authorizer_Casbin) GetDsn() string {
+	if x != nil {
+		return x.Dsn
+	}
+	return ""
+}
+
+func (x *Middleware_Authorizer_Casbin) GetWatcher() string {
+	if x != nil {
+		return x.Watcher
+	}
+	return ""
+}
+
+func (x *Middleware_Authorizer_Casbin) GetWatcherChannel() string {
+	if x != nil {
+		return x.WatcherChannel
+	}
+	return ""
+}
+
+func (x *Middleware_Authorizer_Casbin) GetReloadInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReloadInterval
+	}
+	return nil
+}
+
+// 决策缓存
+type Middleware_Authorizer_Cache struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enable        bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"` // 是否启用，casbin 引擎须同时配置 watcher，否则拒绝启动
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`      // 缓存类型，支持：local（默认）、redis（本地 + 业务 Redis 二级缓存）
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`     // 本地缓存容量
+	Ttl           *durationpb.Duration   `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`        // 缓存有效期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Middleware_Authorizer_Cache) Reset() {
+	*x = Middleware_Authorizer_Cache{}
+	mi := &file_common_conf_middleware_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Middleware_Authorizer_Cache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Authorizer_Cache) ProtoMessage() {}
+
+func (x *Middleware_Authorizer_Cache) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_middleware_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Authorizer_Cache.ProtoReflect.Descriptor instead.
+func (*Middleware_Authorizer_Cache) Descriptor() ([]byte, []int) {
+	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 5, 1}
+}
+
+func (x *Middleware_Authorizer_Cache) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Middleware_Authorizer_Cache) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Middleware_Authorizer_Cache) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Middleware_Authorizer_Cache) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// OPA 授权引擎，策略与角色规则与 casbin 共用 casbin_rule 表
+type Middleware_Authorizer_Opa struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PolicyPath     string                 `protobuf:"bytes,1,opt,name=policy_path,json=policyPath,proto3" json:"policy_path,omitempty"`             // Rego 策略文件或目录，为空时使用内置的 RBAC 策略
+	Query          string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                                         // 决策查询，默认为 data.authz.allow
+	DataPaths      []string               `protobuf:"bytes,3,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`                // JSON 静态数据文件
+	ReloadInterval *durationpb.Duration   `protobuf:"bytes,4,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"` // 定期全量重新加载的间隔，为空时不启用
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Middleware_Authorizer_Opa) Reset() {
+	*x = Middleware_Authorizer_Opa{}
+	mi := &file_common_conf_middleware_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Middleware_Authorizer_Opa) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Authorizer_Opa) ProtoMessage() {}
+
+func (x *Middleware_Authorizer_Opa) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_middleware_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Authorizer_Opa.ProtoReflect.Descriptor instead.
+func (*Middleware_Authorizer_Opa) Descriptor() ([]byte, []int) {
+	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 5, 2}
+}
+
+func (x *Middleware_Authorizer_Opa) GetPolicyPath() string {
+	if x != nil {
+		return x.PolicyPath
+	}
+	return ""
+}
+
+func (x *Middleware_Authorizer_Opa) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *Middleware_Authorizer_Opa) GetDataPaths() []string {
+	if x != nil {
+		return x.DataPaths
+	}
+	return nil
+}
+
+func (x *Middleware_Authorizer_Opa) GetReloadInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReloadInterval
+	}
+	return nil
+}
+
+// Zanzibar 关系授权引擎（ReBAC），关系元组保存在 relation_tuple 表
+type Middleware_Authorizer_Zanzibar struct {
+	state         protoimpl.MessageState                               `protogen:"open.v1"`
+	SchemaPath    string                                               `protobuf:"bytes,1,opt,name=schema_path,json=schemaPath,proto3" json:"schema_path,omitempty"`                                                         // JSON 格式的命名空间配置文件，为空时所有关系仅包含直接元组
+	MaxDepth      int32                                                `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`                                                              // Check 与 Expand 的最大递归深度，默认为 25
+	Operations    map[string]*Middleware_Authorizer_Zanzibar_Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 操作名到关系检查的映射，未配置的操作须为 namespace:id 形式的对象
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Middleware_Authorizer_Zanzibar) Reset() {
+	*x = Middleware_Authorizer_Zanzibar{}
+	mi := &file_common_conf_middleware_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Middleware_Authorizer_Zanzibar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Authorizer_Zanzibar) ProtoMessage() {}
+
+func (x *Middleware_Authorizer_Zanzibar) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_middleware_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Authorizer_Zanzibar.ProtoReflect.Descriptor instead.
+func (*Middleware_Authorizer_Zanzibar) Descriptor() ([]byte, []int) {
+	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 5, 3}
+}
+
+func (x *Middleware_Authorizer_Zanzibar) GetSchemaPath() string {
+	if x != nil {
+		return x.SchemaPath
+	}
+	return ""
+}
+
+func (x *Middleware_Authorizer_Zanzibar) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *Middleware_Authorizer_Zanzibar) GetOperations() map[string]*Middleware_Authorizer_Zanzibar_Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// 远程授权，委托授权服务的 core.service.v1.AuthService/IsAuthorized 决策
+type Middleware_Authorizer_Remote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"` // 授权服务 gRPC 地址，如 127.0.0.1:9000
+	Timeout       *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`   // 单次调用超时，默认 1s
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`       // 调用授权服务的访问令牌，其主体需拥有 IsAuthorized 接口的权限
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Middleware_Authorizer_Remote) Reset() {
+	*x = Middleware_Authorizer_Remote{}
+	mi := &file_common_conf_middleware_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Middleware_Authorizer_Remote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Authorizer_Remote) ProtoMessage() {}
+
+func (x *Middleware_Authorizer_Remote) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_middleware_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Authorizer_Remote.ProtoReflect.Descriptor instead.
+func (*Middleware_Authorizer_Remote) Descriptor() ([]byte, []int) {
+	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 5, 4}
+}
+
+func (x *Middleware_Authorizer_Remote) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Middleware_Authorizer_Remote) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Middleware_Authorizer_Remote) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 接口操作对应的关系检查，非空域时对象 ID 为 <域>/<object>
+type Middleware_Authorizer_Zanzibar_Operation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // 对象命名空间，如 api
+	Object        string                 `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`       // 对象 ID，如 user
+	Relation      string                 `protobuf:"bytes,3,opt,name=relation,proto3" json:"relation,omitempty"`   // 关系，为空时取请求的授权操作
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Middleware_Authorizer_Zanzibar_Operation) Reset() {
+	*x = Middleware_Authorizer_Zanzibar_Operation{}
+	mi := &file_common_conf_middleware_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Middleware_Authorizer_Zanzibar_Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Authorizer_Zanzibar_Operation) ProtoMessage() {}
+
+func (x *Middleware_Authorizer_Zanzibar_Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_middleware_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Authorizer_Zanzibar_Operation.ProtoReflect.Descriptor instead.
+func (*Middleware_Authorizer_Zanzibar_Operation) Descriptor() ([]byte, []int) {
+	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 5, 3, 0}
+}
+
+func (x *Middleware_Authorizer_Zanzibar_Operation) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Middleware_Authorizer_Zanzibar_Operation) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *Middleware_Authorizer_Zanzibar_Operation) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+type Middleware_FieldVisibility_Rule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // 消息全名，如 core.service.v1.User
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`   // 字段名（proto 名称）
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`   // 处理方式，支持：hide（清空）、mask（脱敏，非字符串字段清空）
+	Masker        string                 `protobuf:"bytes,4,opt,name=masker,proto3" json:"masker,omitempty"`   // 脱敏方式，支持：default（默认，保留首尾字符）、phone、email
+	Roles         []string               `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`     // 可查看原值的角色，为空时对所有用户生效
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Middleware_FieldVisibility_Rule) Reset() {
+	*x = Middleware_FieldVisibility_Rule{}
+	mi := &file_common_conf_middleware_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Middleware_FieldVisibility_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_FieldVisibility_Rule) ProtoMessage() {}
+
+func (x *Middleware_FieldVisibility_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_middleware_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_FieldVisibility_Rule.ProtoReflect.Descriptor instead.
+func (*Middleware_FieldVisibility_Rule) Descriptor() ([]byte, []int) {
+	return file_common_conf_middleware_proto_rawDescGZIP(), []int{0, 6, 0}
+}
+
+func (x *Middleware_FieldVisibility_Rule) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Middleware_FieldVisibility_Rule) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *Middleware_FieldVisibility_Rule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Middleware_FieldVisibility_Rule) GetMasker() string {
+	if x != nil {
+		return x.Masker
+	}
+	return ""
+}
+
+func (x *Middleware_FieldVisibility_Rule) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
 var File_common_conf_middleware_proto protoreflect.FileDescriptor
 
 var file_common_conf_middleware_proto_rawDesc = string([]byte{
@@ -494,7 +1141,7 @@ var file_common_conf_middleware_proto_rawDesc = string([]byte{
 	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04,
 	0x63, 0x6f, 0x6e, 0x66, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x08, 0x0a, 0x0a, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x16, 0x0a, 0x0a, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
 	0x61, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f,
 	0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x6e, 0x61,
 	0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e,
@@ -525,49 +1172,165 @@ var file_common_conf_middleware_proto_rawDesc = string([]byte{
 	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
 	0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x1a, 0xbe, 0x01, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x16, 0x0a,
-	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6c, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52,
+	0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x4b, 0x0a, 0x10, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x1a, 0xbe, 0x01, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x21, 0x0a, 0x0b, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x71, 0x0a, 0x07, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x61, 0x75, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x67, 0x61, 0x75,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x1a, 0x24, 0x0a, 0x08,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x1a, 0xdb, 0x02, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x40, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x3c, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73,
+	0x1a, 0xbc, 0x0a, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72,
+	0x2e, 0x43, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x52, 0x06, 0x63, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x12,
+	0x37, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x6f, 0x70, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x72, 0x2e, 0x4f, 0x70, 0x61, 0x52, 0x03, 0x6f, 0x70, 0x61, 0x12, 0x40, 0x0a, 0x08, 0x7a,
+	0x61, 0x6e, 0x7a, 0x69, 0x62, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x2e, 0x5a, 0x61, 0x6e, 0x7a, 0x69,
+	0x62, 0x61, 0x72, 0x52, 0x08, 0x7a, 0x61, 0x6e, 0x7a, 0x69, 0x62, 0x61, 0x72, 0x12, 0x3a, 0x0a,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x1a, 0xfb, 0x01, 0x0a, 0x06, 0x43, 0x61,
+	0x73, 0x62, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x73, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0x74, 0x0a, 0x05, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x21, 0x0a, 0x0b, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x71, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61,
-	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67,
-	0x61, 0x75, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x67, 0x61, 0x75, 0x67,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x1a, 0x24, 0x0a, 0x08, 0x4c,
-	0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x1a, 0xa6, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64, 0x64,
-	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
-	0x72, 0x2e, 0x43, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x52, 0x06, 0x63, 0x61, 0x73, 0x62, 0x69, 0x6e,
-	0x1a, 0x48, 0x0a, 0x06, 0x43, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x61, 0x74, 0x68, 0x42, 0x71, 0x0a, 0x08, 0x63, 0x6f,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x42, 0x0f, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
-	0x72, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0xa2,
-	0x02, 0x03, 0x43, 0x58, 0x58, 0xaa, 0x02, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0xca, 0x02, 0x04, 0x43,
-	0x6f, 0x6e, 0x66, 0xe2, 0x02, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x1a, 0x9f, 0x01,
+	0x0a, 0x03, 0x4f, 0x70, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x72,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0e, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a,
+	0xec, 0x02, 0x0a, 0x08, 0x5a, 0x61, 0x6e, 0x7a, 0x69, 0x62, 0x61, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x54, 0x0a, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x2e, 0x5a, 0x61, 0x6e, 0x7a,
+	0x69, 0x62, 0x61, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x5d, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x6d, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x44, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72,
+	0x2e, 0x5a, 0x61, 0x6e, 0x7a, 0x69, 0x62, 0x61, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6f,
+	0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a,
+	0xce, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x1a, 0x7e, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x42, 0x71, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x42, 0x0f, 0x4d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x24, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
+	0x3b, 0x63, 0x6f, 0x6e, 0x66, 0xa2, 0x02, 0x03, 0x43, 0x58, 0x58, 0xaa, 0x02, 0x04, 0x43, 0x6f,
+	0x6e, 0x66, 0xca, 0x02, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0xe2, 0x02, 0x10, 0x43, 0x6f, 0x6e, 0x66,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x04, 0x43,
+	0x6f, 0x6e, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -582,30 +1345,54 @@ func file_common_conf_middleware_proto_rawDescGZIP() []byte {
 	return file_common_conf_middleware_proto_rawDescData
 }
 
-var file_common_conf_middleware_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_common_conf_middleware_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_common_conf_middleware_proto_goTypes = []any{
-	(*Middleware)(nil),                   // 0: conf.Middleware
-	(*Middleware_Auth)(nil),              // 1: conf.Middleware.Auth
-	(*Middleware_RateLimiter)(nil),       // 2: conf.Middleware.RateLimiter
-	(*Middleware_Metrics)(nil),           // 3: conf.Middleware.Metrics
-	(*Middleware_Localize)(nil),          // 4: conf.Middleware.Localize
-	(*Middleware_Authorizer)(nil),        // 5: conf.Middleware.Authorizer
-	(*Middleware_Authorizer_Casbin)(nil), // 6: conf.Middleware.Authorizer.Casbin
-	(*durationpb.Duration)(nil),          // 7: google.protobuf.Duration
+	(*Middleware)(nil),                               // 0: conf.Middleware
+	(*Middleware_Auth)(nil),                          // 1: conf.Middleware.Auth
+	(*Middleware_RateLimiter)(nil),                   // 2: conf.Middleware.RateLimiter
+	(*Middleware_Metrics)(nil),                       // 3: conf.Middleware.Metrics
+	(*Middleware_Localize)(nil),                      // 4: conf.Middleware.Localize
+	(*Middleware_Captcha)(nil),                       // 5: conf.Middleware.Captcha
+	(*Middleware_Authorizer)(nil),                    // 6: conf.Middleware.Authorizer
+	(*Middleware_FieldVisibility)(nil),               // 7: conf.Middleware.FieldVisibility
+	(*Middleware_Authorizer_Casbin)(nil),             // 8: conf.Middleware.Authorizer.Casbin
+	(*Middleware_Authorizer_Cache)(nil),              // 9: conf.Middleware.Authorizer.Cache
+	(*Middleware_Authorizer_Opa)(nil),                // 10: conf.Middleware.Authorizer.Opa
+	(*Middleware_Authorizer_Zanzibar)(nil),           // 11: conf.Middleware.Authorizer.Zanzibar
+	(*Middleware_Authorizer_Remote)(nil),             // 12: conf.Middleware.Authorizer.Remote
+	(*Middleware_Authorizer_Zanzibar_Operation)(nil), // 13: conf.Middleware.Authorizer.Zanzibar.Operation
+	nil,                                     // 14: conf.Middleware.Authorizer.Zanzibar.OperationsEntry
+	(*Middleware_FieldVisibility_Rule)(nil), // 15: conf.Middleware.FieldVisibility.Rule
+	(*durationpb.Duration)(nil),             // 16: google.protobuf.Duration
 }
 var file_common_conf_middleware_proto_depIdxs = []int32{
-	2, // 0: conf.Middleware.limiter:type_name -> conf.Middleware.RateLimiter
-	3, // 1: conf.Middleware.metrics:type_name -> conf.Middleware.Metrics
-	1, // 2: conf.Middleware.auth:type_name -> conf.Middleware.Auth
-	5, // 3: conf.Middleware.authorizer:type_name -> conf.Middleware.Authorizer
-	4, // 4: conf.Middleware.localize:type_name -> conf.Middleware.Localize
-	7, // 5: conf.Middleware.Auth.expires_time:type_name -> google.protobuf.Duration
-	6, // 6: conf.Middleware.Authorizer.casbin:type_name -> conf.Middleware.Authorizer.Casbin
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	2,  // 0: conf.Middleware.limiter:type_name -> conf.Middleware.RateLimiter
+	3,  // 1: conf.Middleware.metrics:type_name -> conf.Middleware.Metrics
+	1,  // 2: conf.Middleware.auth:type_name -> conf.Middleware.Auth
+	6,  // 3: conf.Middleware.authorizer:type_name -> conf.Middleware.Authorizer
+	4,  // 4: conf.Middleware.localize:type_name -> conf.Middleware.Localize
+	5,  // 5: conf.Middleware.captcha:type_name -> conf.Middleware.Captcha
+	7,  // 6: conf.Middleware.field_visibility:type_name -> conf.Middleware.FieldVisibility
+	16, // 7: conf.Middleware.Auth.expires_time:type_name -> google.protobuf.Duration
+	16, // 8: conf.Middleware.Captcha.failure_window:type_name -> google.protobuf.Duration
+	16, // 9: conf.Middleware.Captcha.expires_time:type_name -> google.protobuf.Duration
+	8,  // 10: conf.Middleware.Authorizer.casbin:type_name -> conf.Middleware.Authorizer.Casbin
+	9,  // 11: conf.Middleware.Authorizer.cache:type_name -> conf.Middleware.Authorizer.Cache
+	10, // 12: conf.Middleware.Authorizer.opa:type_name -> conf.Middleware.Authorizer.Opa
+	11, // 13: conf.Middleware.Authorizer.zanzibar:type_name -> conf.Middleware.Authorizer.Zanzibar
+	12, // 14: conf.Middleware.Authorizer.remote:type_name -> conf.Middleware.Authorizer.Remote
+	15, // 15: conf.Middleware.FieldVisibility.rules:type_name -> conf.Middleware.FieldVisibility.Rule
+	16, // 16: conf.Middleware.Authorizer.Casbin.reload_interval:type_name -> google.protobuf.Duration
+	16, // 17: conf.Middleware.Authorizer.Cache.ttl:type_name -> google.protobuf.Duration
+	16, // 18: conf.Middleware.Authorizer.Opa.reload_interval:type_name -> google.protobuf.Duration
+	14, // 19: conf.Middleware.Authorizer.Zanzibar.operations:type_name -> conf.Middleware.Authorizer.Zanzibar.OperationsEntry
+	16, // 20: conf.Middleware.Authorizer.Remote.timeout:type_name -> google.protobuf.Duration
+	13, // 21: conf.Middleware.Authorizer.Zanzibar.OperationsEntry.value:type_name -> conf.Middleware.Authorizer.Zanzibar.Operation
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_common_conf_middleware_proto_init() }
@@ -619,7 +1406,7 @@ func file_common_conf_middleware_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_conf_middleware_proto_rawDesc), len(file_common_conf_middleware_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -45,7 +45,7 @@ func (r *authRepo) Login(ctx context.Context, name, password string, domainId ui
 	res, err := r.data.DB(ctx).User.Query().Select(user.FieldPassword, user.FieldName).Where(user.NameEQ(name), user.DomainIDEQ(domainId)).Only(ctx)
 	if err != nil {
 		r.log.Errorf("登录数据操作失败，用户名：%s，错误：%v", name, err)
-		return nil, err
+		return nil, entError(err, biz.ErrPasswordIncorrect, nil, errDBQuery)
 	}
 	if !crypto.CheckPasswordHash(password, *res.Password) {
 		r.log.Errorf("登录数据操作失败，用户名：%s，密码错误", name)
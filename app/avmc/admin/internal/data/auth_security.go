@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"backend-service/pkg/auth/authn"
+	authMiddleware "backend-service/pkg/auth/middleware"
 	"backend-service/pkg/utils/convert"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -87,13 +88,8 @@ func (su *securityUser) Name() string {
 func (su *securityUser) ParseFromContext(ctx context.Context) error {
 	if header, ok := transport.FromServerContext(ctx); ok {
 		su.object = header.Operation()
-		su.action = "*"
-		// if header.Kind() == transport.KindHTTP {
-		// 	if ht, ok := header.(http.Transporter); ok {
-		// 		su.object = ht.Request().URL.Object
-		// 		su.action = ht.Request().Action
-		// 	}
-		// }
+		// 与鉴权中间件使用相同的操作模型
+		su.action = string(authMiddleware.HTTPMethodAction(ctx, header))
 	} else {
 		return errors.New("parse from request header")
 	}
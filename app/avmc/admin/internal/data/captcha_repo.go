@@ -0,0 +1,203 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/mojocn/base64Captcha"
+	"github.com/redis/go-redis/v9"
+
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/conf"
+	"backend-service/pkg/utils/ip"
+)
+
+const (
+	// captchaKeyPrefix 验证码答案缓存键前缀
+	captchaKeyPrefix = "admin_captcha_"
+	// captchaFailureKeyPrefix 失败次数缓存键前缀
+	captchaFailureKeyPrefix = "admin_captcha_fail_"
+)
+
+var _ biz.CaptchaRepo = (*captchaRepo)(nil)
+
+// captchaRepo 验证码数据仓库，图片在进程内生成，答案存储在Redis中
+type captchaRepo struct {
+	rdb           *redis.Client
+	log           *log.Helper
+	captcha       *base64Captcha.Captcha
+	options       biz.CaptchaOptions
+	failureWindow time.Duration
+}
+
+// NewCaptchaRepo 创建新的验证码数据仓库实例
+// 参数：c 服务配置，data 数据源，logger 日志记录器
+// 返回值：验证码数据仓库接口
+func NewCaptchaRepo(c *conf.Server, data *Data, logger log.Logger) biz.CaptchaRepo {
+	l := log.NewHelper(log.With(logger, "module", "captcha/data"))
+	cfg := c.GetHttp().GetMiddleware().GetCaptcha()
+
+	opts := biz.CaptchaOptions{
+		Enable:      cfg.GetEnable(),
+		Mode:        biz.CaptchaMode(cfg.GetMode()),
+		MaxFailures: int64(cfg.GetMaxFailures()),
+		Expires:     cfg.GetExpiresTime().AsDuration(),
+	}
+	// 默认始终校验
+	if opts.Mode != biz.CaptchaModeFailure {
+		opts.Mode = biz.CaptchaModeAlways
+	}
+	// 默认失败3次后校验
+	if opts.MaxFailures <= 0 {
+		opts.MaxFailures = 3
+	}
+	// 验证码有效期默认 5分钟
+	if opts.Expires <= 0 {
+		opts.Expires = time.Minute * 5
+	}
+	trusted, err := ip.ParseTrustedProxies(cfg.GetTrustedProxies())
+	if err != nil {
+		l.Fatalf("failed parsing captcha trusted proxies: %s", err.Error())
+	}
+	opts.TrustedProxies = trusted
+	// 失败次数统计窗口默认 15分钟
+	failureWindow := cfg.GetFailureWindow().AsDuration()
+	if failureWindow <= 0 {
+		failureWindow = time.Minute * 15
+	}
+
+	store := &captchaStore{rdb: data.rdb, log: l, expires: opts.Expires}
+	return &captchaRepo{
+		rdb:           data.rdb,
+		log:           l,
+		captcha:       base64Captcha.NewCaptcha(newCaptchaDriver(cfg), store),
+		options:       opts,
+		failureWindow: failureWindow,
+	}
+}
+
+// newCaptchaDriver 根据配置创建验证码图片驱动
+func newCaptchaDriver(cfg *conf.Middleware_Captcha) base64Captcha.Driver {
+	length, width, height := int(cfg.GetLength()), int(cfg.GetWidth()), int(cfg.GetHeight())
+	if length <= 0 {
+		length = 4
+	}
+	if width <= 0 {
+		width = 240
+	}
+	if height <= 0 {
+		height = 80
+	}
+	switch cfg.GetType() {
+	case "string":
+		return base64Captcha.NewDriverString(height, width, 0, base64Captcha.OptionShowHollowLine, length,
+			base64Captcha.TxtNumbers+base64Captcha.TxtAlphabet, nil, nil, nil)
+	case "digit":
+		return base64Captcha.NewDriverDigit(height, width, length, 0.7, 80)
+	default:
+		return base64Captcha.NewDriverMath(height, width, 0, base64Captcha.OptionShowHollowLine, nil, nil, nil)
+	}
+}
+
+// Options 获取验证码校验策略
+func (r *captchaRepo) Options() biz.CaptchaOptions {
+	return r.options
+}
+
+// Generate 生成验证码，返回验证码ID与base64图片
+func (r *captchaRepo) Generate(ctx context.Context) (string, string, error) {
+	id, image, _, err := r.captcha.Generate()
+	if err != nil {
+		return "", "", err
+	}
+	return id, image, nil
+}
+
+// Verify 校验验证码，验证码只能使用一次
+func (r *captchaRepo) Verify(ctx context.Context, id, code string) bool {
+	answer, err := r.rdb.GetDel(ctx, captchaKeyPrefix+id).Result()
+	if err != nil {
+		if err != redis.Nil {
+			r.log.Errorf("获取验证码失败，ID：%s，错误：%v", id, err)
+		}
+		return false
+	}
+	return strings.EqualFold(strings.TrimSpace(code), answer)
+}
+
+// Failures 获取失败次数，多个键时返回最大值
+func (r *captchaRepo) Failures(ctx context.Context, keys ...string) (int64, error) {
+	var failures int64
+	for _, key := range keys {
+		n, err := r.rdb.Get(ctx, captchaFailureKeyPrefix+key).Int64()
+		if err != nil && err != redis.Nil {
+			return 0, err
+		}
+		if n > failures {
+			failures = n
+		}
+	}
+	return failures, nil
+}
+
+// IncrFailures 累加失败次数，统计窗口从首次失败开始计算
+func (r *captchaRepo) IncrFailures(ctx context.Context, keys ...string) error {
+	pipe := r.rdb.TxPipeline()
+	for _, key := range keys {
+		pipe.Incr(ctx, captchaFailureKeyPrefix+key)
+		pipe.ExpireNX(ctx, captchaFailureKeyPrefix+key, r.failureWindow)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ResetFailures 重置失败次数
+func (r *captchaRepo) ResetFailures(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	redisKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		redisKeys = append(redisKeys, captchaFailureKeyPrefix+key)
+	}
+	return r.rdb.Del(ctx, redisKeys...).Err()
+}
+
+var _ base64Captcha.Store = (*captchaStore)(nil)
+
+// captchaStore 基于Redis的验证码答案存储
+type captchaStore struct {
+	rdb     *redis.Client
+	log     *log.Helper
+	expires time.Duration
+}
+
+// Set 存储验证码答案
+func (s *captchaStore) Set(id string, value string) error {
+	return s.rdb.Set(context.Background(), captchaKeyPrefix+id, value, s.expires).Err()
+}
+
+// Get 获取验证码答案，clear 为 true 时同时删除
+func (s *captchaStore) Get(id string, clear bool) string {
+	var (
+		value string
+		err   error
+	)
+	if clear {
+		value, err = s.rdb.GetDel(context.Background(), captchaKeyPrefix+id).Result()
+	} else {
+		value, err = s.rdb.Get(context.Background(), captchaKeyPrefix+id).Result()
+	}
+	if err != nil && err != redis.Nil {
+		s.log.Errorf("获取验证码失败，ID：%s，错误：%v", id, err)
+	}
+	return value
+}
+
+// Verify 校验验证码答案
+func (s *captchaStore) Verify(id, answer string, clear bool) bool {
+	value := s.Get(id, clear)
+	return value != "" && strings.EqualFold(strings.TrimSpace(answer), value)
+}
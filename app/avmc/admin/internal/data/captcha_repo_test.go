@@ -0,0 +1,63 @@
+package data
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"backend-service/app/avmc/admin/internal/conf"
+)
+
+func TestCaptchaRepo(t *testing.T) {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR not set")
+	}
+	ctx := context.Background()
+	rdb := redis.NewClient(&redis.Options{Addr: addr})
+	defer rdb.Close()
+	c := &conf.Server{Http: &conf.Server_HTTP{Middleware: &conf.Middleware{Captcha: &conf.Middleware_Captcha{
+		Enable:        true,
+		Mode:          "failure",
+		MaxFailures:   2,
+		ExpiresTime:   durationpb.New(time.Second),
+		FailureWindow: durationpb.New(time.Second),
+	}}}}
+	repo := NewCaptchaRepo(c, &Data{rdb: rdb}, log.DefaultLogger).(*captchaRepo)
+	store := repo.captcha.Store
+	keys := []string{"ip:test-" + t.Name(), "user:test-" + t.Name()}
+	require.NoError(t, repo.ResetFailures(ctx, keys...))
+
+	// 答错后验证码失效，正确答案也无法再使用
+	require.NoError(t, store.Set("wrong", "42"))
+	assert.False(t, repo.Verify(ctx, "wrong", "41"))
+	assert.False(t, repo.Verify(ctx, "wrong", "42"))
+	require.NoError(t, store.Set("right", "AbC"))
+	assert.True(t, repo.Verify(ctx, "right", " abc "))
+
+	// 失败次数取各维度最大值，统计窗口过期后清零
+	require.NoError(t, repo.IncrFailures(ctx, keys...))
+	require.NoError(t, repo.IncrFailures(ctx, keys[0]))
+	n, err := repo.Failures(ctx, keys...)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	require.NoError(t, repo.ResetFailures(ctx, keys[1]))
+	n, err = repo.Failures(ctx, keys[1])
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	// 验证码与失败次数均按有效期过期
+	require.NoError(t, store.Set("expired", "42"))
+	time.Sleep(1100 * time.Millisecond)
+	assert.False(t, repo.Verify(ctx, "expired", "42"))
+	n, err = repo.Failures(ctx, keys...)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
@@ -0,0 +1,271 @@
+package data
+
+import (
+	"context"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+
+	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/casbinrule"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
+	authzCasbin "backend-service/pkg/auth/authz/casbin"
+)
+
+// casbinLoadBatchSize 加载策略时的分批大小，避免受全局查询条数限制影响
+const casbinLoadBatchSize = 1000
+
+var (
+	_ persist.Adapter             = (*CasbinAdapter)(nil)
+	_ persist.BatchAdapter        = (*CasbinAdapter)(nil)
+	_ persist.ContextBatchAdapter = (*CasbinAdapter)(nil)
+	_ authzCasbin.TxAdapter       = (*CasbinAdapter)(nil)
+)
+
+// CasbinAdapter 基于 ent 的 Casbin 策略适配器，策略与业务数据保存在同一数据库
+// 带上下文的写入使用上下文中的事务，与同一事务中的业务数据一起提交或回滚
+type CasbinAdapter struct {
+	data *Data
+	// onRollback 写入所在的事务回滚时调用，用于重新加载授权器内存中的策略
+	onRollback func(ctx context.Context)
+}
+
+// NewCasbinAdapter 创建基于 ent 的策略适配器
+// 参数：data 数据访问对象
+// 返回值：策略适配器
+func NewCasbinAdapter(data *Data) *CasbinAdapter {
+	return &CasbinAdapter{data: data}
+}
+
+// LoadPolicy 按 ID 顺序分批加载全部策略
+func (a *CasbinAdapter) LoadPolicy(m model.Model) error {
+	return a.LoadPolicyCtx(context.Background(), m)
+}
+
+// LoadPolicyCtx 按 ID 顺序分批加载全部策略
+func (a *CasbinAdapter) LoadPolicyCtx(ctx context.Context, m model.Model) error {
+	var lastID uint64
+	for {
+		rules, err := a.data.DB(ctx).CasbinRule.Query().
+			Where(casbinrule.IDGT(lastID)).
+			Order(gen.Asc(casbinrule.FieldID)).
+			Limit(casbinLoadBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, rule := range rules {
+			if err := persist.LoadPolicyArray(casbinRuleLine(rule), m); err != nil {
+				return err
+			}
+		}
+		if len(rules) < casbinLoadBatchSize {
+			return nil
+		}
+		lastID = rules[len(rules)-1].ID
+	}
+}
+
+// SavePolicy 在事务中清空并重新写入全部策略
+func (a *CasbinAdapter) SavePolicy(m model.Model) error {
+	return a.SavePolicyCtx(context.Background(), m)
+}
+
+// SavePolicyCtx 在事务中清空并重新写入全部策略
+func (a *CasbinAdapter) SavePolicyCtx(ctx context.Context, m model.Model) error {
+	return a.inTx(ctx, func(ctx context.Context, client *gen.Client) error {
+		if _, err := client.CasbinRule.Delete().Exec(ctx); err != nil {
+			return err
+		}
+		for _, sec := range []string{"p", "g"} {
+			for ptype, ast := range m[sec] {
+				if err := createCasbinRules(ctx, client, ptype, ast.Policy); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// AddPolicy 添加策略
+func (a *CasbinAdapter) AddPolicy(sec string, ptype string, rule []string) error {
+	return a.AddPolicyCtx(context.Background(), sec, ptype, rule)
+}
+
+// AddPolicyCtx 添加策略
+func (a *CasbinAdapter) AddPolicyCtx(ctx context.Context, sec string, ptype string, rule []string) error {
+	return a.AddPoliciesCtx(ctx, sec, ptype, [][]string{rule})
+}
+
+// AddPolicies 批量添加策略
+func (a *CasbinAdapter) AddPolicies(sec string, ptype string, rules [][]string) error {
+	return a.AddPoliciesCtx(context.Background(), sec, ptype, rules)
+}
+
+// AddPoliciesCtx 批量添加策略
+func (a *CasbinAdapter) AddPoliciesCtx(ctx context.Context, sec string, ptype string, rules [][]string) error {
+	return a.inTx(ctx, func(ctx context.Context, client *gen.Client) error {
+		return createCasbinRules(ctx, client, ptype, rules)
+	})
+}
+
+// RemovePolicy 移除策略
+func (a *CasbinAdapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	return a.RemovePolicyCtx(context.Background(), sec, ptype, rule)
+}
+
+// RemovePolicyCtx 移除策略
+func (a *CasbinAdapter) RemovePolicyCtx(ctx context.Context, sec string, ptype string, rule []string) error {
+	return a.RemovePoliciesCtx(ctx, sec, ptype, [][]string{rule})
+}
+
+// RemovePolicies 批量移除策略
+func (a *CasbinAdapter) RemovePolicies(sec string, ptype string, rules [][]string) error {
+	return a.RemovePoliciesCtx(context.Background(), sec, ptype, rules)
+}
+
+// RemovePoliciesCtx 批量移除策略
+func (a *CasbinAdapter) RemovePoliciesCtx(ctx context.Context, sec string, ptype string, rules [][]string) error {
+	return a.inTx(ctx, func(ctx context.Context, client *gen.Client) error {
+		for _, rule := range rules {
+			if _, err := client.CasbinRule.Delete().Where(casbinRuleEQ(ptype, rule)...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveFilteredPolicy 按字段过滤移除策略，空字段值表示不限制
+func (a *CasbinAdapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	return a.RemoveFilteredPolicyCtx(context.Background(), sec, ptype, fieldIndex, fieldValues...)
+}
+
+// RemoveFilteredPolicyCtx 按字段过滤移除策略，空字段值表示不限制
+func (a *CasbinAdapter) RemoveFilteredPolicyCtx(ctx context.Context, sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	ps := []predicate.CasbinRule{casbinrule.Ptype(ptype)}
+	for i, value := range fieldValues {
+		if value == "" {
+			continue
+		}
+		if p := casbinRuleField(fieldIndex+i, value); p != nil {
+			ps = append(ps, p)
+		}
+	}
+	return a.inTx(ctx, func(ctx context.Context, client *gen.Client) error {
+		_, err := client.CasbinRule.Delete().Where(ps...).Exec(ctx)
+		return err
+	})
+}
+
+// OnRollback 设置写入所在的事务回滚时的回调
+func (a *CasbinAdapter) OnRollback(fn func(ctx context.Context)) {
+	a.onRollback = fn
+}
+
+// AfterCommit 上下文中有事务时登记事务提交后的回调并返回 true，否则返回 false
+// casbin 授权器借此在事务提交后才更新内存中的策略并通知其它实例
+func (a *CasbinAdapter) AfterCommit(ctx context.Context, fn func(ctx context.Context)) bool {
+	tx := gen.TxFromContext(ctx)
+	if tx == nil {
+		return false
+	}
+	tx.OnCommit(func(next gen.Committer) gen.Committer {
+		return gen.CommitFunc(func(ctx context.Context, tx *gen.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			fn(context.WithoutCancel(ctx))
+			return nil
+		})
+	})
+	return true
+}
+
+// inTx 在事务中执行写入，上下文中已有事务时加入该事务，并在其回滚时调用 onRollback
+func (a *CasbinAdapter) inTx(ctx context.Context, fn func(ctx context.Context, client *gen.Client) error) error {
+	if tx := gen.TxFromContext(ctx); tx != nil && a.onRollback != nil {
+		onRollback := a.onRollback
+		tx.OnRollback(func(next gen.Rollbacker) gen.Rollbacker {
+			return gen.RollbackFunc(func(ctx context.Context, tx *gen.Tx) error {
+				err := next.Rollback(ctx, tx)
+				onRollback(context.WithoutCancel(ctx))
+				return err
+			})
+		})
+	}
+	return a.data.InTx(ctx, func(ctx context.Context) error {
+		return fn(ctx, a.data.DB(ctx))
+	})
+}
+
+// rollback 回滚事务并返回原始错误
+func rollback(tx *gen.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return rerr
+	}
+	return err
+}
+
+// createCasbinRules 批量写入策略
+func createCasbinRules(ctx context.Context, client *gen.Client, ptype string, rules [][]string) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	builders := make([]*gen.CasbinRuleCreate, 0, len(rules))
+	for _, rule := range rules {
+		v := casbinRuleValues(rule)
+		builders = append(builders, client.CasbinRule.Create().
+			SetPtype(ptype).
+			SetV0(v[0]).SetV1(v[1]).SetV2(v[2]).
+			SetV3(v[3]).SetV4(v[4]).SetV5(v[5]))
+	}
+	return client.CasbinRule.CreateBulk(builders...).Exec(ctx)
+}
+
+// casbinRuleValues 将策略补齐为 6 个字段
+func casbinRuleValues(rule []string) [6]string {
+	var v [6]string
+	copy(v[:], rule)
+	return v
+}
+
+// casbinRuleEQ 构造与策略完全匹配的查询条件
+func casbinRuleEQ(ptype string, rule []string) []predicate.CasbinRule {
+	v := casbinRuleValues(rule)
+	ps := []predicate.CasbinRule{casbinrule.Ptype(ptype)}
+	for i, value := range v {
+		ps = append(ps, casbinRuleField(i, value))
+	}
+	return ps
+}
+
+// casbinRuleField 按字段下标构造查询条件
+func casbinRuleField(index int, value string) predicate.CasbinRule {
+	switch index {
+	case 0:
+		return casbinrule.V0(value)
+	case 1:
+		return casbinrule.V1(value)
+	case 2:
+		return casbinrule.V2(value)
+	case 3:
+		return casbinrule.V3(value)
+	case 4:
+		return casbinrule.V4(value)
+	case 5:
+		return casbinrule.V5(value)
+	default:
+		return nil
+	}
+}
+
+// casbinRuleLine 将策略记录转换为策略行，去除末尾空字段
+func casbinRuleLine(rule *gen.CasbinRule) []string {
+	line := []string{rule.Ptype, rule.V0, rule.V1, rule.V2, rule.V3, rule.V4, rule.V5}
+	for len(line) > 1 && line[len(line)-1] == "" {
+		line = line[:len(line)-1]
+	}
+	return line
+}
@@ -0,0 +1,107 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authzEngine "backend-service/pkg/auth/authz"
+	authzCasbin "backend-service/pkg/auth/authz/casbin"
+)
+
+func TestCasbinAdapter(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t)
+	provider := authzCasbin.NewProvider()
+
+	writer, err := provider.NewAuthorizer(ctx, authzCasbin.WithAdapter(NewCasbinAdapter(&Data{db: client})))
+	require.NoError(t, err)
+	_, err = writer.AddPolicies(ctx, []authzEngine.Policy{
+		{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "default"},
+		{Subject: "admin", Object: "/api/users", Action: "DELETE", Domain: "default"},
+		{Subject: "guest", Object: "/api/users", Action: "GET", Domain: "default"},
+	})
+	require.NoError(t, err)
+	_, err = writer.RemovePolicy(ctx, authzEngine.Policy{Subject: "admin", Object: "/api/users", Action: "DELETE", Domain: "default"})
+	require.NoError(t, err)
+
+	count, err := client.CasbinRule.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	reader, err := provider.NewAuthorizer(ctx, authzCasbin.WithAdapter(NewCasbinAdapter(&Data{db: client})))
+	require.NoError(t, err)
+	allowed, _ := reader.Enforce(ctx, "admin", "/api/users", "GET", "default")
+	assert.True(t, allowed)
+	allowed, _ = reader.Enforce(ctx, "admin", "/api/users", "DELETE", "default")
+	assert.False(t, allowed)
+
+	require.NoError(t, NewCasbinAdapter(&Data{db: client}).RemoveFilteredPolicy("p", "p", 0, "guest"))
+	count, err = client.CasbinRule.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestCasbinAdapterTx(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t)
+	d := &Data{db: client}
+	bus := authzCasbin.NewLocalBus()
+	newAuthorizer := func() authzEngine.Authorizer {
+		authorizer, err := authzCasbin.NewProvider().NewAuthorizer(ctx,
+			authzCasbin.WithAdapter(NewCasbinAdapter(d)),
+			authzEngine.WithEnableWatcher(true),
+			authzEngine.WithWatcherType(authzCasbin.WatcherLocal),
+			authzEngine.WithWatcherOption(authzCasbin.WatcherOptionBus, bus),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = authorizer.Close() })
+		return authorizer
+	}
+	writer, reader := newAuthorizer(), newAuthorizer()
+	admin := authzEngine.Policy{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "default"}
+	count := func() int {
+		n, err := client.CasbinRule.Query().Count(ctx)
+		require.NoError(t, err)
+		return n
+	}
+	enforce := func(a authzEngine.Authorizer, sub authzEngine.Subject) bool {
+		allowed, _ := a.Enforce(ctx, sub, "/api/users", "GET", "default")
+		return allowed
+	}
+
+	// 事务回滚时策略写入一并回滚，变更未进入内存，也未通知其它实例
+	errRollback := errors.New("rollback")
+	err := d.InTx(ctx, func(ctx context.Context) error {
+		if _, err := writer.AddPolicy(ctx, admin); err != nil {
+			return err
+		}
+		if _, err := writer.AddRoleForUser(ctx, "alice", "admin", "default"); err != nil {
+			return err
+		}
+		// 提交前其它请求看不到未提交的策略
+		assert.False(t, enforce(writer, "alice"))
+		return errRollback
+	})
+	assert.ErrorIs(t, err, errRollback)
+	assert.Equal(t, 0, count())
+	assert.False(t, enforce(writer, "admin"))
+	assert.False(t, enforce(reader, "admin"))
+	assert.False(t, enforce(reader, "alice"))
+
+	// 事务提交后更新内存并通知其它实例
+	require.NoError(t, d.InTx(ctx, func(ctx context.Context) error {
+		if _, err := writer.AddPolicy(ctx, admin); err != nil {
+			return err
+		}
+		_, err := writer.AddRoleForUser(ctx, "alice", "admin", "default")
+		return err
+	}))
+	assert.Equal(t, 2, count())
+	assert.True(t, enforce(writer, "alice"))
+	assert.Eventually(t, func() bool { return enforce(reader, "alice") }, time.Second, 10*time.Millisecond)
+}
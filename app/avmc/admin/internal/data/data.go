@@ -15,15 +15,14 @@ import (
 	redisotel "github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 
-	// entrapper "github.com/casbin/ent-adapter"
-
-	// casbinmodel "github.com/casbin/casbin/v2/model"
-
 	authnEngine "backend-service/pkg/auth/authn"
 	authnJwt "backend-service/pkg/auth/authn/jwt"
 
 	authzEngine "backend-service/pkg/auth/authz"
+	authzCache "backend-service/pkg/auth/authz/cache"
 	authzCasbin "backend-service/pkg/auth/authz/casbin"
+	authzOPA "backend-service/pkg/auth/authz/opa"
+	authzZanzibar "backend-service/pkg/auth/authz/zanzibar"
 
 	_ "github.com/go-sql-driver/mysql"
 )
@@ -40,6 +39,13 @@ var ProviderSet = wire.NewSet(
 	NewMenuRepo,
 	NewPostRepo,
 	NewDeptRepo,
+	NewCaptchaRepo,
+	NewPolicyRepo,
+	NewExportRepo,
+	NewSearchIndexer,
+	NewSearchRepo,
+	NewTrashRepo,
+	NewHistoryRepo,
 )
 
 // Data .
@@ -188,28 +194,152 @@ func NewAuthenticator(c *conf.Server, logger log.Logger, authSecurity *AuthSecur
 }
 
 // NewAuthorizer 创建权鉴器
-func NewAuthorizer(cfg *conf.Data, logger log.Logger) authzEngine.Authorizer {
+// 授权引擎由 middleware.authorizer.type 指定，支持 casbin（默认）、opa 与 zanzibar
+// 使用 ent 适配器时策略写入可加入业务事务，casbin 授权器在事务提交后才更新内存并通知其它实例，
+// 在写入时即更新内存的授权器（如 opa）在事务回滚后重新加载策略
+func NewAuthorizer(c *conf.Server, cfg *conf.Data, data *Data, logger log.Logger) (authzEngine.Authorizer, func()) {
 	l := log.NewHelper(log.With(logger, "module", "authorizer/auth/initialize"))
-	// adapter, err := entrapper.NewAdapter(cfg.Database.Driver, cfg.Database.Source)
-	// if err != nil {
-	// 	l.Fatalf("failed creating adapter: %s", err.Error())
-	// 	panic(err)
-	// }
-	// model, err := casbinmodel.NewModelFromString(authzCasbin.DefaultAbacModel)
-	// if err != nil {
-	// 	log.Fatalf("failed casbin model connection %v", err)
-	// }
-
-	provider := authzCasbin.NewProvider()
-	authorizer, err := provider.NewAuthorizer(
-		context.Background(),
-		authzEngine.WithAdapterType(authzEngine.AdapterMySQL),
-		authzEngine.WithAdapterDSN(cfg.Database.Source),
+
+	authzCfg := c.GetHttp().GetMiddleware().GetAuthorizer()
+	adapter := NewCasbinAdapter(data)
+	var (
+		provider authzEngine.AuthzProvider
+		opts     []authzEngine.Option
+		// reloadOnRollback 授权器写入时即更新内存，需在事务回滚后重新加载
+		reloadOnRollback bool
 	)
+	switch authzEngine.EngineType(authzCfg.GetType()) {
+	case authzEngine.EngineOPA:
+		provider, opts = authzOPA.NewProvider(), opaOptions(authzCfg.GetOpa(), adapter)
+		reloadOnRollback = true
+	case authzEngine.EngineZanzibar:
+		provider, opts = authzZanzibar.NewProvider(), zanzibarOptions(authzCfg.GetZanzibar(), data.db)
+	default:
+		provider, opts = authzCasbin.NewProvider(), casbinOptions(authzCfg, cfg, adapter, data.rdb)
+	}
 
+	authorizer, err := provider.NewAuthorizer(context.Background(), opts...)
 	if err != nil {
 		l.Fatalf("failed creating authorizer: %s", err.Error())
 		panic(err)
 	}
-	return authorizer
+	if reloader, ok := authorizer.(interface{ Reload(context.Context) error }); ok && reloadOnRollback {
+		adapter.OnRollback(func(ctx context.Context) {
+			if err := reloader.Reload(ctx); err != nil {
+				l.Errorf("failed reloading policies after rollback: %s", err.Error())
+			}
+		})
+	}
+	cleanup := func() {
+		if err := authorizer.Close(); err != nil {
+			l.Error(err)
+		}
+	}
+	return authorizer, cleanup
+}
+
+// casbinOptions casbin 授权器选项
+// 策略适配器由 casbin.adapter 指定，默认使用 ent 适配器，与业务数据同库
+// 多实例部署时通过 watcher 同步策略变更，reload_interval 定期全量重新加载作为兜底
+func casbinOptions(authzCfg *conf.Middleware_Authorizer, cfg *conf.Data, entAdapter *CasbinAdapter, rdb *redis.Client) []authzEngine.Option {
+	casbinCfg := authzCfg.GetCasbin()
+	opts := make([]authzEngine.Option, 0, 4)
+	if casbinCfg.GetModelPath() != "" {
+		opts = append(opts,
+			authzEngine.WithModelFormat(authzEngine.ModelFormatFile),
+			authzEngine.WithModelFile(casbinCfg.GetModelPath()),
+		)
+	}
+	dsn := casbinCfg.GetDsn()
+	switch adapter := authzEngine.AdapterType(casbinCfg.GetAdapter()); adapter {
+	case "", "ent":
+		opts = append(opts, authzCasbin.WithAdapter(entAdapter))
+	case authzEngine.AdapterMySQL, authzEngine.AdapterPostgres:
+		if dsn == "" {
+			dsn = cfg.Database.Source
+		}
+		opts = append(opts, authzEngine.WithAdapterType(adapter), authzEngine.WithAdapterDSN(dsn))
+	case authzEngine.AdapterRedis:
+		opts = append(opts, authzEngine.WithAdapterType(adapter), authzEngine.WithAdapterDSN(dsn))
+		if dsn == "" {
+			opts = append(opts, authzCasbin.WithRedisClient(rdb))
+		}
+	case authzEngine.AdapterFile:
+		if dsn == "" {
+			dsn = casbinCfg.GetPolicyPath()
+		}
+		opts = append(opts, authzEngine.WithAdapterType(adapter), authzEngine.WithAdapterDSN(dsn))
+	default:
+		opts = append(opts, authzEngine.WithAdapterType(adapter), authzEngine.WithAdapterDSN(dsn))
+	}
+
+	if watcher := casbinCfg.GetWatcher(); watcher != "" {
+		opts = append(opts,
+			authzEngine.WithEnableWatcher(true),
+			authzEngine.WithWatcherType(watcher),
+			authzEngine.WithWatcherOption(authzCasbin.WatcherOptionChannel, casbinCfg.GetWatcherChannel()),
+			authzEngine.WithWatcherOption(authzCasbin.WatcherOptionClient, rdb),
+		)
+	}
+	if interval := casbinCfg.GetReloadInterval().AsDuration(); interval > 0 {
+		opts = append(opts, authzCasbin.WithReloadInterval(interval))
+	}
+	if cacheCfg := authzCfg.GetCache(); cacheCfg.GetEnable() {
+		opts = append(opts,
+			authzEngine.WithEnableCache(true),
+			authzEngine.WithCacheType(cacheCfg.GetType()),
+			authzEngine.WithCacheOption(authzCache.OptionClient, rdb),
+		)
+		if size := cacheCfg.GetSize(); size > 0 {
+			opts = append(opts, authzEngine.WithCacheOption(authzCache.OptionSize, int(size)))
+		}
+		if ttl := cacheCfg.GetTtl().AsDuration(); ttl > 0 {
+			opts = append(opts, authzEngine.WithCacheOption(authzCache.OptionTTL, ttl))
+		}
+	}
+
+	return opts
+}
+
+// opaOptions OPA 授权器选项，策略与角色规则和 casbin 共用 ent 适配器
+func opaOptions(opaCfg *conf.Middleware_Authorizer_Opa, adapter *CasbinAdapter) []authzEngine.Option {
+	opts := []authzEngine.Option{authzOPA.WithAdapter(adapter)}
+	if opaCfg.GetPolicyPath() != "" {
+		opts = append(opts,
+			authzEngine.WithModelFormat(authzEngine.ModelFormatFile),
+			authzEngine.WithModelFile(opaCfg.GetPolicyPath()),
+		)
+	}
+	if opaCfg.GetQuery() != "" {
+		opts = append(opts, authzOPA.WithQuery(opaCfg.GetQuery()))
+	}
+	if len(opaCfg.GetDataPaths()) > 0 {
+		opts = append(opts, authzOPA.WithDataFiles(opaCfg.GetDataPaths()...))
+	}
+	if interval := opaCfg.GetReloadInterval().AsDuration(); interval > 0 {
+		opts = append(opts, authzOPA.WithReloadInterval(interval))
+	}
+	return opts
+}
+
+// zanzibarOptions Zanzibar 授权器选项，关系元组保存在 relation_tuple 表
+func zanzibarOptions(zanzibarCfg *conf.Middleware_Authorizer_Zanzibar, db *gen.Client) []authzEngine.Option {
+	opts := []authzEngine.Option{authzZanzibar.WithStore(NewRelationTupleStore(db))}
+	if zanzibarCfg.GetSchemaPath() != "" {
+		opts = append(opts,
+			authzEngine.WithModelFormat(authzEngine.ModelFormatFile),
+			authzEngine.WithModelFile(zanzibarCfg.GetSchemaPath()),
+		)
+	}
+	if depth := zanzibarCfg.GetMaxDepth(); depth > 0 {
+		opts = append(opts, authzZanzibar.WithMaxDepth(int(depth)))
+	}
+	if len(zanzibarCfg.GetOperations()) > 0 {
+		operations := make(map[string]authzZanzibar.Operation, len(zanzibarCfg.GetOperations()))
+		for name, op := range zanzibarCfg.GetOperations() {
+			operations[name] = authzZanzibar.Operation{Namespace: op.GetNamespace(), Object: op.GetObject(), Relation: op.GetRelation()}
+		}
+		opts = append(opts, authzZanzibar.WithOperations(operations))
+	}
+	return opts
 }
@@ -0,0 +1,27 @@
+package data
+
+import (
+	"context"
+	stdsql "database/sql"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	_ "github.com/glebarez/go-sqlite"
+	"github.com/stretchr/testify/require"
+
+	"backend-service/app/avmc/admin/internal/data/ent/gen"
+)
+
+// newTestEntClient 创建基于内存 sqlite 的 ent 客户端，数据库以测试名称命名
+// 参数：t 测试，opts 迁移选项，顶级记录的上级ID为 0 的表需传入 migrate.WithForeignKeys(false)
+// 返回值：ent 客户端，测试结束时关闭
+func newTestEntClient(t *testing.T, opts ...schema.MigrateOption) *gen.Client {
+	db, err := stdsql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	require.NoError(t, err)
+	client := gen.NewClient(gen.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { _ = client.Close() })
+	require.NoError(t, client.Schema.Create(context.Background(), opts...))
+	return client
+}
@@ -2,10 +2,12 @@ package data
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"slices"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
 	"github.com/go-kratos/kratos/v2/log"
 
 	pbPagination "backend-service/api/common/pagination"
@@ -13,11 +15,27 @@ import (
 	"backend-service/app/avmc/admin/internal/biz"
 	"backend-service/app/avmc/admin/internal/data/ent/gen"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/dept"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
+	"backend-service/app/avmc/admin/internal/data/ent/mixins"
+	"backend-service/pkg/entgo"
 	"backend-service/pkg/utils/convert"
+	"backend-service/pkg/utils/trans"
 )
 
 var _ biz.DeptRepo = (*deptRepo)(nil)
 
+// deptListBatchSize 查询所有部门时每批查询的行数
+const deptListBatchSize = 1000
+
+// lockRows 作为查询条件锁定查询到的行直到事务结束，须在事务中使用
+// SQLite 不支持 SELECT ... FOR UPDATE，其写事务本身串行执行，不加锁
+func lockRows(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate()
+	}
+}
+
 type deptRepo struct {
 	data *Data
 	log  *log.Helper
@@ -41,6 +59,10 @@ func (r *deptRepo) toProto(res *gen.Dept) *pbCore.Dept {
 		ParentId:  res.ParentID,
 		CreatedAt: convert.TimeValueToString(&res.CreatedAt, time.DateTime),
 		UpdatedAt: convert.TimeValueToString(&res.UpdatedAt, time.DateTime),
+		Version:   &res.Version,
+		CreatedBy: res.CreatedBy,
+		UpdatedBy: res.UpdatedBy,
+		Ancestors: convert.SliceToAny(res.Ancestors, func(id int) uint32 { return uint32(id) }),
 	}
 }
 
@@ -53,26 +75,37 @@ func (r *deptRepo) toEnt(g *pbCore.Dept) *gen.Dept {
 	}
 }
 
-// Save 保存部门信息
+// Save 保存部门信息，祖级列表按上级部门计算
 // 参数：ctx 上下文，g 部门信息
 // 返回值：部门信息，错误信息
 func (r *deptRepo) Save(ctx context.Context, g *pbCore.Dept) (*pbCore.Dept, error) {
 	r.log.Infof("保存部门，部门信息：%v", g)
 	entDept := r.toEnt(g)
-	builder := r.data.DB(ctx).Dept.Create()
 
 	id, _ := r.GetDeptExistByName(ctx, *entDept.Name)
 	if id > 0 {
 		r.log.Errorf("部门名称已存在，部门信息：%v", g)
-		return nil, fmt.Errorf("dept name already exists")
+		return nil, biz.ErrDeptAlreadyExists
 	}
 
-	res, err := builder.SetName(*entDept.Name).
-		SetNillableParentID(entDept.ParentID).
-		Save(ctx)
+	// 计算祖级列表时锁定上级部门链，与删除或移动上级部门互斥
+	var res *gen.Dept
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		ancestors, err := r.ancestors(ctx, 0, g.GetParentId())
+		if err != nil {
+			r.log.Errorf("上级部门无效，部门信息：%v，错误：%v", g, err)
+			return err
+		}
+		res, err = r.data.DB(ctx).Dept.Create().
+			SetName(*entDept.Name).
+			SetNillableParentID(entDept.ParentID).
+			SetAncestors(ancestors).
+			Save(ctx)
+		return err
+	})
 	if err != nil {
 		r.log.Errorf("保存部门失败，部门信息：%v，错误：%v", g, err)
-		return nil, err
+		return nil, entError(err, nil, biz.ErrDeptAlreadyExists, errDBInsert)
 	}
 	return r.toProto(res), nil
 }
@@ -90,30 +123,125 @@ func (r *deptRepo) GetDeptExistByName(ctx context.Context, name string) (uint32,
 	return entDept.ID, nil
 }
 
-// Update 更新部门信息
-// 参数：ctx 上下文，g 部门信息
+// Update 更新部门信息，传入上级部门时连同下级部门一起移动，在同一事务中执行
+// 参数：ctx 上下文，g 部门信息，名称为空时不更新名称
 // 返回值：部门信息，错误信息
 func (r *deptRepo) Update(ctx context.Context, g *pbCore.Dept) (*pbCore.Dept, error) {
 	r.log.Infof("更新部门，部门信息：%v", g)
 	entDept := r.toEnt(g)
-	builder := r.data.DB(ctx).Dept.UpdateOneID(g.GetId())
-	id, _ := r.GetDeptExistByName(ctx, *entDept.Name)
-	if id > 0 && id != g.GetId() {
-		r.log.Errorf("部门名称已存在，部门信息：%v", g)
-		return nil, fmt.Errorf("dept name already exists")
+	if entDept.Name != nil {
+		id, _ := r.GetDeptExistByName(ctx, *entDept.Name)
+		if id > 0 && id != g.GetId() {
+			r.log.Errorf("部门名称已存在，部门信息：%v", g)
+			return nil, biz.ErrDeptAlreadyExists
+		}
 	}
 
-	res, err := builder.
-		SetName(*entDept.Name).
-		SetNillableParentID(entDept.ParentID).
-		Save(ctx)
+	var res *gen.Dept
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		builder := r.data.DB(ctx).Dept.UpdateOneID(g.GetId())
+		// 传入版本号时为条件更新，数据已被他人修改时不更新
+		if g.Version != nil {
+			builder.Where(dept.Version(g.GetVersion()))
+		}
+		if entDept.ParentID != nil {
+			// 锁定部门自身，上级部门未变化时不移动
+			cur, err := r.data.DB(ctx).Dept.Query().
+				Where(dept.ID(g.GetId()), lockRows).
+				Select(dept.FieldID, dept.FieldParentID).
+				Only(ctx)
+			if err != nil {
+				return err
+			}
+			if trans.Uint32Value(cur.ParentID) != g.GetParentId() {
+				ancestors, err := r.ancestors(ctx, g.GetId(), g.GetParentId())
+				if err != nil {
+					return err
+				}
+				if err := r.moveDescendants(ctx, g.GetId(), ancestors); err != nil {
+					return err
+				}
+				builder.SetParentID(g.GetParentId()).SetAncestors(ancestors)
+			}
+		}
+		var err error
+		res, err = builder.
+			SetNillableName(entDept.Name).
+			AddVersion(1).
+			Save(ctx)
+		return err
+	})
 	if err != nil {
 		r.log.Errorf("更新部门失败，部门信息：%v，错误：%v", g, err)
-		return nil, err
+		err = versionError(ctx, err, g.Version, r.data.DB(ctx).Dept.Query().Where(dept.ID(g.GetId())).Select(dept.FieldVersion).Int)
+		return nil, entError(err, biz.ErrDeptNotFound, biz.ErrDeptAlreadyExists, errDBUpdate)
 	}
 	return r.toProto(res), nil
 }
 
+// ancestors 沿上级部门逐级向上计算部门的祖级列表，不依赖已保存的祖级列表
+// 在事务中调用，逐级锁定上级部门直到事务结束，避免并发移动形成环或上级部门被删除
+// 上级部门不存在、为部门自身或其下级部门时返回上级部门无效错误
+// 参数：ctx 上下文，id 部门ID，新建部门时为 0，parentID 上级部门ID，为 0 时为顶级部门
+// 返回值：从顶级部门到上级部门的ID列表，错误信息
+func (r *deptRepo) ancestors(ctx context.Context, id, parentID uint32) ([]int, error) {
+	ancestors := []int{}
+	for pid := parentID; pid != 0; {
+		// 祖级中出现部门自身说明上级部门是其下级部门，出现重复说明已有数据中存在环
+		if pid == id || slices.Contains(ancestors, int(pid)) {
+			return nil, biz.ErrDeptParentInvalid
+		}
+		parent, err := r.data.DB(ctx).Dept.Query().
+			Where(dept.ID(pid), lockRows).
+			Select(dept.FieldID, dept.FieldParentID).
+			Only(ctx)
+		if err != nil {
+			return nil, entError(err, biz.ErrDeptParentInvalid, nil, errDBQuery)
+		}
+		ancestors = append(ancestors, int(pid))
+		pid = trans.Uint32Value(parent.ParentID)
+	}
+	slices.Reverse(ancestors)
+	return ancestors, nil
+}
+
+// moveDescendants 按部门新的祖级列表逐层重写下级部门的祖级列表，包含已删除的下级部门
+// 重写时递增下级部门的版本号，移动前读取的下级部门不能再以旧版本号覆盖
+// 参数：ctx 上下文，id 部门ID，ancestors 部门新的祖级列表
+// 返回值：错误信息
+func (r *deptRepo) moveDescendants(ctx context.Context, id uint32, ancestors []int) error {
+	client := r.data.DB(ctx)
+	prefixes := map[uint32][]int{id: append(slices.Clone(ancestors), int(id))}
+	visited := map[uint32]bool{id: true}
+	for len(prefixes) > 0 {
+		parents := make([]uint32, 0, len(prefixes))
+		for pid := range prefixes {
+			parents = append(parents, pid)
+		}
+		children, err := client.Dept.Query().
+			Where(dept.ParentIDIn(parents...)).
+			Select(dept.FieldID, dept.FieldParentID).
+			All(mixins.SkipSoftDelete(ctx))
+		if err != nil {
+			return entError(err, nil, nil, errDBQuery)
+		}
+		next := make(map[uint32][]int, len(children))
+		for _, child := range children {
+			if visited[child.ID] {
+				continue
+			}
+			visited[child.ID] = true
+			prefix := prefixes[trans.Uint32Value(child.ParentID)]
+			if err := client.Dept.UpdateOneID(child.ID).SetAncestors(prefix).AddVersion(1).Exec(ctx); err != nil {
+				return entError(err, nil, nil, errDBUpdate)
+			}
+			next[child.ID] = append(slices.Clone(prefix), int(child.ID))
+		}
+		prefixes = next
+	}
+	return nil
+}
+
 // FindByID 通过ID查询部门信息
 // 参数：ctx 上下文，id 部门ID
 // 返回值：部门信息，错误信息
@@ -123,23 +251,45 @@ func (r *deptRepo) FindByID(ctx context.Context, id uint32) (*pbCore.Dept, error
 		Where(dept.IDEQ(id)).Only(ctx)
 	if err != nil {
 		r.log.Errorf("通过ID查询部门失败，ID：%d，错误：%v", id, err)
-		if gen.IsNotFound(err) {
-			return nil, errors.New("查询数据不存在")
-		}
-		return nil, err
+		return nil, entError(err, biz.ErrDeptNotFound, nil, errDBQuery)
 	}
 	return r.toProto(res), nil
 }
 
-// Delete 删除部门
+// Delete 删除部门，存在下级部门或部门下存在用户时不允许删除
+// 检查与删除在同一事务中执行，并锁定部门，与在其下新建或移入下级部门互斥
 // 参数：ctx 上下文，id 部门ID
 // 返回值：错误信息
 func (r *deptRepo) Delete(ctx context.Context, id uint32) error {
 	r.log.Infof("删除部门，部门ID：%d", id)
-	err := r.data.DB(ctx).Dept.UpdateOneID(id).SetDeletedAt(time.Now()).Exec(ctx)
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		client := r.data.DB(ctx)
+		if _, err := client.Dept.Query().Where(dept.ID(id), lockRows).Select(dept.FieldID).Only(ctx); err != nil {
+			return err
+		}
+		hasChildren, err := client.Dept.Query().Where(dept.ParentIDEQ(id)).Exist(ctx)
+		if err != nil {
+			r.log.Errorf("查询下级部门失败，部门ID：%d，错误：%v", id, err)
+			return entError(err, nil, nil, errDBQuery)
+		}
+		if hasChildren {
+			r.log.Errorf("存在下级部门，不允许删除，部门ID：%d", id)
+			return biz.ErrDeptHasChildren
+		}
+		hasUsers, err := client.User.Query().Where(user.DeptIDEQ(id)).Exist(ctx)
+		if err != nil {
+			r.log.Errorf("查询部门用户失败，部门ID：%d，错误：%v", id, err)
+			return entError(err, nil, nil, errDBQuery)
+		}
+		if hasUsers {
+			r.log.Errorf("部门下存在用户，不允许删除，部门ID：%d", id)
+			return biz.ErrDeptHasUsers
+		}
+		return client.Dept.DeleteOneID(id).Exec(ctx)
+	})
 	if err != nil {
 		r.log.Errorf("删除部门失败，部门ID：%d，错误：%v", id, err)
-		return err
+		return entError(err, biz.ErrDeptNotFound, nil, errDBDelete)
 	}
 	return nil
 }
@@ -157,40 +307,85 @@ func (r *deptRepo) ListByName(ctx context.Context, name string) ([]*pbCore.Dept,
 	return convert.SliceToAny(res, r.toProto), nil
 }
 
-// ListAll 查询所有部门列表
+// ListAll 查询所有部门列表，按ID升序排列
+// 按ID逐批查询，不受查询拦截器默认的行数上限限制
 // 参数：ctx 上下文
 // 返回值：部门列表，错误信息
 func (r *deptRepo) ListAll(ctx context.Context) ([]*pbCore.Dept, error) {
 	r.log.Infof("查询所有部门列表")
-	res, err := r.data.DB(ctx).Dept.Query().Select(dept.FieldID, dept.FieldName).Where().Order(gen.Desc(dept.FieldID)).All(ctx)
-	if err != nil {
-		r.log.Errorf("查询所有部门列表失败，错误：%v", err)
-		return nil, err
+	var res []*gen.Dept
+	for last := uint32(0); ; {
+		batch, err := r.data.DB(ctx).Dept.Query().
+			Where(dept.IDGT(last)).
+			Select(dept.FieldID, dept.FieldName, dept.FieldParentID, dept.FieldAncestors).
+			Order(gen.Asc(dept.FieldID)).
+			Limit(deptListBatchSize).
+			All(ctx)
+		if err != nil {
+			r.log.Errorf("查询所有部门列表失败，错误：%v", err)
+			return nil, err
+		}
+		res = append(res, batch...)
+		if len(batch) < deptListBatchSize {
+			break
+		}
+		last = batch[len(batch)-1].ID
 	}
 	return convert.SliceToAny(res, r.toProto), nil
 }
 
+// deptQuerySchema 部门列表开放查询的字段
+var deptQuerySchema = &entgo.Schema{
+	Fields: map[string]entgo.Field{
+		"id":         {Column: dept.FieldID, Type: field.TypeUint32, Filterable: true, Sortable: true},
+		"name":       {Column: dept.FieldName, Type: field.TypeString, Filterable: true, Sortable: true},
+		"parent_id":  {Column: dept.FieldParentID, Type: field.TypeUint32, Filterable: true, Sortable: true},
+		"status":     {Column: dept.FieldStatus, Type: field.TypeInt32, Filterable: true},
+		"leader_id":  {},
+		"sort":       {},
+		"remark":     {},
+		"created_at": {Column: dept.FieldCreatedAt, Type: field.TypeTime, Filterable: true, Sortable: true},
+		"updated_at": {Column: dept.FieldUpdatedAt, Type: field.TypeTime, Filterable: true, Sortable: true},
+		"version":    {Column: dept.FieldVersion, Type: field.TypeUint32},
+		"created_by": {Column: dept.FieldCreatedBy, Type: field.TypeUint32, Filterable: true},
+		"updated_by": {Column: dept.FieldUpdatedBy, Type: field.TypeUint32, Filterable: true},
+		"ancestors":  {Column: dept.FieldAncestors, Type: field.TypeJSON},
+		"children":   {},
+	},
+	DefaultOrder: []string{"-id"},
+	Required:     []string{dept.FieldID},
+}
+
 // ListPage 查询部门列表分页
 // 参数：ctx 上下文，pagination 分页请求
 // 返回值：部门列表响应，错误信息
 func (r *deptRepo) ListPage(ctx context.Context, pagination *pbPagination.PagingRequest) (*pbCore.ListDeptResponse, error) {
 	r.log.Infof("查询部门列表分页，分页请求：%v", pagination)
-	count, err := r.data.DB(ctx).Dept.Query().Select(dept.FieldID).Where(dept.DeletedAtIsNil()).Count(ctx)
+	pq, err := deptQuerySchema.Translate(pagination)
+	if err != nil {
+		return nil, pagingError(err)
+	}
+	query := r.data.DB(ctx).Dept.Query().Where(dept.DeletedAtIsNil()).Where(entgo.Predicates[predicate.Dept](pq)...)
+	count, err := query.Clone().Count(ctx)
 	if err != nil {
 		r.log.Errorf("查询所有部门列表失败，错误：%v", err)
 		return nil, err
 	}
-	res, err := r.data.DB(ctx).Dept.Query().
-		Select(
+	res, err := query.
+		Select(pq.Columns(
 			dept.FieldID,
 			dept.FieldName,
 			dept.FieldParentID,
 			dept.FieldCreatedAt,
 			dept.FieldUpdatedAt,
-		).
-		Offset(int((pagination.GetPage() - 1) * pagination.GetPageSize())).
-		Limit(int(pagination.GetPageSize())).
-		Order(gen.Desc(dept.FieldID)).
+			dept.FieldVersion,
+			dept.FieldCreatedBy,
+			dept.FieldUpdatedBy,
+			dept.FieldAncestors,
+		)...).
+		Offset(pq.Offset).
+		Limit(pq.Limit).
+		Order(entgo.Orders[dept.OrderOption](pq)...).
 		All(ctx)
 	if err != nil {
 		r.log.Errorf("查询部门列表分页失败，分页请求：%v，错误：%v", pagination, err)
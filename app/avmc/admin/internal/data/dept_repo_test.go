@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/conf"
+	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/intercept"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/migrate"
+	"backend-service/pkg/utils/trans"
+)
+
+func TestDeptTree(t *testing.T) {
+	ctx := context.Background()
+	// 顶级部门的 parent_id 为 0，与生产环境的迁移方式一致，不创建外键
+	client := newTestEntClient(t, migrate.WithForeignKeys(false))
+	d := &Data{db: client}
+	repo := NewDeptRepo(d, log.DefaultLogger)
+	users := NewUserRepo(d, log.DefaultLogger)
+	trash := NewTrashRepo(&conf.Data{}, d, nil, log.DefaultLogger)
+	uc := biz.NewDeptUsecase(repo, log.DefaultLogger)
+	save := func(name string, parentID uint32) *pbCore.Dept {
+		res, err := repo.Save(ctx, &pbCore.Dept{Name: trans.String(name), ParentId: trans.Uint32(parentID)})
+		require.NoError(t, err)
+		return res
+	}
+	ancestors := func(id uint32) []uint32 {
+		res, err := repo.FindByID(ctx, id)
+		require.NoError(t, err)
+		return res.GetAncestors()
+	}
+	move := func(id, parentID uint32) error {
+		cur, err := repo.FindByID(ctx, id)
+		require.NoError(t, err)
+		_, err = uc.Move(ctx, &pbCore.MoveDeptRequest{Id: id, ParentId: parentID, Version: cur.Version})
+		return err
+	}
+
+	// 新建部门时按上级部门计算祖级列表
+	hq := save("hq", 0)
+	rd := save("rd", hq.GetId())
+	backend := save("backend", rd.GetId())
+	infra := save("infra", backend.GetId())
+	sales := save("sales", hq.GetId())
+	assert.Empty(t, hq.GetAncestors())
+	assert.Equal(t, []uint32{hq.GetId(), rd.GetId(), backend.GetId()}, infra.GetAncestors())
+	_, err := repo.Save(ctx, &pbCore.Dept{Name: trans.String("ghost"), ParentId: trans.Uint32(999)})
+	assert.ErrorIs(t, err, biz.ErrDeptParentInvalid)
+
+	// 不能移动到自身或其下级部门下，失败时不做任何修改
+	assert.ErrorIs(t, move(rd.GetId(), rd.GetId()), biz.ErrDeptParentInvalid)
+	assert.ErrorIs(t, move(rd.GetId(), infra.GetId()), biz.ErrDeptParentInvalid)
+	assert.Equal(t, []uint32{hq.GetId(), rd.GetId(), backend.GetId()}, ancestors(infra.GetId()))
+
+	// 移动部门时在同一事务中重写下级部门的祖级列表，并递增下级部门的版本号
+	stale, err := repo.FindByID(ctx, infra.GetId())
+	require.NoError(t, err)
+	require.NoError(t, move(backend.GetId(), sales.GetId()))
+	assert.Equal(t, []uint32{hq.GetId(), sales.GetId()}, ancestors(backend.GetId()))
+	assert.Equal(t, []uint32{hq.GetId(), sales.GetId(), backend.GetId()}, ancestors(infra.GetId()))
+	_, err = repo.Update(ctx, &pbCore.Dept{Id: infra.GetId(), Name: trans.String("infra2"), Version: stale.Version})
+	assert.True(t, v1.IsVersionConflict(err))
+	require.NoError(t, move(sales.GetId(), 0))
+	assert.Equal(t, []uint32{sales.GetId(), backend.GetId()}, ancestors(infra.GetId()))
+
+	// 版本号不一致时不移动
+	_, err = uc.Move(ctx, &pbCore.MoveDeptRequest{Id: sales.GetId(), ParentId: hq.GetId(), Version: trans.Uint32(0)})
+	assert.True(t, v1.IsVersionConflict(err))
+	assert.Equal(t, []uint32{sales.GetId(), backend.GetId()}, ancestors(infra.GetId()))
+
+	// 部门树中每个部门只出现一次
+	tree, err := uc.ListTree(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, tree.GetItems(), 2)
+	assert.Equal(t, hq.GetId(), tree.GetItems()[0].GetId())
+	require.Len(t, tree.GetItems()[0].GetChildren(), 1)
+	assert.Equal(t, rd.GetId(), tree.GetItems()[0].GetChildren()[0].GetId())
+	assert.Empty(t, tree.GetItems()[0].GetChildren()[0].GetChildren())
+	require.Len(t, tree.GetItems()[1].GetChildren(), 1)
+	require.Len(t, tree.GetItems()[1].GetChildren()[0].GetChildren(), 1)
+	assert.Equal(t, infra.GetId(), tree.GetItems()[1].GetChildren()[0].GetChildren()[0].GetId())
+	sub, err := uc.ListTree(ctx, sales.GetId())
+	require.NoError(t, err)
+	require.Len(t, sub.GetItems(), 1)
+	assert.Equal(t, backend.GetId(), sub.GetItems()[0].GetId())
+
+	// 存在下级部门或用户时不允许删除
+	assert.ErrorIs(t, uc.Delete(ctx, backend.GetId()), biz.ErrDeptHasChildren)
+	alice, err := users.Save(ctx, &pbCore.User{Name: trans.String("alice"), Password: trans.String("secret"), DeptId: trans.Uint32(infra.GetId())})
+	require.NoError(t, err)
+	assert.ErrorIs(t, uc.Delete(ctx, infra.GetId()), biz.ErrDeptHasUsers)
+	_, err = users.Update(ctx, &pbCore.User{Id: alice.GetId(), DeptId: trans.Uint32(0)})
+	require.NoError(t, err)
+	require.NoError(t, uc.Delete(ctx, infra.GetId()))
+	require.NoError(t, uc.Delete(ctx, backend.GetId()))
+	_, err = users.Update(ctx, &pbCore.User{Id: alice.GetId(), DeptId: trans.Uint32(infra.GetId())})
+	assert.ErrorIs(t, err, biz.ErrDeptNotFound)
+
+	// 上级部门仍在回收站中时不允许恢复
+	assert.ErrorIs(t, trash.Restore(ctx, biz.TrashResourceDept, infra.GetId()), biz.ErrDeptParentInvalid)
+	require.NoError(t, trash.Restore(ctx, biz.TrashResourceDept, backend.GetId()))
+	require.NoError(t, trash.Restore(ctx, biz.TrashResourceDept, infra.GetId()))
+}
+
+func TestDeptListAll(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t, migrate.WithForeignKeys(false))
+	// 与生产环境一致，未指定行数的查询最多返回 1000 行
+	client.Intercept(intercept.Func(func(ctx context.Context, q intercept.Query) error {
+		if ent.QueryFromContext(ctx).Limit == nil {
+			q.Limit(1000)
+		}
+		return nil
+	}))
+	repo := NewDeptRepo(&Data{db: client}, log.DefaultLogger)
+
+	// 超过查询拦截器默认的行数上限时逐批查询全部部门
+	builders := make([]*gen.DeptCreate, deptListBatchSize+1)
+	for i := range builders {
+		builders[i] = client.Dept.Create().SetName("dept" + strconv.Itoa(i))
+	}
+	client.Dept.CreateBulk(builders...).ExecX(ctx)
+	depts, err := repo.ListAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, depts, deptListBatchSize+1)
+	assert.Less(t, depts[0].GetId(), depts[len(depts)-1].GetId())
+
+	// 上级部门未变化时不重写下级部门的祖级列表
+	parent, child := depts[0], depts[1]
+	client.Dept.UpdateOneID(child.GetId()).SetParentID(parent.GetId()).SetAncestors([]int{0}).ExecX(ctx)
+	_, err = repo.Update(ctx, &pbCore.Dept{Id: parent.GetId(), ParentId: trans.Uint32(0)})
+	require.NoError(t, err)
+	assert.Equal(t, []int{0}, client.Dept.GetX(ctx, child.GetId()).Ancestors)
+}
+
+func TestLockRows(t *testing.T) {
+	for d, want := range map[string]string{dialect.MySQL: "FOR UPDATE", dialect.Postgres: "FOR UPDATE", dialect.SQLite: ""} {
+		s := entsql.Dialect(d).Select("id").From(entsql.Table("sys_depts"))
+		lockRows(s)
+		query, _ := s.Query()
+		if want == "" {
+			assert.NotContains(t, query, "FOR UPDATE", d)
+		} else {
+			assert.Contains(t, query, want, d)
+		}
+	}
+}
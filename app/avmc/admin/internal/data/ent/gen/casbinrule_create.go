@@ -0,0 +1,927 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/casbinrule"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CasbinRuleCreate is the builder for creating a CasbinRule entity.
+type CasbinRuleCreate struct {
+	config
+	mutation *CasbinRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPtype sets the "ptype" field.
+func (_c *CasbinRuleCreate) SetPtype(v string) *CasbinRuleCreate {
+	_c.mutation.SetPtype(v)
+	return _c
+}
+
+// SetNillablePtype sets the "ptype" field if the given value is not nil.
+func (_c *CasbinRuleCreate) SetNillablePtype(v *string) *CasbinRuleCreate {
+	if v != nil {
+		_c.SetPtype(*v)
+	}
+	return _c
+}
+
+// SetV0 sets the "v0" field.
+func (_c *CasbinRuleCreate) SetV0(v string) *CasbinRuleCreate {
+	_c.mutation.SetV0(v)
+	return _c
+}
+
+// SetNillableV0 sets the "v0" field if the given value is not nil.
+func (_c *CasbinRuleCreate) SetNillableV0(v *string) *CasbinRuleCreate {
+	if v != nil {
+		_c.SetV0(*v)
+	}
+	return _c
+}
+
+// SetV1 sets the "v1" field.
+func (_c *CasbinRuleCreate) SetV1(v string) *CasbinRuleCreate {
+	_c.mutation.SetV1(v)
+	return _c
+}
+
+// SetNillableV1 sets the "v1" field if the given value is not nil.
+func (_c *CasbinRuleCreate) SetNillableV1(v *string) *CasbinRuleCreate {
+	if v != nil {
+		_c.SetV1(*v)
+	}
+	return _c
+}
+
+// SetV2 sets the "v2" field.
+func (_c *CasbinRuleCreate) SetV2(v string) *CasbinRuleCreate {
+	_c.mutation.SetV2(v)
+	return _c
+}
+
+// SetNillableV2 sets the "v2" field if the given value is not nil.
+func (_c *CasbinRuleCreate) SetNillableV2(v *string) *CasbinRuleCreate {
+	if v != nil {
+		_c.SetV2(*v)
+	}
+	return _c
+}
+
+// SetV3 sets the "v3" field.
+func (_c *CasbinRuleCreate) SetV3(v string) *CasbinRuleCreate {
+	_c.mutation.SetV3(v)
+	return _c
+}
+
+// SetNillableV3 sets the "v3" field if the given value is not nil.
+func (_c *CasbinRuleCreate) SetNillableV3(v *string) *CasbinRuleCreate {
+	if v != nil {
+		_c.SetV3(*v)
+	}
+	return _c
+}
+
+// SetV4 sets the "v4" field.
+func (_c *CasbinRuleCreate) SetV4(v string) *CasbinRuleCreate {
+	_c.mutation.SetV4(v)
+	return _c
+}
+
+// SetNillableV4 sets the "v4" field if the given value is not nil.
+func (_c *CasbinRuleCreate) SetNillableV4(v *string) *CasbinRuleCreate {
+	if v != nil {
+		_c.SetV4(*v)
+	}
+	return _c
+}
+
+// SetV5 sets the "v5" field.
+func (_c *CasbinRuleCreate) SetV5(v string) *CasbinRuleCreate {
+	_c.mutation.SetV5(v)
+	return _c
+}
+
+// SetNillableV5 sets the "v5" field if the given value is not nil.
+func (_c *CasbinRuleCreate) SetNillableV5(v *string) *CasbinRuleCreate {
+	if v != nil {
+		_c.SetV5(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CasbinRuleCreate) SetID(v uint64) *CasbinRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the CasbinRuleMutation object of the builder.
+func (_c *CasbinRuleCreate) Mutation() *CasbinRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the CasbinRule in the database.
+func (_c *CasbinRuleCreate) Save(ctx context.Context) (*CasbinRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CasbinRuleCreate) SaveX(ctx context.Context) *CasbinRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CasbinRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CasbinRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CasbinRuleCreate) defaults() {
+	if _, ok := _c.mutation.Ptype(); !ok {
+		v := casbinrule.DefaultPtype
+		_c.mutation.SetPtype(v)
+	}
+	if _, ok := _c.mutation.V0(); !ok {
+		v := casbinrule.DefaultV0
+		_c.mutation.SetV0(v)
+	}
+	if _, ok := _c.mutation.V1(); !ok {
+		v := casbinrule.DefaultV1
+		_c.mutation.SetV1(v)
+	}
+	if _, ok := _c.mutation.V2(); !ok {
+		v := casbinrule.DefaultV2
+		_c.mutation.SetV2(v)
+	}
+	if _, ok := _c.mutation.V3(); !ok {
+		v := casbinrule.DefaultV3
+		_c.mutation.SetV3(v)
+	}
+	if _, ok := _c.mutation.V4(); !ok {
+		v := casbinrule.DefaultV4
+		_c.mutation.SetV4(v)
+	}
+	if _, ok := _c.mutation.V5(); !ok {
+		v := casbinrule.DefaultV5
+		_c.mutation.SetV5(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CasbinRuleCreate) check() error {
+	if _, ok := _c.mutation.Ptype(); !ok {
+		return &ValidationError{Name: "ptype", err: errors.New(`gen: missing required field "CasbinRule.ptype"`)}
+	}
+	if v, ok := _c.mutation.Ptype(); ok {
+		if err := casbinrule.PtypeValidator(v); err != nil {
+			return &ValidationError{Name: "ptype", err: fmt.Errorf(`gen: validator failed for field "CasbinRule.ptype": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.V0(); !ok {
+		return &ValidationError{Name: "v0", err: errors.New(`gen: missing required field "CasbinRule.v0"`)}
+	}
+	if v, ok := _c.mutation.V0(); ok {
+		if err := casbinrule.V0Validator(v); err != nil {
+			return &ValidationError{Name: "v0", err: fmt.Errorf(`gen: validator failed for field "CasbinRule.v0": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.V1(); !ok {
+		return &ValidationError{Name: "v1", err: errors.New(`gen: missing required field "CasbinRule.v1"`)}
+	}
+	if v, ok := _c.mutation.V1(); ok {
+		if err := casbinrule.V1Validator(v); err != nil {
+			return &ValidationError{Name: "v1", err: fmt.Errorf(`gen: validator failed for field "CasbinRule.v1": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.V2(); !ok {
+		return &ValidationError{Name: "v2", err: errors.New(`gen: missing required field "CasbinRule.v2"`)}
+	}
+	if v, ok := _c.mutation.V2(); ok {
+		if err := casbinrule.V2Validator(v); err != nil {
+			return &ValidationError{Name: "v2", err: fmt.Errorf(`gen: validator failed for field "CasbinRule.v2": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.V3(); !ok {
+		return &ValidationError{Name: "v3", err: errors.New(`gen: missing required field "CasbinRule.v3"`)}
+	}
+	if v, ok := _c.mutation.V3(); ok {
+		if err := casbinrule.V3Validator(v); err != nil {
+			return &ValidationError{Name: "v3", err: fmt.Errorf(`gen: validator failed for field "CasbinRule.v3": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.V4(); !ok {
+		return &ValidationError{Name: "v4", err: errors.New(`gen: missing required field "CasbinRule.v4"`)}
+	}
+	if v, ok := _c.mutation.V4(); ok {
+		if err := casbinrule.V4Validator(v); err != nil {
+			return &ValidationError{Name: "v4", err: fmt.Errorf(`gen: validator failed for field "CasbinRule.v4": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.V5(); !ok {
+		return &ValidationError{Name: "v5", err: errors.New(`gen: missing required field "CasbinRule.v5"`)}
+	}
+	if v, ok := _c.mutation.V5(); ok {
+		if err := casbinrule.V5Validator(v); err != nil {
+			return &ValidationError{Name: "v5", err: fmt.Errorf(`gen: validator failed for field "CasbinRule.v5": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CasbinRuleCreate) sqlSave(ctx context.Context) (*CasbinRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CasbinRuleCreate) createSpec() (*CasbinRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CasbinRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(casbinrule.Table, sqlgraph.NewFieldSpec(casbinrule.FieldID, field.TypeUint64))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Ptype(); ok {
+		_spec.SetField(casbinrule.FieldPtype, field.TypeString, value)
+		_node.Ptype = value
+	}
+	if value, ok := _c.mutation.V0(); ok {
+		_spec.SetField(casbinrule.FieldV0, field.TypeString, value)
+		_node.V0 = value
+	}
+	if value, ok := _c.mutation.V1(); ok {
+		_spec.SetField(casbinrule.FieldV1, field.TypeString, value)
+		_node.V1 = value
+	}
+	if value, ok := _c.mutation.V2(); ok {
+		_spec.SetField(casbinrule.FieldV2, field.TypeString, value)
+		_node.V2 = value
+	}
+	if value, ok := _c.mutation.V3(); ok {
+		_spec.SetField(casbinrule.FieldV3, field.TypeString, value)
+		_node.V3 = value
+	}
+	if value, ok := _c.mutation.V4(); ok {
+		_spec.SetField(casbinrule.FieldV4, field.TypeString, value)
+		_node.V4 = value
+	}
+	if value, ok := _c.mutation.V5(); ok {
+		_spec.SetField(casbinrule.FieldV5, field.TypeString, value)
+		_node.V5 = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CasbinRule.Create().
+//		SetPtype(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CasbinRuleUpsert) {
+//			SetPtype(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CasbinRuleCreate) OnConflict(opts ...sql.ConflictOption) *CasbinRuleUpsertOne {
+	_c.conflict = opts
+	return &CasbinRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CasbinRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CasbinRuleCreate) OnConflictColumns(columns ...string) *CasbinRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CasbinRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CasbinRuleUpsertOne is the builder for "upsert"-ing
+	//  one CasbinRule node.
+	CasbinRuleUpsertOne struct {
+		create *CasbinRuleCreate
+	}
+
+	// CasbinRuleUpsert is the "OnConflict" setter.
+	CasbinRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPtype sets the "ptype" field.
+func (u *CasbinRuleUpsert) SetPtype(v string) *CasbinRuleUpsert {
+	u.Set(casbinrule.FieldPtype, v)
+	return u
+}
+
+// UpdatePtype sets the "ptype" field to the value that was provided on create.
+func (u *CasbinRuleUpsert) UpdatePtype() *CasbinRuleUpsert {
+	u.SetExcluded(casbinrule.FieldPtype)
+	return u
+}
+
+// SetV0 sets the "v0" field.
+func (u *CasbinRuleUpsert) SetV0(v string) *CasbinRuleUpsert {
+	u.Set(casbinrule.FieldV0, v)
+	return u
+}
+
+// UpdateV0 sets the "v0" field to the value that was provided on create.
+func (u *CasbinRuleUpsert) UpdateV0() *CasbinRuleUpsert {
+	u.SetExcluded(casbinrule.FieldV0)
+	return u
+}
+
+// SetV1 sets the "v1" field.
+func (u *CasbinRuleUpsert) SetV1(v string) *CasbinRuleUpsert {
+	u.Set(casbinrule.FieldV1, v)
+	return u
+}
+
+// UpdateV1 sets the "v1" field to the value that was provided on create.
+func (u *CasbinRuleUpsert) UpdateV1() *CasbinRuleUpsert {
+	u.SetExcluded(casbinrule.FieldV1)
+	return u
+}
+
+// SetV2 sets the "v2" field.
+func (u *CasbinRuleUpsert) SetV2(v string) *CasbinRuleUpsert {
+	u.Set(casbinrule.FieldV2, v)
+	return u
+}
+
+// UpdateV2 sets the "v2" field to the value that was provided on create.
+func (u *CasbinRuleUpsert) UpdateV2() *CasbinRuleUpsert {
+	u.SetExcluded(casbinrule.FieldV2)
+	return u
+}
+
+// SetV3 sets the "v3" field.
+func (u *CasbinRuleUpsert) SetV3(v string) *CasbinRuleUpsert {
+	u.Set(casbinrule.FieldV3, v)
+	return u
+}
+
+// UpdateV3 sets the "v3" field to the value that was provided on create.
+func (u *CasbinRuleUpsert) UpdateV3() *CasbinRuleUpsert {
+	u.SetExcluded(casbinrule.FieldV3)
+	return u
+}
+
+// SetV4 sets the "v4" field.
+func (u *CasbinRuleUpsert) SetV4(v string) *CasbinRuleUpsert {
+	u.Set(casbinrule.FieldV4, v)
+	return u
+}
+
+// UpdateV4 sets the "v4" field to the value that was provided on create.
+func (u *CasbinRuleUpsert) UpdateV4() *CasbinRuleUpsert {
+	u.SetExcluded(casbinrule.FieldV4)
+	return u
+}
+
+// SetV5 sets the "v5" field.
+func (u *CasbinRuleUpsert) SetV5(v string) *CasbinRuleUpsert {
+	u.Set(casbinrule.FieldV5, v)
+	return u
+}
+
+// UpdateV5 sets the "v5" field to the value that was provided on create.
+func (u *CasbinRuleUpsert) UpdateV5() *CasbinRuleUpsert {
+	u.SetExcluded(casbinrule.FieldV5)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CasbinRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(casbinrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CasbinRuleUpsertOne) UpdateNewValues() *CasbinRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(casbinrule.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CasbinRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CasbinRuleUpsertOne) Ignore() *CasbinRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CasbinRuleUpsertOne) DoNothing() *CasbinRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CasbinRuleCreate.OnConflict
+// documentation for more info.
+func (u *CasbinRuleUpsertOne) Update(set func(*CasbinRuleUpsert)) *CasbinRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CasbinRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPtype sets the "ptype" field.
+func (u *CasbinRuleUpsertOne) SetPtype(v string) *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetPtype(v)
+	})
+}
+
+// UpdatePtype sets the "ptype" field to the value that was provided on create.
+func (u *CasbinRuleUpsertOne) UpdatePtype() *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdatePtype()
+	})
+}
+
+// SetV0 sets the "v0" field.
+func (u *CasbinRuleUpsertOne) SetV0(v string) *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV0(v)
+	})
+}
+
+// UpdateV0 sets the "v0" field to the value that was provided on create.
+func (u *CasbinRuleUpsertOne) UpdateV0() *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV0()
+	})
+}
+
+// SetV1 sets the "v1" field.
+func (u *CasbinRuleUpsertOne) SetV1(v string) *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV1(v)
+	})
+}
+
+// UpdateV1 sets the "v1" field to the value that was provided on create.
+func (u *CasbinRuleUpsertOne) UpdateV1() *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV1()
+	})
+}
+
+// SetV2 sets the "v2" field.
+func (u *CasbinRuleUpsertOne) SetV2(v string) *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV2(v)
+	})
+}
+
+// UpdateV2 sets the "v2" field to the value that was provided on create.
+func (u *CasbinRuleUpsertOne) UpdateV2() *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV2()
+	})
+}
+
+// SetV3 sets the "v3" field.
+func (u *CasbinRuleUpsertOne) SetV3(v string) *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV3(v)
+	})
+}
+
+// UpdateV3 sets the "v3" field to the value that was provided on create.
+func (u *CasbinRuleUpsertOne) UpdateV3() *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV3()
+	})
+}
+
+// SetV4 sets the "v4" field.
+func (u *CasbinRuleUpsertOne) SetV4(v string) *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV4(v)
+	})
+}
+
+// UpdateV4 sets the "v4" field to the value that was provided on create.
+func (u *CasbinRuleUpsertOne) UpdateV4() *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV4()
+	})
+}
+
+// SetV5 sets the "v5" field.
+func (u *CasbinRuleUpsertOne) SetV5(v string) *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV5(v)
+	})
+}
+
+// UpdateV5 sets the "v5" field to the value that was provided on create.
+func (u *CasbinRuleUpsertOne) UpdateV5() *CasbinRuleUpsertOne {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV5()
+	})
+}
+
+// Exec executes the query.
+func (u *CasbinRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("gen: missing options for CasbinRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CasbinRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CasbinRuleUpsertOne) ID(ctx context.Context) (id uint64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CasbinRuleUpsertOne) IDX(ctx context.Context) uint64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CasbinRuleCreateBulk is the builder for creating many CasbinRule entities in bulk.
+type CasbinRuleCreateBulk struct {
+	config
+	err      error
+	builders []*CasbinRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CasbinRule entities in the database.
+func (_c *CasbinRuleCreateBulk) Save(ctx context.Context) ([]*CasbinRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CasbinRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CasbinRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CasbinRuleCreateBulk) SaveX(ctx context.Context) []*CasbinRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CasbinRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CasbinRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CasbinRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CasbinRuleUpsert) {
+//			SetPtype(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CasbinRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *CasbinRuleUpsertBulk {
+	_c.conflict = opts
+	return &CasbinRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CasbinRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CasbinRuleCreateBulk) OnConflictColumns(columns ...string) *CasbinRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CasbinRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// CasbinRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of CasbinRule nodes.
+type CasbinRuleUpsertBulk struct {
+	create *CasbinRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CasbinRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(casbinrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CasbinRuleUpsertBulk) UpdateNewValues() *CasbinRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(casbinrule.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CasbinRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CasbinRuleUpsertBulk) Ignore() *CasbinRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CasbinRuleUpsertBulk) DoNothing() *CasbinRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CasbinRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *CasbinRuleUpsertBulk) Update(set func(*CasbinRuleUpsert)) *CasbinRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CasbinRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPtype sets the "ptype" field.
+func (u *CasbinRuleUpsertBulk) SetPtype(v string) *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetPtype(v)
+	})
+}
+
+// UpdatePtype sets the "ptype" field to the value that was provided on create.
+func (u *CasbinRuleUpsertBulk) UpdatePtype() *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdatePtype()
+	})
+}
+
+// SetV0 sets the "v0" field.
+func (u *CasbinRuleUpsertBulk) SetV0(v string) *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV0(v)
+	})
+}
+
+// UpdateV0 sets the "v0" field to the value that was provided on create.
+func (u *CasbinRuleUpsertBulk) UpdateV0() *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV0()
+	})
+}
+
+// SetV1 sets the "v1" field.
+func (u *CasbinRuleUpsertBulk) SetV1(v string) *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV1(v)
+	})
+}
+
+// UpdateV1 sets the "v1" field to the value that was provided on create.
+func (u *CasbinRuleUpsertBulk) UpdateV1() *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV1()
+	})
+}
+
+// SetV2 sets the "v2" field.
+func (u *CasbinRuleUpsertBulk) SetV2(v string) *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV2(v)
+	})
+}
+
+// UpdateV2 sets the "v2" field to the value that was provided on create.
+func (u *CasbinRuleUpsertBulk) UpdateV2() *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV2()
+	})
+}
+
+// SetV3 sets the "v3" field.
+func (u *CasbinRuleUpsertBulk) SetV3(v string) *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV3(v)
+	})
+}
+
+// UpdateV3 sets the "v3" field to the value that was provided on create.
+func (u *CasbinRuleUpsertBulk) UpdateV3() *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV3()
+	})
+}
+
+// SetV4 sets the "v4" field.
+func (u *CasbinRuleUpsertBulk) SetV4(v string) *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV4(v)
+	})
+}
+
+// UpdateV4 sets the "v4" field to the value that was provided on create.
+func (u *CasbinRuleUpsertBulk) UpdateV4() *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV4()
+	})
+}
+
+// SetV5 sets the "v5" field.
+func (u *CasbinRuleUpsertBulk) SetV5(v string) *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.SetV5(v)
+	})
+}
+
+// UpdateV5 sets the "v5" field to the value that was provided on create.
+func (u *CasbinRuleUpsertBulk) UpdateV5() *CasbinRuleUpsertBulk {
+	return u.Update(func(s *CasbinRuleUpsert) {
+		s.UpdateV5()
+	})
+}
+
+// Exec executes the query.
+func (u *CasbinRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("gen: OnConflict was set for builder %d. Set it on the CasbinRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("gen: missing options for CasbinRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CasbinRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -11,9 +11,13 @@ import (
 
 	"backend-service/app/avmc/admin/internal/data/ent/gen/migrate"
 
+	"backend-service/app/avmc/admin/internal/data/ent/gen/casbinrule"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/dept"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/entityhistory"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/exportfile"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/menu"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/post"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/relationtuple"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/role"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
 
@@ -30,12 +34,20 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// CasbinRule is the client for interacting with the CasbinRule builders.
+	CasbinRule *CasbinRuleClient
 	// Dept is the client for interacting with the Dept builders.
 	Dept *DeptClient
+	// EntityHistory is the client for interacting with the EntityHistory builders.
+	EntityHistory *EntityHistoryClient
+	// ExportFile is the client for interacting with the ExportFile builders.
+	ExportFile *ExportFileClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// RelationTuple is the client for interacting with the RelationTuple builders.
+	RelationTuple *RelationTupleClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// User is the client for interacting with the User builders.
@@ -51,9 +63,13 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.CasbinRule = NewCasbinRuleClient(c.config)
 	c.Dept = NewDeptClient(c.config)
+	c.EntityHistory = NewEntityHistoryClient(c.config)
+	c.ExportFile = NewExportFileClient(c.config)
 	c.Menu = NewMenuClient(c.config)
 	c.Post = NewPostClient(c.config)
+	c.RelationTuple = NewRelationTupleClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.User = NewUserClient(c.config)
 }
@@ -146,13 +162,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Dept:   NewDeptClient(cfg),
-		Menu:   NewMenuClient(cfg),
-		Post:   NewPostClient(cfg),
-		Role:   NewRoleClient(cfg),
-		User:   NewUserClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		CasbinRule:    NewCasbinRuleClient(cfg),
+		Dept:          NewDeptClient(cfg),
+		EntityHistory: NewEntityHistoryClient(cfg),
+		ExportFile:    NewExportFileClient(cfg),
+		Menu:          NewMenuClient(cfg),
+		Post:          NewPostClient(cfg),
+		RelationTuple: NewRelationTupleClient(cfg),
+		Role:          NewRoleClient(cfg),
+		User:          NewUserClient(cfg),
 	}, nil
 }
 
@@ -170,20 +190,24 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Dept:   NewDeptClient(cfg),
-		Menu:   NewMenuClient(cfg),
-		Post:   NewPostClient(cfg),
-		Role:   NewRoleClient(cfg),
-		User:   NewUserClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		CasbinRule:    NewCasbinRuleClient(cfg),
+		Dept:          NewDeptClient(cfg),
+		EntityHistory: NewEntityHistoryClient(cfg),
+		ExportFile:    NewExportFileClient(cfg),
+		Menu:          NewMenuClient(cfg),
+		Post:          NewPostClient(cfg),
+		RelationTuple: NewRelationTupleClient(cfg),
+		Role:          NewRoleClient(cfg),
+		User:          NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Dept.
+//		CasbinRule.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -205,32 +229,42 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Dept.Use(hooks...)
-	c.Menu.Use(hooks...)
-	c.Post.Use(hooks...)
-	c.Role.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.CasbinRule, c.Dept, c.EntityHistory, c.ExportFile, c.Menu, c.Post,
+		c.RelationTuple, c.Role, c.User,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Dept.Intercept(interceptors...)
-	c.Menu.Intercept(interceptors...)
-	c.Post.Intercept(interceptors...)
-	c.Role.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.CasbinRule, c.Dept, c.EntityHistory, c.ExportFile, c.Menu, c.Post,
+		c.RelationTuple, c.Role, c.User,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *CasbinRuleMutation:
+		return c.CasbinRule.mutate(ctx, m)
 	case *DeptMutation:
 		return c.Dept.mutate(ctx, m)
+	case *EntityHistoryMutation:
+		return c.EntityHistory.mutate(ctx, m)
+	case *ExportFileMutation:
+		return c.ExportFile.mutate(ctx, m)
 	case *MenuMutation:
 		return c.Menu.mutate(ctx, m)
 	case *PostMutation:
 		return c.Post.mutate(ctx, m)
+	case *RelationTupleMutation:
+		return c.RelationTuple.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
 	case *UserMutation:
@@ -240,6 +274,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// CasbinRuleClient is a client for the CasbinRule schema.
+type CasbinRuleClient struct {
+	config
+}
+
+// NewCasbinRuleClient returns a client for the CasbinRule from the given config.
+func NewCasbinRuleClient(c config) *CasbinRuleClient {
+	return &CasbinRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `casbinrule.Hooks(f(g(h())))`.
+func (c *CasbinRuleClient) Use(hooks ...Hook) {
+	c.hooks.CasbinRule = append(c.hooks.CasbinRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `casbinrule.Intercept(f(g(h())))`.
+func (c *CasbinRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CasbinRule = append(c.inters.CasbinRule, interceptors...)
+}
+
+// Create returns a builder for creating a CasbinRule entity.
+func (c *CasbinRuleClient) Create() *CasbinRuleCreate {
+	mutation := newCasbinRuleMutation(c.config, OpCreate)
+	return &CasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CasbinRule entities.
+func (c *CasbinRuleClient) CreateBulk(builders ...*CasbinRuleCreate) *CasbinRuleCreateBulk {
+	return &CasbinRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CasbinRuleClient) MapCreateBulk(slice any, setFunc func(*CasbinRuleCreate, int)) *CasbinRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CasbinRuleCreateBulk{err: fmt.Errorf("calling to CasbinRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CasbinRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CasbinRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CasbinRule.
+func (c *CasbinRuleClient) Update() *CasbinRuleUpdate {
+	mutation := newCasbinRuleMutation(c.config, OpUpdate)
+	return &CasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CasbinRuleClient) UpdateOne(_m *CasbinRule) *CasbinRuleUpdateOne {
+	mutation := newCasbinRuleMutation(c.config, OpUpdateOne, withCasbinRule(_m))
+	return &CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CasbinRuleClient) UpdateOneID(id uint64) *CasbinRuleUpdateOne {
+	mutation := newCasbinRuleMutation(c.config, OpUpdateOne, withCasbinRuleID(id))
+	return &CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CasbinRule.
+func (c *CasbinRuleClient) Delete() *CasbinRuleDelete {
+	mutation := newCasbinRuleMutation(c.config, OpDelete)
+	return &CasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CasbinRuleClient) DeleteOne(_m *CasbinRule) *CasbinRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CasbinRuleClient) DeleteOneID(id uint64) *CasbinRuleDeleteOne {
+	builder := c.Delete().Where(casbinrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CasbinRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for CasbinRule.
+func (c *CasbinRuleClient) Query() *CasbinRuleQuery {
+	return &CasbinRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCasbinRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CasbinRule entity by its id.
+func (c *CasbinRuleClient) Get(ctx context.Context, id uint64) (*CasbinRule, error) {
+	return c.Query().Where(casbinrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CasbinRuleClient) GetX(ctx context.Context, id uint64) *CasbinRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CasbinRuleClient) Hooks() []Hook {
+	return c.hooks.CasbinRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *CasbinRuleClient) Interceptors() []Interceptor {
+	return c.inters.CasbinRule
+}
+
+func (c *CasbinRuleClient) mutate(ctx context.Context, m *CasbinRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown CasbinRule mutation op: %q", m.Op())
+	}
+}
+
 // DeptClient is a client for the Dept schema.
 type DeptClient struct {
 	config
@@ -407,6 +574,272 @@ func (c *DeptClient) mutate(ctx context.Context, m *DeptMutation) (Value, error)
 	}
 }
 
+// EntityHistoryClient is a client for the EntityHistory schema.
+type EntityHistoryClient struct {
+	config
+}
+
+// NewEntityHistoryClient returns a client for the EntityHistory from the given config.
+func NewEntityHistoryClient(c config) *EntityHistoryClient {
+	return &EntityHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `entityhistory.Hooks(f(g(h())))`.
+func (c *EntityHistoryClient) Use(hooks ...Hook) {
+	c.hooks.EntityHistory = append(c.hooks.EntityHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `entityhistory.Intercept(f(g(h())))`.
+func (c *EntityHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EntityHistory = append(c.inters.EntityHistory, interceptors...)
+}
+
+// Create returns a builder for creating a EntityHistory entity.
+func (c *EntityHistoryClient) Create() *EntityHistoryCreate {
+	mutation := newEntityHistoryMutation(c.config, OpCreate)
+	return &EntityHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EntityHistory entities.
+func (c *EntityHistoryClient) CreateBulk(builders ...*EntityHistoryCreate) *EntityHistoryCreateBulk {
+	return &EntityHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EntityHistoryClient) MapCreateBulk(slice any, setFunc func(*EntityHistoryCreate, int)) *EntityHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EntityHistoryCreateBulk{err: fmt.Errorf("calling to EntityHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EntityHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EntityHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EntityHistory.
+func (c *EntityHistoryClient) Update() *EntityHistoryUpdate {
+	mutation := newEntityHistoryMutation(c.config, OpUpdate)
+	return &EntityHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EntityHistoryClient) UpdateOne(_m *EntityHistory) *EntityHistoryUpdateOne {
+	mutation := newEntityHistoryMutation(c.config, OpUpdateOne, withEntityHistory(_m))
+	return &EntityHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EntityHistoryClient) UpdateOneID(id uint64) *EntityHistoryUpdateOne {
+	mutation := newEntityHistoryMutation(c.config, OpUpdateOne, withEntityHistoryID(id))
+	return &EntityHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EntityHistory.
+func (c *EntityHistoryClient) Delete() *EntityHistoryDelete {
+	mutation := newEntityHistoryMutation(c.config, OpDelete)
+	return &EntityHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EntityHistoryClient) DeleteOne(_m *EntityHistory) *EntityHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EntityHistoryClient) DeleteOneID(id uint64) *EntityHistoryDeleteOne {
+	builder := c.Delete().Where(entityhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EntityHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for EntityHistory.
+func (c *EntityHistoryClient) Query() *EntityHistoryQuery {
+	return &EntityHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEntityHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EntityHistory entity by its id.
+func (c *EntityHistoryClient) Get(ctx context.Context, id uint64) (*EntityHistory, error) {
+	return c.Query().Where(entityhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EntityHistoryClient) GetX(ctx context.Context, id uint64) *EntityHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EntityHistoryClient) Hooks() []Hook {
+	return c.hooks.EntityHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *EntityHistoryClient) Interceptors() []Interceptor {
+	return c.inters.EntityHistory
+}
+
+func (c *EntityHistoryClient) mutate(ctx context.Context, m *EntityHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EntityHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EntityHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EntityHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EntityHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown EntityHistory mutation op: %q", m.Op())
+	}
+}
+
+// ExportFileClient is a client for the ExportFile schema.
+type ExportFileClient struct {
+	config
+}
+
+// NewExportFileClient returns a client for the ExportFile from the given config.
+func NewExportFileClient(c config) *ExportFileClient {
+	return &ExportFileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `exportfile.Hooks(f(g(h())))`.
+func (c *ExportFileClient) Use(hooks ...Hook) {
+	c.hooks.ExportFile = append(c.hooks.ExportFile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `exportfile.Intercept(f(g(h())))`.
+func (c *ExportFileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExportFile = append(c.inters.ExportFile, interceptors...)
+}
+
+// Create returns a builder for creating a ExportFile entity.
+func (c *ExportFileClient) Create() *ExportFileCreate {
+	mutation := newExportFileMutation(c.config, OpCreate)
+	return &ExportFileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExportFile entities.
+func (c *ExportFileClient) CreateBulk(builders ...*ExportFileCreate) *ExportFileCreateBulk {
+	return &ExportFileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExportFileClient) MapCreateBulk(slice any, setFunc func(*ExportFileCreate, int)) *ExportFileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExportFileCreateBulk{err: fmt.Errorf("calling to ExportFileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExportFileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExportFileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExportFile.
+func (c *ExportFileClient) Update() *ExportFileUpdate {
+	mutation := newExportFileMutation(c.config, OpUpdate)
+	return &ExportFileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExportFileClient) UpdateOne(_m *ExportFile) *ExportFileUpdateOne {
+	mutation := newExportFileMutation(c.config, OpUpdateOne, withExportFile(_m))
+	return &ExportFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExportFileClient) UpdateOneID(id uint64) *ExportFileUpdateOne {
+	mutation := newExportFileMutation(c.config, OpUpdateOne, withExportFileID(id))
+	return &ExportFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExportFile.
+func (c *ExportFileClient) Delete() *ExportFileDelete {
+	mutation := newExportFileMutation(c.config, OpDelete)
+	return &ExportFileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExportFileClient) DeleteOne(_m *ExportFile) *ExportFileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExportFileClient) DeleteOneID(id uint64) *ExportFileDeleteOne {
+	builder := c.Delete().Where(exportfile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExportFileDeleteOne{builder}
+}
+
+// Query returns a query builder for ExportFile.
+func (c *ExportFileClient) Query() *ExportFileQuery {
+	return &ExportFileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExportFile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExportFile entity by its id.
+func (c *ExportFileClient) Get(ctx context.Context, id uint64) (*ExportFile, error) {
+	return c.Query().Where(exportfile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExportFileClient) GetX(ctx context.Context, id uint64) *ExportFile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExportFileClient) Hooks() []Hook {
+	return c.hooks.ExportFile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExportFileClient) Interceptors() []Interceptor {
+	return c.inters.ExportFile
+}
+
+func (c *ExportFileClient) mutate(ctx context.Context, m *ExportFileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExportFileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExportFileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExportFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExportFileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown ExportFile mutation op: %q", m.Op())
+	}
+}
+
 // MenuClient is a client for the Menu schema.
 type MenuClient struct {
 	config
@@ -709,6 +1142,139 @@ func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error)
 	}
 }
 
+// RelationTupleClient is a client for the RelationTuple schema.
+type RelationTupleClient struct {
+	config
+}
+
+// NewRelationTupleClient returns a client for the RelationTuple from the given config.
+func NewRelationTupleClient(c config) *RelationTupleClient {
+	return &RelationTupleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `relationtuple.Hooks(f(g(h())))`.
+func (c *RelationTupleClient) Use(hooks ...Hook) {
+	c.hooks.RelationTuple = append(c.hooks.RelationTuple, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `relationtuple.Intercept(f(g(h())))`.
+func (c *RelationTupleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RelationTuple = append(c.inters.RelationTuple, interceptors...)
+}
+
+// Create returns a builder for creating a RelationTuple entity.
+func (c *RelationTupleClient) Create() *RelationTupleCreate {
+	mutation := newRelationTupleMutation(c.config, OpCreate)
+	return &RelationTupleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RelationTuple entities.
+func (c *RelationTupleClient) CreateBulk(builders ...*RelationTupleCreate) *RelationTupleCreateBulk {
+	return &RelationTupleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RelationTupleClient) MapCreateBulk(slice any, setFunc func(*RelationTupleCreate, int)) *RelationTupleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RelationTupleCreateBulk{err: fmt.Errorf("calling to RelationTupleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RelationTupleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RelationTupleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RelationTuple.
+func (c *RelationTupleClient) Update() *RelationTupleUpdate {
+	mutation := newRelationTupleMutation(c.config, OpUpdate)
+	return &RelationTupleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RelationTupleClient) UpdateOne(_m *RelationTuple) *RelationTupleUpdateOne {
+	mutation := newRelationTupleMutation(c.config, OpUpdateOne, withRelationTuple(_m))
+	return &RelationTupleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RelationTupleClient) UpdateOneID(id uint64) *RelationTupleUpdateOne {
+	mutation := newRelationTupleMutation(c.config, OpUpdateOne, withRelationTupleID(id))
+	return &RelationTupleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RelationTuple.
+func (c *RelationTupleClient) Delete() *RelationTupleDelete {
+	mutation := newRelationTupleMutation(c.config, OpDelete)
+	return &RelationTupleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RelationTupleClient) DeleteOne(_m *RelationTuple) *RelationTupleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RelationTupleClient) DeleteOneID(id uint64) *RelationTupleDeleteOne {
+	builder := c.Delete().Where(relationtuple.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RelationTupleDeleteOne{builder}
+}
+
+// Query returns a query builder for RelationTuple.
+func (c *RelationTupleClient) Query() *RelationTupleQuery {
+	return &RelationTupleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRelationTuple},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RelationTuple entity by its id.
+func (c *RelationTupleClient) Get(ctx context.Context, id uint64) (*RelationTuple, error) {
+	return c.Query().Where(relationtuple.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RelationTupleClient) GetX(ctx context.Context, id uint64) *RelationTuple {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RelationTupleClient) Hooks() []Hook {
+	return c.hooks.RelationTuple
+}
+
+// Interceptors returns the client interceptors.
+func (c *RelationTupleClient) Interceptors() []Interceptor {
+	return c.inters.RelationTuple
+}
+
+func (c *RelationTupleClient) mutate(ctx context.Context, m *RelationTupleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RelationTupleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RelationTupleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RelationTupleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RelationTupleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown RelationTuple mutation op: %q", m.Op())
+	}
+}
+
 // RoleClient is a client for the Role schema.
 type RoleClient struct {
 	config
@@ -1030,10 +1596,12 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Dept, Menu, Post, Role, User []ent.Hook
+		CasbinRule, Dept, EntityHistory, ExportFile, Menu, Post, RelationTuple, Role,
+		User []ent.Hook
 	}
 	inters struct {
-		Dept, Menu, Post, Role, User []ent.Interceptor
+		CasbinRule, Dept, EntityHistory, ExportFile, Menu, Post, RelationTuple, Role,
+		User []ent.Interceptor
 	}
 )
 
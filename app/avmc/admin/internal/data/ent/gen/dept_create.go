@@ -78,6 +78,62 @@ func (_c *DeptCreate) SetNillableDomainID(v *uint32) *DeptCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *DeptCreate) SetVersion(v uint32) *DeptCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *DeptCreate) SetNillableVersion(v *uint32) *DeptCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *DeptCreate) SetCreatedBy(v uint32) *DeptCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *DeptCreate) SetNillableCreatedBy(v *uint32) *DeptCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *DeptCreate) SetUpdatedBy(v uint32) *DeptCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *DeptCreate) SetNillableUpdatedBy(v *uint32) *DeptCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *DeptCreate) SetDeletedBy(v uint32) *DeptCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *DeptCreate) SetNillableDeletedBy(v *uint32) *DeptCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (_c *DeptCreate) SetDeletedAt(v time.Time) *DeptCreate {
 	_c.mutation.SetDeletedAt(v)
@@ -206,6 +262,10 @@ func (_c *DeptCreate) defaults() error {
 		v := dept.DefaultDomainID()
 		_c.mutation.SetDomainID(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := dept.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.ParentID(); !ok {
 		v := dept.DefaultParentID
 		_c.mutation.SetParentID(v)
@@ -241,6 +301,9 @@ func (_c *DeptCreate) check() error {
 			return &ValidationError{Name: "domain_id", err: fmt.Errorf(`gen: validator failed for field "Dept.domain_id": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`gen: missing required field "Dept.version"`)}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`gen: missing required field "Dept.name"`)}
 	}
@@ -303,6 +366,22 @@ func (_c *DeptCreate) createSpec() (*Dept, *sqlgraph.CreateSpec) {
 		_spec.SetField(dept.FieldDomainID, field.TypeUint32, value)
 		_node.DomainID = value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(dept.FieldVersion, field.TypeUint32, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(dept.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(dept.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(dept.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
 	if value, ok := _c.mutation.DeletedAt(); ok {
 		_spec.SetField(dept.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
@@ -448,6 +527,72 @@ func (u *DeptUpsert) AddDomainID(v uint32) *DeptUpsert {
 	return u
 }
 
+// SetVersion sets the "version" field.
+func (u *DeptUpsert) SetVersion(v uint32) *DeptUpsert {
+	u.Set(dept.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DeptUpsert) UpdateVersion() *DeptUpsert {
+	u.SetExcluded(dept.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DeptUpsert) AddVersion(v uint32) *DeptUpsert {
+	u.Add(dept.FieldVersion, v)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *DeptUpsert) SetUpdatedBy(v uint32) *DeptUpsert {
+	u.Set(dept.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *DeptUpsert) UpdateUpdatedBy() *DeptUpsert {
+	u.SetExcluded(dept.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *DeptUpsert) AddUpdatedBy(v uint32) *DeptUpsert {
+	u.Add(dept.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *DeptUpsert) ClearUpdatedBy() *DeptUpsert {
+	u.SetNull(dept.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *DeptUpsert) SetDeletedBy(v uint32) *DeptUpsert {
+	u.Set(dept.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *DeptUpsert) UpdateDeletedBy() *DeptUpsert {
+	u.SetExcluded(dept.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *DeptUpsert) AddDeletedBy(v uint32) *DeptUpsert {
+	u.Add(dept.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *DeptUpsert) ClearDeletedBy() *DeptUpsert {
+	u.SetNull(dept.FieldDeletedBy)
+	return u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *DeptUpsert) SetDeletedAt(v time.Time) *DeptUpsert {
 	u.Set(dept.FieldDeletedAt, v)
@@ -534,6 +679,9 @@ func (u *DeptUpsertOne) UpdateNewValues() *DeptUpsertOne {
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(dept.FieldCreatedAt)
 		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(dept.FieldCreatedBy)
+		}
 	}))
 	return u
 }
@@ -621,6 +769,83 @@ func (u *DeptUpsertOne) UpdateDomainID() *DeptUpsertOne {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *DeptUpsertOne) SetVersion(v uint32) *DeptUpsertOne {
+	return u.Update(func(s *DeptUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DeptUpsertOne) AddVersion(v uint32) *DeptUpsertOne {
+	return u.Update(func(s *DeptUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DeptUpsertOne) UpdateVersion() *DeptUpsertOne {
+	return u.Update(func(s *DeptUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *DeptUpsertOne) SetUpdatedBy(v uint32) *DeptUpsertOne {
+	return u.Update(func(s *DeptUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *DeptUpsertOne) AddUpdatedBy(v uint32) *DeptUpsertOne {
+	return u.Update(func(s *DeptUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *DeptUpsertOne) UpdateUpdatedBy() *DeptUpsertOne {
+	return u.Update(func(s *DeptUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *DeptUpsertOne) ClearUpdatedBy() *DeptUpsertOne {
+	return u.Update(func(s *DeptUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *DeptUpsertOne) SetDeletedBy(v uint32) *DeptUpsertOne {
+	return u.Update(func(s *DeptUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *DeptUpsertOne) AddDeletedBy(v uint32) *DeptUpsertOne {
+	return u.Update(func(s *DeptUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *DeptUpsertOne) UpdateDeletedBy() *DeptUpsertOne {
+	return u.Update(func(s *DeptUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *DeptUpsertOne) ClearDeletedBy() *DeptUpsertOne {
+	return u.Update(func(s *DeptUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *DeptUpsertOne) SetDeletedAt(v time.Time) *DeptUpsertOne {
 	return u.Update(func(s *DeptUpsert) {
@@ -883,6 +1108,9 @@ func (u *DeptUpsertBulk) UpdateNewValues() *DeptUpsertBulk {
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(dept.FieldCreatedAt)
 			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(dept.FieldCreatedBy)
+			}
 		}
 	}))
 	return u
@@ -971,6 +1199,83 @@ func (u *DeptUpsertBulk) UpdateDomainID() *DeptUpsertBulk {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *DeptUpsertBulk) SetVersion(v uint32) *DeptUpsertBulk {
+	return u.Update(func(s *DeptUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DeptUpsertBulk) AddVersion(v uint32) *DeptUpsertBulk {
+	return u.Update(func(s *DeptUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DeptUpsertBulk) UpdateVersion() *DeptUpsertBulk {
+	return u.Update(func(s *DeptUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *DeptUpsertBulk) SetUpdatedBy(v uint32) *DeptUpsertBulk {
+	return u.Update(func(s *DeptUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *DeptUpsertBulk) AddUpdatedBy(v uint32) *DeptUpsertBulk {
+	return u.Update(func(s *DeptUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *DeptUpsertBulk) UpdateUpdatedBy() *DeptUpsertBulk {
+	return u.Update(func(s *DeptUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *DeptUpsertBulk) ClearUpdatedBy() *DeptUpsertBulk {
+	return u.Update(func(s *DeptUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *DeptUpsertBulk) SetDeletedBy(v uint32) *DeptUpsertBulk {
+	return u.Update(func(s *DeptUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *DeptUpsertBulk) AddDeletedBy(v uint32) *DeptUpsertBulk {
+	return u.Update(func(s *DeptUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *DeptUpsertBulk) UpdateDeletedBy() *DeptUpsertBulk {
+	return u.Update(func(s *DeptUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *DeptUpsertBulk) ClearDeletedBy() *DeptUpsertBulk {
+	return u.Update(func(s *DeptUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *DeptUpsertBulk) SetDeletedAt(v time.Time) *DeptUpsertBulk {
 	return u.Update(func(s *DeptUpsert) {
@@ -78,6 +78,81 @@ func (_u *DeptUpdate) AddDomainID(v int32) *DeptUpdate {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *DeptUpdate) SetVersion(v uint32) *DeptUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DeptUpdate) SetNillableVersion(v *uint32) *DeptUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DeptUpdate) AddVersion(v int32) *DeptUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *DeptUpdate) SetUpdatedBy(v uint32) *DeptUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *DeptUpdate) SetNillableUpdatedBy(v *uint32) *DeptUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *DeptUpdate) AddUpdatedBy(v int32) *DeptUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *DeptUpdate) ClearUpdatedBy() *DeptUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *DeptUpdate) SetDeletedBy(v uint32) *DeptUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *DeptUpdate) SetNillableDeletedBy(v *uint32) *DeptUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *DeptUpdate) AddDeletedBy(v int32) *DeptUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *DeptUpdate) ClearDeletedBy() *DeptUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (_u *DeptUpdate) SetDeletedAt(v time.Time) *DeptUpdate {
 	_u.mutation.SetDeletedAt(v)
@@ -297,6 +372,33 @@ func (_u *DeptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedDomainID(); ok {
 		_spec.AddField(dept.FieldDomainID, field.TypeUint32, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(dept.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(dept.FieldVersion, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(dept.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(dept.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(dept.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(dept.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(dept.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(dept.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(dept.FieldDeletedBy, field.TypeUint32)
+	}
 	if value, ok := _u.mutation.DeletedAt(); ok {
 		_spec.SetField(dept.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -461,6 +563,81 @@ func (_u *DeptUpdateOne) AddDomainID(v int32) *DeptUpdateOne {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *DeptUpdateOne) SetVersion(v uint32) *DeptUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DeptUpdateOne) SetNillableVersion(v *uint32) *DeptUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DeptUpdateOne) AddVersion(v int32) *DeptUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *DeptUpdateOne) SetUpdatedBy(v uint32) *DeptUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *DeptUpdateOne) SetNillableUpdatedBy(v *uint32) *DeptUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *DeptUpdateOne) AddUpdatedBy(v int32) *DeptUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *DeptUpdateOne) ClearUpdatedBy() *DeptUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *DeptUpdateOne) SetDeletedBy(v uint32) *DeptUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *DeptUpdateOne) SetNillableDeletedBy(v *uint32) *DeptUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *DeptUpdateOne) AddDeletedBy(v int32) *DeptUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *DeptUpdateOne) ClearDeletedBy() *DeptUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (_u *DeptUpdateOne) SetDeletedAt(v time.Time) *DeptUpdateOne {
 	_u.mutation.SetDeletedAt(v)
@@ -710,6 +887,33 @@ func (_u *DeptUpdateOne) sqlSave(ctx context.Context) (_node *Dept, err error) {
 	if value, ok := _u.mutation.AddedDomainID(); ok {
 		_spec.AddField(dept.FieldDomainID, field.TypeUint32, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(dept.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(dept.FieldVersion, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(dept.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(dept.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(dept.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(dept.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(dept.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(dept.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(dept.FieldDeletedBy, field.TypeUint32)
+	}
 	if value, ok := _u.mutation.DeletedAt(); ok {
 		_spec.SetField(dept.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -3,9 +3,13 @@
 package gen
 
 import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/casbinrule"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/dept"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/entityhistory"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/exportfile"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/menu"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/post"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/relationtuple"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/role"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
 	"context"
@@ -77,11 +81,15 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			dept.Table: dept.ValidColumn,
-			menu.Table: menu.ValidColumn,
-			post.Table: post.ValidColumn,
-			role.Table: role.ValidColumn,
-			user.Table: user.ValidColumn,
+			casbinrule.Table:    casbinrule.ValidColumn,
+			dept.Table:          dept.ValidColumn,
+			entityhistory.Table: entityhistory.ValidColumn,
+			exportfile.Table:    exportfile.ValidColumn,
+			menu.Table:          menu.ValidColumn,
+			post.Table:          post.ValidColumn,
+			relationtuple.Table: relationtuple.ValidColumn,
+			role.Table:          role.ValidColumn,
+			user.Table:          user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,593 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/entityhistory"
+	"backend-service/pkg/entgo"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EntityHistoryCreate is the builder for creating a EntityHistory entity.
+type EntityHistoryCreate struct {
+	config
+	mutation *EntityHistoryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EntityHistoryCreate) SetCreatedAt(v time.Time) *EntityHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EntityHistoryCreate) SetNillableCreatedAt(v *time.Time) *EntityHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetResource sets the "resource" field.
+func (_c *EntityHistoryCreate) SetResource(v string) *EntityHistoryCreate {
+	_c.mutation.SetResource(v)
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *EntityHistoryCreate) SetEntityID(v uint32) *EntityHistoryCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *EntityHistoryCreate) SetAction(v string) *EntityHistoryCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *EntityHistoryCreate) SetChanges(v []entgo.FieldChange) *EntityHistoryCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (_c *EntityHistoryCreate) SetSnapshot(v map[string]interface{}) *EntityHistoryCreate {
+	_c.mutation.SetSnapshot(v)
+	return _c
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_c *EntityHistoryCreate) SetOperatorID(v uint32) *EntityHistoryCreate {
+	_c.mutation.SetOperatorID(v)
+	return _c
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (_c *EntityHistoryCreate) SetNillableOperatorID(v *uint32) *EntityHistoryCreate {
+	if v != nil {
+		_c.SetOperatorID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EntityHistoryCreate) SetID(v uint64) *EntityHistoryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the EntityHistoryMutation object of the builder.
+func (_c *EntityHistoryCreate) Mutation() *EntityHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the EntityHistory in the database.
+func (_c *EntityHistoryCreate) Save(ctx context.Context) (*EntityHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EntityHistoryCreate) SaveX(ctx context.Context) *EntityHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntityHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntityHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EntityHistoryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := entityhistory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EntityHistoryCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "EntityHistory.created_at"`)}
+	}
+	if _, ok := _c.mutation.Resource(); !ok {
+		return &ValidationError{Name: "resource", err: errors.New(`gen: missing required field "EntityHistory.resource"`)}
+	}
+	if v, ok := _c.mutation.Resource(); ok {
+		if err := entityhistory.ResourceValidator(v); err != nil {
+			return &ValidationError{Name: "resource", err: fmt.Errorf(`gen: validator failed for field "EntityHistory.resource": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`gen: missing required field "EntityHistory.entity_id"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`gen: missing required field "EntityHistory.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := entityhistory.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`gen: validator failed for field "EntityHistory.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *EntityHistoryCreate) sqlSave(ctx context.Context) (*EntityHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EntityHistoryCreate) createSpec() (*EntityHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EntityHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(entityhistory.Table, sqlgraph.NewFieldSpec(entityhistory.FieldID, field.TypeUint64))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(entityhistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Resource(); ok {
+		_spec.SetField(entityhistory.FieldResource, field.TypeString, value)
+		_node.Resource = value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(entityhistory.FieldEntityID, field.TypeUint32, value)
+		_node.EntityID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(entityhistory.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(entityhistory.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.Snapshot(); ok {
+		_spec.SetField(entityhistory.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
+	if value, ok := _c.mutation.OperatorID(); ok {
+		_spec.SetField(entityhistory.FieldOperatorID, field.TypeUint32, value)
+		_node.OperatorID = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EntityHistory.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EntityHistoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EntityHistoryCreate) OnConflict(opts ...sql.ConflictOption) *EntityHistoryUpsertOne {
+	_c.conflict = opts
+	return &EntityHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EntityHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EntityHistoryCreate) OnConflictColumns(columns ...string) *EntityHistoryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EntityHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EntityHistoryUpsertOne is the builder for "upsert"-ing
+	//  one EntityHistory node.
+	EntityHistoryUpsertOne struct {
+		create *EntityHistoryCreate
+	}
+
+	// EntityHistoryUpsert is the "OnConflict" setter.
+	EntityHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EntityHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(entityhistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EntityHistoryUpsertOne) UpdateNewValues() *EntityHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(entityhistory.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(entityhistory.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Resource(); exists {
+			s.SetIgnore(entityhistory.FieldResource)
+		}
+		if _, exists := u.create.mutation.EntityID(); exists {
+			s.SetIgnore(entityhistory.FieldEntityID)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(entityhistory.FieldAction)
+		}
+		if _, exists := u.create.mutation.Changes(); exists {
+			s.SetIgnore(entityhistory.FieldChanges)
+		}
+		if _, exists := u.create.mutation.Snapshot(); exists {
+			s.SetIgnore(entityhistory.FieldSnapshot)
+		}
+		if _, exists := u.create.mutation.OperatorID(); exists {
+			s.SetIgnore(entityhistory.FieldOperatorID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EntityHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EntityHistoryUpsertOne) Ignore() *EntityHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EntityHistoryUpsertOne) DoNothing() *EntityHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EntityHistoryCreate.OnConflict
+// documentation for more info.
+func (u *EntityHistoryUpsertOne) Update(set func(*EntityHistoryUpsert)) *EntityHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EntityHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *EntityHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("gen: missing options for EntityHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EntityHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EntityHistoryUpsertOne) ID(ctx context.Context) (id uint64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EntityHistoryUpsertOne) IDX(ctx context.Context) uint64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EntityHistoryCreateBulk is the builder for creating many EntityHistory entities in bulk.
+type EntityHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*EntityHistoryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EntityHistory entities in the database.
+func (_c *EntityHistoryCreateBulk) Save(ctx context.Context) ([]*EntityHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EntityHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EntityHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EntityHistoryCreateBulk) SaveX(ctx context.Context) []*EntityHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntityHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntityHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EntityHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EntityHistoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EntityHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *EntityHistoryUpsertBulk {
+	_c.conflict = opts
+	return &EntityHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EntityHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EntityHistoryCreateBulk) OnConflictColumns(columns ...string) *EntityHistoryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EntityHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// EntityHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of EntityHistory nodes.
+type EntityHistoryUpsertBulk struct {
+	create *EntityHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EntityHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(entityhistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EntityHistoryUpsertBulk) UpdateNewValues() *EntityHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(entityhistory.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(entityhistory.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Resource(); exists {
+				s.SetIgnore(entityhistory.FieldResource)
+			}
+			if _, exists := b.mutation.EntityID(); exists {
+				s.SetIgnore(entityhistory.FieldEntityID)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(entityhistory.FieldAction)
+			}
+			if _, exists := b.mutation.Changes(); exists {
+				s.SetIgnore(entityhistory.FieldChanges)
+			}
+			if _, exists := b.mutation.Snapshot(); exists {
+				s.SetIgnore(entityhistory.FieldSnapshot)
+			}
+			if _, exists := b.mutation.OperatorID(); exists {
+				s.SetIgnore(entityhistory.FieldOperatorID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EntityHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EntityHistoryUpsertBulk) Ignore() *EntityHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EntityHistoryUpsertBulk) DoNothing() *EntityHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EntityHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *EntityHistoryUpsertBulk) Update(set func(*EntityHistoryUpsert)) *EntityHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EntityHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *EntityHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("gen: OnConflict was set for builder %d. Set it on the EntityHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("gen: missing options for EntityHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EntityHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
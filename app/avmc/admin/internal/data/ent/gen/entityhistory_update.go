@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/entityhistory"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EntityHistoryUpdate is the builder for updating EntityHistory entities.
+type EntityHistoryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EntityHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EntityHistoryUpdate builder.
+func (_u *EntityHistoryUpdate) Where(ps ...predicate.EntityHistory) *EntityHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the EntityHistoryMutation object of the builder.
+func (_u *EntityHistoryUpdate) Mutation() *EntityHistoryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EntityHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EntityHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EntityHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EntityHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EntityHistoryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EntityHistoryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EntityHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(entityhistory.Table, entityhistory.Columns, sqlgraph.NewFieldSpec(entityhistory.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(entityhistory.FieldChanges, field.TypeJSON)
+	}
+	if _u.mutation.SnapshotCleared() {
+		_spec.ClearField(entityhistory.FieldSnapshot, field.TypeJSON)
+	}
+	if _u.mutation.OperatorIDCleared() {
+		_spec.ClearField(entityhistory.FieldOperatorID, field.TypeUint32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entityhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EntityHistoryUpdateOne is the builder for updating a single EntityHistory entity.
+type EntityHistoryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EntityHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the EntityHistoryMutation object of the builder.
+func (_u *EntityHistoryUpdateOne) Mutation() *EntityHistoryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EntityHistoryUpdate builder.
+func (_u *EntityHistoryUpdateOne) Where(ps ...predicate.EntityHistory) *EntityHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EntityHistoryUpdateOne) Select(field string, fields ...string) *EntityHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EntityHistory entity.
+func (_u *EntityHistoryUpdateOne) Save(ctx context.Context) (*EntityHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EntityHistoryUpdateOne) SaveX(ctx context.Context) *EntityHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EntityHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EntityHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EntityHistoryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EntityHistoryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EntityHistoryUpdateOne) sqlSave(ctx context.Context) (_node *EntityHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(entityhistory.Table, entityhistory.Columns, sqlgraph.NewFieldSpec(entityhistory.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "EntityHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, entityhistory.FieldID)
+		for _, f := range fields {
+			if !entityhistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != entityhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(entityhistory.FieldChanges, field.TypeJSON)
+	}
+	if _u.mutation.SnapshotCleared() {
+		_spec.ClearField(entityhistory.FieldSnapshot, field.TypeJSON)
+	}
+	if _u.mutation.OperatorIDCleared() {
+		_spec.ClearField(entityhistory.FieldOperatorID, field.TypeUint32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &EntityHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entityhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,10 +3,14 @@
 package gen
 
 import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/casbinrule"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/dept"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/entityhistory"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/exportfile"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/menu"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/post"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/relationtuple"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/role"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
 
@@ -18,8 +22,28 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 5)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 9)}
 	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   casbinrule.Table,
+			Columns: casbinrule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint64,
+				Column: casbinrule.FieldID,
+			},
+		},
+		Type: "CasbinRule",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			casbinrule.FieldPtype: {Type: field.TypeString, Column: casbinrule.FieldPtype},
+			casbinrule.FieldV0:    {Type: field.TypeString, Column: casbinrule.FieldV0},
+			casbinrule.FieldV1:    {Type: field.TypeString, Column: casbinrule.FieldV1},
+			casbinrule.FieldV2:    {Type: field.TypeString, Column: casbinrule.FieldV2},
+			casbinrule.FieldV3:    {Type: field.TypeString, Column: casbinrule.FieldV3},
+			casbinrule.FieldV4:    {Type: field.TypeString, Column: casbinrule.FieldV4},
+			casbinrule.FieldV5:    {Type: field.TypeString, Column: casbinrule.FieldV5},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dept.Table,
 			Columns: dept.Columns,
@@ -34,13 +58,55 @@ var schemaGraph = func() *sqlgraph.Schema {
 			dept.FieldUpdatedAt: {Type: field.TypeTime, Column: dept.FieldUpdatedAt},
 			dept.FieldStatus:    {Type: field.TypeInt32, Column: dept.FieldStatus},
 			dept.FieldDomainID:  {Type: field.TypeUint32, Column: dept.FieldDomainID},
+			dept.FieldVersion:   {Type: field.TypeUint32, Column: dept.FieldVersion},
+			dept.FieldCreatedBy: {Type: field.TypeUint32, Column: dept.FieldCreatedBy},
+			dept.FieldUpdatedBy: {Type: field.TypeUint32, Column: dept.FieldUpdatedBy},
+			dept.FieldDeletedBy: {Type: field.TypeUint32, Column: dept.FieldDeletedBy},
 			dept.FieldDeletedAt: {Type: field.TypeTime, Column: dept.FieldDeletedAt},
 			dept.FieldName:      {Type: field.TypeString, Column: dept.FieldName},
 			dept.FieldParentID:  {Type: field.TypeUint32, Column: dept.FieldParentID},
 			dept.FieldAncestors: {Type: field.TypeJSON, Column: dept.FieldAncestors},
 		},
 	}
-	graph.Nodes[1] = &sqlgraph.Node{
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   entityhistory.Table,
+			Columns: entityhistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint64,
+				Column: entityhistory.FieldID,
+			},
+		},
+		Type: "EntityHistory",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			entityhistory.FieldCreatedAt:  {Type: field.TypeTime, Column: entityhistory.FieldCreatedAt},
+			entityhistory.FieldResource:   {Type: field.TypeString, Column: entityhistory.FieldResource},
+			entityhistory.FieldEntityID:   {Type: field.TypeUint32, Column: entityhistory.FieldEntityID},
+			entityhistory.FieldAction:     {Type: field.TypeString, Column: entityhistory.FieldAction},
+			entityhistory.FieldChanges:    {Type: field.TypeJSON, Column: entityhistory.FieldChanges},
+			entityhistory.FieldSnapshot:   {Type: field.TypeJSON, Column: entityhistory.FieldSnapshot},
+			entityhistory.FieldOperatorID: {Type: field.TypeUint32, Column: entityhistory.FieldOperatorID},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   exportfile.Table,
+			Columns: exportfile.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint64,
+				Column: exportfile.FieldID,
+			},
+		},
+		Type: "ExportFile",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			exportfile.FieldCreatedAt: {Type: field.TypeTime, Column: exportfile.FieldCreatedAt},
+			exportfile.FieldJobID:     {Type: field.TypeString, Column: exportfile.FieldJobID},
+			exportfile.FieldSeq:       {Type: field.TypeInt, Column: exportfile.FieldSeq},
+			exportfile.FieldData:      {Type: field.TypeBytes, Column: exportfile.FieldData},
+			exportfile.FieldSize:      {Type: field.TypeInt, Column: exportfile.FieldSize},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   menu.Table,
 			Columns: menu.Columns,
@@ -54,6 +120,10 @@ var schemaGraph = func() *sqlgraph.Schema {
 			menu.FieldStatus:             {Type: field.TypeInt32, Column: menu.FieldStatus},
 			menu.FieldCreatedAt:          {Type: field.TypeTime, Column: menu.FieldCreatedAt},
 			menu.FieldUpdatedAt:          {Type: field.TypeTime, Column: menu.FieldUpdatedAt},
+			menu.FieldVersion:            {Type: field.TypeUint32, Column: menu.FieldVersion},
+			menu.FieldCreatedBy:          {Type: field.TypeUint32, Column: menu.FieldCreatedBy},
+			menu.FieldUpdatedBy:          {Type: field.TypeUint32, Column: menu.FieldUpdatedBy},
+			menu.FieldDeletedBy:          {Type: field.TypeUint32, Column: menu.FieldDeletedBy},
 			menu.FieldDeletedAt:          {Type: field.TypeTime, Column: menu.FieldDeletedAt},
 			menu.FieldName:               {Type: field.TypeString, Column: menu.FieldName},
 			menu.FieldPath:               {Type: field.TypeString, Column: menu.FieldPath},
@@ -85,7 +155,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			menu.FieldTitle:              {Type: field.TypeString, Column: menu.FieldTitle},
 		},
 	}
-	graph.Nodes[2] = &sqlgraph.Node{
+	graph.Nodes[5] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   post.Table,
 			Columns: post.Columns,
@@ -100,11 +170,34 @@ var schemaGraph = func() *sqlgraph.Schema {
 			post.FieldUpdatedAt: {Type: field.TypeTime, Column: post.FieldUpdatedAt},
 			post.FieldStatus:    {Type: field.TypeInt32, Column: post.FieldStatus},
 			post.FieldDomainID:  {Type: field.TypeUint32, Column: post.FieldDomainID},
+			post.FieldVersion:   {Type: field.TypeUint32, Column: post.FieldVersion},
+			post.FieldCreatedBy: {Type: field.TypeUint32, Column: post.FieldCreatedBy},
+			post.FieldUpdatedBy: {Type: field.TypeUint32, Column: post.FieldUpdatedBy},
+			post.FieldDeletedBy: {Type: field.TypeUint32, Column: post.FieldDeletedBy},
 			post.FieldDeletedAt: {Type: field.TypeTime, Column: post.FieldDeletedAt},
 			post.FieldName:      {Type: field.TypeString, Column: post.FieldName},
 		},
 	}
-	graph.Nodes[3] = &sqlgraph.Node{
+	graph.Nodes[6] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   relationtuple.Table,
+			Columns: relationtuple.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint64,
+				Column: relationtuple.FieldID,
+			},
+		},
+		Type: "RelationTuple",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			relationtuple.FieldObjectNamespace:  {Type: field.TypeString, Column: relationtuple.FieldObjectNamespace},
+			relationtuple.FieldObjectID:         {Type: field.TypeString, Column: relationtuple.FieldObjectID},
+			relationtuple.FieldRelation:         {Type: field.TypeString, Column: relationtuple.FieldRelation},
+			relationtuple.FieldSubjectNamespace: {Type: field.TypeString, Column: relationtuple.FieldSubjectNamespace},
+			relationtuple.FieldSubjectID:        {Type: field.TypeString, Column: relationtuple.FieldSubjectID},
+			relationtuple.FieldSubjectRelation:  {Type: field.TypeString, Column: relationtuple.FieldSubjectRelation},
+		},
+	}
+	graph.Nodes[7] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   role.Table,
 			Columns: role.Columns,
@@ -119,6 +212,10 @@ var schemaGraph = func() *sqlgraph.Schema {
 			role.FieldUpdatedAt:         {Type: field.TypeTime, Column: role.FieldUpdatedAt},
 			role.FieldStatus:            {Type: field.TypeInt32, Column: role.FieldStatus},
 			role.FieldDomainID:          {Type: field.TypeUint32, Column: role.FieldDomainID},
+			role.FieldVersion:           {Type: field.TypeUint32, Column: role.FieldVersion},
+			role.FieldCreatedBy:         {Type: field.TypeUint32, Column: role.FieldCreatedBy},
+			role.FieldUpdatedBy:         {Type: field.TypeUint32, Column: role.FieldUpdatedBy},
+			role.FieldDeletedBy:         {Type: field.TypeUint32, Column: role.FieldDeletedBy},
 			role.FieldDeletedAt:         {Type: field.TypeTime, Column: role.FieldDeletedAt},
 			role.FieldName:              {Type: field.TypeString, Column: role.FieldName},
 			role.FieldDefaultRouter:     {Type: field.TypeString, Column: role.FieldDefaultRouter},
@@ -127,7 +224,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			role.FieldDeptCheckStrictly: {Type: field.TypeInt32, Column: role.FieldDeptCheckStrictly},
 		},
 	}
-	graph.Nodes[4] = &sqlgraph.Node{
+	graph.Nodes[8] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -142,6 +239,10 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldUpdatedAt:   {Type: field.TypeTime, Column: user.FieldUpdatedAt},
 			user.FieldStatus:      {Type: field.TypeInt32, Column: user.FieldStatus},
 			user.FieldDomainID:    {Type: field.TypeUint32, Column: user.FieldDomainID},
+			user.FieldVersion:     {Type: field.TypeUint32, Column: user.FieldVersion},
+			user.FieldCreatedBy:   {Type: field.TypeUint32, Column: user.FieldCreatedBy},
+			user.FieldUpdatedBy:   {Type: field.TypeUint32, Column: user.FieldUpdatedBy},
+			user.FieldDeletedBy:   {Type: field.TypeUint32, Column: user.FieldDeletedBy},
 			user.FieldDeletedAt:   {Type: field.TypeTime, Column: user.FieldDeletedAt},
 			user.FieldName:        {Type: field.TypeString, Column: user.FieldName},
 			user.FieldPassword:    {Type: field.TypeString, Column: user.FieldPassword},
@@ -159,6 +260,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldSettings:    {Type: field.TypeJSON, Column: user.FieldSettings},
 			user.FieldMetadata:    {Type: field.TypeJSON, Column: user.FieldMetadata},
 			user.FieldDescription: {Type: field.TypeString, Column: user.FieldDescription},
+			user.FieldDeptID:      {Type: field.TypeUint32, Column: user.FieldDeptID},
 		},
 	}
 	graph.MustAddE(
@@ -254,6 +356,81 @@ type predicateAdder interface {
 	addPredicate(func(s *sql.Selector))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *CasbinRuleQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the CasbinRuleQuery builder.
+func (_q *CasbinRuleQuery) Filter() *CasbinRuleFilter {
+	return &CasbinRuleFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *CasbinRuleMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the CasbinRuleMutation builder.
+func (m *CasbinRuleMutation) Filter() *CasbinRuleFilter {
+	return &CasbinRuleFilter{config: m.config, predicateAdder: m}
+}
+
+// CasbinRuleFilter provides a generic filtering capability at runtime for CasbinRuleQuery.
+type CasbinRuleFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CasbinRuleFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint64 predicate on the id field.
+func (f *CasbinRuleFilter) WhereID(p entql.Uint64P) {
+	f.Where(p.Field(casbinrule.FieldID))
+}
+
+// WherePtype applies the entql string predicate on the ptype field.
+func (f *CasbinRuleFilter) WherePtype(p entql.StringP) {
+	f.Where(p.Field(casbinrule.FieldPtype))
+}
+
+// WhereV0 applies the entql string predicate on the v0 field.
+func (f *CasbinRuleFilter) WhereV0(p entql.StringP) {
+	f.Where(p.Field(casbinrule.FieldV0))
+}
+
+// WhereV1 applies the entql string predicate on the v1 field.
+func (f *CasbinRuleFilter) WhereV1(p entql.StringP) {
+	f.Where(p.Field(casbinrule.FieldV1))
+}
+
+// WhereV2 applies the entql string predicate on the v2 field.
+func (f *CasbinRuleFilter) WhereV2(p entql.StringP) {
+	f.Where(p.Field(casbinrule.FieldV2))
+}
+
+// WhereV3 applies the entql string predicate on the v3 field.
+func (f *CasbinRuleFilter) WhereV3(p entql.StringP) {
+	f.Where(p.Field(casbinrule.FieldV3))
+}
+
+// WhereV4 applies the entql string predicate on the v4 field.
+func (f *CasbinRuleFilter) WhereV4(p entql.StringP) {
+	f.Where(p.Field(casbinrule.FieldV4))
+}
+
+// WhereV5 applies the entql string predicate on the v5 field.
+func (f *CasbinRuleFilter) WhereV5(p entql.StringP) {
+	f.Where(p.Field(casbinrule.FieldV5))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *DeptQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -283,7 +460,7 @@ type DeptFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *DeptFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -314,6 +491,26 @@ func (f *DeptFilter) WhereDomainID(p entql.Uint32P) {
 	f.Where(p.Field(dept.FieldDomainID))
 }
 
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *DeptFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(dept.FieldVersion))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *DeptFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(dept.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *DeptFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(dept.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *DeptFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(dept.FieldDeletedBy))
+}
+
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
 func (f *DeptFilter) WhereDeletedAt(p entql.TimeP) {
 	f.Where(p.Field(dept.FieldDeletedAt))
@@ -362,6 +559,146 @@ func (f *DeptFilter) WhereHasChildrenWith(preds ...predicate.Dept) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *EntityHistoryQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the EntityHistoryQuery builder.
+func (_q *EntityHistoryQuery) Filter() *EntityHistoryFilter {
+	return &EntityHistoryFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *EntityHistoryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the EntityHistoryMutation builder.
+func (m *EntityHistoryMutation) Filter() *EntityHistoryFilter {
+	return &EntityHistoryFilter{config: m.config, predicateAdder: m}
+}
+
+// EntityHistoryFilter provides a generic filtering capability at runtime for EntityHistoryQuery.
+type EntityHistoryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *EntityHistoryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint64 predicate on the id field.
+func (f *EntityHistoryFilter) WhereID(p entql.Uint64P) {
+	f.Where(p.Field(entityhistory.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *EntityHistoryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(entityhistory.FieldCreatedAt))
+}
+
+// WhereResource applies the entql string predicate on the resource field.
+func (f *EntityHistoryFilter) WhereResource(p entql.StringP) {
+	f.Where(p.Field(entityhistory.FieldResource))
+}
+
+// WhereEntityID applies the entql uint32 predicate on the entity_id field.
+func (f *EntityHistoryFilter) WhereEntityID(p entql.Uint32P) {
+	f.Where(p.Field(entityhistory.FieldEntityID))
+}
+
+// WhereAction applies the entql string predicate on the action field.
+func (f *EntityHistoryFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(entityhistory.FieldAction))
+}
+
+// WhereChanges applies the entql json.RawMessage predicate on the changes field.
+func (f *EntityHistoryFilter) WhereChanges(p entql.BytesP) {
+	f.Where(p.Field(entityhistory.FieldChanges))
+}
+
+// WhereSnapshot applies the entql json.RawMessage predicate on the snapshot field.
+func (f *EntityHistoryFilter) WhereSnapshot(p entql.BytesP) {
+	f.Where(p.Field(entityhistory.FieldSnapshot))
+}
+
+// WhereOperatorID applies the entql uint32 predicate on the operator_id field.
+func (f *EntityHistoryFilter) WhereOperatorID(p entql.Uint32P) {
+	f.Where(p.Field(entityhistory.FieldOperatorID))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *ExportFileQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ExportFileQuery builder.
+func (_q *ExportFileQuery) Filter() *ExportFileFilter {
+	return &ExportFileFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ExportFileMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ExportFileMutation builder.
+func (m *ExportFileMutation) Filter() *ExportFileFilter {
+	return &ExportFileFilter{config: m.config, predicateAdder: m}
+}
+
+// ExportFileFilter provides a generic filtering capability at runtime for ExportFileQuery.
+type ExportFileFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ExportFileFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint64 predicate on the id field.
+func (f *ExportFileFilter) WhereID(p entql.Uint64P) {
+	f.Where(p.Field(exportfile.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ExportFileFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(exportfile.FieldCreatedAt))
+}
+
+// WhereJobID applies the entql string predicate on the job_id field.
+func (f *ExportFileFilter) WhereJobID(p entql.StringP) {
+	f.Where(p.Field(exportfile.FieldJobID))
+}
+
+// WhereSeq applies the entql int predicate on the seq field.
+func (f *ExportFileFilter) WhereSeq(p entql.IntP) {
+	f.Where(p.Field(exportfile.FieldSeq))
+}
+
+// WhereData applies the entql []byte predicate on the data field.
+func (f *ExportFileFilter) WhereData(p entql.BytesP) {
+	f.Where(p.Field(exportfile.FieldData))
+}
+
+// WhereSize applies the entql int predicate on the size field.
+func (f *ExportFileFilter) WhereSize(p entql.IntP) {
+	f.Where(p.Field(exportfile.FieldSize))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *MenuQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -391,7 +728,7 @@ type MenuFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MenuFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -417,6 +754,26 @@ func (f *MenuFilter) WhereUpdatedAt(p entql.TimeP) {
 	f.Where(p.Field(menu.FieldUpdatedAt))
 }
 
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *MenuFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(menu.FieldVersion))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *MenuFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(menu.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *MenuFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(menu.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *MenuFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(menu.FieldDeletedBy))
+}
+
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
 func (f *MenuFilter) WhereDeletedAt(p entql.TimeP) {
 	f.Where(p.Field(menu.FieldDeletedAt))
@@ -619,7 +976,7 @@ type PostFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PostFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -650,6 +1007,26 @@ func (f *PostFilter) WhereDomainID(p entql.Uint32P) {
 	f.Where(p.Field(post.FieldDomainID))
 }
 
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *PostFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(post.FieldVersion))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *PostFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(post.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *PostFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(post.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *PostFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(post.FieldDeletedBy))
+}
+
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
 func (f *PostFilter) WhereDeletedAt(p entql.TimeP) {
 	f.Where(p.Field(post.FieldDeletedAt))
@@ -660,6 +1037,76 @@ func (f *PostFilter) WhereName(p entql.StringP) {
 	f.Where(p.Field(post.FieldName))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *RelationTupleQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RelationTupleQuery builder.
+func (_q *RelationTupleQuery) Filter() *RelationTupleFilter {
+	return &RelationTupleFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RelationTupleMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RelationTupleMutation builder.
+func (m *RelationTupleMutation) Filter() *RelationTupleFilter {
+	return &RelationTupleFilter{config: m.config, predicateAdder: m}
+}
+
+// RelationTupleFilter provides a generic filtering capability at runtime for RelationTupleQuery.
+type RelationTupleFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RelationTupleFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint64 predicate on the id field.
+func (f *RelationTupleFilter) WhereID(p entql.Uint64P) {
+	f.Where(p.Field(relationtuple.FieldID))
+}
+
+// WhereObjectNamespace applies the entql string predicate on the object_namespace field.
+func (f *RelationTupleFilter) WhereObjectNamespace(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldObjectNamespace))
+}
+
+// WhereObjectID applies the entql string predicate on the object_id field.
+func (f *RelationTupleFilter) WhereObjectID(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldObjectID))
+}
+
+// WhereRelation applies the entql string predicate on the relation field.
+func (f *RelationTupleFilter) WhereRelation(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldRelation))
+}
+
+// WhereSubjectNamespace applies the entql string predicate on the subject_namespace field.
+func (f *RelationTupleFilter) WhereSubjectNamespace(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldSubjectNamespace))
+}
+
+// WhereSubjectID applies the entql string predicate on the subject_id field.
+func (f *RelationTupleFilter) WhereSubjectID(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldSubjectID))
+}
+
+// WhereSubjectRelation applies the entql string predicate on the subject_relation field.
+func (f *RelationTupleFilter) WhereSubjectRelation(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldSubjectRelation))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *RoleQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -689,7 +1136,7 @@ type RoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -720,6 +1167,26 @@ func (f *RoleFilter) WhereDomainID(p entql.Uint32P) {
 	f.Where(p.Field(role.FieldDomainID))
 }
 
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *RoleFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(role.FieldVersion))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *RoleFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(role.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *RoleFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(role.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *RoleFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(role.FieldDeletedBy))
+}
+
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
 func (f *RoleFilter) WhereDeletedAt(p entql.TimeP) {
 	f.Where(p.Field(role.FieldDeletedAt))
@@ -793,7 +1260,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -824,6 +1291,26 @@ func (f *UserFilter) WhereDomainID(p entql.Uint32P) {
 	f.Where(p.Field(user.FieldDomainID))
 }
 
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *UserFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(user.FieldVersion))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *UserFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(user.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *UserFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(user.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *UserFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(user.FieldDeletedBy))
+}
+
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
 func (f *UserFilter) WhereDeletedAt(p entql.TimeP) {
 	f.Where(p.Field(user.FieldDeletedAt))
@@ -909,6 +1396,11 @@ func (f *UserFilter) WhereDescription(p entql.StringP) {
 	f.Where(p.Field(user.FieldDescription))
 }
 
+// WhereDeptID applies the entql uint32 predicate on the dept_id field.
+func (f *UserFilter) WhereDeptID(p entql.Uint32P) {
+	f.Where(p.Field(user.FieldDeptID))
+}
+
 // WhereHasRoles applies a predicate to check if query has an edge roles.
 func (f *UserFilter) WhereHasRoles() {
 	f.Where(entql.HasEdge("roles"))
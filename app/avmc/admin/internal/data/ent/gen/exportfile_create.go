@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/exportfile"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExportFileCreate is the builder for creating a ExportFile entity.
+type ExportFileCreate struct {
+	config
+	mutation *ExportFileMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExportFileCreate) SetCreatedAt(v time.Time) *ExportFileCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExportFileCreate) SetNillableCreatedAt(v *time.Time) *ExportFileCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetJobID sets the "job_id" field.
+func (_c *ExportFileCreate) SetJobID(v string) *ExportFileCreate {
+	_c.mutation.SetJobID(v)
+	return _c
+}
+
+// SetSeq sets the "seq" field.
+func (_c *ExportFileCreate) SetSeq(v int) *ExportFileCreate {
+	_c.mutation.SetSeq(v)
+	return _c
+}
+
+// SetData sets the "data" field.
+func (_c *ExportFileCreate) SetData(v []byte) *ExportFileCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *ExportFileCreate) SetSize(v int) *ExportFileCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ExportFileCreate) SetID(v uint64) *ExportFileCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ExportFileMutation object of the builder.
+func (_c *ExportFileCreate) Mutation() *ExportFileMutation {
+	return _c.mutation
+}
+
+// Save creates the ExportFile in the database.
+func (_c *ExportFileCreate) Save(ctx context.Context) (*ExportFile, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExportFileCreate) SaveX(ctx context.Context) *ExportFile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExportFileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExportFileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExportFileCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := exportfile.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExportFileCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "ExportFile.created_at"`)}
+	}
+	if _, ok := _c.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`gen: missing required field "ExportFile.job_id"`)}
+	}
+	if v, ok := _c.mutation.JobID(); ok {
+		if err := exportfile.JobIDValidator(v); err != nil {
+			return &ValidationError{Name: "job_id", err: fmt.Errorf(`gen: validator failed for field "ExportFile.job_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`gen: missing required field "ExportFile.seq"`)}
+	}
+	if v, ok := _c.mutation.Seq(); ok {
+		if err := exportfile.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`gen: validator failed for field "ExportFile.seq": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`gen: missing required field "ExportFile.data"`)}
+	}
+	if v, ok := _c.mutation.Data(); ok {
+		if err := exportfile.DataValidator(v); err != nil {
+			return &ValidationError{Name: "data", err: fmt.Errorf(`gen: validator failed for field "ExportFile.data": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`gen: missing required field "ExportFile.size"`)}
+	}
+	if v, ok := _c.mutation.Size(); ok {
+		if err := exportfile.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`gen: validator failed for field "ExportFile.size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ExportFileCreate) sqlSave(ctx context.Context) (*ExportFile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExportFileCreate) createSpec() (*ExportFile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExportFile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(exportfile.Table, sqlgraph.NewFieldSpec(exportfile.FieldID, field.TypeUint64))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(exportfile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.JobID(); ok {
+		_spec.SetField(exportfile.FieldJobID, field.TypeString, value)
+		_node.JobID = value
+	}
+	if value, ok := _c.mutation.Seq(); ok {
+		_spec.SetField(exportfile.FieldSeq, field.TypeInt, value)
+		_node.Seq = value
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(exportfile.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(exportfile.FieldSize, field.TypeInt, value)
+		_node.Size = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExportFile.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExportFileUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExportFileCreate) OnConflict(opts ...sql.ConflictOption) *ExportFileUpsertOne {
+	_c.conflict = opts
+	return &ExportFileUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExportFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExportFileCreate) OnConflictColumns(columns ...string) *ExportFileUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExportFileUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ExportFileUpsertOne is the builder for "upsert"-ing
+	//  one ExportFile node.
+	ExportFileUpsertOne struct {
+		create *ExportFileCreate
+	}
+
+	// ExportFileUpsert is the "OnConflict" setter.
+	ExportFileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ExportFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exportfile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExportFileUpsertOne) UpdateNewValues() *ExportFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(exportfile.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(exportfile.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.JobID(); exists {
+			s.SetIgnore(exportfile.FieldJobID)
+		}
+		if _, exists := u.create.mutation.Seq(); exists {
+			s.SetIgnore(exportfile.FieldSeq)
+		}
+		if _, exists := u.create.mutation.Data(); exists {
+			s.SetIgnore(exportfile.FieldData)
+		}
+		if _, exists := u.create.mutation.Size(); exists {
+			s.SetIgnore(exportfile.FieldSize)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExportFile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExportFileUpsertOne) Ignore() *ExportFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExportFileUpsertOne) DoNothing() *ExportFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExportFileCreate.OnConflict
+// documentation for more info.
+func (u *ExportFileUpsertOne) Update(set func(*ExportFileUpsert)) *ExportFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExportFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ExportFileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("gen: missing options for ExportFileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExportFileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExportFileUpsertOne) ID(ctx context.Context) (id uint64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExportFileUpsertOne) IDX(ctx context.Context) uint64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExportFileCreateBulk is the builder for creating many ExportFile entities in bulk.
+type ExportFileCreateBulk struct {
+	config
+	err      error
+	builders []*ExportFileCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExportFile entities in the database.
+func (_c *ExportFileCreateBulk) Save(ctx context.Context) ([]*ExportFile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExportFile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExportFileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExportFileCreateBulk) SaveX(ctx context.Context) []*ExportFile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExportFileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExportFileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExportFile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExportFileUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExportFileCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExportFileUpsertBulk {
+	_c.conflict = opts
+	return &ExportFileUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExportFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExportFileCreateBulk) OnConflictColumns(columns ...string) *ExportFileUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExportFileUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExportFileUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExportFile nodes.
+type ExportFileUpsertBulk struct {
+	create *ExportFileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExportFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exportfile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExportFileUpsertBulk) UpdateNewValues() *ExportFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(exportfile.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(exportfile.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.JobID(); exists {
+				s.SetIgnore(exportfile.FieldJobID)
+			}
+			if _, exists := b.mutation.Seq(); exists {
+				s.SetIgnore(exportfile.FieldSeq)
+			}
+			if _, exists := b.mutation.Data(); exists {
+				s.SetIgnore(exportfile.FieldData)
+			}
+			if _, exists := b.mutation.Size(); exists {
+				s.SetIgnore(exportfile.FieldSize)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExportFile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExportFileUpsertBulk) Ignore() *ExportFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExportFileUpsertBulk) DoNothing() *ExportFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExportFileCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExportFileUpsertBulk) Update(set func(*ExportFileUpsert)) *ExportFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExportFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ExportFileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("gen: OnConflict was set for builder %d. Set it on the ExportFileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("gen: missing options for ExportFileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExportFileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
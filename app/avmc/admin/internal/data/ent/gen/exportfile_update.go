@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/exportfile"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExportFileUpdate is the builder for updating ExportFile entities.
+type ExportFileUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ExportFileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ExportFileUpdate builder.
+func (_u *ExportFileUpdate) Where(ps ...predicate.ExportFile) *ExportFileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the ExportFileMutation object of the builder.
+func (_u *ExportFileUpdate) Mutation() *ExportFileMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExportFileUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExportFileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExportFileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExportFileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExportFileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExportFileUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ExportFileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(exportfile.Table, exportfile.Columns, sqlgraph.NewFieldSpec(exportfile.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exportfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExportFileUpdateOne is the builder for updating a single ExportFile entity.
+type ExportFileUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ExportFileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the ExportFileMutation object of the builder.
+func (_u *ExportFileUpdateOne) Mutation() *ExportFileMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExportFileUpdate builder.
+func (_u *ExportFileUpdateOne) Where(ps ...predicate.ExportFile) *ExportFileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExportFileUpdateOne) Select(field string, fields ...string) *ExportFileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExportFile entity.
+func (_u *ExportFileUpdateOne) Save(ctx context.Context) (*ExportFile, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExportFileUpdateOne) SaveX(ctx context.Context) *ExportFile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExportFileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExportFileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExportFileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExportFileUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ExportFileUpdateOne) sqlSave(ctx context.Context) (_node *ExportFile, err error) {
+	_spec := sqlgraph.NewUpdateSpec(exportfile.Table, exportfile.Columns, sqlgraph.NewFieldSpec(exportfile.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "ExportFile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exportfile.FieldID)
+		for _, f := range fields {
+			if !exportfile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != exportfile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ExportFile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exportfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
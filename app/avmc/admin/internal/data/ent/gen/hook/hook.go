@@ -8,6 +8,18 @@ import (
 	"fmt"
 )
 
+// The CasbinRuleFunc type is an adapter to allow the use of ordinary
+// function as CasbinRule mutator.
+type CasbinRuleFunc func(context.Context, *gen.CasbinRuleMutation) (gen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CasbinRuleFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error) {
+	if mv, ok := m.(*gen.CasbinRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.CasbinRuleMutation", m)
+}
+
 // The DeptFunc type is an adapter to allow the use of ordinary
 // function as Dept mutator.
 type DeptFunc func(context.Context, *gen.DeptMutation) (gen.Value, error)
@@ -20,6 +32,30 @@ func (f DeptFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.DeptMutation", m)
 }
 
+// The EntityHistoryFunc type is an adapter to allow the use of ordinary
+// function as EntityHistory mutator.
+type EntityHistoryFunc func(context.Context, *gen.EntityHistoryMutation) (gen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EntityHistoryFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error) {
+	if mv, ok := m.(*gen.EntityHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.EntityHistoryMutation", m)
+}
+
+// The ExportFileFunc type is an adapter to allow the use of ordinary
+// function as ExportFile mutator.
+type ExportFileFunc func(context.Context, *gen.ExportFileMutation) (gen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExportFileFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error) {
+	if mv, ok := m.(*gen.ExportFileMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.ExportFileMutation", m)
+}
+
 // The MenuFunc type is an adapter to allow the use of ordinary
 // function as Menu mutator.
 type MenuFunc func(context.Context, *gen.MenuMutation) (gen.Value, error)
@@ -44,6 +80,18 @@ func (f PostFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.PostMutation", m)
 }
 
+// The RelationTupleFunc type is an adapter to allow the use of ordinary
+// function as RelationTuple mutator.
+type RelationTupleFunc func(context.Context, *gen.RelationTupleMutation) (gen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RelationTupleFunc) Mutate(ctx context.Context, m gen.Mutation) (gen.Value, error) {
+	if mv, ok := m.(*gen.RelationTupleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *gen.RelationTupleMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *gen.RoleMutation) (gen.Value, error)
@@ -7,10 +7,14 @@ import (
 	"fmt"
 
 	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/casbinrule"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/dept"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/entityhistory"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/exportfile"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/menu"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/post"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/relationtuple"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/role"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
 
@@ -73,6 +77,33 @@ func (f TraverseFunc) Traverse(ctx context.Context, q gen.Query) error {
 	return f(ctx, query)
 }
 
+// The CasbinRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CasbinRuleFunc func(context.Context, *gen.CasbinRuleQuery) (gen.Value, error)
+
+// Query calls f(ctx, q).
+func (f CasbinRuleFunc) Query(ctx context.Context, q gen.Query) (gen.Value, error) {
+	if q, ok := q.(*gen.CasbinRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *gen.CasbinRuleQuery", q)
+}
+
+// The TraverseCasbinRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCasbinRule func(context.Context, *gen.CasbinRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCasbinRule) Intercept(next gen.Querier) gen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCasbinRule) Traverse(ctx context.Context, q gen.Query) error {
+	if q, ok := q.(*gen.CasbinRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *gen.CasbinRuleQuery", q)
+}
+
 // The DeptFunc type is an adapter to allow the use of ordinary function as a Querier.
 type DeptFunc func(context.Context, *gen.DeptQuery) (gen.Value, error)
 
@@ -100,6 +131,60 @@ func (f TraverseDept) Traverse(ctx context.Context, q gen.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *gen.DeptQuery", q)
 }
 
+// The EntityHistoryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type EntityHistoryFunc func(context.Context, *gen.EntityHistoryQuery) (gen.Value, error)
+
+// Query calls f(ctx, q).
+func (f EntityHistoryFunc) Query(ctx context.Context, q gen.Query) (gen.Value, error) {
+	if q, ok := q.(*gen.EntityHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *gen.EntityHistoryQuery", q)
+}
+
+// The TraverseEntityHistory type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseEntityHistory func(context.Context, *gen.EntityHistoryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseEntityHistory) Intercept(next gen.Querier) gen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseEntityHistory) Traverse(ctx context.Context, q gen.Query) error {
+	if q, ok := q.(*gen.EntityHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *gen.EntityHistoryQuery", q)
+}
+
+// The ExportFileFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ExportFileFunc func(context.Context, *gen.ExportFileQuery) (gen.Value, error)
+
+// Query calls f(ctx, q).
+func (f ExportFileFunc) Query(ctx context.Context, q gen.Query) (gen.Value, error) {
+	if q, ok := q.(*gen.ExportFileQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *gen.ExportFileQuery", q)
+}
+
+// The TraverseExportFile type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseExportFile func(context.Context, *gen.ExportFileQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseExportFile) Intercept(next gen.Querier) gen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseExportFile) Traverse(ctx context.Context, q gen.Query) error {
+	if q, ok := q.(*gen.ExportFileQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *gen.ExportFileQuery", q)
+}
+
 // The MenuFunc type is an adapter to allow the use of ordinary function as a Querier.
 type MenuFunc func(context.Context, *gen.MenuQuery) (gen.Value, error)
 
@@ -154,6 +239,33 @@ func (f TraversePost) Traverse(ctx context.Context, q gen.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *gen.PostQuery", q)
 }
 
+// The RelationTupleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RelationTupleFunc func(context.Context, *gen.RelationTupleQuery) (gen.Value, error)
+
+// Query calls f(ctx, q).
+func (f RelationTupleFunc) Query(ctx context.Context, q gen.Query) (gen.Value, error) {
+	if q, ok := q.(*gen.RelationTupleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *gen.RelationTupleQuery", q)
+}
+
+// The TraverseRelationTuple type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRelationTuple func(context.Context, *gen.RelationTupleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRelationTuple) Intercept(next gen.Querier) gen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRelationTuple) Traverse(ctx context.Context, q gen.Query) error {
+	if q, ok := q.(*gen.RelationTupleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *gen.RelationTupleQuery", q)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary function as a Querier.
 type RoleFunc func(context.Context, *gen.RoleQuery) (gen.Value, error)
 
@@ -211,12 +323,20 @@ func (f TraverseUser) Traverse(ctx context.Context, q gen.Query) error {
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q gen.Query) (Query, error) {
 	switch q := q.(type) {
+	case *gen.CasbinRuleQuery:
+		return &query[*gen.CasbinRuleQuery, predicate.CasbinRule, casbinrule.OrderOption]{typ: gen.TypeCasbinRule, tq: q}, nil
 	case *gen.DeptQuery:
 		return &query[*gen.DeptQuery, predicate.Dept, dept.OrderOption]{typ: gen.TypeDept, tq: q}, nil
+	case *gen.EntityHistoryQuery:
+		return &query[*gen.EntityHistoryQuery, predicate.EntityHistory, entityhistory.OrderOption]{typ: gen.TypeEntityHistory, tq: q}, nil
+	case *gen.ExportFileQuery:
+		return &query[*gen.ExportFileQuery, predicate.ExportFile, exportfile.OrderOption]{typ: gen.TypeExportFile, tq: q}, nil
 	case *gen.MenuQuery:
 		return &query[*gen.MenuQuery, predicate.Menu, menu.OrderOption]{typ: gen.TypeMenu, tq: q}, nil
 	case *gen.PostQuery:
 		return &query[*gen.PostQuery, predicate.Post, post.OrderOption]{typ: gen.TypePost, tq: q}, nil
+	case *gen.RelationTupleQuery:
+		return &query[*gen.RelationTupleQuery, predicate.RelationTuple, relationtuple.OrderOption]{typ: gen.TypeRelationTuple, tq: q}, nil
 	case *gen.RoleQuery:
 		return &query[*gen.RoleQuery, predicate.Role, role.OrderOption]{typ: gen.TypeRole, tq: q}, nil
 	case *gen.UserQuery:
@@ -6,4 +6,4 @@
 // Package internal holds a loadable version of the latest schema.
 package internal
 
-const Schema = "{\"Schema\":\"backend-service/app/avmc/admin/internal/data/ent/schema\",\"Package\":\"backend-service/app/avmc/admin/internal/data/ent/gen\",\"Schemas\":[{\"name\":\"Dept\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"parent\",\"type\":\"Dept\",\"field\":\"parent_id\",\"ref\":{\"name\":\"children\",\"type\":\"Dept\"},\"unique\":true,\"inverse\":true}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id,omitempty\\\"\",\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"serial\"},\"comment\":\"id\"},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"创建时间\"},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"update_default\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"更新时间\"},{\"name\":\"status\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"状态：0=未知 1=启用 2=禁用\"},{\"name\":\"domain_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"domain_id,omitempty\\\"\",\"default\":true,\"default_kind\":19,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"bigint\"},\"comment\":\"域ID\"},{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"删除时间\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":128,\"nillable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"名称\"},{\"name\":\"parent_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":0,\"default_kind\":10,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"父级ID\"},{\"name\":\"ancestors\",\"type\":{\"Type\":3,\"Ident\":\"[]int\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]int\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"default\":true,\"default_value\":[],\"default_kind\":23,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"祖级列表\"}],\"hooks\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1}],\"interceptors\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1}],\"annotations\":{\"Comment\":{\"Text\":\"部门表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"with_comments\":true}}},{\"name\":\"Menu\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"parent\",\"type\":\"Menu\",\"field\":\"parent_id\",\"ref\":{\"name\":\"children\",\"type\":\"Menu\"},\"unique\":true,\"inverse\":true}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id,omitempty\\\"\",\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"serial\"},\"comment\":\"id\"},{\"name\":\"status\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"状态：0=未知 1=启用 2=禁用\"},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2},\"comment\":\"创建时间\"},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"update_default\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":3},\"comment\":\"更新时间\"},{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":4},\"comment\":\"删除时间\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":32,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":2,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"菜单名称\"},{\"name\":\"path\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"路径,当其类型为'按钮'的时候对应的数据操作名,例如:/user.service.v1.UserService/Login\"},{\"name\":\"type\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint\",\"postgres\":\"tinyint(2)\"},\"comment\":\"菜单类型 0 UNSPECIFIED, 目录 1 -\\u003e FOLDER, 菜单 2 -\\u003e MENU, 按钮 3 -\\u003e BUTTON\"},{\"name\":\"component\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"组件\"},{\"name\":\"parent_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":0,\"default_kind\":10,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"父级ID\"},{\"name\":\"redirect\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"重定向\"},{\"name\":\"auth_code\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"后端权限标识\"},{\"name\":\"active_icon\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"激活时显示的图标\"},{\"name\":\"active_path\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"作为路由时，需要激活的菜单的Path\"},{\"name\":\"affix_tab\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"固定在标签栏\"},{\"name\":\"affix_tab_order\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":5,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"在标签栏固定的顺序\"},{\"name\":\"badge\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":11,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"徽标内容(当徽标类型为normal时有效)\"},{\"name\":\"badge_type\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":5,\"position\":{\"Index\":12,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"徽标类型\"},{\"name\":\"badge_variants\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":5,\"position\":{\"Index\":13,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"徽标颜色\"},{\"name\":\"hide_children_in_menu\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":14,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"在菜单中隐藏下级\"},{\"name\":\"hide_in_breadcrumb\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":15,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"在面包屑中隐藏\"},{\"name\":\"hide_in_menu\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":16,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"在菜单中隐藏\"},{\"name\":\"hide_in_tab\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":17,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"在标签栏中隐藏\"},{\"name\":\"icon\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":128,\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":18,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"菜单图标\"},{\"name\":\"iframe_src\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":19,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"内嵌Iframe的URL\"},{\"name\":\"keep_alive\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":20,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"是否缓存页面\"},{\"name\":\"link\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":21,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"外链页面的URL\"},{\"name\":\"max_num_of_open_tab\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":5,\"position\":{\"Index\":22,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"同一个路由最大打开的标签数\"},{\"name\":\"no_basic_layout\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":23,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"无需基础布局\"},{\"name\":\"open_in_new_window\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":24,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"是否在新窗口打开\"},{\"name\":\"sort\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":10,\"default_kind\":5,\"position\":{\"Index\":25,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"菜单排序\"},{\"name\":\"query\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":26,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"额外的路由参数\"},{\"name\":\"title\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":27,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"菜单标题\"}],\"indexes\":[{\"fields\":[\"id\"]},{\"fields\":[\"name\"]},{\"fields\":[\"status\"]},{\"fields\":[\"parent_id\"]}],\"hooks\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":4}],\"interceptors\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":4}],\"annotations\":{\"Comment\":{\"Text\":\"菜单表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"with_comments\":true}}},{\"name\":\"Post\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id,omitempty\\\"\",\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"serial\"},\"comment\":\"id\"},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"创建时间\"},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"update_default\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"更新时间\"},{\"name\":\"status\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"状态：0=未知 1=启用 2=禁用\"},{\"name\":\"domain_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"domain_id,omitempty\\\"\",\"default\":true,\"default_kind\":19,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"bigint\"},\"comment\":\"域ID\"},{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"删除时间\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":50,\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"名称\"}],\"hooks\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1}],\"interceptors\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1}],\"annotations\":{\"Comment\":{\"Text\":\"岗位表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"with_comments\":true}}},{\"name\":\"Role\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"users\",\"type\":\"User\",\"ref_name\":\"roles\",\"inverse\":true}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id,omitempty\\\"\",\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"serial\"},\"comment\":\"id\"},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"创建时间\"},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"update_default\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"更新时间\"},{\"name\":\"status\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"状态：0=未知 1=启用 2=禁用\"},{\"name\":\"domain_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"domain_id,omitempty\\\"\",\"default\":true,\"default_kind\":19,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"bigint\"},\"comment\":\"域ID\"},{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"删除时间\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":50,\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"名称\"},{\"name\":\"default_router\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":255,\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"默认路由\"},{\"name\":\"data_scope\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"数据范围（0：未指定 1：全部数据权限 2：本人数据权限 3：本部门数据权限 4：本部门及以下数据权限 5：自定部门数据权限 ）\"},{\"name\":\"menu_check_strictly\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"菜单树选择项是否关联显示\"},{\"name\":\"dept_check_strictly\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"部门树选择项是否关联显示\"}],\"indexes\":[{\"fields\":[\"name\"]}],\"hooks\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1}],\"interceptors\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1}],\"annotations\":{\"Comment\":{\"Text\":\"角色表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"with_comments\":true}}},{\"name\":\"User\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"roles\",\"type\":\"Role\"},{\"name\":\"posts\",\"type\":\"Post\"}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id,omitempty\\\"\",\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"serial\"},\"comment\":\"id\"},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"创建时间\"},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"update_default\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"更新时间\"},{\"name\":\"status\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"状态：0=未知 1=启用 2=禁用\"},{\"name\":\"domain_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"domain_id,omitempty\\\"\",\"default\":true,\"default_kind\":19,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"bigint\"},\"comment\":\"域ID\"},{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"删除时间\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":32,\"unique\":true,\"nillable\":true,\"validators\":3,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"用户名，唯一\"},{\"name\":\"password\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"nillable\":true,\"validators\":2,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"comment\":\"密码哈希\"},{\"name\":\"realname\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":50,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"用户真实姓名\"},{\"name\":\"nickname\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":50,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"用户昵称\"},{\"name\":\"email\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"unique\":true,\"nillable\":true,\"optional\":true,\"validators\":2,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"电子邮箱，唯一\"},{\"name\":\"phone\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":20,\"unique\":true,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"手机号码，唯一\"},{\"name\":\"avatar\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":255,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"头像URL\"},{\"name\":\"birthday\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"date\"},\"comment\":\"生日\"},{\"name\":\"gender\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint\",\"postgres\":\"tinyint(2)\"},\"comment\":\"性别：0=未知 1=男 2=女\"},{\"name\":\"age\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"validators\":2,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"年龄\"},{\"name\":\"last_login_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"最后登录时间\"},{\"name\":\"last_login_ip\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":50,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":11,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"最后登录IP\"},{\"name\":\"login_count\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":2,\"position\":{\"Index\":12,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"登录次数\"},{\"name\":\"settings\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"default\":true,\"default_value\":[],\"default_kind\":23,\"position\":{\"Index\":13,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"用户设置，JSON格式\"},{\"name\":\"metadata\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"default\":true,\"default_value\":[],\"default_kind\":23,\"position\":{\"Index\":14,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"元数据，JSON格式\"},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":255,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":15,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"个人说明\"}],\"indexes\":[{\"fields\":[\"name\"]},{\"fields\":[\"phone\"]},{\"fields\":[\"status\"]},{\"fields\":[\"email\"]}],\"hooks\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1}],\"interceptors\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1}],\"annotations\":{\"Comment\":{\"Text\":\"用户表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"with_comments\":true}}}],\"Features\":[\"privacy\",\"sql/modifier\",\"entql\",\"sql/upsert\",\"sql/lock\",\"intercept\",\"schema/snapshot\",\"sql/execquery\",\"namedges\"]}"
+const Schema = "{\"Schema\":\"backend-service/app/avmc/admin/internal/data/ent/schema\",\"Package\":\"backend-service/app/avmc/admin/internal/data/ent/gen\",\"Schemas\":[{\"name\":\"CasbinRule\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"id\"},{\"name\":\"ptype\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"策略类型\"},{\"name\":\"v0\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"策略字段0\"},{\"name\":\"v1\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"策略字段1\"},{\"name\":\"v2\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"策略字段2\"},{\"name\":\"v3\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"策略字段3\"},{\"name\":\"v4\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"策略字段4\"},{\"name\":\"v5\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"策略字段5\"}],\"indexes\":[{\"unique\":true,\"fields\":[\"ptype\",\"v0\",\"v1\",\"v2\",\"v3\",\"v4\",\"v5\"],\"storage_key\":\"idx_casbin_rule\"}],\"annotations\":{\"Comment\":{\"Text\":\"权限策略表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"table\":\"casbin_rule\",\"with_comments\":true}}},{\"name\":\"Dept\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"parent\",\"type\":\"Dept\",\"field\":\"parent_id\",\"ref\":{\"name\":\"children\",\"type\":\"Dept\"},\"unique\":true,\"inverse\":true}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id,omitempty\\\"\",\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"serial\"},\"comment\":\"id\"},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"创建时间\"},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"update_default\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"更新时间\"},{\"name\":\"status\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"状态：0=未知 1=启用 2=禁用\"},{\"name\":\"domain_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"domain_id,omitempty\\\"\",\"default\":true,\"default_kind\":19,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"bigint\"},\"comment\":\"域ID\"},{\"name\":\"version\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"position\":{\"Index\":5,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"版本号，乐观锁\"},{\"name\":\"created_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"创建人ID\"},{\"name\":\"updated_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"更新人ID\"},{\"name\":\"deleted_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"删除人ID\"},{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2},\"comment\":\"删除时间\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":128,\"nillable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"名称\"},{\"name\":\"parent_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":0,\"default_kind\":10,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"父级ID\"},{\"name\":\"ancestors\",\"type\":{\"Type\":3,\"Ident\":\"[]int\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]int\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"default\":true,\"default_value\":[],\"default_kind\":23,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"祖级列表\"}],\"hooks\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2}],\"interceptors\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2}],\"annotations\":{\"Comment\":{\"Text\":\"部门表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"with_comments\":true}}},{\"name\":\"EntityHistory\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"创建时间\"},{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"id\"},{\"name\":\"resource\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":32,\"immutable\":true,\"validators\":2,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"资源名称\"},{\"name\":\"entity_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"实体ID\"},{\"name\":\"action\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":16,\"immutable\":true,\"validators\":2,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"操作：create/update/delete/restore/purge\"},{\"name\":\"changes\",\"type\":{\"Type\":3,\"Ident\":\"[]entgo.FieldChange\",\"PkgPath\":\"backend-service/pkg/entgo\",\"PkgName\":\"entgo\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]entgo.FieldChange\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"字段变更，敏感字段以掩码代替\"},{\"name\":\"snapshot\",\"type\":{\"Type\":3,\"Ident\":\"map[string]interface {}\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"map[string]interface {}\",\"Kind\":21,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"变更后的数据快照，彻底删除时为空\"},{\"name\":\"operator_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"immutable\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"操作人ID\"}],\"indexes\":[{\"fields\":[\"resource\",\"entity_id\",\"created_at\"],\"storage_key\":\"idx_entity_history\"}],\"annotations\":{\"Comment\":{\"Text\":\"实体变更历史表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"table\":\"entity_history\",\"with_comments\":true}}},{\"name\":\"ExportFile\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"创建时间\"},{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"id\"},{\"name\":\"job_id\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":32,\"immutable\":true,\"validators\":2,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"导出任务ID\"},{\"name\":\"seq\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"分块序号，从0开始\"},{\"name\":\"data\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"size\":1048576,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"分块内容\"},{\"name\":\"size\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"分块大小\"}],\"indexes\":[{\"unique\":true,\"fields\":[\"job_id\",\"seq\"],\"storage_key\":\"idx_export_file\"},{\"fields\":[\"created_at\"],\"storage_key\":\"idx_export_file_created_at\"}],\"annotations\":{\"Comment\":{\"Text\":\"导出文件表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"table\":\"export_file\",\"with_comments\":true}}},{\"name\":\"Menu\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"parent\",\"type\":\"Menu\",\"field\":\"parent_id\",\"ref\":{\"name\":\"children\",\"type\":\"Menu\"},\"unique\":true,\"inverse\":true}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id,omitempty\\\"\",\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"serial\"},\"comment\":\"id\"},{\"name\":\"status\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"状态：0=未知 1=启用 2=禁用\"},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2},\"comment\":\"创建时间\"},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"update_default\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":3},\"comment\":\"更新时间\"},{\"name\":\"version\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":4},\"comment\":\"版本号，乐观锁\"},{\"name\":\"created_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":5},\"comment\":\"创建人ID\"},{\"name\":\"updated_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":5},\"comment\":\"更新人ID\"},{\"name\":\"deleted_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":5},\"comment\":\"删除人ID\"},{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":6},\"comment\":\"删除时间\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":32,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":2,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"菜单名称\"},{\"name\":\"path\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"路径,当其类型为'按钮'的时候对应的数据操作名,例如:/user.service.v1.UserService/Login\"},{\"name\":\"type\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint\",\"postgres\":\"tinyint(2)\"},\"comment\":\"菜单类型 0 UNSPECIFIED, 目录 1 -\\u003e FOLDER, 菜单 2 -\\u003e MENU, 按钮 3 -\\u003e BUTTON\"},{\"name\":\"component\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"组件\"},{\"name\":\"parent_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":0,\"default_kind\":10,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"父级ID\"},{\"name\":\"redirect\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"重定向\"},{\"name\":\"auth_code\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"后端权限标识\"},{\"name\":\"active_icon\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"激活时显示的图标\"},{\"name\":\"active_path\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"作为路由时，需要激活的菜单的Path\"},{\"name\":\"affix_tab\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"固定在标签栏\"},{\"name\":\"affix_tab_order\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":5,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"在标签栏固定的顺序\"},{\"name\":\"badge\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":11,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"徽标内容(当徽标类型为normal时有效)\"},{\"name\":\"badge_type\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":5,\"position\":{\"Index\":12,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"徽标类型\"},{\"name\":\"badge_variants\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":5,\"position\":{\"Index\":13,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"徽标颜色\"},{\"name\":\"hide_children_in_menu\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":14,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"在菜单中隐藏下级\"},{\"name\":\"hide_in_breadcrumb\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":15,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"在面包屑中隐藏\"},{\"name\":\"hide_in_menu\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":16,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"在菜单中隐藏\"},{\"name\":\"hide_in_tab\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":17,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"在标签栏中隐藏\"},{\"name\":\"icon\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":128,\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":18,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"菜单图标\"},{\"name\":\"iframe_src\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":19,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"内嵌Iframe的URL\"},{\"name\":\"keep_alive\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":20,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"是否缓存页面\"},{\"name\":\"link\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":21,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"外链页面的URL\"},{\"name\":\"max_num_of_open_tab\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":5,\"position\":{\"Index\":22,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"同一个路由最大打开的标签数\"},{\"name\":\"no_basic_layout\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":23,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"无需基础布局\"},{\"name\":\"open_in_new_window\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":24,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"是否在新窗口打开\"},{\"name\":\"sort\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":10,\"default_kind\":5,\"position\":{\"Index\":25,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"菜单排序\"},{\"name\":\"query\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":26,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"额外的路由参数\"},{\"name\":\"title\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":27,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"菜单标题\"}],\"indexes\":[{\"fields\":[\"id\"]},{\"fields\":[\"name\"]},{\"fields\":[\"status\"]},{\"fields\":[\"parent_id\"]}],\"hooks\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":5},{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":5},{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":5},{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":6}],\"interceptors\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":6}],\"annotations\":{\"Comment\":{\"Text\":\"菜单表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"with_comments\":true}}},{\"name\":\"Post\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id,omitempty\\\"\",\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"serial\"},\"comment\":\"id\"},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"创建时间\"},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"update_default\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"更新时间\"},{\"name\":\"status\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"状态：0=未知 1=启用 2=禁用\"},{\"name\":\"domain_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"domain_id,omitempty\\\"\",\"default\":true,\"default_kind\":19,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"bigint\"},\"comment\":\"域ID\"},{\"name\":\"version\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"position\":{\"Index\":5,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"版本号，乐观锁\"},{\"name\":\"created_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"创建人ID\"},{\"name\":\"updated_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"更新人ID\"},{\"name\":\"deleted_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"删除人ID\"},{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2},\"comment\":\"删除时间\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":50,\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"名称\"}],\"hooks\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2}],\"interceptors\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2}],\"annotations\":{\"Comment\":{\"Text\":\"岗位表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"with_comments\":true}}},{\"name\":\"RelationTuple\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"id\"},{\"name\":\"object_namespace\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":64,\"validators\":2,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"对象命名空间\"},{\"name\":\"object_id\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":128,\"validators\":2,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"对象ID\"},{\"name\":\"relation\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":64,\"validators\":2,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"关系\"},{\"name\":\"subject_namespace\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":64,\"validators\":2,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"主体命名空间\"},{\"name\":\"subject_id\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":128,\"validators\":2,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"主体ID\"},{\"name\":\"subject_relation\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":64,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"主体关系，为空表示直接主体\"}],\"indexes\":[{\"unique\":true,\"fields\":[\"object_namespace\",\"object_id\",\"relation\",\"subject_namespace\",\"subject_id\",\"subject_relation\"],\"storage_key\":\"idx_relation_tuple\"},{\"fields\":[\"subject_namespace\",\"subject_id\",\"subject_relation\"],\"storage_key\":\"idx_relation_tuple_subject\"}],\"annotations\":{\"Comment\":{\"Text\":\"关系元组表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"table\":\"relation_tuple\",\"with_comments\":true}}},{\"name\":\"Role\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"users\",\"type\":\"User\",\"ref_name\":\"roles\",\"inverse\":true}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id,omitempty\\\"\",\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"serial\"},\"comment\":\"id\"},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"创建时间\"},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"update_default\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"更新时间\"},{\"name\":\"status\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"状态：0=未知 1=启用 2=禁用\"},{\"name\":\"domain_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"domain_id,omitempty\\\"\",\"default\":true,\"default_kind\":19,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"bigint\"},\"comment\":\"域ID\"},{\"name\":\"version\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"position\":{\"Index\":5,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"版本号，乐观锁\"},{\"name\":\"created_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"创建人ID\"},{\"name\":\"updated_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"更新人ID\"},{\"name\":\"deleted_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"删除人ID\"},{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2},\"comment\":\"删除时间\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":50,\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"名称\"},{\"name\":\"default_router\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":255,\"nillable\":true,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"默认路由\"},{\"name\":\"data_scope\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"数据范围（0：未指定 1：全部数据权限 2：本人数据权限 3：本部门数据权限 4：本部门及以下数据权限 5：自定部门数据权限 ）\"},{\"name\":\"menu_check_strictly\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"菜单树选择项是否关联显示\"},{\"name\":\"dept_check_strictly\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"部门树选择项是否关联显示\"}],\"indexes\":[{\"fields\":[\"name\"]}],\"hooks\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2}],\"interceptors\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2}],\"annotations\":{\"Comment\":{\"Text\":\"角色表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"with_comments\":true}}},{\"name\":\"User\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"roles\",\"type\":\"Role\"},{\"name\":\"posts\",\"type\":\"Post\"}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id,omitempty\\\"\",\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"serial\"},\"comment\":\"id\"},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"创建时间\"},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"update_default\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"更新时间\"},{\"name\":\"status\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":1,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":3,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint(2)\",\"postgres\":\"tinyint(2)\"},\"comment\":\"状态：0=未知 1=启用 2=禁用\"},{\"name\":\"domain_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"domain_id,omitempty\\\"\",\"default\":true,\"default_kind\":19,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":true,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"bigint\",\"postgres\":\"bigint\"},\"comment\":\"域ID\"},{\"name\":\"version\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"position\":{\"Index\":5,\"MixedIn\":true,\"MixinIndex\":0},\"comment\":\"版本号，乐观锁\"},{\"name\":\"created_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"创建人ID\"},{\"name\":\"updated_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"更新人ID\"},{\"name\":\"deleted_by\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":1},\"comment\":\"删除人ID\"},{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2},\"comment\":\"删除时间\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":32,\"unique\":true,\"nillable\":true,\"validators\":3,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"用户名，唯一\"},{\"name\":\"password\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"nillable\":true,\"validators\":2,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"comment\":\"密码哈希\"},{\"name\":\"realname\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":50,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"用户真实姓名\"},{\"name\":\"nickname\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":50,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"用户昵称\"},{\"name\":\"email\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"unique\":true,\"nillable\":true,\"optional\":true,\"validators\":2,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"电子邮箱，唯一\"},{\"name\":\"phone\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":20,\"unique\":true,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"手机号码，唯一\"},{\"name\":\"avatar\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":255,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"头像URL\"},{\"name\":\"birthday\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"date\"},\"comment\":\"生日\"},{\"name\":\"gender\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":5,\"validators\":2,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"schema_type\":{\"mysql\":\"tinyint\",\"postgres\":\"tinyint(2)\"},\"comment\":\"性别：0=未知 1=男 2=女\"},{\"name\":\"age\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"validators\":2,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"年龄\"},{\"name\":\"last_login_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"最后登录时间\"},{\"name\":\"last_login_ip\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":50,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":11,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"最后登录IP\"},{\"name\":\"login_count\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"default\":true,\"default_value\":0,\"default_kind\":2,\"position\":{\"Index\":12,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"登录次数\"},{\"name\":\"settings\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"default\":true,\"default_value\":[],\"default_kind\":23,\"position\":{\"Index\":13,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"用户设置，JSON格式\"},{\"name\":\"metadata\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"default\":true,\"default_value\":[],\"default_kind\":23,\"position\":{\"Index\":14,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"元数据，JSON格式\"},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":255,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":15,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"个人说明\"},{\"name\":\"dept_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":16,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"所属部门ID\"}],\"indexes\":[{\"fields\":[\"name\"]},{\"fields\":[\"phone\"]},{\"fields\":[\"status\"]},{\"fields\":[\"email\"]},{\"fields\":[\"dept_id\"]}],\"hooks\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":1,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":2,\"MixedIn\":true,\"MixinIndex\":1},{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2}],\"interceptors\":[{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":2}],\"annotations\":{\"Comment\":{\"Text\":\"用户表\"},\"EntSQL\":{\"charset\":\"utf8mb4\",\"collation\":\"utf8mb4_bin\",\"with_comments\":true}}}],\"Features\":[\"sql/upsert\",\"sql/modifier\",\"sql/execquery\",\"intercept\",\"sql/lock\",\"namedges\",\"entql\",\"privacy\",\"schema/snapshot\"]}"
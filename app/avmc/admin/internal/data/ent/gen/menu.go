@@ -24,6 +24,14 @@ type Menu struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新时间
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 版本号，乐观锁
+	Version uint32 `json:"version,omitempty"`
+	// 创建人ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 更新人ID
+	UpdatedBy *uint32 `json:"updated_by,omitempty"`
+	// 删除人ID
+	DeletedBy *uint32 `json:"deleted_by,omitempty"`
 	// 删除时间
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// 菜单名称
@@ -127,7 +135,7 @@ func (*Menu) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case menu.FieldAffixTab, menu.FieldHideChildrenInMenu, menu.FieldHideInBreadcrumb, menu.FieldHideInMenu, menu.FieldHideInTab, menu.FieldKeepAlive, menu.FieldNoBasicLayout, menu.FieldOpenInNewWindow:
 			values[i] = new(sql.NullBool)
-		case menu.FieldID, menu.FieldStatus, menu.FieldType, menu.FieldParentID, menu.FieldAffixTabOrder, menu.FieldBadgeType, menu.FieldBadgeVariants, menu.FieldMaxNumOfOpenTab, menu.FieldSort:
+		case menu.FieldID, menu.FieldStatus, menu.FieldVersion, menu.FieldCreatedBy, menu.FieldUpdatedBy, menu.FieldDeletedBy, menu.FieldType, menu.FieldParentID, menu.FieldAffixTabOrder, menu.FieldBadgeType, menu.FieldBadgeVariants, menu.FieldMaxNumOfOpenTab, menu.FieldSort:
 			values[i] = new(sql.NullInt64)
 		case menu.FieldName, menu.FieldPath, menu.FieldComponent, menu.FieldRedirect, menu.FieldAuthCode, menu.FieldActiveIcon, menu.FieldActivePath, menu.FieldBadge, menu.FieldIcon, menu.FieldIframeSrc, menu.FieldLink, menu.FieldQuery, menu.FieldTitle:
 			values[i] = new(sql.NullString)
@@ -173,6 +181,33 @@ func (_m *Menu) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case menu.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = uint32(value.Int64)
+			}
+		case menu.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case menu.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(uint32)
+				*_m.UpdatedBy = uint32(value.Int64)
+			}
+		case menu.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = new(uint32)
+				*_m.DeletedBy = uint32(value.Int64)
+			}
 		case menu.FieldDeletedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
@@ -431,6 +466,24 @@ func (_m *Menu) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedBy; v != nil {
+		builder.WriteString("deleted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.DeletedAt; v != nil {
 		builder.WriteString("deleted_at=")
 		builder.WriteString(v.Format(time.ANSIC))
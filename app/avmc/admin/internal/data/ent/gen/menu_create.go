@@ -64,6 +64,62 @@ func (_c *MenuCreate) SetNillableUpdatedAt(v *time.Time) *MenuCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *MenuCreate) SetVersion(v uint32) *MenuCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *MenuCreate) SetNillableVersion(v *uint32) *MenuCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *MenuCreate) SetCreatedBy(v uint32) *MenuCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *MenuCreate) SetNillableCreatedBy(v *uint32) *MenuCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *MenuCreate) SetUpdatedBy(v uint32) *MenuCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *MenuCreate) SetNillableUpdatedBy(v *uint32) *MenuCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *MenuCreate) SetDeletedBy(v uint32) *MenuCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *MenuCreate) SetNillableDeletedBy(v *uint32) *MenuCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (_c *MenuCreate) SetDeletedAt(v time.Time) *MenuCreate {
 	_c.mutation.SetDeletedAt(v)
@@ -551,6 +607,10 @@ func (_c *MenuCreate) defaults() error {
 		v := menu.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := menu.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		v := menu.DefaultName
 		_c.mutation.SetName(v)
@@ -682,6 +742,9 @@ func (_c *MenuCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`gen: missing required field "Menu.updated_at"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`gen: missing required field "Menu.version"`)}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`gen: missing required field "Menu.name"`)}
 	}
@@ -813,6 +876,22 @@ func (_c *MenuCreate) createSpec() (*Menu, *sqlgraph.CreateSpec) {
 		_spec.SetField(menu.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(menu.FieldVersion, field.TypeUint32, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(menu.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(menu.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(menu.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
 	if value, ok := _c.mutation.DeletedAt(); ok {
 		_spec.SetField(menu.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
@@ -1040,6 +1119,72 @@ func (u *MenuUpsert) UpdateUpdatedAt() *MenuUpsert {
 	return u
 }
 
+// SetVersion sets the "version" field.
+func (u *MenuUpsert) SetVersion(v uint32) *MenuUpsert {
+	u.Set(menu.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *MenuUpsert) UpdateVersion() *MenuUpsert {
+	u.SetExcluded(menu.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *MenuUpsert) AddVersion(v uint32) *MenuUpsert {
+	u.Add(menu.FieldVersion, v)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *MenuUpsert) SetUpdatedBy(v uint32) *MenuUpsert {
+	u.Set(menu.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *MenuUpsert) UpdateUpdatedBy() *MenuUpsert {
+	u.SetExcluded(menu.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *MenuUpsert) AddUpdatedBy(v uint32) *MenuUpsert {
+	u.Add(menu.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *MenuUpsert) ClearUpdatedBy() *MenuUpsert {
+	u.SetNull(menu.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *MenuUpsert) SetDeletedBy(v uint32) *MenuUpsert {
+	u.Set(menu.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *MenuUpsert) UpdateDeletedBy() *MenuUpsert {
+	u.SetExcluded(menu.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *MenuUpsert) AddDeletedBy(v uint32) *MenuUpsert {
+	u.Add(menu.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *MenuUpsert) ClearDeletedBy() *MenuUpsert {
+	u.SetNull(menu.FieldDeletedBy)
+	return u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *MenuUpsert) SetDeletedAt(v time.Time) *MenuUpsert {
 	u.Set(menu.FieldDeletedAt, v)
@@ -1486,6 +1631,9 @@ func (u *MenuUpsertOne) UpdateNewValues() *MenuUpsertOne {
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(menu.FieldCreatedAt)
 		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(menu.FieldCreatedBy)
+		}
 	}))
 	return u
 }
@@ -1552,6 +1700,83 @@ func (u *MenuUpsertOne) UpdateUpdatedAt() *MenuUpsertOne {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *MenuUpsertOne) SetVersion(v uint32) *MenuUpsertOne {
+	return u.Update(func(s *MenuUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *MenuUpsertOne) AddVersion(v uint32) *MenuUpsertOne {
+	return u.Update(func(s *MenuUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *MenuUpsertOne) UpdateVersion() *MenuUpsertOne {
+	return u.Update(func(s *MenuUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *MenuUpsertOne) SetUpdatedBy(v uint32) *MenuUpsertOne {
+	return u.Update(func(s *MenuUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *MenuUpsertOne) AddUpdatedBy(v uint32) *MenuUpsertOne {
+	return u.Update(func(s *MenuUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *MenuUpsertOne) UpdateUpdatedBy() *MenuUpsertOne {
+	return u.Update(func(s *MenuUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *MenuUpsertOne) ClearUpdatedBy() *MenuUpsertOne {
+	return u.Update(func(s *MenuUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *MenuUpsertOne) SetDeletedBy(v uint32) *MenuUpsertOne {
+	return u.Update(func(s *MenuUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *MenuUpsertOne) AddDeletedBy(v uint32) *MenuUpsertOne {
+	return u.Update(func(s *MenuUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *MenuUpsertOne) UpdateDeletedBy() *MenuUpsertOne {
+	return u.Update(func(s *MenuUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *MenuUpsertOne) ClearDeletedBy() *MenuUpsertOne {
+	return u.Update(func(s *MenuUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *MenuUpsertOne) SetDeletedAt(v time.Time) *MenuUpsertOne {
 	return u.Update(func(s *MenuUpsert) {
@@ -2234,6 +2459,9 @@ func (u *MenuUpsertBulk) UpdateNewValues() *MenuUpsertBulk {
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(menu.FieldCreatedAt)
 			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(menu.FieldCreatedBy)
+			}
 		}
 	}))
 	return u
@@ -2301,6 +2529,83 @@ func (u *MenuUpsertBulk) UpdateUpdatedAt() *MenuUpsertBulk {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *MenuUpsertBulk) SetVersion(v uint32) *MenuUpsertBulk {
+	return u.Update(func(s *MenuUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *MenuUpsertBulk) AddVersion(v uint32) *MenuUpsertBulk {
+	return u.Update(func(s *MenuUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *MenuUpsertBulk) UpdateVersion() *MenuUpsertBulk {
+	return u.Update(func(s *MenuUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *MenuUpsertBulk) SetUpdatedBy(v uint32) *MenuUpsertBulk {
+	return u.Update(func(s *MenuUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *MenuUpsertBulk) AddUpdatedBy(v uint32) *MenuUpsertBulk {
+	return u.Update(func(s *MenuUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *MenuUpsertBulk) UpdateUpdatedBy() *MenuUpsertBulk {
+	return u.Update(func(s *MenuUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *MenuUpsertBulk) ClearUpdatedBy() *MenuUpsertBulk {
+	return u.Update(func(s *MenuUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *MenuUpsertBulk) SetDeletedBy(v uint32) *MenuUpsertBulk {
+	return u.Update(func(s *MenuUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *MenuUpsertBulk) AddDeletedBy(v uint32) *MenuUpsertBulk {
+	return u.Update(func(s *MenuUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *MenuUpsertBulk) UpdateDeletedBy() *MenuUpsertBulk {
+	return u.Update(func(s *MenuUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *MenuUpsertBulk) ClearDeletedBy() *MenuUpsertBulk {
+	return u.Update(func(s *MenuUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *MenuUpsertBulk) SetDeletedAt(v time.Time) *MenuUpsertBulk {
 	return u.Update(func(s *MenuUpsert) {
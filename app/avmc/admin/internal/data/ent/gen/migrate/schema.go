@@ -9,6 +9,31 @@ import (
 )
 
 var (
+	// CasbinRuleColumns holds the columns for the "casbin_rule" table.
+	CasbinRuleColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "id"},
+		{Name: "ptype", Type: field.TypeString, Size: 100, Comment: "策略类型", Default: ""},
+		{Name: "v0", Type: field.TypeString, Size: 100, Comment: "策略字段0", Default: ""},
+		{Name: "v1", Type: field.TypeString, Size: 100, Comment: "策略字段1", Default: ""},
+		{Name: "v2", Type: field.TypeString, Size: 100, Comment: "策略字段2", Default: ""},
+		{Name: "v3", Type: field.TypeString, Size: 100, Comment: "策略字段3", Default: ""},
+		{Name: "v4", Type: field.TypeString, Size: 100, Comment: "策略字段4", Default: ""},
+		{Name: "v5", Type: field.TypeString, Size: 100, Comment: "策略字段5", Default: ""},
+	}
+	// CasbinRuleTable holds the schema information for the "casbin_rule" table.
+	CasbinRuleTable = &schema.Table{
+		Name:       "casbin_rule",
+		Comment:    "权限策略表",
+		Columns:    CasbinRuleColumns,
+		PrimaryKey: []*schema.Column{CasbinRuleColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_casbin_rule",
+				Unique:  true,
+				Columns: []*schema.Column{CasbinRuleColumns[1], CasbinRuleColumns[2], CasbinRuleColumns[3], CasbinRuleColumns[4], CasbinRuleColumns[5], CasbinRuleColumns[6], CasbinRuleColumns[7]},
+			},
+		},
+	}
 	// DeptsColumns holds the columns for the "depts" table.
 	DeptsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id", SchemaType: map[string]string{"mysql": "bigint", "postgres": "serial"}},
@@ -16,6 +41,10 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
 		{Name: "status", Type: field.TypeInt32, Comment: "状态：0=未知 1=启用 2=禁用", Default: 1, SchemaType: map[string]string{"mysql": "tinyint(2)", "postgres": "tinyint(2)"}},
 		{Name: "domain_id", Type: field.TypeUint32, Comment: "域ID", SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint"}},
+		{Name: "version", Type: field.TypeUint32, Comment: "版本号，乐观锁", Default: 1},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建人ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新人ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除人ID"},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "name", Type: field.TypeString, Size: 128, Comment: "名称"},
 		{Name: "ancestors", Type: field.TypeJSON, Nullable: true, Comment: "祖级列表"},
@@ -30,18 +59,75 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "depts_depts_children",
-				Columns:    []*schema.Column{DeptsColumns[8]},
+				Columns:    []*schema.Column{DeptsColumns[12]},
 				RefColumns: []*schema.Column{DeptsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// EntityHistoryColumns holds the columns for the "entity_history" table.
+	EntityHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "resource", Type: field.TypeString, Size: 32, Comment: "资源名称"},
+		{Name: "entity_id", Type: field.TypeUint32, Comment: "实体ID"},
+		{Name: "action", Type: field.TypeString, Size: 16, Comment: "操作：create/update/delete/restore/purge"},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true, Comment: "字段变更，敏感字段以掩码代替"},
+		{Name: "snapshot", Type: field.TypeJSON, Nullable: true, Comment: "变更后的数据快照，彻底删除时为空"},
+		{Name: "operator_id", Type: field.TypeUint32, Nullable: true, Comment: "操作人ID"},
+	}
+	// EntityHistoryTable holds the schema information for the "entity_history" table.
+	EntityHistoryTable = &schema.Table{
+		Name:       "entity_history",
+		Comment:    "实体变更历史表",
+		Columns:    EntityHistoryColumns,
+		PrimaryKey: []*schema.Column{EntityHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_entity_history",
+				Unique:  false,
+				Columns: []*schema.Column{EntityHistoryColumns[2], EntityHistoryColumns[3], EntityHistoryColumns[1]},
+			},
+		},
+	}
+	// ExportFileColumns holds the columns for the "export_file" table.
+	ExportFileColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "job_id", Type: field.TypeString, Size: 32, Comment: "导出任务ID"},
+		{Name: "seq", Type: field.TypeInt, Comment: "分块序号，从0开始"},
+		{Name: "data", Type: field.TypeBytes, Size: 1048576, Comment: "分块内容"},
+		{Name: "size", Type: field.TypeInt, Comment: "分块大小"},
+	}
+	// ExportFileTable holds the schema information for the "export_file" table.
+	ExportFileTable = &schema.Table{
+		Name:       "export_file",
+		Comment:    "导出文件表",
+		Columns:    ExportFileColumns,
+		PrimaryKey: []*schema.Column{ExportFileColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_export_file",
+				Unique:  true,
+				Columns: []*schema.Column{ExportFileColumns[2], ExportFileColumns[3]},
+			},
+			{
+				Name:    "idx_export_file_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExportFileColumns[1]},
+			},
+		},
+	}
 	// MenusColumns holds the columns for the "menus" table.
 	MenusColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id", SchemaType: map[string]string{"mysql": "bigint", "postgres": "serial"}},
 		{Name: "status", Type: field.TypeInt32, Comment: "状态：0=未知 1=启用 2=禁用", Default: 1, SchemaType: map[string]string{"mysql": "tinyint(2)", "postgres": "tinyint(2)"}},
 		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "version", Type: field.TypeUint32, Comment: "版本号，乐观锁", Default: 1},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建人ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新人ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除人ID"},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "name", Type: field.TypeString, Size: 32, Comment: "菜单名称", Default: ""},
 		{Name: "path", Type: field.TypeString, Nullable: true, Comment: "路径,当其类型为'按钮'的时候对应的数据操作名,例如:/user.service.v1.UserService/Login", Default: ""},
@@ -81,7 +167,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "menus_menus_children",
-				Columns:    []*schema.Column{MenusColumns[32]},
+				Columns:    []*schema.Column{MenusColumns[36]},
 				RefColumns: []*schema.Column{MenusColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -95,7 +181,7 @@ var (
 			{
 				Name:    "menu_name",
 				Unique:  false,
-				Columns: []*schema.Column{MenusColumns[5]},
+				Columns: []*schema.Column{MenusColumns[9]},
 			},
 			{
 				Name:    "menu_status",
@@ -105,7 +191,7 @@ var (
 			{
 				Name:    "menu_parent_id",
 				Unique:  false,
-				Columns: []*schema.Column{MenusColumns[32]},
+				Columns: []*schema.Column{MenusColumns[36]},
 			},
 		},
 	}
@@ -116,6 +202,10 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
 		{Name: "status", Type: field.TypeInt32, Comment: "状态：0=未知 1=启用 2=禁用", Default: 1, SchemaType: map[string]string{"mysql": "tinyint(2)", "postgres": "tinyint(2)"}},
 		{Name: "domain_id", Type: field.TypeUint32, Comment: "域ID", SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint"}},
+		{Name: "version", Type: field.TypeUint32, Comment: "版本号，乐观锁", Default: 1},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建人ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新人ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除人ID"},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "name", Type: field.TypeString, Size: 50, Comment: "名称", Default: ""},
 		{Name: "user_posts", Type: field.TypeUint32, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "serial"}},
@@ -129,12 +219,41 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "posts_users_posts",
-				Columns:    []*schema.Column{PostsColumns[7]},
+				Columns:    []*schema.Column{PostsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// RelationTupleColumns holds the columns for the "relation_tuple" table.
+	RelationTupleColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true, Comment: "id"},
+		{Name: "object_namespace", Type: field.TypeString, Size: 64, Comment: "对象命名空间"},
+		{Name: "object_id", Type: field.TypeString, Size: 128, Comment: "对象ID"},
+		{Name: "relation", Type: field.TypeString, Size: 64, Comment: "关系"},
+		{Name: "subject_namespace", Type: field.TypeString, Size: 64, Comment: "主体命名空间"},
+		{Name: "subject_id", Type: field.TypeString, Size: 128, Comment: "主体ID"},
+		{Name: "subject_relation", Type: field.TypeString, Size: 64, Comment: "主体关系，为空表示直接主体", Default: ""},
+	}
+	// RelationTupleTable holds the schema information for the "relation_tuple" table.
+	RelationTupleTable = &schema.Table{
+		Name:       "relation_tuple",
+		Comment:    "关系元组表",
+		Columns:    RelationTupleColumns,
+		PrimaryKey: []*schema.Column{RelationTupleColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_relation_tuple",
+				Unique:  true,
+				Columns: []*schema.Column{RelationTupleColumns[1], RelationTupleColumns[2], RelationTupleColumns[3], RelationTupleColumns[4], RelationTupleColumns[5], RelationTupleColumns[6]},
+			},
+			{
+				Name:    "idx_relation_tuple_subject",
+				Unique:  false,
+				Columns: []*schema.Column{RelationTupleColumns[4], RelationTupleColumns[5], RelationTupleColumns[6]},
+			},
+		},
+	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id", SchemaType: map[string]string{"mysql": "bigint", "postgres": "serial"}},
@@ -142,6 +261,10 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
 		{Name: "status", Type: field.TypeInt32, Comment: "状态：0=未知 1=启用 2=禁用", Default: 1, SchemaType: map[string]string{"mysql": "tinyint(2)", "postgres": "tinyint(2)"}},
 		{Name: "domain_id", Type: field.TypeUint32, Comment: "域ID", SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint"}},
+		{Name: "version", Type: field.TypeUint32, Comment: "版本号，乐观锁", Default: 1},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建人ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新人ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除人ID"},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "name", Type: field.TypeString, Size: 50, Comment: "名称", Default: ""},
 		{Name: "default_router", Type: field.TypeString, Size: 255, Comment: "默认路由", Default: ""},
@@ -159,7 +282,7 @@ var (
 			{
 				Name:    "role_name",
 				Unique:  false,
-				Columns: []*schema.Column{RolesColumns[6]},
+				Columns: []*schema.Column{RolesColumns[10]},
 			},
 		},
 	}
@@ -170,6 +293,10 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
 		{Name: "status", Type: field.TypeInt32, Comment: "状态：0=未知 1=启用 2=禁用", Default: 1, SchemaType: map[string]string{"mysql": "tinyint(2)", "postgres": "tinyint(2)"}},
 		{Name: "domain_id", Type: field.TypeUint32, Comment: "域ID", SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint"}},
+		{Name: "version", Type: field.TypeUint32, Comment: "版本号，乐观锁", Default: 1},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建人ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新人ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除人ID"},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "name", Type: field.TypeString, Unique: true, Size: 32, Comment: "用户名，唯一"},
 		{Name: "password", Type: field.TypeString, Size: 100, Comment: "密码哈希"},
@@ -187,6 +314,7 @@ var (
 		{Name: "settings", Type: field.TypeJSON, Nullable: true, Comment: "用户设置，JSON格式"},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, Comment: "元数据，JSON格式"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255, Comment: "个人说明"},
+		{Name: "dept_id", Type: field.TypeUint32, Nullable: true, Comment: "所属部门ID"},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -198,12 +326,12 @@ var (
 			{
 				Name:    "user_name",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[6]},
+				Columns: []*schema.Column{UsersColumns[10]},
 			},
 			{
 				Name:    "user_phone",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[11]},
+				Columns: []*schema.Column{UsersColumns[15]},
 			},
 			{
 				Name:    "user_status",
@@ -213,7 +341,12 @@ var (
 			{
 				Name:    "user_email",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[10]},
+				Columns: []*schema.Column{UsersColumns[14]},
+			},
+			{
+				Name:    "user_dept_id",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[26]},
 			},
 		},
 	}
@@ -244,9 +377,13 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		CasbinRuleTable,
 		DeptsTable,
+		EntityHistoryTable,
+		ExportFileTable,
 		MenusTable,
 		PostsTable,
+		RelationTupleTable,
 		RolesTable,
 		UsersTable,
 		UserRolesTable,
@@ -254,11 +391,26 @@ var (
 )
 
 func init() {
+	CasbinRuleTable.Annotation = &entsql.Annotation{
+		Table:     "casbin_rule",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	DeptsTable.ForeignKeys[0].RefTable = DeptsTable
 	DeptsTable.Annotation = &entsql.Annotation{
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	EntityHistoryTable.Annotation = &entsql.Annotation{
+		Table:     "entity_history",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	ExportFileTable.Annotation = &entsql.Annotation{
+		Table:     "export_file",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	MenusTable.ForeignKeys[0].RefTable = MenusTable
 	MenusTable.Annotation = &entsql.Annotation{
 		Charset:   "utf8mb4",
@@ -269,6 +421,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	RelationTupleTable.Annotation = &entsql.Annotation{
+		Table:     "relation_tuple",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	RolesTable.Annotation = &entsql.Annotation{
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
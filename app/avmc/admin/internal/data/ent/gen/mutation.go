@@ -3,12 +3,17 @@
 package gen
 
 import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/casbinrule"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/dept"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/entityhistory"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/exportfile"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/menu"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/post"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/relationtuple"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/role"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
+	"backend-service/pkg/entgo"
 	"context"
 	"errors"
 	"fmt"
@@ -28,51 +33,47 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeDept = "Dept"
-	TypeMenu = "Menu"
-	TypePost = "Post"
-	TypeRole = "Role"
-	TypeUser = "User"
+	TypeCasbinRule    = "CasbinRule"
+	TypeDept          = "Dept"
+	TypeEntityHistory = "EntityHistory"
+	TypeExportFile    = "ExportFile"
+	TypeMenu          = "Menu"
+	TypePost          = "Post"
+	TypeRelationTuple = "RelationTuple"
+	TypeRole          = "Role"
+	TypeUser          = "User"
 )
 
-// DeptMutation represents an operation that mutates the Dept nodes in the graph.
-type DeptMutation struct {
+// CasbinRuleMutation represents an operation that mutates the CasbinRule nodes in the graph.
+type CasbinRuleMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uint32
-	created_at      *time.Time
-	updated_at      *time.Time
-	status          *int32
-	addstatus       *int32
-	domain_id       *uint32
-	adddomain_id    *int32
-	deleted_at      *time.Time
-	name            *string
-	ancestors       *[]int
-	appendancestors []int
-	clearedFields   map[string]struct{}
-	parent          *uint32
-	clearedparent   bool
-	children        map[uint32]struct{}
-	removedchildren map[uint32]struct{}
-	clearedchildren bool
-	done            bool
-	oldValue        func(context.Context) (*Dept, error)
-	predicates      []predicate.Dept
+	op            Op
+	typ           string
+	id            *uint64
+	ptype         *string
+	v0            *string
+	v1            *string
+	v2            *string
+	v3            *string
+	v4            *string
+	v5            *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*CasbinRule, error)
+	predicates    []predicate.CasbinRule
 }
 
-var _ ent.Mutation = (*DeptMutation)(nil)
+var _ ent.Mutation = (*CasbinRuleMutation)(nil)
 
-// deptOption allows management of the mutation configuration using functional options.
-type deptOption func(*DeptMutation)
+// casbinruleOption allows management of the mutation configuration using functional options.
+type casbinruleOption func(*CasbinRuleMutation)
 
-// newDeptMutation creates new mutation for the Dept entity.
-func newDeptMutation(c config, op Op, opts ...deptOption) *DeptMutation {
-	m := &DeptMutation{
+// newCasbinRuleMutation creates new mutation for the CasbinRule entity.
+func newCasbinRuleMutation(c config, op Op, opts ...casbinruleOption) *CasbinRuleMutation {
+	m := &CasbinRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDept,
+		typ:           TypeCasbinRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -81,20 +82,20 @@ func newDeptMutation(c config, op Op, opts ...deptOption) *DeptMutation {
 	return m
 }
 
-// withDeptID sets the ID field of the mutation.
-func withDeptID(id uint32) deptOption {
-	return func(m *DeptMutation) {
+// withCasbinRuleID sets the ID field of the mutation.
+func withCasbinRuleID(id uint64) casbinruleOption {
+	return func(m *CasbinRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Dept
+			value *CasbinRule
 		)
-		m.oldValue = func(ctx context.Context) (*Dept, error) {
+		m.oldValue = func(ctx context.Context) (*CasbinRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Dept.Get(ctx, id)
+					value, err = m.Client().CasbinRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -103,10 +104,10 @@ func withDeptID(id uint32) deptOption {
 	}
 }
 
-// withDept sets the old Dept of the mutation.
-func withDept(node *Dept) deptOption {
-	return func(m *DeptMutation) {
-		m.oldValue = func(context.Context) (*Dept, error) {
+// withCasbinRule sets the old CasbinRule of the mutation.
+func withCasbinRule(node *CasbinRule) casbinruleOption {
+	return func(m *CasbinRuleMutation) {
+		m.oldValue = func(context.Context) (*CasbinRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -115,7 +116,7 @@ func withDept(node *Dept) deptOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeptMutation) Client() *Client {
+func (m CasbinRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -123,7 +124,7 @@ func (m DeptMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeptMutation) Tx() (*Tx, error) {
+func (m CasbinRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("gen: mutation is not running in a transaction")
 	}
@@ -133,14 +134,14 @@ func (m DeptMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Dept entities.
-func (m *DeptMutation) SetID(id uint32) {
+// operation is only accepted on creation of CasbinRule entities.
+func (m *CasbinRuleMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeptMutation) ID() (id uint32, exists bool) {
+func (m *CasbinRuleMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -151,543 +152,328 @@ func (m *DeptMutation) ID() (id uint32, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeptMutation) IDs(ctx context.Context) ([]uint32, error) {
+func (m *CasbinRuleMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uint32{id}, nil
+			return []uint64{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Dept.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CasbinRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DeptMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetPtype sets the "ptype" field.
+func (m *CasbinRuleMutation) SetPtype(s string) {
+	m.ptype = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DeptMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Ptype returns the value of the "ptype" field in the mutation.
+func (m *CasbinRuleMutation) Ptype() (r string, exists bool) {
+	v := m.ptype
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Dept entity.
-// If the Dept object wasn't provided to the builder, the object is fetched from the database.
+// OldPtype returns the old "ptype" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CasbinRuleMutation) OldPtype(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPtype is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPtype requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPtype: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Ptype, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DeptMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetPtype resets all changes to the "ptype" field.
+func (m *CasbinRuleMutation) ResetPtype() {
+	m.ptype = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DeptMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetV0 sets the "v0" field.
+func (m *CasbinRuleMutation) SetV0(s string) {
+	m.v0 = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DeptMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// V0 returns the value of the "v0" field in the mutation.
+func (m *CasbinRuleMutation) V0() (r string, exists bool) {
+	v := m.v0
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Dept entity.
-// If the Dept object wasn't provided to the builder, the object is fetched from the database.
+// OldV0 returns the old "v0" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeptMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CasbinRuleMutation) OldV0(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldV0 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldV0 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldV0: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.V0, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DeptMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetV0 resets all changes to the "v0" field.
+func (m *CasbinRuleMutation) ResetV0() {
+	m.v0 = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *DeptMutation) SetStatus(i int32) {
-	m.status = &i
-	m.addstatus = nil
+// SetV1 sets the "v1" field.
+func (m *CasbinRuleMutation) SetV1(s string) {
+	m.v1 = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *DeptMutation) Status() (r int32, exists bool) {
-	v := m.status
+// V1 returns the value of the "v1" field in the mutation.
+func (m *CasbinRuleMutation) V1() (r string, exists bool) {
+	v := m.v1
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Dept entity.
-// If the Dept object wasn't provided to the builder, the object is fetched from the database.
+// OldV1 returns the old "v1" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeptMutation) OldStatus(ctx context.Context) (v *int32, err error) {
+func (m *CasbinRuleMutation) OldV1(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldV1 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldV1 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// AddStatus adds i to the "status" field.
-func (m *DeptMutation) AddStatus(i int32) {
-	if m.addstatus != nil {
-		*m.addstatus += i
-	} else {
-		m.addstatus = &i
-	}
-}
-
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *DeptMutation) AddedStatus() (r int32, exists bool) {
-	v := m.addstatus
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldV1: %w", err)
 	}
-	return *v, true
+	return oldValue.V1, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *DeptMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
+// ResetV1 resets all changes to the "v1" field.
+func (m *CasbinRuleMutation) ResetV1() {
+	m.v1 = nil
 }
 
-// SetDomainID sets the "domain_id" field.
-func (m *DeptMutation) SetDomainID(u uint32) {
-	m.domain_id = &u
-	m.adddomain_id = nil
+// SetV2 sets the "v2" field.
+func (m *CasbinRuleMutation) SetV2(s string) {
+	m.v2 = &s
 }
 
-// DomainID returns the value of the "domain_id" field in the mutation.
-func (m *DeptMutation) DomainID() (r uint32, exists bool) {
-	v := m.domain_id
+// V2 returns the value of the "v2" field in the mutation.
+func (m *CasbinRuleMutation) V2() (r string, exists bool) {
+	v := m.v2
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDomainID returns the old "domain_id" field's value of the Dept entity.
-// If the Dept object wasn't provided to the builder, the object is fetched from the database.
+// OldV2 returns the old "v2" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeptMutation) OldDomainID(ctx context.Context) (v uint32, err error) {
+func (m *CasbinRuleMutation) OldV2(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDomainID is only allowed on UpdateOne operations")
+		return v, errors.New("OldV2 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDomainID requires an ID field in the mutation")
+		return v, errors.New("OldV2 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDomainID: %w", err)
-	}
-	return oldValue.DomainID, nil
-}
-
-// AddDomainID adds u to the "domain_id" field.
-func (m *DeptMutation) AddDomainID(u int32) {
-	if m.adddomain_id != nil {
-		*m.adddomain_id += u
-	} else {
-		m.adddomain_id = &u
+		return v, fmt.Errorf("querying old value for OldV2: %w", err)
 	}
+	return oldValue.V2, nil
 }
 
-// AddedDomainID returns the value that was added to the "domain_id" field in this mutation.
-func (m *DeptMutation) AddedDomainID() (r int32, exists bool) {
-	v := m.adddomain_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetDomainID resets all changes to the "domain_id" field.
-func (m *DeptMutation) ResetDomainID() {
-	m.domain_id = nil
-	m.adddomain_id = nil
+// ResetV2 resets all changes to the "v2" field.
+func (m *CasbinRuleMutation) ResetV2() {
+	m.v2 = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *DeptMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetV3 sets the "v3" field.
+func (m *CasbinRuleMutation) SetV3(s string) {
+	m.v3 = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *DeptMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// V3 returns the value of the "v3" field in the mutation.
+func (m *CasbinRuleMutation) V3() (r string, exists bool) {
+	v := m.v3
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Dept entity.
-// If the Dept object wasn't provided to the builder, the object is fetched from the database.
+// OldV3 returns the old "v3" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeptMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *CasbinRuleMutation) OldV3(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldV3 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldV3 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldV3: %w", err)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *DeptMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[dept.FieldDeletedAt] = struct{}{}
+	return oldValue.V3, nil
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *DeptMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[dept.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *DeptMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, dept.FieldDeletedAt)
+// ResetV3 resets all changes to the "v3" field.
+func (m *CasbinRuleMutation) ResetV3() {
+	m.v3 = nil
 }
 
-// SetName sets the "name" field.
-func (m *DeptMutation) SetName(s string) {
-	m.name = &s
+// SetV4 sets the "v4" field.
+func (m *CasbinRuleMutation) SetV4(s string) {
+	m.v4 = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DeptMutation) Name() (r string, exists bool) {
-	v := m.name
+// V4 returns the value of the "v4" field in the mutation.
+func (m *CasbinRuleMutation) V4() (r string, exists bool) {
+	v := m.v4
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Dept entity.
-// If the Dept object wasn't provided to the builder, the object is fetched from the database.
+// OldV4 returns the old "v4" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeptMutation) OldName(ctx context.Context) (v *string, err error) {
+func (m *CasbinRuleMutation) OldV4(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldV4 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldV4 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldV4: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.V4, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *DeptMutation) ResetName() {
-	m.name = nil
+// ResetV4 resets all changes to the "v4" field.
+func (m *CasbinRuleMutation) ResetV4() {
+	m.v4 = nil
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *DeptMutation) SetParentID(u uint32) {
-	m.parent = &u
+// SetV5 sets the "v5" field.
+func (m *CasbinRuleMutation) SetV5(s string) {
+	m.v5 = &s
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *DeptMutation) ParentID() (r uint32, exists bool) {
-	v := m.parent
+// V5 returns the value of the "v5" field in the mutation.
+func (m *CasbinRuleMutation) V5() (r string, exists bool) {
+	v := m.v5
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Dept entity.
-// If the Dept object wasn't provided to the builder, the object is fetched from the database.
+// OldV5 returns the old "v5" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeptMutation) OldParentID(ctx context.Context) (v *uint32, err error) {
+func (m *CasbinRuleMutation) OldV5(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldV5 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldV5 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldV5: %w", err)
 	}
-	return oldValue.ParentID, nil
+	return oldValue.V5, nil
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (m *DeptMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[dept.FieldParentID] = struct{}{}
+// ResetV5 resets all changes to the "v5" field.
+func (m *CasbinRuleMutation) ResetV5() {
+	m.v5 = nil
 }
 
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *DeptMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[dept.FieldParentID]
-	return ok
+// Where appends a list predicates to the CasbinRuleMutation builder.
+func (m *CasbinRuleMutation) Where(ps ...predicate.CasbinRule) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *DeptMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, dept.FieldParentID)
+// WhereP appends storage-level predicates to the CasbinRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CasbinRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CasbinRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetAncestors sets the "ancestors" field.
-func (m *DeptMutation) SetAncestors(i []int) {
-	m.ancestors = &i
-	m.appendancestors = nil
+// Op returns the operation name.
+func (m *CasbinRuleMutation) Op() Op {
+	return m.op
 }
 
-// Ancestors returns the value of the "ancestors" field in the mutation.
-func (m *DeptMutation) Ancestors() (r []int, exists bool) {
-	v := m.ancestors
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *CasbinRuleMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldAncestors returns the old "ancestors" field's value of the Dept entity.
-// If the Dept object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeptMutation) OldAncestors(ctx context.Context) (v []int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAncestors is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAncestors requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAncestors: %w", err)
-	}
-	return oldValue.Ancestors, nil
-}
-
-// AppendAncestors adds i to the "ancestors" field.
-func (m *DeptMutation) AppendAncestors(i []int) {
-	m.appendancestors = append(m.appendancestors, i...)
-}
-
-// AppendedAncestors returns the list of values that were appended to the "ancestors" field in this mutation.
-func (m *DeptMutation) AppendedAncestors() ([]int, bool) {
-	if len(m.appendancestors) == 0 {
-		return nil, false
-	}
-	return m.appendancestors, true
-}
-
-// ClearAncestors clears the value of the "ancestors" field.
-func (m *DeptMutation) ClearAncestors() {
-	m.ancestors = nil
-	m.appendancestors = nil
-	m.clearedFields[dept.FieldAncestors] = struct{}{}
-}
-
-// AncestorsCleared returns if the "ancestors" field was cleared in this mutation.
-func (m *DeptMutation) AncestorsCleared() bool {
-	_, ok := m.clearedFields[dept.FieldAncestors]
-	return ok
-}
-
-// ResetAncestors resets all changes to the "ancestors" field.
-func (m *DeptMutation) ResetAncestors() {
-	m.ancestors = nil
-	m.appendancestors = nil
-	delete(m.clearedFields, dept.FieldAncestors)
-}
-
-// ClearParent clears the "parent" edge to the Dept entity.
-func (m *DeptMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[dept.FieldParentID] = struct{}{}
-}
-
-// ParentCleared reports if the "parent" edge to the Dept entity was cleared.
-func (m *DeptMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
-}
-
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *DeptMutation) ParentIDs() (ids []uint32) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetParent resets all changes to the "parent" edge.
-func (m *DeptMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
-}
-
-// AddChildIDs adds the "children" edge to the Dept entity by ids.
-func (m *DeptMutation) AddChildIDs(ids ...uint32) {
-	if m.children == nil {
-		m.children = make(map[uint32]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
-	}
-}
-
-// ClearChildren clears the "children" edge to the Dept entity.
-func (m *DeptMutation) ClearChildren() {
-	m.clearedchildren = true
-}
-
-// ChildrenCleared reports if the "children" edge to the Dept entity was cleared.
-func (m *DeptMutation) ChildrenCleared() bool {
-	return m.clearedchildren
-}
-
-// RemoveChildIDs removes the "children" edge to the Dept entity by IDs.
-func (m *DeptMutation) RemoveChildIDs(ids ...uint32) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uint32]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedChildren returns the removed IDs of the "children" edge to the Dept entity.
-func (m *DeptMutation) RemovedChildrenIDs() (ids []uint32) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *DeptMutation) ChildrenIDs() (ids []uint32) {
-	for id := range m.children {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetChildren resets all changes to the "children" edge.
-func (m *DeptMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
-}
-
-// Where appends a list predicates to the DeptMutation builder.
-func (m *DeptMutation) Where(ps ...predicate.Dept) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the DeptMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeptMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Dept, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *DeptMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *DeptMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Dept).
-func (m *DeptMutation) Type() string {
+// Type returns the node type of this mutation (CasbinRule).
+func (m *CasbinRuleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeptMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.created_at != nil {
-		fields = append(fields, dept.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, dept.FieldUpdatedAt)
+func (m *CasbinRuleMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.ptype != nil {
+		fields = append(fields, casbinrule.FieldPtype)
 	}
-	if m.status != nil {
-		fields = append(fields, dept.FieldStatus)
+	if m.v0 != nil {
+		fields = append(fields, casbinrule.FieldV0)
 	}
-	if m.domain_id != nil {
-		fields = append(fields, dept.FieldDomainID)
+	if m.v1 != nil {
+		fields = append(fields, casbinrule.FieldV1)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, dept.FieldDeletedAt)
+	if m.v2 != nil {
+		fields = append(fields, casbinrule.FieldV2)
 	}
-	if m.name != nil {
-		fields = append(fields, dept.FieldName)
+	if m.v3 != nil {
+		fields = append(fields, casbinrule.FieldV3)
 	}
-	if m.parent != nil {
-		fields = append(fields, dept.FieldParentID)
+	if m.v4 != nil {
+		fields = append(fields, casbinrule.FieldV4)
 	}
-	if m.ancestors != nil {
-		fields = append(fields, dept.FieldAncestors)
+	if m.v5 != nil {
+		fields = append(fields, casbinrule.FieldV5)
 	}
 	return fields
 }
@@ -695,24 +481,22 @@ func (m *DeptMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeptMutation) Field(name string) (ent.Value, bool) {
+func (m *CasbinRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case dept.FieldCreatedAt:
-		return m.CreatedAt()
-	case dept.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case dept.FieldStatus:
-		return m.Status()
-	case dept.FieldDomainID:
-		return m.DomainID()
-	case dept.FieldDeletedAt:
-		return m.DeletedAt()
-	case dept.FieldName:
-		return m.Name()
-	case dept.FieldParentID:
-		return m.ParentID()
-	case dept.FieldAncestors:
-		return m.Ancestors()
+	case casbinrule.FieldPtype:
+		return m.Ptype()
+	case casbinrule.FieldV0:
+		return m.V0()
+	case casbinrule.FieldV1:
+		return m.V1()
+	case casbinrule.FieldV2:
+		return m.V2()
+	case casbinrule.FieldV3:
+		return m.V3()
+	case casbinrule.FieldV4:
+		return m.V4()
+	case casbinrule.FieldV5:
+		return m.V5()
 	}
 	return nil, false
 }
@@ -720,382 +504,248 @@ func (m *DeptMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CasbinRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case dept.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case dept.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case dept.FieldStatus:
-		return m.OldStatus(ctx)
-	case dept.FieldDomainID:
-		return m.OldDomainID(ctx)
-	case dept.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case dept.FieldName:
-		return m.OldName(ctx)
-	case dept.FieldParentID:
-		return m.OldParentID(ctx)
-	case dept.FieldAncestors:
-		return m.OldAncestors(ctx)
-	}
-	return nil, fmt.Errorf("unknown Dept field %s", name)
+	case casbinrule.FieldPtype:
+		return m.OldPtype(ctx)
+	case casbinrule.FieldV0:
+		return m.OldV0(ctx)
+	case casbinrule.FieldV1:
+		return m.OldV1(ctx)
+	case casbinrule.FieldV2:
+		return m.OldV2(ctx)
+	case casbinrule.FieldV3:
+		return m.OldV3(ctx)
+	case casbinrule.FieldV4:
+		return m.OldV4(ctx)
+	case casbinrule.FieldV5:
+		return m.OldV5(ctx)
+	}
+	return nil, fmt.Errorf("unknown CasbinRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeptMutation) SetField(name string, value ent.Value) error {
+func (m *CasbinRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case dept.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case dept.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case casbinrule.FieldPtype:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetPtype(v)
 		return nil
-	case dept.FieldStatus:
-		v, ok := value.(int32)
+	case casbinrule.FieldV0:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetV0(v)
 		return nil
-	case dept.FieldDomainID:
-		v, ok := value.(uint32)
+	case casbinrule.FieldV1:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDomainID(v)
+		m.SetV1(v)
 		return nil
-	case dept.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case casbinrule.FieldV2:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetV2(v)
 		return nil
-	case dept.FieldName:
+	case casbinrule.FieldV3:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetV3(v)
 		return nil
-	case dept.FieldParentID:
-		v, ok := value.(uint32)
+	case casbinrule.FieldV4:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
+		m.SetV4(v)
 		return nil
-	case dept.FieldAncestors:
-		v, ok := value.([]int)
+	case casbinrule.FieldV5:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAncestors(v)
+		m.SetV5(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Dept field %s", name)
+	return fmt.Errorf("unknown CasbinRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeptMutation) AddedFields() []string {
-	var fields []string
-	if m.addstatus != nil {
-		fields = append(fields, dept.FieldStatus)
-	}
-	if m.adddomain_id != nil {
-		fields = append(fields, dept.FieldDomainID)
-	}
-	return fields
+func (m *CasbinRuleMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeptMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case dept.FieldStatus:
-		return m.AddedStatus()
-	case dept.FieldDomainID:
-		return m.AddedDomainID()
-	}
+func (m *CasbinRuleMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeptMutation) AddField(name string, value ent.Value) error {
+func (m *CasbinRuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case dept.FieldStatus:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatus(v)
-		return nil
-	case dept.FieldDomainID:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDomainID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Dept numeric field %s", name)
+	return fmt.Errorf("unknown CasbinRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeptMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(dept.FieldDeletedAt) {
-		fields = append(fields, dept.FieldDeletedAt)
-	}
-	if m.FieldCleared(dept.FieldParentID) {
-		fields = append(fields, dept.FieldParentID)
-	}
-	if m.FieldCleared(dept.FieldAncestors) {
-		fields = append(fields, dept.FieldAncestors)
-	}
-	return fields
+func (m *CasbinRuleMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeptMutation) FieldCleared(name string) bool {
+func (m *CasbinRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeptMutation) ClearField(name string) error {
-	switch name {
-	case dept.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case dept.FieldParentID:
-		m.ClearParentID()
-		return nil
-	case dept.FieldAncestors:
-		m.ClearAncestors()
-		return nil
-	}
-	return fmt.Errorf("unknown Dept nullable field %s", name)
+func (m *CasbinRuleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CasbinRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeptMutation) ResetField(name string) error {
+func (m *CasbinRuleMutation) ResetField(name string) error {
 	switch name {
-	case dept.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case dept.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case casbinrule.FieldPtype:
+		m.ResetPtype()
 		return nil
-	case dept.FieldStatus:
-		m.ResetStatus()
+	case casbinrule.FieldV0:
+		m.ResetV0()
 		return nil
-	case dept.FieldDomainID:
-		m.ResetDomainID()
+	case casbinrule.FieldV1:
+		m.ResetV1()
 		return nil
-	case dept.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case casbinrule.FieldV2:
+		m.ResetV2()
 		return nil
-	case dept.FieldName:
-		m.ResetName()
+	case casbinrule.FieldV3:
+		m.ResetV3()
 		return nil
-	case dept.FieldParentID:
-		m.ResetParentID()
+	case casbinrule.FieldV4:
+		m.ResetV4()
 		return nil
-	case dept.FieldAncestors:
-		m.ResetAncestors()
+	case casbinrule.FieldV5:
+		m.ResetV5()
 		return nil
 	}
-	return fmt.Errorf("unknown Dept field %s", name)
+	return fmt.Errorf("unknown CasbinRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeptMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.parent != nil {
-		edges = append(edges, dept.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, dept.EdgeChildren)
-	}
+func (m *CasbinRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeptMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case dept.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case dept.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CasbinRuleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeptMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedchildren != nil {
-		edges = append(edges, dept.EdgeChildren)
-	}
+func (m *CasbinRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeptMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case dept.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CasbinRuleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeptMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedparent {
-		edges = append(edges, dept.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, dept.EdgeChildren)
-	}
+func (m *CasbinRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeptMutation) EdgeCleared(name string) bool {
-	switch name {
-	case dept.EdgeParent:
-		return m.clearedparent
-	case dept.EdgeChildren:
-		return m.clearedchildren
-	}
+func (m *CasbinRuleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeptMutation) ClearEdge(name string) error {
-	switch name {
-	case dept.EdgeParent:
-		m.ClearParent()
-		return nil
-	}
-	return fmt.Errorf("unknown Dept unique edge %s", name)
+func (m *CasbinRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CasbinRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeptMutation) ResetEdge(name string) error {
-	switch name {
-	case dept.EdgeParent:
-		m.ResetParent()
-		return nil
-	case dept.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	}
-	return fmt.Errorf("unknown Dept edge %s", name)
+func (m *CasbinRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CasbinRule edge %s", name)
 }
 
-// MenuMutation represents an operation that mutates the Menu nodes in the graph.
-type MenuMutation struct {
+// DeptMutation represents an operation that mutates the Dept nodes in the graph.
+type DeptMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uint32
-	status                 *int32
-	addstatus              *int32
-	created_at             *time.Time
-	updated_at             *time.Time
-	deleted_at             *time.Time
-	name                   *string
-	_path                  *string
-	_type                  *int32
-	add_type               *int32
-	component              *string
-	redirect               *string
-	auth_code              *string
-	active_icon            *string
-	active_path            *string
-	affix_tab              *bool
-	affix_tab_order        *int32
-	addaffix_tab_order     *int32
-	badge                  *string
-	badge_type             *int32
-	addbadge_type          *int32
-	badge_variants         *int32
-	addbadge_variants      *int32
-	hide_children_in_menu  *bool
-	hide_in_breadcrumb     *bool
-	hide_in_menu           *bool
-	hide_in_tab            *bool
-	icon                   *string
-	iframe_src             *string
-	keep_alive             *bool
-	link                   *string
-	max_num_of_open_tab    *int32
-	addmax_num_of_open_tab *int32
-	no_basic_layout        *bool
-	open_in_new_window     *bool
-	sort                   *int32
-	addsort                *int32
-	query                  *string
-	title                  *string
-	clearedFields          map[string]struct{}
-	parent                 *uint32
-	clearedparent          bool
-	children               map[uint32]struct{}
-	removedchildren        map[uint32]struct{}
-	clearedchildren        bool
-	done                   bool
-	oldValue               func(context.Context) (*Menu, error)
-	predicates             []predicate.Menu
+	op              Op
+	typ             string
+	id              *uint32
+	created_at      *time.Time
+	updated_at      *time.Time
+	status          *int32
+	addstatus       *int32
+	domain_id       *uint32
+	adddomain_id    *int32
+	version         *uint32
+	addversion      *int32
+	created_by      *uint32
+	addcreated_by   *int32
+	updated_by      *uint32
+	addupdated_by   *int32
+	deleted_by      *uint32
+	adddeleted_by   *int32
+	deleted_at      *time.Time
+	name            *string
+	ancestors       *[]int
+	appendancestors []int
+	clearedFields   map[string]struct{}
+	parent          *uint32
+	clearedparent   bool
+	children        map[uint32]struct{}
+	removedchildren map[uint32]struct{}
+	clearedchildren bool
+	done            bool
+	oldValue        func(context.Context) (*Dept, error)
+	predicates      []predicate.Dept
 }
 
-var _ ent.Mutation = (*MenuMutation)(nil)
+var _ ent.Mutation = (*DeptMutation)(nil)
 
-// menuOption allows management of the mutation configuration using functional options.
-type menuOption func(*MenuMutation)
+// deptOption allows management of the mutation configuration using functional options.
+type deptOption func(*DeptMutation)
 
-// newMenuMutation creates new mutation for the Menu entity.
-func newMenuMutation(c config, op Op, opts ...menuOption) *MenuMutation {
-	m := &MenuMutation{
+// newDeptMutation creates new mutation for the Dept entity.
+func newDeptMutation(c config, op Op, opts ...deptOption) *DeptMutation {
+	m := &DeptMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMenu,
+		typ:           TypeDept,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1104,20 +754,20 @@ func newMenuMutation(c config, op Op, opts ...menuOption) *MenuMutation {
 	return m
 }
 
-// withMenuID sets the ID field of the mutation.
-func withMenuID(id uint32) menuOption {
-	return func(m *MenuMutation) {
+// withDeptID sets the ID field of the mutation.
+func withDeptID(id uint32) deptOption {
+	return func(m *DeptMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Menu
+			value *Dept
 		)
-		m.oldValue = func(ctx context.Context) (*Menu, error) {
+		m.oldValue = func(ctx context.Context) (*Dept, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Menu.Get(ctx, id)
+					value, err = m.Client().Dept.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1126,10 +776,10 @@ func withMenuID(id uint32) menuOption {
 	}
 }
 
-// withMenu sets the old Menu of the mutation.
-func withMenu(node *Menu) menuOption {
-	return func(m *MenuMutation) {
-		m.oldValue = func(context.Context) (*Menu, error) {
+// withDept sets the old Dept of the mutation.
+func withDept(node *Dept) deptOption {
+	return func(m *DeptMutation) {
+		m.oldValue = func(context.Context) (*Dept, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1138,7 +788,7 @@ func withMenu(node *Menu) menuOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MenuMutation) Client() *Client {
+func (m DeptMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1146,7 +796,7 @@ func (m MenuMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MenuMutation) Tx() (*Tx, error) {
+func (m DeptMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("gen: mutation is not running in a transaction")
 	}
@@ -1156,14 +806,14 @@ func (m MenuMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Menu entities.
-func (m *MenuMutation) SetID(id uint32) {
+// operation is only accepted on creation of Dept entities.
+func (m *DeptMutation) SetID(id uint32) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MenuMutation) ID() (id uint32, exists bool) {
+func (m *DeptMutation) ID() (id uint32, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1174,7 +824,7 @@ func (m *MenuMutation) ID() (id uint32, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MenuMutation) IDs(ctx context.Context) ([]uint32, error) {
+func (m *DeptMutation) IDs(ctx context.Context) ([]uint32, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1183,75 +833,19 @@ func (m *MenuMutation) IDs(ctx context.Context) ([]uint32, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Menu.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Dept.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStatus sets the "status" field.
-func (m *MenuMutation) SetStatus(i int32) {
-	m.status = &i
-	m.addstatus = nil
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *MenuMutation) Status() (r int32, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldStatus(ctx context.Context) (v *int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// AddStatus adds i to the "status" field.
-func (m *MenuMutation) AddStatus(i int32) {
-	if m.addstatus != nil {
-		*m.addstatus += i
-	} else {
-		m.addstatus = &i
-	}
-}
-
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *MenuMutation) AddedStatus() (r int32, exists bool) {
-	v := m.addstatus
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *MenuMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
-}
-
 // SetCreatedAt sets the "created_at" field.
-func (m *MenuMutation) SetCreatedAt(t time.Time) {
+func (m *DeptMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MenuMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DeptMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1259,10 +853,10 @@ func (m *MenuMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1277,17 +871,17 @@ func (m *MenuMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MenuMutation) ResetCreatedAt() {
+func (m *DeptMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MenuMutation) SetUpdatedAt(t time.Time) {
+func (m *DeptMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MenuMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *DeptMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1295,10 +889,10 @@ func (m *MenuMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeptMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1313,1356 +907,6222 @@ func (m *MenuMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MenuMutation) ResetUpdatedAt() {
+func (m *DeptMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *MenuMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetStatus sets the "status" field.
+func (m *DeptMutation) SetStatus(i int32) {
+	m.status = &i
+	m.addstatus = nil
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *MenuMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// Status returns the value of the "status" field in the mutation.
+func (m *DeptMutation) Status() (r int32, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *DeptMutation) OldStatus(ctx context.Context) (v *int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.Status, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *MenuMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[menu.FieldDeletedAt] = struct{}{}
+// AddStatus adds i to the "status" field.
+func (m *DeptMutation) AddStatus(i int32) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *MenuMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[menu.FieldDeletedAt]
-	return ok
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *DeptMutation) AddedStatus() (r int32, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *MenuMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, menu.FieldDeletedAt)
+// ResetStatus resets all changes to the "status" field.
+func (m *DeptMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
 }
 
-// SetName sets the "name" field.
-func (m *MenuMutation) SetName(s string) {
-	m.name = &s
+// SetDomainID sets the "domain_id" field.
+func (m *DeptMutation) SetDomainID(u uint32) {
+	m.domain_id = &u
+	m.adddomain_id = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *MenuMutation) Name() (r string, exists bool) {
-	v := m.name
+// DomainID returns the value of the "domain_id" field in the mutation.
+func (m *DeptMutation) DomainID() (r uint32, exists bool) {
+	v := m.domain_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldDomainID returns the old "domain_id" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DeptMutation) OldDomainID(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldDomainID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldDomainID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDomainID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.DomainID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *MenuMutation) ResetName() {
-	m.name = nil
+// AddDomainID adds u to the "domain_id" field.
+func (m *DeptMutation) AddDomainID(u int32) {
+	if m.adddomain_id != nil {
+		*m.adddomain_id += u
+	} else {
+		m.adddomain_id = &u
+	}
 }
 
-// SetPath sets the "path" field.
-func (m *MenuMutation) SetPath(s string) {
-	m._path = &s
+// AddedDomainID returns the value that was added to the "domain_id" field in this mutation.
+func (m *DeptMutation) AddedDomainID() (r int32, exists bool) {
+	v := m.adddomain_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *MenuMutation) Path() (r string, exists bool) {
-	v := m._path
+// ResetDomainID resets all changes to the "domain_id" field.
+func (m *DeptMutation) ResetDomainID() {
+	m.domain_id = nil
+	m.adddomain_id = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *DeptMutation) SetVersion(u uint32) {
+	m.version = &u
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *DeptMutation) Version() (r uint32, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldPath(ctx context.Context) (v *string, err error) {
+func (m *DeptMutation) OldVersion(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Path, nil
+	return oldValue.Version, nil
 }
 
-// ClearPath clears the value of the "path" field.
-func (m *MenuMutation) ClearPath() {
-	m._path = nil
-	m.clearedFields[menu.FieldPath] = struct{}{}
+// AddVersion adds u to the "version" field.
+func (m *DeptMutation) AddVersion(u int32) {
+	if m.addversion != nil {
+		*m.addversion += u
+	} else {
+		m.addversion = &u
+	}
 }
 
-// PathCleared returns if the "path" field was cleared in this mutation.
-func (m *MenuMutation) PathCleared() bool {
-	_, ok := m.clearedFields[menu.FieldPath]
-	return ok
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *DeptMutation) AddedVersion() (r int32, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetPath resets all changes to the "path" field.
-func (m *MenuMutation) ResetPath() {
-	m._path = nil
-	delete(m.clearedFields, menu.FieldPath)
+// ResetVersion resets all changes to the "version" field.
+func (m *DeptMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// SetType sets the "type" field.
-func (m *MenuMutation) SetType(i int32) {
-	m._type = &i
-	m.add_type = nil
+// SetCreatedBy sets the "created_by" field.
+func (m *DeptMutation) SetCreatedBy(u uint32) {
+	m.created_by = &u
+	m.addcreated_by = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *MenuMutation) GetType() (r int32, exists bool) {
-	v := m._type
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *DeptMutation) CreatedBy() (r uint32, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldType(ctx context.Context) (v int32, err error) {
+func (m *DeptMutation) OldCreatedBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.CreatedBy, nil
 }
 
-// AddType adds i to the "type" field.
-func (m *MenuMutation) AddType(i int32) {
-	if m.add_type != nil {
-		*m.add_type += i
+// AddCreatedBy adds u to the "created_by" field.
+func (m *DeptMutation) AddCreatedBy(u int32) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += u
 	} else {
-		m.add_type = &i
+		m.addcreated_by = &u
 	}
 }
 
-// AddedType returns the value that was added to the "type" field in this mutation.
-func (m *MenuMutation) AddedType() (r int32, exists bool) {
-	v := m.add_type
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *DeptMutation) AddedCreatedBy() (r int32, exists bool) {
+	v := m.addcreated_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearType clears the value of the "type" field.
-func (m *MenuMutation) ClearType() {
-	m._type = nil
-	m.add_type = nil
-	m.clearedFields[menu.FieldType] = struct{}{}
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *DeptMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[dept.FieldCreatedBy] = struct{}{}
 }
 
-// TypeCleared returns if the "type" field was cleared in this mutation.
-func (m *MenuMutation) TypeCleared() bool {
-	_, ok := m.clearedFields[menu.FieldType]
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *DeptMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[dept.FieldCreatedBy]
 	return ok
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *MenuMutation) ResetType() {
-	m._type = nil
-	m.add_type = nil
-	delete(m.clearedFields, menu.FieldType)
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *DeptMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, dept.FieldCreatedBy)
 }
 
-// SetComponent sets the "component" field.
-func (m *MenuMutation) SetComponent(s string) {
-	m.component = &s
+// SetUpdatedBy sets the "updated_by" field.
+func (m *DeptMutation) SetUpdatedBy(u uint32) {
+	m.updated_by = &u
+	m.addupdated_by = nil
 }
 
-// Component returns the value of the "component" field in the mutation.
-func (m *MenuMutation) Component() (r string, exists bool) {
-	v := m.component
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *DeptMutation) UpdatedBy() (r uint32, exists bool) {
+	v := m.updated_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldComponent returns the old "component" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedBy returns the old "updated_by" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldComponent(ctx context.Context) (v *string, err error) {
+func (m *DeptMutation) OldUpdatedBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldComponent is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldComponent requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldComponent: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
 	}
-	return oldValue.Component, nil
+	return oldValue.UpdatedBy, nil
 }
 
-// ClearComponent clears the value of the "component" field.
-func (m *MenuMutation) ClearComponent() {
-	m.component = nil
-	m.clearedFields[menu.FieldComponent] = struct{}{}
+// AddUpdatedBy adds u to the "updated_by" field.
+func (m *DeptMutation) AddUpdatedBy(u int32) {
+	if m.addupdated_by != nil {
+		*m.addupdated_by += u
+	} else {
+		m.addupdated_by = &u
+	}
 }
 
-// ComponentCleared returns if the "component" field was cleared in this mutation.
-func (m *MenuMutation) ComponentCleared() bool {
-	_, ok := m.clearedFields[menu.FieldComponent]
+// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
+func (m *DeptMutation) AddedUpdatedBy() (r int32, exists bool) {
+	v := m.addupdated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *DeptMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	m.clearedFields[dept.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *DeptMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[dept.FieldUpdatedBy]
 	return ok
 }
 
-// ResetComponent resets all changes to the "component" field.
-func (m *MenuMutation) ResetComponent() {
-	m.component = nil
-	delete(m.clearedFields, menu.FieldComponent)
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *DeptMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	delete(m.clearedFields, dept.FieldUpdatedBy)
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *MenuMutation) SetParentID(u uint32) {
-	m.parent = &u
+// SetDeletedBy sets the "deleted_by" field.
+func (m *DeptMutation) SetDeletedBy(u uint32) {
+	m.deleted_by = &u
+	m.adddeleted_by = nil
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *MenuMutation) ParentID() (r uint32, exists bool) {
-	v := m.parent
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *DeptMutation) DeletedBy() (r uint32, exists bool) {
+	v := m.deleted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedBy returns the old "deleted_by" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldParentID(ctx context.Context) (v *uint32, err error) {
+func (m *DeptMutation) OldDeletedBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
 	}
-	return oldValue.ParentID, nil
+	return oldValue.DeletedBy, nil
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (m *MenuMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[menu.FieldParentID] = struct{}{}
+// AddDeletedBy adds u to the "deleted_by" field.
+func (m *DeptMutation) AddDeletedBy(u int32) {
+	if m.adddeleted_by != nil {
+		*m.adddeleted_by += u
+	} else {
+		m.adddeleted_by = &u
+	}
 }
 
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *MenuMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[menu.FieldParentID]
+// AddedDeletedBy returns the value that was added to the "deleted_by" field in this mutation.
+func (m *DeptMutation) AddedDeletedBy() (r int32, exists bool) {
+	v := m.adddeleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *DeptMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.adddeleted_by = nil
+	m.clearedFields[dept.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *DeptMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[dept.FieldDeletedBy]
 	return ok
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *MenuMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, menu.FieldParentID)
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *DeptMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	m.adddeleted_by = nil
+	delete(m.clearedFields, dept.FieldDeletedBy)
 }
 
-// SetRedirect sets the "redirect" field.
-func (m *MenuMutation) SetRedirect(s string) {
-	m.redirect = &s
+// SetDeletedAt sets the "deleted_at" field.
+func (m *DeptMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// Redirect returns the value of the "redirect" field in the mutation.
-func (m *MenuMutation) Redirect() (r string, exists bool) {
-	v := m.redirect
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *DeptMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRedirect returns the old "redirect" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldRedirect(ctx context.Context) (v *string, err error) {
+func (m *DeptMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRedirect is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRedirect requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRedirect: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Redirect, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ClearRedirect clears the value of the "redirect" field.
-func (m *MenuMutation) ClearRedirect() {
-	m.redirect = nil
-	m.clearedFields[menu.FieldRedirect] = struct{}{}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *DeptMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[dept.FieldDeletedAt] = struct{}{}
 }
 
-// RedirectCleared returns if the "redirect" field was cleared in this mutation.
-func (m *MenuMutation) RedirectCleared() bool {
-	_, ok := m.clearedFields[menu.FieldRedirect]
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *DeptMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[dept.FieldDeletedAt]
 	return ok
 }
 
-// ResetRedirect resets all changes to the "redirect" field.
-func (m *MenuMutation) ResetRedirect() {
-	m.redirect = nil
-	delete(m.clearedFields, menu.FieldRedirect)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *DeptMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, dept.FieldDeletedAt)
 }
 
-// SetAuthCode sets the "auth_code" field.
-func (m *MenuMutation) SetAuthCode(s string) {
-	m.auth_code = &s
+// SetName sets the "name" field.
+func (m *DeptMutation) SetName(s string) {
+	m.name = &s
 }
 
-// AuthCode returns the value of the "auth_code" field in the mutation.
-func (m *MenuMutation) AuthCode() (r string, exists bool) {
-	v := m.auth_code
+// Name returns the value of the "name" field in the mutation.
+func (m *DeptMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthCode returns the old "auth_code" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldAuthCode(ctx context.Context) (v *string, err error) {
+func (m *DeptMutation) OldName(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthCode requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.AuthCode, nil
+	return oldValue.Name, nil
 }
 
-// ResetAuthCode resets all changes to the "auth_code" field.
-func (m *MenuMutation) ResetAuthCode() {
-	m.auth_code = nil
+// ResetName resets all changes to the "name" field.
+func (m *DeptMutation) ResetName() {
+	m.name = nil
 }
 
-// SetActiveIcon sets the "active_icon" field.
-func (m *MenuMutation) SetActiveIcon(s string) {
-	m.active_icon = &s
+// SetParentID sets the "parent_id" field.
+func (m *DeptMutation) SetParentID(u uint32) {
+	m.parent = &u
 }
 
-// ActiveIcon returns the value of the "active_icon" field in the mutation.
-func (m *MenuMutation) ActiveIcon() (r string, exists bool) {
-	v := m.active_icon
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *DeptMutation) ParentID() (r uint32, exists bool) {
+	v := m.parent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActiveIcon returns the old "active_icon" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldParentID returns the old "parent_id" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldActiveIcon(ctx context.Context) (v *string, err error) {
+func (m *DeptMutation) OldParentID(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActiveIcon is only allowed on UpdateOne operations")
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActiveIcon requires an ID field in the mutation")
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActiveIcon: %w", err)
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
 	}
-	return oldValue.ActiveIcon, nil
+	return oldValue.ParentID, nil
 }
 
-// ResetActiveIcon resets all changes to the "active_icon" field.
-func (m *MenuMutation) ResetActiveIcon() {
-	m.active_icon = nil
+// ClearParentID clears the value of the "parent_id" field.
+func (m *DeptMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[dept.FieldParentID] = struct{}{}
 }
 
-// SetActivePath sets the "active_path" field.
-func (m *MenuMutation) SetActivePath(s string) {
-	m.active_path = &s
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *DeptMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[dept.FieldParentID]
+	return ok
 }
 
-// ActivePath returns the value of the "active_path" field in the mutation.
-func (m *MenuMutation) ActivePath() (r string, exists bool) {
-	v := m.active_path
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *DeptMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, dept.FieldParentID)
+}
+
+// SetAncestors sets the "ancestors" field.
+func (m *DeptMutation) SetAncestors(i []int) {
+	m.ancestors = &i
+	m.appendancestors = nil
+}
+
+// Ancestors returns the value of the "ancestors" field in the mutation.
+func (m *DeptMutation) Ancestors() (r []int, exists bool) {
+	v := m.ancestors
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActivePath returns the old "active_path" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldAncestors returns the old "ancestors" field's value of the Dept entity.
+// If the Dept object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldActivePath(ctx context.Context) (v *string, err error) {
+func (m *DeptMutation) OldAncestors(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActivePath is only allowed on UpdateOne operations")
+		return v, errors.New("OldAncestors is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActivePath requires an ID field in the mutation")
+		return v, errors.New("OldAncestors requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActivePath: %w", err)
+		return v, fmt.Errorf("querying old value for OldAncestors: %w", err)
 	}
-	return oldValue.ActivePath, nil
+	return oldValue.Ancestors, nil
 }
 
-// ResetActivePath resets all changes to the "active_path" field.
-func (m *MenuMutation) ResetActivePath() {
-	m.active_path = nil
+// AppendAncestors adds i to the "ancestors" field.
+func (m *DeptMutation) AppendAncestors(i []int) {
+	m.appendancestors = append(m.appendancestors, i...)
 }
 
-// SetAffixTab sets the "affix_tab" field.
-func (m *MenuMutation) SetAffixTab(b bool) {
-	m.affix_tab = &b
+// AppendedAncestors returns the list of values that were appended to the "ancestors" field in this mutation.
+func (m *DeptMutation) AppendedAncestors() ([]int, bool) {
+	if len(m.appendancestors) == 0 {
+		return nil, false
+	}
+	return m.appendancestors, true
 }
 
-// AffixTab returns the value of the "affix_tab" field in the mutation.
-func (m *MenuMutation) AffixTab() (r bool, exists bool) {
-	v := m.affix_tab
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearAncestors clears the value of the "ancestors" field.
+func (m *DeptMutation) ClearAncestors() {
+	m.ancestors = nil
+	m.appendancestors = nil
+	m.clearedFields[dept.FieldAncestors] = struct{}{}
 }
 
-// OldAffixTab returns the old "affix_tab" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldAffixTab(ctx context.Context) (v *bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAffixTab is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAffixTab requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAffixTab: %w", err)
-	}
-	return oldValue.AffixTab, nil
+// AncestorsCleared returns if the "ancestors" field was cleared in this mutation.
+func (m *DeptMutation) AncestorsCleared() bool {
+	_, ok := m.clearedFields[dept.FieldAncestors]
+	return ok
 }
 
-// ResetAffixTab resets all changes to the "affix_tab" field.
-func (m *MenuMutation) ResetAffixTab() {
-	m.affix_tab = nil
+// ResetAncestors resets all changes to the "ancestors" field.
+func (m *DeptMutation) ResetAncestors() {
+	m.ancestors = nil
+	m.appendancestors = nil
+	delete(m.clearedFields, dept.FieldAncestors)
 }
 
-// SetAffixTabOrder sets the "affix_tab_order" field.
-func (m *MenuMutation) SetAffixTabOrder(i int32) {
-	m.affix_tab_order = &i
-	m.addaffix_tab_order = nil
+// ClearParent clears the "parent" edge to the Dept entity.
+func (m *DeptMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[dept.FieldParentID] = struct{}{}
 }
 
-// AffixTabOrder returns the value of the "affix_tab_order" field in the mutation.
-func (m *MenuMutation) AffixTabOrder() (r int32, exists bool) {
-	v := m.affix_tab_order
-	if v == nil {
-		return
-	}
-	return *v, true
+// ParentCleared reports if the "parent" edge to the Dept entity was cleared.
+func (m *DeptMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
 }
 
-// OldAffixTabOrder returns the old "affix_tab_order" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldAffixTabOrder(ctx context.Context) (v *int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAffixTabOrder is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAffixTabOrder requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAffixTabOrder: %w", err)
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *DeptMutation) ParentIDs() (ids []uint32) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.AffixTabOrder, nil
+	return
 }
 
-// AddAffixTabOrder adds i to the "affix_tab_order" field.
-func (m *MenuMutation) AddAffixTabOrder(i int32) {
-	if m.addaffix_tab_order != nil {
-		*m.addaffix_tab_order += i
-	} else {
-		m.addaffix_tab_order = &i
-	}
+// ResetParent resets all changes to the "parent" edge.
+func (m *DeptMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
 }
 
-// AddedAffixTabOrder returns the value that was added to the "affix_tab_order" field in this mutation.
-func (m *MenuMutation) AddedAffixTabOrder() (r int32, exists bool) {
-	v := m.addaffix_tab_order
-	if v == nil {
-		return
+// AddChildIDs adds the "children" edge to the Dept entity by ids.
+func (m *DeptMutation) AddChildIDs(ids ...uint32) {
+	if m.children == nil {
+		m.children = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
 	}
-	return *v, true
-}
-
-// ResetAffixTabOrder resets all changes to the "affix_tab_order" field.
-func (m *MenuMutation) ResetAffixTabOrder() {
-	m.affix_tab_order = nil
-	m.addaffix_tab_order = nil
 }
 
-// SetBadge sets the "badge" field.
-func (m *MenuMutation) SetBadge(s string) {
-	m.badge = &s
+// ClearChildren clears the "children" edge to the Dept entity.
+func (m *DeptMutation) ClearChildren() {
+	m.clearedchildren = true
 }
 
-// Badge returns the value of the "badge" field in the mutation.
-func (m *MenuMutation) Badge() (r string, exists bool) {
-	v := m.badge
-	if v == nil {
-		return
-	}
-	return *v, true
+// ChildrenCleared reports if the "children" edge to the Dept entity was cleared.
+func (m *DeptMutation) ChildrenCleared() bool {
+	return m.clearedchildren
 }
 
-// OldBadge returns the old "badge" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldBadge(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBadge is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBadge requires an ID field in the mutation")
+// RemoveChildIDs removes the "children" edge to the Dept entity by IDs.
+func (m *DeptMutation) RemoveChildIDs(ids ...uint32) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uint32]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBadge: %w", err)
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
 	}
-	return oldValue.Badge, nil
-}
-
-// ResetBadge resets all changes to the "badge" field.
-func (m *MenuMutation) ResetBadge() {
-	m.badge = nil
 }
 
-// SetBadgeType sets the "badge_type" field.
-func (m *MenuMutation) SetBadgeType(i int32) {
-	m.badge_type = &i
-	m.addbadge_type = nil
+// RemovedChildren returns the removed IDs of the "children" edge to the Dept entity.
+func (m *DeptMutation) RemovedChildrenIDs() (ids []uint32) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// BadgeType returns the value of the "badge_type" field in the mutation.
-func (m *MenuMutation) BadgeType() (r int32, exists bool) {
-	v := m.badge_type
-	if v == nil {
-		return
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *DeptMutation) ChildrenIDs() (ids []uint32) {
+	for id := range m.children {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldBadgeType returns the old "badge_type" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldBadgeType(ctx context.Context) (v *int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBadgeType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBadgeType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBadgeType: %w", err)
-	}
-	return oldValue.BadgeType, nil
+// ResetChildren resets all changes to the "children" edge.
+func (m *DeptMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
 }
 
-// AddBadgeType adds i to the "badge_type" field.
-func (m *MenuMutation) AddBadgeType(i int32) {
-	if m.addbadge_type != nil {
-		*m.addbadge_type += i
-	} else {
-		m.addbadge_type = &i
-	}
+// Where appends a list predicates to the DeptMutation builder.
+func (m *DeptMutation) Where(ps ...predicate.Dept) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AddedBadgeType returns the value that was added to the "badge_type" field in this mutation.
-func (m *MenuMutation) AddedBadgeType() (r int32, exists bool) {
-	v := m.addbadge_type
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the DeptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Dept, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetBadgeType resets all changes to the "badge_type" field.
-func (m *MenuMutation) ResetBadgeType() {
-	m.badge_type = nil
-	m.addbadge_type = nil
+// Op returns the operation name.
+func (m *DeptMutation) Op() Op {
+	return m.op
 }
 
-// SetBadgeVariants sets the "badge_variants" field.
-func (m *MenuMutation) SetBadgeVariants(i int32) {
-	m.badge_variants = &i
-	m.addbadge_variants = nil
+// SetOp allows setting the mutation operation.
+func (m *DeptMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// BadgeVariants returns the value of the "badge_variants" field in the mutation.
-func (m *MenuMutation) BadgeVariants() (r int32, exists bool) {
-	v := m.badge_variants
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Dept).
+func (m *DeptMutation) Type() string {
+	return m.typ
 }
 
-// OldBadgeVariants returns the old "badge_variants" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldBadgeVariants(ctx context.Context) (v *int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBadgeVariants is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeptMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.created_at != nil {
+		fields = append(fields, dept.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBadgeVariants requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, dept.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBadgeVariants: %w", err)
+	if m.status != nil {
+		fields = append(fields, dept.FieldStatus)
 	}
-	return oldValue.BadgeVariants, nil
-}
-
-// AddBadgeVariants adds i to the "badge_variants" field.
-func (m *MenuMutation) AddBadgeVariants(i int32) {
-	if m.addbadge_variants != nil {
-		*m.addbadge_variants += i
-	} else {
-		m.addbadge_variants = &i
+	if m.domain_id != nil {
+		fields = append(fields, dept.FieldDomainID)
 	}
-}
-
-// AddedBadgeVariants returns the value that was added to the "badge_variants" field in this mutation.
-func (m *MenuMutation) AddedBadgeVariants() (r int32, exists bool) {
-	v := m.addbadge_variants
+	if m.version != nil {
+		fields = append(fields, dept.FieldVersion)
+	}
+	if m.created_by != nil {
+		fields = append(fields, dept.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, dept.FieldUpdatedBy)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, dept.FieldDeletedBy)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, dept.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, dept.FieldName)
+	}
+	if m.parent != nil {
+		fields = append(fields, dept.FieldParentID)
+	}
+	if m.ancestors != nil {
+		fields = append(fields, dept.FieldAncestors)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case dept.FieldCreatedAt:
+		return m.CreatedAt()
+	case dept.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case dept.FieldStatus:
+		return m.Status()
+	case dept.FieldDomainID:
+		return m.DomainID()
+	case dept.FieldVersion:
+		return m.Version()
+	case dept.FieldCreatedBy:
+		return m.CreatedBy()
+	case dept.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case dept.FieldDeletedBy:
+		return m.DeletedBy()
+	case dept.FieldDeletedAt:
+		return m.DeletedAt()
+	case dept.FieldName:
+		return m.Name()
+	case dept.FieldParentID:
+		return m.ParentID()
+	case dept.FieldAncestors:
+		return m.Ancestors()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case dept.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case dept.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case dept.FieldStatus:
+		return m.OldStatus(ctx)
+	case dept.FieldDomainID:
+		return m.OldDomainID(ctx)
+	case dept.FieldVersion:
+		return m.OldVersion(ctx)
+	case dept.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case dept.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case dept.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case dept.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case dept.FieldName:
+		return m.OldName(ctx)
+	case dept.FieldParentID:
+		return m.OldParentID(ctx)
+	case dept.FieldAncestors:
+		return m.OldAncestors(ctx)
+	}
+	return nil, fmt.Errorf("unknown Dept field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case dept.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case dept.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case dept.FieldStatus:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case dept.FieldDomainID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomainID(v)
+		return nil
+	case dept.FieldVersion:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case dept.FieldCreatedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case dept.FieldUpdatedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case dept.FieldDeletedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case dept.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case dept.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case dept.FieldParentID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case dept.FieldAncestors:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAncestors(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Dept field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeptMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, dept.FieldStatus)
+	}
+	if m.adddomain_id != nil {
+		fields = append(fields, dept.FieldDomainID)
+	}
+	if m.addversion != nil {
+		fields = append(fields, dept.FieldVersion)
+	}
+	if m.addcreated_by != nil {
+		fields = append(fields, dept.FieldCreatedBy)
+	}
+	if m.addupdated_by != nil {
+		fields = append(fields, dept.FieldUpdatedBy)
+	}
+	if m.adddeleted_by != nil {
+		fields = append(fields, dept.FieldDeletedBy)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case dept.FieldStatus:
+		return m.AddedStatus()
+	case dept.FieldDomainID:
+		return m.AddedDomainID()
+	case dept.FieldVersion:
+		return m.AddedVersion()
+	case dept.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	case dept.FieldUpdatedBy:
+		return m.AddedUpdatedBy()
+	case dept.FieldDeletedBy:
+		return m.AddedDeletedBy()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case dept.FieldStatus:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case dept.FieldDomainID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDomainID(v)
+		return nil
+	case dept.FieldVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case dept.FieldCreatedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
+	case dept.FieldUpdatedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedBy(v)
+		return nil
+	case dept.FieldDeletedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Dept numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(dept.FieldCreatedBy) {
+		fields = append(fields, dept.FieldCreatedBy)
+	}
+	if m.FieldCleared(dept.FieldUpdatedBy) {
+		fields = append(fields, dept.FieldUpdatedBy)
+	}
+	if m.FieldCleared(dept.FieldDeletedBy) {
+		fields = append(fields, dept.FieldDeletedBy)
+	}
+	if m.FieldCleared(dept.FieldDeletedAt) {
+		fields = append(fields, dept.FieldDeletedAt)
+	}
+	if m.FieldCleared(dept.FieldParentID) {
+		fields = append(fields, dept.FieldParentID)
+	}
+	if m.FieldCleared(dept.FieldAncestors) {
+		fields = append(fields, dept.FieldAncestors)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeptMutation) ClearField(name string) error {
+	switch name {
+	case dept.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case dept.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case dept.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case dept.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case dept.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case dept.FieldAncestors:
+		m.ClearAncestors()
+		return nil
+	}
+	return fmt.Errorf("unknown Dept nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeptMutation) ResetField(name string) error {
+	switch name {
+	case dept.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case dept.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case dept.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case dept.FieldDomainID:
+		m.ResetDomainID()
+		return nil
+	case dept.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case dept.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case dept.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case dept.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case dept.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case dept.FieldName:
+		m.ResetName()
+		return nil
+	case dept.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case dept.FieldAncestors:
+		m.ResetAncestors()
+		return nil
+	}
+	return fmt.Errorf("unknown Dept field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.parent != nil {
+		edges = append(edges, dept.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, dept.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeptMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case dept.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case dept.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedchildren != nil {
+		edges = append(edges, dept.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeptMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case dept.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedparent {
+		edges = append(edges, dept.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, dept.EdgeChildren)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeptMutation) EdgeCleared(name string) bool {
+	switch name {
+	case dept.EdgeParent:
+		return m.clearedparent
+	case dept.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeptMutation) ClearEdge(name string) error {
+	switch name {
+	case dept.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Dept unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeptMutation) ResetEdge(name string) error {
+	switch name {
+	case dept.EdgeParent:
+		m.ResetParent()
+		return nil
+	case dept.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Dept edge %s", name)
+}
+
+// EntityHistoryMutation represents an operation that mutates the EntityHistory nodes in the graph.
+type EntityHistoryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uint64
+	created_at     *time.Time
+	resource       *string
+	entity_id      *uint32
+	addentity_id   *int32
+	action         *string
+	changes        *[]entgo.FieldChange
+	appendchanges  []entgo.FieldChange
+	snapshot       *map[string]interface{}
+	operator_id    *uint32
+	addoperator_id *int32
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*EntityHistory, error)
+	predicates     []predicate.EntityHistory
+}
+
+var _ ent.Mutation = (*EntityHistoryMutation)(nil)
+
+// entityhistoryOption allows management of the mutation configuration using functional options.
+type entityhistoryOption func(*EntityHistoryMutation)
+
+// newEntityHistoryMutation creates new mutation for the EntityHistory entity.
+func newEntityHistoryMutation(c config, op Op, opts ...entityhistoryOption) *EntityHistoryMutation {
+	m := &EntityHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEntityHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEntityHistoryID sets the ID field of the mutation.
+func withEntityHistoryID(id uint64) entityhistoryOption {
+	return func(m *EntityHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EntityHistory
+		)
+		m.oldValue = func(ctx context.Context) (*EntityHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EntityHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEntityHistory sets the old EntityHistory of the mutation.
+func withEntityHistory(node *EntityHistory) entityhistoryOption {
+	return func(m *EntityHistoryMutation) {
+		m.oldValue = func(context.Context) (*EntityHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EntityHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EntityHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("gen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EntityHistory entities.
+func (m *EntityHistoryMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EntityHistoryMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EntityHistoryMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EntityHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EntityHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EntityHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EntityHistory entity.
+// If the EntityHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EntityHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetResource sets the "resource" field.
+func (m *EntityHistoryMutation) SetResource(s string) {
+	m.resource = &s
+}
+
+// Resource returns the value of the "resource" field in the mutation.
+func (m *EntityHistoryMutation) Resource() (r string, exists bool) {
+	v := m.resource
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResource returns the old "resource" field's value of the EntityHistory entity.
+// If the EntityHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityHistoryMutation) OldResource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResource: %w", err)
+	}
+	return oldValue.Resource, nil
+}
+
+// ResetResource resets all changes to the "resource" field.
+func (m *EntityHistoryMutation) ResetResource() {
+	m.resource = nil
+}
+
+// SetEntityID sets the "entity_id" field.
+func (m *EntityHistoryMutation) SetEntityID(u uint32) {
+	m.entity_id = &u
+	m.addentity_id = nil
+}
+
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *EntityHistoryMutation) EntityID() (r uint32, exists bool) {
+	v := m.entity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityID returns the old "entity_id" field's value of the EntityHistory entity.
+// If the EntityHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityHistoryMutation) OldEntityID(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
+}
+
+// AddEntityID adds u to the "entity_id" field.
+func (m *EntityHistoryMutation) AddEntityID(u int32) {
+	if m.addentity_id != nil {
+		*m.addentity_id += u
+	} else {
+		m.addentity_id = &u
+	}
+}
+
+// AddedEntityID returns the value that was added to the "entity_id" field in this mutation.
+func (m *EntityHistoryMutation) AddedEntityID() (r int32, exists bool) {
+	v := m.addentity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *EntityHistoryMutation) ResetEntityID() {
+	m.entity_id = nil
+	m.addentity_id = nil
+}
+
+// SetAction sets the "action" field.
+func (m *EntityHistoryMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *EntityHistoryMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the EntityHistory entity.
+// If the EntityHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityHistoryMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *EntityHistoryMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *EntityHistoryMutation) SetChanges(ec []entgo.FieldChange) {
+	m.changes = &ec
+	m.appendchanges = nil
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *EntityHistoryMutation) Changes() (r []entgo.FieldChange, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the EntityHistory entity.
+// If the EntityHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityHistoryMutation) OldChanges(ctx context.Context) (v []entgo.FieldChange, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// AppendChanges adds ec to the "changes" field.
+func (m *EntityHistoryMutation) AppendChanges(ec []entgo.FieldChange) {
+	m.appendchanges = append(m.appendchanges, ec...)
+}
+
+// AppendedChanges returns the list of values that were appended to the "changes" field in this mutation.
+func (m *EntityHistoryMutation) AppendedChanges() ([]entgo.FieldChange, bool) {
+	if len(m.appendchanges) == 0 {
+		return nil, false
+	}
+	return m.appendchanges, true
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (m *EntityHistoryMutation) ClearChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	m.clearedFields[entityhistory.FieldChanges] = struct{}{}
+}
+
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *EntityHistoryMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[entityhistory.FieldChanges]
+	return ok
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *EntityHistoryMutation) ResetChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	delete(m.clearedFields, entityhistory.FieldChanges)
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (m *EntityHistoryMutation) SetSnapshot(value map[string]interface{}) {
+	m.snapshot = &value
+}
+
+// Snapshot returns the value of the "snapshot" field in the mutation.
+func (m *EntityHistoryMutation) Snapshot() (r map[string]interface{}, exists bool) {
+	v := m.snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnapshot returns the old "snapshot" field's value of the EntityHistory entity.
+// If the EntityHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityHistoryMutation) OldSnapshot(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshot: %w", err)
+	}
+	return oldValue.Snapshot, nil
+}
+
+// ClearSnapshot clears the value of the "snapshot" field.
+func (m *EntityHistoryMutation) ClearSnapshot() {
+	m.snapshot = nil
+	m.clearedFields[entityhistory.FieldSnapshot] = struct{}{}
+}
+
+// SnapshotCleared returns if the "snapshot" field was cleared in this mutation.
+func (m *EntityHistoryMutation) SnapshotCleared() bool {
+	_, ok := m.clearedFields[entityhistory.FieldSnapshot]
+	return ok
+}
+
+// ResetSnapshot resets all changes to the "snapshot" field.
+func (m *EntityHistoryMutation) ResetSnapshot() {
+	m.snapshot = nil
+	delete(m.clearedFields, entityhistory.FieldSnapshot)
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (m *EntityHistoryMutation) SetOperatorID(u uint32) {
+	m.operator_id = &u
+	m.addoperator_id = nil
+}
+
+// OperatorID returns the value of the "operator_id" field in the mutation.
+func (m *EntityHistoryMutation) OperatorID() (r uint32, exists bool) {
+	v := m.operator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperatorID returns the old "operator_id" field's value of the EntityHistory entity.
+// If the EntityHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityHistoryMutation) OldOperatorID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperatorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperatorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperatorID: %w", err)
+	}
+	return oldValue.OperatorID, nil
+}
+
+// AddOperatorID adds u to the "operator_id" field.
+func (m *EntityHistoryMutation) AddOperatorID(u int32) {
+	if m.addoperator_id != nil {
+		*m.addoperator_id += u
+	} else {
+		m.addoperator_id = &u
+	}
+}
+
+// AddedOperatorID returns the value that was added to the "operator_id" field in this mutation.
+func (m *EntityHistoryMutation) AddedOperatorID() (r int32, exists bool) {
+	v := m.addoperator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (m *EntityHistoryMutation) ClearOperatorID() {
+	m.operator_id = nil
+	m.addoperator_id = nil
+	m.clearedFields[entityhistory.FieldOperatorID] = struct{}{}
+}
+
+// OperatorIDCleared returns if the "operator_id" field was cleared in this mutation.
+func (m *EntityHistoryMutation) OperatorIDCleared() bool {
+	_, ok := m.clearedFields[entityhistory.FieldOperatorID]
+	return ok
+}
+
+// ResetOperatorID resets all changes to the "operator_id" field.
+func (m *EntityHistoryMutation) ResetOperatorID() {
+	m.operator_id = nil
+	m.addoperator_id = nil
+	delete(m.clearedFields, entityhistory.FieldOperatorID)
+}
+
+// Where appends a list predicates to the EntityHistoryMutation builder.
+func (m *EntityHistoryMutation) Where(ps ...predicate.EntityHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EntityHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EntityHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EntityHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EntityHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EntityHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EntityHistory).
+func (m *EntityHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EntityHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, entityhistory.FieldCreatedAt)
+	}
+	if m.resource != nil {
+		fields = append(fields, entityhistory.FieldResource)
+	}
+	if m.entity_id != nil {
+		fields = append(fields, entityhistory.FieldEntityID)
+	}
+	if m.action != nil {
+		fields = append(fields, entityhistory.FieldAction)
+	}
+	if m.changes != nil {
+		fields = append(fields, entityhistory.FieldChanges)
+	}
+	if m.snapshot != nil {
+		fields = append(fields, entityhistory.FieldSnapshot)
+	}
+	if m.operator_id != nil {
+		fields = append(fields, entityhistory.FieldOperatorID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EntityHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case entityhistory.FieldCreatedAt:
+		return m.CreatedAt()
+	case entityhistory.FieldResource:
+		return m.Resource()
+	case entityhistory.FieldEntityID:
+		return m.EntityID()
+	case entityhistory.FieldAction:
+		return m.Action()
+	case entityhistory.FieldChanges:
+		return m.Changes()
+	case entityhistory.FieldSnapshot:
+		return m.Snapshot()
+	case entityhistory.FieldOperatorID:
+		return m.OperatorID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EntityHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case entityhistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case entityhistory.FieldResource:
+		return m.OldResource(ctx)
+	case entityhistory.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case entityhistory.FieldAction:
+		return m.OldAction(ctx)
+	case entityhistory.FieldChanges:
+		return m.OldChanges(ctx)
+	case entityhistory.FieldSnapshot:
+		return m.OldSnapshot(ctx)
+	case entityhistory.FieldOperatorID:
+		return m.OldOperatorID(ctx)
+	}
+	return nil, fmt.Errorf("unknown EntityHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case entityhistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case entityhistory.FieldResource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResource(v)
+		return nil
+	case entityhistory.FieldEntityID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case entityhistory.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case entityhistory.FieldChanges:
+		v, ok := value.([]entgo.FieldChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case entityhistory.FieldSnapshot:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnapshot(v)
+		return nil
+	case entityhistory.FieldOperatorID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperatorID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EntityHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EntityHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addentity_id != nil {
+		fields = append(fields, entityhistory.FieldEntityID)
+	}
+	if m.addoperator_id != nil {
+		fields = append(fields, entityhistory.FieldOperatorID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EntityHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case entityhistory.FieldEntityID:
+		return m.AddedEntityID()
+	case entityhistory.FieldOperatorID:
+		return m.AddedOperatorID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case entityhistory.FieldEntityID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntityID(v)
+		return nil
+	case entityhistory.FieldOperatorID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOperatorID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EntityHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EntityHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(entityhistory.FieldChanges) {
+		fields = append(fields, entityhistory.FieldChanges)
+	}
+	if m.FieldCleared(entityhistory.FieldSnapshot) {
+		fields = append(fields, entityhistory.FieldSnapshot)
+	}
+	if m.FieldCleared(entityhistory.FieldOperatorID) {
+		fields = append(fields, entityhistory.FieldOperatorID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EntityHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EntityHistoryMutation) ClearField(name string) error {
+	switch name {
+	case entityhistory.FieldChanges:
+		m.ClearChanges()
+		return nil
+	case entityhistory.FieldSnapshot:
+		m.ClearSnapshot()
+		return nil
+	case entityhistory.FieldOperatorID:
+		m.ClearOperatorID()
+		return nil
+	}
+	return fmt.Errorf("unknown EntityHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EntityHistoryMutation) ResetField(name string) error {
+	switch name {
+	case entityhistory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case entityhistory.FieldResource:
+		m.ResetResource()
+		return nil
+	case entityhistory.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case entityhistory.FieldAction:
+		m.ResetAction()
+		return nil
+	case entityhistory.FieldChanges:
+		m.ResetChanges()
+		return nil
+	case entityhistory.FieldSnapshot:
+		m.ResetSnapshot()
+		return nil
+	case entityhistory.FieldOperatorID:
+		m.ResetOperatorID()
+		return nil
+	}
+	return fmt.Errorf("unknown EntityHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EntityHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EntityHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EntityHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EntityHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EntityHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EntityHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EntityHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EntityHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EntityHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EntityHistory edge %s", name)
+}
+
+// ExportFileMutation represents an operation that mutates the ExportFile nodes in the graph.
+type ExportFileMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint64
+	created_at    *time.Time
+	job_id        *string
+	seq           *int
+	addseq        *int
+	data          *[]byte
+	size          *int
+	addsize       *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExportFile, error)
+	predicates    []predicate.ExportFile
+}
+
+var _ ent.Mutation = (*ExportFileMutation)(nil)
+
+// exportfileOption allows management of the mutation configuration using functional options.
+type exportfileOption func(*ExportFileMutation)
+
+// newExportFileMutation creates new mutation for the ExportFile entity.
+func newExportFileMutation(c config, op Op, opts ...exportfileOption) *ExportFileMutation {
+	m := &ExportFileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExportFile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExportFileID sets the ID field of the mutation.
+func withExportFileID(id uint64) exportfileOption {
+	return func(m *ExportFileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExportFile
+		)
+		m.oldValue = func(ctx context.Context) (*ExportFile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExportFile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExportFile sets the old ExportFile of the mutation.
+func withExportFile(node *ExportFile) exportfileOption {
+	return func(m *ExportFileMutation) {
+		m.oldValue = func(context.Context) (*ExportFile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExportFileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExportFileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("gen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExportFile entities.
+func (m *ExportFileMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExportFileMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExportFileMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExportFile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExportFileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExportFileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ExportFile entity.
+// If the ExportFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportFileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExportFileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetJobID sets the "job_id" field.
+func (m *ExportFileMutation) SetJobID(s string) {
+	m.job_id = &s
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *ExportFileMutation) JobID() (r string, exists bool) {
+	v := m.job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the ExportFile entity.
+// If the ExportFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportFileMutation) OldJobID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *ExportFileMutation) ResetJobID() {
+	m.job_id = nil
+}
+
+// SetSeq sets the "seq" field.
+func (m *ExportFileMutation) SetSeq(i int) {
+	m.seq = &i
+	m.addseq = nil
+}
+
+// Seq returns the value of the "seq" field in the mutation.
+func (m *ExportFileMutation) Seq() (r int, exists bool) {
+	v := m.seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeq returns the old "seq" field's value of the ExportFile entity.
+// If the ExportFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportFileMutation) OldSeq(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
+	}
+	return oldValue.Seq, nil
+}
+
+// AddSeq adds i to the "seq" field.
+func (m *ExportFileMutation) AddSeq(i int) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
+}
+
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *ExportFileMutation) AddedSeq() (r int, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeq resets all changes to the "seq" field.
+func (m *ExportFileMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
+}
+
+// SetData sets the "data" field.
+func (m *ExportFileMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *ExportFileMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the ExportFile entity.
+// If the ExportFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportFileMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *ExportFileMutation) ResetData() {
+	m.data = nil
+}
+
+// SetSize sets the "size" field.
+func (m *ExportFileMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *ExportFileMutation) Size() (r int, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the ExportFile entity.
+// If the ExportFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportFileMutation) OldSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *ExportFileMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *ExportFileMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *ExportFileMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// Where appends a list predicates to the ExportFileMutation builder.
+func (m *ExportFileMutation) Where(ps ...predicate.ExportFile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExportFileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExportFileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExportFile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExportFileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExportFileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExportFile).
+func (m *ExportFileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExportFileMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, exportfile.FieldCreatedAt)
+	}
+	if m.job_id != nil {
+		fields = append(fields, exportfile.FieldJobID)
+	}
+	if m.seq != nil {
+		fields = append(fields, exportfile.FieldSeq)
+	}
+	if m.data != nil {
+		fields = append(fields, exportfile.FieldData)
+	}
+	if m.size != nil {
+		fields = append(fields, exportfile.FieldSize)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExportFileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exportfile.FieldCreatedAt:
+		return m.CreatedAt()
+	case exportfile.FieldJobID:
+		return m.JobID()
+	case exportfile.FieldSeq:
+		return m.Seq()
+	case exportfile.FieldData:
+		return m.Data()
+	case exportfile.FieldSize:
+		return m.Size()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExportFileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exportfile.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case exportfile.FieldJobID:
+		return m.OldJobID(ctx)
+	case exportfile.FieldSeq:
+		return m.OldSeq(ctx)
+	case exportfile.FieldData:
+		return m.OldData(ctx)
+	case exportfile.FieldSize:
+		return m.OldSize(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExportFile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExportFileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exportfile.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case exportfile.FieldJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case exportfile.FieldSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeq(v)
+		return nil
+	case exportfile.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case exportfile.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExportFile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExportFileMutation) AddedFields() []string {
+	var fields []string
+	if m.addseq != nil {
+		fields = append(fields, exportfile.FieldSeq)
+	}
+	if m.addsize != nil {
+		fields = append(fields, exportfile.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExportFileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case exportfile.FieldSeq:
+		return m.AddedSeq()
+	case exportfile.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExportFileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case exportfile.FieldSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeq(v)
+		return nil
+	case exportfile.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExportFile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExportFileMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExportFileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExportFileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExportFile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExportFileMutation) ResetField(name string) error {
+	switch name {
+	case exportfile.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case exportfile.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case exportfile.FieldSeq:
+		m.ResetSeq()
+		return nil
+	case exportfile.FieldData:
+		m.ResetData()
+		return nil
+	case exportfile.FieldSize:
+		m.ResetSize()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportFile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExportFileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExportFileMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExportFileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExportFileMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExportFileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExportFileMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExportFileMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExportFile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExportFileMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExportFile edge %s", name)
+}
+
+// MenuMutation represents an operation that mutates the Menu nodes in the graph.
+type MenuMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uint32
+	status                 *int32
+	addstatus              *int32
+	created_at             *time.Time
+	updated_at             *time.Time
+	version                *uint32
+	addversion             *int32
+	created_by             *uint32
+	addcreated_by          *int32
+	updated_by             *uint32
+	addupdated_by          *int32
+	deleted_by             *uint32
+	adddeleted_by          *int32
+	deleted_at             *time.Time
+	name                   *string
+	_path                  *string
+	_type                  *int32
+	add_type               *int32
+	component              *string
+	redirect               *string
+	auth_code              *string
+	active_icon            *string
+	active_path            *string
+	affix_tab              *bool
+	affix_tab_order        *int32
+	addaffix_tab_order     *int32
+	badge                  *string
+	badge_type             *int32
+	addbadge_type          *int32
+	badge_variants         *int32
+	addbadge_variants      *int32
+	hide_children_in_menu  *bool
+	hide_in_breadcrumb     *bool
+	hide_in_menu           *bool
+	hide_in_tab            *bool
+	icon                   *string
+	iframe_src             *string
+	keep_alive             *bool
+	link                   *string
+	max_num_of_open_tab    *int32
+	addmax_num_of_open_tab *int32
+	no_basic_layout        *bool
+	open_in_new_window     *bool
+	sort                   *int32
+	addsort                *int32
+	query                  *string
+	title                  *string
+	clearedFields          map[string]struct{}
+	parent                 *uint32
+	clearedparent          bool
+	children               map[uint32]struct{}
+	removedchildren        map[uint32]struct{}
+	clearedchildren        bool
+	done                   bool
+	oldValue               func(context.Context) (*Menu, error)
+	predicates             []predicate.Menu
+}
+
+var _ ent.Mutation = (*MenuMutation)(nil)
+
+// menuOption allows management of the mutation configuration using functional options.
+type menuOption func(*MenuMutation)
+
+// newMenuMutation creates new mutation for the Menu entity.
+func newMenuMutation(c config, op Op, opts ...menuOption) *MenuMutation {
+	m := &MenuMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMenu,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMenuID sets the ID field of the mutation.
+func withMenuID(id uint32) menuOption {
+	return func(m *MenuMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Menu
+		)
+		m.oldValue = func(ctx context.Context) (*Menu, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Menu.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMenu sets the old Menu of the mutation.
+func withMenu(node *Menu) menuOption {
+	return func(m *MenuMutation) {
+		m.oldValue = func(context.Context) (*Menu, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MenuMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MenuMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("gen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Menu entities.
+func (m *MenuMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MenuMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MenuMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Menu.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *MenuMutation) SetStatus(i int32) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *MenuMutation) Status() (r int32, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldStatus(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *MenuMutation) AddStatus(i int32) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *MenuMutation) AddedStatus() (r int32, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *MenuMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MenuMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MenuMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MenuMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MenuMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MenuMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MenuMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *MenuMutation) SetVersion(u uint32) {
+	m.version = &u
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *MenuMutation) Version() (r uint32, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldVersion(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds u to the "version" field.
+func (m *MenuMutation) AddVersion(u int32) {
+	if m.addversion != nil {
+		*m.addversion += u
+	} else {
+		m.addversion = &u
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *MenuMutation) AddedVersion() (r int32, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *MenuMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *MenuMutation) SetCreatedBy(u uint32) {
+	m.created_by = &u
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *MenuMutation) CreatedBy() (r uint32, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldCreatedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// AddCreatedBy adds u to the "created_by" field.
+func (m *MenuMutation) AddCreatedBy(u int32) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += u
+	} else {
+		m.addcreated_by = &u
+	}
+}
+
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *MenuMutation) AddedCreatedBy() (r int32, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *MenuMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[menu.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *MenuMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[menu.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *MenuMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, menu.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *MenuMutation) SetUpdatedBy(u uint32) {
+	m.updated_by = &u
+	m.addupdated_by = nil
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *MenuMutation) UpdatedBy() (r uint32, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldUpdatedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// AddUpdatedBy adds u to the "updated_by" field.
+func (m *MenuMutation) AddUpdatedBy(u int32) {
+	if m.addupdated_by != nil {
+		*m.addupdated_by += u
+	} else {
+		m.addupdated_by = &u
+	}
+}
+
+// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
+func (m *MenuMutation) AddedUpdatedBy() (r int32, exists bool) {
+	v := m.addupdated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *MenuMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	m.clearedFields[menu.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *MenuMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[menu.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *MenuMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	delete(m.clearedFields, menu.FieldUpdatedBy)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *MenuMutation) SetDeletedBy(u uint32) {
+	m.deleted_by = &u
+	m.adddeleted_by = nil
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *MenuMutation) DeletedBy() (r uint32, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldDeletedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// AddDeletedBy adds u to the "deleted_by" field.
+func (m *MenuMutation) AddDeletedBy(u int32) {
+	if m.adddeleted_by != nil {
+		*m.adddeleted_by += u
+	} else {
+		m.adddeleted_by = &u
+	}
+}
+
+// AddedDeletedBy returns the value that was added to the "deleted_by" field in this mutation.
+func (m *MenuMutation) AddedDeletedBy() (r int32, exists bool) {
+	v := m.adddeleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *MenuMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.adddeleted_by = nil
+	m.clearedFields[menu.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *MenuMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[menu.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *MenuMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	m.adddeleted_by = nil
+	delete(m.clearedFields, menu.FieldDeletedBy)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *MenuMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *MenuMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *MenuMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[menu.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *MenuMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[menu.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *MenuMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, menu.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *MenuMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MenuMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MenuMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPath sets the "path" field.
+func (m *MenuMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *MenuMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldPath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *MenuMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[menu.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *MenuMutation) PathCleared() bool {
+	_, ok := m.clearedFields[menu.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *MenuMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, menu.FieldPath)
+}
+
+// SetType sets the "type" field.
+func (m *MenuMutation) SetType(i int32) {
+	m._type = &i
+	m.add_type = nil
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *MenuMutation) GetType() (r int32, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldType(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// AddType adds i to the "type" field.
+func (m *MenuMutation) AddType(i int32) {
+	if m.add_type != nil {
+		*m.add_type += i
+	} else {
+		m.add_type = &i
+	}
+}
+
+// AddedType returns the value that was added to the "type" field in this mutation.
+func (m *MenuMutation) AddedType() (r int32, exists bool) {
+	v := m.add_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearType clears the value of the "type" field.
+func (m *MenuMutation) ClearType() {
+	m._type = nil
+	m.add_type = nil
+	m.clearedFields[menu.FieldType] = struct{}{}
+}
+
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *MenuMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[menu.FieldType]
+	return ok
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *MenuMutation) ResetType() {
+	m._type = nil
+	m.add_type = nil
+	delete(m.clearedFields, menu.FieldType)
+}
+
+// SetComponent sets the "component" field.
+func (m *MenuMutation) SetComponent(s string) {
+	m.component = &s
+}
+
+// Component returns the value of the "component" field in the mutation.
+func (m *MenuMutation) Component() (r string, exists bool) {
+	v := m.component
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComponent returns the old "component" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldComponent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComponent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComponent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComponent: %w", err)
+	}
+	return oldValue.Component, nil
+}
+
+// ClearComponent clears the value of the "component" field.
+func (m *MenuMutation) ClearComponent() {
+	m.component = nil
+	m.clearedFields[menu.FieldComponent] = struct{}{}
+}
+
+// ComponentCleared returns if the "component" field was cleared in this mutation.
+func (m *MenuMutation) ComponentCleared() bool {
+	_, ok := m.clearedFields[menu.FieldComponent]
+	return ok
+}
+
+// ResetComponent resets all changes to the "component" field.
+func (m *MenuMutation) ResetComponent() {
+	m.component = nil
+	delete(m.clearedFields, menu.FieldComponent)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *MenuMutation) SetParentID(u uint32) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *MenuMutation) ParentID() (r uint32, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldParentID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *MenuMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[menu.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *MenuMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[menu.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *MenuMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, menu.FieldParentID)
+}
+
+// SetRedirect sets the "redirect" field.
+func (m *MenuMutation) SetRedirect(s string) {
+	m.redirect = &s
+}
+
+// Redirect returns the value of the "redirect" field in the mutation.
+func (m *MenuMutation) Redirect() (r string, exists bool) {
+	v := m.redirect
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedirect returns the old "redirect" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldRedirect(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedirect is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedirect requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedirect: %w", err)
+	}
+	return oldValue.Redirect, nil
+}
+
+// ClearRedirect clears the value of the "redirect" field.
+func (m *MenuMutation) ClearRedirect() {
+	m.redirect = nil
+	m.clearedFields[menu.FieldRedirect] = struct{}{}
+}
+
+// RedirectCleared returns if the "redirect" field was cleared in this mutation.
+func (m *MenuMutation) RedirectCleared() bool {
+	_, ok := m.clearedFields[menu.FieldRedirect]
+	return ok
+}
+
+// ResetRedirect resets all changes to the "redirect" field.
+func (m *MenuMutation) ResetRedirect() {
+	m.redirect = nil
+	delete(m.clearedFields, menu.FieldRedirect)
+}
+
+// SetAuthCode sets the "auth_code" field.
+func (m *MenuMutation) SetAuthCode(s string) {
+	m.auth_code = &s
+}
+
+// AuthCode returns the value of the "auth_code" field in the mutation.
+func (m *MenuMutation) AuthCode() (r string, exists bool) {
+	v := m.auth_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthCode returns the old "auth_code" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldAuthCode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthCode: %w", err)
+	}
+	return oldValue.AuthCode, nil
+}
+
+// ResetAuthCode resets all changes to the "auth_code" field.
+func (m *MenuMutation) ResetAuthCode() {
+	m.auth_code = nil
+}
+
+// SetActiveIcon sets the "active_icon" field.
+func (m *MenuMutation) SetActiveIcon(s string) {
+	m.active_icon = &s
+}
+
+// ActiveIcon returns the value of the "active_icon" field in the mutation.
+func (m *MenuMutation) ActiveIcon() (r string, exists bool) {
+	v := m.active_icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActiveIcon returns the old "active_icon" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldActiveIcon(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActiveIcon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActiveIcon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActiveIcon: %w", err)
+	}
+	return oldValue.ActiveIcon, nil
+}
+
+// ResetActiveIcon resets all changes to the "active_icon" field.
+func (m *MenuMutation) ResetActiveIcon() {
+	m.active_icon = nil
+}
+
+// SetActivePath sets the "active_path" field.
+func (m *MenuMutation) SetActivePath(s string) {
+	m.active_path = &s
+}
+
+// ActivePath returns the value of the "active_path" field in the mutation.
+func (m *MenuMutation) ActivePath() (r string, exists bool) {
+	v := m.active_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivePath returns the old "active_path" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldActivePath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivePath: %w", err)
+	}
+	return oldValue.ActivePath, nil
+}
+
+// ResetActivePath resets all changes to the "active_path" field.
+func (m *MenuMutation) ResetActivePath() {
+	m.active_path = nil
+}
+
+// SetAffixTab sets the "affix_tab" field.
+func (m *MenuMutation) SetAffixTab(b bool) {
+	m.affix_tab = &b
+}
+
+// AffixTab returns the value of the "affix_tab" field in the mutation.
+func (m *MenuMutation) AffixTab() (r bool, exists bool) {
+	v := m.affix_tab
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAffixTab returns the old "affix_tab" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldAffixTab(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAffixTab is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAffixTab requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAffixTab: %w", err)
+	}
+	return oldValue.AffixTab, nil
+}
+
+// ResetAffixTab resets all changes to the "affix_tab" field.
+func (m *MenuMutation) ResetAffixTab() {
+	m.affix_tab = nil
+}
+
+// SetAffixTabOrder sets the "affix_tab_order" field.
+func (m *MenuMutation) SetAffixTabOrder(i int32) {
+	m.affix_tab_order = &i
+	m.addaffix_tab_order = nil
+}
+
+// AffixTabOrder returns the value of the "affix_tab_order" field in the mutation.
+func (m *MenuMutation) AffixTabOrder() (r int32, exists bool) {
+	v := m.affix_tab_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAffixTabOrder returns the old "affix_tab_order" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldAffixTabOrder(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAffixTabOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAffixTabOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAffixTabOrder: %w", err)
+	}
+	return oldValue.AffixTabOrder, nil
+}
+
+// AddAffixTabOrder adds i to the "affix_tab_order" field.
+func (m *MenuMutation) AddAffixTabOrder(i int32) {
+	if m.addaffix_tab_order != nil {
+		*m.addaffix_tab_order += i
+	} else {
+		m.addaffix_tab_order = &i
+	}
+}
+
+// AddedAffixTabOrder returns the value that was added to the "affix_tab_order" field in this mutation.
+func (m *MenuMutation) AddedAffixTabOrder() (r int32, exists bool) {
+	v := m.addaffix_tab_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAffixTabOrder resets all changes to the "affix_tab_order" field.
+func (m *MenuMutation) ResetAffixTabOrder() {
+	m.affix_tab_order = nil
+	m.addaffix_tab_order = nil
+}
+
+// SetBadge sets the "badge" field.
+func (m *MenuMutation) SetBadge(s string) {
+	m.badge = &s
+}
+
+// Badge returns the value of the "badge" field in the mutation.
+func (m *MenuMutation) Badge() (r string, exists bool) {
+	v := m.badge
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBadge returns the old "badge" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldBadge(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBadge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBadge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBadge: %w", err)
+	}
+	return oldValue.Badge, nil
+}
+
+// ResetBadge resets all changes to the "badge" field.
+func (m *MenuMutation) ResetBadge() {
+	m.badge = nil
+}
+
+// SetBadgeType sets the "badge_type" field.
+func (m *MenuMutation) SetBadgeType(i int32) {
+	m.badge_type = &i
+	m.addbadge_type = nil
+}
+
+// BadgeType returns the value of the "badge_type" field in the mutation.
+func (m *MenuMutation) BadgeType() (r int32, exists bool) {
+	v := m.badge_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBadgeType returns the old "badge_type" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldBadgeType(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBadgeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBadgeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBadgeType: %w", err)
+	}
+	return oldValue.BadgeType, nil
+}
+
+// AddBadgeType adds i to the "badge_type" field.
+func (m *MenuMutation) AddBadgeType(i int32) {
+	if m.addbadge_type != nil {
+		*m.addbadge_type += i
+	} else {
+		m.addbadge_type = &i
+	}
+}
+
+// AddedBadgeType returns the value that was added to the "badge_type" field in this mutation.
+func (m *MenuMutation) AddedBadgeType() (r int32, exists bool) {
+	v := m.addbadge_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBadgeType resets all changes to the "badge_type" field.
+func (m *MenuMutation) ResetBadgeType() {
+	m.badge_type = nil
+	m.addbadge_type = nil
+}
+
+// SetBadgeVariants sets the "badge_variants" field.
+func (m *MenuMutation) SetBadgeVariants(i int32) {
+	m.badge_variants = &i
+	m.addbadge_variants = nil
+}
+
+// BadgeVariants returns the value of the "badge_variants" field in the mutation.
+func (m *MenuMutation) BadgeVariants() (r int32, exists bool) {
+	v := m.badge_variants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBadgeVariants returns the old "badge_variants" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldBadgeVariants(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBadgeVariants is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBadgeVariants requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBadgeVariants: %w", err)
+	}
+	return oldValue.BadgeVariants, nil
+}
+
+// AddBadgeVariants adds i to the "badge_variants" field.
+func (m *MenuMutation) AddBadgeVariants(i int32) {
+	if m.addbadge_variants != nil {
+		*m.addbadge_variants += i
+	} else {
+		m.addbadge_variants = &i
+	}
+}
+
+// AddedBadgeVariants returns the value that was added to the "badge_variants" field in this mutation.
+func (m *MenuMutation) AddedBadgeVariants() (r int32, exists bool) {
+	v := m.addbadge_variants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBadgeVariants resets all changes to the "badge_variants" field.
+func (m *MenuMutation) ResetBadgeVariants() {
+	m.badge_variants = nil
+	m.addbadge_variants = nil
+}
+
+// SetHideChildrenInMenu sets the "hide_children_in_menu" field.
+func (m *MenuMutation) SetHideChildrenInMenu(b bool) {
+	m.hide_children_in_menu = &b
+}
+
+// HideChildrenInMenu returns the value of the "hide_children_in_menu" field in the mutation.
+func (m *MenuMutation) HideChildrenInMenu() (r bool, exists bool) {
+	v := m.hide_children_in_menu
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHideChildrenInMenu returns the old "hide_children_in_menu" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldHideChildrenInMenu(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideChildrenInMenu is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideChildrenInMenu requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideChildrenInMenu: %w", err)
+	}
+	return oldValue.HideChildrenInMenu, nil
+}
+
+// ResetHideChildrenInMenu resets all changes to the "hide_children_in_menu" field.
+func (m *MenuMutation) ResetHideChildrenInMenu() {
+	m.hide_children_in_menu = nil
+}
+
+// SetHideInBreadcrumb sets the "hide_in_breadcrumb" field.
+func (m *MenuMutation) SetHideInBreadcrumb(b bool) {
+	m.hide_in_breadcrumb = &b
+}
+
+// HideInBreadcrumb returns the value of the "hide_in_breadcrumb" field in the mutation.
+func (m *MenuMutation) HideInBreadcrumb() (r bool, exists bool) {
+	v := m.hide_in_breadcrumb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHideInBreadcrumb returns the old "hide_in_breadcrumb" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldHideInBreadcrumb(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideInBreadcrumb is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideInBreadcrumb requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideInBreadcrumb: %w", err)
+	}
+	return oldValue.HideInBreadcrumb, nil
+}
+
+// ResetHideInBreadcrumb resets all changes to the "hide_in_breadcrumb" field.
+func (m *MenuMutation) ResetHideInBreadcrumb() {
+	m.hide_in_breadcrumb = nil
+}
+
+// SetHideInMenu sets the "hide_in_menu" field.
+func (m *MenuMutation) SetHideInMenu(b bool) {
+	m.hide_in_menu = &b
+}
+
+// HideInMenu returns the value of the "hide_in_menu" field in the mutation.
+func (m *MenuMutation) HideInMenu() (r bool, exists bool) {
+	v := m.hide_in_menu
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHideInMenu returns the old "hide_in_menu" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldHideInMenu(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideInMenu is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideInMenu requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideInMenu: %w", err)
+	}
+	return oldValue.HideInMenu, nil
+}
+
+// ResetHideInMenu resets all changes to the "hide_in_menu" field.
+func (m *MenuMutation) ResetHideInMenu() {
+	m.hide_in_menu = nil
+}
+
+// SetHideInTab sets the "hide_in_tab" field.
+func (m *MenuMutation) SetHideInTab(b bool) {
+	m.hide_in_tab = &b
+}
+
+// HideInTab returns the value of the "hide_in_tab" field in the mutation.
+func (m *MenuMutation) HideInTab() (r bool, exists bool) {
+	v := m.hide_in_tab
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHideInTab returns the old "hide_in_tab" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldHideInTab(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideInTab is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideInTab requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideInTab: %w", err)
+	}
+	return oldValue.HideInTab, nil
+}
+
+// ResetHideInTab resets all changes to the "hide_in_tab" field.
+func (m *MenuMutation) ResetHideInTab() {
+	m.hide_in_tab = nil
+}
+
+// SetIcon sets the "icon" field.
+func (m *MenuMutation) SetIcon(s string) {
+	m.icon = &s
+}
+
+// Icon returns the value of the "icon" field in the mutation.
+func (m *MenuMutation) Icon() (r string, exists bool) {
+	v := m.icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIcon returns the old "icon" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldIcon(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIcon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+	}
+	return oldValue.Icon, nil
+}
+
+// ResetIcon resets all changes to the "icon" field.
+func (m *MenuMutation) ResetIcon() {
+	m.icon = nil
+}
+
+// SetIframeSrc sets the "iframe_src" field.
+func (m *MenuMutation) SetIframeSrc(s string) {
+	m.iframe_src = &s
+}
+
+// IframeSrc returns the value of the "iframe_src" field in the mutation.
+func (m *MenuMutation) IframeSrc() (r string, exists bool) {
+	v := m.iframe_src
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIframeSrc returns the old "iframe_src" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldIframeSrc(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIframeSrc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIframeSrc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIframeSrc: %w", err)
+	}
+	return oldValue.IframeSrc, nil
+}
+
+// ResetIframeSrc resets all changes to the "iframe_src" field.
+func (m *MenuMutation) ResetIframeSrc() {
+	m.iframe_src = nil
+}
+
+// SetKeepAlive sets the "keep_alive" field.
+func (m *MenuMutation) SetKeepAlive(b bool) {
+	m.keep_alive = &b
+}
+
+// KeepAlive returns the value of the "keep_alive" field in the mutation.
+func (m *MenuMutation) KeepAlive() (r bool, exists bool) {
+	v := m.keep_alive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepAlive returns the old "keep_alive" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldKeepAlive(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepAlive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepAlive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepAlive: %w", err)
+	}
+	return oldValue.KeepAlive, nil
+}
+
+// ResetKeepAlive resets all changes to the "keep_alive" field.
+func (m *MenuMutation) ResetKeepAlive() {
+	m.keep_alive = nil
+}
+
+// SetLink sets the "link" field.
+func (m *MenuMutation) SetLink(s string) {
+	m.link = &s
+}
+
+// Link returns the value of the "link" field in the mutation.
+func (m *MenuMutation) Link() (r string, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLink returns the old "link" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldLink(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLink requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+	}
+	return oldValue.Link, nil
+}
+
+// ResetLink resets all changes to the "link" field.
+func (m *MenuMutation) ResetLink() {
+	m.link = nil
+}
+
+// SetMaxNumOfOpenTab sets the "max_num_of_open_tab" field.
+func (m *MenuMutation) SetMaxNumOfOpenTab(i int32) {
+	m.max_num_of_open_tab = &i
+	m.addmax_num_of_open_tab = nil
+}
+
+// MaxNumOfOpenTab returns the value of the "max_num_of_open_tab" field in the mutation.
+func (m *MenuMutation) MaxNumOfOpenTab() (r int32, exists bool) {
+	v := m.max_num_of_open_tab
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxNumOfOpenTab returns the old "max_num_of_open_tab" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldMaxNumOfOpenTab(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxNumOfOpenTab is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxNumOfOpenTab requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxNumOfOpenTab: %w", err)
+	}
+	return oldValue.MaxNumOfOpenTab, nil
+}
+
+// AddMaxNumOfOpenTab adds i to the "max_num_of_open_tab" field.
+func (m *MenuMutation) AddMaxNumOfOpenTab(i int32) {
+	if m.addmax_num_of_open_tab != nil {
+		*m.addmax_num_of_open_tab += i
+	} else {
+		m.addmax_num_of_open_tab = &i
+	}
+}
+
+// AddedMaxNumOfOpenTab returns the value that was added to the "max_num_of_open_tab" field in this mutation.
+func (m *MenuMutation) AddedMaxNumOfOpenTab() (r int32, exists bool) {
+	v := m.addmax_num_of_open_tab
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxNumOfOpenTab resets all changes to the "max_num_of_open_tab" field.
+func (m *MenuMutation) ResetMaxNumOfOpenTab() {
+	m.max_num_of_open_tab = nil
+	m.addmax_num_of_open_tab = nil
+}
+
+// SetNoBasicLayout sets the "no_basic_layout" field.
+func (m *MenuMutation) SetNoBasicLayout(b bool) {
+	m.no_basic_layout = &b
+}
+
+// NoBasicLayout returns the value of the "no_basic_layout" field in the mutation.
+func (m *MenuMutation) NoBasicLayout() (r bool, exists bool) {
+	v := m.no_basic_layout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNoBasicLayout returns the old "no_basic_layout" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldNoBasicLayout(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNoBasicLayout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNoBasicLayout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNoBasicLayout: %w", err)
+	}
+	return oldValue.NoBasicLayout, nil
+}
+
+// ResetNoBasicLayout resets all changes to the "no_basic_layout" field.
+func (m *MenuMutation) ResetNoBasicLayout() {
+	m.no_basic_layout = nil
+}
+
+// SetOpenInNewWindow sets the "open_in_new_window" field.
+func (m *MenuMutation) SetOpenInNewWindow(b bool) {
+	m.open_in_new_window = &b
+}
+
+// OpenInNewWindow returns the value of the "open_in_new_window" field in the mutation.
+func (m *MenuMutation) OpenInNewWindow() (r bool, exists bool) {
+	v := m.open_in_new_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenInNewWindow returns the old "open_in_new_window" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldOpenInNewWindow(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenInNewWindow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenInNewWindow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenInNewWindow: %w", err)
+	}
+	return oldValue.OpenInNewWindow, nil
+}
+
+// ResetOpenInNewWindow resets all changes to the "open_in_new_window" field.
+func (m *MenuMutation) ResetOpenInNewWindow() {
+	m.open_in_new_window = nil
+}
+
+// SetSort sets the "sort" field.
+func (m *MenuMutation) SetSort(i int32) {
+	m.sort = &i
+	m.addsort = nil
+}
+
+// Sort returns the value of the "sort" field in the mutation.
+func (m *MenuMutation) Sort() (r int32, exists bool) {
+	v := m.sort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSort returns the old "sort" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldSort(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+	}
+	return oldValue.Sort, nil
+}
+
+// AddSort adds i to the "sort" field.
+func (m *MenuMutation) AddSort(i int32) {
+	if m.addsort != nil {
+		*m.addsort += i
+	} else {
+		m.addsort = &i
+	}
+}
+
+// AddedSort returns the value that was added to the "sort" field in this mutation.
+func (m *MenuMutation) AddedSort() (r int32, exists bool) {
+	v := m.addsort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSort resets all changes to the "sort" field.
+func (m *MenuMutation) ResetSort() {
+	m.sort = nil
+	m.addsort = nil
+}
+
+// SetQuery sets the "query" field.
+func (m *MenuMutation) SetQuery(s string) {
+	m.query = &s
+}
+
+// Query returns the value of the "query" field in the mutation.
+func (m *MenuMutation) Query() (r string, exists bool) {
+	v := m.query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuery returns the old "query" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldQuery(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+	}
+	return oldValue.Query, nil
+}
+
+// ClearQuery clears the value of the "query" field.
+func (m *MenuMutation) ClearQuery() {
+	m.query = nil
+	m.clearedFields[menu.FieldQuery] = struct{}{}
+}
+
+// QueryCleared returns if the "query" field was cleared in this mutation.
+func (m *MenuMutation) QueryCleared() bool {
+	_, ok := m.clearedFields[menu.FieldQuery]
+	return ok
+}
+
+// ResetQuery resets all changes to the "query" field.
+func (m *MenuMutation) ResetQuery() {
+	m.query = nil
+	delete(m.clearedFields, menu.FieldQuery)
+}
+
+// SetTitle sets the "title" field.
+func (m *MenuMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *MenuMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
-	return *v, true
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *MenuMutation) ResetTitle() {
+	m.title = nil
+}
+
+// ClearParent clears the "parent" edge to the Menu entity.
+func (m *MenuMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[menu.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Menu entity was cleared.
+func (m *MenuMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *MenuMutation) ParentIDs() (ids []uint32) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *MenuMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Menu entity by ids.
+func (m *MenuMutation) AddChildIDs(ids ...uint32) {
+	if m.children == nil {
+		m.children = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Menu entity.
+func (m *MenuMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Menu entity was cleared.
+func (m *MenuMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Menu entity by IDs.
+func (m *MenuMutation) RemoveChildIDs(ids ...uint32) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Menu entity.
+func (m *MenuMutation) RemovedChildrenIDs() (ids []uint32) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *MenuMutation) ChildrenIDs() (ids []uint32) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *MenuMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the MenuMutation builder.
+func (m *MenuMutation) Where(ps ...predicate.Menu) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MenuMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MenuMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Menu, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MenuMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MenuMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Menu).
+func (m *MenuMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MenuMutation) Fields() []string {
+	fields := make([]string, 0, 36)
+	if m.status != nil {
+		fields = append(fields, menu.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, menu.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, menu.FieldUpdatedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, menu.FieldVersion)
+	}
+	if m.created_by != nil {
+		fields = append(fields, menu.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, menu.FieldUpdatedBy)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, menu.FieldDeletedBy)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, menu.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, menu.FieldName)
+	}
+	if m._path != nil {
+		fields = append(fields, menu.FieldPath)
+	}
+	if m._type != nil {
+		fields = append(fields, menu.FieldType)
+	}
+	if m.component != nil {
+		fields = append(fields, menu.FieldComponent)
+	}
+	if m.parent != nil {
+		fields = append(fields, menu.FieldParentID)
+	}
+	if m.redirect != nil {
+		fields = append(fields, menu.FieldRedirect)
+	}
+	if m.auth_code != nil {
+		fields = append(fields, menu.FieldAuthCode)
+	}
+	if m.active_icon != nil {
+		fields = append(fields, menu.FieldActiveIcon)
+	}
+	if m.active_path != nil {
+		fields = append(fields, menu.FieldActivePath)
+	}
+	if m.affix_tab != nil {
+		fields = append(fields, menu.FieldAffixTab)
+	}
+	if m.affix_tab_order != nil {
+		fields = append(fields, menu.FieldAffixTabOrder)
+	}
+	if m.badge != nil {
+		fields = append(fields, menu.FieldBadge)
+	}
+	if m.badge_type != nil {
+		fields = append(fields, menu.FieldBadgeType)
+	}
+	if m.badge_variants != nil {
+		fields = append(fields, menu.FieldBadgeVariants)
+	}
+	if m.hide_children_in_menu != nil {
+		fields = append(fields, menu.FieldHideChildrenInMenu)
+	}
+	if m.hide_in_breadcrumb != nil {
+		fields = append(fields, menu.FieldHideInBreadcrumb)
+	}
+	if m.hide_in_menu != nil {
+		fields = append(fields, menu.FieldHideInMenu)
+	}
+	if m.hide_in_tab != nil {
+		fields = append(fields, menu.FieldHideInTab)
+	}
+	if m.icon != nil {
+		fields = append(fields, menu.FieldIcon)
+	}
+	if m.iframe_src != nil {
+		fields = append(fields, menu.FieldIframeSrc)
+	}
+	if m.keep_alive != nil {
+		fields = append(fields, menu.FieldKeepAlive)
+	}
+	if m.link != nil {
+		fields = append(fields, menu.FieldLink)
+	}
+	if m.max_num_of_open_tab != nil {
+		fields = append(fields, menu.FieldMaxNumOfOpenTab)
+	}
+	if m.no_basic_layout != nil {
+		fields = append(fields, menu.FieldNoBasicLayout)
+	}
+	if m.open_in_new_window != nil {
+		fields = append(fields, menu.FieldOpenInNewWindow)
+	}
+	if m.sort != nil {
+		fields = append(fields, menu.FieldSort)
+	}
+	if m.query != nil {
+		fields = append(fields, menu.FieldQuery)
+	}
+	if m.title != nil {
+		fields = append(fields, menu.FieldTitle)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MenuMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case menu.FieldStatus:
+		return m.Status()
+	case menu.FieldCreatedAt:
+		return m.CreatedAt()
+	case menu.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case menu.FieldVersion:
+		return m.Version()
+	case menu.FieldCreatedBy:
+		return m.CreatedBy()
+	case menu.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case menu.FieldDeletedBy:
+		return m.DeletedBy()
+	case menu.FieldDeletedAt:
+		return m.DeletedAt()
+	case menu.FieldName:
+		return m.Name()
+	case menu.FieldPath:
+		return m.Path()
+	case menu.FieldType:
+		return m.GetType()
+	case menu.FieldComponent:
+		return m.Component()
+	case menu.FieldParentID:
+		return m.ParentID()
+	case menu.FieldRedirect:
+		return m.Redirect()
+	case menu.FieldAuthCode:
+		return m.AuthCode()
+	case menu.FieldActiveIcon:
+		return m.ActiveIcon()
+	case menu.FieldActivePath:
+		return m.ActivePath()
+	case menu.FieldAffixTab:
+		return m.AffixTab()
+	case menu.FieldAffixTabOrder:
+		return m.AffixTabOrder()
+	case menu.FieldBadge:
+		return m.Badge()
+	case menu.FieldBadgeType:
+		return m.BadgeType()
+	case menu.FieldBadgeVariants:
+		return m.BadgeVariants()
+	case menu.FieldHideChildrenInMenu:
+		return m.HideChildrenInMenu()
+	case menu.FieldHideInBreadcrumb:
+		return m.HideInBreadcrumb()
+	case menu.FieldHideInMenu:
+		return m.HideInMenu()
+	case menu.FieldHideInTab:
+		return m.HideInTab()
+	case menu.FieldIcon:
+		return m.Icon()
+	case menu.FieldIframeSrc:
+		return m.IframeSrc()
+	case menu.FieldKeepAlive:
+		return m.KeepAlive()
+	case menu.FieldLink:
+		return m.Link()
+	case menu.FieldMaxNumOfOpenTab:
+		return m.MaxNumOfOpenTab()
+	case menu.FieldNoBasicLayout:
+		return m.NoBasicLayout()
+	case menu.FieldOpenInNewWindow:
+		return m.OpenInNewWindow()
+	case menu.FieldSort:
+		return m.Sort()
+	case menu.FieldQuery:
+		return m.Query()
+	case menu.FieldTitle:
+		return m.Title()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MenuMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case menu.FieldStatus:
+		return m.OldStatus(ctx)
+	case menu.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case menu.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case menu.FieldVersion:
+		return m.OldVersion(ctx)
+	case menu.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case menu.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case menu.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case menu.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case menu.FieldName:
+		return m.OldName(ctx)
+	case menu.FieldPath:
+		return m.OldPath(ctx)
+	case menu.FieldType:
+		return m.OldType(ctx)
+	case menu.FieldComponent:
+		return m.OldComponent(ctx)
+	case menu.FieldParentID:
+		return m.OldParentID(ctx)
+	case menu.FieldRedirect:
+		return m.OldRedirect(ctx)
+	case menu.FieldAuthCode:
+		return m.OldAuthCode(ctx)
+	case menu.FieldActiveIcon:
+		return m.OldActiveIcon(ctx)
+	case menu.FieldActivePath:
+		return m.OldActivePath(ctx)
+	case menu.FieldAffixTab:
+		return m.OldAffixTab(ctx)
+	case menu.FieldAffixTabOrder:
+		return m.OldAffixTabOrder(ctx)
+	case menu.FieldBadge:
+		return m.OldBadge(ctx)
+	case menu.FieldBadgeType:
+		return m.OldBadgeType(ctx)
+	case menu.FieldBadgeVariants:
+		return m.OldBadgeVariants(ctx)
+	case menu.FieldHideChildrenInMenu:
+		return m.OldHideChildrenInMenu(ctx)
+	case menu.FieldHideInBreadcrumb:
+		return m.OldHideInBreadcrumb(ctx)
+	case menu.FieldHideInMenu:
+		return m.OldHideInMenu(ctx)
+	case menu.FieldHideInTab:
+		return m.OldHideInTab(ctx)
+	case menu.FieldIcon:
+		return m.OldIcon(ctx)
+	case menu.FieldIframeSrc:
+		return m.OldIframeSrc(ctx)
+	case menu.FieldKeepAlive:
+		return m.OldKeepAlive(ctx)
+	case menu.FieldLink:
+		return m.OldLink(ctx)
+	case menu.FieldMaxNumOfOpenTab:
+		return m.OldMaxNumOfOpenTab(ctx)
+	case menu.FieldNoBasicLayout:
+		return m.OldNoBasicLayout(ctx)
+	case menu.FieldOpenInNewWindow:
+		return m.OldOpenInNewWindow(ctx)
+	case menu.FieldSort:
+		return m.OldSort(ctx)
+	case menu.FieldQuery:
+		return m.OldQuery(ctx)
+	case menu.FieldTitle:
+		return m.OldTitle(ctx)
+	}
+	return nil, fmt.Errorf("unknown Menu field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MenuMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case menu.FieldStatus:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case menu.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case menu.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case menu.FieldVersion:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case menu.FieldCreatedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case menu.FieldUpdatedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case menu.FieldDeletedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case menu.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case menu.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case menu.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case menu.FieldType:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case menu.FieldComponent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComponent(v)
+		return nil
+	case menu.FieldParentID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case menu.FieldRedirect:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirect(v)
+		return nil
+	case menu.FieldAuthCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthCode(v)
+		return nil
+	case menu.FieldActiveIcon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActiveIcon(v)
+		return nil
+	case menu.FieldActivePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivePath(v)
+		return nil
+	case menu.FieldAffixTab:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAffixTab(v)
+		return nil
+	case menu.FieldAffixTabOrder:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAffixTabOrder(v)
+		return nil
+	case menu.FieldBadge:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBadge(v)
+		return nil
+	case menu.FieldBadgeType:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBadgeType(v)
+		return nil
+	case menu.FieldBadgeVariants:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBadgeVariants(v)
+		return nil
+	case menu.FieldHideChildrenInMenu:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideChildrenInMenu(v)
+		return nil
+	case menu.FieldHideInBreadcrumb:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideInBreadcrumb(v)
+		return nil
+	case menu.FieldHideInMenu:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideInMenu(v)
+		return nil
+	case menu.FieldHideInTab:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideInTab(v)
+		return nil
+	case menu.FieldIcon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIcon(v)
+		return nil
+	case menu.FieldIframeSrc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIframeSrc(v)
+		return nil
+	case menu.FieldKeepAlive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepAlive(v)
+		return nil
+	case menu.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case menu.FieldMaxNumOfOpenTab:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxNumOfOpenTab(v)
+		return nil
+	case menu.FieldNoBasicLayout:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNoBasicLayout(v)
+		return nil
+	case menu.FieldOpenInNewWindow:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenInNewWindow(v)
+		return nil
+	case menu.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSort(v)
+		return nil
+	case menu.FieldQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuery(v)
+		return nil
+	case menu.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Menu field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MenuMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, menu.FieldStatus)
+	}
+	if m.addversion != nil {
+		fields = append(fields, menu.FieldVersion)
+	}
+	if m.addcreated_by != nil {
+		fields = append(fields, menu.FieldCreatedBy)
+	}
+	if m.addupdated_by != nil {
+		fields = append(fields, menu.FieldUpdatedBy)
+	}
+	if m.adddeleted_by != nil {
+		fields = append(fields, menu.FieldDeletedBy)
+	}
+	if m.add_type != nil {
+		fields = append(fields, menu.FieldType)
+	}
+	if m.addaffix_tab_order != nil {
+		fields = append(fields, menu.FieldAffixTabOrder)
+	}
+	if m.addbadge_type != nil {
+		fields = append(fields, menu.FieldBadgeType)
+	}
+	if m.addbadge_variants != nil {
+		fields = append(fields, menu.FieldBadgeVariants)
+	}
+	if m.addmax_num_of_open_tab != nil {
+		fields = append(fields, menu.FieldMaxNumOfOpenTab)
+	}
+	if m.addsort != nil {
+		fields = append(fields, menu.FieldSort)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MenuMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case menu.FieldStatus:
+		return m.AddedStatus()
+	case menu.FieldVersion:
+		return m.AddedVersion()
+	case menu.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	case menu.FieldUpdatedBy:
+		return m.AddedUpdatedBy()
+	case menu.FieldDeletedBy:
+		return m.AddedDeletedBy()
+	case menu.FieldType:
+		return m.AddedType()
+	case menu.FieldAffixTabOrder:
+		return m.AddedAffixTabOrder()
+	case menu.FieldBadgeType:
+		return m.AddedBadgeType()
+	case menu.FieldBadgeVariants:
+		return m.AddedBadgeVariants()
+	case menu.FieldMaxNumOfOpenTab:
+		return m.AddedMaxNumOfOpenTab()
+	case menu.FieldSort:
+		return m.AddedSort()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MenuMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case menu.FieldStatus:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case menu.FieldVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case menu.FieldCreatedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
+	case menu.FieldUpdatedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedBy(v)
+		return nil
+	case menu.FieldDeletedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedBy(v)
+		return nil
+	case menu.FieldType:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddType(v)
+		return nil
+	case menu.FieldAffixTabOrder:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAffixTabOrder(v)
+		return nil
+	case menu.FieldBadgeType:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBadgeType(v)
+		return nil
+	case menu.FieldBadgeVariants:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBadgeVariants(v)
+		return nil
+	case menu.FieldMaxNumOfOpenTab:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxNumOfOpenTab(v)
+		return nil
+	case menu.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSort(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Menu numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MenuMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(menu.FieldCreatedBy) {
+		fields = append(fields, menu.FieldCreatedBy)
+	}
+	if m.FieldCleared(menu.FieldUpdatedBy) {
+		fields = append(fields, menu.FieldUpdatedBy)
+	}
+	if m.FieldCleared(menu.FieldDeletedBy) {
+		fields = append(fields, menu.FieldDeletedBy)
+	}
+	if m.FieldCleared(menu.FieldDeletedAt) {
+		fields = append(fields, menu.FieldDeletedAt)
+	}
+	if m.FieldCleared(menu.FieldPath) {
+		fields = append(fields, menu.FieldPath)
+	}
+	if m.FieldCleared(menu.FieldType) {
+		fields = append(fields, menu.FieldType)
+	}
+	if m.FieldCleared(menu.FieldComponent) {
+		fields = append(fields, menu.FieldComponent)
+	}
+	if m.FieldCleared(menu.FieldParentID) {
+		fields = append(fields, menu.FieldParentID)
+	}
+	if m.FieldCleared(menu.FieldRedirect) {
+		fields = append(fields, menu.FieldRedirect)
+	}
+	if m.FieldCleared(menu.FieldQuery) {
+		fields = append(fields, menu.FieldQuery)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MenuMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MenuMutation) ClearField(name string) error {
+	switch name {
+	case menu.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case menu.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case menu.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case menu.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case menu.FieldPath:
+		m.ClearPath()
+		return nil
+	case menu.FieldType:
+		m.ClearType()
+		return nil
+	case menu.FieldComponent:
+		m.ClearComponent()
+		return nil
+	case menu.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case menu.FieldRedirect:
+		m.ClearRedirect()
+		return nil
+	case menu.FieldQuery:
+		m.ClearQuery()
+		return nil
+	}
+	return fmt.Errorf("unknown Menu nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MenuMutation) ResetField(name string) error {
+	switch name {
+	case menu.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case menu.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case menu.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case menu.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case menu.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case menu.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case menu.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case menu.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case menu.FieldName:
+		m.ResetName()
+		return nil
+	case menu.FieldPath:
+		m.ResetPath()
+		return nil
+	case menu.FieldType:
+		m.ResetType()
+		return nil
+	case menu.FieldComponent:
+		m.ResetComponent()
+		return nil
+	case menu.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case menu.FieldRedirect:
+		m.ResetRedirect()
+		return nil
+	case menu.FieldAuthCode:
+		m.ResetAuthCode()
+		return nil
+	case menu.FieldActiveIcon:
+		m.ResetActiveIcon()
+		return nil
+	case menu.FieldActivePath:
+		m.ResetActivePath()
+		return nil
+	case menu.FieldAffixTab:
+		m.ResetAffixTab()
+		return nil
+	case menu.FieldAffixTabOrder:
+		m.ResetAffixTabOrder()
+		return nil
+	case menu.FieldBadge:
+		m.ResetBadge()
+		return nil
+	case menu.FieldBadgeType:
+		m.ResetBadgeType()
+		return nil
+	case menu.FieldBadgeVariants:
+		m.ResetBadgeVariants()
+		return nil
+	case menu.FieldHideChildrenInMenu:
+		m.ResetHideChildrenInMenu()
+		return nil
+	case menu.FieldHideInBreadcrumb:
+		m.ResetHideInBreadcrumb()
+		return nil
+	case menu.FieldHideInMenu:
+		m.ResetHideInMenu()
+		return nil
+	case menu.FieldHideInTab:
+		m.ResetHideInTab()
+		return nil
+	case menu.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case menu.FieldIframeSrc:
+		m.ResetIframeSrc()
+		return nil
+	case menu.FieldKeepAlive:
+		m.ResetKeepAlive()
+		return nil
+	case menu.FieldLink:
+		m.ResetLink()
+		return nil
+	case menu.FieldMaxNumOfOpenTab:
+		m.ResetMaxNumOfOpenTab()
+		return nil
+	case menu.FieldNoBasicLayout:
+		m.ResetNoBasicLayout()
+		return nil
+	case menu.FieldOpenInNewWindow:
+		m.ResetOpenInNewWindow()
+		return nil
+	case menu.FieldSort:
+		m.ResetSort()
+		return nil
+	case menu.FieldQuery:
+		m.ResetQuery()
+		return nil
+	case menu.FieldTitle:
+		m.ResetTitle()
+		return nil
+	}
+	return fmt.Errorf("unknown Menu field %s", name)
 }
 
-// ResetBadgeVariants resets all changes to the "badge_variants" field.
-func (m *MenuMutation) ResetBadgeVariants() {
-	m.badge_variants = nil
-	m.addbadge_variants = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MenuMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.parent != nil {
+		edges = append(edges, menu.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, menu.EdgeChildren)
+	}
+	return edges
 }
 
-// SetHideChildrenInMenu sets the "hide_children_in_menu" field.
-func (m *MenuMutation) SetHideChildrenInMenu(b bool) {
-	m.hide_children_in_menu = &b
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MenuMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case menu.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case menu.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// HideChildrenInMenu returns the value of the "hide_children_in_menu" field in the mutation.
-func (m *MenuMutation) HideChildrenInMenu() (r bool, exists bool) {
-	v := m.hide_children_in_menu
-	if v == nil {
-		return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MenuMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedchildren != nil {
+		edges = append(edges, menu.EdgeChildren)
 	}
-	return *v, true
+	return edges
 }
 
-// OldHideChildrenInMenu returns the old "hide_children_in_menu" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldHideChildrenInMenu(ctx context.Context) (v *bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHideChildrenInMenu is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHideChildrenInMenu requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHideChildrenInMenu: %w", err)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MenuMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case menu.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.HideChildrenInMenu, nil
+	return nil
 }
 
-// ResetHideChildrenInMenu resets all changes to the "hide_children_in_menu" field.
-func (m *MenuMutation) ResetHideChildrenInMenu() {
-	m.hide_children_in_menu = nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MenuMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedparent {
+		edges = append(edges, menu.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, menu.EdgeChildren)
+	}
+	return edges
 }
 
-// SetHideInBreadcrumb sets the "hide_in_breadcrumb" field.
-func (m *MenuMutation) SetHideInBreadcrumb(b bool) {
-	m.hide_in_breadcrumb = &b
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MenuMutation) EdgeCleared(name string) bool {
+	switch name {
+	case menu.EdgeParent:
+		return m.clearedparent
+	case menu.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
 }
 
-// HideInBreadcrumb returns the value of the "hide_in_breadcrumb" field in the mutation.
-func (m *MenuMutation) HideInBreadcrumb() (r bool, exists bool) {
-	v := m.hide_in_breadcrumb
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MenuMutation) ClearEdge(name string) error {
+	switch name {
+	case menu.EdgeParent:
+		m.ClearParent()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Menu unique edge %s", name)
 }
 
-// OldHideInBreadcrumb returns the old "hide_in_breadcrumb" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldHideInBreadcrumb(ctx context.Context) (v *bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHideInBreadcrumb is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHideInBreadcrumb requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHideInBreadcrumb: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MenuMutation) ResetEdge(name string) error {
+	switch name {
+	case menu.EdgeParent:
+		m.ResetParent()
+		return nil
+	case menu.EdgeChildren:
+		m.ResetChildren()
+		return nil
 	}
-	return oldValue.HideInBreadcrumb, nil
+	return fmt.Errorf("unknown Menu edge %s", name)
 }
 
-// ResetHideInBreadcrumb resets all changes to the "hide_in_breadcrumb" field.
-func (m *MenuMutation) ResetHideInBreadcrumb() {
-	m.hide_in_breadcrumb = nil
+// PostMutation represents an operation that mutates the Post nodes in the graph.
+type PostMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint32
+	created_at    *time.Time
+	updated_at    *time.Time
+	status        *int32
+	addstatus     *int32
+	domain_id     *uint32
+	adddomain_id  *int32
+	version       *uint32
+	addversion    *int32
+	created_by    *uint32
+	addcreated_by *int32
+	updated_by    *uint32
+	addupdated_by *int32
+	deleted_by    *uint32
+	adddeleted_by *int32
+	deleted_at    *time.Time
+	name          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Post, error)
+	predicates    []predicate.Post
 }
 
-// SetHideInMenu sets the "hide_in_menu" field.
-func (m *MenuMutation) SetHideInMenu(b bool) {
-	m.hide_in_menu = &b
-}
+var _ ent.Mutation = (*PostMutation)(nil)
 
-// HideInMenu returns the value of the "hide_in_menu" field in the mutation.
-func (m *MenuMutation) HideInMenu() (r bool, exists bool) {
-	v := m.hide_in_menu
-	if v == nil {
-		return
+// postOption allows management of the mutation configuration using functional options.
+type postOption func(*PostMutation)
+
+// newPostMutation creates new mutation for the Post entity.
+func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
+	m := &PostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePost,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldHideInMenu returns the old "hide_in_menu" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldHideInMenu(ctx context.Context) (v *bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHideInMenu is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHideInMenu requires an ID field in the mutation")
+// withPostID sets the ID field of the mutation.
+func withPostID(id uint32) postOption {
+	return func(m *PostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Post
+		)
+		m.oldValue = func(ctx context.Context) (*Post, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Post.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHideInMenu: %w", err)
+}
+
+// withPost sets the old Post of the mutation.
+func withPost(node *Post) postOption {
+	return func(m *PostMutation) {
+		m.oldValue = func(context.Context) (*Post, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.HideInMenu, nil
 }
 
-// ResetHideInMenu resets all changes to the "hide_in_menu" field.
-func (m *MenuMutation) ResetHideInMenu() {
-	m.hide_in_menu = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("gen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetHideInTab sets the "hide_in_tab" field.
-func (m *MenuMutation) SetHideInTab(b bool) {
-	m.hide_in_tab = &b
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Post entities.
+func (m *PostMutation) SetID(id uint32) {
+	m.id = &id
 }
 
-// HideInTab returns the value of the "hide_in_tab" field in the mutation.
-func (m *MenuMutation) HideInTab() (r bool, exists bool) {
-	v := m.hide_in_tab
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldHideInTab returns the old "hide_in_tab" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldHideInTab(ctx context.Context) (v *bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHideInTab is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHideInTab requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHideInTab: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.HideInTab, nil
-}
-
-// ResetHideInTab resets all changes to the "hide_in_tab" field.
-func (m *MenuMutation) ResetHideInTab() {
-	m.hide_in_tab = nil
 }
 
-// SetIcon sets the "icon" field.
-func (m *MenuMutation) SetIcon(s string) {
-	m.icon = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *PostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Icon returns the value of the "icon" field in the mutation.
-func (m *MenuMutation) Icon() (r string, exists bool) {
-	v := m.icon
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIcon returns the old "icon" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldIcon(ctx context.Context) (v *string, err error) {
+func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIcon requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Icon, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetIcon resets all changes to the "icon" field.
-func (m *MenuMutation) ResetIcon() {
-	m.icon = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetIframeSrc sets the "iframe_src" field.
-func (m *MenuMutation) SetIframeSrc(s string) {
-	m.iframe_src = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PostMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// IframeSrc returns the value of the "iframe_src" field in the mutation.
-func (m *MenuMutation) IframeSrc() (r string, exists bool) {
-	v := m.iframe_src
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIframeSrc returns the old "iframe_src" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldIframeSrc(ctx context.Context) (v *string, err error) {
+func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIframeSrc is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIframeSrc requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIframeSrc: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.IframeSrc, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetIframeSrc resets all changes to the "iframe_src" field.
-func (m *MenuMutation) ResetIframeSrc() {
-	m.iframe_src = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PostMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetKeepAlive sets the "keep_alive" field.
-func (m *MenuMutation) SetKeepAlive(b bool) {
-	m.keep_alive = &b
+// SetStatus sets the "status" field.
+func (m *PostMutation) SetStatus(i int32) {
+	m.status = &i
+	m.addstatus = nil
 }
 
-// KeepAlive returns the value of the "keep_alive" field in the mutation.
-func (m *MenuMutation) KeepAlive() (r bool, exists bool) {
-	v := m.keep_alive
+// Status returns the value of the "status" field in the mutation.
+func (m *PostMutation) Status() (r int32, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKeepAlive returns the old "keep_alive" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldKeepAlive(ctx context.Context) (v *bool, err error) {
+func (m *PostMutation) OldStatus(ctx context.Context) (v *int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeepAlive is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeepAlive requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeepAlive: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.KeepAlive, nil
-}
-
-// ResetKeepAlive resets all changes to the "keep_alive" field.
-func (m *MenuMutation) ResetKeepAlive() {
-	m.keep_alive = nil
+	return oldValue.Status, nil
 }
 
-// SetLink sets the "link" field.
-func (m *MenuMutation) SetLink(s string) {
-	m.link = &s
+// AddStatus adds i to the "status" field.
+func (m *PostMutation) AddStatus(i int32) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
 }
 
-// Link returns the value of the "link" field in the mutation.
-func (m *MenuMutation) Link() (r string, exists bool) {
-	v := m.link
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *PostMutation) AddedStatus() (r int32, exists bool) {
+	v := m.addstatus
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLink returns the old "link" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldLink(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLink is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLink requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLink: %w", err)
-	}
-	return oldValue.Link, nil
-}
-
-// ResetLink resets all changes to the "link" field.
-func (m *MenuMutation) ResetLink() {
-	m.link = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *PostMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
 }
 
-// SetMaxNumOfOpenTab sets the "max_num_of_open_tab" field.
-func (m *MenuMutation) SetMaxNumOfOpenTab(i int32) {
-	m.max_num_of_open_tab = &i
-	m.addmax_num_of_open_tab = nil
+// SetDomainID sets the "domain_id" field.
+func (m *PostMutation) SetDomainID(u uint32) {
+	m.domain_id = &u
+	m.adddomain_id = nil
 }
 
-// MaxNumOfOpenTab returns the value of the "max_num_of_open_tab" field in the mutation.
-func (m *MenuMutation) MaxNumOfOpenTab() (r int32, exists bool) {
-	v := m.max_num_of_open_tab
+// DomainID returns the value of the "domain_id" field in the mutation.
+func (m *PostMutation) DomainID() (r uint32, exists bool) {
+	v := m.domain_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxNumOfOpenTab returns the old "max_num_of_open_tab" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldDomainID returns the old "domain_id" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldMaxNumOfOpenTab(ctx context.Context) (v *int32, err error) {
+func (m *PostMutation) OldDomainID(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxNumOfOpenTab is only allowed on UpdateOne operations")
+		return v, errors.New("OldDomainID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxNumOfOpenTab requires an ID field in the mutation")
+		return v, errors.New("OldDomainID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxNumOfOpenTab: %w", err)
+		return v, fmt.Errorf("querying old value for OldDomainID: %w", err)
 	}
-	return oldValue.MaxNumOfOpenTab, nil
+	return oldValue.DomainID, nil
 }
 
-// AddMaxNumOfOpenTab adds i to the "max_num_of_open_tab" field.
-func (m *MenuMutation) AddMaxNumOfOpenTab(i int32) {
-	if m.addmax_num_of_open_tab != nil {
-		*m.addmax_num_of_open_tab += i
+// AddDomainID adds u to the "domain_id" field.
+func (m *PostMutation) AddDomainID(u int32) {
+	if m.adddomain_id != nil {
+		*m.adddomain_id += u
 	} else {
-		m.addmax_num_of_open_tab = &i
+		m.adddomain_id = &u
 	}
 }
 
-// AddedMaxNumOfOpenTab returns the value that was added to the "max_num_of_open_tab" field in this mutation.
-func (m *MenuMutation) AddedMaxNumOfOpenTab() (r int32, exists bool) {
-	v := m.addmax_num_of_open_tab
+// AddedDomainID returns the value that was added to the "domain_id" field in this mutation.
+func (m *PostMutation) AddedDomainID() (r int32, exists bool) {
+	v := m.adddomain_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMaxNumOfOpenTab resets all changes to the "max_num_of_open_tab" field.
-func (m *MenuMutation) ResetMaxNumOfOpenTab() {
-	m.max_num_of_open_tab = nil
-	m.addmax_num_of_open_tab = nil
+// ResetDomainID resets all changes to the "domain_id" field.
+func (m *PostMutation) ResetDomainID() {
+	m.domain_id = nil
+	m.adddomain_id = nil
 }
 
-// SetNoBasicLayout sets the "no_basic_layout" field.
-func (m *MenuMutation) SetNoBasicLayout(b bool) {
-	m.no_basic_layout = &b
+// SetVersion sets the "version" field.
+func (m *PostMutation) SetVersion(u uint32) {
+	m.version = &u
+	m.addversion = nil
 }
 
-// NoBasicLayout returns the value of the "no_basic_layout" field in the mutation.
-func (m *MenuMutation) NoBasicLayout() (r bool, exists bool) {
-	v := m.no_basic_layout
+// Version returns the value of the "version" field in the mutation.
+func (m *PostMutation) Version() (r uint32, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNoBasicLayout returns the old "no_basic_layout" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldNoBasicLayout(ctx context.Context) (v *bool, err error) {
+func (m *PostMutation) OldVersion(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNoBasicLayout is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNoBasicLayout requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNoBasicLayout: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.NoBasicLayout, nil
+	return oldValue.Version, nil
 }
 
-// ResetNoBasicLayout resets all changes to the "no_basic_layout" field.
-func (m *MenuMutation) ResetNoBasicLayout() {
-	m.no_basic_layout = nil
+// AddVersion adds u to the "version" field.
+func (m *PostMutation) AddVersion(u int32) {
+	if m.addversion != nil {
+		*m.addversion += u
+	} else {
+		m.addversion = &u
+	}
 }
 
-// SetOpenInNewWindow sets the "open_in_new_window" field.
-func (m *MenuMutation) SetOpenInNewWindow(b bool) {
-	m.open_in_new_window = &b
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *PostMutation) AddedVersion() (r int32, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// OpenInNewWindow returns the value of the "open_in_new_window" field in the mutation.
-func (m *MenuMutation) OpenInNewWindow() (r bool, exists bool) {
-	v := m.open_in_new_window
+// ResetVersion resets all changes to the "version" field.
+func (m *PostMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *PostMutation) SetCreatedBy(u uint32) {
+	m.created_by = &u
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *PostMutation) CreatedBy() (r uint32, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOpenInNewWindow returns the old "open_in_new_window" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldOpenInNewWindow(ctx context.Context) (v *bool, err error) {
+func (m *PostMutation) OldCreatedBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOpenInNewWindow is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOpenInNewWindow requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOpenInNewWindow: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.OpenInNewWindow, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ResetOpenInNewWindow resets all changes to the "open_in_new_window" field.
-func (m *MenuMutation) ResetOpenInNewWindow() {
-	m.open_in_new_window = nil
+// AddCreatedBy adds u to the "created_by" field.
+func (m *PostMutation) AddCreatedBy(u int32) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += u
+	} else {
+		m.addcreated_by = &u
+	}
 }
 
-// SetSort sets the "sort" field.
-func (m *MenuMutation) SetSort(i int32) {
-	m.sort = &i
-	m.addsort = nil
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *PostMutation) AddedCreatedBy() (r int32, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Sort returns the value of the "sort" field in the mutation.
-func (m *MenuMutation) Sort() (r int32, exists bool) {
-	v := m.sort
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *PostMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[post.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *PostMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[post.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *PostMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, post.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *PostMutation) SetUpdatedBy(u uint32) {
+	m.updated_by = &u
+	m.addupdated_by = nil
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *PostMutation) UpdatedBy() (r uint32, exists bool) {
+	v := m.updated_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSort returns the old "sort" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedBy returns the old "updated_by" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldSort(ctx context.Context) (v *int32, err error) {
+func (m *PostMutation) OldUpdatedBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSort requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
 	}
-	return oldValue.Sort, nil
+	return oldValue.UpdatedBy, nil
 }
 
-// AddSort adds i to the "sort" field.
-func (m *MenuMutation) AddSort(i int32) {
-	if m.addsort != nil {
-		*m.addsort += i
+// AddUpdatedBy adds u to the "updated_by" field.
+func (m *PostMutation) AddUpdatedBy(u int32) {
+	if m.addupdated_by != nil {
+		*m.addupdated_by += u
 	} else {
-		m.addsort = &i
+		m.addupdated_by = &u
 	}
 }
 
-// AddedSort returns the value that was added to the "sort" field in this mutation.
-func (m *MenuMutation) AddedSort() (r int32, exists bool) {
-	v := m.addsort
+// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
+func (m *PostMutation) AddedUpdatedBy() (r int32, exists bool) {
+	v := m.addupdated_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSort resets all changes to the "sort" field.
-func (m *MenuMutation) ResetSort() {
-	m.sort = nil
-	m.addsort = nil
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *PostMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	m.clearedFields[post.FieldUpdatedBy] = struct{}{}
 }
 
-// SetQuery sets the "query" field.
-func (m *MenuMutation) SetQuery(s string) {
-	m.query = &s
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *PostMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[post.FieldUpdatedBy]
+	return ok
 }
 
-// Query returns the value of the "query" field in the mutation.
-func (m *MenuMutation) Query() (r string, exists bool) {
-	v := m.query
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *PostMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	delete(m.clearedFields, post.FieldUpdatedBy)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *PostMutation) SetDeletedBy(u uint32) {
+	m.deleted_by = &u
+	m.adddeleted_by = nil
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *PostMutation) DeletedBy() (r uint32, exists bool) {
+	v := m.deleted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuery returns the old "query" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedBy returns the old "deleted_by" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldQuery(ctx context.Context) (v *string, err error) {
+func (m *PostMutation) OldDeletedBy(ctx context.Context) (v *uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuery requires an ID field in the mutation")
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
 	}
-	return oldValue.Query, nil
+	return oldValue.DeletedBy, nil
 }
 
-// ClearQuery clears the value of the "query" field.
-func (m *MenuMutation) ClearQuery() {
-	m.query = nil
-	m.clearedFields[menu.FieldQuery] = struct{}{}
+// AddDeletedBy adds u to the "deleted_by" field.
+func (m *PostMutation) AddDeletedBy(u int32) {
+	if m.adddeleted_by != nil {
+		*m.adddeleted_by += u
+	} else {
+		m.adddeleted_by = &u
+	}
 }
 
-// QueryCleared returns if the "query" field was cleared in this mutation.
-func (m *MenuMutation) QueryCleared() bool {
-	_, ok := m.clearedFields[menu.FieldQuery]
+// AddedDeletedBy returns the value that was added to the "deleted_by" field in this mutation.
+func (m *PostMutation) AddedDeletedBy() (r int32, exists bool) {
+	v := m.adddeleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *PostMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.adddeleted_by = nil
+	m.clearedFields[post.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *PostMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[post.FieldDeletedBy]
 	return ok
 }
 
-// ResetQuery resets all changes to the "query" field.
-func (m *MenuMutation) ResetQuery() {
-	m.query = nil
-	delete(m.clearedFields, menu.FieldQuery)
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *PostMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	m.adddeleted_by = nil
+	delete(m.clearedFields, post.FieldDeletedBy)
 }
 
-// SetTitle sets the "title" field.
-func (m *MenuMutation) SetTitle(s string) {
-	m.title = &s
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *MenuMutation) Title() (r string, exists bool) {
-	v := m.title
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldTitle(ctx context.Context) (v *string, err error) {
+func (m *PostMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *MenuMutation) ResetTitle() {
-	m.title = nil
+	return oldValue.DeletedAt, nil
 }
 
-// ClearParent clears the "parent" edge to the Menu entity.
-func (m *MenuMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[menu.FieldParentID] = struct{}{}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[post.FieldDeletedAt] = struct{}{}
 }
 
-// ParentCleared reports if the "parent" edge to the Menu entity was cleared.
-func (m *MenuMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldDeletedAt]
+	return ok
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *MenuMutation) ParentIDs() (ids []uint32) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, post.FieldDeletedAt)
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *MenuMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// SetName sets the "name" field.
+func (m *PostMutation) SetName(s string) {
+	m.name = &s
 }
 
-// AddChildIDs adds the "children" edge to the Menu entity by ids.
-func (m *MenuMutation) AddChildIDs(ids ...uint32) {
-	if m.children == nil {
-		m.children = make(map[uint32]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+// Name returns the value of the "name" field in the mutation.
+func (m *PostMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearChildren clears the "children" edge to the Menu entity.
-func (m *MenuMutation) ClearChildren() {
-	m.clearedchildren = true
-}
-
-// ChildrenCleared reports if the "children" edge to the Menu entity was cleared.
-func (m *MenuMutation) ChildrenCleared() bool {
-	return m.clearedchildren
-}
-
-// RemoveChildIDs removes the "children" edge to the Menu entity by IDs.
-func (m *MenuMutation) RemoveChildIDs(ids ...uint32) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uint32]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+// OldName returns the old "name" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedChildren returns the removed IDs of the "children" edge to the Menu entity.
-func (m *MenuMutation) RemovedChildrenIDs() (ids []uint32) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	return
-}
-
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *MenuMutation) ChildrenIDs() (ids []uint32) {
-	for id := range m.children {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return
+	return oldValue.Name, nil
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *MenuMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// ResetName resets all changes to the "name" field.
+func (m *PostMutation) ResetName() {
+	m.name = nil
 }
 
-// Where appends a list predicates to the MenuMutation builder.
-func (m *MenuMutation) Where(ps ...predicate.Menu) {
+// Where appends a list predicates to the PostMutation builder.
+func (m *PostMutation) Where(ps ...predicate.Post) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MenuMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MenuMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Menu, len(ps))
+func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Post, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2670,120 +7130,54 @@ func (m *MenuMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MenuMutation) Op() Op {
+func (m *PostMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MenuMutation) SetOp(op Op) {
+func (m *PostMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Menu).
-func (m *MenuMutation) Type() string {
+// Type returns the node type of this mutation (Post).
+func (m *PostMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MenuMutation) Fields() []string {
-	fields := make([]string, 0, 32)
-	if m.status != nil {
-		fields = append(fields, menu.FieldStatus)
-	}
+func (m *PostMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
-		fields = append(fields, menu.FieldCreatedAt)
+		fields = append(fields, post.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, menu.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, menu.FieldDeletedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, menu.FieldName)
-	}
-	if m._path != nil {
-		fields = append(fields, menu.FieldPath)
-	}
-	if m._type != nil {
-		fields = append(fields, menu.FieldType)
-	}
-	if m.component != nil {
-		fields = append(fields, menu.FieldComponent)
-	}
-	if m.parent != nil {
-		fields = append(fields, menu.FieldParentID)
-	}
-	if m.redirect != nil {
-		fields = append(fields, menu.FieldRedirect)
-	}
-	if m.auth_code != nil {
-		fields = append(fields, menu.FieldAuthCode)
-	}
-	if m.active_icon != nil {
-		fields = append(fields, menu.FieldActiveIcon)
-	}
-	if m.active_path != nil {
-		fields = append(fields, menu.FieldActivePath)
-	}
-	if m.affix_tab != nil {
-		fields = append(fields, menu.FieldAffixTab)
-	}
-	if m.affix_tab_order != nil {
-		fields = append(fields, menu.FieldAffixTabOrder)
-	}
-	if m.badge != nil {
-		fields = append(fields, menu.FieldBadge)
-	}
-	if m.badge_type != nil {
-		fields = append(fields, menu.FieldBadgeType)
-	}
-	if m.badge_variants != nil {
-		fields = append(fields, menu.FieldBadgeVariants)
-	}
-	if m.hide_children_in_menu != nil {
-		fields = append(fields, menu.FieldHideChildrenInMenu)
-	}
-	if m.hide_in_breadcrumb != nil {
-		fields = append(fields, menu.FieldHideInBreadcrumb)
-	}
-	if m.hide_in_menu != nil {
-		fields = append(fields, menu.FieldHideInMenu)
-	}
-	if m.hide_in_tab != nil {
-		fields = append(fields, menu.FieldHideInTab)
-	}
-	if m.icon != nil {
-		fields = append(fields, menu.FieldIcon)
-	}
-	if m.iframe_src != nil {
-		fields = append(fields, menu.FieldIframeSrc)
+		fields = append(fields, post.FieldUpdatedAt)
 	}
-	if m.keep_alive != nil {
-		fields = append(fields, menu.FieldKeepAlive)
+	if m.status != nil {
+		fields = append(fields, post.FieldStatus)
 	}
-	if m.link != nil {
-		fields = append(fields, menu.FieldLink)
+	if m.domain_id != nil {
+		fields = append(fields, post.FieldDomainID)
 	}
-	if m.max_num_of_open_tab != nil {
-		fields = append(fields, menu.FieldMaxNumOfOpenTab)
+	if m.version != nil {
+		fields = append(fields, post.FieldVersion)
 	}
-	if m.no_basic_layout != nil {
-		fields = append(fields, menu.FieldNoBasicLayout)
+	if m.created_by != nil {
+		fields = append(fields, post.FieldCreatedBy)
 	}
-	if m.open_in_new_window != nil {
-		fields = append(fields, menu.FieldOpenInNewWindow)
+	if m.updated_by != nil {
+		fields = append(fields, post.FieldUpdatedBy)
 	}
-	if m.sort != nil {
-		fields = append(fields, menu.FieldSort)
+	if m.deleted_by != nil {
+		fields = append(fields, post.FieldDeletedBy)
 	}
-	if m.query != nil {
-		fields = append(fields, menu.FieldQuery)
+	if m.deleted_at != nil {
+		fields = append(fields, post.FieldDeletedAt)
 	}
-	if m.title != nil {
-		fields = append(fields, menu.FieldTitle)
+	if m.name != nil {
+		fields = append(fields, post.FieldName)
 	}
 	return fields
 }
@@ -2791,72 +7185,28 @@ func (m *MenuMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MenuMutation) Field(name string) (ent.Value, bool) {
+func (m *PostMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case menu.FieldStatus:
-		return m.Status()
-	case menu.FieldCreatedAt:
+	case post.FieldCreatedAt:
 		return m.CreatedAt()
-	case menu.FieldUpdatedAt:
+	case post.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case menu.FieldDeletedAt:
+	case post.FieldStatus:
+		return m.Status()
+	case post.FieldDomainID:
+		return m.DomainID()
+	case post.FieldVersion:
+		return m.Version()
+	case post.FieldCreatedBy:
+		return m.CreatedBy()
+	case post.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case post.FieldDeletedBy:
+		return m.DeletedBy()
+	case post.FieldDeletedAt:
 		return m.DeletedAt()
-	case menu.FieldName:
+	case post.FieldName:
 		return m.Name()
-	case menu.FieldPath:
-		return m.Path()
-	case menu.FieldType:
-		return m.GetType()
-	case menu.FieldComponent:
-		return m.Component()
-	case menu.FieldParentID:
-		return m.ParentID()
-	case menu.FieldRedirect:
-		return m.Redirect()
-	case menu.FieldAuthCode:
-		return m.AuthCode()
-	case menu.FieldActiveIcon:
-		return m.ActiveIcon()
-	case menu.FieldActivePath:
-		return m.ActivePath()
-	case menu.FieldAffixTab:
-		return m.AffixTab()
-	case menu.FieldAffixTabOrder:
-		return m.AffixTabOrder()
-	case menu.FieldBadge:
-		return m.Badge()
-	case menu.FieldBadgeType:
-		return m.BadgeType()
-	case menu.FieldBadgeVariants:
-		return m.BadgeVariants()
-	case menu.FieldHideChildrenInMenu:
-		return m.HideChildrenInMenu()
-	case menu.FieldHideInBreadcrumb:
-		return m.HideInBreadcrumb()
-	case menu.FieldHideInMenu:
-		return m.HideInMenu()
-	case menu.FieldHideInTab:
-		return m.HideInTab()
-	case menu.FieldIcon:
-		return m.Icon()
-	case menu.FieldIframeSrc:
-		return m.IframeSrc()
-	case menu.FieldKeepAlive:
-		return m.KeepAlive()
-	case menu.FieldLink:
-		return m.Link()
-	case menu.FieldMaxNumOfOpenTab:
-		return m.MaxNumOfOpenTab()
-	case menu.FieldNoBasicLayout:
-		return m.NoBasicLayout()
-	case menu.FieldOpenInNewWindow:
-		return m.OpenInNewWindow()
-	case menu.FieldSort:
-		return m.Sort()
-	case menu.FieldQuery:
-		return m.Query()
-	case menu.FieldTitle:
-		return m.Title()
 	}
 	return nil, false
 }
@@ -2864,333 +7214,132 @@ func (m *MenuMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MenuMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case menu.FieldStatus:
-		return m.OldStatus(ctx)
-	case menu.FieldCreatedAt:
+	case post.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case menu.FieldUpdatedAt:
+	case post.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case menu.FieldDeletedAt:
+	case post.FieldStatus:
+		return m.OldStatus(ctx)
+	case post.FieldDomainID:
+		return m.OldDomainID(ctx)
+	case post.FieldVersion:
+		return m.OldVersion(ctx)
+	case post.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case post.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case post.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case post.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case menu.FieldName:
+	case post.FieldName:
 		return m.OldName(ctx)
-	case menu.FieldPath:
-		return m.OldPath(ctx)
-	case menu.FieldType:
-		return m.OldType(ctx)
-	case menu.FieldComponent:
-		return m.OldComponent(ctx)
-	case menu.FieldParentID:
-		return m.OldParentID(ctx)
-	case menu.FieldRedirect:
-		return m.OldRedirect(ctx)
-	case menu.FieldAuthCode:
-		return m.OldAuthCode(ctx)
-	case menu.FieldActiveIcon:
-		return m.OldActiveIcon(ctx)
-	case menu.FieldActivePath:
-		return m.OldActivePath(ctx)
-	case menu.FieldAffixTab:
-		return m.OldAffixTab(ctx)
-	case menu.FieldAffixTabOrder:
-		return m.OldAffixTabOrder(ctx)
-	case menu.FieldBadge:
-		return m.OldBadge(ctx)
-	case menu.FieldBadgeType:
-		return m.OldBadgeType(ctx)
-	case menu.FieldBadgeVariants:
-		return m.OldBadgeVariants(ctx)
-	case menu.FieldHideChildrenInMenu:
-		return m.OldHideChildrenInMenu(ctx)
-	case menu.FieldHideInBreadcrumb:
-		return m.OldHideInBreadcrumb(ctx)
-	case menu.FieldHideInMenu:
-		return m.OldHideInMenu(ctx)
-	case menu.FieldHideInTab:
-		return m.OldHideInTab(ctx)
-	case menu.FieldIcon:
-		return m.OldIcon(ctx)
-	case menu.FieldIframeSrc:
-		return m.OldIframeSrc(ctx)
-	case menu.FieldKeepAlive:
-		return m.OldKeepAlive(ctx)
-	case menu.FieldLink:
-		return m.OldLink(ctx)
-	case menu.FieldMaxNumOfOpenTab:
-		return m.OldMaxNumOfOpenTab(ctx)
-	case menu.FieldNoBasicLayout:
-		return m.OldNoBasicLayout(ctx)
-	case menu.FieldOpenInNewWindow:
-		return m.OldOpenInNewWindow(ctx)
-	case menu.FieldSort:
-		return m.OldSort(ctx)
-	case menu.FieldQuery:
-		return m.OldQuery(ctx)
-	case menu.FieldTitle:
-		return m.OldTitle(ctx)
 	}
-	return nil, fmt.Errorf("unknown Menu field %s", name)
+	return nil, fmt.Errorf("unknown Post field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MenuMutation) SetField(name string, value ent.Value) error {
+func (m *PostMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case menu.FieldStatus:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case menu.FieldCreatedAt:
+	case post.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case menu.FieldUpdatedAt:
+	case post.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case menu.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case menu.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case menu.FieldPath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPath(v)
-		return nil
-	case menu.FieldType:
+	case post.FieldStatus:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
-		return nil
-	case menu.FieldComponent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetComponent(v)
+		m.SetStatus(v)
 		return nil
-	case menu.FieldParentID:
+	case post.FieldDomainID:
 		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
-		return nil
-	case menu.FieldRedirect:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRedirect(v)
-		return nil
-	case menu.FieldAuthCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuthCode(v)
-		return nil
-	case menu.FieldActiveIcon:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActiveIcon(v)
-		return nil
-	case menu.FieldActivePath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActivePath(v)
-		return nil
-	case menu.FieldAffixTab:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAffixTab(v)
-		return nil
-	case menu.FieldAffixTabOrder:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAffixTabOrder(v)
-		return nil
-	case menu.FieldBadge:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBadge(v)
-		return nil
-	case menu.FieldBadgeType:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBadgeType(v)
-		return nil
-	case menu.FieldBadgeVariants:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBadgeVariants(v)
-		return nil
-	case menu.FieldHideChildrenInMenu:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHideChildrenInMenu(v)
-		return nil
-	case menu.FieldHideInBreadcrumb:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHideInBreadcrumb(v)
-		return nil
-	case menu.FieldHideInMenu:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHideInMenu(v)
-		return nil
-	case menu.FieldHideInTab:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHideInTab(v)
-		return nil
-	case menu.FieldIcon:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIcon(v)
-		return nil
-	case menu.FieldIframeSrc:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIframeSrc(v)
-		return nil
-	case menu.FieldKeepAlive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetKeepAlive(v)
-		return nil
-	case menu.FieldLink:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLink(v)
+		m.SetDomainID(v)
 		return nil
-	case menu.FieldMaxNumOfOpenTab:
-		v, ok := value.(int32)
+	case post.FieldVersion:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxNumOfOpenTab(v)
+		m.SetVersion(v)
 		return nil
-	case menu.FieldNoBasicLayout:
-		v, ok := value.(bool)
+	case post.FieldCreatedBy:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNoBasicLayout(v)
+		m.SetCreatedBy(v)
 		return nil
-	case menu.FieldOpenInNewWindow:
-		v, ok := value.(bool)
+	case post.FieldUpdatedBy:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOpenInNewWindow(v)
+		m.SetUpdatedBy(v)
 		return nil
-	case menu.FieldSort:
-		v, ok := value.(int32)
+	case post.FieldDeletedBy:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSort(v)
+		m.SetDeletedBy(v)
 		return nil
-	case menu.FieldQuery:
-		v, ok := value.(string)
+	case post.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuery(v)
+		m.SetDeletedAt(v)
 		return nil
-	case menu.FieldTitle:
+	case post.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Menu field %s", name)
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MenuMutation) AddedFields() []string {
+func (m *PostMutation) AddedFields() []string {
 	var fields []string
 	if m.addstatus != nil {
-		fields = append(fields, menu.FieldStatus)
-	}
-	if m.add_type != nil {
-		fields = append(fields, menu.FieldType)
+		fields = append(fields, post.FieldStatus)
 	}
-	if m.addaffix_tab_order != nil {
-		fields = append(fields, menu.FieldAffixTabOrder)
+	if m.adddomain_id != nil {
+		fields = append(fields, post.FieldDomainID)
 	}
-	if m.addbadge_type != nil {
-		fields = append(fields, menu.FieldBadgeType)
+	if m.addversion != nil {
+		fields = append(fields, post.FieldVersion)
 	}
-	if m.addbadge_variants != nil {
-		fields = append(fields, menu.FieldBadgeVariants)
+	if m.addcreated_by != nil {
+		fields = append(fields, post.FieldCreatedBy)
 	}
-	if m.addmax_num_of_open_tab != nil {
-		fields = append(fields, menu.FieldMaxNumOfOpenTab)
+	if m.addupdated_by != nil {
+		fields = append(fields, post.FieldUpdatedBy)
 	}
-	if m.addsort != nil {
-		fields = append(fields, menu.FieldSort)
+	if m.adddeleted_by != nil {
+		fields = append(fields, post.FieldDeletedBy)
 	}
 	return fields
 }
@@ -3198,22 +7347,20 @@ func (m *MenuMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MenuMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case menu.FieldStatus:
+	case post.FieldStatus:
 		return m.AddedStatus()
-	case menu.FieldType:
-		return m.AddedType()
-	case menu.FieldAffixTabOrder:
-		return m.AddedAffixTabOrder()
-	case menu.FieldBadgeType:
-		return m.AddedBadgeType()
-	case menu.FieldBadgeVariants:
-		return m.AddedBadgeVariants()
-	case menu.FieldMaxNumOfOpenTab:
-		return m.AddedMaxNumOfOpenTab()
-	case menu.FieldSort:
-		return m.AddedSort()
+	case post.FieldDomainID:
+		return m.AddedDomainID()
+	case post.FieldVersion:
+		return m.AddedVersion()
+	case post.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	case post.FieldUpdatedBy:
+		return m.AddedUpdatedBy()
+	case post.FieldDeletedBy:
+		return m.AddedDeletedBy()
 	}
 	return nil, false
 }
@@ -3221,362 +7368,215 @@ func (m *MenuMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MenuMutation) AddField(name string, value ent.Value) error {
+func (m *PostMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case menu.FieldStatus:
+	case post.FieldStatus:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddStatus(v)
 		return nil
-	case menu.FieldType:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddType(v)
-		return nil
-	case menu.FieldAffixTabOrder:
+	case post.FieldDomainID:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAffixTabOrder(v)
+		m.AddDomainID(v)
 		return nil
-	case menu.FieldBadgeType:
+	case post.FieldVersion:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddBadgeType(v)
+		m.AddVersion(v)
 		return nil
-	case menu.FieldBadgeVariants:
+	case post.FieldCreatedBy:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddBadgeVariants(v)
+		m.AddCreatedBy(v)
 		return nil
-	case menu.FieldMaxNumOfOpenTab:
+	case post.FieldUpdatedBy:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMaxNumOfOpenTab(v)
+		m.AddUpdatedBy(v)
 		return nil
-	case menu.FieldSort:
+	case post.FieldDeletedBy:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSort(v)
+		m.AddDeletedBy(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Menu numeric field %s", name)
+	return fmt.Errorf("unknown Post numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MenuMutation) ClearedFields() []string {
+func (m *PostMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(menu.FieldDeletedAt) {
-		fields = append(fields, menu.FieldDeletedAt)
-	}
-	if m.FieldCleared(menu.FieldPath) {
-		fields = append(fields, menu.FieldPath)
-	}
-	if m.FieldCleared(menu.FieldType) {
-		fields = append(fields, menu.FieldType)
-	}
-	if m.FieldCleared(menu.FieldComponent) {
-		fields = append(fields, menu.FieldComponent)
+	if m.FieldCleared(post.FieldCreatedBy) {
+		fields = append(fields, post.FieldCreatedBy)
 	}
-	if m.FieldCleared(menu.FieldParentID) {
-		fields = append(fields, menu.FieldParentID)
+	if m.FieldCleared(post.FieldUpdatedBy) {
+		fields = append(fields, post.FieldUpdatedBy)
 	}
-	if m.FieldCleared(menu.FieldRedirect) {
-		fields = append(fields, menu.FieldRedirect)
+	if m.FieldCleared(post.FieldDeletedBy) {
+		fields = append(fields, post.FieldDeletedBy)
 	}
-	if m.FieldCleared(menu.FieldQuery) {
-		fields = append(fields, menu.FieldQuery)
+	if m.FieldCleared(post.FieldDeletedAt) {
+		fields = append(fields, post.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MenuMutation) FieldCleared(name string) bool {
+func (m *PostMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MenuMutation) ClearField(name string) error {
+func (m *PostMutation) ClearField(name string) error {
 	switch name {
-	case menu.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case menu.FieldPath:
-		m.ClearPath()
-		return nil
-	case menu.FieldType:
-		m.ClearType()
+	case post.FieldCreatedBy:
+		m.ClearCreatedBy()
 		return nil
-	case menu.FieldComponent:
-		m.ClearComponent()
-		return nil
-	case menu.FieldParentID:
-		m.ClearParentID()
+	case post.FieldUpdatedBy:
+		m.ClearUpdatedBy()
 		return nil
-	case menu.FieldRedirect:
-		m.ClearRedirect()
+	case post.FieldDeletedBy:
+		m.ClearDeletedBy()
 		return nil
-	case menu.FieldQuery:
-		m.ClearQuery()
+	case post.FieldDeletedAt:
+		m.ClearDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Menu nullable field %s", name)
+	return fmt.Errorf("unknown Post nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MenuMutation) ResetField(name string) error {
+func (m *PostMutation) ResetField(name string) error {
 	switch name {
-	case menu.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case menu.FieldCreatedAt:
+	case post.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case menu.FieldUpdatedAt:
+	case post.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case menu.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case menu.FieldName:
-		m.ResetName()
-		return nil
-	case menu.FieldPath:
-		m.ResetPath()
-		return nil
-	case menu.FieldType:
-		m.ResetType()
-		return nil
-	case menu.FieldComponent:
-		m.ResetComponent()
-		return nil
-	case menu.FieldParentID:
-		m.ResetParentID()
-		return nil
-	case menu.FieldRedirect:
-		m.ResetRedirect()
-		return nil
-	case menu.FieldAuthCode:
-		m.ResetAuthCode()
-		return nil
-	case menu.FieldActiveIcon:
-		m.ResetActiveIcon()
-		return nil
-	case menu.FieldActivePath:
-		m.ResetActivePath()
-		return nil
-	case menu.FieldAffixTab:
-		m.ResetAffixTab()
-		return nil
-	case menu.FieldAffixTabOrder:
-		m.ResetAffixTabOrder()
-		return nil
-	case menu.FieldBadge:
-		m.ResetBadge()
-		return nil
-	case menu.FieldBadgeType:
-		m.ResetBadgeType()
-		return nil
-	case menu.FieldBadgeVariants:
-		m.ResetBadgeVariants()
-		return nil
-	case menu.FieldHideChildrenInMenu:
-		m.ResetHideChildrenInMenu()
-		return nil
-	case menu.FieldHideInBreadcrumb:
-		m.ResetHideInBreadcrumb()
-		return nil
-	case menu.FieldHideInMenu:
-		m.ResetHideInMenu()
-		return nil
-	case menu.FieldHideInTab:
-		m.ResetHideInTab()
-		return nil
-	case menu.FieldIcon:
-		m.ResetIcon()
-		return nil
-	case menu.FieldIframeSrc:
-		m.ResetIframeSrc()
-		return nil
-	case menu.FieldKeepAlive:
-		m.ResetKeepAlive()
+	case post.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case menu.FieldLink:
-		m.ResetLink()
+	case post.FieldDomainID:
+		m.ResetDomainID()
 		return nil
-	case menu.FieldMaxNumOfOpenTab:
-		m.ResetMaxNumOfOpenTab()
+	case post.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case menu.FieldNoBasicLayout:
-		m.ResetNoBasicLayout()
+	case post.FieldCreatedBy:
+		m.ResetCreatedBy()
 		return nil
-	case menu.FieldOpenInNewWindow:
-		m.ResetOpenInNewWindow()
+	case post.FieldUpdatedBy:
+		m.ResetUpdatedBy()
 		return nil
-	case menu.FieldSort:
-		m.ResetSort()
+	case post.FieldDeletedBy:
+		m.ResetDeletedBy()
 		return nil
-	case menu.FieldQuery:
-		m.ResetQuery()
+	case post.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case menu.FieldTitle:
-		m.ResetTitle()
+	case post.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown Menu field %s", name)
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MenuMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.parent != nil {
-		edges = append(edges, menu.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, menu.EdgeChildren)
-	}
+func (m *PostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MenuMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case menu.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case menu.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *PostMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MenuMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedchildren != nil {
-		edges = append(edges, menu.EdgeChildren)
-	}
+func (m *PostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MenuMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case menu.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *PostMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MenuMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedparent {
-		edges = append(edges, menu.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, menu.EdgeChildren)
-	}
+func (m *PostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MenuMutation) EdgeCleared(name string) bool {
-	switch name {
-	case menu.EdgeParent:
-		return m.clearedparent
-	case menu.EdgeChildren:
-		return m.clearedchildren
-	}
+func (m *PostMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MenuMutation) ClearEdge(name string) error {
-	switch name {
-	case menu.EdgeParent:
-		m.ClearParent()
-		return nil
-	}
-	return fmt.Errorf("unknown Menu unique edge %s", name)
+func (m *PostMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Post unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MenuMutation) ResetEdge(name string) error {
-	switch name {
-	case menu.EdgeParent:
-		m.ResetParent()
-		return nil
-	case menu.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	}
-	return fmt.Errorf("unknown Menu edge %s", name)
-}
-
-// PostMutation represents an operation that mutates the Post nodes in the graph.
-type PostMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uint32
-	created_at    *time.Time
-	updated_at    *time.Time
-	status        *int32
-	addstatus     *int32
-	domain_id     *uint32
-	adddomain_id  *int32
-	deleted_at    *time.Time
-	name          *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Post, error)
-	predicates    []predicate.Post
+func (m *PostMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Post edge %s", name)
 }
-
-var _ ent.Mutation = (*PostMutation)(nil)
-
-// postOption allows management of the mutation configuration using functional options.
-type postOption func(*PostMutation)
-
-// newPostMutation creates new mutation for the Post entity.
-func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
-	m := &PostMutation{
+
+// RelationTupleMutation represents an operation that mutates the RelationTuple nodes in the graph.
+type RelationTupleMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uint64
+	object_namespace  *string
+	object_id         *string
+	relation          *string
+	subject_namespace *string
+	subject_id        *string
+	subject_relation  *string
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*RelationTuple, error)
+	predicates        []predicate.RelationTuple
+}
+
+var _ ent.Mutation = (*RelationTupleMutation)(nil)
+
+// relationtupleOption allows management of the mutation configuration using functional options.
+type relationtupleOption func(*RelationTupleMutation)
+
+// newRelationTupleMutation creates new mutation for the RelationTuple entity.
+func newRelationTupleMutation(c config, op Op, opts ...relationtupleOption) *RelationTupleMutation {
+	m := &RelationTupleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePost,
+		typ:           TypeRelationTuple,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3585,20 +7585,20 @@ func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
 	return m
 }
 
-// withPostID sets the ID field of the mutation.
-func withPostID(id uint32) postOption {
-	return func(m *PostMutation) {
+// withRelationTupleID sets the ID field of the mutation.
+func withRelationTupleID(id uint64) relationtupleOption {
+	return func(m *RelationTupleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Post
+			value *RelationTuple
 		)
-		m.oldValue = func(ctx context.Context) (*Post, error) {
+		m.oldValue = func(ctx context.Context) (*RelationTuple, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Post.Get(ctx, id)
+					value, err = m.Client().RelationTuple.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3607,10 +7607,10 @@ func withPostID(id uint32) postOption {
 	}
 }
 
-// withPost sets the old Post of the mutation.
-func withPost(node *Post) postOption {
-	return func(m *PostMutation) {
-		m.oldValue = func(context.Context) (*Post, error) {
+// withRelationTuple sets the old RelationTuple of the mutation.
+func withRelationTuple(node *RelationTuple) relationtupleOption {
+	return func(m *RelationTupleMutation) {
+		m.oldValue = func(context.Context) (*RelationTuple, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3619,7 +7619,7 @@ func withPost(node *Post) postOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostMutation) Client() *Client {
+func (m RelationTupleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3627,7 +7627,7 @@ func (m PostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostMutation) Tx() (*Tx, error) {
+func (m RelationTupleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("gen: mutation is not running in a transaction")
 	}
@@ -3637,14 +7637,14 @@ func (m PostMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Post entities.
-func (m *PostMutation) SetID(id uint32) {
+// operation is only accepted on creation of RelationTuple entities.
+func (m *RelationTupleMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PostMutation) ID() (id uint32, exists bool) {
+func (m *RelationTupleMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3655,299 +7655,246 @@ func (m *PostMutation) ID() (id uint32, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PostMutation) IDs(ctx context.Context) ([]uint32, error) {
+func (m *RelationTupleMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uint32{id}, nil
+			return []uint64{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RelationTuple.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PostMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetObjectNamespace sets the "object_namespace" field.
+func (m *RelationTupleMutation) SetObjectNamespace(s string) {
+	m.object_namespace = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ObjectNamespace returns the value of the "object_namespace" field in the mutation.
+func (m *RelationTupleMutation) ObjectNamespace() (r string, exists bool) {
+	v := m.object_namespace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldObjectNamespace returns the old "object_namespace" field's value of the RelationTuple entity.
+// If the RelationTuple object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RelationTupleMutation) OldObjectNamespace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldObjectNamespace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldObjectNamespace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldObjectNamespace: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ObjectNamespace, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetObjectNamespace resets all changes to the "object_namespace" field.
+func (m *RelationTupleMutation) ResetObjectNamespace() {
+	m.object_namespace = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PostMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetObjectID sets the "object_id" field.
+func (m *RelationTupleMutation) SetObjectID(s string) {
+	m.object_id = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ObjectID returns the value of the "object_id" field in the mutation.
+func (m *RelationTupleMutation) ObjectID() (r string, exists bool) {
+	v := m.object_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldObjectID returns the old "object_id" field's value of the RelationTuple entity.
+// If the RelationTuple object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RelationTupleMutation) OldObjectID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldObjectID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldObjectID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldObjectID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ObjectID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PostMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetObjectID resets all changes to the "object_id" field.
+func (m *RelationTupleMutation) ResetObjectID() {
+	m.object_id = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *PostMutation) SetStatus(i int32) {
-	m.status = &i
-	m.addstatus = nil
+// SetRelation sets the "relation" field.
+func (m *RelationTupleMutation) SetRelation(s string) {
+	m.relation = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PostMutation) Status() (r int32, exists bool) {
-	v := m.status
+// Relation returns the value of the "relation" field in the mutation.
+func (m *RelationTupleMutation) Relation() (r string, exists bool) {
+	v := m.relation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldRelation returns the old "relation" field's value of the RelationTuple entity.
+// If the RelationTuple object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldStatus(ctx context.Context) (v *int32, err error) {
+func (m *RelationTupleMutation) OldRelation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldRelation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldRelation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// AddStatus adds i to the "status" field.
-func (m *PostMutation) AddStatus(i int32) {
-	if m.addstatus != nil {
-		*m.addstatus += i
-	} else {
-		m.addstatus = &i
-	}
-}
-
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *PostMutation) AddedStatus() (r int32, exists bool) {
-	v := m.addstatus
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldRelation: %w", err)
 	}
-	return *v, true
+	return oldValue.Relation, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PostMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
+// ResetRelation resets all changes to the "relation" field.
+func (m *RelationTupleMutation) ResetRelation() {
+	m.relation = nil
 }
 
-// SetDomainID sets the "domain_id" field.
-func (m *PostMutation) SetDomainID(u uint32) {
-	m.domain_id = &u
-	m.adddomain_id = nil
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (m *RelationTupleMutation) SetSubjectNamespace(s string) {
+	m.subject_namespace = &s
 }
 
-// DomainID returns the value of the "domain_id" field in the mutation.
-func (m *PostMutation) DomainID() (r uint32, exists bool) {
-	v := m.domain_id
+// SubjectNamespace returns the value of the "subject_namespace" field in the mutation.
+func (m *RelationTupleMutation) SubjectNamespace() (r string, exists bool) {
+	v := m.subject_namespace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDomainID returns the old "domain_id" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldSubjectNamespace returns the old "subject_namespace" field's value of the RelationTuple entity.
+// If the RelationTuple object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldDomainID(ctx context.Context) (v uint32, err error) {
+func (m *RelationTupleMutation) OldSubjectNamespace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDomainID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubjectNamespace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDomainID requires an ID field in the mutation")
+		return v, errors.New("OldSubjectNamespace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDomainID: %w", err)
-	}
-	return oldValue.DomainID, nil
-}
-
-// AddDomainID adds u to the "domain_id" field.
-func (m *PostMutation) AddDomainID(u int32) {
-	if m.adddomain_id != nil {
-		*m.adddomain_id += u
-	} else {
-		m.adddomain_id = &u
+		return v, fmt.Errorf("querying old value for OldSubjectNamespace: %w", err)
 	}
+	return oldValue.SubjectNamespace, nil
 }
 
-// AddedDomainID returns the value that was added to the "domain_id" field in this mutation.
-func (m *PostMutation) AddedDomainID() (r int32, exists bool) {
-	v := m.adddomain_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetDomainID resets all changes to the "domain_id" field.
-func (m *PostMutation) ResetDomainID() {
-	m.domain_id = nil
-	m.adddomain_id = nil
+// ResetSubjectNamespace resets all changes to the "subject_namespace" field.
+func (m *RelationTupleMutation) ResetSubjectNamespace() {
+	m.subject_namespace = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *PostMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetSubjectID sets the "subject_id" field.
+func (m *RelationTupleMutation) SetSubjectID(s string) {
+	m.subject_id = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// SubjectID returns the value of the "subject_id" field in the mutation.
+func (m *RelationTupleMutation) SubjectID() (r string, exists bool) {
+	v := m.subject_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldSubjectID returns the old "subject_id" field's value of the RelationTuple entity.
+// If the RelationTuple object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *RelationTupleMutation) OldSubjectID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubjectID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldSubjectID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubjectID: %w", err)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PostMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[post.FieldDeletedAt] = struct{}{}
+	return oldValue.SubjectID, nil
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PostMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[post.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PostMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, post.FieldDeletedAt)
+// ResetSubjectID resets all changes to the "subject_id" field.
+func (m *RelationTupleMutation) ResetSubjectID() {
+	m.subject_id = nil
 }
 
-// SetName sets the "name" field.
-func (m *PostMutation) SetName(s string) {
-	m.name = &s
+// SetSubjectRelation sets the "subject_relation" field.
+func (m *RelationTupleMutation) SetSubjectRelation(s string) {
+	m.subject_relation = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PostMutation) Name() (r string, exists bool) {
-	v := m.name
+// SubjectRelation returns the value of the "subject_relation" field in the mutation.
+func (m *RelationTupleMutation) SubjectRelation() (r string, exists bool) {
+	v := m.subject_relation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldSubjectRelation returns the old "subject_relation" field's value of the RelationTuple entity.
+// If the RelationTuple object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldName(ctx context.Context) (v *string, err error) {
+func (m *RelationTupleMutation) OldSubjectRelation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubjectRelation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldSubjectRelation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubjectRelation: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.SubjectRelation, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PostMutation) ResetName() {
-	m.name = nil
+// ResetSubjectRelation resets all changes to the "subject_relation" field.
+func (m *RelationTupleMutation) ResetSubjectRelation() {
+	m.subject_relation = nil
 }
 
-// Where appends a list predicates to the PostMutation builder.
-func (m *PostMutation) Where(ps ...predicate.Post) {
+// Where appends a list predicates to the RelationTupleMutation builder.
+func (m *RelationTupleMutation) Where(ps ...predicate.RelationTuple) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RelationTupleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Post, len(ps))
+func (m *RelationTupleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RelationTuple, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3955,42 +7902,42 @@ func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostMutation) Op() Op {
+func (m *RelationTupleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostMutation) SetOp(op Op) {
+func (m *RelationTupleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Post).
-func (m *PostMutation) Type() string {
+// Type returns the node type of this mutation (RelationTuple).
+func (m *RelationTupleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostMutation) Fields() []string {
+func (m *RelationTupleMutation) Fields() []string {
 	fields := make([]string, 0, 6)
-	if m.created_at != nil {
-		fields = append(fields, post.FieldCreatedAt)
+	if m.object_namespace != nil {
+		fields = append(fields, relationtuple.FieldObjectNamespace)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, post.FieldUpdatedAt)
+	if m.object_id != nil {
+		fields = append(fields, relationtuple.FieldObjectID)
 	}
-	if m.status != nil {
-		fields = append(fields, post.FieldStatus)
+	if m.relation != nil {
+		fields = append(fields, relationtuple.FieldRelation)
 	}
-	if m.domain_id != nil {
-		fields = append(fields, post.FieldDomainID)
+	if m.subject_namespace != nil {
+		fields = append(fields, relationtuple.FieldSubjectNamespace)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, post.FieldDeletedAt)
+	if m.subject_id != nil {
+		fields = append(fields, relationtuple.FieldSubjectID)
 	}
-	if m.name != nil {
-		fields = append(fields, post.FieldName)
+	if m.subject_relation != nil {
+		fields = append(fields, relationtuple.FieldSubjectRelation)
 	}
 	return fields
 }
@@ -3998,20 +7945,20 @@ func (m *PostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostMutation) Field(name string) (ent.Value, bool) {
+func (m *RelationTupleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case post.FieldCreatedAt:
-		return m.CreatedAt()
-	case post.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case post.FieldStatus:
-		return m.Status()
-	case post.FieldDomainID:
-		return m.DomainID()
-	case post.FieldDeletedAt:
-		return m.DeletedAt()
-	case post.FieldName:
-		return m.Name()
+	case relationtuple.FieldObjectNamespace:
+		return m.ObjectNamespace()
+	case relationtuple.FieldObjectID:
+		return m.ObjectID()
+	case relationtuple.FieldRelation:
+		return m.Relation()
+	case relationtuple.FieldSubjectNamespace:
+		return m.SubjectNamespace()
+	case relationtuple.FieldSubjectID:
+		return m.SubjectID()
+	case relationtuple.FieldSubjectRelation:
+		return m.SubjectRelation()
 	}
 	return nil, false
 }
@@ -4019,224 +7966,188 @@ func (m *PostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RelationTupleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case post.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case post.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case post.FieldStatus:
-		return m.OldStatus(ctx)
-	case post.FieldDomainID:
-		return m.OldDomainID(ctx)
-	case post.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case post.FieldName:
-		return m.OldName(ctx)
-	}
-	return nil, fmt.Errorf("unknown Post field %s", name)
+	case relationtuple.FieldObjectNamespace:
+		return m.OldObjectNamespace(ctx)
+	case relationtuple.FieldObjectID:
+		return m.OldObjectID(ctx)
+	case relationtuple.FieldRelation:
+		return m.OldRelation(ctx)
+	case relationtuple.FieldSubjectNamespace:
+		return m.OldSubjectNamespace(ctx)
+	case relationtuple.FieldSubjectID:
+		return m.OldSubjectID(ctx)
+	case relationtuple.FieldSubjectRelation:
+		return m.OldSubjectRelation(ctx)
+	}
+	return nil, fmt.Errorf("unknown RelationTuple field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) SetField(name string, value ent.Value) error {
+func (m *RelationTupleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case relationtuple.FieldObjectNamespace:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetObjectNamespace(v)
 		return nil
-	case post.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case relationtuple.FieldObjectID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetObjectID(v)
 		return nil
-	case post.FieldStatus:
-		v, ok := value.(int32)
+	case relationtuple.FieldRelation:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetRelation(v)
 		return nil
-	case post.FieldDomainID:
-		v, ok := value.(uint32)
+	case relationtuple.FieldSubjectNamespace:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDomainID(v)
+		m.SetSubjectNamespace(v)
 		return nil
-	case post.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case relationtuple.FieldSubjectID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetSubjectID(v)
 		return nil
-	case post.FieldName:
+	case relationtuple.FieldSubjectRelation:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetSubjectRelation(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown RelationTuple field %s", name)
 }
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PostMutation) AddedFields() []string {
-	var fields []string
-	if m.addstatus != nil {
-		fields = append(fields, post.FieldStatus)
-	}
-	if m.adddomain_id != nil {
-		fields = append(fields, post.FieldDomainID)
-	}
-	return fields
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RelationTupleMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case post.FieldStatus:
-		return m.AddedStatus()
-	case post.FieldDomainID:
-		return m.AddedDomainID()
-	}
+func (m *RelationTupleMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) AddField(name string, value ent.Value) error {
+func (m *RelationTupleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldStatus:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatus(v)
-		return nil
-	case post.FieldDomainID:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDomainID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Post numeric field %s", name)
+	return fmt.Errorf("unknown RelationTuple numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(post.FieldDeletedAt) {
-		fields = append(fields, post.FieldDeletedAt)
-	}
-	return fields
+func (m *RelationTupleMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostMutation) FieldCleared(name string) bool {
+func (m *RelationTupleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostMutation) ClearField(name string) error {
-	switch name {
-	case post.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Post nullable field %s", name)
+func (m *RelationTupleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RelationTuple nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostMutation) ResetField(name string) error {
+func (m *RelationTupleMutation) ResetField(name string) error {
 	switch name {
-	case post.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case relationtuple.FieldObjectNamespace:
+		m.ResetObjectNamespace()
 		return nil
-	case post.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case relationtuple.FieldObjectID:
+		m.ResetObjectID()
 		return nil
-	case post.FieldStatus:
-		m.ResetStatus()
+	case relationtuple.FieldRelation:
+		m.ResetRelation()
 		return nil
-	case post.FieldDomainID:
-		m.ResetDomainID()
+	case relationtuple.FieldSubjectNamespace:
+		m.ResetSubjectNamespace()
 		return nil
-	case post.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case relationtuple.FieldSubjectID:
+		m.ResetSubjectID()
 		return nil
-	case post.FieldName:
-		m.ResetName()
+	case relationtuple.FieldSubjectRelation:
+		m.ResetSubjectRelation()
 		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown RelationTuple field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostMutation) AddedEdges() []string {
+func (m *RelationTupleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostMutation) AddedIDs(name string) []ent.Value {
+func (m *RelationTupleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostMutation) RemovedEdges() []string {
+func (m *RelationTupleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+func (m *RelationTupleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostMutation) ClearedEdges() []string {
+func (m *RelationTupleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostMutation) EdgeCleared(name string) bool {
+func (m *RelationTupleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Post unique edge %s", name)
+func (m *RelationTupleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RelationTuple unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Post edge %s", name)
+func (m *RelationTupleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RelationTuple edge %s", name)
 }
 
 // RoleMutation represents an operation that mutates the Role nodes in the graph.
@@ -4251,6 +8162,14 @@ type RoleMutation struct {
 	addstatus              *int32
 	domain_id              *uint32
 	adddomain_id           *int32
+	version                *uint32
+	addversion             *int32
+	created_by             *uint32
+	addcreated_by          *int32
+	updated_by             *uint32
+	addupdated_by          *int32
+	deleted_by             *uint32
+	adddeleted_by          *int32
 	deleted_at             *time.Time
 	name                   *string
 	default_router         *string
@@ -4557,6 +8476,272 @@ func (m *RoleMutation) ResetDomainID() {
 	m.adddomain_id = nil
 }
 
+// SetVersion sets the "version" field.
+func (m *RoleMutation) SetVersion(u uint32) {
+	m.version = &u
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *RoleMutation) Version() (r uint32, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldVersion(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds u to the "version" field.
+func (m *RoleMutation) AddVersion(u int32) {
+	if m.addversion != nil {
+		*m.addversion += u
+	} else {
+		m.addversion = &u
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *RoleMutation) AddedVersion() (r int32, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *RoleMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *RoleMutation) SetCreatedBy(u uint32) {
+	m.created_by = &u
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *RoleMutation) CreatedBy() (r uint32, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldCreatedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// AddCreatedBy adds u to the "created_by" field.
+func (m *RoleMutation) AddCreatedBy(u int32) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += u
+	} else {
+		m.addcreated_by = &u
+	}
+}
+
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *RoleMutation) AddedCreatedBy() (r int32, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *RoleMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[role.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *RoleMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[role.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *RoleMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, role.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *RoleMutation) SetUpdatedBy(u uint32) {
+	m.updated_by = &u
+	m.addupdated_by = nil
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *RoleMutation) UpdatedBy() (r uint32, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldUpdatedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// AddUpdatedBy adds u to the "updated_by" field.
+func (m *RoleMutation) AddUpdatedBy(u int32) {
+	if m.addupdated_by != nil {
+		*m.addupdated_by += u
+	} else {
+		m.addupdated_by = &u
+	}
+}
+
+// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
+func (m *RoleMutation) AddedUpdatedBy() (r int32, exists bool) {
+	v := m.addupdated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *RoleMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	m.clearedFields[role.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *RoleMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[role.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *RoleMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	delete(m.clearedFields, role.FieldUpdatedBy)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *RoleMutation) SetDeletedBy(u uint32) {
+	m.deleted_by = &u
+	m.adddeleted_by = nil
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *RoleMutation) DeletedBy() (r uint32, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldDeletedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// AddDeletedBy adds u to the "deleted_by" field.
+func (m *RoleMutation) AddDeletedBy(u int32) {
+	if m.adddeleted_by != nil {
+		*m.adddeleted_by += u
+	} else {
+		m.adddeleted_by = &u
+	}
+}
+
+// AddedDeletedBy returns the value that was added to the "deleted_by" field in this mutation.
+func (m *RoleMutation) AddedDeletedBy() (r int32, exists bool) {
+	v := m.adddeleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *RoleMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.adddeleted_by = nil
+	m.clearedFields[role.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *RoleMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[role.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *RoleMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	m.adddeleted_by = nil
+	delete(m.clearedFields, role.FieldDeletedBy)
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (m *RoleMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
@@ -4934,7 +9119,7 @@ func (m *RoleMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *RoleMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 14)
 	if m.created_at != nil {
 		fields = append(fields, role.FieldCreatedAt)
 	}
@@ -4947,6 +9132,18 @@ func (m *RoleMutation) Fields() []string {
 	if m.domain_id != nil {
 		fields = append(fields, role.FieldDomainID)
 	}
+	if m.version != nil {
+		fields = append(fields, role.FieldVersion)
+	}
+	if m.created_by != nil {
+		fields = append(fields, role.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, role.FieldUpdatedBy)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, role.FieldDeletedBy)
+	}
 	if m.deleted_at != nil {
 		fields = append(fields, role.FieldDeletedAt)
 	}
@@ -4981,6 +9178,14 @@ func (m *RoleMutation) Field(name string) (ent.Value, bool) {
 		return m.Status()
 	case role.FieldDomainID:
 		return m.DomainID()
+	case role.FieldVersion:
+		return m.Version()
+	case role.FieldCreatedBy:
+		return m.CreatedBy()
+	case role.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case role.FieldDeletedBy:
+		return m.DeletedBy()
 	case role.FieldDeletedAt:
 		return m.DeletedAt()
 	case role.FieldName:
@@ -5010,6 +9215,14 @@ func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldStatus(ctx)
 	case role.FieldDomainID:
 		return m.OldDomainID(ctx)
+	case role.FieldVersion:
+		return m.OldVersion(ctx)
+	case role.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case role.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case role.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
 	case role.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
 	case role.FieldName:
@@ -5059,6 +9272,34 @@ func (m *RoleMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDomainID(v)
 		return nil
+	case role.FieldVersion:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case role.FieldCreatedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case role.FieldUpdatedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case role.FieldDeletedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
 	case role.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -5115,6 +9356,18 @@ func (m *RoleMutation) AddedFields() []string {
 	if m.adddomain_id != nil {
 		fields = append(fields, role.FieldDomainID)
 	}
+	if m.addversion != nil {
+		fields = append(fields, role.FieldVersion)
+	}
+	if m.addcreated_by != nil {
+		fields = append(fields, role.FieldCreatedBy)
+	}
+	if m.addupdated_by != nil {
+		fields = append(fields, role.FieldUpdatedBy)
+	}
+	if m.adddeleted_by != nil {
+		fields = append(fields, role.FieldDeletedBy)
+	}
 	if m.adddata_scope != nil {
 		fields = append(fields, role.FieldDataScope)
 	}
@@ -5136,6 +9389,14 @@ func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedStatus()
 	case role.FieldDomainID:
 		return m.AddedDomainID()
+	case role.FieldVersion:
+		return m.AddedVersion()
+	case role.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	case role.FieldUpdatedBy:
+		return m.AddedUpdatedBy()
+	case role.FieldDeletedBy:
+		return m.AddedDeletedBy()
 	case role.FieldDataScope:
 		return m.AddedDataScope()
 	case role.FieldMenuCheckStrictly:
@@ -5165,6 +9426,34 @@ func (m *RoleMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddDomainID(v)
 		return nil
+	case role.FieldVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case role.FieldCreatedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
+	case role.FieldUpdatedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedBy(v)
+		return nil
+	case role.FieldDeletedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedBy(v)
+		return nil
 	case role.FieldDataScope:
 		v, ok := value.(int32)
 		if !ok {
@@ -5194,6 +9483,15 @@ func (m *RoleMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *RoleMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(role.FieldCreatedBy) {
+		fields = append(fields, role.FieldCreatedBy)
+	}
+	if m.FieldCleared(role.FieldUpdatedBy) {
+		fields = append(fields, role.FieldUpdatedBy)
+	}
+	if m.FieldCleared(role.FieldDeletedBy) {
+		fields = append(fields, role.FieldDeletedBy)
+	}
 	if m.FieldCleared(role.FieldDeletedAt) {
 		fields = append(fields, role.FieldDeletedAt)
 	}
@@ -5211,6 +9509,15 @@ func (m *RoleMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *RoleMutation) ClearField(name string) error {
 	switch name {
+	case role.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case role.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case role.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
 	case role.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
@@ -5234,6 +9541,18 @@ func (m *RoleMutation) ResetField(name string) error {
 	case role.FieldDomainID:
 		m.ResetDomainID()
 		return nil
+	case role.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case role.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case role.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case role.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
 	case role.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
@@ -5352,6 +9671,14 @@ type UserMutation struct {
 	addstatus      *int32
 	domain_id      *uint32
 	adddomain_id   *int32
+	version        *uint32
+	addversion     *int32
+	created_by     *uint32
+	addcreated_by  *int32
+	updated_by     *uint32
+	addupdated_by  *int32
+	deleted_by     *uint32
+	adddeleted_by  *int32
 	deleted_at     *time.Time
 	name           *string
 	password       *string
@@ -5374,6 +9701,8 @@ type UserMutation struct {
 	metadata       *[]string
 	appendmetadata []string
 	description    *string
+	dept_id        *uint32
+	adddept_id     *int32
 	clearedFields  map[string]struct{}
 	roles          map[uint32]struct{}
 	removedroles   map[uint32]struct{}
@@ -5674,6 +10003,272 @@ func (m *UserMutation) ResetDomainID() {
 	m.adddomain_id = nil
 }
 
+// SetVersion sets the "version" field.
+func (m *UserMutation) SetVersion(u uint32) {
+	m.version = &u
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *UserMutation) Version() (r uint32, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldVersion(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds u to the "version" field.
+func (m *UserMutation) AddVersion(u int32) {
+	if m.addversion != nil {
+		*m.addversion += u
+	} else {
+		m.addversion = &u
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *UserMutation) AddedVersion() (r int32, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *UserMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *UserMutation) SetCreatedBy(u uint32) {
+	m.created_by = &u
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *UserMutation) CreatedBy() (r uint32, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// AddCreatedBy adds u to the "created_by" field.
+func (m *UserMutation) AddCreatedBy(u int32) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += u
+	} else {
+		m.addcreated_by = &u
+	}
+}
+
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *UserMutation) AddedCreatedBy() (r int32, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *UserMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[user.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *UserMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[user.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *UserMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, user.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *UserMutation) SetUpdatedBy(u uint32) {
+	m.updated_by = &u
+	m.addupdated_by = nil
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *UserMutation) UpdatedBy() (r uint32, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// AddUpdatedBy adds u to the "updated_by" field.
+func (m *UserMutation) AddUpdatedBy(u int32) {
+	if m.addupdated_by != nil {
+		*m.addupdated_by += u
+	} else {
+		m.addupdated_by = &u
+	}
+}
+
+// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
+func (m *UserMutation) AddedUpdatedBy() (r int32, exists bool) {
+	v := m.addupdated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *UserMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	m.clearedFields[user.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *UserMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[user.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *UserMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	delete(m.clearedFields, user.FieldUpdatedBy)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *UserMutation) SetDeletedBy(u uint32) {
+	m.deleted_by = &u
+	m.adddeleted_by = nil
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *UserMutation) DeletedBy() (r uint32, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedBy(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// AddDeletedBy adds u to the "deleted_by" field.
+func (m *UserMutation) AddDeletedBy(u int32) {
+	if m.adddeleted_by != nil {
+		*m.adddeleted_by += u
+	} else {
+		m.adddeleted_by = &u
+	}
+}
+
+// AddedDeletedBy returns the value that was added to the "deleted_by" field in this mutation.
+func (m *UserMutation) AddedDeletedBy() (r int32, exists bool) {
+	v := m.adddeleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *UserMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.adddeleted_by = nil
+	m.clearedFields[user.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *UserMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *UserMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	m.adddeleted_by = nil
+	delete(m.clearedFields, user.FieldDeletedBy)
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (m *UserMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
@@ -6548,6 +11143,76 @@ func (m *UserMutation) ResetDescription() {
 	delete(m.clearedFields, user.FieldDescription)
 }
 
+// SetDeptID sets the "dept_id" field.
+func (m *UserMutation) SetDeptID(u uint32) {
+	m.dept_id = &u
+	m.adddept_id = nil
+}
+
+// DeptID returns the value of the "dept_id" field in the mutation.
+func (m *UserMutation) DeptID() (r uint32, exists bool) {
+	v := m.dept_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeptID returns the old "dept_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeptID(ctx context.Context) (v *uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeptID: %w", err)
+	}
+	return oldValue.DeptID, nil
+}
+
+// AddDeptID adds u to the "dept_id" field.
+func (m *UserMutation) AddDeptID(u int32) {
+	if m.adddept_id != nil {
+		*m.adddept_id += u
+	} else {
+		m.adddept_id = &u
+	}
+}
+
+// AddedDeptID returns the value that was added to the "dept_id" field in this mutation.
+func (m *UserMutation) AddedDeptID() (r int32, exists bool) {
+	v := m.adddept_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDeptID clears the value of the "dept_id" field.
+func (m *UserMutation) ClearDeptID() {
+	m.dept_id = nil
+	m.adddept_id = nil
+	m.clearedFields[user.FieldDeptID] = struct{}{}
+}
+
+// DeptIDCleared returns if the "dept_id" field was cleared in this mutation.
+func (m *UserMutation) DeptIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeptID]
+	return ok
+}
+
+// ResetDeptID resets all changes to the "dept_id" field.
+func (m *UserMutation) ResetDeptID() {
+	m.dept_id = nil
+	m.adddept_id = nil
+	delete(m.clearedFields, user.FieldDeptID)
+}
+
 // AddRoleIDs adds the "roles" edge to the Role entity by ids.
 func (m *UserMutation) AddRoleIDs(ids ...uint32) {
 	if m.roles == nil {
@@ -6690,7 +11355,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 21)
+	fields := make([]string, 0, 26)
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -6703,6 +11368,18 @@ func (m *UserMutation) Fields() []string {
 	if m.domain_id != nil {
 		fields = append(fields, user.FieldDomainID)
 	}
+	if m.version != nil {
+		fields = append(fields, user.FieldVersion)
+	}
+	if m.created_by != nil {
+		fields = append(fields, user.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, user.FieldUpdatedBy)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, user.FieldDeletedBy)
+	}
 	if m.deleted_at != nil {
 		fields = append(fields, user.FieldDeletedAt)
 	}
@@ -6754,6 +11431,9 @@ func (m *UserMutation) Fields() []string {
 	if m.description != nil {
 		fields = append(fields, user.FieldDescription)
 	}
+	if m.dept_id != nil {
+		fields = append(fields, user.FieldDeptID)
+	}
 	return fields
 }
 
@@ -6770,6 +11450,14 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Status()
 	case user.FieldDomainID:
 		return m.DomainID()
+	case user.FieldVersion:
+		return m.Version()
+	case user.FieldCreatedBy:
+		return m.CreatedBy()
+	case user.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case user.FieldDeletedBy:
+		return m.DeletedBy()
 	case user.FieldDeletedAt:
 		return m.DeletedAt()
 	case user.FieldName:
@@ -6804,6 +11492,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Metadata()
 	case user.FieldDescription:
 		return m.Description()
+	case user.FieldDeptID:
+		return m.DeptID()
 	}
 	return nil, false
 }
@@ -6821,6 +11511,14 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldStatus(ctx)
 	case user.FieldDomainID:
 		return m.OldDomainID(ctx)
+	case user.FieldVersion:
+		return m.OldVersion(ctx)
+	case user.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case user.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case user.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
 	case user.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
 	case user.FieldName:
@@ -6855,6 +11553,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldMetadata(ctx)
 	case user.FieldDescription:
 		return m.OldDescription(ctx)
+	case user.FieldDeptID:
+		return m.OldDeptID(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -6892,6 +11592,34 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDomainID(v)
 		return nil
+	case user.FieldVersion:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case user.FieldCreatedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case user.FieldUpdatedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case user.FieldDeletedBy:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
 	case user.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -7011,6 +11739,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDescription(v)
 		return nil
+	case user.FieldDeptID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeptID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -7025,6 +11760,18 @@ func (m *UserMutation) AddedFields() []string {
 	if m.adddomain_id != nil {
 		fields = append(fields, user.FieldDomainID)
 	}
+	if m.addversion != nil {
+		fields = append(fields, user.FieldVersion)
+	}
+	if m.addcreated_by != nil {
+		fields = append(fields, user.FieldCreatedBy)
+	}
+	if m.addupdated_by != nil {
+		fields = append(fields, user.FieldUpdatedBy)
+	}
+	if m.adddeleted_by != nil {
+		fields = append(fields, user.FieldDeletedBy)
+	}
 	if m.addgender != nil {
 		fields = append(fields, user.FieldGender)
 	}
@@ -7034,6 +11781,9 @@ func (m *UserMutation) AddedFields() []string {
 	if m.addlogin_count != nil {
 		fields = append(fields, user.FieldLoginCount)
 	}
+	if m.adddept_id != nil {
+		fields = append(fields, user.FieldDeptID)
+	}
 	return fields
 }
 
@@ -7046,12 +11796,22 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedStatus()
 	case user.FieldDomainID:
 		return m.AddedDomainID()
+	case user.FieldVersion:
+		return m.AddedVersion()
+	case user.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	case user.FieldUpdatedBy:
+		return m.AddedUpdatedBy()
+	case user.FieldDeletedBy:
+		return m.AddedDeletedBy()
 	case user.FieldGender:
 		return m.AddedGender()
 	case user.FieldAge:
 		return m.AddedAge()
 	case user.FieldLoginCount:
 		return m.AddedLoginCount()
+	case user.FieldDeptID:
+		return m.AddedDeptID()
 	}
 	return nil, false
 }
@@ -7075,6 +11835,34 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddDomainID(v)
 		return nil
+	case user.FieldVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case user.FieldCreatedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
+	case user.FieldUpdatedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedBy(v)
+		return nil
+	case user.FieldDeletedBy:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedBy(v)
+		return nil
 	case user.FieldGender:
 		v, ok := value.(int32)
 		if !ok {
@@ -7096,6 +11884,13 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddLoginCount(v)
 		return nil
+	case user.FieldDeptID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeptID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -7104,6 +11899,15 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *UserMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(user.FieldCreatedBy) {
+		fields = append(fields, user.FieldCreatedBy)
+	}
+	if m.FieldCleared(user.FieldUpdatedBy) {
+		fields = append(fields, user.FieldUpdatedBy)
+	}
+	if m.FieldCleared(user.FieldDeletedBy) {
+		fields = append(fields, user.FieldDeletedBy)
+	}
 	if m.FieldCleared(user.FieldDeletedAt) {
 		fields = append(fields, user.FieldDeletedAt)
 	}
@@ -7143,6 +11947,9 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldDescription) {
 		fields = append(fields, user.FieldDescription)
 	}
+	if m.FieldCleared(user.FieldDeptID) {
+		fields = append(fields, user.FieldDeptID)
+	}
 	return fields
 }
 
@@ -7157,6 +11964,15 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
 	switch name {
+	case user.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case user.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case user.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
 	case user.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
@@ -7196,6 +12012,9 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldDescription:
 		m.ClearDescription()
 		return nil
+	case user.FieldDeptID:
+		m.ClearDeptID()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -7216,6 +12035,18 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldDomainID:
 		m.ResetDomainID()
 		return nil
+	case user.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case user.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case user.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case user.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
 	case user.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
@@ -7267,6 +12098,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldDescription:
 		m.ResetDescription()
 		return nil
+	case user.FieldDeptID:
+		m.ResetDeptID()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -4,6 +4,19 @@ package gen
 
 import "context"
 
+func (_m *CasbinRuleQuery) Page(ctx context.Context, page, size int) ([]*CasbinRule, int, error) {
+	cnt, err := _m.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset := size * (page - 1)
+	rs, err := _m.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rs, cnt, nil
+}
+
 func (_m *DeptQuery) Page(ctx context.Context, page, size int) ([]*Dept, int, error) {
 	cnt, err := _m.Count(ctx)
 	if err != nil {
@@ -17,6 +30,32 @@ func (_m *DeptQuery) Page(ctx context.Context, page, size int) ([]*Dept, int, er
 	return rs, cnt, nil
 }
 
+func (_m *EntityHistoryQuery) Page(ctx context.Context, page, size int) ([]*EntityHistory, int, error) {
+	cnt, err := _m.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset := size * (page - 1)
+	rs, err := _m.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rs, cnt, nil
+}
+
+func (_m *ExportFileQuery) Page(ctx context.Context, page, size int) ([]*ExportFile, int, error) {
+	cnt, err := _m.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset := size * (page - 1)
+	rs, err := _m.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rs, cnt, nil
+}
+
 func (_m *MenuQuery) Page(ctx context.Context, page, size int) ([]*Menu, int, error) {
 	cnt, err := _m.Count(ctx)
 	if err != nil {
@@ -43,6 +82,19 @@ func (_m *PostQuery) Page(ctx context.Context, page, size int) ([]*Post, int, er
 	return rs, cnt, nil
 }
 
+func (_m *RelationTupleQuery) Page(ctx context.Context, page, size int) ([]*RelationTuple, int, error) {
+	cnt, err := _m.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset := size * (page - 1)
+	rs, err := _m.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rs, cnt, nil
+}
+
 func (_m *RoleQuery) Page(ctx context.Context, page, size int) ([]*Role, int, error) {
 	cnt, err := _m.Count(ctx)
 	if err != nil {
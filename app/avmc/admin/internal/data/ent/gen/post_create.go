@@ -78,6 +78,62 @@ func (_c *PostCreate) SetNillableDomainID(v *uint32) *PostCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *PostCreate) SetVersion(v uint32) *PostCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *PostCreate) SetNillableVersion(v *uint32) *PostCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PostCreate) SetCreatedBy(v uint32) *PostCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PostCreate) SetNillableCreatedBy(v *uint32) *PostCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *PostCreate) SetUpdatedBy(v uint32) *PostCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *PostCreate) SetNillableUpdatedBy(v *uint32) *PostCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *PostCreate) SetDeletedBy(v uint32) *PostCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *PostCreate) SetNillableDeletedBy(v *uint32) *PostCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (_c *PostCreate) SetDeletedAt(v time.Time) *PostCreate {
 	_c.mutation.SetDeletedAt(v)
@@ -174,6 +230,10 @@ func (_c *PostCreate) defaults() error {
 		v := post.DefaultDomainID()
 		_c.mutation.SetDomainID(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := post.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		v := post.DefaultName
 		_c.mutation.SetName(v)
@@ -205,6 +265,9 @@ func (_c *PostCreate) check() error {
 			return &ValidationError{Name: "domain_id", err: fmt.Errorf(`gen: validator failed for field "Post.domain_id": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`gen: missing required field "Post.version"`)}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`gen: missing required field "Post.name"`)}
 	}
@@ -267,6 +330,22 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldDomainID, field.TypeUint32, value)
 		_node.DomainID = value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(post.FieldVersion, field.TypeUint32, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(post.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(post.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(post.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
 	if value, ok := _c.mutation.DeletedAt(); ok {
 		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
@@ -375,6 +454,72 @@ func (u *PostUpsert) AddDomainID(v uint32) *PostUpsert {
 	return u
 }
 
+// SetVersion sets the "version" field.
+func (u *PostUpsert) SetVersion(v uint32) *PostUpsert {
+	u.Set(post.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *PostUpsert) UpdateVersion() *PostUpsert {
+	u.SetExcluded(post.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *PostUpsert) AddVersion(v uint32) *PostUpsert {
+	u.Add(post.FieldVersion, v)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PostUpsert) SetUpdatedBy(v uint32) *PostUpsert {
+	u.Set(post.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PostUpsert) UpdateUpdatedBy() *PostUpsert {
+	u.SetExcluded(post.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *PostUpsert) AddUpdatedBy(v uint32) *PostUpsert {
+	u.Add(post.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PostUpsert) ClearUpdatedBy() *PostUpsert {
+	u.SetNull(post.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PostUpsert) SetDeletedBy(v uint32) *PostUpsert {
+	u.Set(post.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PostUpsert) UpdateDeletedBy() *PostUpsert {
+	u.SetExcluded(post.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *PostUpsert) AddDeletedBy(v uint32) *PostUpsert {
+	u.Add(post.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PostUpsert) ClearDeletedBy() *PostUpsert {
+	u.SetNull(post.FieldDeletedBy)
+	return u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *PostUpsert) SetDeletedAt(v time.Time) *PostUpsert {
 	u.Set(post.FieldDeletedAt, v)
@@ -425,6 +570,9 @@ func (u *PostUpsertOne) UpdateNewValues() *PostUpsertOne {
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(post.FieldCreatedAt)
 		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(post.FieldCreatedBy)
+		}
 	}))
 	return u
 }
@@ -512,6 +660,83 @@ func (u *PostUpsertOne) UpdateDomainID() *PostUpsertOne {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *PostUpsertOne) SetVersion(v uint32) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *PostUpsertOne) AddVersion(v uint32) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateVersion() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PostUpsertOne) SetUpdatedBy(v uint32) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *PostUpsertOne) AddUpdatedBy(v uint32) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateUpdatedBy() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PostUpsertOne) ClearUpdatedBy() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PostUpsertOne) SetDeletedBy(v uint32) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *PostUpsertOne) AddDeletedBy(v uint32) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateDeletedBy() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PostUpsertOne) ClearDeletedBy() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *PostUpsertOne) SetDeletedAt(v time.Time) *PostUpsertOne {
 	return u.Update(func(s *PostUpsert) {
@@ -732,6 +957,9 @@ func (u *PostUpsertBulk) UpdateNewValues() *PostUpsertBulk {
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(post.FieldCreatedAt)
 			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(post.FieldCreatedBy)
+			}
 		}
 	}))
 	return u
@@ -820,6 +1048,83 @@ func (u *PostUpsertBulk) UpdateDomainID() *PostUpsertBulk {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *PostUpsertBulk) SetVersion(v uint32) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *PostUpsertBulk) AddVersion(v uint32) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateVersion() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PostUpsertBulk) SetUpdatedBy(v uint32) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *PostUpsertBulk) AddUpdatedBy(v uint32) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateUpdatedBy() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PostUpsertBulk) ClearUpdatedBy() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PostUpsertBulk) SetDeletedBy(v uint32) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *PostUpsertBulk) AddDeletedBy(v uint32) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateDeletedBy() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PostUpsertBulk) ClearDeletedBy() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *PostUpsertBulk) SetDeletedAt(v time.Time) *PostUpsertBulk {
 	return u.Update(func(s *PostUpsert) {
@@ -77,6 +77,81 @@ func (_u *PostUpdate) AddDomainID(v int32) *PostUpdate {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *PostUpdate) SetVersion(v uint32) *PostUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableVersion(v *uint32) *PostUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *PostUpdate) AddVersion(v int32) *PostUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PostUpdate) SetUpdatedBy(v uint32) *PostUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableUpdatedBy(v *uint32) *PostUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *PostUpdate) AddUpdatedBy(v int32) *PostUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PostUpdate) ClearUpdatedBy() *PostUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PostUpdate) SetDeletedBy(v uint32) *PostUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableDeletedBy(v *uint32) *PostUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *PostUpdate) AddDeletedBy(v int32) *PostUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PostUpdate) ClearDeletedBy() *PostUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (_u *PostUpdate) SetDeletedAt(v time.Time) *PostUpdate {
 	_u.mutation.SetDeletedAt(v)
@@ -211,6 +286,33 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedDomainID(); ok {
 		_spec.AddField(post.FieldDomainID, field.TypeUint32, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(post.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(post.FieldVersion, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(post.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(post.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(post.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(post.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(post.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(post.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(post.FieldDeletedBy, field.TypeUint32)
+	}
 	if value, ok := _u.mutation.DeletedAt(); ok {
 		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -290,6 +392,81 @@ func (_u *PostUpdateOne) AddDomainID(v int32) *PostUpdateOne {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *PostUpdateOne) SetVersion(v uint32) *PostUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableVersion(v *uint32) *PostUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *PostUpdateOne) AddVersion(v int32) *PostUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PostUpdateOne) SetUpdatedBy(v uint32) *PostUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableUpdatedBy(v *uint32) *PostUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *PostUpdateOne) AddUpdatedBy(v int32) *PostUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PostUpdateOne) ClearUpdatedBy() *PostUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PostUpdateOne) SetDeletedBy(v uint32) *PostUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableDeletedBy(v *uint32) *PostUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *PostUpdateOne) AddDeletedBy(v int32) *PostUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PostUpdateOne) ClearDeletedBy() *PostUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (_u *PostUpdateOne) SetDeletedAt(v time.Time) *PostUpdateOne {
 	_u.mutation.SetDeletedAt(v)
@@ -454,6 +631,33 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 	if value, ok := _u.mutation.AddedDomainID(); ok {
 		_spec.AddField(post.FieldDomainID, field.TypeUint32, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(post.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(post.FieldVersion, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(post.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(post.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(post.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(post.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(post.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(post.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(post.FieldDeletedBy, field.TypeUint32)
+	}
 	if value, ok := _u.mutation.DeletedAt(); ok {
 		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
 	}
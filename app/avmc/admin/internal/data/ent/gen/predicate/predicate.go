@@ -6,15 +6,27 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// CasbinRule is the predicate function for casbinrule builders.
+type CasbinRule func(*sql.Selector)
+
 // Dept is the predicate function for dept builders.
 type Dept func(*sql.Selector)
 
+// EntityHistory is the predicate function for entityhistory builders.
+type EntityHistory func(*sql.Selector)
+
+// ExportFile is the predicate function for exportfile builders.
+type ExportFile func(*sql.Selector)
+
 // Menu is the predicate function for menu builders.
 type Menu func(*sql.Selector)
 
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
+// RelationTuple is the predicate function for relationtuple builders.
+type RelationTuple func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
@@ -111,6 +111,30 @@ func DenyMutationOperationRule(op gen.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The CasbinRuleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CasbinRuleQueryRuleFunc func(context.Context, *gen.CasbinRuleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CasbinRuleQueryRuleFunc) EvalQuery(ctx context.Context, q gen.Query) error {
+	if q, ok := q.(*gen.CasbinRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("gen/privacy: unexpected query type %T, expect *gen.CasbinRuleQuery", q)
+}
+
+// The CasbinRuleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CasbinRuleMutationRuleFunc func(context.Context, *gen.CasbinRuleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CasbinRuleMutationRuleFunc) EvalMutation(ctx context.Context, m gen.Mutation) error {
+	if m, ok := m.(*gen.CasbinRuleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("gen/privacy: unexpected mutation type %T, expect *gen.CasbinRuleMutation", m)
+}
+
 // The DeptQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type DeptQueryRuleFunc func(context.Context, *gen.DeptQuery) error
@@ -135,6 +159,54 @@ func (f DeptMutationRuleFunc) EvalMutation(ctx context.Context, m gen.Mutation)
 	return Denyf("gen/privacy: unexpected mutation type %T, expect *gen.DeptMutation", m)
 }
 
+// The EntityHistoryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type EntityHistoryQueryRuleFunc func(context.Context, *gen.EntityHistoryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f EntityHistoryQueryRuleFunc) EvalQuery(ctx context.Context, q gen.Query) error {
+	if q, ok := q.(*gen.EntityHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("gen/privacy: unexpected query type %T, expect *gen.EntityHistoryQuery", q)
+}
+
+// The EntityHistoryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type EntityHistoryMutationRuleFunc func(context.Context, *gen.EntityHistoryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f EntityHistoryMutationRuleFunc) EvalMutation(ctx context.Context, m gen.Mutation) error {
+	if m, ok := m.(*gen.EntityHistoryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("gen/privacy: unexpected mutation type %T, expect *gen.EntityHistoryMutation", m)
+}
+
+// The ExportFileQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ExportFileQueryRuleFunc func(context.Context, *gen.ExportFileQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ExportFileQueryRuleFunc) EvalQuery(ctx context.Context, q gen.Query) error {
+	if q, ok := q.(*gen.ExportFileQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("gen/privacy: unexpected query type %T, expect *gen.ExportFileQuery", q)
+}
+
+// The ExportFileMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ExportFileMutationRuleFunc func(context.Context, *gen.ExportFileMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ExportFileMutationRuleFunc) EvalMutation(ctx context.Context, m gen.Mutation) error {
+	if m, ok := m.(*gen.ExportFileMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("gen/privacy: unexpected mutation type %T, expect *gen.ExportFileMutation", m)
+}
+
 // The MenuQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type MenuQueryRuleFunc func(context.Context, *gen.MenuQuery) error
@@ -183,6 +255,30 @@ func (f PostMutationRuleFunc) EvalMutation(ctx context.Context, m gen.Mutation)
 	return Denyf("gen/privacy: unexpected mutation type %T, expect *gen.PostMutation", m)
 }
 
+// The RelationTupleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RelationTupleQueryRuleFunc func(context.Context, *gen.RelationTupleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RelationTupleQueryRuleFunc) EvalQuery(ctx context.Context, q gen.Query) error {
+	if q, ok := q.(*gen.RelationTupleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("gen/privacy: unexpected query type %T, expect *gen.RelationTupleQuery", q)
+}
+
+// The RelationTupleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RelationTupleMutationRuleFunc func(context.Context, *gen.RelationTupleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RelationTupleMutationRuleFunc) EvalMutation(ctx context.Context, m gen.Mutation) error {
+	if m, ok := m.(*gen.RelationTupleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("gen/privacy: unexpected mutation type %T, expect *gen.RelationTupleMutation", m)
+}
+
 // The RoleQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type RoleQueryRuleFunc func(context.Context, *gen.RoleQuery) error
@@ -266,12 +362,20 @@ var _ QueryMutationRule = FilterFunc(nil)
 
 func queryFilter(q gen.Query) (Filter, error) {
 	switch q := q.(type) {
+	case *gen.CasbinRuleQuery:
+		return q.Filter(), nil
 	case *gen.DeptQuery:
 		return q.Filter(), nil
+	case *gen.EntityHistoryQuery:
+		return q.Filter(), nil
+	case *gen.ExportFileQuery:
+		return q.Filter(), nil
 	case *gen.MenuQuery:
 		return q.Filter(), nil
 	case *gen.PostQuery:
 		return q.Filter(), nil
+	case *gen.RelationTupleQuery:
+		return q.Filter(), nil
 	case *gen.RoleQuery:
 		return q.Filter(), nil
 	case *gen.UserQuery:
@@ -283,12 +387,20 @@ func queryFilter(q gen.Query) (Filter, error) {
 
 func mutationFilter(m gen.Mutation) (Filter, error) {
 	switch m := m.(type) {
+	case *gen.CasbinRuleMutation:
+		return m.Filter(), nil
 	case *gen.DeptMutation:
 		return m.Filter(), nil
+	case *gen.EntityHistoryMutation:
+		return m.Filter(), nil
+	case *gen.ExportFileMutation:
+		return m.Filter(), nil
 	case *gen.MenuMutation:
 		return m.Filter(), nil
 	case *gen.PostMutation:
 		return m.Filter(), nil
+	case *gen.RelationTupleMutation:
+		return m.Filter(), nil
 	case *gen.RoleMutation:
 		return m.Filter(), nil
 	case *gen.UserMutation:
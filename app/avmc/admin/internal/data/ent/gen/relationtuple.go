@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/relationtuple"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 关系元组表
+type RelationTuple struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint64 `json:"id,omitempty"`
+	// 对象命名空间
+	ObjectNamespace string `json:"object_namespace,omitempty"`
+	// 对象ID
+	ObjectID string `json:"object_id,omitempty"`
+	// 关系
+	Relation string `json:"relation,omitempty"`
+	// 主体命名空间
+	SubjectNamespace string `json:"subject_namespace,omitempty"`
+	// 主体ID
+	SubjectID string `json:"subject_id,omitempty"`
+	// 主体关系，为空表示直接主体
+	SubjectRelation string `json:"subject_relation,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RelationTuple) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case relationtuple.FieldID:
+			values[i] = new(sql.NullInt64)
+		case relationtuple.FieldObjectNamespace, relationtuple.FieldObjectID, relationtuple.FieldRelation, relationtuple.FieldSubjectNamespace, relationtuple.FieldSubjectID, relationtuple.FieldSubjectRelation:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RelationTuple fields.
+func (_m *RelationTuple) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case relationtuple.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case relationtuple.FieldObjectNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_namespace", values[i])
+			} else if value.Valid {
+				_m.ObjectNamespace = value.String
+			}
+		case relationtuple.FieldObjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_id", values[i])
+			} else if value.Valid {
+				_m.ObjectID = value.String
+			}
+		case relationtuple.FieldRelation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field relation", values[i])
+			} else if value.Valid {
+				_m.Relation = value.String
+			}
+		case relationtuple.FieldSubjectNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_namespace", values[i])
+			} else if value.Valid {
+				_m.SubjectNamespace = value.String
+			}
+		case relationtuple.FieldSubjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_id", values[i])
+			} else if value.Valid {
+				_m.SubjectID = value.String
+			}
+		case relationtuple.FieldSubjectRelation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_relation", values[i])
+			} else if value.Valid {
+				_m.SubjectRelation = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RelationTuple.
+// This includes values selected through modifiers, order, etc.
+func (_m *RelationTuple) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RelationTuple.
+// Note that you need to call RelationTuple.Unwrap() before calling this method if this RelationTuple
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RelationTuple) Update() *RelationTupleUpdateOne {
+	return NewRelationTupleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RelationTuple entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RelationTuple) Unwrap() *RelationTuple {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("gen: RelationTuple is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RelationTuple) String() string {
+	var builder strings.Builder
+	builder.WriteString("RelationTuple(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("object_namespace=")
+	builder.WriteString(_m.ObjectNamespace)
+	builder.WriteString(", ")
+	builder.WriteString("object_id=")
+	builder.WriteString(_m.ObjectID)
+	builder.WriteString(", ")
+	builder.WriteString("relation=")
+	builder.WriteString(_m.Relation)
+	builder.WriteString(", ")
+	builder.WriteString("subject_namespace=")
+	builder.WriteString(_m.SubjectNamespace)
+	builder.WriteString(", ")
+	builder.WriteString("subject_id=")
+	builder.WriteString(_m.SubjectID)
+	builder.WriteString(", ")
+	builder.WriteString("subject_relation=")
+	builder.WriteString(_m.SubjectRelation)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RelationTuples is a parsable slice of RelationTuple.
+type RelationTuples []*RelationTuple
@@ -0,0 +1,104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package relationtuple
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the relationtuple type in the database.
+	Label = "relation_tuple"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldObjectNamespace holds the string denoting the object_namespace field in the database.
+	FieldObjectNamespace = "object_namespace"
+	// FieldObjectID holds the string denoting the object_id field in the database.
+	FieldObjectID = "object_id"
+	// FieldRelation holds the string denoting the relation field in the database.
+	FieldRelation = "relation"
+	// FieldSubjectNamespace holds the string denoting the subject_namespace field in the database.
+	FieldSubjectNamespace = "subject_namespace"
+	// FieldSubjectID holds the string denoting the subject_id field in the database.
+	FieldSubjectID = "subject_id"
+	// FieldSubjectRelation holds the string denoting the subject_relation field in the database.
+	FieldSubjectRelation = "subject_relation"
+	// Table holds the table name of the relationtuple in the database.
+	Table = "relation_tuple"
+)
+
+// Columns holds all SQL columns for relationtuple fields.
+var Columns = []string{
+	FieldID,
+	FieldObjectNamespace,
+	FieldObjectID,
+	FieldRelation,
+	FieldSubjectNamespace,
+	FieldSubjectID,
+	FieldSubjectRelation,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ObjectNamespaceValidator is a validator for the "object_namespace" field. It is called by the builders before save.
+	ObjectNamespaceValidator func(string) error
+	// ObjectIDValidator is a validator for the "object_id" field. It is called by the builders before save.
+	ObjectIDValidator func(string) error
+	// RelationValidator is a validator for the "relation" field. It is called by the builders before save.
+	RelationValidator func(string) error
+	// SubjectNamespaceValidator is a validator for the "subject_namespace" field. It is called by the builders before save.
+	SubjectNamespaceValidator func(string) error
+	// SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	SubjectIDValidator func(string) error
+	// DefaultSubjectRelation holds the default value on creation for the "subject_relation" field.
+	DefaultSubjectRelation string
+	// SubjectRelationValidator is a validator for the "subject_relation" field. It is called by the builders before save.
+	SubjectRelationValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the RelationTuple queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByObjectNamespace orders the results by the object_namespace field.
+func ByObjectNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectNamespace, opts...).ToFunc()
+}
+
+// ByObjectID orders the results by the object_id field.
+func ByObjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectID, opts...).ToFunc()
+}
+
+// ByRelation orders the results by the relation field.
+func ByRelation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRelation, opts...).ToFunc()
+}
+
+// BySubjectNamespace orders the results by the subject_namespace field.
+func BySubjectNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectNamespace, opts...).ToFunc()
+}
+
+// BySubjectID orders the results by the subject_id field.
+func BySubjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectID, opts...).ToFunc()
+}
+
+// BySubjectRelation orders the results by the subject_relation field.
+func BySubjectRelation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectRelation, opts...).ToFunc()
+}
@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package relationtuple
+
+import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldID, id))
+}
+
+// ObjectNamespace applies equality check predicate on the "object_namespace" field. It's identical to ObjectNamespaceEQ.
+func ObjectNamespace(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldObjectNamespace, v))
+}
+
+// ObjectID applies equality check predicate on the "object_id" field. It's identical to ObjectIDEQ.
+func ObjectID(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldObjectID, v))
+}
+
+// Relation applies equality check predicate on the "relation" field. It's identical to RelationEQ.
+func Relation(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldRelation, v))
+}
+
+// SubjectNamespace applies equality check predicate on the "subject_namespace" field. It's identical to SubjectNamespaceEQ.
+func SubjectNamespace(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectNamespace, v))
+}
+
+// SubjectID applies equality check predicate on the "subject_id" field. It's identical to SubjectIDEQ.
+func SubjectID(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SubjectRelation applies equality check predicate on the "subject_relation" field. It's identical to SubjectRelationEQ.
+func SubjectRelation(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectRelation, v))
+}
+
+// ObjectNamespaceEQ applies the EQ predicate on the "object_namespace" field.
+func ObjectNamespaceEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldObjectNamespace, v))
+}
+
+// ObjectNamespaceNEQ applies the NEQ predicate on the "object_namespace" field.
+func ObjectNamespaceNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldObjectNamespace, v))
+}
+
+// ObjectNamespaceIn applies the In predicate on the "object_namespace" field.
+func ObjectNamespaceIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldObjectNamespace, vs...))
+}
+
+// ObjectNamespaceNotIn applies the NotIn predicate on the "object_namespace" field.
+func ObjectNamespaceNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldObjectNamespace, vs...))
+}
+
+// ObjectNamespaceGT applies the GT predicate on the "object_namespace" field.
+func ObjectNamespaceGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldObjectNamespace, v))
+}
+
+// ObjectNamespaceGTE applies the GTE predicate on the "object_namespace" field.
+func ObjectNamespaceGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldObjectNamespace, v))
+}
+
+// ObjectNamespaceLT applies the LT predicate on the "object_namespace" field.
+func ObjectNamespaceLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldObjectNamespace, v))
+}
+
+// ObjectNamespaceLTE applies the LTE predicate on the "object_namespace" field.
+func ObjectNamespaceLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldObjectNamespace, v))
+}
+
+// ObjectNamespaceContains applies the Contains predicate on the "object_namespace" field.
+func ObjectNamespaceContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldObjectNamespace, v))
+}
+
+// ObjectNamespaceHasPrefix applies the HasPrefix predicate on the "object_namespace" field.
+func ObjectNamespaceHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldObjectNamespace, v))
+}
+
+// ObjectNamespaceHasSuffix applies the HasSuffix predicate on the "object_namespace" field.
+func ObjectNamespaceHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldObjectNamespace, v))
+}
+
+// ObjectNamespaceEqualFold applies the EqualFold predicate on the "object_namespace" field.
+func ObjectNamespaceEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldObjectNamespace, v))
+}
+
+// ObjectNamespaceContainsFold applies the ContainsFold predicate on the "object_namespace" field.
+func ObjectNamespaceContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldObjectNamespace, v))
+}
+
+// ObjectIDEQ applies the EQ predicate on the "object_id" field.
+func ObjectIDEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldObjectID, v))
+}
+
+// ObjectIDNEQ applies the NEQ predicate on the "object_id" field.
+func ObjectIDNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldObjectID, v))
+}
+
+// ObjectIDIn applies the In predicate on the "object_id" field.
+func ObjectIDIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldObjectID, vs...))
+}
+
+// ObjectIDNotIn applies the NotIn predicate on the "object_id" field.
+func ObjectIDNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldObjectID, vs...))
+}
+
+// ObjectIDGT applies the GT predicate on the "object_id" field.
+func ObjectIDGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldObjectID, v))
+}
+
+// ObjectIDGTE applies the GTE predicate on the "object_id" field.
+func ObjectIDGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldObjectID, v))
+}
+
+// ObjectIDLT applies the LT predicate on the "object_id" field.
+func ObjectIDLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldObjectID, v))
+}
+
+// ObjectIDLTE applies the LTE predicate on the "object_id" field.
+func ObjectIDLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldObjectID, v))
+}
+
+// ObjectIDContains applies the Contains predicate on the "object_id" field.
+func ObjectIDContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldObjectID, v))
+}
+
+// ObjectIDHasPrefix applies the HasPrefix predicate on the "object_id" field.
+func ObjectIDHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldObjectID, v))
+}
+
+// ObjectIDHasSuffix applies the HasSuffix predicate on the "object_id" field.
+func ObjectIDHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldObjectID, v))
+}
+
+// ObjectIDEqualFold applies the EqualFold predicate on the "object_id" field.
+func ObjectIDEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldObjectID, v))
+}
+
+// ObjectIDContainsFold applies the ContainsFold predicate on the "object_id" field.
+func ObjectIDContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldObjectID, v))
+}
+
+// RelationEQ applies the EQ predicate on the "relation" field.
+func RelationEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldRelation, v))
+}
+
+// RelationNEQ applies the NEQ predicate on the "relation" field.
+func RelationNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldRelation, v))
+}
+
+// RelationIn applies the In predicate on the "relation" field.
+func RelationIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldRelation, vs...))
+}
+
+// RelationNotIn applies the NotIn predicate on the "relation" field.
+func RelationNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldRelation, vs...))
+}
+
+// RelationGT applies the GT predicate on the "relation" field.
+func RelationGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldRelation, v))
+}
+
+// RelationGTE applies the GTE predicate on the "relation" field.
+func RelationGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldRelation, v))
+}
+
+// RelationLT applies the LT predicate on the "relation" field.
+func RelationLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldRelation, v))
+}
+
+// RelationLTE applies the LTE predicate on the "relation" field.
+func RelationLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldRelation, v))
+}
+
+// RelationContains applies the Contains predicate on the "relation" field.
+func RelationContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldRelation, v))
+}
+
+// RelationHasPrefix applies the HasPrefix predicate on the "relation" field.
+func RelationHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldRelation, v))
+}
+
+// RelationHasSuffix applies the HasSuffix predicate on the "relation" field.
+func RelationHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldRelation, v))
+}
+
+// RelationEqualFold applies the EqualFold predicate on the "relation" field.
+func RelationEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldRelation, v))
+}
+
+// RelationContainsFold applies the ContainsFold predicate on the "relation" field.
+func RelationContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldRelation, v))
+}
+
+// SubjectNamespaceEQ applies the EQ predicate on the "subject_namespace" field.
+func SubjectNamespaceEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceNEQ applies the NEQ predicate on the "subject_namespace" field.
+func SubjectNamespaceNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceIn applies the In predicate on the "subject_namespace" field.
+func SubjectNamespaceIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldSubjectNamespace, vs...))
+}
+
+// SubjectNamespaceNotIn applies the NotIn predicate on the "subject_namespace" field.
+func SubjectNamespaceNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldSubjectNamespace, vs...))
+}
+
+// SubjectNamespaceGT applies the GT predicate on the "subject_namespace" field.
+func SubjectNamespaceGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceGTE applies the GTE predicate on the "subject_namespace" field.
+func SubjectNamespaceGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceLT applies the LT predicate on the "subject_namespace" field.
+func SubjectNamespaceLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceLTE applies the LTE predicate on the "subject_namespace" field.
+func SubjectNamespaceLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceContains applies the Contains predicate on the "subject_namespace" field.
+func SubjectNamespaceContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceHasPrefix applies the HasPrefix predicate on the "subject_namespace" field.
+func SubjectNamespaceHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceHasSuffix applies the HasSuffix predicate on the "subject_namespace" field.
+func SubjectNamespaceHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceEqualFold applies the EqualFold predicate on the "subject_namespace" field.
+func SubjectNamespaceEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceContainsFold applies the ContainsFold predicate on the "subject_namespace" field.
+func SubjectNamespaceContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldSubjectNamespace, v))
+}
+
+// SubjectIDEQ applies the EQ predicate on the "subject_id" field.
+func SubjectIDEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SubjectIDNEQ applies the NEQ predicate on the "subject_id" field.
+func SubjectIDNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldSubjectID, v))
+}
+
+// SubjectIDIn applies the In predicate on the "subject_id" field.
+func SubjectIDIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDNotIn applies the NotIn predicate on the "subject_id" field.
+func SubjectIDNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDGT applies the GT predicate on the "subject_id" field.
+func SubjectIDGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldSubjectID, v))
+}
+
+// SubjectIDGTE applies the GTE predicate on the "subject_id" field.
+func SubjectIDGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldSubjectID, v))
+}
+
+// SubjectIDLT applies the LT predicate on the "subject_id" field.
+func SubjectIDLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldSubjectID, v))
+}
+
+// SubjectIDLTE applies the LTE predicate on the "subject_id" field.
+func SubjectIDLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldSubjectID, v))
+}
+
+// SubjectIDContains applies the Contains predicate on the "subject_id" field.
+func SubjectIDContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldSubjectID, v))
+}
+
+// SubjectIDHasPrefix applies the HasPrefix predicate on the "subject_id" field.
+func SubjectIDHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldSubjectID, v))
+}
+
+// SubjectIDHasSuffix applies the HasSuffix predicate on the "subject_id" field.
+func SubjectIDHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldSubjectID, v))
+}
+
+// SubjectIDEqualFold applies the EqualFold predicate on the "subject_id" field.
+func SubjectIDEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldSubjectID, v))
+}
+
+// SubjectIDContainsFold applies the ContainsFold predicate on the "subject_id" field.
+func SubjectIDContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldSubjectID, v))
+}
+
+// SubjectRelationEQ applies the EQ predicate on the "subject_relation" field.
+func SubjectRelationEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectRelation, v))
+}
+
+// SubjectRelationNEQ applies the NEQ predicate on the "subject_relation" field.
+func SubjectRelationNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldSubjectRelation, v))
+}
+
+// SubjectRelationIn applies the In predicate on the "subject_relation" field.
+func SubjectRelationIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldSubjectRelation, vs...))
+}
+
+// SubjectRelationNotIn applies the NotIn predicate on the "subject_relation" field.
+func SubjectRelationNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldSubjectRelation, vs...))
+}
+
+// SubjectRelationGT applies the GT predicate on the "subject_relation" field.
+func SubjectRelationGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldSubjectRelation, v))
+}
+
+// SubjectRelationGTE applies the GTE predicate on the "subject_relation" field.
+func SubjectRelationGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldSubjectRelation, v))
+}
+
+// SubjectRelationLT applies the LT predicate on the "subject_relation" field.
+func SubjectRelationLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldSubjectRelation, v))
+}
+
+// SubjectRelationLTE applies the LTE predicate on the "subject_relation" field.
+func SubjectRelationLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldSubjectRelation, v))
+}
+
+// SubjectRelationContains applies the Contains predicate on the "subject_relation" field.
+func SubjectRelationContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldSubjectRelation, v))
+}
+
+// SubjectRelationHasPrefix applies the HasPrefix predicate on the "subject_relation" field.
+func SubjectRelationHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldSubjectRelation, v))
+}
+
+// SubjectRelationHasSuffix applies the HasSuffix predicate on the "subject_relation" field.
+func SubjectRelationHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldSubjectRelation, v))
+}
+
+// SubjectRelationEqualFold applies the EqualFold predicate on the "subject_relation" field.
+func SubjectRelationEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldSubjectRelation, v))
+}
+
+// SubjectRelationContainsFold applies the ContainsFold predicate on the "subject_relation" field.
+func SubjectRelationContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldSubjectRelation, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RelationTuple) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RelationTuple) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RelationTuple) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.NotPredicates(p))
+}
@@ -0,0 +1,797 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/relationtuple"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RelationTupleCreate is the builder for creating a RelationTuple entity.
+type RelationTupleCreate struct {
+	config
+	mutation *RelationTupleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetObjectNamespace sets the "object_namespace" field.
+func (_c *RelationTupleCreate) SetObjectNamespace(v string) *RelationTupleCreate {
+	_c.mutation.SetObjectNamespace(v)
+	return _c
+}
+
+// SetObjectID sets the "object_id" field.
+func (_c *RelationTupleCreate) SetObjectID(v string) *RelationTupleCreate {
+	_c.mutation.SetObjectID(v)
+	return _c
+}
+
+// SetRelation sets the "relation" field.
+func (_c *RelationTupleCreate) SetRelation(v string) *RelationTupleCreate {
+	_c.mutation.SetRelation(v)
+	return _c
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (_c *RelationTupleCreate) SetSubjectNamespace(v string) *RelationTupleCreate {
+	_c.mutation.SetSubjectNamespace(v)
+	return _c
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_c *RelationTupleCreate) SetSubjectID(v string) *RelationTupleCreate {
+	_c.mutation.SetSubjectID(v)
+	return _c
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (_c *RelationTupleCreate) SetSubjectRelation(v string) *RelationTupleCreate {
+	_c.mutation.SetSubjectRelation(v)
+	return _c
+}
+
+// SetNillableSubjectRelation sets the "subject_relation" field if the given value is not nil.
+func (_c *RelationTupleCreate) SetNillableSubjectRelation(v *string) *RelationTupleCreate {
+	if v != nil {
+		_c.SetSubjectRelation(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RelationTupleCreate) SetID(v uint64) *RelationTupleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the RelationTupleMutation object of the builder.
+func (_c *RelationTupleCreate) Mutation() *RelationTupleMutation {
+	return _c.mutation
+}
+
+// Save creates the RelationTuple in the database.
+func (_c *RelationTupleCreate) Save(ctx context.Context) (*RelationTuple, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RelationTupleCreate) SaveX(ctx context.Context) *RelationTuple {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RelationTupleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RelationTupleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RelationTupleCreate) defaults() {
+	if _, ok := _c.mutation.SubjectRelation(); !ok {
+		v := relationtuple.DefaultSubjectRelation
+		_c.mutation.SetSubjectRelation(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RelationTupleCreate) check() error {
+	if _, ok := _c.mutation.ObjectNamespace(); !ok {
+		return &ValidationError{Name: "object_namespace", err: errors.New(`gen: missing required field "RelationTuple.object_namespace"`)}
+	}
+	if v, ok := _c.mutation.ObjectNamespace(); ok {
+		if err := relationtuple.ObjectNamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "object_namespace", err: fmt.Errorf(`gen: validator failed for field "RelationTuple.object_namespace": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ObjectID(); !ok {
+		return &ValidationError{Name: "object_id", err: errors.New(`gen: missing required field "RelationTuple.object_id"`)}
+	}
+	if v, ok := _c.mutation.ObjectID(); ok {
+		if err := relationtuple.ObjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "object_id", err: fmt.Errorf(`gen: validator failed for field "RelationTuple.object_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Relation(); !ok {
+		return &ValidationError{Name: "relation", err: errors.New(`gen: missing required field "RelationTuple.relation"`)}
+	}
+	if v, ok := _c.mutation.Relation(); ok {
+		if err := relationtuple.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`gen: validator failed for field "RelationTuple.relation": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SubjectNamespace(); !ok {
+		return &ValidationError{Name: "subject_namespace", err: errors.New(`gen: missing required field "RelationTuple.subject_namespace"`)}
+	}
+	if v, ok := _c.mutation.SubjectNamespace(); ok {
+		if err := relationtuple.SubjectNamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "subject_namespace", err: fmt.Errorf(`gen: validator failed for field "RelationTuple.subject_namespace": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SubjectID(); !ok {
+		return &ValidationError{Name: "subject_id", err: errors.New(`gen: missing required field "RelationTuple.subject_id"`)}
+	}
+	if v, ok := _c.mutation.SubjectID(); ok {
+		if err := relationtuple.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`gen: validator failed for field "RelationTuple.subject_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SubjectRelation(); !ok {
+		return &ValidationError{Name: "subject_relation", err: errors.New(`gen: missing required field "RelationTuple.subject_relation"`)}
+	}
+	if v, ok := _c.mutation.SubjectRelation(); ok {
+		if err := relationtuple.SubjectRelationValidator(v); err != nil {
+			return &ValidationError{Name: "subject_relation", err: fmt.Errorf(`gen: validator failed for field "RelationTuple.subject_relation": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *RelationTupleCreate) sqlSave(ctx context.Context) (*RelationTuple, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RelationTupleCreate) createSpec() (*RelationTuple, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RelationTuple{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(relationtuple.Table, sqlgraph.NewFieldSpec(relationtuple.FieldID, field.TypeUint64))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ObjectNamespace(); ok {
+		_spec.SetField(relationtuple.FieldObjectNamespace, field.TypeString, value)
+		_node.ObjectNamespace = value
+	}
+	if value, ok := _c.mutation.ObjectID(); ok {
+		_spec.SetField(relationtuple.FieldObjectID, field.TypeString, value)
+		_node.ObjectID = value
+	}
+	if value, ok := _c.mutation.Relation(); ok {
+		_spec.SetField(relationtuple.FieldRelation, field.TypeString, value)
+		_node.Relation = value
+	}
+	if value, ok := _c.mutation.SubjectNamespace(); ok {
+		_spec.SetField(relationtuple.FieldSubjectNamespace, field.TypeString, value)
+		_node.SubjectNamespace = value
+	}
+	if value, ok := _c.mutation.SubjectID(); ok {
+		_spec.SetField(relationtuple.FieldSubjectID, field.TypeString, value)
+		_node.SubjectID = value
+	}
+	if value, ok := _c.mutation.SubjectRelation(); ok {
+		_spec.SetField(relationtuple.FieldSubjectRelation, field.TypeString, value)
+		_node.SubjectRelation = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RelationTuple.Create().
+//		SetObjectNamespace(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RelationTupleUpsert) {
+//			SetObjectNamespace(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RelationTupleCreate) OnConflict(opts ...sql.ConflictOption) *RelationTupleUpsertOne {
+	_c.conflict = opts
+	return &RelationTupleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RelationTupleCreate) OnConflictColumns(columns ...string) *RelationTupleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RelationTupleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RelationTupleUpsertOne is the builder for "upsert"-ing
+	//  one RelationTuple node.
+	RelationTupleUpsertOne struct {
+		create *RelationTupleCreate
+	}
+
+	// RelationTupleUpsert is the "OnConflict" setter.
+	RelationTupleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetObjectNamespace sets the "object_namespace" field.
+func (u *RelationTupleUpsert) SetObjectNamespace(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldObjectNamespace, v)
+	return u
+}
+
+// UpdateObjectNamespace sets the "object_namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateObjectNamespace() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldObjectNamespace)
+	return u
+}
+
+// SetObjectID sets the "object_id" field.
+func (u *RelationTupleUpsert) SetObjectID(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldObjectID, v)
+	return u
+}
+
+// UpdateObjectID sets the "object_id" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateObjectID() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldObjectID)
+	return u
+}
+
+// SetRelation sets the "relation" field.
+func (u *RelationTupleUpsert) SetRelation(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldRelation, v)
+	return u
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateRelation() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldRelation)
+	return u
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (u *RelationTupleUpsert) SetSubjectNamespace(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldSubjectNamespace, v)
+	return u
+}
+
+// UpdateSubjectNamespace sets the "subject_namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateSubjectNamespace() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldSubjectNamespace)
+	return u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *RelationTupleUpsert) SetSubjectID(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldSubjectID, v)
+	return u
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateSubjectID() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldSubjectID)
+	return u
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (u *RelationTupleUpsert) SetSubjectRelation(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldSubjectRelation, v)
+	return u
+}
+
+// UpdateSubjectRelation sets the "subject_relation" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateSubjectRelation() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldSubjectRelation)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(relationtuple.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RelationTupleUpsertOne) UpdateNewValues() *RelationTupleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(relationtuple.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RelationTupleUpsertOne) Ignore() *RelationTupleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RelationTupleUpsertOne) DoNothing() *RelationTupleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RelationTupleCreate.OnConflict
+// documentation for more info.
+func (u *RelationTupleUpsertOne) Update(set func(*RelationTupleUpsert)) *RelationTupleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RelationTupleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetObjectNamespace sets the "object_namespace" field.
+func (u *RelationTupleUpsertOne) SetObjectNamespace(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetObjectNamespace(v)
+	})
+}
+
+// UpdateObjectNamespace sets the "object_namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateObjectNamespace() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateObjectNamespace()
+	})
+}
+
+// SetObjectID sets the "object_id" field.
+func (u *RelationTupleUpsertOne) SetObjectID(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetObjectID(v)
+	})
+}
+
+// UpdateObjectID sets the "object_id" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateObjectID() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateObjectID()
+	})
+}
+
+// SetRelation sets the "relation" field.
+func (u *RelationTupleUpsertOne) SetRelation(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetRelation(v)
+	})
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateRelation() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateRelation()
+	})
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (u *RelationTupleUpsertOne) SetSubjectNamespace(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectNamespace(v)
+	})
+}
+
+// UpdateSubjectNamespace sets the "subject_namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateSubjectNamespace() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectNamespace()
+	})
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *RelationTupleUpsertOne) SetSubjectID(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateSubjectID() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (u *RelationTupleUpsertOne) SetSubjectRelation(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectRelation(v)
+	})
+}
+
+// UpdateSubjectRelation sets the "subject_relation" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateSubjectRelation() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectRelation()
+	})
+}
+
+// Exec executes the query.
+func (u *RelationTupleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("gen: missing options for RelationTupleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RelationTupleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RelationTupleUpsertOne) ID(ctx context.Context) (id uint64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RelationTupleUpsertOne) IDX(ctx context.Context) uint64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RelationTupleCreateBulk is the builder for creating many RelationTuple entities in bulk.
+type RelationTupleCreateBulk struct {
+	config
+	err      error
+	builders []*RelationTupleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RelationTuple entities in the database.
+func (_c *RelationTupleCreateBulk) Save(ctx context.Context) ([]*RelationTuple, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RelationTuple, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RelationTupleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RelationTupleCreateBulk) SaveX(ctx context.Context) []*RelationTuple {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RelationTupleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RelationTupleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RelationTuple.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RelationTupleUpsert) {
+//			SetObjectNamespace(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RelationTupleCreateBulk) OnConflict(opts ...sql.ConflictOption) *RelationTupleUpsertBulk {
+	_c.conflict = opts
+	return &RelationTupleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RelationTupleCreateBulk) OnConflictColumns(columns ...string) *RelationTupleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RelationTupleUpsertBulk{
+		create: _c,
+	}
+}
+
+// RelationTupleUpsertBulk is the builder for "upsert"-ing
+// a bulk of RelationTuple nodes.
+type RelationTupleUpsertBulk struct {
+	create *RelationTupleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(relationtuple.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RelationTupleUpsertBulk) UpdateNewValues() *RelationTupleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(relationtuple.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RelationTupleUpsertBulk) Ignore() *RelationTupleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RelationTupleUpsertBulk) DoNothing() *RelationTupleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RelationTupleCreateBulk.OnConflict
+// documentation for more info.
+func (u *RelationTupleUpsertBulk) Update(set func(*RelationTupleUpsert)) *RelationTupleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RelationTupleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetObjectNamespace sets the "object_namespace" field.
+func (u *RelationTupleUpsertBulk) SetObjectNamespace(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetObjectNamespace(v)
+	})
+}
+
+// UpdateObjectNamespace sets the "object_namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateObjectNamespace() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateObjectNamespace()
+	})
+}
+
+// SetObjectID sets the "object_id" field.
+func (u *RelationTupleUpsertBulk) SetObjectID(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetObjectID(v)
+	})
+}
+
+// UpdateObjectID sets the "object_id" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateObjectID() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateObjectID()
+	})
+}
+
+// SetRelation sets the "relation" field.
+func (u *RelationTupleUpsertBulk) SetRelation(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetRelation(v)
+	})
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateRelation() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateRelation()
+	})
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (u *RelationTupleUpsertBulk) SetSubjectNamespace(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectNamespace(v)
+	})
+}
+
+// UpdateSubjectNamespace sets the "subject_namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateSubjectNamespace() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectNamespace()
+	})
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *RelationTupleUpsertBulk) SetSubjectID(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateSubjectID() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (u *RelationTupleUpsertBulk) SetSubjectRelation(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectRelation(v)
+	})
+}
+
+// UpdateSubjectRelation sets the "subject_relation" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateSubjectRelation() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectRelation()
+	})
+}
+
+// Exec executes the query.
+func (u *RelationTupleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("gen: OnConflict was set for builder %d. Set it on the RelationTupleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("gen: missing options for RelationTupleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RelationTupleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
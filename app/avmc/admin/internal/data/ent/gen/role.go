@@ -26,6 +26,14 @@ type Role struct {
 	Status *int32 `json:"status,omitempty"`
 	// 域ID
 	DomainID uint32 `json:"domain_id,omitempty"`
+	// 版本号，乐观锁
+	Version uint32 `json:"version,omitempty"`
+	// 创建人ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 更新人ID
+	UpdatedBy *uint32 `json:"updated_by,omitempty"`
+	// 删除人ID
+	DeletedBy *uint32 `json:"deleted_by,omitempty"`
 	// 删除时间
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// 名称
@@ -68,7 +76,7 @@ func (*Role) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case role.FieldID, role.FieldStatus, role.FieldDomainID, role.FieldDataScope, role.FieldMenuCheckStrictly, role.FieldDeptCheckStrictly:
+		case role.FieldID, role.FieldStatus, role.FieldDomainID, role.FieldVersion, role.FieldCreatedBy, role.FieldUpdatedBy, role.FieldDeletedBy, role.FieldDataScope, role.FieldMenuCheckStrictly, role.FieldDeptCheckStrictly:
 			values[i] = new(sql.NullInt64)
 		case role.FieldName, role.FieldDefaultRouter:
 			values[i] = new(sql.NullString)
@@ -120,6 +128,33 @@ func (_m *Role) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DomainID = uint32(value.Int64)
 			}
+		case role.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = uint32(value.Int64)
+			}
+		case role.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case role.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(uint32)
+				*_m.UpdatedBy = uint32(value.Int64)
+			}
+		case role.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = new(uint32)
+				*_m.DeletedBy = uint32(value.Int64)
+			}
 		case role.FieldDeletedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
@@ -217,6 +252,24 @@ func (_m *Role) String() string {
 	builder.WriteString("domain_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DomainID))
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedBy; v != nil {
+		builder.WriteString("deleted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.DeletedAt; v != nil {
 		builder.WriteString("deleted_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -79,6 +79,62 @@ func (_c *RoleCreate) SetNillableDomainID(v *uint32) *RoleCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *RoleCreate) SetVersion(v uint32) *RoleCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableVersion(v *uint32) *RoleCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *RoleCreate) SetCreatedBy(v uint32) *RoleCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableCreatedBy(v *uint32) *RoleCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *RoleCreate) SetUpdatedBy(v uint32) *RoleCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableUpdatedBy(v *uint32) *RoleCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *RoleCreate) SetDeletedBy(v uint32) *RoleCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableDeletedBy(v *uint32) *RoleCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (_c *RoleCreate) SetDeletedAt(v time.Time) *RoleCreate {
 	_c.mutation.SetDeletedAt(v)
@@ -246,6 +302,10 @@ func (_c *RoleCreate) defaults() error {
 		v := role.DefaultDomainID()
 		_c.mutation.SetDomainID(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := role.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		v := role.DefaultName
 		_c.mutation.SetName(v)
@@ -293,6 +353,9 @@ func (_c *RoleCreate) check() error {
 			return &ValidationError{Name: "domain_id", err: fmt.Errorf(`gen: validator failed for field "Role.domain_id": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`gen: missing required field "Role.version"`)}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`gen: missing required field "Role.name"`)}
 	}
@@ -372,6 +435,22 @@ func (_c *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldDomainID, field.TypeUint32, value)
 		_node.DomainID = value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(role.FieldVersion, field.TypeUint32, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(role.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(role.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(role.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
 	if value, ok := _c.mutation.DeletedAt(); ok {
 		_spec.SetField(role.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
@@ -512,6 +591,72 @@ func (u *RoleUpsert) AddDomainID(v uint32) *RoleUpsert {
 	return u
 }
 
+// SetVersion sets the "version" field.
+func (u *RoleUpsert) SetVersion(v uint32) *RoleUpsert {
+	u.Set(role.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *RoleUpsert) UpdateVersion() *RoleUpsert {
+	u.SetExcluded(role.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *RoleUpsert) AddVersion(v uint32) *RoleUpsert {
+	u.Add(role.FieldVersion, v)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *RoleUpsert) SetUpdatedBy(v uint32) *RoleUpsert {
+	u.Set(role.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *RoleUpsert) UpdateUpdatedBy() *RoleUpsert {
+	u.SetExcluded(role.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *RoleUpsert) AddUpdatedBy(v uint32) *RoleUpsert {
+	u.Add(role.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *RoleUpsert) ClearUpdatedBy() *RoleUpsert {
+	u.SetNull(role.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *RoleUpsert) SetDeletedBy(v uint32) *RoleUpsert {
+	u.Set(role.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *RoleUpsert) UpdateDeletedBy() *RoleUpsert {
+	u.SetExcluded(role.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *RoleUpsert) AddDeletedBy(v uint32) *RoleUpsert {
+	u.Add(role.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *RoleUpsert) ClearDeletedBy() *RoleUpsert {
+	u.SetNull(role.FieldDeletedBy)
+	return u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *RoleUpsert) SetDeletedAt(v time.Time) *RoleUpsert {
 	u.Set(role.FieldDeletedAt, v)
@@ -628,6 +773,9 @@ func (u *RoleUpsertOne) UpdateNewValues() *RoleUpsertOne {
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(role.FieldCreatedAt)
 		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(role.FieldCreatedBy)
+		}
 	}))
 	return u
 }
@@ -715,6 +863,83 @@ func (u *RoleUpsertOne) UpdateDomainID() *RoleUpsertOne {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *RoleUpsertOne) SetVersion(v uint32) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *RoleUpsertOne) AddVersion(v uint32) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *RoleUpsertOne) UpdateVersion() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *RoleUpsertOne) SetUpdatedBy(v uint32) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *RoleUpsertOne) AddUpdatedBy(v uint32) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *RoleUpsertOne) UpdateUpdatedBy() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *RoleUpsertOne) ClearUpdatedBy() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *RoleUpsertOne) SetDeletedBy(v uint32) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *RoleUpsertOne) AddDeletedBy(v uint32) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *RoleUpsertOne) UpdateDeletedBy() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *RoleUpsertOne) ClearDeletedBy() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *RoleUpsertOne) SetDeletedAt(v time.Time) *RoleUpsertOne {
 	return u.Update(func(s *RoleUpsert) {
@@ -1012,6 +1237,9 @@ func (u *RoleUpsertBulk) UpdateNewValues() *RoleUpsertBulk {
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(role.FieldCreatedAt)
 			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(role.FieldCreatedBy)
+			}
 		}
 	}))
 	return u
@@ -1100,6 +1328,83 @@ func (u *RoleUpsertBulk) UpdateDomainID() *RoleUpsertBulk {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *RoleUpsertBulk) SetVersion(v uint32) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *RoleUpsertBulk) AddVersion(v uint32) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *RoleUpsertBulk) UpdateVersion() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *RoleUpsertBulk) SetUpdatedBy(v uint32) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *RoleUpsertBulk) AddUpdatedBy(v uint32) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *RoleUpsertBulk) UpdateUpdatedBy() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *RoleUpsertBulk) ClearUpdatedBy() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *RoleUpsertBulk) SetDeletedBy(v uint32) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *RoleUpsertBulk) AddDeletedBy(v uint32) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *RoleUpsertBulk) UpdateDeletedBy() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *RoleUpsertBulk) ClearDeletedBy() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *RoleUpsertBulk) SetDeletedAt(v time.Time) *RoleUpsertBulk {
 	return u.Update(func(s *RoleUpsert) {
@@ -3,9 +3,13 @@
 package runtime
 
 import (
+	"backend-service/app/avmc/admin/internal/data/ent/gen/casbinrule"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/dept"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/entityhistory"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/exportfile"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/menu"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/post"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/relationtuple"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/role"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
 	"backend-service/app/avmc/admin/internal/data/ent/schema"
@@ -16,11 +20,59 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	casbinruleFields := schema.CasbinRule{}.Fields()
+	_ = casbinruleFields
+	// casbinruleDescPtype is the schema descriptor for ptype field.
+	casbinruleDescPtype := casbinruleFields[1].Descriptor()
+	// casbinrule.DefaultPtype holds the default value on creation for the ptype field.
+	casbinrule.DefaultPtype = casbinruleDescPtype.Default.(string)
+	// casbinrule.PtypeValidator is a validator for the "ptype" field. It is called by the builders before save.
+	casbinrule.PtypeValidator = casbinruleDescPtype.Validators[0].(func(string) error)
+	// casbinruleDescV0 is the schema descriptor for v0 field.
+	casbinruleDescV0 := casbinruleFields[2].Descriptor()
+	// casbinrule.DefaultV0 holds the default value on creation for the v0 field.
+	casbinrule.DefaultV0 = casbinruleDescV0.Default.(string)
+	// casbinrule.V0Validator is a validator for the "v0" field. It is called by the builders before save.
+	casbinrule.V0Validator = casbinruleDescV0.Validators[0].(func(string) error)
+	// casbinruleDescV1 is the schema descriptor for v1 field.
+	casbinruleDescV1 := casbinruleFields[3].Descriptor()
+	// casbinrule.DefaultV1 holds the default value on creation for the v1 field.
+	casbinrule.DefaultV1 = casbinruleDescV1.Default.(string)
+	// casbinrule.V1Validator is a validator for the "v1" field. It is called by the builders before save.
+	casbinrule.V1Validator = casbinruleDescV1.Validators[0].(func(string) error)
+	// casbinruleDescV2 is the schema descriptor for v2 field.
+	casbinruleDescV2 := casbinruleFields[4].Descriptor()
+	// casbinrule.DefaultV2 holds the default value on creation for the v2 field.
+	casbinrule.DefaultV2 = casbinruleDescV2.Default.(string)
+	// casbinrule.V2Validator is a validator for the "v2" field. It is called by the builders before save.
+	casbinrule.V2Validator = casbinruleDescV2.Validators[0].(func(string) error)
+	// casbinruleDescV3 is the schema descriptor for v3 field.
+	casbinruleDescV3 := casbinruleFields[5].Descriptor()
+	// casbinrule.DefaultV3 holds the default value on creation for the v3 field.
+	casbinrule.DefaultV3 = casbinruleDescV3.Default.(string)
+	// casbinrule.V3Validator is a validator for the "v3" field. It is called by the builders before save.
+	casbinrule.V3Validator = casbinruleDescV3.Validators[0].(func(string) error)
+	// casbinruleDescV4 is the schema descriptor for v4 field.
+	casbinruleDescV4 := casbinruleFields[6].Descriptor()
+	// casbinrule.DefaultV4 holds the default value on creation for the v4 field.
+	casbinrule.DefaultV4 = casbinruleDescV4.Default.(string)
+	// casbinrule.V4Validator is a validator for the "v4" field. It is called by the builders before save.
+	casbinrule.V4Validator = casbinruleDescV4.Validators[0].(func(string) error)
+	// casbinruleDescV5 is the schema descriptor for v5 field.
+	casbinruleDescV5 := casbinruleFields[7].Descriptor()
+	// casbinrule.DefaultV5 holds the default value on creation for the v5 field.
+	casbinrule.DefaultV5 = casbinruleDescV5.Default.(string)
+	// casbinrule.V5Validator is a validator for the "v5" field. It is called by the builders before save.
+	casbinrule.V5Validator = casbinruleDescV5.Validators[0].(func(string) error)
 	deptMixin := schema.Dept{}.Mixin()
 	deptMixinHooks1 := deptMixin[1].Hooks()
+	deptMixinHooks2 := deptMixin[2].Hooks()
 	dept.Hooks[0] = deptMixinHooks1[0]
-	deptMixinInters1 := deptMixin[1].Interceptors()
-	dept.Interceptors[0] = deptMixinInters1[0]
+	dept.Hooks[1] = deptMixinHooks1[1]
+	dept.Hooks[2] = deptMixinHooks1[2]
+	dept.Hooks[3] = deptMixinHooks2[0]
+	deptMixinInters2 := deptMixin[2].Interceptors()
+	dept.Interceptors[0] = deptMixinInters2[0]
 	deptMixinFields0 := deptMixin[0].Fields()
 	_ = deptMixinFields0
 	deptFields := schema.Dept{}.Fields()
@@ -61,6 +113,10 @@ func init() {
 	dept.DefaultDomainID = deptDescDomainID.Default.(func() uint32)
 	// dept.DomainIDValidator is a validator for the "domain_id" field. It is called by the builders before save.
 	dept.DomainIDValidator = deptDescDomainID.Validators[0].(func(uint32) error)
+	// deptDescVersion is the schema descriptor for version field.
+	deptDescVersion := deptMixinFields0[5].Descriptor()
+	// dept.DefaultVersion holds the default value on creation for the version field.
+	dept.DefaultVersion = deptDescVersion.Default.(uint32)
 	// deptDescName is the schema descriptor for name field.
 	deptDescName := deptFields[0].Descriptor()
 	// dept.NameValidator is a validator for the "name" field. It is called by the builders before save.
@@ -77,11 +133,99 @@ func init() {
 	deptDescID := deptMixinFields0[0].Descriptor()
 	// dept.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	dept.IDValidator = deptDescID.Validators[0].(func(uint32) error)
+	entityhistoryMixin := schema.EntityHistory{}.Mixin()
+	entityhistoryMixinFields0 := entityhistoryMixin[0].Fields()
+	_ = entityhistoryMixinFields0
+	entityhistoryFields := schema.EntityHistory{}.Fields()
+	_ = entityhistoryFields
+	// entityhistoryDescCreatedAt is the schema descriptor for created_at field.
+	entityhistoryDescCreatedAt := entityhistoryMixinFields0[0].Descriptor()
+	// entityhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	entityhistory.DefaultCreatedAt = entityhistoryDescCreatedAt.Default.(func() time.Time)
+	// entityhistoryDescResource is the schema descriptor for resource field.
+	entityhistoryDescResource := entityhistoryFields[1].Descriptor()
+	// entityhistory.ResourceValidator is a validator for the "resource" field. It is called by the builders before save.
+	entityhistory.ResourceValidator = func() func(string) error {
+		validators := entityhistoryDescResource.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(resource string) error {
+			for _, fn := range fns {
+				if err := fn(resource); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// entityhistoryDescAction is the schema descriptor for action field.
+	entityhistoryDescAction := entityhistoryFields[3].Descriptor()
+	// entityhistory.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	entityhistory.ActionValidator = func() func(string) error {
+		validators := entityhistoryDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	exportfileMixin := schema.ExportFile{}.Mixin()
+	exportfileMixinFields0 := exportfileMixin[0].Fields()
+	_ = exportfileMixinFields0
+	exportfileFields := schema.ExportFile{}.Fields()
+	_ = exportfileFields
+	// exportfileDescCreatedAt is the schema descriptor for created_at field.
+	exportfileDescCreatedAt := exportfileMixinFields0[0].Descriptor()
+	// exportfile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exportfile.DefaultCreatedAt = exportfileDescCreatedAt.Default.(func() time.Time)
+	// exportfileDescJobID is the schema descriptor for job_id field.
+	exportfileDescJobID := exportfileFields[1].Descriptor()
+	// exportfile.JobIDValidator is a validator for the "job_id" field. It is called by the builders before save.
+	exportfile.JobIDValidator = func() func(string) error {
+		validators := exportfileDescJobID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(job_id string) error {
+			for _, fn := range fns {
+				if err := fn(job_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// exportfileDescSeq is the schema descriptor for seq field.
+	exportfileDescSeq := exportfileFields[2].Descriptor()
+	// exportfile.SeqValidator is a validator for the "seq" field. It is called by the builders before save.
+	exportfile.SeqValidator = exportfileDescSeq.Validators[0].(func(int) error)
+	// exportfileDescData is the schema descriptor for data field.
+	exportfileDescData := exportfileFields[3].Descriptor()
+	// exportfile.DataValidator is a validator for the "data" field. It is called by the builders before save.
+	exportfile.DataValidator = exportfileDescData.Validators[0].(func([]byte) error)
+	// exportfileDescSize is the schema descriptor for size field.
+	exportfileDescSize := exportfileFields[4].Descriptor()
+	// exportfile.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	exportfile.SizeValidator = exportfileDescSize.Validators[0].(func(int) error)
 	menuMixin := schema.Menu{}.Mixin()
-	menuMixinHooks4 := menuMixin[4].Hooks()
-	menu.Hooks[0] = menuMixinHooks4[0]
-	menuMixinInters4 := menuMixin[4].Interceptors()
-	menu.Interceptors[0] = menuMixinInters4[0]
+	menuMixinHooks5 := menuMixin[5].Hooks()
+	menuMixinHooks6 := menuMixin[6].Hooks()
+	menu.Hooks[0] = menuMixinHooks5[0]
+	menu.Hooks[1] = menuMixinHooks5[1]
+	menu.Hooks[2] = menuMixinHooks5[2]
+	menu.Hooks[3] = menuMixinHooks6[0]
+	menuMixinInters6 := menuMixin[6].Interceptors()
+	menu.Interceptors[0] = menuMixinInters6[0]
 	menuMixinFields0 := menuMixin[0].Fields()
 	_ = menuMixinFields0
 	menuMixinFields1 := menuMixin[1].Fields()
@@ -90,6 +234,8 @@ func init() {
 	_ = menuMixinFields2
 	menuMixinFields3 := menuMixin[3].Fields()
 	_ = menuMixinFields3
+	menuMixinFields4 := menuMixin[4].Fields()
+	_ = menuMixinFields4
 	menuFields := schema.Menu{}.Fields()
 	_ = menuFields
 	// menuDescStatus is the schema descriptor for status field.
@@ -122,6 +268,10 @@ func init() {
 	menu.DefaultUpdatedAt = menuDescUpdatedAt.Default.(func() time.Time)
 	// menu.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	menu.UpdateDefaultUpdatedAt = menuDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// menuDescVersion is the schema descriptor for version field.
+	menuDescVersion := menuMixinFields4[0].Descriptor()
+	// menu.DefaultVersion holds the default value on creation for the version field.
+	menu.DefaultVersion = menuDescVersion.Default.(uint32)
 	// menuDescName is the schema descriptor for name field.
 	menuDescName := menuFields[0].Descriptor()
 	// menu.DefaultName holds the default value on creation for the name field.
@@ -260,9 +410,13 @@ func init() {
 	menu.IDValidator = menuDescID.Validators[0].(func(uint32) error)
 	postMixin := schema.Post{}.Mixin()
 	postMixinHooks1 := postMixin[1].Hooks()
+	postMixinHooks2 := postMixin[2].Hooks()
 	post.Hooks[0] = postMixinHooks1[0]
-	postMixinInters1 := postMixin[1].Interceptors()
-	post.Interceptors[0] = postMixinInters1[0]
+	post.Hooks[1] = postMixinHooks1[1]
+	post.Hooks[2] = postMixinHooks1[2]
+	post.Hooks[3] = postMixinHooks2[0]
+	postMixinInters2 := postMixin[2].Interceptors()
+	post.Interceptors[0] = postMixinInters2[0]
 	postMixinFields0 := postMixin[0].Fields()
 	_ = postMixinFields0
 	postFields := schema.Post{}.Fields()
@@ -303,6 +457,10 @@ func init() {
 	post.DefaultDomainID = postDescDomainID.Default.(func() uint32)
 	// post.DomainIDValidator is a validator for the "domain_id" field. It is called by the builders before save.
 	post.DomainIDValidator = postDescDomainID.Validators[0].(func(uint32) error)
+	// postDescVersion is the schema descriptor for version field.
+	postDescVersion := postMixinFields0[5].Descriptor()
+	// post.DefaultVersion holds the default value on creation for the version field.
+	post.DefaultVersion = postDescVersion.Default.(uint32)
 	// postDescName is the schema descriptor for name field.
 	postDescName := postFields[0].Descriptor()
 	// post.DefaultName holds the default value on creation for the name field.
@@ -313,11 +471,113 @@ func init() {
 	postDescID := postMixinFields0[0].Descriptor()
 	// post.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	post.IDValidator = postDescID.Validators[0].(func(uint32) error)
+	relationtupleFields := schema.RelationTuple{}.Fields()
+	_ = relationtupleFields
+	// relationtupleDescObjectNamespace is the schema descriptor for object_namespace field.
+	relationtupleDescObjectNamespace := relationtupleFields[1].Descriptor()
+	// relationtuple.ObjectNamespaceValidator is a validator for the "object_namespace" field. It is called by the builders before save.
+	relationtuple.ObjectNamespaceValidator = func() func(string) error {
+		validators := relationtupleDescObjectNamespace.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(object_namespace string) error {
+			for _, fn := range fns {
+				if err := fn(object_namespace); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// relationtupleDescObjectID is the schema descriptor for object_id field.
+	relationtupleDescObjectID := relationtupleFields[2].Descriptor()
+	// relationtuple.ObjectIDValidator is a validator for the "object_id" field. It is called by the builders before save.
+	relationtuple.ObjectIDValidator = func() func(string) error {
+		validators := relationtupleDescObjectID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(object_id string) error {
+			for _, fn := range fns {
+				if err := fn(object_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// relationtupleDescRelation is the schema descriptor for relation field.
+	relationtupleDescRelation := relationtupleFields[3].Descriptor()
+	// relationtuple.RelationValidator is a validator for the "relation" field. It is called by the builders before save.
+	relationtuple.RelationValidator = func() func(string) error {
+		validators := relationtupleDescRelation.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(relation string) error {
+			for _, fn := range fns {
+				if err := fn(relation); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// relationtupleDescSubjectNamespace is the schema descriptor for subject_namespace field.
+	relationtupleDescSubjectNamespace := relationtupleFields[4].Descriptor()
+	// relationtuple.SubjectNamespaceValidator is a validator for the "subject_namespace" field. It is called by the builders before save.
+	relationtuple.SubjectNamespaceValidator = func() func(string) error {
+		validators := relationtupleDescSubjectNamespace.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject_namespace string) error {
+			for _, fn := range fns {
+				if err := fn(subject_namespace); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// relationtupleDescSubjectID is the schema descriptor for subject_id field.
+	relationtupleDescSubjectID := relationtupleFields[5].Descriptor()
+	// relationtuple.SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	relationtuple.SubjectIDValidator = func() func(string) error {
+		validators := relationtupleDescSubjectID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject_id string) error {
+			for _, fn := range fns {
+				if err := fn(subject_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// relationtupleDescSubjectRelation is the schema descriptor for subject_relation field.
+	relationtupleDescSubjectRelation := relationtupleFields[6].Descriptor()
+	// relationtuple.DefaultSubjectRelation holds the default value on creation for the subject_relation field.
+	relationtuple.DefaultSubjectRelation = relationtupleDescSubjectRelation.Default.(string)
+	// relationtuple.SubjectRelationValidator is a validator for the "subject_relation" field. It is called by the builders before save.
+	relationtuple.SubjectRelationValidator = relationtupleDescSubjectRelation.Validators[0].(func(string) error)
 	roleMixin := schema.Role{}.Mixin()
 	roleMixinHooks1 := roleMixin[1].Hooks()
+	roleMixinHooks2 := roleMixin[2].Hooks()
 	role.Hooks[0] = roleMixinHooks1[0]
-	roleMixinInters1 := roleMixin[1].Interceptors()
-	role.Interceptors[0] = roleMixinInters1[0]
+	role.Hooks[1] = roleMixinHooks1[1]
+	role.Hooks[2] = roleMixinHooks1[2]
+	role.Hooks[3] = roleMixinHooks2[0]
+	roleMixinInters2 := roleMixin[2].Interceptors()
+	role.Interceptors[0] = roleMixinInters2[0]
 	roleMixinFields0 := roleMixin[0].Fields()
 	_ = roleMixinFields0
 	roleFields := schema.Role{}.Fields()
@@ -358,6 +618,10 @@ func init() {
 	role.DefaultDomainID = roleDescDomainID.Default.(func() uint32)
 	// role.DomainIDValidator is a validator for the "domain_id" field. It is called by the builders before save.
 	role.DomainIDValidator = roleDescDomainID.Validators[0].(func(uint32) error)
+	// roleDescVersion is the schema descriptor for version field.
+	roleDescVersion := roleMixinFields0[5].Descriptor()
+	// role.DefaultVersion holds the default value on creation for the version field.
+	role.DefaultVersion = roleDescVersion.Default.(uint32)
 	// roleDescName is the schema descriptor for name field.
 	roleDescName := roleFields[0].Descriptor()
 	// role.DefaultName holds the default value on creation for the name field.
@@ -388,9 +652,13 @@ func init() {
 	role.IDValidator = roleDescID.Validators[0].(func(uint32) error)
 	userMixin := schema.User{}.Mixin()
 	userMixinHooks1 := userMixin[1].Hooks()
+	userMixinHooks2 := userMixin[2].Hooks()
 	user.Hooks[0] = userMixinHooks1[0]
-	userMixinInters1 := userMixin[1].Interceptors()
-	user.Interceptors[0] = userMixinInters1[0]
+	user.Hooks[1] = userMixinHooks1[1]
+	user.Hooks[2] = userMixinHooks1[2]
+	user.Hooks[3] = userMixinHooks2[0]
+	userMixinInters2 := userMixin[2].Interceptors()
+	user.Interceptors[0] = userMixinInters2[0]
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
 	userFields := schema.User{}.Fields()
@@ -431,6 +699,10 @@ func init() {
 	user.DefaultDomainID = userDescDomainID.Default.(func() uint32)
 	// user.DomainIDValidator is a validator for the "domain_id" field. It is called by the builders before save.
 	user.DomainIDValidator = userDescDomainID.Validators[0].(func(uint32) error)
+	// userDescVersion is the schema descriptor for version field.
+	userDescVersion := userMixinFields0[5].Descriptor()
+	// user.DefaultVersion holds the default value on creation for the version field.
+	user.DefaultVersion = userDescVersion.Default.(uint32)
 	// userDescName is the schema descriptor for name field.
 	userDescName := userFields[0].Descriptor()
 	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
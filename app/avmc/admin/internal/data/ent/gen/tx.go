@@ -14,12 +14,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// CasbinRule is the client for interacting with the CasbinRule builders.
+	CasbinRule *CasbinRuleClient
 	// Dept is the client for interacting with the Dept builders.
 	Dept *DeptClient
+	// EntityHistory is the client for interacting with the EntityHistory builders.
+	EntityHistory *EntityHistoryClient
+	// ExportFile is the client for interacting with the ExportFile builders.
+	ExportFile *ExportFileClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// RelationTuple is the client for interacting with the RelationTuple builders.
+	RelationTuple *RelationTupleClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// User is the client for interacting with the User builders.
@@ -155,9 +163,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.CasbinRule = NewCasbinRuleClient(tx.config)
 	tx.Dept = NewDeptClient(tx.config)
+	tx.EntityHistory = NewEntityHistoryClient(tx.config)
+	tx.ExportFile = NewExportFileClient(tx.config)
 	tx.Menu = NewMenuClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
+	tx.RelationTuple = NewRelationTupleClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
@@ -169,7 +181,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Dept.QueryXXX(), the query will be executed
+// applies a query, for example: CasbinRule.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -19,5 +19,6 @@ func (BaseMixin) Fields() []ent.Field {
 	fields = append(fields, pkgMixin.UpdatedAt{}.Fields()...)
 	fields = append(fields, pkgMixin.Status{}.Fields()...)
 	fields = append(fields, pkgMixin.DomainID{}.Fields()...)
+	fields = append(fields, pkgMixin.Version{}.Fields()...)
 	return fields
 }
@@ -87,7 +87,7 @@ func (d SoftDeleteMixin) Hooks() []ent.Hook {
 					mx, ok := m.(interface {
 						SetOp(ent.Op)
 						Client() *gen.Client // 替换为你的实际 Client 类型
-						SetDeleteTime(time.Time)
+						SetDeletedAt(time.Time)
 						WhereP(...func(*sql.Selector))
 					})
 					if !ok {
@@ -95,7 +95,7 @@ func (d SoftDeleteMixin) Hooks() []ent.Hook {
 					}
 					d.P(mx)
 					mx.SetOp(ent.OpUpdate)
-					mx.SetDeleteTime(time.Now())
+					mx.SetDeletedAt(time.Now())
 					return mx.Client().Mutate(ctx, m)
 				})
 			},
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CasbinRule holds the schema definition for the CasbinRule entity.
+// 表结构与 gorm-adapter 的 casbin_rule 保持一致，便于在适配器之间切换
+type CasbinRule struct {
+	ent.Schema
+}
+
+func (CasbinRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "casbin_rule",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("权限策略表"),
+	}
+}
+
+// Fields of the CasbinRule.
+func (CasbinRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Comment("id"),
+		field.String("ptype").Comment("策略类型").MaxLen(100).Default(""),
+		field.String("v0").Comment("策略字段0").MaxLen(100).Default(""),
+		field.String("v1").Comment("策略字段1").MaxLen(100).Default(""),
+		field.String("v2").Comment("策略字段2").MaxLen(100).Default(""),
+		field.String("v3").Comment("策略字段3").MaxLen(100).Default(""),
+		field.String("v4").Comment("策略字段4").MaxLen(100).Default(""),
+		field.String("v5").Comment("策略字段5").MaxLen(100).Default(""),
+	}
+}
+
+// Edges of the CasbinRule.
+func (CasbinRule) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CasbinRule.
+func (CasbinRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ptype", "v0", "v1", "v2", "v3", "v4", "v5").
+			Unique().
+			StorageKey("idx_casbin_rule"),
+	}
+}
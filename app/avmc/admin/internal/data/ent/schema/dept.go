@@ -2,6 +2,7 @@ package schema
 
 import (
 	"backend-service/app/avmc/admin/internal/data/ent/mixins"
+	pkgMixin "backend-service/pkg/entgo/mixin"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
@@ -49,6 +50,7 @@ func (Dept) Edges() []ent.Edge {
 func (Dept) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
+		pkgMixin.Operator{},
 		mixins.SoftDeleteMixin{},
 	}
 }
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"backend-service/pkg/entgo"
+	pkgMixin "backend-service/pkg/entgo/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EntityHistory holds the schema definition for the EntityHistory entity.
+// 实体变更历史，每次变更记录字段级差异与变更后的完整快照，只追加不修改
+type EntityHistory struct {
+	ent.Schema
+}
+
+func (EntityHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "entity_history",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("实体变更历史表"),
+	}
+}
+
+// Fields of the EntityHistory.
+func (EntityHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Comment("id"),
+		field.String("resource").Comment("资源名称").MaxLen(32).NotEmpty().Immutable(),
+		field.Uint32("entity_id").Comment("实体ID").Immutable(),
+		field.String("action").Comment("操作：create/update/delete/restore/purge").MaxLen(16).NotEmpty().Immutable(),
+		field.JSON("changes", []entgo.FieldChange{}).Comment("字段变更，敏感字段以掩码代替").Optional().Immutable(),
+		field.JSON("snapshot", map[string]any{}).Comment("变更后的数据快照，彻底删除时为空").Optional().Immutable(),
+		field.Uint32("operator_id").Comment("操作人ID").Optional().Nillable().Immutable(),
+	}
+}
+
+// Mixin of the EntityHistory.
+func (EntityHistory) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pkgMixin.CreatedAt{},
+	}
+}
+
+// Indexes of the EntityHistory.
+func (EntityHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource", "entity_id", "created_at").
+			StorageKey("idx_entity_history"),
+	}
+}
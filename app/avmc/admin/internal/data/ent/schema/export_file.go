@@ -0,0 +1,58 @@
+package schema
+
+import (
+	pkgMixin "backend-service/pkg/entgo/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExportFile holds the schema definition for the ExportFile entity.
+// 导出文件分块存储，多实例部署时任一实例都可以下载其它实例生成的文件
+type ExportFile struct {
+	ent.Schema
+}
+
+func (ExportFile) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "export_file",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("导出文件表"),
+	}
+}
+
+// Fields of the ExportFile.
+func (ExportFile) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Comment("id"),
+		field.String("job_id").Comment("导出任务ID").MaxLen(32).NotEmpty().Immutable(),
+		field.Int("seq").Comment("分块序号，从0开始").NonNegative().Immutable(),
+		field.Bytes("data").Comment("分块内容").MaxLen(1 << 20).Immutable(),
+		field.Int("size").Comment("分块大小").NonNegative().Immutable(),
+	}
+}
+
+// Mixin of the ExportFile.
+func (ExportFile) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pkgMixin.CreatedAt{},
+	}
+}
+
+// Indexes of the ExportFile.
+func (ExportFile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_id", "seq").
+			Unique().
+			StorageKey("idx_export_file"),
+		index.Fields("created_at").
+			StorageKey("idx_export_file_created_at"),
+	}
+}
@@ -81,6 +81,8 @@ func (Menu) Mixin() []ent.Mixin {
 		pkgMixin.Status{},
 		pkgMixin.CreatedAt{},
 		pkgMixin.UpdatedAt{},
+		pkgMixin.Version{},
+		pkgMixin.Operator{},
 		mixins.SoftDeleteMixin{},
 	}
 }
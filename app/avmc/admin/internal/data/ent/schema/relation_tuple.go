@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RelationTuple holds the schema definition for the RelationTuple entity.
+// 关系元组 object#relation@subject，subject_relation 非空时主体为用户集
+type RelationTuple struct {
+	ent.Schema
+}
+
+func (RelationTuple) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "relation_tuple",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("关系元组表"),
+	}
+}
+
+// Fields of the RelationTuple.
+func (RelationTuple) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Comment("id"),
+		field.String("object_namespace").Comment("对象命名空间").MaxLen(64).NotEmpty(),
+		field.String("object_id").Comment("对象ID").MaxLen(128).NotEmpty(),
+		field.String("relation").Comment("关系").MaxLen(64).NotEmpty(),
+		field.String("subject_namespace").Comment("主体命名空间").MaxLen(64).NotEmpty(),
+		field.String("subject_id").Comment("主体ID").MaxLen(128).NotEmpty(),
+		field.String("subject_relation").Comment("主体关系，为空表示直接主体").MaxLen(64).Default(""),
+	}
+}
+
+// Edges of the RelationTuple.
+func (RelationTuple) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the RelationTuple.
+func (RelationTuple) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("object_namespace", "object_id", "relation", "subject_namespace", "subject_id", "subject_relation").
+			Unique().
+			StorageKey("idx_relation_tuple"),
+		index.Fields("subject_namespace", "subject_id", "subject_relation").
+			StorageKey("idx_relation_tuple_subject"),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"backend-service/app/avmc/admin/internal/data/ent/mixins"
+	pkgMixin "backend-service/pkg/entgo/mixin"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -51,6 +52,7 @@ func (Role) Edges() []ent.Edge {
 func (Role) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
+		pkgMixin.Operator{},
 		mixins.SoftDeleteMixin{},
 	}
 }
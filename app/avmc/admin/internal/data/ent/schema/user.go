@@ -2,6 +2,7 @@ package schema
 
 import (
 	"backend-service/app/avmc/admin/internal/data/ent/mixins"
+	pkgMixin "backend-service/pkg/entgo/mixin"
 	"regexp"
 
 	"entgo.io/ent"
@@ -48,6 +49,7 @@ func (User) Fields() []ent.Field {
 		field.JSON("settings", []string{}).Optional().Default([]string{}).Comment("用户设置，JSON格式"),
 		field.JSON("metadata", []string{}).Optional().Default([]string{}).Comment("元数据，JSON格式"),
 		field.String("description").Optional().MaxLen(255).Nillable().Comment("个人说明"),
+		field.Uint32("dept_id").Optional().Nillable().Comment("所属部门ID"),
 	}
 }
 
@@ -63,6 +65,7 @@ func (User) Edges() []ent.Edge {
 func (User) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
+		pkgMixin.Operator{},
 		mixins.SoftDeleteMixin{},
 	}
 }
@@ -74,5 +77,6 @@ func (User) Indexes() []ent.Index {
 		index.Fields("phone"),
 		index.Fields("status"),
 		index.Fields("email"),
+		index.Fields("dept_id"),
 	}
 }
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"backend-service/app/avmc/admin/internal/biz"
 	"backend-service/app/avmc/admin/internal/conf"
 	"context"
 
@@ -9,6 +10,7 @@ import (
 	"backend-service/app/avmc/admin/internal/data/ent/gen/migrate"
 
 	_ "backend-service/app/avmc/admin/internal/data/ent/gen/runtime"
+	"backend-service/pkg/search"
 
 	// init mysql driver
 
@@ -20,7 +22,7 @@ import (
 )
 
 // NewEntClient .
-func NewEntClient(cfg *conf.Data, logger log.Logger) *gen.Client {
+func NewEntClient(cfg *conf.Data, indexer search.Indexer, logger log.Logger) *gen.Client {
 	l := log.NewHelper(log.With(logger, "module", "ent/data/initialize"))
 	drv, err := sql.Open(cfg.Database.Driver, cfg.Database.Source)
 	if err != nil {
@@ -61,6 +63,13 @@ func NewEntClient(cfg *conf.Data, logger log.Logger) *gen.Client {
 		}
 	}
 	// client.Use()
+	// 用户与菜单的变更同步到搜索索引
+	client.User.Use(searchHook(indexer, biz.SearchIndexUsers, logger))
+	client.Menu.Use(searchHook(indexer, biz.SearchIndexMenus, logger))
+	// 用户、角色与菜单记录变更历史
+	client.User.Use(historyHook(biz.HistoryResourceUser, logger))
+	client.Role.Use(historyHook(biz.HistoryResourceRole, logger))
+	client.Menu.Use(historyHook(biz.HistoryResourceMenu, logger))
 	client.Intercept(
 		intercept.Func(func(ctx context.Context, q intercept.Query) error {
 			// Limit all queries to 1000 records.
@@ -0,0 +1,64 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/pkg/entgo"
+)
+
+// entError 将ent错误转换为业务错误
+// 参数：err ent错误，notFound 记录不存在时返回的错误，conflict 违反约束时返回的错误，fallback 其它数据库错误的包装错误
+// 返回值：业务错误，notFound/conflict 为空时统一使用 fallback 包装
+func entError(err error, notFound, conflict error, fallback *errors.Error) error {
+	se := new(errors.Error)
+	switch {
+	case err == nil:
+		return nil
+	case gen.IsNotFound(err) && notFound != nil:
+		return notFound
+	case gen.IsConstraintError(err) && conflict != nil:
+		return conflict
+	case errors.As(err, &se):
+		// 已经是业务错误，直接返回
+		return err
+	default:
+		return fallback.WithCause(err)
+	}
+}
+
+// pagingError 将分页请求翻译错误转换为业务错误，不合法的过滤、排序或字段掩码返回 BAD_REQUEST
+// 参数：err 翻译错误
+// 返回值：业务错误
+func pagingError(err error) error {
+	if errors.Is(err, entgo.ErrInvalidQuery) {
+		return v1.ErrorBadRequest("%s", err.Error()).WithCause(err)
+	}
+	return errDBQuery.WithCause(err)
+}
+
+// 数据库操作失败的包装错误
+var (
+	errDBQuery  = v1.ErrorDbQueryError("数据库查询失败")
+	errDBInsert = v1.ErrorDbInsertError("数据库插入失败")
+	errDBUpdate = v1.ErrorDbUpdateError("数据库更新失败")
+	errDBDelete = v1.ErrorDbDeleteError("数据库删除失败")
+)
+
+// versionError 区分条件更新未命中的原因，记录仍存在说明版本号不一致
+// 参数：err 更新错误，version 更新条件中的版本号，为空时未做条件更新，latest 查询记录的最新版本号
+// 返回值：包含最新版本号的版本冲突错误，记录不存在或未做条件更新时原样返回 err
+func versionError(ctx context.Context, err error, version *uint32, latest func(context.Context) (int, error)) error {
+	if version == nil || !gen.IsNotFound(err) {
+		return err
+	}
+	v, lerr := latest(ctx)
+	if lerr != nil {
+		return err
+	}
+	return biz.NewVersionConflictError(uint32(v))
+}
@@ -0,0 +1,294 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/conf"
+	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/exportfile"
+	"backend-service/pkg/utils/pagination"
+)
+
+const (
+	// exportJobKeyPrefix 导出任务缓存键前缀
+	exportJobKeyPrefix = "admin_export_job_"
+	// exportLeaseKeyPrefix 导出任务执行租约缓存键前缀
+	exportLeaseKeyPrefix = "admin_export_lease_"
+	// exportChunkSize 导出文件分块大小，与表结构中分块内容的最大长度一致
+	exportChunkSize = 1 << 20
+)
+
+var _ biz.ExportRepo = (*exportRepo)(nil)
+
+// exportRepo 导出任务数据仓库，任务状态存储在Redis中，文件分块存储在数据库中
+// 多实例部署时任一实例都可以查询任务与下载文件
+type exportRepo struct {
+	data    *Data
+	log     *log.Helper
+	ttl     time.Duration
+	options biz.ExportOptions
+}
+
+// NewExportRepo 创建新的导出任务数据仓库实例
+// 参数：c 数据配置，data 数据源，logger 日志记录器
+// 返回值：导出任务数据仓库接口
+func NewExportRepo(c *conf.Data, data *Data, logger log.Logger) biz.ExportRepo {
+	cfg := c.GetExport()
+	opts := biz.ExportOptions{
+		BatchSize:   int(cfg.GetBatchSize()),
+		SyncLimit:   int(cfg.GetSyncLimit()),
+		MaxRows:     int(cfg.GetMaxRows()),
+		Concurrency: int(cfg.GetConcurrency()),
+	}
+	// 每批默认 500行，不超过分页大小上限
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 500
+	}
+	if opts.BatchSize > pagination.MAX_PAGE_SIZE {
+		opts.BatchSize = pagination.MAX_PAGE_SIZE
+	}
+	// 同步导出默认最多 5000行
+	if opts.SyncLimit <= 0 {
+		opts.SyncLimit = 5000
+	}
+	// 导出任务默认最多 100万行
+	if opts.MaxRows <= 0 {
+		opts.MaxRows = 1000000
+	}
+	// 默认同时执行 2个导出任务
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 2
+	}
+	// 任务与文件默认保留 24小时
+	ttl := cfg.GetTtl().AsDuration()
+	if ttl <= 0 {
+		ttl = time.Hour * 24
+	}
+
+	return &exportRepo{
+		data:    data,
+		log:     log.NewHelper(log.With(logger, "module", "export/data")),
+		ttl:     ttl,
+		options: opts,
+	}
+}
+
+// Options 获取导出配置
+func (r *exportRepo) Options() biz.ExportOptions {
+	return r.options
+}
+
+// NextID 生成导出任务ID
+func (r *exportRepo) NextID() string {
+	return r.data.sf.Generate().String()
+}
+
+// SaveJob 保存导出任务，任务在保留时长后过期
+func (r *exportRepo) SaveJob(ctx context.Context, job *v1.ExportJob) error {
+	data, err := protojson.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return r.data.rdb.Set(ctx, exportJobKeyPrefix+job.GetId(), data, r.ttl).Err()
+}
+
+// GetJob 获取导出任务
+func (r *exportRepo) GetJob(ctx context.Context, id string) (*v1.ExportJob, error) {
+	data, err := r.data.rdb.Get(ctx, exportJobKeyPrefix+id).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, biz.ErrExportJobNotFound
+		}
+		r.log.Errorf("获取导出任务失败，任务ID：%s，错误：%v", id, err)
+		return nil, v1.ErrorCacheGetError("获取导出任务失败")
+	}
+	job := &v1.ExportJob{}
+	if err := protojson.Unmarshal(data, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// ListJobs 列出未过期的导出任务，逐批扫描任务缓存键
+func (r *exportRepo) ListJobs(ctx context.Context) ([]*v1.ExportJob, error) {
+	var jobs []*v1.ExportJob
+	iter := r.data.rdb.Scan(ctx, 0, exportJobKeyPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		job, err := r.GetJob(ctx, strings.TrimPrefix(iter.Val(), exportJobKeyPrefix))
+		if err != nil {
+			// 扫描期间过期的任务直接跳过
+			if errors.Is(err, biz.ErrExportJobNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	if err := iter.Err(); err != nil {
+		r.log.Errorf("扫描导出任务失败，错误：%v", err)
+		return nil, v1.ErrorCacheGetError("获取导出任务失败")
+	}
+	return jobs, nil
+}
+
+// RenewLease 续期导出任务的执行租约
+func (r *exportRepo) RenewLease(ctx context.Context, id string, ttl time.Duration) error {
+	return r.data.rdb.Set(ctx, exportLeaseKeyPrefix+id, 1, ttl).Err()
+}
+
+// HasLease 判断导出任务的执行租约是否有效
+func (r *exportRepo) HasLease(ctx context.Context, id string) (bool, error) {
+	n, err := r.data.rdb.Exists(ctx, exportLeaseKeyPrefix+id).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// CreateFile 创建导出任务的文件，同时清理超过保留时长的文件
+// 文件按固定大小分块写入数据库，关闭时写入最后一块
+func (r *exportRepo) CreateFile(ctx context.Context, job *v1.ExportJob) (io.WriteCloser, error) {
+	r.sweep(ctx)
+	if err := r.RemoveFile(ctx, job); err != nil {
+		return nil, err
+	}
+	return &exportFileWriter{ctx: ctx, repo: r, jobID: job.GetId()}, nil
+}
+
+// OpenFile 打开导出任务的文件，按分块顺序逐块读取
+func (r *exportRepo) OpenFile(ctx context.Context, job *v1.ExportJob) (io.ReadCloser, int64, error) {
+	var v []struct {
+		Count int   `json:"count"`
+		Size  int64 `json:"size"`
+	}
+	err := r.data.DB(ctx).ExportFile.Query().
+		Where(exportfile.JobID(job.GetId())).
+		Aggregate(gen.Count(), gen.As(gen.Sum(exportfile.FieldSize), "size")).
+		Scan(ctx, &v)
+	if err != nil {
+		r.log.Errorf("查询导出文件失败，任务ID：%s，错误：%v", job.GetId(), err)
+		return nil, 0, v1.ErrorInternalServerError("查询导出文件失败")
+	}
+	if len(v) == 0 || v[0].Count == 0 {
+		return nil, 0, biz.ErrExportJobNotFound
+	}
+	return &exportFileReader{ctx: ctx, repo: r, jobID: job.GetId(), chunks: v[0].Count}, v[0].Size, nil
+}
+
+// RemoveFile 删除导出任务的文件
+func (r *exportRepo) RemoveFile(ctx context.Context, job *v1.ExportJob) error {
+	_, err := r.data.DB(ctx).ExportFile.Delete().
+		Where(exportfile.JobID(job.GetId())).
+		Exec(ctx)
+	return err
+}
+
+// sweep 删除超过保留时长的文件，失败时只记录日志
+func (r *exportRepo) sweep(ctx context.Context) {
+	n, err := r.data.DB(ctx).ExportFile.Delete().
+		Where(exportfile.CreatedAtLT(time.Now().Add(-r.ttl))).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("删除过期导出文件失败，错误：%v", err)
+		return
+	}
+	if n > 0 {
+		r.log.Infof("已删除过期导出文件分块：%d", n)
+	}
+}
+
+// exportFileWriter 导出文件写入器，缓冲满一块后写入数据库
+type exportFileWriter struct {
+	ctx   context.Context
+	repo  *exportRepo
+	jobID string
+	seq   int
+	buf   bytes.Buffer
+}
+
+func (w *exportFileWriter) Write(p []byte) (int, error) {
+	n, _ := w.buf.Write(p)
+	for w.buf.Len() >= exportChunkSize {
+		if err := w.flush(w.buf.Next(exportChunkSize)); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// Close 写入剩余内容，空文件也写入一块，便于区分文件不存在
+func (w *exportFileWriter) Close() error {
+	if w.buf.Len() == 0 && w.seq > 0 {
+		return nil
+	}
+	return w.flush(w.buf.Next(w.buf.Len()))
+}
+
+func (w *exportFileWriter) flush(chunk []byte) error {
+	// 空内容写入空值而不是 NULL
+	if chunk == nil {
+		chunk = []byte{}
+	}
+	err := w.repo.data.DB(w.ctx).ExportFile.Create().
+		SetJobID(w.jobID).
+		SetSeq(w.seq).
+		SetData(chunk).
+		SetSize(len(chunk)).
+		Exec(w.ctx)
+	if err != nil {
+		w.repo.log.Errorf("写入导出文件失败，任务ID：%s，分块：%d，错误：%v", w.jobID, w.seq, err)
+		return err
+	}
+	w.seq++
+	return nil
+}
+
+// exportFileReader 导出文件读取器，读完当前块后再查询下一块
+type exportFileReader struct {
+	ctx    context.Context
+	repo   *exportRepo
+	jobID  string
+	chunks int
+	seq    int
+	buf    []byte
+}
+
+func (r *exportFileReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.seq >= r.chunks {
+			return 0, io.EOF
+		}
+		chunk, err := r.repo.data.DB(r.ctx).ExportFile.Query().
+			Where(exportfile.JobID(r.jobID), exportfile.Seq(r.seq)).
+			Only(r.ctx)
+		if err != nil {
+			// 读取期间文件过期被清理
+			if gen.IsNotFound(err) {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		r.buf = chunk.Data
+		r.seq++
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *exportFileReader) Close() error {
+	r.buf = nil
+	r.seq = r.chunks
+	return nil
+}
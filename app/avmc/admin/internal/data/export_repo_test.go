@@ -0,0 +1,74 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/exportfile"
+)
+
+func TestExportFile(t *testing.T) {
+	ctx := context.Background()
+	data := &Data{db: newTestEntClient(t)}
+	// 两个实例共享同一个数据库
+	writer := &exportRepo{data: data, log: log.NewHelper(log.DefaultLogger), ttl: time.Hour}
+	reader := &exportRepo{data: data, log: log.NewHelper(log.DefaultLogger), ttl: time.Hour}
+	job := &v1.ExportJob{Id: "1", Format: "csv"}
+
+	_, _, err := reader.OpenFile(ctx, job)
+	assert.ErrorIs(t, err, biz.ErrExportJobNotFound)
+
+	// 内容超过一块时分块写入
+	content := bytes.Repeat([]byte("0123456789"), exportChunkSize/5+3)
+	w, err := writer.CreateFile(ctx, job)
+	require.NoError(t, err)
+	for _, p := range [][]byte{content[:100], content[100:]} {
+		_, err = w.Write(p)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	n, err := data.db.ExportFile.Query().Where(exportfile.JobID("1")).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	rc, size, err := reader.OpenFile(ctx, job)
+	require.NoError(t, err)
+	got, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	assert.Equal(t, int64(len(content)), size)
+	assert.Equal(t, content, got)
+
+	// 空文件也可以下载
+	empty := &v1.ExportJob{Id: "2", Format: "csv"}
+	w, err = writer.CreateFile(ctx, empty)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	rc, size, err = reader.OpenFile(ctx, empty)
+	require.NoError(t, err)
+	got, err = io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Zero(t, size)
+	assert.Empty(t, got)
+
+	require.NoError(t, reader.RemoveFile(ctx, job))
+	_, _, err = reader.OpenFile(ctx, job)
+	assert.ErrorIs(t, err, biz.ErrExportJobNotFound)
+
+	// 创建文件时清理超过保留时长的文件
+	writer.ttl = -time.Second
+	w, err = writer.CreateFile(ctx, &v1.ExportJob{Id: "3", Format: "csv"})
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	_, _, err = reader.OpenFile(ctx, empty)
+	assert.ErrorIs(t, err, biz.ErrExportJobNotFound)
+}
@@ -0,0 +1,299 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/entityhistory"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/menu"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/role"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
+	"backend-service/app/avmc/admin/internal/data/ent/mixins"
+	"backend-service/pkg/auth/authn"
+	"backend-service/pkg/entgo"
+	"backend-service/pkg/utils/convert"
+	"backend-service/pkg/utils/trans"
+)
+
+const (
+	// historyActionCreate 创建
+	historyActionCreate = "create"
+	// historyActionUpdate 更新
+	historyActionUpdate = "update"
+	// historyActionDelete 软删除
+	historyActionDelete = "delete"
+	// historyActionRestore 从回收站恢复
+	historyActionRestore = "restore"
+	// historyActionPurge 彻底删除
+	historyActionPurge = "purge"
+)
+
+// historyIgnoredFields 每次更新都会变化的字段，不记录为字段变更，仍包含在快照中
+var historyIgnoredFields = []string{"updated_at", "updated_by", "version"}
+
+// historyLoader 按ID读取实体快照，包含已软删除的数据，彻底删除的数据不在结果中
+type historyLoader func(ctx context.Context, client *gen.Client, ids []uint32) (map[uint32]map[string]any, error)
+
+// historyLoaders 各资源的快照读取方式，在此注册并在客户端上注册 historyHook 的资源记录变更历史
+var historyLoaders = map[string]historyLoader{
+	biz.HistoryResourceUser: func(ctx context.Context, client *gen.Client, ids []uint32) (map[uint32]map[string]any, error) {
+		res, err := client.User.Query().Where(user.IDIn(ids...)).All(mixins.SkipSoftDelete(ctx))
+		return historySnapshots(res, func(u *gen.User) uint32 { return u.ID }), err
+	},
+	biz.HistoryResourceRole: func(ctx context.Context, client *gen.Client, ids []uint32) (map[uint32]map[string]any, error) {
+		res, err := client.Role.Query().Where(role.IDIn(ids...)).All(mixins.SkipSoftDelete(ctx))
+		return historySnapshots(res, func(r *gen.Role) uint32 { return r.ID }), err
+	},
+	biz.HistoryResourceMenu: func(ctx context.Context, client *gen.Client, ids []uint32) (map[uint32]map[string]any, error) {
+		res, err := client.Menu.Query().Where(menu.IDIn(ids...)).All(mixins.SkipSoftDelete(ctx))
+		return historySnapshots(res, func(m *gen.Menu) uint32 { return m.ID }), err
+	},
+}
+
+// historySnapshots 将实体转换为以ID为键的快照
+func historySnapshots[T any](res []T, id func(T) uint32) map[uint32]map[string]any {
+	snapshots := make(map[uint32]map[string]any, len(res))
+	for _, e := range res {
+		snapshots[id(e)] = entgo.Snapshot(e)
+	}
+	return snapshots
+}
+
+// historyMutation 可记录变更历史的变更
+type historyMutation interface {
+	ent.Mutation
+	ID() (uint32, bool)
+	IDs(context.Context) ([]uint32, error)
+	Client() *gen.Client
+	Tx() (*gen.Tx, error)
+}
+
+// historyHook 记录变更历史的钩子
+// 变更前后按ID读取快照并比较，每个实体写入一条记录；敏感字段不在快照中，变更时以掩码记录。
+// 软删除由软删除钩子转换为更新后再次经过本钩子，删除操作本身只记录彻底删除。
+// 事务中的变更与变更历史一起提交或回滚，事务外的变更已生效，变更历史写入失败只记录日志
+func historyHook(resource string, logger log.Logger) ent.Hook {
+	l := log.NewHelper(log.With(logger, "module", "history/data/hook"))
+	load := historyLoaders[resource]
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mx, ok := m.(historyMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			// 软删除钩子会将删除变更改为更新，变更前记录原操作
+			op := m.Op()
+			var (
+				ids    []uint32
+				before map[uint32]map[string]any
+			)
+			if !op.Is(ent.OpCreate) {
+				var err error
+				if ids, err = mx.IDs(ctx); err != nil {
+					return nil, err
+				}
+				if len(ids) == 0 {
+					return next.Mutate(ctx, m)
+				}
+				if before, err = load(ctx, mx.Client(), ids); err != nil {
+					return nil, err
+				}
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			if op.Is(ent.OpCreate) {
+				if id, ok := mx.ID(); ok {
+					ids = []uint32{id}
+				}
+			}
+			if len(ids) == 0 {
+				return v, nil
+			}
+
+			if err := writeHistory(ctx, mx, op, resource, ids, before, load); err != nil {
+				l.Errorf("写入变更历史失败，资源：%s，ID：%v，错误：%v", resource, ids, err)
+				// 事务中返回错误使变更一起回滚
+				if _, txErr := mx.Tx(); txErr == nil {
+					return nil, err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+// writeHistory 读取变更后的快照，与变更前比较后写入变更历史
+func writeHistory(ctx context.Context, m historyMutation, op ent.Op, resource string, ids []uint32, before map[uint32]map[string]any, load historyLoader) error {
+	after, err := load(ctx, m.Client(), ids)
+	if err != nil {
+		return err
+	}
+	var operator *uint32
+	if id := authn.GetAuthUserID(ctx); id > 0 {
+		operator = &id
+	}
+	builders := make([]*gen.EntityHistoryCreate, 0, len(ids))
+	for _, id := range ids {
+		old, cur := before[id], after[id]
+		// 删除操作中仍存在的数据为软删除，已在转换后的更新中记录
+		if op.Is(ent.OpDelete|ent.OpDeleteOne) && cur != nil {
+			continue
+		}
+		action := historyAction(old, cur)
+		changes := append(entgo.Diff(old, cur, historyIgnoredFields...), sensitiveChanges(m, old, cur)...)
+		if action == historyActionUpdate && len(changes) == 0 {
+			continue
+		}
+		builders = append(builders, m.Client().EntityHistory.Create().
+			SetResource(resource).
+			SetEntityID(id).
+			SetAction(action).
+			SetChanges(changes).
+			SetSnapshot(cur).
+			SetNillableOperatorID(operator))
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+	return m.Client().EntityHistory.CreateBulk(builders...).Exec(ctx)
+}
+
+// historyAction 根据变更前后的快照确定操作
+func historyAction(old, cur map[string]any) string {
+	switch {
+	case old == nil:
+		return historyActionCreate
+	case cur == nil:
+		return historyActionPurge
+	case old["deleted_at"] == nil && cur["deleted_at"] != nil:
+		return historyActionDelete
+	case old["deleted_at"] != nil && cur["deleted_at"] == nil:
+		return historyActionRestore
+	default:
+		return historyActionUpdate
+	}
+}
+
+// sensitiveChanges 变更中不在快照里的字段为敏感字段，以掩码记录变更
+func sensitiveChanges(m ent.Mutation, old, cur map[string]any) []entgo.FieldChange {
+	snapshot := cur
+	if snapshot == nil {
+		snapshot = old
+	}
+	mask := func(set bool) any {
+		if set {
+			return entgo.SensitiveMask
+		}
+		return nil
+	}
+	var changes []entgo.FieldChange
+	for _, name := range m.Fields() {
+		if _, ok := snapshot[name]; !ok {
+			changes = append(changes, entgo.FieldChange{Field: name, Old: mask(old != nil), New: entgo.SensitiveMask})
+		}
+	}
+	for _, name := range m.ClearedFields() {
+		if _, ok := snapshot[name]; !ok {
+			changes = append(changes, entgo.FieldChange{Field: name, Old: entgo.SensitiveMask})
+		}
+	}
+	return changes
+}
+
+var _ biz.HistoryRepo = (*historyRepo)(nil)
+
+// historyRepo 实体变更历史数据仓库
+type historyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewHistoryRepo 创建新的实体变更历史数据仓库实例
+// 参数：data 数据源，logger 日志记录器
+// 返回值：变更历史数据仓库接口
+func NewHistoryRepo(data *Data, logger log.Logger) biz.HistoryRepo {
+	return &historyRepo{data: data, log: log.NewHelper(log.With(logger, "module", "history/data"))}
+}
+
+// List 分页查询实体的变更记录
+func (r *historyRepo) List(ctx context.Context, resource string, id uint32, before *time.Time, offset, limit int) ([]*v1.HistoryRecord, int, error) {
+	q := r.data.DB(ctx).EntityHistory.Query().
+		Where(entityhistory.Resource(resource), entityhistory.EntityID(id))
+	if before != nil {
+		q.Where(entityhistory.CreatedAtLTE(*before))
+	}
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("查询变更历史失败，资源：%s，ID：%d，错误：%v", resource, id, err)
+		return nil, 0, entError(err, nil, nil, errDBQuery)
+	}
+	res, err := q.
+		Order(entityhistory.ByCreatedAt(sql.OrderDesc()), entityhistory.ByID(sql.OrderDesc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("查询变更历史失败，资源：%s，ID：%d，错误：%v", resource, id, err)
+		return nil, 0, entError(err, nil, nil, errDBQuery)
+	}
+	return convert.SliceToAny(res, historyRecord), total, nil
+}
+
+// Snapshot 查询时间点及之前最后一条变更记录的快照
+func (r *historyRepo) Snapshot(ctx context.Context, resource string, id uint32, at time.Time) (*structpb.Struct, error) {
+	res, err := r.data.DB(ctx).EntityHistory.Query().
+		Where(entityhistory.Resource(resource), entityhistory.EntityID(id), entityhistory.CreatedAtLTE(at)).
+		Order(entityhistory.ByCreatedAt(sql.OrderDesc()), entityhistory.ByID(sql.OrderDesc())).
+		First(ctx)
+	if gen.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("查询数据快照失败，资源：%s，ID：%d，错误：%v", resource, id, err)
+		return nil, entError(err, nil, nil, errDBQuery)
+	}
+	if res.Snapshot == nil {
+		return nil, nil
+	}
+	snapshot, err := structpb.NewStruct(res.Snapshot)
+	if err != nil {
+		r.log.Errorf("转换数据快照失败，资源：%s，ID：%d，错误：%v", resource, id, err)
+		return nil, errDBQuery
+	}
+	return snapshot, nil
+}
+
+// historyRecord 转换gen.EntityHistory为v1.HistoryRecord
+func historyRecord(res *gen.EntityHistory) *v1.HistoryRecord {
+	changes := make([]*v1.FieldChange, 0, len(res.Changes))
+	for _, c := range res.Changes {
+		changes = append(changes, &v1.FieldChange{Field: c.Field, OldValue: historyValue(c.Old), NewValue: historyValue(c.New)})
+	}
+	return &v1.HistoryRecord{
+		Id:         res.ID,
+		Action:     res.Action,
+		Changes:    changes,
+		OperatorId: res.OperatorID,
+		CreatedAt:  trans.StringValue(convert.TimeValueToString(&res.CreatedAt, time.DateTime)),
+	}
+}
+
+// historyValue 转换字段值，无法转换的值以字符串表示
+func historyValue(v any) *structpb.Value {
+	value, err := structpb.NewValue(v)
+	if err != nil {
+		return structpb.NewStringValue(fmt.Sprint(v))
+	}
+	return value
+}
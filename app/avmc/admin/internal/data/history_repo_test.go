@@ -0,0 +1,144 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/conf"
+	"backend-service/pkg/auth/authn"
+	"backend-service/pkg/entgo"
+	"backend-service/pkg/middleware/fieldmask"
+	"backend-service/pkg/utils/trans"
+)
+
+func TestHistoryHookAndRepo(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t)
+	client.User.Use(historyHook(biz.HistoryResourceUser, log.DefaultLogger))
+	client.Role.Use(historyHook(biz.HistoryResourceRole, log.DefaultLogger))
+	d := &Data{db: client}
+	repo := NewHistoryRepo(d, log.DefaultLogger)
+	users := NewUserRepo(d, log.DefaultLogger)
+	roles := NewRoleRepo(d, log.DefaultLogger)
+	trash := NewTrashRepo(&conf.Data{}, d, nil, log.DefaultLogger)
+	operator := authn.ContextWithAuthUser(ctx, &securityUser{subject: "7"})
+	actions := func(resource string, id uint32) []string {
+		items, _, err := repo.List(ctx, resource, id, nil, 0, 20)
+		require.NoError(t, err)
+		var res []string
+		for _, item := range items {
+			res = append(res, item.GetAction())
+		}
+		return res
+	}
+
+	// 创建时记录全部字段，敏感字段以掩码记录且不在快照中
+	alice := client.User.Create().SetName("alice").SetPassword("secret").SaveX(operator)
+	items, total, err := repo.List(ctx, biz.HistoryResourceUser, alice.ID, nil, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	assert.Equal(t, "create", items[0].GetAction())
+	assert.Equal(t, uint32(7), items[0].GetOperatorId())
+	changes := map[string]*v1.FieldChange{}
+	for _, c := range items[0].GetChanges() {
+		changes[c.GetField()] = c
+	}
+	assert.Equal(t, "alice", changes["name"].GetNewValue().GetStringValue())
+	assert.Equal(t, entgo.SensitiveMask, changes["password"].GetNewValue().GetStringValue())
+	created := time.Now()
+
+	// 更新只记录变化的字段
+	_, err = users.Update(ctx, &pbCore.User{Id: alice.ID, Name: trans.String("alicia"), Password: trans.String("secret2")})
+	require.NoError(t, err)
+	items, _, err = repo.List(ctx, biz.HistoryResourceUser, alice.ID, nil, 0, 1)
+	require.NoError(t, err)
+	assert.Nil(t, items[0].OperatorId)
+	var fields []string
+	for _, c := range items[0].GetChanges() {
+		fields = append(fields, c.GetField())
+		if c.GetField() == "name" {
+			assert.Equal(t, "alice", c.GetOldValue().GetStringValue())
+			assert.Equal(t, "alicia", c.GetNewValue().GetStringValue())
+		}
+	}
+	assert.Equal(t, []string{"name", "password"}, fields)
+
+	// 时间点的快照
+	snapshot, err := repo.Snapshot(ctx, biz.HistoryResourceUser, alice.ID, created)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", snapshot.GetFields()["name"].GetStringValue())
+	assert.NotContains(t, snapshot.GetFields(), "password")
+	snapshot, err = repo.Snapshot(ctx, biz.HistoryResourceUser, alice.ID, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "alicia", snapshot.GetFields()["name"].GetStringValue())
+	snapshot, err = repo.Snapshot(ctx, biz.HistoryResourceUser, alice.ID, created.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+
+	// 软删除、恢复与彻底删除各记录一次，没有字段变化的更新不记录
+	admin, err := roles.Save(ctx, &pbCore.Role{Name: trans.String("admin")})
+	require.NoError(t, err)
+	_, err = roles.Update(ctx, &pbCore.Role{Id: admin.GetId(), Name: trans.String("admin")})
+	require.NoError(t, err)
+	require.NoError(t, roles.Delete(ctx, admin.GetId()))
+	require.NoError(t, trash.Restore(ctx, biz.TrashResourceRole, admin.GetId()))
+	require.NoError(t, roles.Delete(ctx, admin.GetId()))
+	require.NoError(t, trash.Purge(ctx, biz.TrashResourceRole, admin.GetId()))
+	assert.Equal(t, []string{"purge", "delete", "restore", "delete", "create"}, actions(biz.HistoryResourceRole, admin.GetId()))
+	snapshot, err = repo.Snapshot(ctx, biz.HistoryResourceRole, admin.GetId(), time.Now())
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+
+	// 事务回滚时变更历史一并回滚
+	count := client.EntityHistory.Query().CountX(ctx)
+	err = d.InTx(ctx, func(ctx context.Context) error {
+		if _, err := roles.Save(ctx, &pbCore.Role{Name: trans.String("guest")}); err != nil {
+			return err
+		}
+		return biz.ErrRoleAlreadyExists
+	})
+	require.ErrorIs(t, err, biz.ErrRoleAlreadyExists)
+	assert.Equal(t, count, client.EntityHistory.Query().CountX(ctx))
+
+	// 业务用例校验时间点格式
+	uc := biz.NewHistoryUsecase(repo, nil, log.DefaultLogger)
+	_, err = uc.List(ctx, biz.HistoryResourceUser, &v1.ListHistoryRequest{Id: alice.ID, AsOf: "yesterday"})
+	assert.True(t, v1.IsBadRequest(err))
+	resp, err := uc.List(ctx, biz.HistoryResourceUser, &v1.ListHistoryRequest{Id: alice.ID, AsOf: time.Now().Add(time.Second).Format(time.DateTime)})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.GetTotal())
+	assert.Equal(t, "alicia", resp.GetSnapshot().GetFields()["name"].GetStringValue())
+
+	// 快照与字段变更按字段可见性规则脱敏或隐藏
+	_, err = users.Update(ctx, &pbCore.User{Id: alice.ID, Phone: trans.String("13812345678"), Email: trans.String("alice@example.com")})
+	require.NoError(t, err)
+	values := fieldmask.Values(fieldmask.WithRules(
+		fieldmask.Rule{Message: "core.service.v1.User", Fields: []protoreflect.Name{"phone"}, Action: fieldmask.ActionMask, Masker: fieldmask.MaskerPhone, Roles: []string{"hr"}},
+		fieldmask.Rule{Message: "core.service.v1.User", Fields: []protoreflect.Name{"email"}, Action: fieldmask.ActionHide, Roles: []string{"hr"}},
+	))
+	uc = biz.NewHistoryUsecase(repo, func(ctx context.Context, message protoreflect.FullName) func(string, *structpb.Value) (*structpb.Value, bool) {
+		return values(ctx, message)
+	}, log.DefaultLogger)
+	resp, err = uc.List(ctx, biz.HistoryResourceUser, &v1.ListHistoryRequest{Id: alice.ID, PageSize: 1, AsOf: time.Now().Add(time.Second).Format(time.DateTime)})
+	require.NoError(t, err)
+	assert.Equal(t, "138****5678", resp.GetSnapshot().GetFields()["phone"].GetStringValue())
+	assert.NotContains(t, resp.GetSnapshot().GetFields(), "email")
+	assert.Equal(t, "alicia", resp.GetSnapshot().GetFields()["name"].GetStringValue())
+	changes = map[string]*v1.FieldChange{}
+	for _, c := range resp.GetItems()[0].GetChanges() {
+		changes[c.GetField()] = c
+	}
+	assert.Equal(t, "138****5678", changes["phone"].GetNewValue().GetStringValue())
+	require.Contains(t, changes, "email")
+	assert.Nil(t, changes["email"].GetNewValue())
+}
@@ -2,10 +2,9 @@ package data
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"time"
 
+	"entgo.io/ent/schema/field"
 	"github.com/go-kratos/kratos/v2/log"
 
 	"backend-service/api/common/enum"
@@ -14,6 +13,8 @@ import (
 	"backend-service/app/avmc/admin/internal/biz"
 	"backend-service/app/avmc/admin/internal/data/ent/gen"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/menu"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
+	"backend-service/pkg/entgo"
 	"backend-service/pkg/utils/convert"
 )
 
@@ -71,6 +72,9 @@ func (r *menuRepo) toProto(res *gen.Menu) *pbCore.Menu {
 		Status:    (*enum.Status)(res.Status),
 		CreatedAt: convert.TimeValueToString(&res.CreatedAt, time.DateTime),
 		UpdatedAt: convert.TimeValueToString(&res.UpdatedAt, time.DateTime),
+		Version:   &res.Version,
+		CreatedBy: res.CreatedBy,
+		UpdatedBy: res.UpdatedBy,
 	}
 }
 
@@ -124,7 +128,7 @@ func (r *menuRepo) Save(ctx context.Context, g *pbCore.Menu) (*pbCore.Menu, erro
 	})
 	if exist {
 		r.log.Errorf("菜单名称已存在，菜单信息：%v", g)
-		return nil, fmt.Errorf("menu name already exists")
+		return nil, biz.ErrMenuNameAlreadyExists
 	}
 
 	res, err := builder.
@@ -160,7 +164,7 @@ func (r *menuRepo) Save(ctx context.Context, g *pbCore.Menu) (*pbCore.Menu, erro
 		Save(ctx)
 	if err != nil {
 		r.log.Errorf("保存菜单失败，菜单信息：%v，错误：%v", g, err)
-		return nil, err
+		return nil, entError(err, nil, biz.ErrMenuNameAlreadyExists, errDBInsert)
 	}
 	return r.toProto(res), nil
 }
@@ -178,9 +182,13 @@ func (r *menuRepo) Update(ctx context.Context, g *pbCore.Menu) (*pbCore.Menu, er
 	})
 	if exist {
 		r.log.Errorf("菜单名称已存在，菜单信息：%v", g)
-		return nil, fmt.Errorf("menu name already exists")
+		return nil, biz.ErrMenuNameAlreadyExists
 	}
 
+	// 传入版本号时为条件更新，数据已被他人修改时不更新
+	if g.Version != nil {
+		builder.Where(menu.Version(g.GetVersion()))
+	}
 	res, err := builder.
 		SetName(entMenu.Name).
 		SetNillableTitle(entMenu.Title).
@@ -211,10 +219,12 @@ func (r *menuRepo) Update(ctx context.Context, g *pbCore.Menu) (*pbCore.Menu, er
 		SetNillableOpenInNewWindow(entMenu.OpenInNewWindow).
 		SetNillableSort(entMenu.Sort).
 		SetNillableQuery(entMenu.Query).
+		AddVersion(1).
 		Save(ctx)
 	if err != nil {
 		r.log.Errorf("更新菜单失败，菜单信息：%v，错误：%v", g, err)
-		return nil, err
+		err = versionError(ctx, err, g.Version, r.data.DB(ctx).Menu.Query().Where(menu.ID(g.GetId())).Select(menu.FieldVersion).Int)
+		return nil, entError(err, biz.ErrMenuNotFound, biz.ErrMenuNameAlreadyExists, errDBUpdate)
 	}
 	return r.toProto(res), nil
 }
@@ -227,11 +237,8 @@ func (r *menuRepo) FindByID(ctx context.Context, id uint32) (*pbCore.Menu, error
 	res, err := r.data.DB(ctx).Menu.Query().
 		Where(menu.IDEQ(id)).Only(ctx)
 	if err != nil {
-		if gen.IsNotFound(err) {
-			return nil, errors.New("查询数据不存在")
-		}
 		r.log.Errorf("通过ID查询菜单失败，ID：%d，错误：%v", id, err)
-		return nil, err
+		return nil, entError(err, biz.ErrMenuNotFound, nil, errDBQuery)
 	}
 	return r.toProto(res), nil
 }
@@ -241,10 +248,19 @@ func (r *menuRepo) FindByID(ctx context.Context, id uint32) (*pbCore.Menu, error
 // 返回值：错误信息
 func (r *menuRepo) Delete(ctx context.Context, id uint32) error {
 	r.log.Infof("删除菜单，菜单ID：%d", id)
-	err := r.data.DB(ctx).Menu.DeleteOneID(id).Exec(ctx)
+	hasChildren, err := r.data.DB(ctx).Menu.Query().Where(menu.ParentIDEQ(id)).Exist(ctx)
+	if err != nil {
+		r.log.Errorf("查询子菜单失败，菜单ID：%d，错误：%v", id, err)
+		return entError(err, nil, nil, errDBQuery)
+	}
+	if hasChildren {
+		r.log.Errorf("存在子菜单，不允许删除，菜单ID：%d", id)
+		return biz.ErrMenuCannotDeleteWithChildren
+	}
+	err = r.data.DB(ctx).Menu.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		r.log.Errorf("删除菜单失败，菜单ID：%d，错误：%v", id, err)
-		return err
+		return entError(err, biz.ErrMenuNotFound, nil, errDBDelete)
 	}
 	return nil
 }
@@ -297,23 +313,47 @@ func (r *menuRepo) ListAll(ctx context.Context) ([]*pbCore.Menu, error) {
 	return convert.SliceToAny(res, r.toProto), nil
 }
 
+// menuQuerySchema 菜单列表开放查询的字段
+var menuQuerySchema = &entgo.Schema{
+	Fields: map[string]entgo.Field{
+		"id":         {Column: menu.FieldID, Type: field.TypeUint32, Filterable: true, Sortable: true},
+		"name":       {Column: menu.FieldName, Type: field.TypeString, Filterable: true, Sortable: true},
+		"path":       {Column: menu.FieldPath, Type: field.TypeString, Filterable: true},
+		"auth_code":  {Column: menu.FieldAuthCode, Type: field.TypeString, Filterable: true},
+		"meta":       {Select: menuMetaColumns},
+		"children":   {},
+		"component":  {Column: menu.FieldComponent, Type: field.TypeString},
+		"pid":        {Column: menu.FieldParentID, Type: field.TypeUint32, Filterable: true, Sortable: true},
+		"redirect":   {Column: menu.FieldRedirect, Type: field.TypeString},
+		"type":       {Column: menu.FieldType, Type: field.TypeInt32, Filterable: true, Sortable: true},
+		"status":     {Column: menu.FieldStatus, Type: field.TypeInt32, Filterable: true, Sortable: true},
+		"created_at": {Column: menu.FieldCreatedAt, Type: field.TypeTime, Filterable: true, Sortable: true},
+		"updated_at": {Column: menu.FieldUpdatedAt, Type: field.TypeTime, Filterable: true, Sortable: true},
+		"version":    {Column: menu.FieldVersion, Type: field.TypeUint32},
+		"created_by": {Column: menu.FieldCreatedBy, Type: field.TypeUint32, Filterable: true},
+		"updated_by": {Column: menu.FieldUpdatedBy, Type: field.TypeUint32, Filterable: true},
+	},
+	DefaultOrder: []string{"-id"},
+	Required:     []string{menu.FieldID},
+}
+
 // ListPage 查询菜单列表分页
 // 参数：ctx 上下文，pagination 分页请求
 // 返回值：菜单列表响应，错误信息
 func (r *menuRepo) ListPage(ctx context.Context, pagination *pbPagination.PagingRequest) (*pbCore.ListMenuResponse, error) {
 	r.log.Infof("查询菜单列表分页，分页请求：%v", pagination)
-	count, err := r.data.DB(ctx).Menu.Query().Select(menu.FieldID).
-		// Where(menu.DeletedAtIsNil()).
-		Count(ctx)
+	pq, err := menuQuerySchema.Translate(pagination)
+	if err != nil {
+		return nil, pagingError(err)
+	}
+	query := r.data.DB(ctx).Menu.Query().Where(entgo.Predicates[predicate.Menu](pq)...)
+	count, err := query.Clone().Count(ctx)
 	if err != nil {
-		if gen.IsNotFound(err) {
-			return nil, nil
-		}
 		r.log.Errorf("查询所有菜单列表失败，错误：%v", err)
 		return nil, err
 	}
-	res, err := r.data.DB(ctx).Menu.Query().
-		Select(
+	res, err := query.
+		Select(pq.Columns(
 			menu.FieldID,
 			menu.FieldName,
 			menu.FieldTitle,
@@ -324,15 +364,15 @@ func (r *menuRepo) ListPage(ctx context.Context, pagination *pbPagination.Paging
 			menu.FieldStatus,
 			menu.FieldCreatedAt,
 			menu.FieldUpdatedAt,
-		).
-		Offset(int((pagination.GetPage() - 1) * pagination.GetPageSize())).
-		Limit(int(pagination.GetPageSize())).
-		Order(gen.Desc(menu.FieldID)).
+			menu.FieldVersion,
+			menu.FieldCreatedBy,
+			menu.FieldUpdatedBy,
+		)...).
+		Offset(pq.Offset).
+		Limit(pq.Limit).
+		Order(entgo.Orders[menu.OrderOption](pq)...).
 		All(ctx)
 	if err != nil {
-		if gen.IsNotFound(err) {
-			return nil, nil
-		}
 		r.log.Errorf("查询菜单列表分页失败，分页请求：%v，错误：%v", pagination, err)
 		return nil, err
 	}
@@ -381,3 +421,28 @@ func (r *menuRepo) ExistByName(ctx context.Context, req *pbCore.ExistMenuByNameR
 	}
 	return true, nil
 }
+
+// menuMetaColumns 菜单元数据对应的列
+var menuMetaColumns = []string{
+	menu.FieldTitle,
+	menu.FieldActiveIcon,
+	menu.FieldActivePath,
+	menu.FieldAffixTab,
+	menu.FieldAffixTabOrder,
+	menu.FieldBadge,
+	menu.FieldBadgeType,
+	menu.FieldBadgeVariants,
+	menu.FieldHideChildrenInMenu,
+	menu.FieldHideInBreadcrumb,
+	menu.FieldHideInMenu,
+	menu.FieldHideInTab,
+	menu.FieldIcon,
+	menu.FieldIframeSrc,
+	menu.FieldKeepAlive,
+	menu.FieldLink,
+	menu.FieldMaxNumOfOpenTab,
+	menu.FieldNoBasicLayout,
+	menu.FieldOpenInNewWindow,
+	menu.FieldSort,
+	menu.FieldQuery,
+}
@@ -0,0 +1,146 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/pkg/auth/authz"
+	"backend-service/pkg/utils/pagination"
+)
+
+var _ biz.PolicyRepo = (*policyRepo)(nil)
+
+// policyRepo 策略仓库，直接读写授权引擎，变更经由引擎持久化并通知其它实例
+type policyRepo struct {
+	data       *Data
+	authorizer authz.Authorizer
+	log        *log.Helper
+}
+
+// NewPolicyRepo 创建新的策略仓库实例
+// 参数：data 数据访问实例，authorizer 授权器，logger 日志记录器
+// 返回值：策略仓库实例
+func NewPolicyRepo(data *Data, authorizer authz.Authorizer, logger log.Logger) biz.PolicyRepo {
+	return &policyRepo{
+		data:       data,
+		authorizer: authorizer,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// manager 返回授权器的策略管理接口
+func (r *policyRepo) manager() (authz.PolicyManager, error) {
+	manager, ok := r.authorizer.(authz.PolicyManager)
+	if !ok {
+		return nil, biz.ErrPolicyNotSupported
+	}
+	return manager, nil
+}
+
+// ListPolicies 按条件分页获取策略，除对象前缀外的条件由授权引擎过滤
+func (r *policyRepo) ListPolicies(ctx context.Context, filter biz.PolicyFilter, pageNum, pageSize int) ([]authz.Policy, int, error) {
+	manager, err := r.manager()
+	if err != nil {
+		return nil, 0, err
+	}
+	query := authz.Policy{Subject: filter.Subject, Action: filter.Action, Domain: filter.Domain, Effect: filter.Effect}
+	prefix, isPrefix := strings.CutSuffix(string(filter.Object), "*")
+	if !isPrefix {
+		query.Object = filter.Object
+	}
+	policies, err := manager.GetFilteredPolicies(ctx, query)
+	if err != nil {
+		return nil, 0, authzError(err)
+	}
+	if prefix != "" && isPrefix {
+		policies = slices.DeleteFunc(policies, func(p authz.Policy) bool { return !strings.HasPrefix(string(p.Object), prefix) })
+	}
+	return pagination.Slice(policies, pageNum, pageSize), len(policies), nil
+}
+
+// ListRoleBindings 按条件分页获取角色绑定，条件由授权引擎过滤
+func (r *policyRepo) ListRoleBindings(ctx context.Context, filter biz.RoleBindingFilter, pageNum, pageSize int) ([]authz.RoleBinding, int, error) {
+	manager, err := r.manager()
+	if err != nil {
+		return nil, 0, err
+	}
+	bindings, err := manager.GetFilteredRoleBindings(ctx, authz.RoleBinding{User: filter.User, Role: filter.Role, Domain: filter.Domain})
+	if err != nil {
+		return nil, 0, authzError(err)
+	}
+	return pagination.Slice(bindings, pageNum, pageSize), len(bindings), nil
+}
+
+// Apply 在一个事务中应用策略差异，先新增后移除
+// 授权引擎经由上下文中的事务持久化，任一步骤失败时事务回滚，引擎在回滚时重新加载策略
+func (r *policyRepo) Apply(ctx context.Context, diff *biz.PolicyDiff) error {
+	manager, err := r.manager()
+	if err != nil {
+		return err
+	}
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		if len(diff.AddedPolicies) > 0 {
+			if _, err := r.authorizer.AddPolicies(ctx, diff.AddedPolicies); err != nil {
+				return authzError(err)
+			}
+		}
+		if len(diff.AddedRoleBindings) > 0 {
+			if _, err := manager.AddRoleBindings(ctx, diff.AddedRoleBindings); err != nil {
+				return authzError(err)
+			}
+		}
+		if len(diff.RemovedPolicies) > 0 {
+			if _, err := r.authorizer.RemovePolicies(ctx, diff.RemovedPolicies); err != nil {
+				return authzError(err)
+			}
+		}
+		if len(diff.RemovedRoleBindings) > 0 {
+			if _, err := manager.RemoveRoleBindings(ctx, diff.RemovedRoleBindings); err != nil {
+				return authzError(err)
+			}
+		}
+		return nil
+	})
+}
+
+// Explain 绕过决策缓存执行授权检查并返回决策依据
+func (r *policyRepo) Explain(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (*authz.Explanation, error) {
+	explainer, ok := r.authorizer.(authz.Explainer)
+	if !ok {
+		return nil, biz.ErrPolicyNotSupported
+	}
+	explanation, err := explainer.Explain(ctx, sub, obj, act, domain)
+	if err != nil {
+		return nil, authzError(err)
+	}
+	return explanation, nil
+}
+
+// Enforce 执行授权检查，授权引擎以错误表示的拒绝转换为 false
+func (r *policyRepo) Enforce(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (bool, error) {
+	allowed, err := r.authorizer.Enforce(ctx, sub, obj, act, domain)
+	if err != nil {
+		if code, _ := authz.GetAuthzErrorCode(err); code == authz.ErrCodePermissionDenied {
+			return false, nil
+		}
+		return false, authzError(err)
+	}
+	return allowed, nil
+}
+
+// authzError 将授权引擎错误转换为业务错误，参数类错误返回策略无效
+func authzError(err error) error {
+	code, _ := authz.GetAuthzErrorCode(err)
+	switch code {
+	case authz.ErrCodeInvalidPolicy, authz.ErrCodeInvalidSubject, authz.ErrCodeInvalidObject,
+		authz.ErrCodeInvalidAction, authz.ErrCodeInvalidRole, authz.ErrCodeInvalidUser:
+		return v1.ErrorPolicyInvalid("策略格式无效").WithCause(err)
+	default:
+		return v1.ErrorInternalServerError("授权引擎操作失败").WithCause(err)
+	}
+}
@@ -0,0 +1,197 @@
+package data
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/hook"
+	authzEngine "backend-service/pkg/auth/authz"
+	authzCasbin "backend-service/pkg/auth/authz/casbin"
+	authzZanzibar "backend-service/pkg/auth/authz/zanzibar"
+)
+
+func TestPolicyImportExport(t *testing.T) {
+	ctx := context.Background()
+	authorizer, err := authzCasbin.NewProvider().NewAuthorizer(ctx)
+	require.NoError(t, err)
+	uc := biz.NewPolicyUsecase(NewPolicyRepo(&Data{db: newTestEntClient(t)}, authorizer, log.DefaultLogger), nil, log.DefaultLogger)
+
+	require.NoError(t, uc.Add(ctx,
+		[]authzEngine.Policy{
+			{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "1"},
+			{Subject: "admin", Object: "/api/users", Action: "DELETE", Domain: "1"},
+			{Subject: "guest", Object: "/api/users", Action: "GET", Domain: "2"},
+		},
+		[]authzEngine.RoleBinding{{User: "alice", Role: "admin", Domain: "1"}},
+	))
+
+	csv := []byte("p, admin, /api/users, GET, 1\np, admin, /api/posts, GET, 1, allow\ng, bob, admin, 1\n")
+
+	// 预览不应用变更
+	diff, err := uc.Import(ctx, csv, "1", true, true)
+	require.NoError(t, err)
+	assert.Equal(t, []authzEngine.Policy{{Subject: "admin", Object: "/api/posts", Action: "GET", Domain: "1", Effect: authzEngine.EffectAllow}}, diff.AddedPolicies)
+	assert.Equal(t, []authzEngine.Policy{{Subject: "admin", Object: "/api/users", Action: "DELETE", Domain: "1", Effect: authzEngine.EffectAllow}}, diff.RemovedPolicies)
+	assert.Equal(t, []authzEngine.RoleBinding{{User: "bob", Role: "admin", Domain: "1"}}, diff.AddedRoleBindings)
+	assert.Equal(t, []authzEngine.RoleBinding{{User: "alice", Role: "admin", Domain: "1"}}, diff.RemovedRoleBindings)
+	_, total, err := uc.ListPolicies(ctx, biz.PolicyFilter{Domain: "1"}, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	// 替换域 1 的规则，域 2 不受影响
+	_, err = uc.Import(ctx, csv, "1", true, false)
+	require.NoError(t, err)
+	ok, err := authorizer.Enforce(ctx, "bob", "/api/posts", "GET", "1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, _ = authorizer.Enforce(ctx, "alice", "/api/users", "GET", "1")
+	assert.False(t, ok)
+
+	data, err := uc.Export(ctx, "")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"p, admin, /api/users, GET, 1, allow",
+		"p, guest, /api/users, GET, 2, allow",
+		"p, admin, /api/posts, GET, 1, allow",
+		"g, bob, admin, 1",
+	}, splitLines(string(data)))
+
+	// 再次导入无差异
+	diff, err = uc.Import(ctx, data, "", false, false)
+	require.NoError(t, err)
+	assert.True(t, diff.Empty())
+
+	items, total, err := uc.ListPolicies(ctx, biz.PolicyFilter{Object: "/api/*", Domain: "1"}, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, items, 1)
+
+	// 范围外的规则与格式错误
+	_, err = uc.Import(ctx, []byte("p, guest, /api/users, GET, 2\n"), "1", false, true)
+	assert.True(t, v1.IsPolicyInvalid(err))
+	_, err = uc.Import(ctx, []byte("x, a, b\n"), "", false, true)
+	assert.True(t, v1.IsPolicyInvalid(err))
+}
+
+func TestPolicyApplyRollback(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t)
+	d := &Data{db: client}
+	adapter := NewCasbinAdapter(d)
+	authorizer, err := authzCasbin.NewProvider().NewAuthorizer(ctx, authzCasbin.WithAdapter(adapter))
+	require.NoError(t, err)
+	adapter.OnRollback(func(ctx context.Context) {
+		require.NoError(t, authorizer.(*authzCasbin.CasbinAuthorizer).Reload(ctx))
+	})
+	uc := biz.NewPolicyUsecase(NewPolicyRepo(d, authorizer, log.DefaultLogger), nil, log.DefaultLogger)
+	require.NoError(t, uc.Add(ctx,
+		[]authzEngine.Policy{{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "1"}},
+		[]authzEngine.RoleBinding{{User: "alice", Role: "admin", Domain: "1"}},
+	))
+
+	// 移除规则失败时，同一差异中已新增的策略与角色绑定一并回滚
+	client.CasbinRule.Use(func(next gen.Mutator) gen.Mutator {
+		return hook.CasbinRuleFunc(func(ctx context.Context, m *gen.CasbinRuleMutation) (gen.Value, error) {
+			if m.Op().Is(gen.OpDelete | gen.OpDeleteOne) {
+				return nil, stderrors.New("delete failed")
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	_, err = uc.Import(ctx, []byte("p, admin, /api/posts, GET, 1\ng, bob, admin, 1\n"), "1", true, false)
+	require.Error(t, err)
+
+	count, err := client.CasbinRule.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	data, err := uc.Export(ctx, "1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"p, admin, /api/users, GET, 1, allow", "g, alice, admin, 1"}, splitLines(string(data)))
+	ok, _ := authorizer.Enforce(ctx, "bob", "/api/posts", "GET", "1")
+	assert.False(t, ok)
+}
+
+func TestPolicyExplain(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t)
+	require.NoError(t, client.Role.Create().SetName("admin").SetDataScope(4).Exec(ctx))
+	require.NoError(t, client.Role.Create().SetName("auditor").SetDataScope(2).Exec(ctx))
+
+	authorizer, err := authzCasbin.NewProvider().NewAuthorizer(ctx)
+	require.NoError(t, err)
+	uc := biz.NewPolicyUsecase(NewPolicyRepo(&Data{db: client}, authorizer, log.DefaultLogger), NewRoleRepo(&Data{db: client}, log.DefaultLogger), log.DefaultLogger)
+	require.NoError(t, uc.Add(ctx,
+		[]authzEngine.Policy{{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "1"}},
+		[]authzEngine.RoleBinding{
+			{User: "1", Role: "auditor", Domain: "1"},
+			{User: "auditor", Role: "admin", Domain: "1"},
+			{User: "1", Role: "ops", Domain: "1"},
+		},
+	))
+
+	e, err := uc.Explain(ctx, "1", "/api/users", "GET", "1")
+	require.NoError(t, err)
+	assert.True(t, e.Allowed)
+	assert.Equal(t, []authzEngine.Policy{{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "1", Effect: authzEngine.EffectAllow}}, e.MatchedPolicies)
+	assert.Len(t, e.RoleChain, 3)
+	assert.Equal(t, int32(4), e.DataScope)
+	require.Len(t, e.Roles, 3)
+	for _, r := range e.Roles {
+		assert.Equal(t, r.Name != "ops", r.Role != nil, r.Name)
+	}
+
+	// 未授权时不返回错误
+	e, err = uc.Explain(ctx, "1", "/api/users", "DELETE", "1")
+	require.NoError(t, err)
+	assert.False(t, e.Allowed)
+	assert.Empty(t, e.MatchedPolicies)
+
+	_, err = uc.Explain(ctx, "1", "", "GET", "1")
+	assert.True(t, v1.IsPolicyInvalid(err))
+}
+
+func TestPolicyIsAuthorized(t *testing.T) {
+	ctx := context.Background()
+	authorizer, err := authzCasbin.NewProvider().NewAuthorizer(ctx)
+	require.NoError(t, err)
+	uc := biz.NewPolicyUsecase(NewPolicyRepo(&Data{db: newTestEntClient(t)}, authorizer, log.DefaultLogger), nil, log.DefaultLogger)
+	require.NoError(t, uc.Add(ctx,
+		[]authzEngine.Policy{{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "1"}},
+		[]authzEngine.RoleBinding{{User: "alice", Role: "admin", Domain: "1"}},
+	))
+
+	allowed, err := uc.IsAuthorized(ctx, "alice", "/api/users", "GET", "1")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	// 拒绝不作为错误返回
+	allowed, err = uc.IsAuthorized(ctx, "bob", "/api/users", "GET", "1")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	_, err = uc.IsAuthorized(ctx, "alice", "", "GET", "1")
+	assert.Equal(t, v1.ErrorReason_POLICY_INVALID.String(), errors.FromError(err).Reason)
+}
+
+func TestPolicyNotSupported(t *testing.T) {
+	ctx := context.Background()
+	authorizer, err := authzZanzibar.NewProvider().NewAuthorizer(ctx)
+	require.NoError(t, err)
+	uc := biz.NewPolicyUsecase(NewPolicyRepo(&Data{db: newTestEntClient(t)}, authorizer, log.DefaultLogger), nil, log.DefaultLogger)
+	_, err = uc.Export(ctx, "")
+	assert.Equal(t, int32(501), errors.FromError(err).Code)
+}
+
+func splitLines(s string) []string {
+	return strings.Split(strings.TrimSpace(s), "\n")
+}
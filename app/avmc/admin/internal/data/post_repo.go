@@ -2,10 +2,9 @@ package data
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"time"
 
+	"entgo.io/ent/schema/field"
 	"github.com/go-kratos/kratos/v2/log"
 
 	pbPagination "backend-service/api/common/pagination"
@@ -13,6 +12,8 @@ import (
 	"backend-service/app/avmc/admin/internal/biz"
 	"backend-service/app/avmc/admin/internal/data/ent/gen"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/post"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
+	"backend-service/pkg/entgo"
 	"backend-service/pkg/utils/convert"
 )
 
@@ -40,6 +41,9 @@ func (r *postRepo) toProto(res *gen.Post) *pbCore.Post {
 		Name:      res.Name,
 		CreatedAt: convert.TimeValueToString(&res.CreatedAt, time.DateTime),
 		UpdatedAt: convert.TimeValueToString(&res.UpdatedAt, time.DateTime),
+		Version:   &res.Version,
+		CreatedBy: res.CreatedBy,
+		UpdatedBy: res.UpdatedBy,
 	}
 }
 
@@ -62,14 +66,14 @@ func (r *postRepo) Save(ctx context.Context, g *pbCore.Post) (*pbCore.Post, erro
 	id, _ := r.GetPostExistByName(ctx, *entPost.Name)
 	if id > 0 {
 		r.log.Errorf("岗位名称已存在，岗位信息：%v", g)
-		return nil, fmt.Errorf("post name already exists")
+		return nil, biz.ErrPostAlreadyExists
 	}
 
 	res, err := builder.SetName(*entPost.Name).
 		Save(ctx)
 	if err != nil {
 		r.log.Errorf("保存岗位失败，岗位信息：%v，错误：%v", g, err)
-		return nil, err
+		return nil, entError(err, nil, biz.ErrPostAlreadyExists, errDBInsert)
 	}
 	return r.toProto(res), nil
 }
@@ -97,15 +101,21 @@ func (r *postRepo) Update(ctx context.Context, g *pbCore.Post) (*pbCore.Post, er
 	id, _ := r.GetPostExistByName(ctx, *entPost.Name)
 	if id > 0 && id != g.GetId() {
 		r.log.Errorf("岗位名称已存在，岗位信息：%v", g)
-		return nil, fmt.Errorf("post name already exists")
+		return nil, biz.ErrPostAlreadyExists
 	}
 
+	// 传入版本号时为条件更新，数据已被他人修改时不更新
+	if g.Version != nil {
+		builder.Where(post.Version(g.GetVersion()))
+	}
 	res, err := builder.
 		SetName(*entPost.Name).
+		AddVersion(1).
 		Save(ctx)
 	if err != nil {
 		r.log.Errorf("更新岗位失败，岗位信息：%v，错误：%v", g, err)
-		return nil, err
+		err = versionError(ctx, err, g.Version, r.data.DB(ctx).Post.Query().Where(post.ID(g.GetId())).Select(post.FieldVersion).Int)
+		return nil, entError(err, biz.ErrPostNotFound, biz.ErrPostAlreadyExists, errDBUpdate)
 	}
 	return r.toProto(res), nil
 }
@@ -119,10 +129,7 @@ func (r *postRepo) FindByID(ctx context.Context, id uint32) (*pbCore.Post, error
 		Where(post.IDEQ(id)).Only(ctx)
 	if err != nil {
 		r.log.Errorf("通过ID查询岗位失败，ID：%d，错误：%v", id, err)
-		if gen.IsNotFound(err) {
-			return nil, errors.New("查询数据不存在")
-		}
-		return nil, err
+		return nil, entError(err, biz.ErrPostNotFound, nil, errDBQuery)
 	}
 	return r.toProto(res), nil
 }
@@ -132,10 +139,10 @@ func (r *postRepo) FindByID(ctx context.Context, id uint32) (*pbCore.Post, error
 // 返回值：错误信息
 func (r *postRepo) Delete(ctx context.Context, id uint32) error {
 	r.log.Infof("删除岗位，岗位ID：%d", id)
-	err := r.data.DB(ctx).Post.UpdateOneID(id).SetDeletedAt(time.Now()).Exec(ctx)
+	err := r.data.DB(ctx).Post.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		r.log.Errorf("删除岗位失败，岗位ID：%d，错误：%v", id, err)
-		return err
+		return entError(err, biz.ErrPostNotFound, nil, errDBDelete)
 	}
 	return nil
 }
@@ -166,27 +173,52 @@ func (r *postRepo) ListAll(ctx context.Context) ([]*pbCore.Post, error) {
 	return convert.SliceToAny(res, r.toProto), nil
 }
 
+// postQuerySchema 岗位列表开放查询的字段
+var postQuerySchema = &entgo.Schema{
+	Fields: map[string]entgo.Field{
+		"id":         {Column: post.FieldID, Type: field.TypeUint32, Filterable: true, Sortable: true},
+		"name":       {Column: post.FieldName, Type: field.TypeString, Filterable: true, Sortable: true},
+		"status":     {Column: post.FieldStatus, Type: field.TypeInt32, Filterable: true, Sortable: true},
+		"sort":       {},
+		"remark":     {},
+		"created_at": {Column: post.FieldCreatedAt, Type: field.TypeTime, Filterable: true, Sortable: true},
+		"updated_at": {Column: post.FieldUpdatedAt, Type: field.TypeTime, Filterable: true, Sortable: true},
+		"version":    {Column: post.FieldVersion, Type: field.TypeUint32},
+		"created_by": {Column: post.FieldCreatedBy, Type: field.TypeUint32, Filterable: true},
+		"updated_by": {Column: post.FieldUpdatedBy, Type: field.TypeUint32, Filterable: true},
+	},
+	DefaultOrder: []string{"-id"},
+	Required:     []string{post.FieldID},
+}
+
 // ListPage 查询岗位列表分页
 // 参数：ctx 上下文，pagination 分页请求
 // 返回值：岗位列表响应，错误信息
 func (r *postRepo) ListPage(ctx context.Context, pagination *pbPagination.PagingRequest) (*pbCore.ListPostResponse, error) {
 	r.log.Infof("查询岗位列表分页，分页请求：%v", pagination)
-	count, err := r.data.DB(ctx).Post.Query().Select(post.FieldID).Where(post.DeletedAtIsNil()).Count(ctx)
+	pq, err := postQuerySchema.Translate(pagination)
+	if err != nil {
+		return nil, pagingError(err)
+	}
+	query := r.data.DB(ctx).Post.Query().Where(post.DeletedAtIsNil()).Where(entgo.Predicates[predicate.Post](pq)...)
+	count, err := query.Clone().Count(ctx)
 	if err != nil {
 		r.log.Errorf("查询所有岗位列表失败，错误：%v", err)
 		return nil, err
 	}
-	res, err := r.data.DB(ctx).Post.Query().
-		Select(
+	res, err := query.
+		Select(pq.Columns(
 			post.FieldID,
 			post.FieldName,
 			post.FieldCreatedAt,
 			post.FieldUpdatedAt,
-		).
-		Where().
-		Offset(int((pagination.GetPage() - 1) * pagination.GetPageSize())).
-		Limit(int(pagination.GetPageSize())).
-		Order(gen.Desc(post.FieldID)).
+			post.FieldVersion,
+			post.FieldCreatedBy,
+			post.FieldUpdatedBy,
+		)...).
+		Offset(pq.Offset).
+		Limit(pq.Limit).
+		Order(entgo.Orders[post.OrderOption](pq)...).
 		All(ctx)
 	if err != nil {
 		r.log.Errorf("查询岗位列表分页失败，分页请求：%v，错误：%v", pagination, err)
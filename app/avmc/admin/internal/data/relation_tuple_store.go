@@ -0,0 +1,154 @@
+package data
+
+import (
+	"context"
+	"slices"
+
+	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/relationtuple"
+	"backend-service/pkg/auth/authz/zanzibar"
+)
+
+var _ zanzibar.Store = (*RelationTupleStore)(nil)
+
+// RelationTupleStore 基于 ent 的关系元组存储，元组与业务数据保存在同一数据库
+type RelationTupleStore struct {
+	db *gen.Client
+}
+
+// NewRelationTupleStore 创建基于 ent 的关系元组存储
+// 参数：db ent客户端
+// 返回值：关系元组存储
+func NewRelationTupleStore(db *gen.Client) *RelationTupleStore {
+	return &RelationTupleStore{db: db}
+}
+
+// Write 在事务中写入元组，已存在及重复的元组忽略
+func (s *RelationTupleStore) Write(ctx context.Context, tuples ...zanzibar.Tuple) error {
+	if len(tuples) == 0 {
+		return nil
+	}
+	return withEntTx(ctx, s.db, func(ctx context.Context, client *gen.Client) error {
+		builders := make([]*gen.RelationTupleCreate, 0, len(tuples))
+		created := make([]zanzibar.Tuple, 0, len(tuples))
+		for _, t := range tuples {
+			if slices.Contains(created, t) {
+				continue
+			}
+			exist, err := client.RelationTuple.Query().Where(relationTupleEQ(t)...).Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if exist {
+				continue
+			}
+			created = append(created, t)
+			builders = append(builders, client.RelationTuple.Create().
+				SetObjectNamespace(t.Object.Namespace).
+				SetObjectID(t.Object.ID).
+				SetRelation(t.Relation).
+				SetSubjectNamespace(t.Subject.Namespace).
+				SetSubjectID(t.Subject.ID).
+				SetSubjectRelation(t.Subject.Relation))
+		}
+		if len(builders) == 0 {
+			return nil
+		}
+		return client.RelationTuple.CreateBulk(builders...).Exec(ctx)
+	})
+}
+
+// Delete 在事务中删除元组
+func (s *RelationTupleStore) Delete(ctx context.Context, tuples ...zanzibar.Tuple) error {
+	if len(tuples) == 0 {
+		return nil
+	}
+	return withEntTx(ctx, s.db, func(ctx context.Context, client *gen.Client) error {
+		for _, t := range tuples {
+			if _, err := client.RelationTuple.Delete().Where(relationTupleEQ(t)...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Read 按条件分批查询元组，避免受全局查询条数限制影响
+func (s *RelationTupleStore) Read(ctx context.Context, filter zanzibar.Filter) ([]zanzibar.Tuple, error) {
+	ps := relationTupleFilter(filter)
+	var (
+		result []zanzibar.Tuple
+		lastID uint64
+	)
+	for {
+		rows, err := s.db.RelationTuple.Query().
+			Where(append(ps, relationtuple.IDGT(lastID))...).
+			Order(gen.Asc(relationtuple.FieldID)).
+			Limit(casbinLoadBatchSize).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			result = append(result, zanzibar.Tuple{
+				Object:   zanzibar.ObjectRef{Namespace: row.ObjectNamespace, ID: row.ObjectID},
+				Relation: row.Relation,
+				Subject:  zanzibar.SubjectRef{Namespace: row.SubjectNamespace, ID: row.SubjectID, Relation: row.SubjectRelation},
+			})
+		}
+		if len(rows) < casbinLoadBatchSize {
+			return result, nil
+		}
+		lastID = rows[len(rows)-1].ID
+	}
+}
+
+// withEntTx 在事务中执行批量操作，已处于事务中时直接复用
+func withEntTx(ctx context.Context, db *gen.Client, fn func(ctx context.Context, client *gen.Client) error) error {
+	if tx := gen.TxFromContext(ctx); tx != nil {
+		return fn(ctx, tx.Client())
+	}
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(ctx, tx.Client()); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// relationTupleEQ 构造与元组完全匹配的查询条件
+func relationTupleEQ(t zanzibar.Tuple) []predicate.RelationTuple {
+	return []predicate.RelationTuple{
+		relationtuple.ObjectNamespace(t.Object.Namespace),
+		relationtuple.ObjectID(t.Object.ID),
+		relationtuple.Relation(t.Relation),
+		relationtuple.SubjectNamespace(t.Subject.Namespace),
+		relationtuple.SubjectID(t.Subject.ID),
+		relationtuple.SubjectRelation(t.Subject.Relation),
+	}
+}
+
+// relationTupleFilter 构造元组查询条件，空字段表示不限制
+func relationTupleFilter(filter zanzibar.Filter) []predicate.RelationTuple {
+	var ps []predicate.RelationTuple
+	if filter.ObjectNamespace != "" {
+		ps = append(ps, relationtuple.ObjectNamespace(filter.ObjectNamespace))
+	}
+	if filter.ObjectID != "" {
+		ps = append(ps, relationtuple.ObjectID(filter.ObjectID))
+	}
+	if filter.Relation != "" {
+		ps = append(ps, relationtuple.Relation(filter.Relation))
+	}
+	if s := filter.Subject; s != nil {
+		ps = append(ps,
+			relationtuple.SubjectNamespace(s.Namespace),
+			relationtuple.SubjectID(s.ID),
+			relationtuple.SubjectRelation(s.Relation),
+		)
+	}
+	return ps
+}
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"backend-service/pkg/auth/authz/zanzibar"
+)
+
+func TestRelationTupleStore(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t)
+	store := NewRelationTupleStore(client)
+
+	provider := zanzibar.NewProvider()
+	authorizer, err := provider.NewAuthorizer(ctx,
+		zanzibar.WithStore(store),
+		zanzibar.WithNamespaces(zanzibar.Namespace{Name: "post", Relations: []zanzibar.Relation{
+			{Name: "dept"},
+			{Name: "editor", Rewrite: zanzibar.Union(zanzibar.This(), zanzibar.TupleTo("dept", "member"))},
+		}}),
+	)
+	require.NoError(t, err)
+	a := authorizer.(*zanzibar.ZanzibarAuthorizer)
+
+	var tuples []zanzibar.Tuple
+	for _, s := range []string{
+		"dept:1#member@user:alice",
+		"post:10#dept@dept:1",
+		"post:10#dept@dept:1",
+		"post:20#editor@dept:2#member",
+	} {
+		tuple, err := zanzibar.ParseTuple(s)
+		require.NoError(t, err)
+		tuples = append(tuples, tuple)
+	}
+	require.NoError(t, a.WriteTuples(ctx, tuples...))
+	require.NoError(t, a.WriteTuples(ctx, tuples[0]))
+
+	count, err := client.RelationTuple.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	read, err := store.Read(ctx, zanzibar.Filter{ObjectNamespace: "post", Relation: "editor"})
+	require.NoError(t, err)
+	require.Len(t, read, 1)
+	assert.Equal(t, tuples[3], read[0])
+
+	ok, err := a.Enforce(ctx, "alice", "post:10", "editor", "")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, store.Delete(ctx, tuples[0]))
+	ok, _ = a.Enforce(ctx, "alice", "post:10", "editor", "")
+	assert.False(t, ok)
+}
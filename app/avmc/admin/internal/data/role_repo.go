@@ -2,10 +2,9 @@ package data
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"time"
 
+	"entgo.io/ent/schema/field"
 	"github.com/go-kratos/kratos/v2/log"
 
 	"backend-service/api/common/enum"
@@ -13,7 +12,9 @@ import (
 	pbCore "backend-service/api/core/service/v1"
 	"backend-service/app/avmc/admin/internal/biz"
 	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/role"
+	"backend-service/pkg/entgo"
 	"backend-service/pkg/utils/convert"
 )
 
@@ -46,6 +47,9 @@ func (r *roleRepo) toProto(res *gen.Role) *pbCore.Role {
 		DeptCheckStrictly: res.DeptCheckStrictly,
 		CreatedAt:         convert.TimeValueToString(&res.CreatedAt, time.DateTime),
 		UpdatedAt:         convert.TimeValueToString(&res.UpdatedAt, time.DateTime),
+		Version:           &res.Version,
+		CreatedBy:         res.CreatedBy,
+		UpdatedBy:         res.UpdatedBy,
 	}
 }
 
@@ -86,7 +90,7 @@ func (r *roleRepo) Save(ctx context.Context, g *pbCore.Role) (*pbCore.Role, erro
 	id, _ := r.GetRoleExistByName(ctx, *entRole.Name)
 	if id > 0 {
 		r.log.Errorf("角色名称已存在，角色信息：%v", g)
-		return nil, fmt.Errorf("role name already exists")
+		return nil, biz.ErrRoleAlreadyExists
 	}
 
 	res, err := builder.SetName(*entRole.Name).
@@ -97,7 +101,7 @@ func (r *roleRepo) Save(ctx context.Context, g *pbCore.Role) (*pbCore.Role, erro
 		Save(ctx)
 	if err != nil {
 		r.log.Errorf("保存角色失败，角色信息：%v，错误：%v", g, err)
-		return nil, err
+		return nil, entError(err, nil, biz.ErrRoleAlreadyExists, errDBInsert)
 	}
 	return r.toProto(res), nil
 }
@@ -111,19 +115,26 @@ func (r *roleRepo) Update(ctx context.Context, g *pbCore.Role) (*pbCore.Role, er
 	id, _ := r.GetRoleExistByName(ctx, *entRole.Name)
 	if id > 0 && id != g.GetId() {
 		r.log.Errorf("角色名称已存在，角色信息：%v", g)
-		return nil, fmt.Errorf("role name already exists")
+		return nil, biz.ErrRoleAlreadyExists
 	}
 
-	res, err := r.data.DB(ctx).Role.UpdateOneID(g.GetId()).
+	builder := r.data.DB(ctx).Role.UpdateOneID(g.GetId())
+	// 传入版本号时为条件更新，数据已被他人修改时不更新
+	if g.Version != nil {
+		builder.Where(role.Version(g.GetVersion()))
+	}
+	res, err := builder.
 		SetName(*entRole.Name).
 		SetNillableDefaultRouter(entRole.DefaultRouter).
 		SetNillableDataScope(entRole.DataScope).
 		SetNillableMenuCheckStrictly(entRole.MenuCheckStrictly).
 		SetNillableDeptCheckStrictly(entRole.DeptCheckStrictly).
+		AddVersion(1).
 		Save(ctx)
 	if err != nil {
 		r.log.Errorf("更新角色失败，角色信息：%v，错误：%v", g, err)
-		return nil, err
+		err = versionError(ctx, err, g.Version, r.data.DB(ctx).Role.Query().Where(role.ID(g.GetId())).Select(role.FieldVersion).Int)
+		return nil, entError(err, biz.ErrRoleNotFound, biz.ErrRoleAlreadyExists, errDBUpdate)
 	}
 	return r.toProto(res), nil
 }
@@ -136,10 +147,7 @@ func (r *roleRepo) FindByID(ctx context.Context, id uint32) (*pbCore.Role, error
 	res, err := r.data.DB(ctx).Role.Query().Where(role.ID(id)).First(ctx)
 	if err != nil {
 		r.log.Errorf("根据ID查询角色失败，角色ID：%v，错误：%v", id, err)
-		if gen.IsNotFound(err) {
-			return nil, errors.New("查询数据不存在")
-		}
-		return nil, err
+		return nil, entError(err, biz.ErrRoleNotFound, nil, errDBQuery)
 	}
 	return r.toProto(res), nil
 }
@@ -149,10 +157,10 @@ func (r *roleRepo) FindByID(ctx context.Context, id uint32) (*pbCore.Role, error
 // 返回值：错误信息
 func (r *roleRepo) Delete(ctx context.Context, id uint32) error {
 	r.log.Infof("删除角色，角色ID：%v", id)
-	err := r.data.DB(ctx).Role.UpdateOneID(id).SetDeletedAt(time.Now()).Exec(ctx)
+	err := r.data.DB(ctx).Role.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		r.log.Errorf("删除角色失败，角色ID：%v，错误：%v", id, err)
-		return err
+		return entError(err, biz.ErrRoleNotFound, nil, errDBDelete)
 	}
 	return nil
 }
@@ -175,6 +183,27 @@ func (r *roleRepo) ListByName(ctx context.Context, name string) ([]*pbCore.Role,
 	return roles, nil
 }
 
+// ListByNames 根据名称精确查询角色列表
+// 参数：ctx 上下文，names 角色名称列表
+// 返回值：角色列表，错误信息
+func (r *roleRepo) ListByNames(ctx context.Context, names []string) ([]*pbCore.Role, error) {
+	r.log.Infof("根据名称查询角色，角色名称：%v", names)
+	if len(names) == 0 {
+		return nil, nil
+	}
+	res, err := r.data.DB(ctx).Role.Query().Where(role.NameIn(names...), role.DeletedAtIsNil()).All(ctx)
+	if err != nil {
+		r.log.Errorf("根据名称查询角色失败，角色名称：%v，错误：%v", names, err)
+		return nil, entError(err, nil, nil, errDBQuery)
+	}
+
+	roles := make([]*pbCore.Role, 0, len(res))
+	for _, role := range res {
+		roles = append(roles, r.toProto(role))
+	}
+	return roles, nil
+}
+
 // ListAll 查询所有角色
 // 参数：ctx 上下文
 // 返回值：角色列表，错误信息
@@ -193,34 +222,66 @@ func (r *roleRepo) ListAll(ctx context.Context) ([]*pbCore.Role, error) {
 	return roles, nil
 }
 
+// roleQuerySchema 角色列表开放查询的字段
+var roleQuerySchema = &entgo.Schema{
+	Fields: map[string]entgo.Field{
+		"id":                  {Column: role.FieldID, Type: field.TypeUint32, Filterable: true, Sortable: true},
+		"name":                {Column: role.FieldName, Type: field.TypeString, Filterable: true, Sortable: true},
+		"status":              {Column: role.FieldStatus, Type: field.TypeInt32, Filterable: true, Sortable: true},
+		"default_router":      {Column: role.FieldDefaultRouter, Type: field.TypeString},
+		"data_scope":          {Column: role.FieldDataScope, Type: field.TypeInt32, Filterable: true},
+		"menu_check_strictly": {Column: role.FieldMenuCheckStrictly, Type: field.TypeBool},
+		"dept_check_strictly": {Column: role.FieldDeptCheckStrictly, Type: field.TypeBool},
+		"sort":                {},
+		"remark":              {},
+		"created_at":          {Column: role.FieldCreatedAt, Type: field.TypeTime, Filterable: true, Sortable: true},
+		"updated_at":          {Column: role.FieldUpdatedAt, Type: field.TypeTime, Filterable: true, Sortable: true},
+		"version":             {Column: role.FieldVersion, Type: field.TypeUint32},
+		"created_by":          {Column: role.FieldCreatedBy, Type: field.TypeUint32, Filterable: true},
+		"updated_by":          {Column: role.FieldUpdatedBy, Type: field.TypeUint32, Filterable: true},
+	},
+	DefaultOrder: []string{"-id"},
+	Required:     []string{role.FieldID},
+}
+
 // ListPage 分页查询角色
 // 参数：ctx 上下文，pagination 分页请求
 // 返回值：角色列表响应，错误信息
 func (r *roleRepo) ListPage(ctx context.Context, pagination *pbPagination.PagingRequest) (*pbCore.ListRoleResponse, error) {
 	r.log.Infof("分页查询角色，分页请求：%v", pagination)
-
-	// 查询总数
-	count, err := r.data.DB(ctx).Role.Query().Where(role.DeletedAtIsNil()).Count(ctx)
+	pq, err := roleQuerySchema.Translate(pagination)
+	if err != nil {
+		return nil, pagingError(err)
+	}
+	query := r.data.DB(ctx).Role.Query().Where(role.DeletedAtIsNil()).Where(entgo.Predicates[predicate.Role](pq)...)
+	count, err := query.Clone().Count(ctx)
 	if err != nil {
 		r.log.Errorf("查询角色总数失败，错误：%v", err)
 		return nil, err
 	}
-
-	// 计算偏移量
-	offset := (pagination.GetPage() - 1) * pagination.GetPageSize()
-
-	// 查询分页数据
-	res, err := r.data.DB(ctx).Role.Query().
-		Where(role.DeletedAtIsNil()).
-		Offset(int(offset)).
-		Limit(int(pagination.GetPageSize())).
+	res, err := query.
+		Select(pq.Columns(
+			role.FieldID,
+			role.FieldName,
+			role.FieldDefaultRouter,
+			role.FieldDataScope,
+			role.FieldStatus,
+			role.FieldMenuCheckStrictly,
+			role.FieldDeptCheckStrictly,
+			role.FieldCreatedAt,
+			role.FieldUpdatedAt,
+			role.FieldVersion,
+			role.FieldCreatedBy,
+			role.FieldUpdatedBy,
+		)...).
+		Offset(pq.Offset).
+		Limit(pq.Limit).
+		Order(entgo.Orders[role.OrderOption](pq)...).
 		All(ctx)
 	if err != nil {
-		r.log.Errorf("分页查询角色失败，错误：%v", err)
+		r.log.Errorf("分页查询角色失败，分页请求：%v，错误：%v", pagination, err)
 		return nil, err
 	}
-
-	// 转换数据
 	return &pbCore.ListRoleResponse{
 		Items: convert.SliceToAny(res, r.toProto),
 		Total: int32(count),
@@ -0,0 +1,89 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	pbPagination "backend-service/api/common/pagination"
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/conf"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/role"
+	"backend-service/app/avmc/admin/internal/data/ent/mixins"
+	"backend-service/pkg/auth/authn"
+	"backend-service/pkg/utils/trans"
+)
+
+func TestRoleUpdateVersion(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t)
+	repo := NewRoleRepo(&Data{db: client}, log.DefaultLogger)
+	admin := client.Role.Create().SetName("admin").SaveX(ctx)
+	assert.Equal(t, uint32(1), admin.Version)
+
+	// 版本号一致时更新成功并自增
+	res, err := repo.Update(ctx, &pbCore.Role{Id: admin.ID, Name: trans.String("manager"), Version: trans.Uint32(1)})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), res.GetVersion())
+
+	// 使用过期的版本号更新时返回最新版本号，数据不变
+	_, err = repo.Update(ctx, &pbCore.Role{Id: admin.ID, Name: trans.String("operator"), Version: trans.Uint32(1)})
+	require.Error(t, err)
+	assert.True(t, v1.IsVersionConflict(err))
+	assert.Equal(t, "2", errors.FromError(err).GetMetadata()["version"])
+	assert.Equal(t, "manager", *client.Role.GetX(ctx, admin.ID).Name)
+
+	// 不传版本号时不做条件更新
+	res, err = repo.Update(ctx, &pbCore.Role{Id: admin.ID, Name: trans.String("operator")})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), res.GetVersion())
+
+	// 记录不存在时仍返回不存在错误
+	_, err = repo.Update(ctx, &pbCore.Role{Id: admin.ID + 1, Name: trans.String("guest"), Version: trans.Uint32(1)})
+	assert.ErrorIs(t, err, biz.ErrRoleNotFound)
+}
+
+func TestRoleOperator(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t)
+	d := &Data{db: client}
+	repo := NewRoleRepo(d, log.DefaultLogger)
+	trash := NewTrashRepo(&conf.Data{}, d, nil, log.DefaultLogger)
+	operator := func(subject string) context.Context {
+		return authn.ContextWithAuthUser(ctx, &securityUser{subject: subject})
+	}
+
+	// 没有认证用户时不填充
+	guest, err := repo.Save(ctx, &pbCore.Role{Name: trans.String("guest")})
+	require.NoError(t, err)
+	assert.Nil(t, guest.CreatedBy)
+
+	admin, err := repo.Save(operator("7"), &pbCore.Role{Name: trans.String("admin")})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(7), admin.GetCreatedBy())
+	assert.Nil(t, admin.UpdatedBy)
+
+	admin, err = repo.Update(operator("8"), &pbCore.Role{Id: admin.GetId(), Name: trans.String("manager")})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(7), admin.GetCreatedBy())
+	assert.Equal(t, uint32(8), admin.GetUpdatedBy())
+
+	// 按操作人过滤
+	res, err := repo.ListPage(ctx, &pbPagination.PagingRequest{Query: trans.String(`{"created_by":7}`)})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), res.GetTotal())
+
+	// 删除时记录删除人，恢复时清除
+	require.NoError(t, repo.Delete(operator("9"), admin.GetId()))
+	items, _, err := trash.List(ctx, biz.TrashResourceRole, "", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(9), items[0].GetDeletedBy())
+	require.NoError(t, trash.Restore(ctx, biz.TrashResourceRole, admin.GetId()))
+	assert.Nil(t, client.Role.Query().Where(role.ID(admin.GetId())).OnlyX(mixins.SkipSoftDelete(ctx)).DeletedBy)
+}
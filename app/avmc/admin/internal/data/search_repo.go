@@ -0,0 +1,379 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"entgo.io/ent"
+	"github.com/go-kratos/kratos/v2/log"
+
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/conf"
+	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/menu"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
+	"backend-service/pkg/search"
+	"backend-service/pkg/search/meilisearch"
+	"backend-service/pkg/utils/pagination"
+	"backend-service/pkg/utils/trans"
+)
+
+// searchIndexes 搜索索引定义，搜索字段按权重从高到低排列
+// 邮箱、手机号受字段可见性规则保护，不写入索引，避免无权查看的用户借搜索结果推断
+var searchIndexes = map[string]search.Index{
+	biz.SearchIndexUsers: {Name: biz.SearchIndexUsers, Searchable: []string{"name", "nickname"}},
+	biz.SearchIndexMenus: {Name: biz.SearchIndexMenus, Searchable: []string{"title", "name"}},
+}
+
+// searchLoader 按ID从数据库读取索引文档，已删除的数据不在结果中，跳过软删除过滤时同样如此
+type searchLoader func(ctx context.Context, client *gen.Client, ids []uint32) ([]search.Document, error)
+
+// searchLoaders 各索引的文档读取方式
+var searchLoaders = map[string]searchLoader{
+	biz.SearchIndexUsers: loadUserDocuments,
+	biz.SearchIndexMenus: loadMenuDocuments,
+}
+
+// NewSearchIndexer 创建搜索索引，配置了 meilisearch 时使用 Meilisearch，否则使用进程内索引
+// 参数：cfg 数据配置，logger 日志记录器
+// 返回值：搜索索引
+func NewSearchIndexer(cfg *conf.Data, logger log.Logger) search.Indexer {
+	l := log.NewHelper(log.With(logger, "module", "search/data/initialize"))
+	c := cfg.GetMeilisearch()
+	if c.GetHost() == "" {
+		l.Warn("未配置 meilisearch，使用进程内搜索索引，索引在首次搜索时从数据库重建")
+		return search.NewMemory()
+	}
+	return meilisearch.New(c.GetHost(),
+		meilisearch.WithAPIKey(c.GetApiKey()),
+		meilisearch.WithTimeout(c.GetTimeout().AsDuration()),
+	)
+}
+
+var _ biz.SearchRepo = (*searchRepo)(nil)
+
+// searchRepo 全文搜索数据仓库，在索引中搜索后按ID回表查询，结果保持索引返回的相关度顺序
+type searchRepo struct {
+	data    *Data
+	indexer search.Indexer
+	users   *userRepo
+	menus   *menuRepo
+	log     *log.Helper
+
+	// rebuilt 进程内索引在首次搜索时从数据库重建
+	mu      sync.Mutex
+	rebuilt map[string]bool
+}
+
+// NewSearchRepo 创建新的全文搜索数据仓库实例，并创建索引、设置搜索字段
+// 参数：data 数据源，indexer 搜索索引，logger 日志记录器
+// 返回值：全文搜索数据仓库接口
+func NewSearchRepo(data *Data, indexer search.Indexer, logger log.Logger) biz.SearchRepo {
+	r := &searchRepo{
+		data:    data,
+		indexer: indexer,
+		users:   &userRepo{data: data, log: log.NewHelper(logger)},
+		menus:   &menuRepo{data: data, log: log.NewHelper(logger)},
+		log:     log.NewHelper(log.With(logger, "module", "search/data")),
+		rebuilt: make(map[string]bool),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, index := range searchIndexes {
+		// 搜索服务暂时不可用时不影响启动，可在恢复后重建索引
+		if err := indexer.EnsureIndex(ctx, index); err != nil {
+			r.log.Errorf("创建搜索索引失败，索引：%s，错误：%v", index.Name, err)
+		}
+	}
+	return r
+}
+
+// SearchUsers 搜索用户
+func (r *searchRepo) SearchUsers(ctx context.Context, keyword string, limit int) ([]*pbCore.User, error) {
+	ids, err := r.search(ctx, biz.SearchIndexUsers, keyword, limit)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	res, err := r.data.DB(ctx).User.Query().
+		Where(user.IDIn(ids...)).
+		Select(
+			user.FieldID,
+			user.FieldName,
+			user.FieldEmail,
+			user.FieldNickname,
+			user.FieldRealname,
+			user.FieldBirthday,
+			user.FieldGender,
+			user.FieldPhone,
+			user.FieldAvatar,
+			user.FieldStatus,
+			user.FieldCreatedAt,
+			user.FieldUpdatedAt,
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("查询搜索结果失败，索引：%s，错误：%v", biz.SearchIndexUsers, err)
+		return nil, err
+	}
+	found := make(map[uint32]*gen.User, len(res))
+	for _, u := range res {
+		found[u.ID] = u
+	}
+	items := make([]*pbCore.User, 0, len(res))
+	for _, id := range ids {
+		if u, ok := found[id]; ok {
+			items = append(items, r.users.toProto(u))
+		}
+	}
+	return items, nil
+}
+
+// SearchMenus 搜索菜单
+func (r *searchRepo) SearchMenus(ctx context.Context, keyword string, limit int) ([]*pbCore.Menu, error) {
+	ids, err := r.search(ctx, biz.SearchIndexMenus, keyword, limit)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	res, err := r.data.DB(ctx).Menu.Query().Where(menu.IDIn(ids...)).All(ctx)
+	if err != nil {
+		r.log.Errorf("查询搜索结果失败，索引：%s，错误：%v", biz.SearchIndexMenus, err)
+		return nil, err
+	}
+	found := make(map[uint32]*gen.Menu, len(res))
+	for _, m := range res {
+		found[m.ID] = m
+	}
+	items := make([]*pbCore.Menu, 0, len(res))
+	for _, id := range ids {
+		if m, ok := found[id]; ok {
+			items = append(items, r.menus.toProto(m))
+		}
+	}
+	return items, nil
+}
+
+// Rebuild 清空索引后按ID顺序分批从数据库重新写入
+func (r *searchRepo) Rebuild(ctx context.Context, index string) (int, error) {
+	load, ok := searchLoaders[index]
+	if !ok {
+		return 0, biz.ErrSearchIndexNotSupported
+	}
+	if err := r.indexer.DeleteAll(ctx, index); err != nil {
+		r.log.Errorf("清空搜索索引失败，索引：%s，错误：%v", index, err)
+		return 0, biz.ErrSearchUnavailable
+	}
+
+	total := 0
+	for last := uint32(0); ; {
+		ids, err := r.nextIDs(ctx, index, last)
+		if err != nil {
+			r.log.Errorf("读取索引数据失败，索引：%s，错误：%v", index, err)
+			return total, err
+		}
+		if len(ids) == 0 {
+			return total, nil
+		}
+		docs, err := load(ctx, r.data.DB(ctx), ids)
+		if err != nil {
+			r.log.Errorf("读取索引数据失败，索引：%s，错误：%v", index, err)
+			return total, err
+		}
+		if err := r.indexer.Upsert(ctx, index, docs...); err != nil {
+			r.log.Errorf("写入搜索索引失败，索引：%s，错误：%v", index, err)
+			return total, biz.ErrSearchUnavailable
+		}
+		total += len(docs)
+		last = ids[len(ids)-1]
+	}
+}
+
+// nextIDs 按ID升序读取下一批ID
+func (r *searchRepo) nextIDs(ctx context.Context, index string, last uint32) ([]uint32, error) {
+	switch index {
+	case biz.SearchIndexUsers:
+		return r.data.DB(ctx).User.Query().Where(user.IDGT(last)).Order(gen.Asc(user.FieldID)).Limit(pagination.MAX_PAGE_SIZE).IDs(ctx)
+	default:
+		return r.data.DB(ctx).Menu.Query().Where(menu.IDGT(last)).Order(gen.Asc(menu.FieldID)).Limit(pagination.MAX_PAGE_SIZE).IDs(ctx)
+	}
+}
+
+// search 在索引中搜索，返回按相关度排列的ID
+func (r *searchRepo) search(ctx context.Context, index, keyword string, limit int) ([]uint32, error) {
+	if keyword == "" {
+		return nil, nil
+	}
+	if err := r.ensureRebuilt(ctx, index); err != nil {
+		return nil, err
+	}
+	hits, err := r.indexer.Search(ctx, index, search.Query{Text: keyword, Limit: limit})
+	if err != nil {
+		// 索引尚未创建时没有结果
+		if errors.Is(err, search.ErrIndexNotFound) {
+			return nil, nil
+		}
+		r.log.Errorf("搜索失败，索引：%s，关键字：%s，错误：%v", index, keyword, err)
+		return nil, biz.ErrSearchUnavailable
+	}
+	ids := make([]uint32, 0, len(hits))
+	for _, hit := range hits {
+		id, err := strconv.ParseUint(hit.ID, 10, 32)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, uint32(id))
+	}
+	return ids, nil
+}
+
+// ensureRebuilt 进程内索引在进程启动后为空，首次搜索前从数据库重建
+func (r *searchRepo) ensureRebuilt(ctx context.Context, index string) error {
+	if _, ok := r.indexer.(*search.Memory); !ok {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.rebuilt[index] {
+		return nil
+	}
+	if _, err := r.Rebuild(ctx, index); err != nil {
+		return err
+	}
+	r.rebuilt[index] = true
+	return nil
+}
+
+// loadUserDocuments 读取用户索引文档
+func loadUserDocuments(ctx context.Context, client *gen.Client, ids []uint32) ([]search.Document, error) {
+	res, err := client.User.Query().
+		Where(user.IDIn(ids...), user.DeletedAtIsNil()).
+		Select(user.FieldID, user.FieldName, user.FieldNickname).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]search.Document, 0, len(res))
+	for _, u := range res {
+		docs = append(docs, search.Document{
+			ID: strconv.FormatUint(uint64(u.ID), 10),
+			Fields: map[string]string{
+				"name":     trans.StringValue(u.Name),
+				"nickname": trans.StringValue(u.Nickname),
+			},
+		})
+	}
+	return docs, nil
+}
+
+// loadMenuDocuments 读取菜单索引文档
+func loadMenuDocuments(ctx context.Context, client *gen.Client, ids []uint32) ([]search.Document, error) {
+	res, err := client.Menu.Query().
+		Where(menu.IDIn(ids...), menu.DeletedAtIsNil()).
+		Select(menu.FieldID, menu.FieldName, menu.FieldTitle).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]search.Document, 0, len(res))
+	for _, m := range res {
+		docs = append(docs, search.Document{
+			ID: strconv.FormatUint(uint64(m.ID), 10),
+			Fields: map[string]string{
+				"title": trans.StringValue(m.Title),
+				"name":  m.Name,
+			},
+		})
+	}
+	return docs, nil
+}
+
+// searchMutation 可同步到搜索索引的变更
+type searchMutation interface {
+	ent.Mutation
+	ID() (uint32, bool)
+	IDs(context.Context) ([]uint32, error)
+	Client() *gen.Client
+	Tx() (*gen.Tx, error)
+}
+
+// searchHook 将变更同步到搜索索引的钩子
+// 变更成功后按ID从数据库读取最新数据，存在的写入索引，已删除的从索引中删除；
+// 事务中的变更在提交后才写入索引。索引写入失败只记录日志，不影响数据库变更，可通过重建索引修复
+func searchHook(indexer search.Indexer, index string, logger log.Logger) ent.Hook {
+	l := log.NewHelper(log.With(logger, "module", "search/data/hook"))
+	load := searchLoaders[index]
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mx, ok := m.(searchMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			// 批量更新与删除在变更前确定受影响的ID
+			var ids []uint32
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = mx.IDs(ctx); err != nil {
+					return nil, err
+				}
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			if m.Op().Is(ent.OpCreate) {
+				if id, ok := mx.ID(); ok {
+					ids = []uint32{id}
+				}
+			}
+			if len(ids) == 0 {
+				return v, nil
+			}
+
+			docs, err := load(ctx, mx.Client(), ids)
+			if err != nil {
+				l.Errorf("读取索引数据失败，索引：%s，错误：%v", index, err)
+				return v, nil
+			}
+			apply := func(ctx context.Context) {
+				if err := syncSearchIndex(ctx, indexer, index, ids, docs); err != nil {
+					l.Errorf("同步搜索索引失败，索引：%s，ID：%v，错误：%v", index, ids, err)
+				}
+			}
+			if tx, err := mx.Tx(); err == nil {
+				tx.OnCommit(func(next gen.Committer) gen.Committer {
+					return gen.CommitFunc(func(ctx context.Context, tx *gen.Tx) error {
+						if err := next.Commit(ctx, tx); err != nil {
+							return err
+						}
+						apply(ctx)
+						return nil
+					})
+				})
+			} else {
+				apply(ctx)
+			}
+			return v, nil
+		})
+	}
+}
+
+// syncSearchIndex 写入存在的文档，删除其余ID对应的文档
+func syncSearchIndex(ctx context.Context, indexer search.Indexer, index string, ids []uint32, docs []search.Document) error {
+	found := make(map[string]bool, len(docs))
+	for _, doc := range docs {
+		found[doc.ID] = true
+	}
+	var removed []string
+	for _, id := range ids {
+		if key := strconv.FormatUint(uint64(id), 10); !found[key] {
+			removed = append(removed, key)
+		}
+	}
+	if err := indexer.Upsert(ctx, index, docs...); err != nil {
+		return err
+	}
+	return indexer.Delete(ctx, index, removed...)
+}
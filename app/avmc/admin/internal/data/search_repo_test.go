@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/migrate"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
+	"backend-service/pkg/search"
+)
+
+func TestSearchHookAndRepo(t *testing.T) {
+	ctx := context.Background()
+	// 根菜单的 parent_id 为 0，与生产环境的迁移方式一致，不创建外键
+	client := newTestEntClient(t, migrate.WithForeignKeys(false))
+	indexer := search.NewMemory()
+	client.User.Use(searchHook(indexer, biz.SearchIndexUsers, log.DefaultLogger))
+	client.Menu.Use(searchHook(indexer, biz.SearchIndexMenus, log.DefaultLogger))
+	d := &Data{db: client}
+	repo := NewSearchRepo(d, indexer, log.DefaultLogger).(*searchRepo)
+	// 钩子已同步全部变更，跳过首次搜索前的重建
+	repo.rebuilt[biz.SearchIndexUsers] = true
+
+	alice := client.User.Create().SetName("alice").SetNickname("Ally").SetEmail("alice@example.com").SetPhone("13800000001").SetPassword("secret").SaveX(ctx)
+	bob := client.User.Create().SetName("bob").SetNickname("Alice Fan").SetPhone("13900000002").SetPassword("secret").SaveX(ctx)
+	client.Menu.Create().SetName("UserManage").SetTitle("用户管理").SetAuthCode("").SetActiveIcon("").SetActivePath("").
+		SetBadge("").SetIcon("").SetIframeSrc("").SetLink("").SaveX(ctx)
+
+	names := func(keyword string) []string {
+		users, err := repo.SearchUsers(ctx, keyword, 10)
+		require.NoError(t, err)
+		var names []string
+		for _, u := range users {
+			names = append(names, u.GetName())
+		}
+		return names
+	}
+	assert.Equal(t, []string{"alice", "bob"}, names("alice"))
+	// 邮箱、手机号不参与搜索
+	assert.Empty(t, names("139"))
+	assert.Empty(t, names("example.com"))
+
+	menus, err := repo.SearchMenus(ctx, "用户", 10)
+	require.NoError(t, err)
+	require.Len(t, menus, 1)
+	assert.Equal(t, "UserManage", menus[0].GetName())
+
+	// 更新后按最新数据写入索引
+	client.User.UpdateOne(bob).SetNickname("Bobby").ExecX(ctx)
+	assert.Equal(t, []string{"alice"}, names("alice"))
+
+	// 回滚的事务不写入索引，提交的事务在提交后写入
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	tx.User.Create().SetName("alice2").SetPassword("secret").SaveX(ctx)
+	require.NoError(t, tx.Rollback())
+	assert.Equal(t, []string{"alice"}, names("alice"))
+	require.NoError(t, d.InTx(ctx, func(ctx context.Context) error {
+		return d.DB(ctx).User.Update().Where(user.IDEQ(bob.ID)).SetNickname("alice's friend").Exec(ctx)
+	}))
+	assert.Equal(t, []string{"alice", "bob"}, names("alice"))
+
+	// 软删除后从索引中删除
+	require.NoError(t, NewUserRepo(d, log.DefaultLogger).Delete(ctx, alice.ID))
+	assert.Equal(t, []string{"bob"}, names("alice"))
+
+	// 重建索引
+	require.NoError(t, indexer.DeleteAll(ctx, biz.SearchIndexUsers))
+	assert.Empty(t, names("bob"))
+	total, err := repo.Rebuild(ctx, biz.SearchIndexUsers)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, []string{"bob"}, names("bob"))
+
+	_, err = repo.Rebuild(ctx, "posts")
+	assert.True(t, errors.Is(err, biz.ErrSearchIndexNotSupported))
+}
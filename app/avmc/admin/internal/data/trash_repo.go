@@ -0,0 +1,497 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/conf"
+	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/dept"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/menu"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/post"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/role"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
+	"backend-service/app/avmc/admin/internal/data/ent/mixins"
+	"backend-service/pkg/auth/authz"
+	"backend-service/pkg/utils/convert"
+	"backend-service/pkg/utils/pagination"
+	"backend-service/pkg/utils/trans"
+)
+
+// trashTable 资源的回收站操作，只作用于已软删除的数据，调用时上下文已跳过软删除过滤
+type trashTable struct {
+	// list 按名称关键字分页查询已删除的数据，按删除时间倒序排列
+	list func(ctx context.Context, client *gen.Client, keyword string, offset, limit int) ([]*v1.TrashItem, int, error)
+	// restore 检查唯一字段后清除删除时间与删除人，重复时返回对应的已存在错误
+	restore func(ctx context.Context, client *gen.Client, id uint32) error
+	// expired 查询在 before 之前删除的数据ID，最早删除的在前
+	expired func(ctx context.Context, client *gen.Client, before time.Time, limit int) ([]uint32, error)
+	// purge 清除多对多关联后彻底删除，返回删除的行数
+	purge func(ctx context.Context, client *gen.Client, ids []uint32) (int, error)
+	// subjects 彻底删除前查询数据在授权引擎中的主体，其策略与角色绑定随数据一并清除，为空表示资源不涉及授权
+	subjects func(ctx context.Context, client *gen.Client, ids []uint32) ([]authz.Subject, error)
+}
+
+// trashTables 各资源的回收站操作
+var trashTables = map[string]trashTable{
+	biz.TrashResourceUser: {
+		list: func(ctx context.Context, client *gen.Client, keyword string, offset, limit int) ([]*v1.TrashItem, int, error) {
+			q := client.User.Query().Where(user.DeletedAtNotNil())
+			if keyword != "" {
+				q.Where(user.NameContains(keyword))
+			}
+			q.Order(user.ByDeletedAt(sql.OrderDesc()), user.ByID(sql.OrderDesc()))
+			return listTrash(ctx, q, offset, limit, func(u *gen.User) *v1.TrashItem {
+				return trashItem(u.ID, trans.StringValue(u.Name), u.DeletedAt, u.DeletedBy)
+			})
+		},
+		restore: func(ctx context.Context, client *gen.Client, id uint32) error {
+			u, err := client.User.Query().Where(user.ID(id), user.DeletedAtNotNil()).Only(ctx)
+			if err != nil {
+				return err
+			}
+			uniques := []trashUnique{
+				{client.User.Query().Where(user.Name(trans.StringValue(u.Name)), user.DeletedAtIsNil()).Exist, biz.ErrUserAlreadyExists},
+			}
+			if email := trans.StringValue(u.Email); email != "" {
+				uniques = append(uniques, trashUnique{client.User.Query().Where(user.Email(email), user.DeletedAtIsNil()).Exist, biz.ErrUserEmailAlreadyExists})
+			}
+			if phone := trans.StringValue(u.Phone); phone != "" {
+				uniques = append(uniques, trashUnique{client.User.Query().Where(user.Phone(phone), user.DeletedAtIsNil()).Exist, biz.ErrUserPhoneAlreadyExists})
+			}
+			if err := checkTrashUnique(ctx, uniques...); err != nil {
+				return err
+			}
+			return client.User.UpdateOneID(id).ClearDeletedAt().ClearDeletedBy().Exec(ctx)
+		},
+		expired: func(ctx context.Context, client *gen.Client, before time.Time, limit int) ([]uint32, error) {
+			return client.User.Query().Where(user.DeletedAtLT(before)).Order(user.ByDeletedAt(), user.ByID()).Limit(limit).IDs(ctx)
+		},
+		purge: func(ctx context.Context, client *gen.Client, ids []uint32) (int, error) {
+			if err := client.User.Update().Where(user.IDIn(ids...), user.DeletedAtNotNil()).ClearRoles().ClearPosts().Exec(ctx); err != nil {
+				return 0, err
+			}
+			return client.User.Delete().Where(user.IDIn(ids...), user.DeletedAtNotNil()).Exec(ctx)
+		},
+		// 用户在授权引擎中以用户ID作为主体
+		subjects: func(ctx context.Context, client *gen.Client, ids []uint32) ([]authz.Subject, error) {
+			ids, err := client.User.Query().Where(user.IDIn(ids...), user.DeletedAtNotNil()).IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			subjects := make([]authz.Subject, 0, len(ids))
+			for _, id := range ids {
+				subjects = append(subjects, authz.Subject(strconv.FormatUint(uint64(id), 10)))
+			}
+			return subjects, nil
+		},
+	},
+	biz.TrashResourceRole: {
+		list: func(ctx context.Context, client *gen.Client, keyword string, offset, limit int) ([]*v1.TrashItem, int, error) {
+			q := client.Role.Query().Where(role.DeletedAtNotNil())
+			if keyword != "" {
+				q.Where(role.NameContains(keyword))
+			}
+			q.Order(role.ByDeletedAt(sql.OrderDesc()), role.ByID(sql.OrderDesc()))
+			return listTrash(ctx, q, offset, limit, func(r *gen.Role) *v1.TrashItem {
+				return trashItem(r.ID, trans.StringValue(r.Name), r.DeletedAt, r.DeletedBy)
+			})
+		},
+		restore: func(ctx context.Context, client *gen.Client, id uint32) error {
+			r, err := client.Role.Query().Where(role.ID(id), role.DeletedAtNotNil()).Only(ctx)
+			if err != nil {
+				return err
+			}
+			if err := checkTrashUnique(ctx,
+				trashUnique{client.Role.Query().Where(role.Name(trans.StringValue(r.Name)), role.DeletedAtIsNil()).Exist, biz.ErrRoleAlreadyExists},
+			); err != nil {
+				return err
+			}
+			return client.Role.UpdateOneID(id).ClearDeletedAt().ClearDeletedBy().Exec(ctx)
+		},
+		expired: func(ctx context.Context, client *gen.Client, before time.Time, limit int) ([]uint32, error) {
+			return client.Role.Query().Where(role.DeletedAtLT(before)).Order(role.ByDeletedAt(), role.ByID()).Limit(limit).IDs(ctx)
+		},
+		purge: func(ctx context.Context, client *gen.Client, ids []uint32) (int, error) {
+			if err := client.Role.Update().Where(role.IDIn(ids...), role.DeletedAtNotNil()).ClearUsers().Exec(ctx); err != nil {
+				return 0, err
+			}
+			return client.Role.Delete().Where(role.IDIn(ids...), role.DeletedAtNotNil()).Exec(ctx)
+		},
+		// 角色在授权引擎中以名称作为主体，删除后已有同名的新角色时保留其策略与角色绑定
+		subjects: func(ctx context.Context, client *gen.Client, ids []uint32) ([]authz.Subject, error) {
+			roles, err := client.Role.Query().Where(role.IDIn(ids...), role.DeletedAtNotNil()).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			subjects := make([]authz.Subject, 0, len(roles))
+			for _, r := range roles {
+				name := trans.StringValue(r.Name)
+				if name == "" {
+					continue
+				}
+				exist, err := client.Role.Query().Where(role.Name(name), role.DeletedAtIsNil()).Exist(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if !exist {
+					subjects = append(subjects, authz.Subject(name))
+				}
+			}
+			return subjects, nil
+		},
+	},
+	biz.TrashResourceDept: {
+		list: func(ctx context.Context, client *gen.Client, keyword string, offset, limit int) ([]*v1.TrashItem, int, error) {
+			q := client.Dept.Query().Where(dept.DeletedAtNotNil())
+			if keyword != "" {
+				q.Where(dept.NameContains(keyword))
+			}
+			q.Order(dept.ByDeletedAt(sql.OrderDesc()), dept.ByID(sql.OrderDesc()))
+			return listTrash(ctx, q, offset, limit, func(d *gen.Dept) *v1.TrashItem {
+				return trashItem(d.ID, trans.StringValue(d.Name), d.DeletedAt, d.DeletedBy)
+			})
+		},
+		restore: func(ctx context.Context, client *gen.Client, id uint32) error {
+			d, err := client.Dept.Query().Where(dept.ID(id), dept.DeletedAtNotNil()).Only(ctx)
+			if err != nil {
+				return err
+			}
+			if err := checkTrashUnique(ctx,
+				trashUnique{client.Dept.Query().Where(dept.Name(trans.StringValue(d.Name)), dept.DeletedAtIsNil()).Exist, biz.ErrDeptAlreadyExists},
+			); err != nil {
+				return err
+			}
+			// 上级部门仍在回收站中时不允许恢复
+			if pid := trans.Uint32Value(d.ParentID); pid > 0 {
+				exist, err := client.Dept.Query().Where(dept.ID(pid), dept.DeletedAtIsNil()).Exist(ctx)
+				if err != nil {
+					return err
+				}
+				if !exist {
+					return biz.ErrDeptParentInvalid
+				}
+			}
+			return client.Dept.UpdateOneID(id).ClearDeletedAt().ClearDeletedBy().Exec(ctx)
+		},
+		// 存在下级部门（包括回收站中的）的部门暂不清理，待下级部门清理后再清理
+		expired: func(ctx context.Context, client *gen.Client, before time.Time, limit int) ([]uint32, error) {
+			return client.Dept.Query().Where(dept.DeletedAtLT(before), dept.Not(dept.HasChildren())).Order(dept.ByDeletedAt(), dept.ByID()).Limit(limit).IDs(ctx)
+		},
+		// 存在不在本次删除范围内的下级部门（包括回收站中的）时不允许彻底删除，避免下级部门失去上级部门
+		purge: func(ctx context.Context, client *gen.Client, ids []uint32) (int, error) {
+			exist, err := client.Dept.Query().Where(dept.ParentIDIn(ids...), dept.IDNotIn(ids...)).Exist(ctx)
+			if err != nil {
+				return 0, err
+			}
+			if exist {
+				return 0, biz.ErrDeptHasChildren
+			}
+			return client.Dept.Delete().Where(dept.IDIn(ids...), dept.DeletedAtNotNil()).Exec(ctx)
+		},
+	},
+	biz.TrashResourceMenu: {
+		list: func(ctx context.Context, client *gen.Client, keyword string, offset, limit int) ([]*v1.TrashItem, int, error) {
+			q := client.Menu.Query().Where(menu.DeletedAtNotNil())
+			if keyword != "" {
+				q.Where(menu.Or(menu.TitleContains(keyword), menu.NameContains(keyword)))
+			}
+			q.Order(menu.ByDeletedAt(sql.OrderDesc()), menu.ByID(sql.OrderDesc()))
+			return listTrash(ctx, q, offset, limit, func(m *gen.Menu) *v1.TrashItem {
+				return trashItem(m.ID, trans.StringValue(m.Title), m.DeletedAt, m.DeletedBy)
+			})
+		},
+		restore: func(ctx context.Context, client *gen.Client, id uint32) error {
+			m, err := client.Menu.Query().Where(menu.ID(id), menu.DeletedAtNotNil()).Only(ctx)
+			if err != nil {
+				return err
+			}
+			if err := checkTrashUnique(ctx,
+				trashUnique{client.Menu.Query().Where(menu.Name(m.Name), menu.DeletedAtIsNil()).Exist, biz.ErrMenuNameAlreadyExists},
+			); err != nil {
+				return err
+			}
+			return client.Menu.UpdateOneID(id).ClearDeletedAt().ClearDeletedBy().Exec(ctx)
+		},
+		expired: func(ctx context.Context, client *gen.Client, before time.Time, limit int) ([]uint32, error) {
+			return client.Menu.Query().Where(menu.DeletedAtLT(before)).Order(menu.ByDeletedAt(), menu.ByID()).Limit(limit).IDs(ctx)
+		},
+		purge: func(ctx context.Context, client *gen.Client, ids []uint32) (int, error) {
+			return client.Menu.Delete().Where(menu.IDIn(ids...), menu.DeletedAtNotNil()).Exec(ctx)
+		},
+	},
+	biz.TrashResourcePost: {
+		list: func(ctx context.Context, client *gen.Client, keyword string, offset, limit int) ([]*v1.TrashItem, int, error) {
+			q := client.Post.Query().Where(post.DeletedAtNotNil())
+			if keyword != "" {
+				q.Where(post.NameContains(keyword))
+			}
+			q.Order(post.ByDeletedAt(sql.OrderDesc()), post.ByID(sql.OrderDesc()))
+			return listTrash(ctx, q, offset, limit, func(p *gen.Post) *v1.TrashItem {
+				return trashItem(p.ID, trans.StringValue(p.Name), p.DeletedAt, p.DeletedBy)
+			})
+		},
+		restore: func(ctx context.Context, client *gen.Client, id uint32) error {
+			p, err := client.Post.Query().Where(post.ID(id), post.DeletedAtNotNil()).Only(ctx)
+			if err != nil {
+				return err
+			}
+			if err := checkTrashUnique(ctx,
+				trashUnique{client.Post.Query().Where(post.Name(trans.StringValue(p.Name)), post.DeletedAtIsNil()).Exist, biz.ErrPostAlreadyExists},
+			); err != nil {
+				return err
+			}
+			return client.Post.UpdateOneID(id).ClearDeletedAt().ClearDeletedBy().Exec(ctx)
+		},
+		expired: func(ctx context.Context, client *gen.Client, before time.Time, limit int) ([]uint32, error) {
+			return client.Post.Query().Where(post.DeletedAtLT(before)).Order(post.ByDeletedAt(), post.ByID()).Limit(limit).IDs(ctx)
+		},
+		purge: func(ctx context.Context, client *gen.Client, ids []uint32) (int, error) {
+			return client.Post.Delete().Where(post.IDIn(ids...), post.DeletedAtNotNil()).Exec(ctx)
+		},
+	},
+}
+
+// trashQuery 回收站分页查询使用的 ent 查询方法
+type trashQuery[Q, T any] interface {
+	Clone() Q
+	Count(ctx context.Context) (int, error)
+	Offset(offset int) Q
+	Limit(limit int) Q
+	All(ctx context.Context) ([]T, error)
+}
+
+// listTrash 查询总数后分页查询，并转换为回收站数据
+func listTrash[Q trashQuery[Q, T], T any](ctx context.Context, q Q, offset, limit int, item func(T) *v1.TrashItem) ([]*v1.TrashItem, int, error) {
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	res, err := q.Offset(offset).Limit(limit).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return convert.SliceToAny(res, item), total, nil
+}
+
+// trashItem 创建回收站数据
+func trashItem(id uint32, name string, deletedAt *time.Time, deletedBy *uint32) *v1.TrashItem {
+	return &v1.TrashItem{
+		Id:        id,
+		Name:      name,
+		DeletedAt: trans.StringValue(convert.TimeValueToString(deletedAt, time.DateTime)),
+		DeletedBy: deletedBy,
+	}
+}
+
+// trashUnique 恢复前检查的唯一字段，exist 查询未删除的数据中是否已存在相同的值
+type trashUnique struct {
+	exist    func(ctx context.Context) (bool, error)
+	conflict error
+}
+
+// checkTrashUnique 依次检查唯一字段，已存在时返回对应的错误
+func checkTrashUnique(ctx context.Context, uniques ...trashUnique) error {
+	for _, u := range uniques {
+		exist, err := u.exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exist {
+			return u.conflict
+		}
+	}
+	return nil
+}
+
+var _ biz.TrashRepo = (*trashRepo)(nil)
+
+// trashRepo 回收站数据仓库，恢复与彻底删除经过 ent 钩子，搜索索引随之更新
+// 彻底删除用户或角色时，其在授权引擎中的策略与角色绑定在同一事务中清除
+type trashRepo struct {
+	data       *Data
+	authorizer authz.Authorizer
+	log        *log.Helper
+	options    biz.TrashOptions
+}
+
+// NewTrashRepo 创建新的回收站数据仓库实例
+// 参数：c 数据配置，data 数据源，authorizer 授权器，logger 日志记录器
+// 返回值：回收站数据仓库接口
+func NewTrashRepo(c *conf.Data, data *Data, authorizer authz.Authorizer, logger log.Logger) biz.TrashRepo {
+	cfg := c.GetTrash()
+	opts := biz.TrashOptions{
+		Retention:     cfg.GetRetention().AsDuration(),
+		PurgeInterval: cfg.GetPurgeInterval().AsDuration(),
+		BatchSize:     int(cfg.GetBatchSize()),
+	}
+	// 已删除数据默认保留 30天
+	if opts.Retention <= 0 {
+		opts.Retention = time.Hour * 24 * 30
+	}
+	// 默认每小时清理一次
+	if opts.PurgeInterval <= 0 {
+		opts.PurgeInterval = time.Hour
+	}
+	// 每批默认 500行，不超过分页大小上限
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 500
+	}
+	if opts.BatchSize > pagination.MAX_PAGE_SIZE {
+		opts.BatchSize = pagination.MAX_PAGE_SIZE
+	}
+
+	return &trashRepo{
+		data:       data,
+		authorizer: authorizer,
+		log:        log.NewHelper(log.With(logger, "module", "trash/data")),
+		options:    opts,
+	}
+}
+
+// Options 获取回收站配置
+// 返回值：回收站配置
+func (r *trashRepo) Options() biz.TrashOptions {
+	return r.options
+}
+
+// List 分页查询资源已删除的数据
+// 参数：ctx 上下文，resource 资源，keyword 名称关键字，offset 偏移量，limit 每页行数
+// 返回值：已删除的数据，总数，错误信息
+func (r *trashRepo) List(ctx context.Context, resource, keyword string, offset, limit int) ([]*v1.TrashItem, int, error) {
+	table, ok := trashTables[resource]
+	if !ok {
+		return nil, 0, biz.ErrTrashResourceNotSupported
+	}
+	items, total, err := table.list(mixins.SkipSoftDelete(ctx), r.data.DB(ctx), keyword, offset, limit)
+	if err != nil {
+		r.log.Errorf("查询回收站失败，资源：%s，错误：%v", resource, err)
+		return nil, 0, entError(err, nil, nil, errDBQuery)
+	}
+	return items, total, nil
+}
+
+// Restore 恢复已删除的数据，检查唯一字段与恢复在同一事务中执行
+// 参数：ctx 上下文，resource 资源，id 数据ID
+// 返回值：错误信息
+func (r *trashRepo) Restore(ctx context.Context, resource string, id uint32) error {
+	table, ok := trashTables[resource]
+	if !ok {
+		return biz.ErrTrashResourceNotSupported
+	}
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		return table.restore(mixins.SkipSoftDelete(ctx), r.data.DB(ctx), id)
+	})
+	if err != nil {
+		r.log.Errorf("恢复已删除的数据失败，资源：%s，ID：%d，错误：%v", resource, id, err)
+		return entError(err, biz.ErrTrashNotFound, nil, errDBUpdate)
+	}
+	return nil
+}
+
+// Purge 彻底删除已删除的数据，清除关联、授权规则与删除在同一事务中执行
+// 参数：ctx 上下文，resource 资源，id 数据ID
+// 返回值：错误信息
+func (r *trashRepo) Purge(ctx context.Context, resource string, id uint32) error {
+	table, ok := trashTables[resource]
+	if !ok {
+		return biz.ErrTrashResourceNotSupported
+	}
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		n, err := r.purge(mixins.SkipSoftDelete(ctx), table, []uint32{id})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return biz.ErrTrashNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("彻底删除数据失败，资源：%s，ID：%d，错误：%v", resource, id, err)
+		return entError(err, biz.ErrTrashNotFound, nil, errDBDelete)
+	}
+	return nil
+}
+
+// PurgeExpired 彻底删除在 before 之前删除的数据
+// 参数：ctx 上下文，resource 资源，before 删除时间上限，limit 最多删除的行数
+// 返回值：删除的行数，错误信息
+func (r *trashRepo) PurgeExpired(ctx context.Context, resource string, before time.Time, limit int) (int, error) {
+	table, ok := trashTables[resource]
+	if !ok {
+		return 0, biz.ErrTrashResourceNotSupported
+	}
+	var n int
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		ctx = mixins.SkipSoftDelete(ctx)
+		ids, err := table.expired(ctx, r.data.DB(ctx), before, limit)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		n, err = r.purge(ctx, table, ids)
+		return err
+	})
+	if err != nil {
+		r.log.Errorf("清理回收站过期数据失败，资源：%s，错误：%v", resource, err)
+		return 0, entError(err, nil, nil, errDBDelete)
+	}
+	return n, nil
+}
+
+// purge 彻底删除数据并清除其在授权引擎中的策略与角色绑定，调用时已在事务中
+// 授权引擎经由上下文中的事务持久化，事务回滚时引擎重新加载策略
+func (r *trashRepo) purge(ctx context.Context, table trashTable, ids []uint32) (int, error) {
+	client := r.data.DB(ctx)
+	var subjects []authz.Subject
+	if table.subjects != nil {
+		var err error
+		if subjects, err = table.subjects(ctx, client, ids); err != nil {
+			return 0, err
+		}
+	}
+	n, err := table.purge(ctx, client, ids)
+	if err != nil || n == 0 {
+		return n, err
+	}
+	return n, r.revoke(ctx, subjects)
+}
+
+// revoke 清除主体在所有域中的策略，以及主体作为用户或角色的角色绑定
+// 授权器不支持策略管理时跳过
+func (r *trashRepo) revoke(ctx context.Context, subjects []authz.Subject) error {
+	manager, ok := r.authorizer.(authz.PolicyManager)
+	if !ok {
+		return nil
+	}
+	for _, sub := range subjects {
+		policies, err := manager.GetFilteredPolicies(ctx, authz.Policy{Subject: sub})
+		if err != nil {
+			return authzError(err)
+		}
+		if len(policies) > 0 {
+			if _, err := r.authorizer.RemovePolicies(ctx, policies); err != nil {
+				return authzError(err)
+			}
+		}
+		for _, filter := range []authz.RoleBinding{{User: sub}, {Role: sub}} {
+			bindings, err := manager.GetFilteredRoleBindings(ctx, filter)
+			if err != nil {
+				return authzError(err)
+			}
+			if len(bindings) == 0 {
+				continue
+			}
+			if _, err := manager.RemoveRoleBindings(ctx, bindings); err != nil {
+				return authzError(err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package data
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/conf"
+	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/dept"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/hook"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/migrate"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/role"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
+	"backend-service/app/avmc/admin/internal/data/ent/mixins"
+	authzEngine "backend-service/pkg/auth/authz"
+	authzCasbin "backend-service/pkg/auth/authz/casbin"
+	"backend-service/pkg/search"
+)
+
+func TestTrashRepo(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t)
+	indexer := search.NewMemory()
+	client.User.Use(searchHook(indexer, biz.SearchIndexUsers, log.DefaultLogger))
+	d := &Data{db: client}
+	repo := NewTrashRepo(&conf.Data{}, d, nil, log.DefaultLogger)
+	users := NewUserRepo(d, log.DefaultLogger)
+	roles := NewRoleRepo(d, log.DefaultLogger)
+	hits := func(keyword string) int {
+		res, err := indexer.Search(ctx, biz.SearchIndexUsers, search.Query{Text: keyword})
+		require.NoError(t, err)
+		return len(res)
+	}
+
+	admin := client.Role.Create().SetName("admin").SaveX(ctx)
+	alice := client.User.Create().SetName("alice").SetPhone("13800000001").SetPassword("secret").AddRoles(admin).SaveX(ctx)
+	bob := client.User.Create().SetName("bob").SetPassword("secret").SaveX(ctx)
+	require.NoError(t, users.Delete(ctx, alice.ID))
+	require.NoError(t, users.Delete(ctx, bob.ID))
+	assert.Zero(t, hits("alice"))
+
+	items, total, err := repo.List(ctx, biz.TrashResourceUser, "", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, []string{"bob", "alice"}, []string{items[0].GetName(), items[1].GetName()})
+	assert.NotEmpty(t, items[0].GetDeletedAt())
+	items, total, err = repo.List(ctx, biz.TrashResourceUser, "ali", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, alice.ID, items[0].GetId())
+
+	// 恢复后重新出现在查询与搜索索引中
+	require.NoError(t, repo.Restore(ctx, biz.TrashResourceUser, alice.ID))
+	assert.True(t, client.User.Query().Where(user.ID(alice.ID)).ExistX(ctx))
+	assert.Equal(t, 1, hits("alice"))
+	assert.ErrorIs(t, repo.Restore(ctx, biz.TrashResourceUser, alice.ID), biz.ErrTrashNotFound)
+
+	// 名称与现有数据重复时不允许恢复
+	require.NoError(t, roles.Delete(ctx, admin.ID))
+	client.Role.Create().SetName("admin").SaveX(ctx)
+	assert.ErrorIs(t, repo.Restore(ctx, biz.TrashResourceRole, admin.ID), biz.ErrRoleAlreadyExists)
+
+	// 彻底删除只作用于已删除的数据，并清除关联
+	assert.ErrorIs(t, repo.Purge(ctx, biz.TrashResourceUser, alice.ID), biz.ErrTrashNotFound)
+	require.NoError(t, repo.Purge(ctx, biz.TrashResourceRole, admin.ID))
+	assert.Zero(t, client.User.QueryRoles(alice).CountX(ctx))
+	require.NoError(t, repo.Purge(ctx, biz.TrashResourceUser, bob.ID))
+	assert.Equal(t, 1, client.User.Query().CountX(mixins.SkipSoftDelete(ctx)))
+	assert.ErrorIs(t, repo.Purge(ctx, biz.TrashResourceUser, bob.ID), biz.ErrTrashNotFound)
+
+	// 定时清理只删除超过保留时长的数据
+	require.NoError(t, users.Delete(ctx, alice.ID))
+	uc := biz.NewTrashUsecase(repo, log.DefaultLogger)
+	n, err := uc.PurgeExpired(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	n, err = repo.PurgeExpired(ctx, biz.TrashResourceUser, time.Now().Add(time.Second), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Zero(t, client.User.Query().CountX(mixins.SkipSoftDelete(ctx)))
+
+	_, err = uc.List(ctx, &v1.ListTrashRequest{Resource: "casbin_rule"})
+	assert.ErrorIs(t, err, biz.ErrTrashResourceNotSupported)
+}
+
+func TestTrashPurgeAuthz(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t)
+	d := &Data{db: client}
+	bus := authzCasbin.NewLocalBus()
+	newAuthorizer := func() authzEngine.Authorizer {
+		authorizer, err := authzCasbin.NewProvider().NewAuthorizer(ctx,
+			authzCasbin.WithAdapter(NewCasbinAdapter(d)),
+			authzEngine.WithEnableWatcher(true),
+			authzEngine.WithWatcherType(authzCasbin.WatcherLocal),
+			authzEngine.WithWatcherOption(authzCasbin.WatcherOptionBus, bus),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = authorizer.Close() })
+		return authorizer
+	}
+	// reader 模拟其它实例，经观察者同步策略变更
+	authorizer, reader := newAuthorizer(), newAuthorizer()
+	manager := authorizer.(authzEngine.PolicyManager)
+	repo := NewTrashRepo(&conf.Data{}, d, authorizer, log.DefaultLogger)
+	deleted := time.Now().Add(-time.Hour)
+
+	editor := client.Role.Create().SetName("editor").SetDeletedAt(deleted).SaveX(ctx)
+	staleAdmin := client.Role.Create().SetName("admin").SetDeletedAt(deleted).SaveX(ctx)
+	client.Role.Create().SetName("admin").SaveX(ctx)
+	alice := client.User.Create().SetName("alice").SetPassword("secret").SetDeletedAt(deleted).SaveX(ctx)
+	aliceID := authzEngine.Subject(strconv.FormatUint(uint64(alice.ID), 10))
+	_, err := authorizer.AddPolicies(ctx, []authzEngine.Policy{
+		{Subject: "editor", Object: "/api/posts", Action: "GET", Domain: "1"},
+		{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "1"},
+		{Subject: aliceID, Object: "/api/profile", Action: "GET", Domain: "2"},
+	})
+	require.NoError(t, err)
+	_, err = manager.AddRoleBindings(ctx, []authzEngine.RoleBinding{
+		{User: aliceID, Role: "editor", Domain: "1"},
+		{User: aliceID, Role: "admin", Domain: "2"},
+		{User: "editor", Role: "admin", Domain: "1"},
+	})
+	require.NoError(t, err)
+
+	// 彻底删除角色时清除其策略，以及其作为用户或角色的角色绑定
+	require.NoError(t, repo.Purge(ctx, biz.TrashResourceRole, editor.ID))
+	policies, err := manager.GetFilteredPolicies(ctx, authzEngine.Policy{Subject: "editor"})
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+	bindings, err := manager.GetFilteredRoleBindings(ctx, authzEngine.RoleBinding{})
+	require.NoError(t, err)
+	assert.Equal(t, []authzEngine.RoleBinding{{User: aliceID, Role: "admin", Domain: "2"}}, bindings)
+
+	// 已有同名的新角色时保留其授权规则
+	require.NoError(t, repo.Purge(ctx, biz.TrashResourceRole, staleAdmin.ID))
+	policies, err = manager.GetFilteredPolicies(ctx, authzEngine.Policy{Subject: "admin"})
+	require.NoError(t, err)
+	assert.Len(t, policies, 1)
+
+	// 彻底删除用户时清除其在所有域中的策略与角色绑定
+	require.NoError(t, repo.Purge(ctx, biz.TrashResourceUser, alice.ID))
+	policies, err = manager.GetFilteredPolicies(ctx, authzEngine.Policy{Subject: aliceID})
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+	bindings, err = manager.GetFilteredRoleBindings(ctx, authzEngine.RoleBinding{})
+	require.NoError(t, err)
+	assert.Empty(t, bindings)
+	assert.Equal(t, 1, client.CasbinRule.Query().CountX(ctx))
+
+	// 清除授权规则中途失败时彻底删除一并回滚，已移除的策略未进入内存，也未通知其它实例
+	viewer := client.Role.Create().SetName("viewer").SetDeletedAt(deleted).SaveX(ctx)
+	_, err = authorizer.AddPolicies(ctx, []authzEngine.Policy{{Subject: "viewer", Object: "/api/posts", Action: "GET", Domain: "1"}})
+	require.NoError(t, err)
+	_, err = manager.AddRoleBindings(ctx, []authzEngine.RoleBinding{{User: "viewer", Role: "admin", Domain: "1"}})
+	require.NoError(t, err)
+	allowed := func(a authzEngine.Authorizer) bool {
+		ok, _ := a.Enforce(ctx, "viewer", "/api/posts", "GET", "1")
+		return ok
+	}
+	require.Eventually(t, func() bool { return allowed(reader) }, time.Second, 10*time.Millisecond)
+	deletes := 0
+	client.CasbinRule.Use(func(next gen.Mutator) gen.Mutator {
+		return hook.CasbinRuleFunc(func(ctx context.Context, m *gen.CasbinRuleMutation) (gen.Value, error) {
+			// 策略移除成功，角色绑定移除失败
+			if m.Op().Is(gen.OpDelete | gen.OpDeleteOne) {
+				if deletes++; deletes > 1 {
+					return nil, stderrors.New("delete failed")
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	require.Error(t, repo.Purge(ctx, biz.TrashResourceRole, viewer.ID))
+	assert.Equal(t, 2, deletes)
+	assert.True(t, client.Role.Query().Where(role.ID(viewer.ID)).ExistX(mixins.SkipSoftDelete(ctx)))
+	assert.Equal(t, 3, client.CasbinRule.Query().CountX(ctx))
+	assert.True(t, allowed(authorizer))
+	assert.True(t, allowed(reader))
+}
+
+func TestTrashPurgeDept(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t, migrate.WithForeignKeys(false))
+	repo := NewTrashRepo(&conf.Data{}, &Data{db: client}, nil, log.DefaultLogger)
+	deleted := time.Now().Add(-time.Hour)
+
+	parent := client.Dept.Create().SetName("parent").SetDeletedAt(deleted).SaveX(ctx)
+	child := client.Dept.Create().SetName("child").SetParentID(parent.ID).SetDeletedAt(deleted.Add(time.Minute)).SaveX(ctx)
+
+	// 存在下级部门（包括回收站中的）时不允许彻底删除
+	assert.ErrorIs(t, repo.Purge(ctx, biz.TrashResourceDept, parent.ID), biz.ErrDeptHasChildren)
+
+	// 定时清理先清理下级部门，下一次清理上级部门
+	n, err := repo.PurgeExpired(ctx, biz.TrashResourceDept, time.Now(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.False(t, client.Dept.Query().Where(dept.ID(child.ID)).ExistX(mixins.SkipSoftDelete(ctx)))
+	n, err = repo.PurgeExpired(ctx, biz.TrashResourceDept, time.Now(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Zero(t, client.Dept.Query().CountX(mixins.SkipSoftDelete(ctx)))
+}
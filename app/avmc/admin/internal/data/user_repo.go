@@ -2,10 +2,10 @@ package data
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/schema/field"
 	"github.com/go-kratos/kratos/v2/log"
 
 	"backend-service/api/common/enum"
@@ -13,9 +13,13 @@ import (
 	pbCore "backend-service/api/core/service/v1"
 	"backend-service/app/avmc/admin/internal/biz"
 	"backend-service/app/avmc/admin/internal/data/ent/gen"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/dept"
+	"backend-service/app/avmc/admin/internal/data/ent/gen/predicate"
 	"backend-service/app/avmc/admin/internal/data/ent/gen/user"
+	"backend-service/pkg/entgo"
 	"backend-service/pkg/utils/convert"
 	"backend-service/pkg/utils/crypto"
+	"backend-service/pkg/utils/trans"
 )
 
 var _ biz.UserRepo = (*userRepo)(nil)
@@ -53,6 +57,10 @@ func (r *userRepo) toProto(res *gen.User) *pbCore.User {
 		Birthday:    convert.TimeValueToString(res.Birthday, time.DateOnly),
 		CreatedAt:   convert.TimeValueToString(&res.CreatedAt, time.DateTime),
 		UpdatedAt:   convert.TimeValueToString(&res.UpdatedAt, time.DateTime),
+		Version:     &res.Version,
+		CreatedBy:   res.CreatedBy,
+		UpdatedBy:   res.UpdatedBy,
+		DeptId:      res.DeptID,
 	}
 }
 
@@ -71,9 +79,25 @@ func (r *userRepo) toEnt(g *pbCore.User) *gen.User {
 		Password:    g.Password,
 		Gender:      (*int32)(g.Gender),
 		Status:      (*int32)(g.Status),
+		DeptID:      g.DeptId,
 	}
 }
 
+// ExistDept 校验用户所属部门是否存在
+// 参数：ctx 上下文，deptID 部门ID
+// 返回值：错误信息，部门不存在时返回部门不存在错误
+func (r *userRepo) ExistDept(ctx context.Context, deptID uint32) error {
+	exist, err := r.data.DB(ctx).Dept.Query().Where(dept.ID(deptID)).Exist(ctx)
+	if err != nil {
+		r.log.Errorf("查询用户所属部门失败，部门ID：%d，错误：%v", deptID, err)
+		return entError(err, nil, nil, errDBQuery)
+	}
+	if !exist {
+		return biz.ErrDeptNotFound
+	}
+	return nil
+}
+
 // ExistByEmail 获取用户邮箱是否存在
 // 参数：ctx 上下文，email 用户邮箱
 // 返回值：用户ID，错误信息
@@ -124,13 +148,13 @@ func (r *userRepo) Save(ctx context.Context, g *pbCore.User) (*pbCore.User, erro
 	id, _ := r.ExistByName(ctx, *entUser.Name)
 	if id > 0 {
 		r.log.Errorf("用户名已存在，用户信息：%v", g)
-		return nil, fmt.Errorf("user name already exists")
+		return nil, biz.ErrUserAlreadyExists
 	}
 	if entUser.Email != nil {
 		id, _ = r.ExistByEmail(ctx, *entUser.Email)
 		if id > 0 {
 			r.log.Errorf("用户名已存在，用户信息：%v", g)
-			return nil, fmt.Errorf("user email already exists")
+			return nil, biz.ErrUserEmailAlreadyExists
 		}
 		builder = builder.SetNillableEmail(entUser.Email)
 	}
@@ -138,7 +162,7 @@ func (r *userRepo) Save(ctx context.Context, g *pbCore.User) (*pbCore.User, erro
 		id, _ = r.ExistByPhone(ctx, *entUser.Phone)
 		if id > 0 {
 			r.log.Errorf("手机号已存在，用户信息：%v", g)
-			return nil, fmt.Errorf("user phone already exists")
+			return nil, biz.ErrUserPhoneAlreadyExists
 		}
 		builder = builder.SetNillablePhone(entUser.Phone)
 	}
@@ -146,6 +170,13 @@ func (r *userRepo) Save(ctx context.Context, g *pbCore.User) (*pbCore.User, erro
 		hashPassword, _ := crypto.HashPassword(*entUser.Password)
 		builder = builder.SetPassword(hashPassword)
 	}
+	// 部门ID为 0 时不属于任何部门
+	if g.GetDeptId() > 0 {
+		if err := r.ExistDept(ctx, g.GetDeptId()); err != nil {
+			return nil, err
+		}
+		builder = builder.SetDeptID(g.GetDeptId())
+	}
 	res, err := builder.SetName(*entUser.Name).
 		SetNillableEmail(entUser.Email).
 		SetNillableNickname(entUser.Nickname).
@@ -158,7 +189,7 @@ func (r *userRepo) Save(ctx context.Context, g *pbCore.User) (*pbCore.User, erro
 		Save(ctx)
 	if err != nil {
 		r.log.Errorf("保存用户失败，用户信息：%v，错误：%v", g, err)
-		return nil, err
+		return nil, entError(err, nil, biz.ErrUserAlreadyExists, errDBInsert)
 	}
 	return r.toProto(res), nil
 }
@@ -174,7 +205,7 @@ func (r *userRepo) Update(ctx context.Context, g *pbCore.User) (*pbCore.User, er
 		id, _ := r.ExistByName(ctx, *entUser.Name)
 		if id > 0 && id != g.GetId() {
 			r.log.Errorf("用户名已存在，用户信息：%v", g)
-			return nil, fmt.Errorf("user name already exists")
+			return nil, biz.ErrUserAlreadyExists
 		}
 		builder = builder.SetName(*entUser.Name)
 	}
@@ -183,7 +214,7 @@ func (r *userRepo) Update(ctx context.Context, g *pbCore.User) (*pbCore.User, er
 		id, _ := r.ExistByEmail(ctx, *entUser.Email)
 		if id > 0 && id != g.GetId() {
 			r.log.Errorf("用户名已存在，用户信息：%v", g)
-			return nil, fmt.Errorf("user email already exists")
+			return nil, biz.ErrUserEmailAlreadyExists
 		}
 		builder = builder.SetNillableEmail(entUser.Email)
 	}
@@ -191,7 +222,7 @@ func (r *userRepo) Update(ctx context.Context, g *pbCore.User) (*pbCore.User, er
 		id, _ := r.ExistByPhone(ctx, *entUser.Phone)
 		if id > 0 && id != g.GetId() {
 			r.log.Errorf("手机号已存在，用户信息：%v", g)
-			return nil, fmt.Errorf("user phone already exists")
+			return nil, biz.ErrUserPhoneAlreadyExists
 		}
 		builder = builder.SetNillablePhone(entUser.Phone)
 	}
@@ -199,6 +230,21 @@ func (r *userRepo) Update(ctx context.Context, g *pbCore.User) (*pbCore.User, er
 		hashPassword, _ := crypto.HashPassword(*entUser.Password)
 		builder = builder.SetPassword(hashPassword)
 	}
+	// 部门ID为 0 时移出部门
+	if g.DeptId != nil {
+		if g.GetDeptId() == 0 {
+			builder = builder.ClearDeptID()
+		} else {
+			if err := r.ExistDept(ctx, g.GetDeptId()); err != nil {
+				return nil, err
+			}
+			builder = builder.SetDeptID(g.GetDeptId())
+		}
+	}
+	// 传入版本号时为条件更新，数据已被他人修改时不更新
+	if g.Version != nil {
+		builder.Where(user.Version(g.GetVersion()))
+	}
 	res, err := builder.
 		SetNillableNickname(entUser.Nickname).
 		SetNillableRealname(entUser.Realname).
@@ -207,10 +253,12 @@ func (r *userRepo) Update(ctx context.Context, g *pbCore.User) (*pbCore.User, er
 		SetNillableAvatar(entUser.Avatar).
 		SetNillableStatus(entUser.Status).
 		SetNillableDescription(entUser.Description).
+		AddVersion(1).
 		Save(ctx)
 	if err != nil {
 		r.log.Errorf("更新用户失败，用户信息：%v，错误：%v", g, err)
-		return nil, err
+		err = versionError(ctx, err, g.Version, r.data.DB(ctx).User.Query().Where(user.ID(g.GetId())).Select(user.FieldVersion).Int)
+		return nil, entError(err, biz.ErrUserNotFound, biz.ErrUserAlreadyExists, errDBUpdate)
 	}
 	return r.toProto(res), nil
 }
@@ -226,10 +274,7 @@ func (r *userRepo) FindByID(ctx context.Context, id uint32) (*pbCore.User, error
 	fmt.Printf("%v", res)
 	if err != nil {
 		r.log.Errorf("通过ID查询用户失败，ID：%d，错误：%v", id, err)
-		if gen.IsNotFound(err) {
-			return nil, errors.New("查询数据不存在")
-		}
-		return nil, err
+		return nil, entError(err, biz.ErrUserNotFound, nil, errDBQuery)
 	}
 	return r.toProto(res), nil
 }
@@ -291,70 +336,108 @@ func (r *userRepo) ListAll(ctx context.Context) ([]*pbCore.User, error) {
 	return convert.SliceToAny(res, r.toProto), nil
 }
 
-// ListPageSimple 查询用户简单列表分页
+// userQuerySchema 用户列表开放查询的字段
+// 手机号与邮箱可能按字段可见性规则脱敏，不开放过滤，避免按条件逐位推断原值
+var userQuerySchema = &entgo.Schema{
+	Fields: map[string]entgo.Field{
+		"id":          {Column: user.FieldID, Type: field.TypeUint32, Filterable: true, Sortable: true},
+		"name":        {Column: user.FieldName, Type: field.TypeString, Filterable: true, Sortable: true},
+		"nickname":    {Column: user.FieldNickname, Type: field.TypeString, Filterable: true, Sortable: true, Nullable: true},
+		"realname":    {Column: user.FieldRealname, Type: field.TypeString, Filterable: true, Sortable: true, Nullable: true},
+		"birthday":    {Column: user.FieldBirthday, Type: field.TypeTime, Filterable: true, Sortable: true, Nullable: true},
+		"gender":      {Column: user.FieldGender, Type: field.TypeInt32, Filterable: true},
+		"phone":       {Column: user.FieldPhone, Type: field.TypeString},
+		"email":       {Column: user.FieldEmail, Type: field.TypeString},
+		"avatar":      {Column: user.FieldAvatar, Type: field.TypeString},
+		"status":      {Column: user.FieldStatus, Type: field.TypeInt32, Filterable: true, Sortable: true},
+		"description": {Column: user.FieldDescription, Type: field.TypeString},
+		"created_at":  {Column: user.FieldCreatedAt, Type: field.TypeTime, Filterable: true, Sortable: true},
+		"updated_at":  {Column: user.FieldUpdatedAt, Type: field.TypeTime, Filterable: true, Sortable: true},
+		"version":     {Column: user.FieldVersion, Type: field.TypeUint32},
+		"created_by":  {Column: user.FieldCreatedBy, Type: field.TypeUint32, Filterable: true},
+		"updated_by":  {Column: user.FieldUpdatedBy, Type: field.TypeUint32, Filterable: true},
+		"dept_id":     {Column: user.FieldDeptID, Type: field.TypeUint32, Filterable: true},
+	},
+	DefaultOrder: []string{"-id"},
+	Required:     []string{user.FieldID},
+	Cursor:       true,
+}
+
+// ListPageSimple 查询用户简单列表分页，支持游标分页
 // 参数：ctx 上下文，pagination 分页请求
 // 返回值：用户列表响应，错误信息
 func (r *userRepo) ListPageSimple(ctx context.Context, pagination *pbPagination.PagingRequest) (*pbCore.ListUserResponse, error) {
 	r.log.Infof("查询用户简单列表分页，分页请求：%v", pagination)
-	count, err := r.data.DB(ctx).User.Query().Select(user.FieldID).Where().Count(ctx)
-	if err != nil {
-		r.log.Errorf("查询所有用户列表失败，错误：%v", err)
-		return nil, err
-	}
-	res, err := r.data.DB(ctx).User.Query().
-		Select(user.FieldID, user.FieldName).
-		Where().
-		Offset(int((pagination.GetPage() - 1) * pagination.GetPageSize())).
-		Limit(int(pagination.GetPageSize())).
-		Order(gen.Desc(user.FieldID)).
-		All(ctx)
-	if err != nil {
-		r.log.Errorf("查询用户简单列表分页失败，分页请求：%v，错误：%v", pagination, err)
-		return nil, err
-	}
-	return &pbCore.ListUserResponse{
-		Items: convert.SliceToAny(res, r.toProto),
-		Total: int32(count),
-	}, nil
+	return r.listPage(ctx, pagination, user.FieldID, user.FieldName)
 }
 
-// ListPage 查询用户列表分页
+// ListPage 查询用户列表分页，支持游标分页
 // 参数：ctx 上下文，pagination 分页请求
 // 返回值：用户列表响应，错误信息
 func (r *userRepo) ListPage(ctx context.Context, pagination *pbPagination.PagingRequest) (*pbCore.ListUserResponse, error) {
 	r.log.Infof("查询用户列表分页，分页请求：%v", pagination)
-	count, err := r.data.DB(ctx).User.Query().Select(user.FieldID).Count(ctx)
+	return r.listPage(ctx, pagination,
+		user.FieldID,
+		user.FieldName,
+		user.FieldEmail,
+		user.FieldNickname,
+		user.FieldRealname,
+		user.FieldBirthday,
+		user.FieldGender,
+		user.FieldPhone,
+		user.FieldAvatar,
+		user.FieldStatus,
+		user.FieldCreatedAt,
+		user.FieldUpdatedAt,
+		user.FieldVersion,
+		user.FieldCreatedBy,
+		user.FieldUpdatedBy,
+		user.FieldDeptID,
+	)
+}
+
+// listPage 按分页请求查询用户列表
+// 参数：ctx 上下文，pagination 分页请求，columns 未指定字段掩码时查询的列
+// 返回值：用户列表响应，错误信息
+func (r *userRepo) listPage(ctx context.Context, pagination *pbPagination.PagingRequest, columns ...string) (*pbCore.ListUserResponse, error) {
+	pq, err := userQuerySchema.Translate(pagination)
 	if err != nil {
-		r.log.Errorf("查询所有用户列表失败，错误：%v", err)
-		return nil, err
+		return nil, pagingError(err)
 	}
-	res, err := r.data.DB(ctx).User.Query().
-		Select(
-			user.FieldID,
-			user.FieldName,
-			user.FieldEmail,
-			user.FieldNickname,
-			user.FieldRealname,
-			user.FieldBirthday,
-			user.FieldGender,
-			user.FieldPhone,
-			user.FieldAvatar,
-			user.FieldStatus,
-			user.FieldCreatedAt,
-			user.FieldUpdatedAt,
-		).
-		Offset(int((pagination.GetPage() - 1) * pagination.GetPageSize())).
-		Limit(int(pagination.GetPageSize())).
-		Order(gen.Desc(user.FieldID)).
+	query := r.data.DB(ctx).User.Query().Where(entgo.Predicates[predicate.User](pq)...)
+	var count int
+	if pq.Count {
+		if count, err = query.Clone().Count(ctx); err != nil {
+			r.log.Errorf("查询所有用户列表失败，错误：%v", err)
+			return nil, err
+		}
+	}
+	res, err := query.
+		Where(entgo.Seek[predicate.User](pq)...).
+		Select(pq.Columns(columns...)...).
+		Offset(pq.Offset).
+		Limit(pq.Limit).
+		Order(entgo.Orders[user.OrderOption](pq)...).
 		All(ctx)
 	if err != nil {
 		r.log.Errorf("查询用户列表分页失败，分页请求：%v，错误：%v", pagination, err)
 		return nil, err
 	}
-	return &pbCore.ListUserResponse{
+	res, page, err := entgo.Paginate(pq, res)
+	if err != nil {
+		return nil, pagingError(err)
+	}
+	reply := &pbCore.ListUserResponse{
 		Items: convert.SliceToAny(res, r.toProto),
 		Total: int32(count),
-	}, nil
+	}
+	if page != nil && page.Next != "" {
+		reply.NextCursor = trans.String(page.Next)
+	}
+	if page != nil && page.Prev != "" {
+		reply.PrevCursor = trans.String(page.Prev)
+	}
+	return reply, nil
 }
 
 // Delete 删除用户
@@ -362,10 +445,10 @@ func (r *userRepo) ListPage(ctx context.Context, pagination *pbPagination.Paging
 // 返回值：错误信息
 func (r *userRepo) Delete(ctx context.Context, id uint32) error {
 	r.log.Infof("删除用户，用户ID：%d", id)
-	err := r.data.DB(ctx).User.UpdateOneID(id).SetDeletedAt(time.Now()).Exec(ctx)
+	err := r.data.DB(ctx).User.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		r.log.Errorf("删除用户失败，用户ID：%d，错误：%v", id, err)
-		return err
+		return entError(err, biz.ErrUserNotFound, nil, errDBDelete)
 	}
 	return nil
 }
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	pbPagination "backend-service/api/common/pagination"
+	"backend-service/pkg/utils/trans"
+)
+
+func TestUserListPage(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEntClient(t)
+	repo := NewUserRepo(&Data{db: client}, log.DefaultLogger)
+	for i, name := range []string{"alice", "bob", "carol"} {
+		client.User.Create().SetName(name).SetNickname(name).SetPhone(fmt.Sprintf("1380000000%d", i)).SetPassword("secret").SaveX(ctx)
+	}
+
+	tests := []struct {
+		name  string
+		req   *pbPagination.PagingRequest
+		names []string
+		total int32
+	}{
+		{name: "default order", req: &pbPagination.PagingRequest{}, names: []string{"carol", "bob", "alice"}, total: 3},
+		{name: "order by name", req: &pbPagination.PagingRequest{OrderBy: []string{"name"}, PageSize: trans.Int32(2)}, names: []string{"alice", "bob"}, total: 3},
+		{name: "second page", req: &pbPagination.PagingRequest{OrderBy: []string{"name"}, Page: trans.Int32(2), PageSize: trans.Int32(2)}, names: []string{"carol"}, total: 3},
+		{name: "and query", req: &pbPagination.PagingRequest{Query: trans.String(`{"name":"bob"}`)}, names: []string{"bob"}, total: 1},
+		{name: "or query", req: &pbPagination.PagingRequest{OrQuery: trans.String(`{"name":"bob","nickname":"alice"}`), OrderBy: []string{"-name"}}, names: []string{"bob", "alice"}, total: 2},
+		{name: "operators", req: &pbPagination.PagingRequest{Query: trans.String(`{"name__in":["alice","carol"],"nickname__contains":"aro"}`)}, names: []string{"carol"}, total: 1},
+		{name: "camel case", req: &pbPagination.PagingRequest{OrderBy: []string{"-createdAt"}}, names: []string{"carol", "bob", "alice"}, total: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reply, err := repo.ListPage(ctx, tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.total, reply.GetTotal())
+			var names []string
+			for _, u := range reply.GetItems() {
+				names = append(names, u.GetName())
+			}
+			assert.Equal(t, tt.names, names)
+		})
+	}
+
+	// 字段掩码只查询选中的列
+	reply, err := repo.ListPage(ctx, &pbPagination.PagingRequest{FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}}})
+	require.NoError(t, err)
+	assert.NotZero(t, reply.GetItems()[0].GetId())
+	assert.Equal(t, "carol", reply.GetItems()[0].GetName())
+	assert.Empty(t, reply.GetItems()[0].GetPhone())
+
+	// 游标分页
+	req := &pbPagination.PagingRequest{OrderBy: []string{"name"}, PageSize: trans.Int32(2), UseCursor: trans.Bool(true)}
+	reply, err = repo.ListPage(ctx, req)
+	require.NoError(t, err)
+	assert.Zero(t, reply.GetTotal())
+	assert.Equal(t, "alice", reply.GetItems()[0].GetName())
+	assert.Empty(t, reply.GetPrevCursor())
+	reply, err = repo.ListPage(ctx, &pbPagination.PagingRequest{PageSize: trans.Int32(2), Cursor: reply.NextCursor, WithCount: trans.Bool(true)})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), reply.GetTotal())
+	require.Len(t, reply.GetItems(), 1)
+	assert.Equal(t, "carol", reply.GetItems()[0].GetName())
+	assert.Empty(t, reply.GetNextCursor())
+	assert.NotEmpty(t, reply.GetPrevCursor())
+
+	// 未选中排序字段的列表仍按排序字段生成游标
+	reply, err = repo.ListPageSimple(ctx, &pbPagination.PagingRequest{OrderBy: []string{"-createdAt"}, PageSize: trans.Int32(2), UseCursor: trans.Bool(true)})
+	require.NoError(t, err)
+	require.Len(t, reply.GetItems(), 2)
+	assert.Equal(t, "bob", reply.GetItems()[1].GetName())
+	reply, err = repo.ListPageSimple(ctx, &pbPagination.PagingRequest{PageSize: trans.Int32(2), Cursor: reply.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, reply.GetItems(), 1)
+	assert.Equal(t, "alice", reply.GetItems()[0].GetName())
+
+	// 未开放游标分页的列表拒绝游标请求
+	_, err = NewRoleRepo(&Data{db: client}, log.DefaultLogger).ListPage(ctx, &pbPagination.PagingRequest{UseCursor: trans.Bool(true)})
+	assert.Equal(t, v1.ErrorReason_BAD_REQUEST.String(), errors.FromError(err).Reason)
+
+	// 不在白名单中的字段返回 BAD_REQUEST
+	for _, req := range []*pbPagination.PagingRequest{
+		{Query: trans.String(`{"password":"secret"}`)},
+		{OrderBy: []string{"phone"}},
+		// 可为空的列不能作为游标分页的排序字段
+		{OrderBy: []string{"birthday"}, UseCursor: trans.Bool(true)},
+		// 可能脱敏的字段不开放过滤
+		{Query: trans.String(`{"phone__startswith":"138"}`)},
+		{OrQuery: trans.String(`{"email__contains":"@example"}`)},
+		{FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"password"}}},
+		{Query: trans.String(`not json`)},
+	} {
+		_, err := repo.ListPage(ctx, req)
+		assert.Equal(t, v1.ErrorReason_BAD_REQUEST.String(), errors.FromError(err).Reason, req.String())
+	}
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	nethttp "net/http"
+
+	v1 "backend-service/api/avmc/admin/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	authnEngine "backend-service/pkg/auth/authn"
+	authzEngine "backend-service/pkg/auth/authz"
+	authMiddleware "backend-service/pkg/auth/middleware"
+	"backend-service/pkg/middleware/localize"
+)
+
+// convertError 将认证、鉴权错误转换为 error_reason 中定义的错误原因与状态码，其它错误原样返回
+func convertError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var authnErr *authnEngine.AuthError
+	if errors.As(err, &authnErr) {
+		return convertAuthnError(authnErr.Code).WithCause(err)
+	}
+	var authzErr *authzEngine.AuthzError
+	if errors.As(err, &authzErr) {
+		return convertAuthzError(authzErr.Code).WithCause(err)
+	}
+
+	// 认证中间件的错误以认证、鉴权包的预定义错误为原因，按原因细分
+	switch {
+	case errors.Is(err, authnEngine.ErrMissingToken):
+		return convertAuthnError(authnEngine.ErrCodeMissingToken)
+	case errors.Is(err, authnEngine.ErrExpiredToken):
+		return convertAuthnError(authnEngine.ErrCodeExpiredToken)
+	case errors.Is(err, authnEngine.ErrInvalidToken):
+		return convertAuthnError(authnEngine.ErrCodeInvalidToken)
+	case errors.Is(err, authzEngine.ErrPermissionDenied):
+		return convertAuthzError(authzEngine.ErrCodePermissionDenied)
+	// 其它通用的 UNAUTHORIZED/FORBIDDEN 错误，错误码与原因相同即匹配
+	case errors.Is(err, authMiddleware.ErrMissingToken):
+		return convertAuthnError(authnEngine.ErrCodeUnknown).WithCause(err)
+	case errors.Is(err, authMiddleware.ErrPermissionDenied):
+		return convertAuthzError(authzEngine.ErrCodeUnknown).WithCause(err)
+	}
+	return err
+}
+
+// convertAuthnError 认证错误码转换
+func convertAuthnError(code authnEngine.ErrorCode) *errors.Error {
+	switch code {
+	case authnEngine.ErrCodeMissingToken:
+		return v1.ErrorAuthTokenNotExist("请先登录")
+	case authnEngine.ErrCodeExpiredToken:
+		return v1.ErrorAuthTokenExpired("登录已过期，请重新登录")
+	case authnEngine.ErrCodeInvalidToken,
+		authnEngine.ErrCodeInvalidSignature,
+		authnEngine.ErrCodeInvalidClaims,
+		authnEngine.ErrCodeInvalidTokenFormat,
+		authnEngine.ErrCodeUnsupportedTokenScheme,
+		authnEngine.ErrCodeInvalidSubject,
+		authnEngine.ErrCodeInvalidIssuer,
+		authnEngine.ErrCodeInvalidAudience,
+		authnEngine.ErrCodeNotBeforeTime:
+		return v1.ErrorAuthInvalidToken("无效的登录令牌")
+	default:
+		return v1.ErrorAuthFailed("认证失败")
+	}
+}
+
+// convertAuthzError 鉴权错误码转换
+func convertAuthzError(code authzEngine.ErrorCode) *errors.Error {
+	switch code {
+	case authzEngine.ErrCodePermissionDenied:
+		return v1.ErrorPermissionDenied("权限不足")
+	default:
+		return v1.ErrorAuthorizationFailed("鉴权失败")
+	}
+}
+
+// errorMiddleware 错误转换中间件，HTTP 与 gRPC 共用
+func errorMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			if err != nil {
+				return nil, convertError(err)
+			}
+			return reply, nil
+		}
+	}
+}
+
+// newErrorEncoder 创建HTTP错误编码器，先转换认证、鉴权错误，再按请求语言翻译错误信息
+// 同时覆盖未经过中间件链的错误（如请求解码阶段）
+func newErrorEncoder(translator *localize.Translator) http.EncodeErrorFunc {
+	encoder := localize.ErrorEncoder(translator, nil)
+	return func(w nethttp.ResponseWriter, r *nethttp.Request, err error) {
+		encoder(w, r, convertError(err))
+	}
+}
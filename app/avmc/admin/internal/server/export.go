@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"io"
+	"mime"
+	"strconv"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/service"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// registerExportRoutes 注册文件下载路由
+// 生成的 HTTP 代码不支持流式响应，这里手动注册并设置操作名，使请求经过与其它接口相同的认证、鉴权与校验中间件
+func registerExportRoutes(srv *http.Server, export *service.ExportServiceService, logger log.Logger) {
+	l := log.NewHelper(log.With(logger, "module", "export/server"))
+	r := srv.Route("/")
+	r.GET("/admin/v1/exports/{resource}/data", exportDataHandler(export, l))
+	r.GET("/admin/v1/exports/{id}/file", downloadExportJobHandler(export, l))
+}
+
+// exportDataHandler 同步导出数据，开始写入文件后出错只能中断响应，错误只记录日志
+func exportDataHandler(export *service.ExportServiceService, l *log.Helper) http.HandlerFunc {
+	return func(ctx http.Context) error {
+		var in v1.ExportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, v1.ExportService_ExportData_FullMethodName)
+		written := false
+		h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+			return nil, export.Export(c, req.(*v1.ExportRequest), func(f biz.ExportFile) (io.Writer, error) {
+				setFileHeader(ctx, f)
+				written = true
+				return ctx.Response(), nil
+			})
+		})
+		if _, err := h(ctx, &in); err != nil {
+			if !written {
+				return err
+			}
+			l.Errorf("导出数据中断，资源：%s，错误：%v", in.GetResource(), err)
+		}
+		return nil
+	}
+}
+
+// downloadExportJobHandler 下载导出任务生成的文件
+func downloadExportJobHandler(export *service.ExportServiceService, l *log.Helper) http.HandlerFunc {
+	return func(ctx http.Context) error {
+		var in v1.GetExportJobRequest
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, v1.ExportService_DownloadExportJob_FullMethodName)
+		h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+			f, rc, err := export.Download(c, req.(*v1.GetExportJobRequest))
+			if err != nil {
+				return nil, err
+			}
+			defer rc.Close()
+			setFileHeader(ctx, f)
+			if _, err := io.Copy(ctx.Response(), rc); err != nil {
+				l.Errorf("下载导出文件中断，任务ID：%s，错误：%v", in.GetId(), err)
+			}
+			return nil, nil
+		})
+		_, err := h(ctx, &in)
+		return err
+	}
+}
+
+// setFileHeader 设置文件下载的响应头
+func setFileHeader(ctx http.Context, f biz.ExportFile) {
+	header := ctx.Response().Header()
+	header.Set("Content-Type", f.ContentType)
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": f.Name}))
+	if f.Size > 0 {
+		header.Set("Content-Length", strconv.FormatInt(f.Size, 10))
+	}
+}
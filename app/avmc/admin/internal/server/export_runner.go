@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"backend-service/app/avmc/admin/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+var _ transport.Server = (*ExportRunner)(nil)
+
+// ExportRunner 管理后台导出任务的生命周期，随应用启动与停止
+// 启动时将执行已中断的任务标记为失败，停止时取消执行中的任务并等待其结束
+type ExportRunner struct {
+	uc   *biz.ExportUsecase
+	log  *log.Helper
+	stop chan struct{}
+	once sync.Once
+}
+
+// NewExportRunner 创建导出任务运行器
+// 参数：uc 数据导出业务用例，logger 日志记录器
+// 返回值：导出任务运行器
+func NewExportRunner(uc *biz.ExportUsecase, logger log.Logger) *ExportRunner {
+	return &ExportRunner{
+		uc:   uc,
+		log:  log.NewHelper(log.With(logger, "module", "export/server")),
+		stop: make(chan struct{}),
+	}
+}
+
+// Start 将执行已中断的导出任务标记为失败，之后等待应用停止
+func (r *ExportRunner) Start(ctx context.Context) error {
+	n, err := r.uc.Recover(ctx)
+	if err != nil {
+		r.log.Errorf("处理已中断的导出任务失败，错误：%v", err)
+	} else if n > 0 {
+		r.log.Infof("已将 %d 个中断的导出任务标记为失败", n)
+	}
+	select {
+	case <-r.stop:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// Stop 取消执行中的导出任务并等待其结束
+func (r *ExportRunner) Stop(ctx context.Context) error {
+	r.once.Do(func() { close(r.stop) })
+	return r.uc.Close(ctx)
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+
+	pbPagination "backend-service/api/common/pagination"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	authnEngine "backend-service/pkg/auth/authn"
+	authzEngine "backend-service/pkg/auth/authz"
+	"backend-service/pkg/middleware/fieldmask"
+)
+
+// ResponseShaper 响应整形中间件，按请求的字段掩码裁剪响应，并按角色隐藏或脱敏字段
+type ResponseShaper middleware.Middleware
+
+// NewResponseShaper 根据 http.middleware.field_visibility 配置创建响应整形中间件，HTTP 与 gRPC 共用
+// 规则引用的消息或字段不存在时返回错误
+func NewResponseShaper(c *conf.Server, authorizer authzEngine.Authorizer) (ResponseShaper, error) {
+	opts, err := fieldOptions(c, authorizer)
+	if err != nil {
+		return nil, err
+	}
+	return ResponseShaper(fieldmask.Server(opts...)), nil
+}
+
+// NewHistoryShaper 按与响应整形中间件相同的字段可见性规则处理变更历史中的快照与字段变更
+func NewHistoryShaper(c *conf.Server, authorizer authzEngine.Authorizer) (biz.HistoryShaper, error) {
+	opts, err := fieldOptions(c, authorizer)
+	if err != nil {
+		return nil, err
+	}
+	values := fieldmask.Values(opts...)
+	return func(ctx context.Context, message protoreflect.FullName) func(string, *structpb.Value) (*structpb.Value, bool) {
+		return values(ctx, message)
+	}, nil
+}
+
+// fieldOptions 根据 http.middleware.field_visibility 配置创建字段可见性规则选项
+func fieldOptions(c *conf.Server, authorizer authzEngine.Authorizer) ([]fieldmask.Option, error) {
+	var rules []fieldmask.Rule
+	for _, r := range c.GetHttp().GetMiddleware().GetFieldVisibility().GetRules() {
+		fields := make([]protoreflect.Name, 0, len(r.GetFields()))
+		for _, f := range r.GetFields() {
+			fields = append(fields, protoreflect.Name(f))
+		}
+		rules = append(rules, fieldmask.Rule{
+			Message: protoreflect.FullName(r.GetMessage()),
+			Fields:  fields,
+			Action:  fieldmask.Action(r.GetAction()),
+			Masker:  r.GetMasker(),
+			Roles:   r.GetRoles(),
+		})
+	}
+	if err := fieldmask.Validate(rules); err != nil {
+		return nil, err
+	}
+	return []fieldmask.Option{
+		fieldmask.WithRules(rules...),
+		fieldmask.WithRoleResolver(newFieldRoleResolver(authorizer)),
+	}, nil
+}
+
+// newFieldRoleResolver 获取当前用户在所属域内直接与间接拥有的角色，未认证时没有角色
+func newFieldRoleResolver(authorizer authzEngine.Authorizer) fieldmask.RoleResolver {
+	return func(ctx context.Context) ([]string, error) {
+		claims, ok := authnEngine.AuthClaimsFromContext(ctx)
+		if !ok || authorizer == nil {
+			return nil, nil
+		}
+		chain, err := authzEngine.ResolveRoleChain(ctx, authorizer,
+			authzEngine.Subject(claims.GetSubject()), authzEngine.Domain(claims.GetDomain()))
+		if err != nil {
+			return nil, err
+		}
+		roles := make([]string, 0, len(chain))
+		for _, binding := range chain {
+			roles = append(roles, string(binding.Role))
+		}
+		return roles, nil
+	}
+}
+
+// NewExportShaper 导出数据时按响应整形中间件处理每批列表数据，导出文件与列表接口的字段可见性一致
+func NewExportShaper(shaper ResponseShaper) biz.ExportShaper {
+	return func(ctx context.Context, req *pbPagination.PagingRequest, reply proto.Message) (proto.Message, error) {
+		out, err := shaper(func(context.Context, interface{}) (interface{}, error) {
+			return reply, nil
+		})(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return out.(proto.Message), nil
+	}
+}
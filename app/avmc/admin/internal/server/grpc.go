@@ -1,28 +1,87 @@
 package server
 
 import (
+	"context"
+
 	v1 "backend-service/api/avmc/admin/v1"
+	pbCore "backend-service/api/core/service/v1"
 	"backend-service/app/avmc/admin/internal/conf"
 	"backend-service/app/avmc/admin/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+
+	authnEngine "backend-service/pkg/auth/authn"
+
+	authzEngine "backend-service/pkg/auth/authz"
+	authMiddleware "backend-service/pkg/auth/middleware"
+	"backend-service/pkg/middleware/localize"
 )
 
+// grpcAuthzInfoExtractor gRPC授权信息提取器，域取自认证声明中的 dom，缺少认证声明时拒绝访问，
+// 操作取方法注解的 HTTP 方法，与 HTTP 保持一致
+func grpcAuthzInfoExtractor(ctx context.Context, fullMethod string) (authzEngine.Subject, authzEngine.Object, authzEngine.Action, authzEngine.Domain, error) {
+	claims, ok := authnEngine.AuthClaimsFromContext(ctx)
+	if !ok || claims == nil {
+		return "", "", "", "", authMiddleware.ErrIncompleteAuthzInfo
+	}
+	sub, obj, _, _, err := authMiddleware.DefaultGRPCAuthzInfoExtractor(ctx, fullMethod)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	act := authzEngine.Action(authMiddleware.OperationHTTPMethod(fullMethod))
+	return sub, obj, act, authzEngine.Domain(claims.GetDomain()), nil
+}
+
+// newGRPCMiddleware 创建中间件
+func newGRPCMiddleware(
+	logger log.Logger,
+	translator *localize.Translator,
+	authenticator authnEngine.Authenticator,
+	authorizer authzEngine.Authorizer,
+	shaper ResponseShaper,
+) []middleware.Middleware {
+	var ms []middleware.Middleware
+	ms = append(ms, recovery.Recovery())
+	ms = append(ms, localize.I18N(translator))
+	ms = append(ms, logging.Server(logger))
+	ms = append(ms, errorMiddleware())
+	ms = append(ms, selector.Server(
+		authMiddleware.GRPCAuthnMiddleware(authenticator, nil),
+		authMiddleware.GRPCAuthzMiddleware(authorizer, grpcAuthzInfoExtractor),
+	).Match(newWhiteListMatcher()).Build())
+	if shaper != nil {
+		ms = append(ms, middleware.Middleware(shaper))
+	}
+	ms = append(ms, validate.Validator())
+
+	return ms
+}
+
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server,
+func NewGRPCServer(c *conf.Server, logger log.Logger,
+	translator *localize.Translator,
+	authenticator authnEngine.Authenticator, authorizer authzEngine.Authorizer,
+	shaper ResponseShaper,
 	auth *service.AuthServiceService,
 	user *service.UserServiceService,
 	dept *service.DeptServiceService,
 	menu *service.MenuServiceService,
 	role *service.RoleServiceService,
-	logger log.Logger,
+	post *service.PostServiceService,
+	policy *service.PolicyServiceService,
+	export *service.ExportServiceService,
+	search *service.SearchServiceService,
+	trash *service.TrashServiceService,
+	coreAuth *service.CoreAuthServiceService,
 ) *grpc.Server {
 	var opts = []grpc.ServerOption{
-		grpc.Middleware(
-			recovery.Recovery(),
-		),
+		grpc.Middleware(newGRPCMiddleware(logger, translator, authenticator, authorizer, shaper)...),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -39,5 +98,11 @@ func NewGRPCServer(c *conf.Server,
 	v1.RegisterDeptServiceServer(srv, dept)
 	v1.RegisterMenuServiceServer(srv, menu)
 	v1.RegisterRoleServiceServer(srv, role)
+	v1.RegisterPostServiceServer(srv, post)
+	v1.RegisterPolicyServiceServer(srv, policy)
+	v1.RegisterExportServiceServer(srv, export)
+	v1.RegisterSearchServiceServer(srv, search)
+	v1.RegisterTrashServiceServer(srv, trash)
+	pbCore.RegisterAuthServiceServer(srv, coreAuth)
 	return srv
 }
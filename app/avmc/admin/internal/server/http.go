@@ -5,14 +5,15 @@ import (
 	"backend-service/app/avmc/admin/cmd/server/assets"
 	"backend-service/app/avmc/admin/internal/conf"
 	"backend-service/app/avmc/admin/internal/service"
-	"context"
 
 	nethttp "net/http"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/gorilla/handlers"
 
@@ -20,48 +21,51 @@ import (
 
 	authzEngine "backend-service/pkg/auth/authz"
 	authMiddleware "backend-service/pkg/auth/middleware"
+	"backend-service/pkg/middleware/localize"
 )
 
-// NewWhiteListMatcher 创建jwt白名单
-func newHTTPWhiteListMatcher() selector.MatchFunc {
-	whiteList := make(map[string]bool)
-	whiteList[v1.OperationAuthServiceLoginCode] = true
-	whiteList[v1.OperationAuthServiceLoginPassword] = true
-	whiteList[v1.OperationAuthServiceRefreshToken] = true
-	return func(ctx context.Context, operation string) bool {
-		if _, ok := whiteList[operation]; ok {
-			return false
-		}
-		return true
-	}
-}
-
 // NewMiddleware 创建中间件
 func newHTTPMiddleware(
 	logger log.Logger,
+	translator *localize.Translator,
 	authenticator authnEngine.Authenticator,
 	authorizer authzEngine.Authorizer,
+	shaper ResponseShaper,
 ) []middleware.Middleware {
 	var ms []middleware.Middleware
+	ms = append(ms, recovery.Recovery())
+	ms = append(ms, localize.I18N(translator))
 	ms = append(ms, logging.Server(logger))
+	ms = append(ms, errorMiddleware())
 	ms = append(ms, selector.Server(
 		authMiddleware.AuthnMiddleware(authenticator),
 		// auth.Server(userToken),
+		// 默认以接口注解的 HTTP 方法为操作，与 gRPC 请求命中相同策略
 		authMiddleware.AuthzMiddleware(authorizer),
-	).Match(newHTTPWhiteListMatcher()).Build())
+	).Match(newWhiteListMatcher()).Build())
+	if shaper != nil {
+		ms = append(ms, middleware.Middleware(shaper))
+	}
+	ms = append(ms, validate.Validator())
 
 	return ms
 }
 
 // NewHTTPServer new an HTTP server.
 func NewHTTPServer(c *conf.Server, logger log.Logger,
+	translator *localize.Translator,
 	authenticator authnEngine.Authenticator, authorizer authzEngine.Authorizer,
+	shaper ResponseShaper,
 	auth *service.AuthServiceService,
 	user *service.UserServiceService,
 	dept *service.DeptServiceService,
 	menu *service.MenuServiceService,
 	role *service.RoleServiceService,
 	post *service.PostServiceService,
+	policy *service.PolicyServiceService,
+	export *service.ExportServiceService,
+	search *service.SearchServiceService,
+	trash *service.TrashServiceService,
 ) *http.Server {
 	var opts = []http.ServerOption{
 		http.Filter(handlers.CORS(
@@ -69,7 +73,8 @@ func NewHTTPServer(c *conf.Server, logger log.Logger,
 			handlers.AllowedMethods(c.Http.Cors.Methods),
 			handlers.AllowedOrigins(c.Http.Cors.Origins),
 		)),
-		http.Middleware(newHTTPMiddleware(logger, authenticator, authorizer)...),
+		http.Middleware(newHTTPMiddleware(logger, translator, authenticator, authorizer, shaper)...),
+		http.ErrorEncoder(newErrorEncoder(translator)),
 	}
 	if c.Http.Network != "" {
 		opts = append(opts, http.Network(c.Http.Network))
@@ -87,6 +92,11 @@ func NewHTTPServer(c *conf.Server, logger log.Logger,
 	v1.RegisterMenuServiceHTTPServer(srv, menu)
 	v1.RegisterRoleServiceHTTPServer(srv, role)
 	v1.RegisterPostServiceHTTPServer(srv, post)
+	v1.RegisterPolicyServiceHTTPServer(srv, policy)
+	v1.RegisterExportServiceHTTPServer(srv, export)
+	v1.RegisterSearchServiceHTTPServer(srv, search)
+	v1.RegisterTrashServiceHTTPServer(srv, trash)
+	registerExportRoutes(srv, export, logger)
 	if c.GetHttp().GetEnableSwagger() {
 		allFS := nethttp.FS(assets.OpenApiData)
 		// swagger-ui: http://127.0.0.1:8000/docs/swagger-ui
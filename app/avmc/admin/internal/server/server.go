@@ -1,8 +1,64 @@
 package server
 
 import (
+	"context"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/app/avmc/admin/cmd/server/assets"
+	"backend-service/pkg/auth/authz/catalog"
+	"backend-service/pkg/middleware/localize"
+
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewHTTPServer, NewGRPCServer)
+var ProviderSet = wire.NewSet(NewHTTPServer, NewGRPCServer, NewTranslator, NewPermissionCatalog, NewResponseShaper, NewExportShaper, NewHistoryShaper, NewTrashPurger, NewExportRunner)
+
+// NewTranslator 创建错误信息翻译器，加载后台管理服务按错误原因定义的多语言消息
+func NewTranslator() (*localize.Translator, error) {
+	return localize.NewTranslator(localize.WithMessageFS(assets.I18nData, "i18n/*.toml"))
+}
+
+// services 注册到 HTTP 与 gRPC 服务器的服务，核心认证服务仅注册到 gRPC 服务器
+var services = []string{
+	v1.AuthService_ServiceDesc.ServiceName,
+	v1.UserService_ServiceDesc.ServiceName,
+	v1.DeptService_ServiceDesc.ServiceName,
+	v1.MenuService_ServiceDesc.ServiceName,
+	v1.RoleService_ServiceDesc.ServiceName,
+	v1.PostService_ServiceDesc.ServiceName,
+	v1.PolicyService_ServiceDesc.ServiceName,
+	v1.ExportService_ServiceDesc.ServiceName,
+	v1.SearchService_ServiceDesc.ServiceName,
+	v1.TrashService_ServiceDesc.ServiceName,
+	pbCore.AuthService_ServiceDesc.ServiceName,
+}
+
+// NewPermissionCatalog 根据注册的服务生成权限目录，公开接口无需授权，不纳入目录
+func NewPermissionCatalog() (*catalog.Catalog, error) {
+	exclude := make([]string, 0, len(publicOperations))
+	for op := range publicOperations {
+		exclude = append(exclude, op)
+	}
+	return catalog.New(services, catalog.WithExclude(exclude...))
+}
+
+// publicOperations 公开接口（无需认证与鉴权），HTTP 与 gRPC 共用
+var publicOperations = map[string]struct{}{
+	v1.OperationAuthServiceLoginCode:     {},
+	v1.OperationAuthServiceLoginPassword: {},
+	v1.OperationAuthServiceRefreshToken:  {},
+	v1.OperationAuthServiceCaptcha:       {},
+}
+
+// newWhiteListMatcher 创建认证白名单匹配器，命中白名单的接口跳过认证与鉴权
+func newWhiteListMatcher() selector.MatchFunc {
+	return func(ctx context.Context, operation string) bool {
+		if _, ok := publicOperations[operation]; ok {
+			return false
+		}
+		return true
+	}
+}
@@ -0,0 +1,428 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "backend-service/api/avmc/admin/v1"
+	"backend-service/api/common/pagination"
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/app/avmc/admin/internal/conf"
+	"backend-service/app/avmc/admin/internal/data"
+	"backend-service/app/avmc/admin/internal/service"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	authnEngine "backend-service/pkg/auth/authn"
+	authnJwt "backend-service/pkg/auth/authn/jwt"
+	authzEngine "backend-service/pkg/auth/authz"
+	"backend-service/pkg/auth/authz/catalog"
+	authMiddleware "backend-service/pkg/auth/middleware"
+	"backend-service/pkg/middleware/localize"
+	"backend-service/pkg/utils/trans"
+)
+
+type headerCarrier nethttp.Header
+
+func (hc headerCarrier) Get(key string) string { return nethttp.Header(hc).Get(key) }
+
+func (hc headerCarrier) Set(key string, value string) { nethttp.Header(hc).Set(key, value) }
+
+func (hc headerCarrier) Add(key string, value string) { nethttp.Header(hc).Add(key, value) }
+
+func (hc headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(hc))
+	for k := range nethttp.Header(hc) {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (hc headerCarrier) Values(key string) []string { return nethttp.Header(hc).Values(key) }
+
+type testTransport struct {
+	kind      transport.Kind
+	operation string
+	header    headerCarrier
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return tr.kind }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return tr.operation }
+func (tr *testTransport) RequestHeader() transport.Header { return tr.header }
+func (tr *testTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+type testHTTPTransport struct {
+	testTransport
+	request *nethttp.Request
+}
+
+func (tr *testHTTPTransport) Request() *nethttp.Request        { return tr.request }
+func (tr *testHTTPTransport) PathTemplate() string             { return "" }
+func (tr *testHTTPTransport) Response() nethttp.ResponseWriter { return nil }
+
+type testAuthorizer struct {
+	authzEngine.Authorizer
+	allow    bool
+	enforced []authzEngine.Object
+	actions  []authzEngine.Action
+	roles    map[authzEngine.Subject][]authzEngine.Subject
+}
+
+func (a *testAuthorizer) GetRolesForUser(_ context.Context, user authzEngine.Subject, _ authzEngine.Domain) ([]authzEngine.Subject, error) {
+	return a.roles[user], nil
+}
+
+func (a *testAuthorizer) Enforce(_ context.Context, _ authzEngine.Subject, obj authzEngine.Object, act authzEngine.Action, _ authzEngine.Domain) (bool, error) {
+	a.enforced = append(a.enforced, obj)
+	a.actions = append(a.actions, act)
+	return a.allow, nil
+}
+
+func newTestAuthenticator(t *testing.T) authnEngine.Authenticator {
+	authenticator, err := authnJwt.NewProvider().NewAuthenticator(
+		context.Background(),
+		authnEngine.WithSigningKey([]byte("test-key")),
+		authnEngine.WithUserFactory(data.NewAuthSecurity(log.DefaultLogger).NewSecurityUser),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return authenticator
+}
+
+func newTestTranslator(t *testing.T) *localize.Translator {
+	translator, err := NewTranslator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return translator
+}
+
+// newTestContext 构造指定传输层的服务端上下文，token 非空时按各传输层的约定携带令牌
+// HTTP 请求按操作注解的主路由构造
+func newTestContext(kind transport.Kind, operation, token string) context.Context {
+	tr := &testTransport{kind: kind, operation: operation, header: headerCarrier{}}
+	ctx := context.Background()
+	switch kind {
+	case transport.KindHTTP:
+		if token != "" {
+			tr.header.Set(authnEngine.HeaderAuthorize, "Bearer "+token)
+		}
+		p, _ := catalog.Describe(operation)
+		request := httptest.NewRequest(p.Routes[0].Method, p.Routes[0].Path, nil)
+		return transport.NewServerContext(ctx, &testHTTPTransport{testTransport: *tr, request: request})
+	case transport.KindGRPC:
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+	}
+	return transport.NewServerContext(ctx, tr)
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	authenticator := newTestAuthenticator(t)
+	token, err := authenticator.CreateToken(context.Background(), authnEngine.AuthClaims{"sub": "1", "dom": "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	translator := newTestTranslator(t)
+	transports := map[transport.Kind]func(log.Logger, *localize.Translator, authnEngine.Authenticator, authzEngine.Authorizer, ResponseShaper) []middleware.Middleware{
+		transport.KindHTTP: newHTTPMiddleware,
+		transport.KindGRPC: newGRPCMiddleware,
+	}
+	tests := []struct {
+		name      string
+		operation string
+		token     string
+		allow     bool
+		code      int
+		reason    v1.ErrorReason
+	}{
+		{name: "public operation", operation: v1.OperationAuthServiceLoginPassword},
+		{name: "missing token", operation: v1.OperationPostServiceListPost, code: 401, reason: v1.ErrorReason_AUTH_TOKEN_NOT_EXIST},
+		{name: "invalid token", operation: v1.OperationPostServiceListPost, token: "invalid", code: 401, reason: v1.ErrorReason_AUTH_INVALID_TOKEN},
+		{name: "permission denied", operation: v1.OperationPostServiceListPost, token: token, code: 403, reason: v1.ErrorReason_PERMISSION_DENIED},
+		{name: "authorized", operation: v1.OperationPostServiceListPost, token: token, allow: true},
+	}
+	for kind, newMiddleware := range transports {
+		for _, tt := range tests {
+			t.Run(kind.String()+"/"+tt.name, func(t *testing.T) {
+				authorizer := &testAuthorizer{allow: tt.allow}
+				var userID uint32
+				handler := middleware.Chain(newMiddleware(log.DefaultLogger, translator, authenticator, authorizer, nil)...)(
+					func(ctx context.Context, req interface{}) (interface{}, error) {
+						userID = authnEngine.GetAuthUserID(ctx)
+						return "ok", nil
+					},
+				)
+				reply, err := handler(newTestContext(kind, tt.operation, tt.token), nil)
+				if tt.code != 0 {
+					assert.Equal(t, tt.code, int(errors.FromError(err).Code))
+					assert.Equal(t, tt.reason.String(), errors.FromError(err).Reason)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, "ok", reply)
+				if tt.token != "" {
+					assert.Equal(t, uint32(1), userID)
+					assert.Equal(t, []authzEngine.Object{authzEngine.Object(tt.operation)}, authorizer.enforced)
+					// 两种传输层均以接口注解的 HTTP 方法为操作
+					assert.Equal(t, []authzEngine.Action{nethttp.MethodGet}, authorizer.actions)
+				} else {
+					assert.Empty(t, authorizer.enforced)
+				}
+			})
+		}
+	}
+}
+
+func TestGRPCAuthzInfoExtractor(t *testing.T) {
+	// 缺少认证声明时拒绝访问
+	_, _, _, _, err := grpcAuthzInfoExtractor(context.Background(), v1.OperationUserServiceListUser)
+	assert.ErrorIs(t, err, authMiddleware.ErrIncompleteAuthzInfo)
+
+	ctx := authnEngine.ContextWithAuthClaims(context.Background(), &authnEngine.AuthClaims{"sub": "1", "dom": "2"})
+	sub, obj, act, dom, err := grpcAuthzInfoExtractor(ctx, v1.OperationUserServiceListUser)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", v1.OperationUserServiceListUser, nethttp.MethodGet, "2"}, []string{string(sub), string(obj), string(act), string(dom)})
+}
+
+func TestHTTPMiddlewareRecovery(t *testing.T) {
+	handler := middleware.Chain(newHTTPMiddleware(log.DefaultLogger, newTestTranslator(t), newTestAuthenticator(t), &testAuthorizer{}, nil)...)(
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			panic("boom")
+		},
+	)
+	_, err := handler(newTestContext(transport.KindHTTP, v1.OperationAuthServiceLoginPassword, ""), nil)
+	assert.Equal(t, 500, int(errors.FromError(err).Code))
+}
+
+func TestResponseShaper(t *testing.T) {
+	authenticator := newTestAuthenticator(t)
+	token, err := authenticator.CreateToken(context.Background(), authnEngine.AuthClaims{"sub": "1", "dom": "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &conf.Server{Http: &conf.Server_HTTP{Middleware: &conf.Middleware{
+		FieldVisibility: &conf.Middleware_FieldVisibility{Rules: []*conf.Middleware_FieldVisibility_Rule{
+			{Message: "core.service.v1.User", Fields: []string{"phone"}, Action: "mask", Masker: "phone", Roles: []string{"hr"}},
+			{Message: "core.service.v1.User", Fields: []string{"email"}, Action: "hide", Roles: []string{"hr"}},
+		}},
+	}}}
+
+	_, err = NewResponseShaper(&conf.Server{Http: &conf.Server_HTTP{Middleware: &conf.Middleware{
+		FieldVisibility: &conf.Middleware_FieldVisibility{Rules: []*conf.Middleware_FieldVisibility_Rule{
+			{Message: "core.service.v1.User", Fields: []string{"mobile"}, Action: "mask"},
+		}},
+	}}}, &testAuthorizer{})
+	assert.Error(t, err)
+
+	translator := newTestTranslator(t)
+	transports := map[transport.Kind]func(log.Logger, *localize.Translator, authnEngine.Authenticator, authzEngine.Authorizer, ResponseShaper) []middleware.Middleware{
+		transport.KindHTTP: newHTTPMiddleware,
+		transport.KindGRPC: newGRPCMiddleware,
+	}
+	tests := []struct {
+		name  string
+		roles []authzEngine.Subject
+		phone string
+		email string
+	}{
+		{name: "masked", roles: []authzEngine.Subject{"staff"}, phone: "138****5678"},
+		{name: "inherited role", roles: []authzEngine.Subject{"staff", "hr"}, phone: "13812345678", email: "alice@example.com"},
+	}
+	for kind, newMiddleware := range transports {
+		for _, tt := range tests {
+			t.Run(kind.String()+"/"+tt.name, func(t *testing.T) {
+				authorizer := &testAuthorizer{allow: true, roles: map[authzEngine.Subject][]authzEngine.Subject{"1": tt.roles[:1]}}
+				if len(tt.roles) > 1 {
+					authorizer.roles[tt.roles[0]] = tt.roles[1:]
+				}
+				shaper, err := NewResponseShaper(c, authorizer)
+				assert.NoError(t, err)
+
+				user := &pbCore.User{Id: 1, Name: trans.String("alice"), Phone: trans.String("13812345678"), Email: trans.String("alice@example.com")}
+				handler := middleware.Chain(newMiddleware(log.DefaultLogger, translator, authenticator, authorizer, shaper)...)(
+					func(ctx context.Context, req interface{}) (interface{}, error) {
+						return &pbCore.ListUserResponse{Items: []*pbCore.User{user}, Total: 1}, nil
+					},
+				)
+				req := &pagination.PagingRequest{FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "phone", "email"}}}
+				reply, err := handler(newTestContext(kind, v1.OperationUserServiceListUser, token), req)
+				assert.NoError(t, err)
+
+				got := reply.(*pbCore.ListUserResponse)
+				assert.Equal(t, int32(1), got.GetTotal())
+				assert.Equal(t, uint32(1), got.GetItems()[0].GetId())
+				assert.Empty(t, got.GetItems()[0].GetName())
+				assert.Equal(t, tt.phone, got.GetItems()[0].GetPhone())
+				assert.Equal(t, tt.email, got.GetItems()[0].GetEmail())
+				// 业务层返回的对象不被修改
+				assert.Equal(t, "13812345678", user.GetPhone())
+			})
+		}
+	}
+}
+
+func TestConvertError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		reason string
+		code   int
+	}{
+		{name: "authn expired", err: authnEngine.NewAuthError(authnEngine.ErrCodeExpiredToken, "expired", nil),
+			reason: v1.ErrorReason_AUTH_TOKEN_EXPIRED.String(), code: 401},
+		{name: "authn unknown", err: authnEngine.NewAuthError(authnEngine.ErrCodeInitializationFailed, "init", nil),
+			reason: v1.ErrorReason_AUTH_FAILED.String(), code: 401},
+		{name: "authz denied", err: authzEngine.NewAuthzError(authzEngine.ErrCodePermissionDenied, "denied", nil),
+			reason: v1.ErrorReason_PERMISSION_DENIED.String(), code: 403},
+		{name: "authz enforce failed", err: authzEngine.NewAuthzError(authzEngine.ErrCodeEnforceFailed, "enforce", nil),
+			reason: v1.ErrorReason_AUTHORIZATION_FAILED.String(), code: 403},
+		{name: "middleware expired", err: authMiddleware.ErrExpiredToken,
+			reason: v1.ErrorReason_AUTH_TOKEN_EXPIRED.String(), code: 401},
+		{name: "middleware missing", err: authMiddleware.ErrMissingToken,
+			reason: v1.ErrorReason_AUTH_TOKEN_NOT_EXIST.String(), code: 401},
+		{name: "middleware reworded", err: errors.Unauthorized("UNAUTHORIZED", "session timed out").WithCause(authnEngine.ErrExpiredToken),
+			reason: v1.ErrorReason_AUTH_TOKEN_EXPIRED.String(), code: 401},
+		{name: "middleware generic", err: errors.Unauthorized("UNAUTHORIZED", "token has expired"),
+			reason: v1.ErrorReason_AUTH_FAILED.String(), code: 401},
+		{name: "middleware denied", err: authMiddleware.ErrPermissionDenied,
+			reason: v1.ErrorReason_PERMISSION_DENIED.String(), code: 403},
+		{name: "middleware incomplete", err: authMiddleware.ErrIncompleteAuthzInfo,
+			reason: v1.ErrorReason_AUTHORIZATION_FAILED.String(), code: 403},
+		{name: "email exists", err: biz.ErrUserEmailAlreadyExists,
+			reason: v1.ErrorReason_USER_EMAIL_ALREADY_EXISTS.String(), code: 400},
+		{name: "phone exists", err: biz.ErrUserPhoneAlreadyExists,
+			reason: v1.ErrorReason_USER_PHONE_ALREADY_EXISTS.String(), code: 400},
+		{name: "business error", err: v1.ErrorRoleNotFound("角色不存在"),
+			reason: v1.ErrorReason_ROLE_NOT_FOUND.String(), code: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			se := errors.FromError(convertError(tt.err))
+			assert.Equal(t, tt.reason, se.Reason)
+			assert.Equal(t, tt.code, int(se.Code))
+		})
+	}
+	assert.NoError(t, convertError(nil))
+}
+
+func TestErrorLocalization(t *testing.T) {
+	translator := newTestTranslator(t)
+	tests := []struct {
+		name    string
+		target  string
+		accept  string
+		err     error
+		message string
+	}{
+		{name: "default language", target: "/admin/v1/roles/1", err: v1.ErrorRoleNotFound("角色不存在"), message: "角色不存在"},
+		{name: "query parameter", target: "/admin/v1/roles/1?lang=en", accept: "zh-CN", err: v1.ErrorRoleNotFound("角色不存在"), message: "Role not found"},
+		{name: "accept language", target: "/admin/v1/roles/1", accept: "en-US,en;q=0.9", err: v1.ErrorRoleNotFound("角色不存在"), message: "Role not found"},
+		{name: "authn error", target: "/admin/v1/roles/1?lang=en", err: authMiddleware.ErrExpiredToken, message: "Session has expired, please log in again"},
+		{name: "detailed message", target: "/admin/v1/roles/1?lang=en", err: v1.ErrorPolicyInvalid("策略效果只能为 allow 或 deny"), message: "策略效果只能为 allow 或 deny"},
+		{name: "reason and message", target: "/admin/v1/roles/1?lang=en", err: authnEngine.NewAuthError(authnEngine.ErrCodeInitializationFailed, "init", nil),
+			message: "Authentication failed"},
+		{name: "validation error", target: "/admin/v1/roles/1?lang=en", err: errors.BadRequest("VALIDATOR", "invalid Role.Name"),
+			message: "Invalid request parameters: invalid Role.Name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(nethttp.MethodGet, tt.target, nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept-Language", tt.accept)
+			}
+			tr := &testHTTPTransport{testTransport: testTransport{kind: transport.KindHTTP, header: headerCarrier(r.Header)}, request: r}
+			r = r.WithContext(transport.NewServerContext(r.Context(), tr))
+			tr.request = r
+
+			w := httptest.NewRecorder()
+			newErrorEncoder(translator)(w, r, tt.err)
+			var reply errors.Status
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &reply))
+			assert.Equal(t, tt.message, reply.Message)
+		})
+	}
+
+	// gRPC 由中间件翻译
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "en"))
+	ctx = transport.NewServerContext(ctx, &testTransport{kind: transport.KindGRPC, header: headerCarrier{"Accept-Language": []string{"en"}}})
+	_, err := localize.I18N(translator)(func(context.Context, interface{}) (interface{}, error) {
+		return nil, v1.ErrorMenuCannotDeleteWithChildren("存在子菜单，不允许删除")
+	})(ctx, nil)
+	assert.Equal(t, "Menus with children cannot be deleted", errors.FromError(err).Message)
+}
+
+func TestNewGRPCServerRegistersAllServices(t *testing.T) {
+	srv := NewGRPCServer(&conf.Server{Grpc: &conf.Server_GRPC{}}, log.DefaultLogger,
+		newTestTranslator(t), newTestAuthenticator(t), &testAuthorizer{}, nil,
+		&service.AuthServiceService{}, &service.UserServiceService{}, &service.DeptServiceService{},
+		&service.MenuServiceService{}, &service.RoleServiceService{}, &service.PostServiceService{},
+		&service.PolicyServiceService{}, &service.ExportServiceService{}, &service.SearchServiceService{}, &service.TrashServiceService{},
+		&service.CoreAuthServiceService{},
+	)
+	services := srv.GetServiceInfo()
+	for _, name := range []string{
+		v1.AuthService_ServiceDesc.ServiceName,
+		v1.UserService_ServiceDesc.ServiceName,
+		v1.DeptService_ServiceDesc.ServiceName,
+		v1.MenuService_ServiceDesc.ServiceName,
+		v1.RoleService_ServiceDesc.ServiceName,
+		v1.PostService_ServiceDesc.ServiceName,
+		v1.PolicyService_ServiceDesc.ServiceName,
+		v1.ExportService_ServiceDesc.ServiceName,
+		v1.SearchService_ServiceDesc.ServiceName,
+		v1.TrashService_ServiceDesc.ServiceName,
+		pbCore.AuthService_ServiceDesc.ServiceName,
+	} {
+		assert.Contains(t, services, name)
+	}
+}
+
+func TestPermissionCatalog(t *testing.T) {
+	c, err := NewPermissionCatalog()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 目录覆盖 gRPC 服务器注册的全部服务，公开接口除外
+	srv := NewGRPCServer(&conf.Server{Grpc: &conf.Server_GRPC{}}, log.DefaultLogger,
+		newTestTranslator(t), newTestAuthenticator(t), &testAuthorizer{}, nil,
+		&service.AuthServiceService{}, &service.UserServiceService{}, &service.DeptServiceService{},
+		&service.MenuServiceService{}, &service.RoleServiceService{}, &service.PostServiceService{},
+		&service.PolicyServiceService{}, &service.ExportServiceService{}, &service.SearchServiceService{}, &service.TrashServiceService{},
+		&service.CoreAuthServiceService{},
+	)
+	for name, info := range srv.GetServiceInfo() {
+		if !strings.HasPrefix(name, "avmc.admin.v1.") && !strings.HasPrefix(name, "core.service.v1.") {
+			continue
+		}
+		assert.Contains(t, services, name)
+		for _, m := range info.Methods {
+			op := "/" + name + "/" + m.Name
+			_, public := publicOperations[op]
+			assert.Equal(t, !public, c.Contains(op), op)
+		}
+	}
+
+	p, ok := c.Lookup(v1.OperationMenuServiceListMenuTree)
+	assert.True(t, ok)
+	assert.Equal(t, v1.MenuService_ServiceDesc.ServiceName, p.Service)
+	assert.Equal(t, []catalog.Route{{Method: "GET", Path: "/admin/v1/menus/tree/{pid}"}, {Method: "GET", Path: "/admin/v1/menus/tree"}}, p.Routes)
+	assert.NotEmpty(t, p.Summary)
+	assert.NotEmpty(t, p.Tags)
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"backend-service/app/avmc/admin/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+var _ transport.Server = (*TrashPurger)(nil)
+
+// TrashPurger 定时彻底删除回收站中超过保留时长的数据，随应用启动与停止
+// 多实例部署时各实例均会执行，删除操作可重复执行
+type TrashPurger struct {
+	uc   *biz.TrashUsecase
+	log  *log.Helper
+	stop chan struct{}
+	once sync.Once
+}
+
+// NewTrashPurger 创建回收站定时清理器
+// 参数：uc 回收站业务用例，logger 日志记录器
+// 返回值：回收站定时清理器
+func NewTrashPurger(uc *biz.TrashUsecase, logger log.Logger) *TrashPurger {
+	return &TrashPurger{
+		uc:   uc,
+		log:  log.NewHelper(log.With(logger, "module", "trash/server")),
+		stop: make(chan struct{}),
+	}
+}
+
+// Start 启动后立即清理一次，之后按清理间隔执行，直到应用停止
+func (p *TrashPurger) Start(ctx context.Context) error {
+	interval := p.uc.Options().PurgeInterval
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.purge(ctx, interval)
+		select {
+		case <-ticker.C:
+		case <-p.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop 停止定时清理
+func (p *TrashPurger) Stop(context.Context) error {
+	p.once.Do(func() { close(p.stop) })
+	return nil
+}
+
+// purge 执行一次清理，最长执行一个清理间隔
+func (p *TrashPurger) purge(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if _, err := p.uc.PurgeExpired(ctx); err != nil {
+		p.log.Errorf("清理回收站过期数据失败，错误：%v", err)
+	}
+}
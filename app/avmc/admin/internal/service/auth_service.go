@@ -15,16 +15,18 @@ type AuthServiceService struct {
 	pb.UnimplementedAuthServiceServer
 	auc *biz.AuthUsecase
 	uuc *biz.UserUsecase
+	cuc *biz.CaptchaUsecase
 	log *log.Helper
 }
 
 // NewAuthServiceService 创建新的认证服务实例
-// 参数：uuc 业务用例实例，logger 日志记录器
+// 参数：uuc 业务用例实例，cuc 验证码业务用例实例，logger 日志记录器
 // 返回值：认证服务实例指针
-func NewAuthServiceService(auc *biz.AuthUsecase, uuc *biz.UserUsecase, logger log.Logger) *AuthServiceService {
+func NewAuthServiceService(auc *biz.AuthUsecase, uuc *biz.UserUsecase, cuc *biz.CaptchaUsecase, logger log.Logger) *AuthServiceService {
 	return &AuthServiceService{
 		auc: auc,
 		uuc: uuc,
+		cuc: cuc,
 		log: log.NewHelper(logger),
 	}
 }
@@ -39,7 +41,7 @@ func (s *AuthServiceService) LoginPassword(ctx context.Context, req *pb.LoginReq
 		return nil, pb.ErrorUserIncorrectPassword("用户名或密码为空")
 	}
 	// 调用业务逻辑层
-	resp, err := s.auc.Login(ctx, loginPassword.GetUsername(), loginPassword.GetPassword(), req.GetDomainId())
+	resp, err := s.auc.Login(ctx, loginPassword.GetUsername(), loginPassword.GetPassword(), req.GetDomainId(), loginPassword.GetCaptcha())
 	if err != nil {
 		s.log.Errorf("登录失败: %v", err)
 		return nil, err
@@ -48,11 +50,27 @@ func (s *AuthServiceService) LoginPassword(ctx context.Context, req *pb.LoginReq
 	return resp, nil
 }
 
-// Login 处理后台登录请求
+// LoginCode 处理手机验证码登录请求
+// 短信验证码的发送与校验尚未接入，直接返回未实现
 // 参数：ctx 上下文，req 登录请求
 // 返回值：登录响应，错误信息
 func (s *AuthServiceService) LoginCode(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
-	return nil, nil
+	s.log.Warnf("暂不支持手机验证码登录，手机号：%s", req.GetCode().GetPhone())
+	return nil, pb.ErrorNotImplemented("暂不支持手机验证码登录")
+}
+
+// Captcha 处理获取图形验证码请求
+// 参数：ctx 上下文，req 图形验证码请求
+// 返回值：图形验证码响应，错误信息
+func (s *AuthServiceService) Captcha(ctx context.Context, req *pb.CaptchaRequest) (*pb.CaptchaResponse, error) {
+	// 调用业务逻辑层
+	resp, err := s.cuc.Generate(ctx, req.GetUsername())
+	if err != nil {
+		s.log.Errorf("获取图形验证码失败: %v", err)
+		return nil, err
+	}
+
+	return resp, nil
 }
 
 // RefreshToken 处理刷新令牌请求
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/pkg/auth/authz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// CoreAuthServiceService 核心认证服务结构体，仅通过 gRPC 向其它服务提供授权决策
+// 包含业务用例和日志记录器
+type CoreAuthServiceService struct {
+	pbCore.UnimplementedAuthServiceServer
+	puc *biz.PolicyUsecase
+	log *log.Helper
+}
+
+// NewCoreAuthServiceService 创建新的核心认证服务实例
+// 参数：puc 策略业务用例实例，logger 日志记录器
+// 返回值：核心认证服务实例指针
+func NewCoreAuthServiceService(puc *biz.PolicyUsecase, logger log.Logger) *CoreAuthServiceService {
+	return &CoreAuthServiceService{
+		puc: puc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// IsAuthorized 使用本地授权器判断主体能否对资源执行操作
+// 参数：ctx 上下文，req 鉴权请求，resource 为授权对象，project 为授权域
+// 返回值：鉴权响应，错误信息
+func (s *CoreAuthServiceService) IsAuthorized(ctx context.Context, req *pbCore.IsAuthorizedRequest) (*pbCore.IsAuthorizedResponse, error) {
+	allowed, err := s.puc.IsAuthorized(ctx,
+		authz.Subject(req.GetSubject()),
+		authz.Object(req.GetResource()),
+		authz.Action(req.GetAction()),
+		authz.Domain(req.GetProject()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pbCore.IsAuthorizedResponse{Allowed: allowed}, nil
+}
@@ -37,6 +37,14 @@ func (s *DeptServiceService) ListDept(ctx context.Context, req *pbPagination.Pag
 	return s.duc.ListPage(ctx, req)
 }
 
+// ListDeptTree 处理部门树请求
+// 参数：ctx 上下文，req 获取部门树请求
+// 返回值：部门树响应，错误信息
+func (s *DeptServiceService) ListDeptTree(ctx context.Context, req *pbCore.ListDeptTreeRequest) (*pbCore.ListDeptTreeResponse, error) {
+	s.log.Infof("查询部门树，上级部门ID：%v", req.GetParentId())
+	return s.duc.ListTree(ctx, req.GetParentId())
+}
+
 // GetDept 处理获取部门详情请求
 // 参数：ctx 上下文，req 获取部门请求
 // 返回值：部门详情，错误信息
@@ -73,6 +81,9 @@ func (s *DeptServiceService) UpdateDept(ctx context.Context, req *pbCore.UpdateD
 	if req.GetDept() == nil {
 		return nil, pb.ErrorDeptInvalidId("部门信息不能为空")
 	}
+	if req.GetDept().Version == nil {
+		return nil, pb.ErrorBadRequest("版本号不能为空")
+	}
 	s.log.Infof("更新部门，部门ID：%v，部门信息：%v", req.GetId(), req.GetDept())
 	req.Dept.Id = req.GetId()
 	_, err := s.duc.Update(ctx, req.GetDept())
@@ -96,3 +107,21 @@ func (s *DeptServiceService) DeleteDept(ctx context.Context, req *pbCore.DeleteD
 	}
 	return &pbCore.DeleteDeptResponse{}, nil
 }
+
+// MoveDept 处理移动部门请求
+// 参数：ctx 上下文，req 移动部门请求
+// 返回值：移动部门响应，错误信息
+func (s *DeptServiceService) MoveDept(ctx context.Context, req *pbCore.MoveDeptRequest) (*pbCore.MoveDeptResponse, error) {
+	if req.GetId() == 0 {
+		return nil, pb.ErrorDeptInvalidId("部门ID不能为空")
+	}
+	if req.Version == nil {
+		return nil, pb.ErrorBadRequest("版本号不能为空")
+	}
+	s.log.Infof("移动部门，部门ID：%v，上级部门ID：%v", req.GetId(), req.GetParentId())
+	_, err := s.duc.Move(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pbCore.MoveDeptResponse{}, nil
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	pb "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ExportServiceService 数据导出服务结构体
+// 文件下载接口（ExportData、DownloadExportJob）由 HTTP 路由调用 Export、Download 提供，gRPC 未实现
+type ExportServiceService struct {
+	pb.UnimplementedExportServiceServer
+	euc *biz.ExportUsecase
+	log *log.Helper
+}
+
+// NewExportServiceService 创建新的数据导出服务实例
+// 参数：euc 数据导出业务用例实例，logger 日志记录器
+// 返回值：数据导出服务实例指针
+func NewExportServiceService(euc *biz.ExportUsecase, logger log.Logger) *ExportServiceService {
+	return &ExportServiceService{
+		euc: euc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// Export 处理同步导出数据请求
+// 参数：ctx 上下文，req 导出请求，open 写入数据前调用，用于设置响应头并返回输出
+// 返回值：错误信息
+func (s *ExportServiceService) Export(ctx context.Context, req *pb.ExportRequest, open func(biz.ExportFile) (io.Writer, error)) error {
+	s.log.Infof("同步导出数据，资源：%s，格式：%s", req.GetResource(), req.GetFormat())
+	return s.euc.Export(ctx, req, open)
+}
+
+// CreateExportJob 处理创建导出任务请求
+// 参数：ctx 上下文，req 导出请求
+// 返回值：导出任务，错误信息
+func (s *ExportServiceService) CreateExportJob(ctx context.Context, req *pb.ExportRequest) (*pb.ExportJob, error) {
+	s.log.Infof("创建导出任务，资源：%s，格式：%s", req.GetResource(), req.GetFormat())
+	return s.euc.CreateJob(ctx, req)
+}
+
+// GetExportJob 处理获取导出任务请求
+// 参数：ctx 上下文，req 获取导出任务请求
+// 返回值：导出任务，错误信息
+func (s *ExportServiceService) GetExportJob(ctx context.Context, req *pb.GetExportJobRequest) (*pb.ExportJob, error) {
+	if req.GetId() == "" {
+		return nil, biz.ErrExportJobNotFound
+	}
+	return s.euc.GetJob(ctx, req.GetId())
+}
+
+// Download 处理下载导出文件请求
+// 参数：ctx 上下文，req 获取导出任务请求
+// 返回值：文件信息，文件内容，错误信息
+func (s *ExportServiceService) Download(ctx context.Context, req *pb.GetExportJobRequest) (biz.ExportFile, io.ReadCloser, error) {
+	if req.GetId() == "" {
+		return biz.ExportFile{}, nil, biz.ErrExportJobNotFound
+	}
+	s.log.Infof("下载导出文件，任务ID：%s", req.GetId())
+	return s.euc.OpenJob(ctx, req.GetId())
+}
@@ -16,15 +16,17 @@ import (
 type MenuServiceService struct {
 	pb.UnimplementedMenuServiceServer
 	muc *biz.MenuUsecase
+	huc *biz.HistoryUsecase
 	log *log.Helper
 }
 
 // NewMenuServiceService 创建新的菜单服务实例
-// 参数：muc 菜单业务用例实例，logger 日志记录器
+// 参数：muc 菜单业务用例实例，huc 变更历史业务用例实例，logger 日志记录器
 // 返回值：菜单服务实例指针
-func NewMenuServiceService(muc *biz.MenuUsecase, logger log.Logger) *MenuServiceService {
+func NewMenuServiceService(muc *biz.MenuUsecase, huc *biz.HistoryUsecase, logger log.Logger) *MenuServiceService {
 	return &MenuServiceService{
 		muc: muc,
+		huc: huc,
 		log: log.NewHelper(logger),
 	}
 }
@@ -81,6 +83,9 @@ func (s *MenuServiceService) UpdateMenu(ctx context.Context, req *pbCore.UpdateM
 	if req.GetMenu() == nil {
 		return nil, pb.ErrorMenuInvalidId("菜单信息不能为空")
 	}
+	if req.GetMenu().Version == nil {
+		return nil, pb.ErrorBadRequest("版本号不能为空")
+	}
 	s.log.Infof("更新菜单，菜单ID：%v，菜单信息：%v", req.GetId(), req.GetMenu())
 	req.Menu.Id = req.GetId()
 	_, err := s.muc.Update(ctx, req.GetMenu())
@@ -138,3 +143,10 @@ func (s *MenuServiceService) ExistMenuByName(ctx context.Context, req *pbCore.Ex
 		Exist: exist,
 	}, nil
 }
+
+// ListMenuHistory 处理查询菜单变更历史请求
+// 参数：ctx 上下文，req 查询变更历史请求
+// 返回值：查询变更历史响应，错误信息
+func (s *MenuServiceService) ListMenuHistory(ctx context.Context, req *pb.ListHistoryRequest) (*pb.ListHistoryResponse, error) {
+	return s.huc.List(ctx, biz.HistoryResourceMenu, req)
+}
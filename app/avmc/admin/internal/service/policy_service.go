@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+
+	pb "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/pkg/auth/authn"
+	"backend-service/pkg/auth/authz"
+	"backend-service/pkg/auth/authz/catalog"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PolicyServiceService 策略管理服务结构体
+// 包含业务用例和日志记录器
+type PolicyServiceService struct {
+	pb.UnimplementedPolicyServiceServer
+	puc   *biz.PolicyUsecase
+	permc *biz.PermissionUsecase
+	log   *log.Helper
+}
+
+// NewPolicyServiceService 创建新的策略管理服务实例
+// 参数：puc 策略管理业务用例实例，permc 权限目录业务用例实例，logger 日志记录器
+// 返回值：策略管理服务实例指针
+func NewPolicyServiceService(puc *biz.PolicyUsecase, permc *biz.PermissionUsecase, logger log.Logger) *PolicyServiceService {
+	return &PolicyServiceService{
+		puc:   puc,
+		permc: permc,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// ListPolicy 处理策略列表请求
+// 参数：ctx 上下文，req 策略列表请求
+// 返回值：策略列表响应，错误信息
+func (s *PolicyServiceService) ListPolicy(ctx context.Context, req *pb.ListPolicyRequest) (*pb.ListPolicyResponse, error) {
+	s.log.Infof("查询策略列表，请求：%v", req)
+	domain, err := claimsDomain(ctx, req.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	policies, total, err := s.puc.ListPolicies(ctx, biz.PolicyFilter{
+		Domain:  domain,
+		Subject: authz.Subject(req.GetSubject()),
+		Object:  authz.Object(req.GetObject()),
+		Action:  authz.Action(req.GetAction()),
+		Effect:  authz.Effect(req.GetEffect()),
+	}, int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListPolicyResponse{Items: policiesToProto(policies), Total: int32(total)}, nil
+}
+
+// ListRoleBinding 处理角色绑定列表请求
+// 参数：ctx 上下文，req 角色绑定列表请求
+// 返回值：角色绑定列表响应，错误信息
+func (s *PolicyServiceService) ListRoleBinding(ctx context.Context, req *pb.ListRoleBindingRequest) (*pb.ListRoleBindingResponse, error) {
+	s.log.Infof("查询角色绑定列表，请求：%v", req)
+	domain, err := claimsDomain(ctx, req.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	bindings, total, err := s.puc.ListRoleBindings(ctx, biz.RoleBindingFilter{
+		Domain: domain,
+		User:   authz.Subject(req.GetUser()),
+		Role:   authz.Subject(req.GetRole()),
+	}, int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListRoleBindingResponse{Items: roleBindingsToProto(bindings), Total: int32(total)}, nil
+}
+
+// AddPolicy 处理添加策略与角色绑定请求
+// 参数：ctx 上下文，req 添加策略请求
+// 返回值：添加策略响应，错误信息
+func (s *PolicyServiceService) AddPolicy(ctx context.Context, req *pb.AddPolicyRequest) (*pb.AddPolicyResponse, error) {
+	s.log.Infof("添加策略，请求：%v", req)
+	policies, bindings, err := scopePolicies(ctx, req.GetPolicies(), req.GetRoleBindings())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.puc.Add(ctx, policies, bindings); err != nil {
+		return nil, err
+	}
+	return &pb.AddPolicyResponse{}, nil
+}
+
+// RemovePolicy 处理移除策略与角色绑定请求
+// 参数：ctx 上下文，req 移除策略请求
+// 返回值：移除策略响应，错误信息
+func (s *PolicyServiceService) RemovePolicy(ctx context.Context, req *pb.RemovePolicyRequest) (*pb.RemovePolicyResponse, error) {
+	s.log.Infof("移除策略，请求：%v", req)
+	policies, bindings, err := scopePolicies(ctx, req.GetPolicies(), req.GetRoleBindings())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.puc.Remove(ctx, policies, bindings); err != nil {
+		return nil, err
+	}
+	return &pb.RemovePolicyResponse{}, nil
+}
+
+// ImportPolicy 处理导入策略请求，dry_run 时只返回差异
+// 参数：ctx 上下文，req 导入策略请求
+// 返回值：导入策略响应，错误信息
+func (s *PolicyServiceService) ImportPolicy(ctx context.Context, req *pb.ImportPolicyRequest) (*pb.ImportPolicyResponse, error) {
+	s.log.Infof("导入策略，域：%q，替换：%v，预览：%v", req.GetDomain(), req.GetReplace(), req.GetDryRun())
+	domain, err := claimsDomain(ctx, req.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	diff, err := s.puc.Import(ctx, []byte(req.GetCsv()), domain, req.GetReplace(), req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ImportPolicyResponse{
+		Diff: &pb.PolicyDiff{
+			AddedPolicies:       policiesToProto(diff.AddedPolicies),
+			RemovedPolicies:     policiesToProto(diff.RemovedPolicies),
+			AddedRoleBindings:   roleBindingsToProto(diff.AddedRoleBindings),
+			RemovedRoleBindings: roleBindingsToProto(diff.RemovedRoleBindings),
+		},
+		Applied: !req.GetDryRun() && !diff.Empty(),
+	}, nil
+}
+
+// ExportPolicy 处理导出策略请求
+// 参数：ctx 上下文，req 导出策略请求
+// 返回值：导出策略响应，错误信息
+func (s *PolicyServiceService) ExportPolicy(ctx context.Context, req *pb.ExportPolicyRequest) (*pb.ExportPolicyResponse, error) {
+	s.log.Infof("导出策略，域：%q", req.GetDomain())
+	domain, err := claimsDomain(ctx, req.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.puc.Export(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportPolicyResponse{Csv: string(data)}, nil
+}
+
+// ExplainPolicy 处理解释授权决策请求
+// 参数：ctx 上下文，req 解释授权决策请求
+// 返回值：解释授权决策响应，错误信息
+func (s *PolicyServiceService) ExplainPolicy(ctx context.Context, req *pb.ExplainPolicyRequest) (*pb.ExplainPolicyResponse, error) {
+	s.log.Infof("解释授权决策，请求：%v", req)
+	domain, err := claimsDomain(ctx, req.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	explanation, err := s.puc.Explain(ctx, authz.Subject(req.GetSubject()), authz.Object(req.GetObject()),
+		authz.Action(req.GetAction()), domain)
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]*pb.ExplainRole, len(explanation.Roles))
+	for i, r := range explanation.Roles {
+		roles[i] = &pb.ExplainRole{Name: string(r.Name)}
+		if r.Role != nil {
+			roles[i].Id = &r.Role.Id
+			roles[i].DataScope = r.Role.DataScope
+		}
+	}
+	return &pb.ExplainPolicyResponse{
+		Allowed:         explanation.Allowed,
+		MatchedPolicies: policiesToProto(explanation.MatchedPolicies),
+		RoleChain:       roleBindingsToProto(explanation.RoleChain),
+		Roles:           roles,
+		DataScope:       explanation.DataScope,
+		Trace:           explanation.Trace,
+	}, nil
+}
+
+// ListPermission 处理权限目录请求
+// 参数：ctx 上下文，req 权限目录请求
+// 返回值：权限目录响应，错误信息
+func (s *PolicyServiceService) ListPermission(ctx context.Context, req *pb.ListPermissionRequest) (*pb.ListPermissionResponse, error) {
+	permissions, total := s.permc.List(biz.PermissionFilter{Tag: req.GetTag(), Keyword: req.GetKeyword()},
+		int(req.GetPage()), int(req.GetPageSize()))
+	return &pb.ListPermissionResponse{Items: permissionsToProto(permissions), Total: int32(total)}, nil
+}
+
+// ListStaleMenu 处理失效按钮菜单请求
+// 参数：ctx 上下文，req 失效按钮菜单请求
+// 返回值：失效按钮菜单响应，错误信息
+func (s *PolicyServiceService) ListStaleMenu(ctx context.Context, req *pb.ListStaleMenuRequest) (*pb.ListStaleMenuResponse, error) {
+	s.log.Infof("查询失效的按钮菜单")
+	menus, err := s.permc.ListStaleMenus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*pb.StaleMenu, len(menus))
+	for i, m := range menus {
+		items[i] = &pb.StaleMenu{Id: m.GetId(), Name: m.GetName(), Path: m.GetPath(), Pid: m.GetPid()}
+	}
+	return &pb.ListStaleMenuResponse{Items: items}, nil
+}
+
+// claimsDomain 返回调用者认证声明中的域，策略查询、变更、解释与导入导出仅限该域
+// 参数：ctx 上下文，domain 请求中的域，为空时取认证声明中的域，非空时须与之一致
+// 返回值：域，错误信息
+func claimsDomain(ctx context.Context, domain string) (authz.Domain, error) {
+	claims, ok := authn.AuthClaimsFromContext(ctx)
+	if !ok || claims.GetDomain() == "" {
+		return "", pb.ErrorAuthFailed("认证失败")
+	}
+	if domain != "" && domain != claims.GetDomain() {
+		return "", pb.ErrorAccessForbidden("没有权限访问该资源")
+	}
+	return authz.Domain(claims.GetDomain()), nil
+}
+
+// scopePolicies 转换请求中的策略与角色绑定，未填写的域取认证声明中的域，其它域返回无权访问
+// 参数：ctx 上下文，policies 策略，bindings 角色绑定
+// 返回值：策略，角色绑定，错误信息
+func scopePolicies(ctx context.Context, policies []*pb.Policy, bindings []*pb.RoleBinding) ([]authz.Policy, []authz.RoleBinding, error) {
+	result := policiesFromProto(policies)
+	for i, p := range result {
+		domain, err := claimsDomain(ctx, string(p.Domain))
+		if err != nil {
+			return nil, nil, err
+		}
+		result[i].Domain = domain
+	}
+	resultBindings := roleBindingsFromProto(bindings)
+	for i, b := range resultBindings {
+		domain, err := claimsDomain(ctx, string(b.Domain))
+		if err != nil {
+			return nil, nil, err
+		}
+		resultBindings[i].Domain = domain
+	}
+	return result, resultBindings, nil
+}
+
+// permissionsToProto 转换权限目录项为 proto
+func permissionsToProto(permissions []catalog.Permission) []*pb.Permission {
+	result := make([]*pb.Permission, len(permissions))
+	for i, p := range permissions {
+		routes := make([]*pb.PermissionRoute, len(p.Routes))
+		for j, r := range p.Routes {
+			routes[j] = &pb.PermissionRoute{Method: r.Method, Path: r.Path}
+		}
+		result[i] = &pb.Permission{
+			Operation:   p.Operation,
+			Service:     p.Service,
+			Method:      p.Method,
+			Routes:      routes,
+			Summary:     p.Summary,
+			Description: p.Description,
+			Tags:        p.Tags,
+		}
+	}
+	return result
+}
+
+// policiesToProto 转换策略为 proto
+func policiesToProto(policies []authz.Policy) []*pb.Policy {
+	result := make([]*pb.Policy, len(policies))
+	for i, p := range policies {
+		result[i] = &pb.Policy{
+			Subject: string(p.Subject),
+			Object:  string(p.Object),
+			Action:  string(p.Action),
+			Domain:  string(p.Domain),
+			Effect:  string(p.Effect),
+		}
+	}
+	return result
+}
+
+// policiesFromProto 转换 proto 为策略
+func policiesFromProto(policies []*pb.Policy) []authz.Policy {
+	result := make([]authz.Policy, len(policies))
+	for i, p := range policies {
+		result[i] = authz.Policy{
+			Subject: authz.Subject(p.GetSubject()),
+			Object:  authz.Object(p.GetObject()),
+			Action:  authz.Action(p.GetAction()),
+			Domain:  authz.Domain(p.GetDomain()),
+			Effect:  authz.Effect(p.GetEffect()),
+		}
+	}
+	return result
+}
+
+// roleBindingsToProto 转换角色绑定为 proto
+func roleBindingsToProto(bindings []authz.RoleBinding) []*pb.RoleBinding {
+	result := make([]*pb.RoleBinding, len(bindings))
+	for i, b := range bindings {
+		result[i] = &pb.RoleBinding{User: string(b.User), Role: string(b.Role), Domain: string(b.Domain)}
+	}
+	return result
+}
+
+// roleBindingsFromProto 转换 proto 为角色绑定
+func roleBindingsFromProto(bindings []*pb.RoleBinding) []authz.RoleBinding {
+	result := make([]authz.RoleBinding, len(bindings))
+	for i, b := range bindings {
+		result[i] = authz.RoleBinding{
+			User:   authz.Subject(b.GetUser()),
+			Role:   authz.Subject(b.GetRole()),
+			Domain: authz.Domain(b.GetDomain()),
+		}
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "backend-service/api/avmc/admin/v1"
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+	"backend-service/pkg/auth/authn"
+	"backend-service/pkg/auth/authz"
+)
+
+// fakePolicyRepo 记录查询使用的域
+type fakePolicyRepo struct {
+	biz.PolicyRepo
+	domains []authz.Domain
+}
+
+func (r *fakePolicyRepo) ListPolicies(_ context.Context, filter biz.PolicyFilter, _, _ int) ([]authz.Policy, int, error) {
+	r.domains = append(r.domains, filter.Domain)
+	return nil, 0, nil
+}
+
+func (r *fakePolicyRepo) ListRoleBindings(_ context.Context, filter biz.RoleBindingFilter, _, _ int) ([]authz.RoleBinding, int, error) {
+	r.domains = append(r.domains, filter.Domain)
+	return nil, 0, nil
+}
+
+func (r *fakePolicyRepo) Explain(_ context.Context, _ authz.Subject, _ authz.Object, _ authz.Action, domain authz.Domain) (*authz.Explanation, error) {
+	r.domains = append(r.domains, domain)
+	return &authz.Explanation{}, nil
+}
+
+type fakeRoleRepo struct {
+	biz.RoleRepo
+}
+
+func (fakeRoleRepo) ListByNames(context.Context, []string) ([]*pbCore.Role, error) {
+	return nil, nil
+}
+
+func TestPolicyServiceDomain(t *testing.T) {
+	repo := &fakePolicyRepo{}
+	s := NewPolicyServiceService(biz.NewPolicyUsecase(repo, fakeRoleRepo{}, log.DefaultLogger), nil, log.DefaultLogger)
+	ctx := authn.ContextWithAuthClaims(context.Background(), &authn.AuthClaims{"sub": "1", "dom": "1"})
+	calls := map[string]func(ctx context.Context, domain string) error{
+		"ListPolicy": func(ctx context.Context, domain string) error {
+			_, err := s.ListPolicy(ctx, &pb.ListPolicyRequest{Domain: domain})
+			return err
+		},
+		"ListRoleBinding": func(ctx context.Context, domain string) error {
+			_, err := s.ListRoleBinding(ctx, &pb.ListRoleBindingRequest{Domain: domain})
+			return err
+		},
+		"ExplainPolicy": func(ctx context.Context, domain string) error {
+			_, err := s.ExplainPolicy(ctx, &pb.ExplainPolicyRequest{Subject: "1", Object: "/api/users", Action: "GET", Domain: domain})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			repo.domains = nil
+
+			// 查询其它域时拒绝访问，不访问仓库
+			err := call(ctx, "2")
+			assert.True(t, pb.IsAccessForbidden(err), err)
+			assert.Empty(t, repo.domains)
+
+			// 缺少认证声明时认证失败
+			err = call(context.Background(), "")
+			assert.True(t, pb.IsAuthFailed(err), err)
+			assert.Empty(t, repo.domains)
+
+			// 未填写或填写所在域时均查询所在域
+			require.NoError(t, call(ctx, ""))
+			require.NoError(t, call(ctx, "1"))
+			assert.Equal(t, []authz.Domain{"1", "1"}, repo.domains)
+		})
+	}
+}
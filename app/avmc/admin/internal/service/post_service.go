@@ -73,6 +73,9 @@ func (s *PostServiceService) UpdatePost(ctx context.Context, req *pbCore.UpdateP
 	if req.GetPost() == nil {
 		return nil, pb.ErrorPostInvalidId("岗位信息不能为空")
 	}
+	if req.GetPost().Version == nil {
+		return nil, pb.ErrorBadRequest("版本号不能为空")
+	}
 	s.log.Infof("更新岗位，岗位ID：%v，岗位信息：%v", req.GetId(), req.GetPost())
 	req.Post.Id = req.GetId()
 	_, err := s.puc.Update(ctx, req.GetPost())
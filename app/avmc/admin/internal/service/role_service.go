@@ -16,15 +16,17 @@ import (
 type RoleServiceService struct {
 	pb.UnimplementedRoleServiceServer
 	ruc *biz.RoleUsecase
+	huc *biz.HistoryUsecase
 	log *log.Helper
 }
 
 // NewRoleServiceService 创建新的角色服务实例
-// 参数：ruc 角色业务用例实例，logger 日志记录器
+// 参数：ruc 角色业务用例实例，huc 变更历史业务用例实例，logger 日志记录器
 // 返回值：角色服务实例指针
-func NewRoleServiceService(ruc *biz.RoleUsecase, logger log.Logger) *RoleServiceService {
+func NewRoleServiceService(ruc *biz.RoleUsecase, huc *biz.HistoryUsecase, logger log.Logger) *RoleServiceService {
 	return &RoleServiceService{
 		ruc: ruc,
+		huc: huc,
 		log: log.NewHelper(logger),
 	}
 }
@@ -73,6 +75,9 @@ func (s *RoleServiceService) UpdateRole(ctx context.Context, req *pbCore.UpdateR
 	if req.GetRole() == nil {
 		return nil, pb.ErrorRoleInvalidId("角色信息不能为空")
 	}
+	if req.GetRole().Version == nil {
+		return nil, pb.ErrorBadRequest("版本号不能为空")
+	}
 	s.log.Infof("更新角色，角色ID：%v，角色信息：%v", req.GetId(), req.GetRole())
 	req.Role.Id = req.GetId()
 	_, err := s.ruc.Update(ctx, req.GetRole())
@@ -96,3 +101,10 @@ func (s *RoleServiceService) DeleteRole(ctx context.Context, req *pbCore.DeleteR
 	}
 	return &pbCore.DeleteRoleResponse{}, nil
 }
+
+// ListRoleHistory 处理查询角色变更历史请求
+// 参数：ctx 上下文，req 查询变更历史请求
+// 返回值：查询变更历史响应，错误信息
+func (s *RoleServiceService) ListRoleHistory(ctx context.Context, req *pb.ListHistoryRequest) (*pb.ListHistoryResponse, error) {
+	return s.huc.List(ctx, biz.HistoryResourceRole, req)
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	pb "backend-service/api/avmc/admin/v1"
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SearchServiceService 全文搜索服务结构体
+// 包含业务用例和日志记录器
+type SearchServiceService struct {
+	pb.UnimplementedSearchServiceServer
+	suc *biz.SearchUsecase
+	log *log.Helper
+}
+
+// NewSearchServiceService 创建新的全文搜索服务实例
+// 参数：suc 全文搜索业务用例实例，logger 日志记录器
+// 返回值：全文搜索服务实例指针
+func NewSearchServiceService(suc *biz.SearchUsecase, logger log.Logger) *SearchServiceService {
+	return &SearchServiceService{
+		suc: suc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// SearchUser 处理搜索用户请求
+// 参数：ctx 上下文，req 搜索请求
+// 返回值：用户列表响应，错误信息
+func (s *SearchServiceService) SearchUser(ctx context.Context, req *pb.SearchRequest) (*pbCore.ListUserResponse, error) {
+	if strings.TrimSpace(req.GetKeyword()) == "" {
+		return nil, pb.ErrorBadRequest("搜索关键字不能为空")
+	}
+	return s.suc.SearchUsers(ctx, req.GetKeyword(), req.GetLimit())
+}
+
+// SearchMenu 处理搜索菜单请求
+// 参数：ctx 上下文，req 搜索请求
+// 返回值：菜单列表响应，错误信息
+func (s *SearchServiceService) SearchMenu(ctx context.Context, req *pb.SearchRequest) (*pbCore.ListMenuResponse, error) {
+	if strings.TrimSpace(req.GetKeyword()) == "" {
+		return nil, pb.ErrorBadRequest("搜索关键字不能为空")
+	}
+	return s.suc.SearchMenus(ctx, req.GetKeyword(), req.GetLimit())
+}
+
+// RebuildSearchIndex 处理重建搜索索引请求
+// 参数：ctx 上下文，req 重建搜索索引请求
+// 返回值：重建搜索索引响应，错误信息
+func (s *SearchServiceService) RebuildSearchIndex(ctx context.Context, req *pb.RebuildSearchIndexRequest) (*pb.RebuildSearchIndexResponse, error) {
+	s.log.Infof("重建搜索索引，索引：%s", req.GetIndex())
+	total, err := s.suc.Rebuild(ctx, req.GetIndex())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RebuildSearchIndexResponse{Total: int32(total)}, nil
+}
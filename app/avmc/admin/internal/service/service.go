@@ -10,4 +10,9 @@ var ProviderSet = wire.NewSet(
 	NewMenuServiceService,
 	NewRoleServiceService,
 	NewPostServiceService,
+	NewPolicyServiceService,
+	NewCoreAuthServiceService,
+	NewExportServiceService,
+	NewSearchServiceService,
+	NewTrashServiceService,
 )
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	pb "backend-service/api/avmc/admin/v1"
+	"backend-service/app/avmc/admin/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// TrashServiceService 回收站服务结构体
+// 包含业务用例和日志记录器
+type TrashServiceService struct {
+	pb.UnimplementedTrashServiceServer
+	tuc *biz.TrashUsecase
+	log *log.Helper
+}
+
+// NewTrashServiceService 创建新的回收站服务实例
+// 参数：tuc 回收站业务用例实例，logger 日志记录器
+// 返回值：回收站服务实例指针
+func NewTrashServiceService(tuc *biz.TrashUsecase, logger log.Logger) *TrashServiceService {
+	return &TrashServiceService{
+		tuc: tuc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// ListTrash 处理查询回收站请求
+// 参数：ctx 上下文，req 查询回收站请求
+// 返回值：查询回收站响应，错误信息
+func (s *TrashServiceService) ListTrash(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	return s.tuc.List(ctx, req)
+}
+
+// RestoreTrash 处理恢复数据请求
+// 参数：ctx 上下文，req 恢复数据请求
+// 返回值：空响应，错误信息
+func (s *TrashServiceService) RestoreTrash(ctx context.Context, req *pb.TrashRequest) (*emptypb.Empty, error) {
+	if err := s.tuc.Restore(ctx, req); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// PurgeTrash 处理彻底删除数据请求
+// 参数：ctx 上下文，req 彻底删除数据请求
+// 返回值：空响应，错误信息
+func (s *TrashServiceService) PurgeTrash(ctx context.Context, req *pb.TrashRequest) (*emptypb.Empty, error) {
+	if err := s.tuc.Purge(ctx, req); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
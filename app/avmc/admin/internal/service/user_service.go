@@ -3,8 +3,6 @@ package service
 import (
 	"context"
 
-	"github.com/go-kratos/kratos/v2/errors"
-
 	pb "backend-service/api/avmc/admin/v1"
 	pbPagination "backend-service/api/common/pagination"
 	pbCore "backend-service/api/core/service/v1"
@@ -18,15 +16,17 @@ import (
 type UserServiceService struct {
 	pb.UnimplementedUserServiceServer
 	uuc *biz.UserUsecase
+	huc *biz.HistoryUsecase
 	log *log.Helper
 }
 
 // NewUserServiceService 创建新的用户服务实例
-// 参数：uuc 业务用例实例，logger 日志记录器
+// 参数：uuc 业务用例实例，huc 变更历史业务用例实例，logger 日志记录器
 // 返回值：用户服务实例指针
-func NewUserServiceService(uuc *biz.UserUsecase, logger log.Logger) *UserServiceService {
+func NewUserServiceService(uuc *biz.UserUsecase, huc *biz.HistoryUsecase, logger log.Logger) *UserServiceService {
 	return &UserServiceService{
 		uuc: uuc,
+		huc: huc,
 		log: log.NewHelper(logger),
 	}
 }
@@ -52,7 +52,7 @@ func (s *UserServiceService) ListUser(ctx context.Context, req *pbPagination.Pag
 // 返回值：用户详情响应，错误信息
 func (s *UserServiceService) GetUser(ctx context.Context, req *pbCore.GetUserRequest) (*pbCore.User, error) {
 	if req.Id == 0 {
-		return nil, errors.New(1001, "用户ID不能为空", "user id is required")
+		return nil, biz.ErrUserInvalidID
 	}
 	s.log.Infof("获取用户详情，用户ID：%v", req.Id)
 	return s.uuc.Get(ctx, req.Id)
@@ -83,6 +83,9 @@ func (s *UserServiceService) UpdateUser(ctx context.Context, req *pbCore.UpdateU
 	if req.GetUser() == nil {
 		return nil, pb.ErrorUserInvalidId("用户信息不能为空")
 	}
+	if req.GetUser().Version == nil {
+		return nil, pb.ErrorBadRequest("版本号不能为空")
+	}
 	req.User.Id = req.GetId()
 	if req.GetOperatorId() == 0 {
 		// return nil, pb.ErrorUserInvalidOperatorId("操作人ID不能为空")
@@ -109,3 +112,10 @@ func (s *UserServiceService) DeleteUser(ctx context.Context, req *pbCore.DeleteU
 	}
 	return &pbCore.DeleteUserResponse{}, nil
 }
+
+// ListUserHistory 处理查询用户变更历史请求
+// 参数：ctx 上下文，req 查询变更历史请求
+// 返回值：查询变更历史响应，错误信息
+func (s *UserServiceService) ListUserHistory(ctx context.Context, req *pb.ListHistoryRequest) (*pb.ListHistoryResponse, error) {
+	return s.huc.List(ctx, biz.HistoryResourceUser, req)
+}
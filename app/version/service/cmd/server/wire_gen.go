@@ -34,10 +34,21 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	releaseRepo := data.NewReleaseRepo(dataData, logger)
 	releaseUsecase := biz.NewReleaseUsecase(releaseRepo, logger)
 	releaseService := service.NewReleaseService(releaseUsecase, logger)
-	grpcServer := server.NewGRPCServer(confServer, releaseService, logger)
+	authenticator, err := data.NewAuthenticator(confServer)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	authorizer, cleanup2, err := data.NewAuthorizer(confServer, redisClient, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer := server.NewGRPCServer(confServer, releaseService, authenticator, authorizer, logger)
 	httpServer := server.NewHTTPServer(confServer, releaseService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	pbCore "backend-service/api/core/service/v1"
+	"backend-service/app/version/service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+
+	authnEngine "backend-service/pkg/auth/authn"
+	authnJwt "backend-service/pkg/auth/authn/jwt"
+	authzEngine "backend-service/pkg/auth/authz"
+	authzCache "backend-service/pkg/auth/authz/cache"
+	authzRemote "backend-service/pkg/auth/authz/remote"
+)
+
+// NewAuthenticator 创建身份验证器，与后台管理服务共用 JWT 签名配置
+// 未配置 grpc.middleware.auth 时返回 nil，服务不启用认证与鉴权
+func NewAuthenticator(c *conf.Server) (authnEngine.Authenticator, error) {
+	authCfg := c.GetGrpc().GetMiddleware().GetAuth()
+	if authCfg.GetKey() == "" {
+		return nil, nil
+	}
+	return authnJwt.NewProvider().NewAuthenticator(
+		context.Background(),
+		authnEngine.WithSigningKey([]byte(authCfg.GetKey())),
+		authnEngine.WithSigningMethod(authCfg.GetMethod()),
+	)
+}
+
+// NewAuthorizer 创建远程授权器，授权决策委托给 grpc.middleware.authorizer.remote 指定的授权服务
+// 未启用认证时返回 nil；启用认证但未配置远程授权服务时返回错误，避免服务在无鉴权的情况下运行
+func NewAuthorizer(c *conf.Server, rdb *redis.Client, logger log.Logger) (authzEngine.Authorizer, func(), error) {
+	l := log.NewHelper(log.With(logger, "module", "authorizer/auth/initialize"))
+	mw := c.GetGrpc().GetMiddleware()
+	if mw.GetAuth().GetKey() == "" {
+		return nil, func() {}, nil
+	}
+	authzCfg := mw.GetAuthorizer()
+	if authzEngine.Mode(authzCfg.GetType()) != authzEngine.ModeRemote || authzCfg.GetRemote().GetEndpoint() == "" {
+		return nil, nil, fmt.Errorf("authorizer: remote authorizer endpoint is required when auth is enabled")
+	}
+
+	remoteCfg := authzCfg.GetRemote()
+	opts := []authzEngine.Option{
+		authzEngine.WithRemoteURL(remoteCfg.GetEndpoint()),
+		authzRemote.WithClientFactory(newCoreAuthzClient),
+		authzRemote.WithToken(remoteCfg.GetToken()),
+	}
+	if timeout := remoteCfg.GetTimeout().AsDuration(); timeout > 0 {
+		opts = append(opts, authzRemote.WithTimeout(timeout))
+	}
+	if cacheCfg := authzCfg.GetCache(); cacheCfg.GetEnable() {
+		opts = append(opts,
+			authzEngine.WithEnableCache(true),
+			authzEngine.WithCacheType(cacheCfg.GetType()),
+			authzEngine.WithCacheOption(authzCache.OptionClient, rdb),
+		)
+		if size := cacheCfg.GetSize(); size > 0 {
+			opts = append(opts, authzEngine.WithCacheOption(authzCache.OptionSize, int(size)))
+		}
+		if ttl := cacheCfg.GetTtl().AsDuration(); ttl > 0 {
+			opts = append(opts, authzEngine.WithCacheOption(authzCache.OptionTTL, ttl))
+		}
+	}
+
+	authorizer, err := authzRemote.NewProvider().NewAuthorizer(context.Background(), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		if err := authorizer.Close(); err != nil {
+			l.Error(err)
+		}
+	}
+	return authorizer, cleanup, nil
+}
+
+// coreAuthzClient 通过核心认证服务的 IsAuthorized 接口查询授权决策
+type coreAuthzClient struct {
+	client pbCore.AuthServiceClient
+}
+
+// newCoreAuthzClient 基于 gRPC 连接创建核心认证服务客户端
+func newCoreAuthzClient(conn grpc.ClientConnInterface) authzRemote.Client {
+	return &coreAuthzClient{client: pbCore.NewAuthServiceClient(conn)}
+}
+
+// IsAuthorized 查询主体能否在域内对对象执行操作，对象与域对应请求中的 resource 与 project
+func (c *coreAuthzClient) IsAuthorized(ctx context.Context, sub authzEngine.Subject, obj authzEngine.Object, act authzEngine.Action, domain authzEngine.Domain) (bool, error) {
+	reply, err := c.client.IsAuthorized(ctx, &pbCore.IsAuthorizedRequest{
+		Subject:  string(sub),
+		Action:   string(act),
+		Resource: string(obj),
+		Project:  string(domain),
+	})
+	if err != nil {
+		return false, err
+	}
+	return reply.GetAllowed(), nil
+}
@@ -19,7 +19,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewEntClient, NewRedisClient, NewSnowflake, NewTransaction, NewReleaseRepo)
+var ProviderSet = wire.NewSet(NewData, NewEntClient, NewRedisClient, NewSnowflake, NewTransaction, NewReleaseRepo, NewAuthenticator, NewAuthorizer)
 
 // Data .
 type Data struct {
@@ -1,21 +1,53 @@
 package server
 
 import (
+	"context"
+
 	v1 "backend-service/api/version/service/v1"
 	"backend-service/app/version/service/internal/conf"
 	"backend-service/app/version/service/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+
+	authnEngine "backend-service/pkg/auth/authn"
+	authzEngine "backend-service/pkg/auth/authz"
+	authMiddleware "backend-service/pkg/auth/middleware"
 )
 
+// grpcAuthzInfoExtractor gRPC授权信息提取器，域取自认证声明中的 dom，缺少认证声明时拒绝访问，与后台管理服务保持一致
+func grpcAuthzInfoExtractor(ctx context.Context, fullMethod string) (authzEngine.Subject, authzEngine.Object, authzEngine.Action, authzEngine.Domain, error) {
+	claims, ok := authnEngine.AuthClaimsFromContext(ctx)
+	if !ok || claims == nil {
+		return "", "", "", "", authMiddleware.ErrIncompleteAuthzInfo
+	}
+	sub, obj, act, _, err := authMiddleware.DefaultGRPCAuthzInfoExtractor(ctx, fullMethod)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	return sub, obj, act, authzEngine.Domain(claims.GetDomain()), nil
+}
+
+// newGRPCMiddleware 创建中间件，未启用认证时不校验令牌与权限
+func newGRPCMiddleware(authenticator authnEngine.Authenticator, authorizer authzEngine.Authorizer) []middleware.Middleware {
+	ms := []middleware.Middleware{recovery.Recovery()}
+	if authenticator != nil && authorizer != nil {
+		ms = append(ms,
+			authMiddleware.GRPCAuthnMiddleware(authenticator, nil),
+			authMiddleware.GRPCAuthzMiddleware(authorizer, grpcAuthzInfoExtractor),
+		)
+	}
+	return ms
+}
+
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, release *service.ReleaseService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, release *service.ReleaseService,
+	authenticator authnEngine.Authenticator, authorizer authzEngine.Authorizer,
+	logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
-		grpc.Middleware(
-			recovery.Recovery(),
-		),
+		grpc.Middleware(newGRPCMiddleware(authenticator, authorizer)...),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -175,3 +175,65 @@ type AuthzProvider interface {
 	// 返回: 授权器实例和可能的错误
 	NewAuthorizer(ctx context.Context, opts ...Option) (Authorizer, error)
 }
+
+// RoleBinding 角色绑定，表示用户在域内拥有的角色（对应 casbin 的 g 规则）
+type RoleBinding struct {
+	// User 用户
+	User Subject
+	// Role 角色
+	Role Subject
+	// Domain 域
+	Domain Domain
+}
+
+// PolicyManager 策略管理接口，授权器可选实现，用于列出与导出全部规则
+type PolicyManager interface {
+	// GetPolicies 获取策略
+	// ctx: 上下文信息
+	// domain: 域，为空时返回全部域的策略
+	// 返回: 策略列表和可能的错误
+	GetPolicies(ctx context.Context, domain Domain) ([]Policy, error)
+
+	// GetRoleBindings 获取角色绑定
+	// ctx: 上下文信息
+	// domain: 域，为空时返回全部域的角色绑定
+	// 返回: 角色绑定列表和可能的错误
+	GetRoleBindings(ctx context.Context, domain Domain) ([]RoleBinding, error)
+
+	// GetFilteredPolicies 按条件获取策略
+	// ctx: 上下文信息
+	// filter: 过滤条件，为空的字段不限制
+	// 返回: 策略列表和可能的错误
+	GetFilteredPolicies(ctx context.Context, filter Policy) ([]Policy, error)
+
+	// GetFilteredRoleBindings 按条件获取角色绑定
+	// ctx: 上下文信息
+	// filter: 过滤条件，为空的字段不限制
+	// 返回: 角色绑定列表和可能的错误
+	GetFilteredRoleBindings(ctx context.Context, filter RoleBinding) ([]RoleBinding, error)
+
+	// AddRoleBindings 批量添加角色绑定，一次写入存储
+	// ctx: 上下文信息
+	// bindings: 角色绑定列表
+	// 返回: 是否有新增和可能的错误
+	AddRoleBindings(ctx context.Context, bindings []RoleBinding) (bool, error)
+
+	// RemoveRoleBindings 批量移除角色绑定，一次写入存储
+	// ctx: 上下文信息
+	// bindings: 角色绑定列表
+	// 返回: 是否有移除和可能的错误
+	RemoveRoleBindings(ctx context.Context, bindings []RoleBinding) (bool, error)
+}
+
+// Match 判断策略是否满足过滤条件，过滤条件中为空的字段不限制
+func (f Policy) Match(p Policy) bool {
+	return (f.Subject == "" || f.Subject == p.Subject) && (f.Object == "" || f.Object == p.Object) &&
+		(f.Action == "" || f.Action == p.Action) && (f.Domain == "" || f.Domain == p.Domain) &&
+		(f.Effect == "" || f.Effect == p.Effect)
+}
+
+// Match 判断角色绑定是否满足过滤条件，过滤条件中为空的字段不限制
+func (f RoleBinding) Match(b RoleBinding) bool {
+	return (f.User == "" || f.User == b.User) && (f.Role == "" || f.Role == b.Role) &&
+		(f.Domain == "" || f.Domain == b.Domain)
+}
@@ -0,0 +1,198 @@
+// Package cache 授权决策缓存，按 (主体, 对象, 操作, 域) 缓存 Enforce 结果
+// 本地 LRU 为一级缓存，可选 Redis 作为多实例共享的二级缓存
+package cache
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// defaultSize 本地缓存默认容量
+	defaultSize = 10000
+	// defaultTTL 缓存默认有效期
+	defaultTTL = 5 * time.Minute
+	// defaultRedisPrefix Redis 缓存键默认前缀
+	defaultRedisPrefix = "authz:decision"
+)
+
+// Key 缓存键
+type Key struct {
+	Subject string
+	Object  string
+	Action  string
+	Domain  string
+}
+
+// Stats 缓存命中统计
+type Stats struct {
+	// Hits 命中次数
+	Hits uint64
+	// Misses 未命中次数
+	Misses uint64
+}
+
+// Option 缓存选项
+type Option func(*options)
+
+type options struct {
+	size        int
+	ttl         time.Duration
+	redis       redis.UniversalClient
+	redisPrefix string
+	meter       metric.Meter
+}
+
+// WithSize 设置本地缓存容量
+func WithSize(size int) Option {
+	return func(o *options) {
+		o.size = size
+	}
+}
+
+// WithTTL 设置缓存有效期
+func WithTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.ttl = ttl
+	}
+}
+
+// WithRedis 启用 Redis 二级缓存，prefix 为空时使用默认前缀
+func WithRedis(client redis.UniversalClient, prefix string) Option {
+	return func(o *options) {
+		o.redis = client
+		if prefix != "" {
+			o.redisPrefix = prefix
+		}
+	}
+}
+
+// WithMeter 设置指标采集器，默认使用全局 MeterProvider
+func WithMeter(meter metric.Meter) Option {
+	return func(o *options) {
+		o.meter = meter
+	}
+}
+
+// DecisionCache 授权决策缓存
+type DecisionCache struct {
+	local  *lru
+	remote *redisStore
+
+	// epoch 每次失效递增，写入时校验，避免并发失效后写入过期决策
+	epoch atomic.Uint64
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+
+	hitCounter  metric.Int64Counter
+	missCounter metric.Int64Counter
+}
+
+// New 创建授权决策缓存
+func New(opts ...Option) *DecisionCache {
+	o := &options{
+		size:        defaultSize,
+		ttl:         defaultTTL,
+		redisPrefix: defaultRedisPrefix,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.meter == nil {
+		o.meter = otel.Meter("backend-service/pkg/auth/authz/cache")
+	}
+
+	c := &DecisionCache{local: newLRU(o.size, o.ttl)}
+	if o.redis != nil {
+		c.remote = newRedisStore(o.redis, o.redisPrefix, o.ttl)
+	}
+	// 指标创建失败时返回的是可用的空实现，无需处理错误
+	c.hitCounter, _ = o.meter.Int64Counter("authz.cache.hits", metric.WithDescription("authorization decision cache hits"))
+	c.missCounter, _ = o.meter.Int64Counter("authz.cache.misses", metric.WithDescription("authorization decision cache misses"))
+	return c
+}
+
+// Get 查询缓存的决策，本地未命中时查询 Redis 并回填本地缓存
+func (c *DecisionCache) Get(ctx context.Context, key Key) (allowed bool, found bool) {
+	if allowed, found = c.local.get(key); found {
+		c.hit(ctx, "local")
+		return allowed, true
+	}
+	if c.remote != nil {
+		epoch := c.epoch.Load()
+		if allowed, found = c.remote.get(ctx, key); found {
+			if c.epoch.Load() == epoch {
+				c.local.set(key, allowed)
+			}
+			c.hit(ctx, "redis")
+			return allowed, true
+		}
+	}
+	c.misses.Add(1)
+	c.missCounter.Add(ctx, 1)
+	return false, false
+}
+
+// Epoch 失效版本，计算决策前获取，写入时传回
+type Epoch struct {
+	// local 本实例的失效版本
+	local uint64
+	// remote Redis 中的策略版本，未启用 Redis 或读取失败时为 -1
+	remote int64
+}
+
+// Epoch 返回当前失效版本，计算决策前获取，写入时传回
+func (c *DecisionCache) Epoch(ctx context.Context) Epoch {
+	e := Epoch{local: c.epoch.Load(), remote: -1}
+	if c.remote != nil {
+		e.remote = c.remote.version(ctx)
+	}
+	return e
+}
+
+// Set 写入决策，期间本实例发生过失效时放弃写入
+// Redis 中的决策以计算前的策略版本标记，期间任一实例发生过失效时读取按未命中处理
+func (c *DecisionCache) Set(ctx context.Context, key Key, allowed bool, epoch Epoch) {
+	if c.epoch.Load() != epoch.local {
+		return
+	}
+	c.local.set(key, allowed)
+	if c.remote != nil && epoch.remote >= 0 {
+		c.remote.set(ctx, key, allowed, epoch.remote)
+	}
+}
+
+// Invalidate 使主体在域内的决策失效，object、action 均为空时使该主体在域内的全部决策失效
+func (c *DecisionCache) Invalidate(ctx context.Context, subject, domain, object, action string) {
+	c.epoch.Add(1)
+	c.local.invalidate(subject, domain, object, action)
+	if c.remote != nil {
+		c.remote.invalidate(ctx, subject, domain, object, action)
+	}
+}
+
+// Clear 清空全部决策
+func (c *DecisionCache) Clear(ctx context.Context) {
+	c.epoch.Add(1)
+	c.local.clear()
+	if c.remote != nil {
+		c.remote.clear(ctx)
+	}
+}
+
+// Stats 返回命中统计
+func (c *DecisionCache) Stats() Stats {
+	return Stats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+}
+
+func (c *DecisionCache) hit(ctx context.Context, tier string) {
+	c.hits.Add(1)
+	c.hitCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("tier", tier)))
+}
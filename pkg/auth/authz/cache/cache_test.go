@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecisionCache(t *testing.T) {
+	ctx := context.Background()
+	c := New()
+	read := Key{Subject: "alice", Object: "/api/users", Action: "GET", Domain: "default"}
+	write := Key{Subject: "alice", Object: "/api/users", Action: "POST", Domain: "default"}
+	other := Key{Subject: "bob", Object: "/api/users", Action: "GET", Domain: "default"}
+
+	_, found := c.Get(ctx, read)
+	assert.False(t, found)
+	c.Set(ctx, read, true, c.Epoch(ctx))
+	c.Set(ctx, write, false, c.Epoch(ctx))
+	allowed, found := c.Get(ctx, read)
+	assert.True(t, found)
+	assert.True(t, allowed)
+	assert.Equal(t, Stats{Hits: 1, Misses: 1}, c.Stats())
+
+	// 精确失效只影响指定的对象与操作
+	c.Invalidate(ctx, "alice", "default", "/api/users", "POST")
+	_, found = c.Get(ctx, write)
+	assert.False(t, found)
+	_, found = c.Get(ctx, read)
+	assert.True(t, found)
+
+	// 主体失效影响该主体在域内的全部决策
+	c.Invalidate(ctx, "alice", "default", "", "")
+	_, found = c.Get(ctx, read)
+	assert.False(t, found)
+
+	// 计算期间发生失效时放弃写入
+	epoch := c.Epoch(ctx)
+	c.Invalidate(ctx, "bob", "default", "", "")
+	c.Set(ctx, other, true, epoch)
+	_, found = c.Get(ctx, other)
+	assert.False(t, found)
+
+	// 超出容量时淘汰最久未使用的条目
+	small := New(WithSize(2))
+	small.Set(ctx, read, true, small.Epoch(ctx))
+	small.Set(ctx, write, false, small.Epoch(ctx))
+	_, _ = small.Get(ctx, read)
+	small.Set(ctx, other, true, small.Epoch(ctx))
+	_, found = small.Get(ctx, write)
+	assert.False(t, found)
+	_, found = small.Get(ctx, read)
+	assert.True(t, found)
+}
+
+func TestDecisionCacheTTL(t *testing.T) {
+	ctx := context.Background()
+	c := New(WithTTL(10 * time.Millisecond))
+	key := Key{Subject: "alice", Object: "/api/users", Action: "GET", Domain: "default"}
+	c.Set(ctx, key, true, c.Epoch(ctx))
+	time.Sleep(20 * time.Millisecond)
+	_, found := c.Get(ctx, key)
+	assert.False(t, found)
+}
+
+func TestDecisionCacheRedis(t *testing.T) {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR not set")
+	}
+	ctx := context.Background()
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	defer client.Close()
+
+	writer := New(WithRedis(client, "authz:decision:test"))
+	reader := New(WithRedis(client, "authz:decision:test"))
+	defer writer.Clear(ctx)
+	key := Key{Subject: "alice", Object: "/api/users", Action: "GET", Domain: "default"}
+
+	writer.Set(ctx, key, true, writer.Epoch(ctx))
+	allowed, found := reader.Get(ctx, key)
+	require.True(t, found)
+	assert.True(t, allowed)
+
+	writer.Invalidate(ctx, "alice", "default", "", "")
+	reader.Clear(ctx)
+	_, found = reader.Get(ctx, key)
+	assert.False(t, found)
+
+	// 其它实例失效前开始计算、失效后写入的决策不被读取
+	other := New(WithRedis(client, "authz:decision:test"))
+	epoch := reader.Epoch(ctx)
+	writer.Invalidate(ctx, "alice", "default", "", "")
+	reader.Set(ctx, key, true, epoch)
+	_, found = other.Get(ctx, key)
+	assert.False(t, found)
+	other.Set(ctx, key, false, other.Epoch(ctx))
+	allowed, found = writer.Get(ctx, key)
+	require.True(t, found)
+	assert.False(t, allowed)
+}
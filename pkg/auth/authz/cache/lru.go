@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// subjectKey 主体索引键，用于按主体精确失效
+type subjectKey struct {
+	subject string
+	domain  string
+}
+
+type lruEntry struct {
+	key     Key
+	allowed bool
+	expires time.Time
+}
+
+// lru 带有效期的本地 LRU 缓存
+type lru struct {
+	mu       sync.Mutex
+	size     int
+	ttl      time.Duration
+	ll       *list.List
+	items    map[Key]*list.Element
+	subjects map[subjectKey]map[Key]struct{}
+}
+
+func newLRU(size int, ttl time.Duration) *lru {
+	return &lru{
+		size:     size,
+		ttl:      ttl,
+		ll:       list.New(),
+		items:    make(map[Key]*list.Element),
+		subjects: make(map[subjectKey]map[Key]struct{}),
+	}
+}
+
+func (c *lru) get(key Key) (bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return false, false
+	}
+	entry := el.Value.(*lruEntry)
+	if c.ttl > 0 && time.Now().After(entry.expires) {
+		c.remove(el)
+		return false, false
+	}
+	c.ll.MoveToFront(el)
+	return entry.allowed, true
+}
+
+func (c *lru) set(key Key, allowed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expires := time.Now().Add(c.ttl)
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*lruEntry)
+		entry.allowed, entry.expires = allowed, expires
+		c.ll.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, allowed: allowed, expires: expires})
+	sk := subjectKey{subject: key.Subject, domain: key.Domain}
+	if c.subjects[sk] == nil {
+		c.subjects[sk] = make(map[Key]struct{})
+	}
+	c.subjects[sk][key] = struct{}{}
+	for c.size > 0 && c.ll.Len() > c.size {
+		c.remove(c.ll.Back())
+	}
+}
+
+func (c *lru) invalidate(subject, domain, object, action string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if object != "" || action != "" {
+		if el, ok := c.items[Key{Subject: subject, Object: object, Action: action, Domain: domain}]; ok {
+			c.remove(el)
+		}
+		return
+	}
+	for key := range c.subjects[subjectKey{subject: subject, domain: domain}] {
+		c.remove(c.items[key])
+	}
+}
+
+func (c *lru) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ll.Init()
+	c.items = make(map[Key]*list.Element)
+	c.subjects = make(map[subjectKey]map[Key]struct{})
+}
+
+// remove 移除条目并维护主体索引，调用方需持有锁
+func (c *lru) remove(el *list.Element) {
+	key := c.ll.Remove(el).(*lruEntry).key
+	delete(c.items, key)
+	sk := subjectKey{subject: key.Subject, domain: key.Domain}
+	delete(c.subjects[sk], key)
+	if len(c.subjects[sk]) == 0 {
+		delete(c.subjects, sk)
+	}
+}
@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// 缓存类型，对应 authz.Options.CacheType
+const (
+	// TypeLocal 仅使用本地缓存
+	TypeLocal = "local"
+	// TypeRedis 本地缓存 + Redis 二级缓存
+	TypeRedis = "redis"
+)
+
+// 缓存选项键，对应 authz.Options.CacheOptions
+const (
+	// OptionSize 本地缓存容量（int）
+	OptionSize = "size"
+	// OptionTTL 缓存有效期（time.Duration）
+	OptionTTL = "ttl"
+	// OptionClient Redis 客户端（redis.UniversalClient）
+	OptionClient = "client"
+	// OptionPrefix Redis 缓存键前缀（string）
+	OptionPrefix = "prefix"
+)
+
+// NewFromOptions 根据授权器配置创建决策缓存，未启用缓存时返回 nil
+func NewFromOptions(o authz.Options) (*DecisionCache, error) {
+	if !o.EnableCache {
+		return nil, nil
+	}
+
+	var opts []Option
+	if size, ok := o.CacheOptions[OptionSize].(int); ok {
+		opts = append(opts, WithSize(size))
+	}
+	if ttl, ok := o.CacheOptions[OptionTTL].(time.Duration); ok {
+		opts = append(opts, WithTTL(ttl))
+	}
+
+	switch o.CacheType {
+	case "", TypeLocal:
+	case TypeRedis:
+		client, _ := o.CacheOptions[OptionClient].(redis.UniversalClient)
+		if client == nil {
+			return nil, authz.NewAuthzError(
+				authz.ErrCodeInvalidConfiguration,
+				"redis client is required for redis cache",
+				nil,
+			)
+		}
+		prefix, _ := o.CacheOptions[OptionPrefix].(string)
+		opts = append(opts, WithRedis(client, prefix))
+	default:
+		return nil, authz.NewAuthzError(
+			authz.ErrCodeInvalidConfiguration,
+			fmt.Sprintf("unsupported cache type: %s", o.CacheType),
+			nil,
+		)
+	}
+	return New(opts...), nil
+}
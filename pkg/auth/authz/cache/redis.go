@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisStore Redis 二级缓存，每个 (域, 主体) 对应一个哈希，字段为对象与操作
+// 任一实例失效时递增共享的策略版本，决策值带写入时的版本，读取时与当前版本不一致按未命中处理，
+// 避免其它实例以失效前的策略计算出的决策在失效后写入并长期生效
+// 缓存为尽力而为，Redis 错误按未命中处理
+type redisStore struct {
+	client redis.UniversalClient
+	prefix string
+	ttl    time.Duration
+}
+
+func newRedisStore(client redis.UniversalClient, prefix string, ttl time.Duration) *redisStore {
+	return &redisStore{client: client, prefix: prefix, ttl: ttl}
+}
+
+// hashKey 主体哈希键
+func (s *redisStore) hashKey(subject, domain string) string {
+	return s.prefix + ":" + domain + ":" + subject
+}
+
+// versionKey 策略版本键，哈希键总含域与主体两段，不会与之冲突
+func (s *redisStore) versionKey() string {
+	return s.prefix + ":version"
+}
+
+// field 哈希字段
+func field(object, action string) string {
+	return object + "\n" + action
+}
+
+// version 返回当前策略版本，不存在时为 0，读取失败时返回 -1
+func (s *redisStore) version(ctx context.Context) int64 {
+	version, err := s.client.Get(ctx, s.versionKey()).Int64()
+	switch {
+	case err == nil:
+		return version
+	case errors.Is(err, redis.Nil):
+		return 0
+	default:
+		return -1
+	}
+}
+
+// get 在一次往返中读取策略版本与决策，决策的版本与当前版本不一致时按未命中处理
+func (s *redisStore) get(ctx context.Context, key Key) (bool, bool) {
+	var version *redis.StringCmd
+	var value *redis.StringCmd
+	_, _ = s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		version = pipe.Get(ctx, s.versionKey())
+		value = pipe.HGet(ctx, s.hashKey(key.Subject, key.Domain), field(key.Object, key.Action))
+		return nil
+	})
+	current, err := version.Int64()
+	if errors.Is(err, redis.Nil) {
+		current, err = 0, nil
+	}
+	if err != nil {
+		return false, false
+	}
+	v, err := value.Result()
+	if err != nil {
+		return false, false
+	}
+	tagged, decision, ok := strings.Cut(v, ":")
+	if !ok || tagged != strconv.FormatInt(current, 10) {
+		return false, false
+	}
+	return decision == "1", true
+}
+
+// set 写入以 version 标记的决策
+func (s *redisStore) set(ctx context.Context, key Key, allowed bool, version int64) {
+	value := strconv.FormatInt(version, 10) + ":0"
+	if allowed {
+		value = strconv.FormatInt(version, 10) + ":1"
+	}
+	hashKey := s.hashKey(key.Subject, key.Domain)
+	_, _ = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, hashKey, field(key.Object, key.Action), value)
+		if s.ttl > 0 {
+			pipe.Expire(ctx, hashKey, s.ttl)
+		}
+		return nil
+	})
+}
+
+// invalidate 递增策略版本并删除主体的决策
+func (s *redisStore) invalidate(ctx context.Context, subject, domain, object, action string) {
+	s.client.Incr(ctx, s.versionKey())
+	hashKey := s.hashKey(subject, domain)
+	if object != "" || action != "" {
+		s.client.HDel(ctx, hashKey, field(object, action))
+		return
+	}
+	s.client.Del(ctx, hashKey)
+}
+
+// clear 递增策略版本，再扫描并删除全部决策键，逐个删除以兼容集群模式
+func (s *redisStore) clear(ctx context.Context) {
+	s.client.Incr(ctx, s.versionKey())
+	iter := s.client.Scan(ctx, 0, s.prefix+":*", 100).Iterator()
+	pipe := s.client.Pipeline()
+	for iter.Next(ctx) {
+		if iter.Val() == s.versionKey() {
+			continue
+		}
+		pipe.Del(ctx, iter.Val())
+		if pipe.Len() >= 100 {
+			_, _ = pipe.Exec(ctx)
+		}
+	}
+	_, _ = pipe.Exec(ctx)
+}
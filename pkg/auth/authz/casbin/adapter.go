@@ -0,0 +1,86 @@
+package casbin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/casbin/casbin/v2/persist"
+	fileadapter "github.com/casbin/casbin/v2/persist/file-adapter"
+	gormadapter "github.com/casbin/gorm-adapter/v3"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/redis/go-redis/v9"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// TxAdapter 可加入调用方事务的适配器
+// 写入处于调用方事务中时，授权器在事务提交后才更新内存中的策略并通知其它实例
+type TxAdapter interface {
+	// AfterCommit 上下文处于事务中时登记事务提交后的回调并返回 true，否则返回 false
+	AfterCommit(ctx context.Context, fn func(ctx context.Context)) bool
+}
+
+// newAdapter 根据配置创建策略适配器
+func newAdapter(options authz.Options) (persist.Adapter, error) {
+	if adapter, ok := providerOption[persist.Adapter](options, providerOptionAdapter); ok && adapter != nil {
+		return adapter, nil
+	}
+
+	switch options.AdapterType {
+	case authz.AdapterFile:
+		if options.AdapterDSN == "" {
+			return nil, authz.NewAuthzError(
+				authz.ErrCodeInvalidConfiguration,
+				"adapter DSN (policy file path) is required for file adapter",
+				nil,
+			)
+		}
+		return fileadapter.NewAdapter(options.AdapterDSN), nil
+	case authz.AdapterMemory:
+		return NewMemoryAdapter(), nil
+	case authz.AdapterMySQL, authz.AdapterPostgres:
+		if options.AdapterDSN == "" {
+			return nil, authz.NewAuthzError(
+				authz.ErrCodeInvalidConfiguration,
+				fmt.Sprintf("adapter DSN is required for %s adapter", options.AdapterType),
+				nil,
+			)
+		}
+		adapter, err := gormadapter.NewAdapter(string(options.AdapterType), options.AdapterDSN, true)
+		if err != nil {
+			return nil, authz.NewAuthzError(
+				authz.ErrCodeInitializationFailed,
+				fmt.Sprintf("failed to create %s adapter", options.AdapterType),
+				err,
+			)
+		}
+		return adapter, nil
+	case authz.AdapterRedis:
+		client, ok := providerOption[redis.UniversalClient](options, providerOptionRedisClient)
+		if !ok || client == nil {
+			if options.AdapterDSN == "" {
+				return nil, authz.NewAuthzError(
+					authz.ErrCodeInvalidConfiguration,
+					"redis client or adapter DSN is required for redis adapter",
+					nil,
+				)
+			}
+			opt, err := redis.ParseURL(options.AdapterDSN)
+			if err != nil {
+				return nil, authz.NewAuthzError(
+					authz.ErrCodeInvalidConfiguration,
+					"invalid redis adapter DSN",
+					err,
+				)
+			}
+			client = redis.NewClient(opt)
+		}
+		return NewRedisAdapter(client), nil
+	default:
+		return nil, authz.NewAuthzError(
+			authz.ErrCodeInvalidConfiguration,
+			fmt.Sprintf("unsupported adapter type: %s", options.AdapterType),
+			nil,
+		)
+	}
+}
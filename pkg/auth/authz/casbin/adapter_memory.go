@@ -0,0 +1,125 @@
+package casbin
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+)
+
+var (
+	_ persist.Adapter      = (*MemoryAdapter)(nil)
+	_ persist.BatchAdapter = (*MemoryAdapter)(nil)
+)
+
+// MemoryAdapter 内存策略适配器，策略保存在进程内，多个执行器共享同一实例时可模拟共享存储
+type MemoryAdapter struct {
+	mu    sync.RWMutex
+	rules [][]string
+}
+
+// NewMemoryAdapter 创建内存策略适配器
+func NewMemoryAdapter() *MemoryAdapter {
+	return &MemoryAdapter{}
+}
+
+// LoadPolicy 加载全部策略
+func (a *MemoryAdapter) LoadPolicy(m model.Model) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, rule := range a.rules {
+		if err := persist.LoadPolicyArray(rule, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SavePolicy 保存全部策略，覆盖已有策略
+func (a *MemoryAdapter) SavePolicy(m model.Model) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.rules = modelRules(m)
+	return nil
+}
+
+// AddPolicy 添加策略
+func (a *MemoryAdapter) AddPolicy(sec string, ptype string, rule []string) error {
+	return a.AddPolicies(sec, ptype, [][]string{rule})
+}
+
+// AddPolicies 批量添加策略，已存在的策略忽略
+func (a *MemoryAdapter) AddPolicies(sec string, ptype string, rules [][]string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, rule := range rules {
+		line := policyLine(ptype, rule)
+		if !slices.ContainsFunc(a.rules, func(r []string) bool { return slices.Equal(r, line) }) {
+			a.rules = append(a.rules, line)
+		}
+	}
+	return nil
+}
+
+// RemovePolicy 移除策略
+func (a *MemoryAdapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	return a.RemovePolicies(sec, ptype, [][]string{rule})
+}
+
+// RemovePolicies 批量移除策略
+func (a *MemoryAdapter) RemovePolicies(sec string, ptype string, rules [][]string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, rule := range rules {
+		line := policyLine(ptype, rule)
+		a.rules = slices.DeleteFunc(a.rules, func(r []string) bool { return slices.Equal(r, line) })
+	}
+	return nil
+}
+
+// RemoveFilteredPolicy 按字段过滤移除策略，空字段值表示不限制
+func (a *MemoryAdapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.rules = slices.DeleteFunc(a.rules, func(r []string) bool {
+		return matchFilteredRule(r, ptype, fieldIndex, fieldValues...)
+	})
+	return nil
+}
+
+// policyLine 组装策略行，首个元素为策略类型
+func policyLine(ptype string, rule []string) []string {
+	return append([]string{ptype}, rule...)
+}
+
+// modelRules 导出模型中的全部策略行
+func modelRules(m model.Model) [][]string {
+	var rules [][]string
+	for _, sec := range []string{"p", "g"} {
+		for ptype, ast := range m[sec] {
+			for _, rule := range ast.Policy {
+				rules = append(rules, policyLine(ptype, rule))
+			}
+		}
+	}
+	return rules
+}
+
+// matchFilteredRule 判断策略行是否匹配过滤条件
+func matchFilteredRule(line []string, ptype string, fieldIndex int, fieldValues ...string) bool {
+	if len(line) == 0 || line[0] != ptype {
+		return false
+	}
+	rule := line[1:]
+	for i, value := range fieldValues {
+		if value == "" {
+			continue
+		}
+		idx := fieldIndex + i
+		if idx >= len(rule) || rule[idx] != value {
+			return false
+		}
+	}
+	return true
+}
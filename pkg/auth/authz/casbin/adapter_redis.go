@@ -0,0 +1,172 @@
+package casbin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// defaultRedisKey Redis 适配器默认的策略键
+	defaultRedisKey = "casbin_rules"
+	// redisTxRetries 乐观锁事务因并发修改失败时的最大重试次数
+	redisTxRetries = 16
+)
+
+var (
+	_ persist.Adapter      = (*RedisAdapter)(nil)
+	_ persist.BatchAdapter = (*RedisAdapter)(nil)
+)
+
+// RedisAdapter Redis 策略适配器，策略以 JSON 数组形式保存在列表中
+type RedisAdapter struct {
+	client redis.UniversalClient
+	key    string
+}
+
+// RedisAdapterOption Redis 适配器选项
+type RedisAdapterOption func(*RedisAdapter)
+
+// WithRedisKey 设置策略列表的键名
+func WithRedisKey(key string) RedisAdapterOption {
+	return func(a *RedisAdapter) {
+		a.key = key
+	}
+}
+
+// NewRedisAdapter 创建 Redis 策略适配器
+func NewRedisAdapter(client redis.UniversalClient, opts ...RedisAdapterOption) *RedisAdapter {
+	a := &RedisAdapter{client: client, key: defaultRedisKey}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// LoadPolicy 加载全部策略
+func (a *RedisAdapter) LoadPolicy(m model.Model) error {
+	lines, err := a.client.LRange(context.Background(), a.key, 0, -1).Result()
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		var rule []string
+		if err := json.Unmarshal([]byte(line), &rule); err != nil {
+			return err
+		}
+		if err := persist.LoadPolicyArray(rule, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SavePolicy 保存全部策略，覆盖已有策略
+func (a *RedisAdapter) SavePolicy(m model.Model) error {
+	return a.replace(context.Background(), modelRules(m))
+}
+
+// AddPolicy 添加策略
+func (a *RedisAdapter) AddPolicy(sec string, ptype string, rule []string) error {
+	return a.AddPolicies(sec, ptype, [][]string{rule})
+}
+
+// AddPolicies 批量添加策略
+func (a *RedisAdapter) AddPolicies(sec string, ptype string, rules [][]string) error {
+	values := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		line, err := json.Marshal(policyLine(ptype, rule))
+		if err != nil {
+			return err
+		}
+		values = append(values, line)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return a.client.RPush(context.Background(), a.key, values...).Err()
+}
+
+// RemovePolicy 移除策略
+func (a *RedisAdapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	return a.RemovePolicies(sec, ptype, [][]string{rule})
+}
+
+// RemovePolicies 批量移除策略
+func (a *RedisAdapter) RemovePolicies(sec string, ptype string, rules [][]string) error {
+	ctx := context.Background()
+	_, err := a.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, rule := range rules {
+			line, err := json.Marshal(policyLine(ptype, rule))
+			if err != nil {
+				return err
+			}
+			pipe.LRem(ctx, a.key, 0, line)
+		}
+		return nil
+	})
+	return err
+}
+
+// RemoveFilteredPolicy 按字段过滤移除策略，空字段值表示不限制
+// 以 WATCH/MULTI 乐观锁读取并改写策略列表，读取后列表被并发修改时重试，避免覆盖其它实例的写入
+func (a *RedisAdapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	ctx := context.Background()
+	for i := 0; i < redisTxRetries; i++ {
+		err := a.client.Watch(ctx, func(tx *redis.Tx) error {
+			lines, err := tx.LRange(ctx, a.key, 0, -1).Result()
+			if err != nil {
+				return err
+			}
+			kept := make([]interface{}, 0, len(lines))
+			for _, line := range lines {
+				var rule []string
+				if err := json.Unmarshal([]byte(line), &rule); err != nil {
+					return err
+				}
+				if !matchFilteredRule(rule, ptype, fieldIndex, fieldValues...) {
+					kept = append(kept, line)
+				}
+			}
+			if len(kept) == len(lines) {
+				return nil
+			}
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.Del(ctx, a.key)
+				if len(kept) > 0 {
+					pipe.RPush(ctx, a.key, kept...)
+				}
+				return nil
+			})
+			return err
+		}, a.key)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+	}
+	return redis.TxFailedErr
+}
+
+// replace 以事务方式整体替换策略列表
+func (a *RedisAdapter) replace(ctx context.Context, rules [][]string) error {
+	values := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		line, err := json.Marshal(rule)
+		if err != nil {
+			return err
+		}
+		values = append(values, line)
+	}
+	_, err := a.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, a.key)
+		if len(values) > 0 {
+			pipe.RPush(ctx, a.key, values...)
+		}
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,112 @@
+package casbin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// testAdapterRoundTrip 通过一个授权器写入策略，另一个共享同一存储的授权器读取
+func testAdapterRoundTrip(t *testing.T, adapter persist.Adapter) {
+	ctx := context.Background()
+	provider := &CasbinProvider{}
+
+	writer, err := provider.NewAuthorizer(ctx, WithAdapter(adapter))
+	require.NoError(t, err)
+	ok, err := writer.AddPolicies(ctx, []authz.Policy{
+		{Subject: "alice", Object: "/api/users", Action: "GET", Domain: "default"},
+		{Subject: "alice", Object: "/api/users", Action: "DELETE", Domain: "default"},
+		{Subject: "bob", Object: "/api/users", Action: "GET", Domain: "default"},
+	})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = writer.RemovePolicy(ctx, authz.Policy{Subject: "alice", Object: "/api/users", Action: "DELETE", Domain: "default"})
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	reader, err := provider.NewAuthorizer(ctx, WithAdapter(adapter))
+	require.NoError(t, err)
+	allowed, _ := reader.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	assert.True(t, allowed)
+	allowed, _ = reader.Enforce(ctx, "alice", "/api/users", "DELETE", "default")
+	assert.False(t, allowed)
+
+	require.NoError(t, adapter.RemoveFilteredPolicy("p", "p", 0, "bob"))
+	reader, err = provider.NewAuthorizer(ctx, WithAdapter(adapter))
+	require.NoError(t, err)
+	allowed, _ = reader.Enforce(ctx, "bob", "/api/users", "GET", "default")
+	assert.False(t, allowed)
+	allowed, _ = reader.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	assert.True(t, allowed)
+}
+
+func TestMemoryAdapter(t *testing.T) {
+	testAdapterRoundTrip(t, NewMemoryAdapter())
+}
+
+func TestRedisAdapter(t *testing.T) {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR not set")
+	}
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	defer client.Close()
+	adapter := NewRedisAdapter(client, WithRedisKey("casbin_rules_test"))
+	defer client.Del(context.Background(), "casbin_rules_test")
+	require.NoError(t, client.Del(context.Background(), "casbin_rules_test").Err())
+	testAdapterRoundTrip(t, adapter)
+}
+
+func TestRedisAdapterConcurrentRemoveFiltered(t *testing.T) {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR not set")
+	}
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	defer client.Close()
+	adapter := NewRedisAdapter(client, WithRedisKey("casbin_rules_concurrent_test"))
+	defer client.Del(context.Background(), "casbin_rules_concurrent_test")
+	require.NoError(t, client.Del(context.Background(), "casbin_rules_concurrent_test").Err())
+
+	// 过滤移除与其它写入并发执行时，不得丢失并发写入的策略
+	const n = 50
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, adapter.AddPolicy("p", "p", []string{"alice", fmt.Sprintf("/api/%d", i), "GET", "default", "allow"}))
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, adapter.AddPolicy("p", "p", []string{"bob", fmt.Sprintf("/api/%d", i), "GET", "default", "allow"}))
+			assert.NoError(t, adapter.RemoveFilteredPolicy("p", "p", 0, "bob"))
+		}(i)
+	}
+	wg.Wait()
+	require.NoError(t, adapter.RemoveFilteredPolicy("p", "p", 0, "bob"))
+
+	lines, err := client.LRange(context.Background(), "casbin_rules_concurrent_test", 0, -1).Result()
+	require.NoError(t, err)
+	assert.Len(t, lines, n)
+}
+
+func TestNewAdapter(t *testing.T) {
+	adapter, err := newAdapter(authz.Options{AdapterType: authz.AdapterMemory})
+	require.NoError(t, err)
+	assert.IsType(t, &MemoryAdapter{}, adapter)
+
+	_, err = newAdapter(authz.Options{AdapterType: authz.AdapterPostgres})
+	assert.Error(t, err)
+	_, err = newAdapter(authz.Options{AdapterType: authz.AdapterMongoDB})
+	assert.Error(t, err)
+}
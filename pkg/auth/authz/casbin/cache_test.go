@@ -0,0 +1,99 @@
+package casbin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// cacheOptions 启用决策缓存及其依赖的观察者
+func cacheOptions() []authz.Option {
+	return []authz.Option{
+		authz.WithEnableCache(true),
+		authz.WithEnableWatcher(true),
+		authz.WithWatcherType(WatcherLocal),
+		authz.WithWatcherOption(WatcherOptionBus, NewLocalBus()),
+	}
+}
+
+func TestEnforceCacheRequiresWatcher(t *testing.T) {
+	_, err := NewProvider().NewAuthorizer(context.Background(), authz.WithEnableCache(true))
+	code, _ := authz.GetAuthzErrorCode(err)
+	assert.Equal(t, authz.ErrCodeInvalidConfiguration, code)
+}
+
+func TestEnforceCache(t *testing.T) {
+	ctx := context.Background()
+	a, err := NewProvider().NewAuthorizer(ctx, cacheOptions()...)
+	require.NoError(t, err)
+	defer a.Close()
+
+	_, err = a.AddPolicy(ctx, authz.Policy{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "default"})
+	require.NoError(t, err)
+	_, err = a.AddRoleForUser(ctx, "manager", "admin", "default")
+	require.NoError(t, err)
+	_, err = a.AddRoleForUser(ctx, "alice", "manager", "default")
+	require.NoError(t, err)
+
+	allowed, _ := a.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	assert.True(t, allowed)
+	allowed, _ = a.Enforce(ctx, "alice", "/api/users", "DELETE", "default")
+	assert.False(t, allowed)
+
+	// 角色策略变更使间接继承该角色的用户的缓存失效
+	_, err = a.AddPolicy(ctx, authz.Policy{Subject: "admin", Object: "/api/users", Action: "DELETE", Domain: "default"})
+	require.NoError(t, err)
+	allowed, _ = a.Enforce(ctx, "alice", "/api/users", "DELETE", "default")
+	assert.True(t, allowed)
+
+	// 解除角色后用户在域内的缓存全部失效
+	_, err = a.DeleteRoleForUser(ctx, "alice", "manager", "default")
+	require.NoError(t, err)
+	allowed, _ = a.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	assert.False(t, allowed)
+
+	stats := a.(*CasbinAuthorizer).cache.Stats()
+	assert.Zero(t, stats.Hits)
+	assert.Equal(t, uint64(4), stats.Misses)
+	_, _ = a.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	assert.Equal(t, uint64(1), a.(*CasbinAuthorizer).cache.Stats().Hits)
+}
+
+func BenchmarkEnforce(b *testing.B) {
+	for _, enableCache := range []bool{false, true} {
+		b.Run(fmt.Sprintf("cache=%t", enableCache), func(b *testing.B) {
+			ctx := context.Background()
+			var opts []authz.Option
+			if enableCache {
+				opts = cacheOptions()
+			}
+			a, err := NewProvider().NewAuthorizer(ctx, opts...)
+			require.NoError(b, err)
+			defer a.Close()
+
+			policies := make([]authz.Policy, 0, 1000)
+			for i := 0; i < 1000; i++ {
+				policies = append(policies, authz.Policy{
+					Subject: authz.Subject(fmt.Sprintf("role%d", i%50)),
+					Object:  authz.Object(fmt.Sprintf("/api/resource/%d", i)),
+					Action:  "GET",
+					Domain:  "default",
+				})
+			}
+			_, err = a.AddPolicies(ctx, policies)
+			require.NoError(b, err)
+			_, err = a.AddRoleForUser(ctx, "alice", "role7", "default")
+			require.NoError(b, err)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = a.Enforce(ctx, "alice", "/api/resource/507", "GET", "default")
+			}
+		})
+	}
+}
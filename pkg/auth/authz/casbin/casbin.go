@@ -2,16 +2,18 @@ package casbin
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	stdcasbin "github.com/casbin/casbin/v2"
 	"github.com/casbin/casbin/v2/model"
 	"github.com/casbin/casbin/v2/persist"
-	fileadapter "github.com/casbin/casbin/v2/persist/file-adapter"
-	gormadapter "github.com/casbin/gorm-adapter/v3"
-	_ "github.com/go-sql-driver/mysql"
 
 	"backend-service/pkg/auth/authz"
+	"backend-service/pkg/auth/authz/cache"
 )
 
 // 默认的RBAC模型定义
@@ -32,14 +34,30 @@ e = some(where (p.eft == allow)) && !some(where (p.eft == deny))
 m = g(r.sub, p.sub, r.dom) && r.obj == p.obj && r.act == p.act && r.dom == p.dom
 `
 
-var _ authz.Authorizer = (*CasbinAuthorizer)(nil)
+var (
+	_ authz.Authorizer    = (*CasbinAuthorizer)(nil)
+	_ authz.PolicyManager = (*CasbinAuthorizer)(nil)
+	_ authz.Explainer     = (*CasbinAuthorizer)(nil)
+)
 
 // CasbinAuthorizer Casbin授权器实现
 type CasbinAuthorizer struct {
 	// options 配置选项
 	options authz.Options
 	// enforcer Casbin执行器
-	enforcer stdcasbin.IEnforcer
+	enforcer stdcasbin.IDistributedEnforcer
+	// adapter 策略适配器，实现 persist.ContextBatchAdapter 时以请求上下文写入
+	adapter persist.Adapter
+	// writeMu 串行化经由上下文适配器的写入，保证检查、持久化与更新内存的一致性
+	writeMu sync.Mutex
+	// watcher 策略变更观察者
+	watcher persist.Watcher
+	// stopReload 停止定期重新加载策略
+	stopReload chan struct{}
+	// cache 授权决策缓存，未启用时为 nil
+	cache *cache.DecisionCache
+	// preciseInvalidation 是否按策略精确失效缓存，仅默认模型的字段布局已知
+	preciseInvalidation bool
 }
 
 // CasbinProvider Casbin授权提供者
@@ -114,43 +132,15 @@ func (a *CasbinAuthorizer) Init(ctx context.Context, opts ...authz.Option) error
 	}
 
 	// 加载适配器
-	var adapter persist.Adapter
-	switch a.options.AdapterType {
-	case authz.AdapterFile:
-		if a.options.AdapterDSN == "" {
-			return authz.NewAuthzError(
-				authz.ErrCodeInvalidConfiguration,
-				"adapter DSN (policy file path) is required for file adapter",
-				nil,
-			)
-		}
-		adapter = fileadapter.NewAdapter(a.options.AdapterDSN)
-	case authz.AdapterMemory:
-	case authz.AdapterMySQL:
-		// println("MySQL adapter", a.options.AdapterDSN)
-		if a.options.AdapterDSN == "" {
-			return authz.NewAuthzError(
-				authz.ErrCodeInvalidConfiguration,
-				"adapter DSN (MySQL connection string) is required for MySQL adapter",
-				nil,
-			)
-		}
-		adapter, _ = gormadapter.NewAdapter("mysql", a.options.AdapterDSN, true) // Your driver and data source.
-	default:
-		// 其他适配器类型需要在实际应用中实现
-		return authz.NewAuthzError(
-			authz.ErrCodeInvalidConfiguration,
-			fmt.Sprintf("unsupported adapter type: %s", a.options.AdapterType),
-			nil,
-		)
+	adapter, err := newAdapter(a.options)
+	if err != nil {
+		return err
 	}
 
 	// 创建执行器
-	if adapter != nil {
-		a.enforcer, err = stdcasbin.NewEnforcer(m, adapter)
-	} else {
-		a.enforcer, err = stdcasbin.NewEnforcer(m)
-	}
+	// 使用并发安全的执行器，观察者回调与定期重新加载会与请求并发修改策略
+	a.adapter = adapter
+	a.enforcer, err = stdcasbin.NewDistributedEnforcer(m, adapter)
 
 	if err != nil {
 		return authz.NewAuthzError(
@@ -168,14 +158,308 @@ func (a *CasbinAuthorizer) Init(ctx context.Context, opts ...authz.Option) error
 		a.enforcer.EnableLog(true)
 	}
 
-	// 启用自动通知观察者
-	if a.options.EnableWatcher && a.options.AutoNotifyWatcher {
-		a.enforcer.EnableAutoNotifyWatcher(true)
+	// 启用决策缓存，缓存依赖观察者得知其它实例的策略变更并失效，未启用观察者时拒绝启动
+	if a.options.EnableCache && !a.options.EnableWatcher {
+		return authz.NewAuthzError(
+			authz.ErrCodeInvalidConfiguration,
+			"watcher is required when decision cache is enabled",
+			nil,
+		)
+	}
+	if a.cache, err = cache.NewFromOptions(a.options); err != nil {
+		return err
+	}
+	a.preciseInvalidation = a.options.ModelFormat == authz.ModelFormatText &&
+		(a.options.ModelText == "" || a.options.ModelText == defaultRBACModel)
+
+	// 启用观察者，其它实例的策略变更增量应用到本实例
+	if a.options.EnableWatcher {
+		if a.watcher, err = buildWatcher(ctx, a.options); err != nil {
+			return err
+		}
+		if err = a.enforcer.SetWatcher(a.watcher); err != nil {
+			return authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to set watcher", err)
+		}
+		if err = a.watcher.SetUpdateCallback(a.applyUpdate); err != nil {
+			return authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to set watcher callback", err)
+		}
+		a.enforcer.EnableAutoNotifyWatcher(a.options.AutoNotifyWatcher)
+	}
+
+	// 定期全量重新加载策略
+	if interval, _ := providerOption[time.Duration](a.options, providerOptionReloadInterval); interval > 0 {
+		a.stopReload = make(chan struct{})
+		go a.reloadLoop(interval, a.stopReload)
 	}
 
 	return nil
 }
 
+// applyUpdate 应用其它实例的策略变更，仅修改内存中的策略，不写回适配器
+// 消息无法解析或增量应用失败时全量重新加载
+func (a *CasbinAuthorizer) applyUpdate(payload string) {
+	ctx := context.Background()
+	var msg updateMessage
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		a.reload(ctx)
+		return
+	}
+
+	var err error
+	switch msg.Type {
+	case updateTypeAddPolicies:
+		_, err = a.enforcer.AddPoliciesSelf(nil, msg.Sec, msg.Ptype, msg.Rules)
+	case updateTypeRemovePolicies:
+		_, err = a.enforcer.RemovePoliciesSelf(nil, msg.Sec, msg.Ptype, msg.Rules)
+	case updateTypeRemoveFilteredPolicy:
+		_, err = a.enforcer.RemoveFilteredPolicySelf(nil, msg.Sec, msg.Ptype, msg.FieldIndex, msg.FieldValues...)
+	default:
+		a.reload(ctx)
+		return
+	}
+	if err != nil || msg.Type == updateTypeRemoveFilteredPolicy {
+		a.reload(ctx)
+		return
+	}
+	a.invalidateRules(ctx, msg.Sec, msg.Rules)
+}
+
+// reload 全量重新加载策略并清空决策缓存
+func (a *CasbinAuthorizer) reload(ctx context.Context) {
+	_ = a.Reload(ctx)
+}
+
+// Reload 从适配器全量重新加载策略并清空决策缓存
+// 用于调用方的事务回滚后丢弃已应用到内存中的变更
+func (a *CasbinAuthorizer) Reload(ctx context.Context) error {
+	err := a.enforcer.LoadPolicy()
+	if a.cache != nil {
+		a.cache.Clear(ctx)
+	}
+	return err
+}
+
+// addRules 添加策略并返回是否有新增
+// 适配器支持上下文时由授权器以请求上下文持久化（可加入调用方的数据库事务），再更新内存中的策略、失效决策缓存并通知其它实例；
+// 否则交由执行器持久化
+func (a *CasbinAuthorizer) addRules(ctx context.Context, sec, ptype string, rules [][]string) (bool, error) {
+	adapter, ok := a.adapter.(persist.ContextBatchAdapter)
+	if !ok {
+		var added bool
+		var err error
+		if sec == "g" {
+			added, err = a.enforcer.AddNamedGroupingPolicies(ptype, rules)
+		} else {
+			added, err = a.enforcer.AddNamedPolicies(ptype, rules)
+		}
+		if err == nil {
+			a.invalidateRules(ctx, sec, rules)
+		}
+		return added, err
+	}
+
+	a.writeMu.Lock()
+	defer a.writeMu.Unlock()
+	missing, err := a.filterRules(sec, ptype, rules, false)
+	if err != nil {
+		return false, err
+	}
+	if len(missing) == 0 {
+		return false, nil
+	}
+	if err := adapter.AddPoliciesCtx(ctx, sec, ptype, missing); err != nil {
+		return false, err
+	}
+	return true, a.afterCommit(ctx, func(ctx context.Context) error {
+		return a.applyAdded(ctx, sec, ptype, missing)
+	})
+}
+
+// removeRules 移除策略并返回是否有移除，持久化方式同 addRules
+func (a *CasbinAuthorizer) removeRules(ctx context.Context, sec, ptype string, rules [][]string) (bool, error) {
+	adapter, ok := a.adapter.(persist.ContextBatchAdapter)
+	if !ok {
+		var removed bool
+		var err error
+		if sec == "g" {
+			removed, err = a.enforcer.RemoveNamedGroupingPolicies(ptype, rules)
+		} else {
+			removed, err = a.enforcer.RemoveNamedPolicies(ptype, rules)
+		}
+		if err == nil {
+			a.invalidateRules(ctx, sec, rules)
+		}
+		return removed, err
+	}
+
+	a.writeMu.Lock()
+	defer a.writeMu.Unlock()
+	existing, err := a.filterRules(sec, ptype, rules, true)
+	if err != nil {
+		return false, err
+	}
+	if len(existing) == 0 {
+		return false, nil
+	}
+	if err := adapter.RemovePoliciesCtx(ctx, sec, ptype, existing); err != nil {
+		return false, err
+	}
+	return true, a.afterCommit(ctx, func(ctx context.Context) error {
+		return a.applyRemoved(ctx, sec, ptype, existing)
+	})
+}
+
+// afterCommit 应用已持久化的变更，调用方持有 writeMu
+// 适配器实现 TxAdapter 且写入处于调用方事务中时，延后到事务提交后重新加锁应用，事务回滚时变更不会进入内存，也不会通知其它实例
+func (a *CasbinAuthorizer) afterCommit(ctx context.Context, apply func(ctx context.Context) error) error {
+	if txAdapter, ok := a.adapter.(TxAdapter); ok && txAdapter.AfterCommit(ctx, func(ctx context.Context) {
+		a.writeMu.Lock()
+		defer a.writeMu.Unlock()
+		_ = apply(ctx)
+	}) {
+		return nil
+	}
+	return apply(ctx)
+}
+
+// applyAdded 将已持久化的新增策略更新到内存，失效决策缓存并通知其它实例
+// 延后应用时其它事务可能已提交相同的策略，仅应用内存中不存在的部分
+func (a *CasbinAuthorizer) applyAdded(ctx context.Context, sec, ptype string, rules [][]string) error {
+	missing, err := a.filterRules(sec, ptype, rules, false)
+	if err == nil && len(missing) > 0 {
+		_, err = a.enforcer.AddPoliciesSelf(nil, sec, ptype, missing)
+	}
+	if err != nil {
+		a.reload(ctx)
+		return err
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	a.invalidateRules(ctx, sec, missing)
+	a.notify(func(w persist.WatcherEx) error { return w.UpdateForAddPolicies(sec, ptype, missing...) })
+	return nil
+}
+
+// applyRemoved 将已持久化的移除策略更新到内存，失效决策缓存并通知其它实例
+// 延后应用时其它事务可能已移除相同的策略，仅应用内存中仍存在的部分
+func (a *CasbinAuthorizer) applyRemoved(ctx context.Context, sec, ptype string, rules [][]string) error {
+	existing, err := a.filterRules(sec, ptype, rules, true)
+	if err == nil && len(existing) > 0 {
+		_, err = a.enforcer.RemovePoliciesSelf(nil, sec, ptype, existing)
+	}
+	if err != nil {
+		a.reload(ctx)
+		return err
+	}
+	if len(existing) == 0 {
+		return nil
+	}
+	a.invalidateRules(ctx, sec, existing)
+	a.notify(func(w persist.WatcherEx) error { return w.UpdateForRemovePolicies(sec, ptype, existing...) })
+	return nil
+}
+
+// filterRules 去重并返回内存中存在（exists 为 true）或不存在的策略
+func (a *CasbinAuthorizer) filterRules(sec, ptype string, rules [][]string, exists bool) ([][]string, error) {
+	var result [][]string
+	seen := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		key := strings.Join(rule, ",")
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		has, err := a.hasRule(sec, ptype, rule)
+		if err != nil {
+			return nil, err
+		}
+		if has == exists {
+			result = append(result, rule)
+		}
+	}
+	return result, nil
+}
+
+// hasRule 检查内存中是否存在策略
+func (a *CasbinAuthorizer) hasRule(sec, ptype string, rule []string) (bool, error) {
+	if sec == "g" {
+		return a.enforcer.HasNamedGroupingPolicy(ptype, rule)
+	}
+	return a.enforcer.HasNamedPolicy(ptype, rule)
+}
+
+// notify 按执行器的自动通知设置通知其它实例，观察者不支持增量消息时通知全量重新加载
+func (a *CasbinAuthorizer) notify(update func(w persist.WatcherEx) error) {
+	if a.watcher == nil || !a.options.AutoNotifyWatcher {
+		return
+	}
+	if w, ok := a.watcher.(persist.WatcherEx); ok {
+		_ = update(w)
+		return
+	}
+	_ = a.watcher.Update()
+}
+
+// invalidateRules 使策略变更影响的决策缓存失效
+// p 策略失效主体及继承该主体的全部用户在对应对象、操作上的决策，g 策略失效用户及其下级在域内的全部决策
+func (a *CasbinAuthorizer) invalidateRules(ctx context.Context, sec string, rules [][]string) {
+	if a.cache == nil {
+		return
+	}
+	if !a.preciseInvalidation {
+		a.cache.Clear(ctx)
+		return
+	}
+	for _, rule := range rules {
+		switch {
+		case sec == "p" && len(rule) >= 4:
+			a.invalidateSubject(ctx, rule[0], rule[3], rule[1], rule[2])
+		case sec == "g" && len(rule) >= 3:
+			a.invalidateSubject(ctx, rule[0], rule[2], "", "")
+		default:
+			a.cache.Clear(ctx)
+			return
+		}
+	}
+}
+
+// invalidateSubject 使主体及直接、间接继承该主体的用户的决策失效
+func (a *CasbinAuthorizer) invalidateSubject(ctx context.Context, sub, domain, obj, act string) {
+	visited := map[string]struct{}{sub: {}}
+	queue := []string{sub}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		a.cache.Invalidate(ctx, name, domain, obj, act)
+		users, err := a.enforcer.GetUsersForRole(name, domain)
+		if err != nil {
+			a.cache.Clear(ctx)
+			return
+		}
+		for _, user := range users {
+			if _, ok := visited[user]; !ok {
+				visited[user] = struct{}{}
+				queue = append(queue, user)
+			}
+		}
+	}
+}
+
+// reloadLoop 按固定间隔全量重新加载策略，作为观察者消息丢失时的兜底
+func (a *CasbinAuthorizer) reloadLoop(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			a.reload(context.Background())
+		case <-stop:
+			return
+		}
+	}
+}
+
 // Enforce 执行授权检查
 func (a *CasbinAuthorizer) Enforce(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (bool, error) {
 	// 检查参数
@@ -189,10 +473,25 @@ func (a *CasbinAuthorizer) Enforce(ctx context.Context, sub authz.Subject, obj a
 		return false, authz.NewAuthzError(authz.ErrCodeInvalidAction, "action is required", nil)
 	}
 
-	// 执行授权检查
-	result, err := a.enforcer.Enforce(string(sub), string(obj), string(act), string(domain))
-	if err != nil {
-		return false, authz.NewAuthzError(authz.ErrCodeEnforceFailed, "enforce check failed", err)
+	// 执行授权检查，优先使用缓存的决策
+	key := cache.Key{Subject: string(sub), Object: string(obj), Action: string(act), Domain: string(domain)}
+	result, found := false, false
+	if a.cache != nil {
+		result, found = a.cache.Get(ctx, key)
+	}
+	if !found {
+		var epoch cache.Epoch
+		if a.cache != nil {
+			epoch = a.cache.Epoch(ctx)
+		}
+		var err error
+		result, err = a.enforcer.Enforce(string(sub), string(obj), string(act), string(domain))
+		if err != nil {
+			return false, authz.NewAuthzError(authz.ErrCodeEnforceFailed, "enforce check failed", err)
+		}
+		if a.cache != nil {
+			a.cache.Set(ctx, key, result, epoch)
+		}
 	}
 
 	// 如果未授权，返回权限被拒绝错误
@@ -252,7 +551,8 @@ func (a *CasbinAuthorizer) AddPolicy(ctx context.Context, policy authz.Policy) (
 		effect = "deny"
 	}
 
-	added, err := a.enforcer.AddPolicy(string(policy.Subject), string(policy.Object), string(policy.Action), string(policy.Domain), effect)
+	rule := []string{string(policy.Subject), string(policy.Object), string(policy.Action), string(policy.Domain), effect}
+	added, err := a.addRules(ctx, "p", "p", [][]string{rule})
 	if err != nil {
 		return false, authz.NewAuthzError(authz.ErrCodeAddPolicyFailed, "add policy failed", err)
 	}
@@ -279,7 +579,8 @@ func (a *CasbinAuthorizer) RemovePolicy(ctx context.Context, policy authz.Policy
 		effect = "deny"
 	}
 
-	removed, err := a.enforcer.RemovePolicy(string(policy.Subject), string(policy.Object), string(policy.Action), string(policy.Domain), effect)
+	rule := []string{string(policy.Subject), string(policy.Object), string(policy.Action), string(policy.Domain), effect}
+	removed, err := a.removeRules(ctx, "p", "p", [][]string{rule})
 	if err != nil {
 		return false, authz.NewAuthzError(authz.ErrCodeRemovePolicyFailed, "remove policy failed", err)
 	}
@@ -305,7 +606,7 @@ func (a *CasbinAuthorizer) AddPolicies(ctx context.Context, policies []authz.Pol
 	}
 
 	// 批量添加策略
-	added, err := a.enforcer.AddPolicies(rules)
+	added, err := a.addRules(ctx, "p", "p", rules)
 	if err != nil {
 		return false, authz.NewAuthzError(authz.ErrCodeAddPoliciesFailed, "add policies failed", err)
 	}
@@ -331,7 +632,7 @@ func (a *CasbinAuthorizer) RemovePolicies(ctx context.Context, policies []authz.
 	}
 
 	// 批量移除策略
-	removed, err := a.enforcer.RemovePolicies(rules)
+	removed, err := a.removeRules(ctx, "p", "p", rules)
 	if err != nil {
 		return false, authz.NewAuthzError(authz.ErrCodeRemovePoliciesFailed, "remove policies failed", err)
 	}
@@ -457,7 +758,7 @@ func (a *CasbinAuthorizer) HasRoleForUser(ctx context.Context, user authz.Subjec
 // AddRoleForUser 为用户添加角色
 func (a *CasbinAuthorizer) AddRoleForUser(ctx context.Context, user authz.Subject, role authz.Subject, domain authz.Domain) (bool, error) {
 	// 为用户添加角色
-	added, err := a.enforcer.AddRoleForUser(string(user), string(role), string(domain))
+	added, err := a.addRules(ctx, "g", "g", [][]string{{string(user), string(role), string(domain)}})
 	if err != nil {
 		return false, authz.NewAuthzError(authz.ErrCodeAddRoleForUserFailed, "add role for user failed", err)
 	}
@@ -468,7 +769,7 @@ func (a *CasbinAuthorizer) AddRoleForUser(ctx context.Context, user authz.Subjec
 // DeleteRoleForUser 删除用户的角色
 func (a *CasbinAuthorizer) DeleteRoleForUser(ctx context.Context, user authz.Subject, role authz.Subject, domain authz.Domain) (bool, error) {
 	// 删除用户的角色
-	deleted, err := a.enforcer.DeleteRoleForUser(string(user), string(role), string(domain))
+	deleted, err := a.removeRules(ctx, "g", "g", [][]string{{string(user), string(role), string(domain)}})
 	if err != nil {
 		return false, authz.NewAuthzError(authz.ErrCodeDeleteRoleForUserFailed, "delete role for user failed", err)
 	}
@@ -476,6 +777,135 @@ func (a *CasbinAuthorizer) DeleteRoleForUser(ctx context.Context, user authz.Sub
 	return deleted, nil
 }
 
+// GetPolicies 获取策略，domain 为空时返回全部域的策略
+func (a *CasbinAuthorizer) GetPolicies(ctx context.Context, domain authz.Domain) ([]authz.Policy, error) {
+	return a.GetFilteredPolicies(ctx, authz.Policy{Domain: domain})
+}
+
+// GetFilteredPolicies 按条件获取策略，主体、对象、操作与域由引擎按字段过滤
+func (a *CasbinAuthorizer) GetFilteredPolicies(ctx context.Context, filter authz.Policy) ([]authz.Policy, error) {
+	rules, err := a.enforcer.GetFilteredPolicy(0, string(filter.Subject), string(filter.Object), string(filter.Action), string(filter.Domain))
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeUnknown, "get policies failed", err)
+	}
+
+	// 转换为授权策略类型，未填写效果的规则视为 allow，按转换后的效果过滤
+	result := make([]authz.Policy, 0, len(rules))
+	for _, rule := range rules {
+		if policy := policyFromRule(rule); filter.Match(policy) {
+			result = append(result, policy)
+		}
+	}
+
+	return result, nil
+}
+
+// policyFromRule 转换 p 规则为授权策略，字段布局为 sub, obj, act, dom, eft
+func policyFromRule(rule []string) authz.Policy {
+	v := make([]string, 5)
+	copy(v, rule)
+	effect := authz.EffectAllow
+	if v[4] == "deny" {
+		effect = authz.EffectDeny
+	}
+	return authz.Policy{
+		Subject: authz.Subject(v[0]),
+		Object:  authz.Object(v[1]),
+		Action:  authz.Action(v[2]),
+		Domain:  authz.Domain(v[3]),
+		Effect:  effect,
+	}
+}
+
+// GetRoleBindings 获取角色绑定，domain 为空时返回全部域的角色绑定
+func (a *CasbinAuthorizer) GetRoleBindings(ctx context.Context, domain authz.Domain) ([]authz.RoleBinding, error) {
+	return a.GetFilteredRoleBindings(ctx, authz.RoleBinding{Domain: domain})
+}
+
+// GetFilteredRoleBindings 按条件获取角色绑定，由引擎按字段过滤
+func (a *CasbinAuthorizer) GetFilteredRoleBindings(ctx context.Context, filter authz.RoleBinding) ([]authz.RoleBinding, error) {
+	rules, err := a.enforcer.GetFilteredGroupingPolicy(0, string(filter.User), string(filter.Role), string(filter.Domain))
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeUnknown, "get role bindings failed", err)
+	}
+
+	// 转换为角色绑定类型，字段布局为 user, role, dom
+	result := make([]authz.RoleBinding, len(rules))
+	for i, rule := range rules {
+		v := make([]string, 3)
+		copy(v, rule)
+		result[i] = authz.RoleBinding{User: authz.Subject(v[0]), Role: authz.Subject(v[1]), Domain: authz.Domain(v[2])}
+	}
+
+	return result, nil
+}
+
+// roleBindingRules 转换角色绑定为 g 规则
+func roleBindingRules(bindings []authz.RoleBinding) [][]string {
+	rules := make([][]string, len(bindings))
+	for i, b := range bindings {
+		rules[i] = []string{string(b.User), string(b.Role), string(b.Domain)}
+	}
+	return rules
+}
+
+// AddRoleBindings 批量添加角色绑定，已存在的绑定忽略，全部新增在一次适配器写入中完成
+func (a *CasbinAuthorizer) AddRoleBindings(ctx context.Context, bindings []authz.RoleBinding) (bool, error) {
+	if len(bindings) == 0 {
+		return false, nil
+	}
+	rules := roleBindingRules(bindings)
+	added, err := a.addRules(ctx, "g", "g", rules)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeAddRoleForUserFailed, "add role bindings failed", err)
+	}
+
+	return added, nil
+}
+
+// RemoveRoleBindings 批量移除角色绑定，全部移除在一次适配器写入中完成
+func (a *CasbinAuthorizer) RemoveRoleBindings(ctx context.Context, bindings []authz.RoleBinding) (bool, error) {
+	if len(bindings) == 0 {
+		return false, nil
+	}
+	rules := roleBindingRules(bindings)
+	removed, err := a.removeRules(ctx, "g", "g", rules)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeDeleteRoleForUserFailed, "remove role bindings failed", err)
+	}
+
+	return removed, nil
+}
+
+// Explain 绕过决策缓存执行授权检查，返回决定结果的策略与主体的角色链
+func (a *CasbinAuthorizer) Explain(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (*authz.Explanation, error) {
+	// 检查参数
+	if sub == "" {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidSubject, "subject is required", nil)
+	}
+	if obj == "" {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidObject, "object is required", nil)
+	}
+	if act == "" {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidAction, "action is required", nil)
+	}
+
+	allowed, rule, err := a.enforcer.EnforceEx(string(sub), string(obj), string(act), string(domain))
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeEnforceFailed, "enforce check failed", err)
+	}
+	chain, err := authz.ResolveRoleChain(ctx, a, sub, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &authz.Explanation{Allowed: allowed, RoleChain: chain}
+	if len(rule) > 0 {
+		explanation.MatchedPolicies = []authz.Policy{policyFromRule(rule)}
+	}
+	return explanation, nil
+}
+
 // Name 获取授权器名称
 func (a *CasbinAuthorizer) Name() string {
 	return "casbin"
@@ -488,7 +918,14 @@ func (a *CasbinAuthorizer) Options() authz.Options {
 
 // Close 关闭授权器，释放资源
 func (a *CasbinAuthorizer) Close() error {
-	// 无需特殊清理
+	if a.stopReload != nil {
+		close(a.stopReload)
+		a.stopReload = nil
+	}
+	if a.watcher != nil {
+		a.watcher.Close()
+		a.watcher = nil
+	}
 	return nil
 }
 
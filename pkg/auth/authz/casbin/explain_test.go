@@ -0,0 +1,54 @@
+package casbin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"backend-service/pkg/auth/authz"
+)
+
+func TestExplain(t *testing.T) {
+	ctx := context.Background()
+	a, err := NewProvider().NewAuthorizer(ctx, cacheOptions()...)
+	require.NoError(t, err)
+	defer a.Close()
+
+	_, err = a.AddPolicies(ctx, []authz.Policy{
+		{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "default", Effect: authz.EffectAllow},
+		{Subject: "manager", Object: "/api/users", Action: "DELETE", Domain: "default", Effect: authz.EffectDeny},
+		{Subject: "admin", Object: "/api/users", Action: "DELETE", Domain: "default", Effect: authz.EffectAllow},
+	})
+	require.NoError(t, err)
+	_, err = a.AddRoleForUser(ctx, "manager", "admin", "default")
+	require.NoError(t, err)
+	_, err = a.AddRoleForUser(ctx, "alice", "manager", "default")
+	require.NoError(t, err)
+
+	explainer := a.(authz.Explainer)
+	chain := []authz.RoleBinding{
+		{User: "alice", Role: "manager", Domain: "default"},
+		{User: "manager", Role: "admin", Domain: "default"},
+	}
+
+	e, err := explainer.Explain(ctx, "alice", "/api/users", "GET", "default")
+	require.NoError(t, err)
+	assert.True(t, e.Allowed)
+	assert.Equal(t, []authz.Policy{{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "default", Effect: authz.EffectAllow}}, e.MatchedPolicies)
+	assert.Equal(t, chain, e.RoleChain)
+
+	// 拒绝优先，返回决定结果的拒绝策略
+	e, err = explainer.Explain(ctx, "alice", "/api/users", "DELETE", "default")
+	require.NoError(t, err)
+	assert.False(t, e.Allowed)
+	assert.Equal(t, []authz.Policy{{Subject: "manager", Object: "/api/users", Action: "DELETE", Domain: "default", Effect: authz.EffectDeny}}, e.MatchedPolicies)
+
+	// 无匹配策略
+	e, err = explainer.Explain(ctx, "alice", "/api/users", "GET", "other")
+	require.NoError(t, err)
+	assert.False(t, e.Allowed)
+	assert.Empty(t, e.MatchedPolicies)
+	assert.Empty(t, e.RoleChain)
+}
@@ -0,0 +1,53 @@
+package casbin
+
+import (
+	"time"
+
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/redis/go-redis/v9"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// 提供者特定选项键
+const (
+	// providerOptionAdapter 自定义策略适配器
+	providerOptionAdapter = "casbin.adapter"
+	// providerOptionRedisClient Redis 适配器使用的客户端
+	providerOptionRedisClient = "casbin.redis_client"
+	// providerOptionWatcher 自定义观察者
+	providerOptionWatcher = "casbin.watcher"
+	// providerOptionReloadInterval 定期全量重新加载策略的间隔
+	providerOptionReloadInterval = "casbin.reload_interval"
+)
+
+// WithAdapter 使用自定义策略适配器，优先级高于 AdapterType
+func WithAdapter(adapter persist.Adapter) authz.Option {
+	return authz.WithProviderOption(providerOptionAdapter, adapter)
+}
+
+// WithWatcher 使用自定义观察者，优先级高于 WatcherType，需同时启用 EnableWatcher
+func WithWatcher(watcher persist.Watcher) authz.Option {
+	return authz.WithProviderOption(providerOptionWatcher, watcher)
+}
+
+// WithReloadInterval 设置定期全量重新加载策略的间隔，作为观察者消息丢失时的兜底，0 表示不启用
+func WithReloadInterval(interval time.Duration) authz.Option {
+	return authz.WithProviderOption(providerOptionReloadInterval, interval)
+}
+
+// WithRedisClient 设置 Redis 适配器、观察者使用的客户端，设置后忽略对应的 DSN
+func WithRedisClient(client redis.UniversalClient) authz.Option {
+	return authz.WithProviderOption(providerOptionRedisClient, client)
+}
+
+// providerOption 读取提供者特定选项
+func providerOption[T any](options authz.Options, key string) (T, bool) {
+	var zero T
+	values, ok := options.ProviderOptions.(map[string]interface{})
+	if !ok {
+		return zero, false
+	}
+	value, ok := values[key].(T)
+	return value, ok
+}
@@ -0,0 +1,204 @@
+package casbin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// 观察者类型
+const (
+	// WatcherRedis Redis 发布订阅观察者
+	WatcherRedis = "redis"
+	// WatcherLocal 进程内观察者
+	WatcherLocal = "local"
+)
+
+// 观察者选项键
+const (
+	// WatcherOptionChannel Redis 发布订阅频道
+	WatcherOptionChannel = "channel"
+	// WatcherOptionClient Redis 客户端，未设置时依次使用 WithRedisClient 与 WatcherOptionDSN
+	WatcherOptionClient = "client"
+	// WatcherOptionDSN Redis 连接地址
+	WatcherOptionDSN = "dsn"
+	// WatcherOptionBus 进程内消息总线
+	WatcherOptionBus = "bus"
+)
+
+// 策略变更类型
+const (
+	updateTypeReload               = "Reload"
+	updateTypeAddPolicies          = "AddPolicies"
+	updateTypeRemovePolicies       = "RemovePolicies"
+	updateTypeRemoveFilteredPolicy = "RemoveFilteredPolicy"
+)
+
+// updateMessage 策略变更消息
+type updateMessage struct {
+	// ID 发送方实例标识，用于忽略自身消息
+	ID string `json:"id"`
+	// Type 变更类型
+	Type string `json:"type"`
+	// Sec 策略段
+	Sec string `json:"sec,omitempty"`
+	// Ptype 策略类型
+	Ptype string `json:"ptype,omitempty"`
+	// Rules 变更的策略
+	Rules [][]string `json:"rules,omitempty"`
+	// FieldIndex 过滤字段起始下标
+	FieldIndex int `json:"field_index,omitempty"`
+	// FieldValues 过滤字段值
+	FieldValues []string `json:"field_values,omitempty"`
+}
+
+// watcher 观察者公共实现，负责编码变更消息并过滤自身消息，具体传输由 publish 完成
+type watcher struct {
+	id      string
+	publish func(payload []byte) error
+
+	mu       sync.RWMutex
+	callback func(string)
+}
+
+var _ persist.WatcherEx = (*watcher)(nil)
+
+func newWatcher(publish func(payload []byte) error) *watcher {
+	return &watcher{id: uuid.NewString(), publish: publish}
+}
+
+// SetUpdateCallback 设置收到其它实例变更时的回调
+func (w *watcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.callback = callback
+	return nil
+}
+
+// Update 通知其它实例全量重新加载策略
+func (w *watcher) Update() error {
+	return w.send(updateMessage{Type: updateTypeReload})
+}
+
+// UpdateForAddPolicy 通知其它实例添加策略
+func (w *watcher) UpdateForAddPolicy(sec, ptype string, params ...string) error {
+	return w.UpdateForAddPolicies(sec, ptype, params)
+}
+
+// UpdateForRemovePolicy 通知其它实例移除策略
+func (w *watcher) UpdateForRemovePolicy(sec, ptype string, params ...string) error {
+	return w.UpdateForRemovePolicies(sec, ptype, params)
+}
+
+// UpdateForRemoveFilteredPolicy 通知其它实例按条件移除策略
+func (w *watcher) UpdateForRemoveFilteredPolicy(sec, ptype string, fieldIndex int, fieldValues ...string) error {
+	return w.send(updateMessage{
+		Type:        updateTypeRemoveFilteredPolicy,
+		Sec:         sec,
+		Ptype:       ptype,
+		FieldIndex:  fieldIndex,
+		FieldValues: fieldValues,
+	})
+}
+
+// UpdateForSavePolicy 通知其它实例全量重新加载策略
+func (w *watcher) UpdateForSavePolicy(model.Model) error {
+	return w.Update()
+}
+
+// UpdateForAddPolicies 通知其它实例批量添加策略
+func (w *watcher) UpdateForAddPolicies(sec string, ptype string, rules ...[]string) error {
+	return w.send(updateMessage{Type: updateTypeAddPolicies, Sec: sec, Ptype: ptype, Rules: rules})
+}
+
+// UpdateForRemovePolicies 通知其它实例批量移除策略
+func (w *watcher) UpdateForRemovePolicies(sec string, ptype string, rules ...[]string) error {
+	return w.send(updateMessage{Type: updateTypeRemovePolicies, Sec: sec, Ptype: ptype, Rules: rules})
+}
+
+// Close 关闭观察者
+func (w *watcher) Close() {}
+
+// send 编码并发布变更消息
+func (w *watcher) send(msg updateMessage) error {
+	msg.ID = w.id
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return w.publish(payload)
+}
+
+// receive 处理收到的变更消息，忽略自身发出的消息
+func (w *watcher) receive(payload []byte) {
+	var msg updateMessage
+	if err := json.Unmarshal(payload, &msg); err == nil && msg.ID == w.id {
+		return
+	}
+	w.mu.RLock()
+	callback := w.callback
+	w.mu.RUnlock()
+	if callback != nil {
+		callback(string(payload))
+	}
+}
+
+// buildWatcher 根据配置创建观察者，自定义观察者优先
+func buildWatcher(ctx context.Context, options authz.Options) (persist.Watcher, error) {
+	if w, ok := providerOption[persist.Watcher](options, providerOptionWatcher); ok && w != nil {
+		return w, nil
+	}
+
+	switch options.WatcherType {
+	case WatcherRedis:
+		channel, _ := options.WatcherOptions[WatcherOptionChannel].(string)
+		client, _ := options.WatcherOptions[WatcherOptionClient].(redis.UniversalClient)
+		if client == nil {
+			client, _ = providerOption[redis.UniversalClient](options, providerOptionRedisClient)
+		}
+		if client == nil {
+			dsn, _ := options.WatcherOptions[WatcherOptionDSN].(string)
+			if dsn == "" {
+				return nil, authz.NewAuthzError(
+					authz.ErrCodeInvalidConfiguration,
+					"redis client or watcher DSN is required for redis watcher",
+					nil,
+				)
+			}
+			opt, err := redis.ParseURL(dsn)
+			if err != nil {
+				return nil, authz.NewAuthzError(authz.ErrCodeInvalidConfiguration, "invalid redis watcher DSN", err)
+			}
+			client = redis.NewClient(opt)
+		}
+		w, err := NewRedisWatcher(ctx, client, channel)
+		if err != nil {
+			return nil, authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to create redis watcher", err)
+		}
+		return w, nil
+	case WatcherLocal:
+		bus, _ := options.WatcherOptions[WatcherOptionBus].(*LocalBus)
+		if bus == nil {
+			return nil, authz.NewAuthzError(
+				authz.ErrCodeInvalidConfiguration,
+				"local bus is required for local watcher",
+				nil,
+			)
+		}
+		return NewLocalWatcher(bus), nil
+	default:
+		return nil, authz.NewAuthzError(
+			authz.ErrCodeInvalidConfiguration,
+			fmt.Sprintf("unsupported watcher type: %s", options.WatcherType),
+			nil,
+		)
+	}
+}
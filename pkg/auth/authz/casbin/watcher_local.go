@@ -0,0 +1,50 @@
+package casbin
+
+import "sync"
+
+// LocalBus 进程内消息总线，同一总线上的观察者互相同步策略变更，主要用于测试与单机多实例
+type LocalBus struct {
+	mu       sync.RWMutex
+	watchers map[*LocalWatcher]struct{}
+}
+
+// NewLocalBus 创建进程内消息总线
+func NewLocalBus() *LocalBus {
+	return &LocalBus{watchers: make(map[*LocalWatcher]struct{})}
+}
+
+// publish 同步投递消息给总线上的全部观察者
+func (b *LocalBus) publish(payload []byte) error {
+	b.mu.RLock()
+	watchers := make([]*LocalWatcher, 0, len(b.watchers))
+	for w := range b.watchers {
+		watchers = append(watchers, w)
+	}
+	b.mu.RUnlock()
+	for _, w := range watchers {
+		w.receive(payload)
+	}
+	return nil
+}
+
+// LocalWatcher 进程内观察者
+type LocalWatcher struct {
+	*watcher
+	bus *LocalBus
+}
+
+// NewLocalWatcher 创建进程内观察者并加入消息总线
+func NewLocalWatcher(bus *LocalBus) *LocalWatcher {
+	w := &LocalWatcher{watcher: newWatcher(bus.publish), bus: bus}
+	bus.mu.Lock()
+	bus.watchers[w] = struct{}{}
+	bus.mu.Unlock()
+	return w
+}
+
+// Close 离开消息总线
+func (w *LocalWatcher) Close() {
+	w.bus.mu.Lock()
+	delete(w.bus.watchers, w)
+	w.bus.mu.Unlock()
+}
@@ -0,0 +1,63 @@
+package casbin
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// defaultWatcherChannel 默认的策略变更频道
+const defaultWatcherChannel = "casbin:policy:update"
+
+// RedisWatcher 基于 Redis 发布订阅的观察者，用于多实例间同步策略变更
+type RedisWatcher struct {
+	*watcher
+	client  redis.UniversalClient
+	channel string
+	pubsub  *redis.PubSub
+	cancel  context.CancelFunc
+}
+
+// NewRedisWatcher 创建 Redis 观察者并订阅变更频道，channel 为空时使用默认频道
+func NewRedisWatcher(ctx context.Context, client redis.UniversalClient, channel string) (*RedisWatcher, error) {
+	if channel == "" {
+		channel = defaultWatcherChannel
+	}
+	w := &RedisWatcher{client: client, channel: channel}
+	w.watcher = newWatcher(func(payload []byte) error {
+		return client.Publish(context.Background(), channel, payload).Err()
+	})
+
+	w.pubsub = client.Subscribe(ctx, channel)
+	// 等待订阅确认，确保创建后不会丢失变更消息
+	if _, err := w.pubsub.Receive(ctx); err != nil {
+		_ = w.pubsub.Close()
+		return nil, err
+	}
+
+	ctx, w.cancel = context.WithCancel(context.Background())
+	go w.run(ctx)
+	return w, nil
+}
+
+// run 接收变更消息直到观察者关闭
+func (w *RedisWatcher) run(ctx context.Context) {
+	ch := w.pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			w.receive([]byte(msg.Payload))
+		}
+	}
+}
+
+// Close 取消订阅并停止接收变更消息
+func (w *RedisWatcher) Close() {
+	w.cancel()
+	_ = w.pubsub.Close()
+}
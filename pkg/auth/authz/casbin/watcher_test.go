@@ -0,0 +1,93 @@
+package casbin
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// testWatcherSync 两个共享存储的授权器通过观察者同步策略变更
+func testWatcherSync(t *testing.T, opts ...authz.Option) {
+	ctx := context.Background()
+	adapter := NewMemoryAdapter()
+	provider := &CasbinProvider{}
+	opts = append([]authz.Option{WithAdapter(adapter), authz.WithEnableWatcher(true)}, opts...)
+
+	a, err := provider.NewAuthorizer(ctx, opts...)
+	require.NoError(t, err)
+	defer a.Close()
+	b, err := provider.NewAuthorizer(ctx, opts...)
+	require.NoError(t, err)
+	defer b.Close()
+
+	eventually := func(want bool, sub authz.Subject, act authz.Action) {
+		assert.Eventually(t, func() bool {
+			allowed, _ := b.Enforce(ctx, sub, "/api/users", act, "default")
+			return allowed == want
+		}, time.Second, 10*time.Millisecond)
+	}
+
+	_, err = a.AddPolicies(ctx, []authz.Policy{
+		{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "default"},
+		{Subject: "admin", Object: "/api/users", Action: "DELETE", Domain: "default"},
+	})
+	require.NoError(t, err)
+	eventually(true, "admin", "DELETE")
+
+	_, err = a.AddRoleForUser(ctx, "alice", "admin", "default")
+	require.NoError(t, err)
+	eventually(true, "alice", "GET")
+
+	_, err = a.RemovePolicy(ctx, authz.Policy{Subject: "admin", Object: "/api/users", Action: "DELETE", Domain: "default"})
+	require.NoError(t, err)
+	eventually(false, "alice", "DELETE")
+	eventually(true, "alice", "GET")
+
+	_, err = a.DeleteRoleForUser(ctx, "alice", "admin", "default")
+	require.NoError(t, err)
+	eventually(false, "alice", "GET")
+}
+
+func TestLocalWatcher(t *testing.T) {
+	bus := NewLocalBus()
+	testWatcherSync(t,
+		authz.WithWatcherType(WatcherLocal),
+		authz.WithWatcherOption(WatcherOptionBus, bus),
+	)
+}
+
+func TestRedisWatcher(t *testing.T) {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR not set")
+	}
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	defer client.Close()
+	testWatcherSync(t,
+		authz.WithWatcherType(WatcherRedis),
+		authz.WithWatcherOption(WatcherOptionChannel, "casbin:policy:test"),
+		authz.WithWatcherOption(WatcherOptionClient, client),
+	)
+}
+
+func TestReloadInterval(t *testing.T) {
+	ctx := context.Background()
+	adapter := NewMemoryAdapter()
+	a, err := NewProvider().NewAuthorizer(ctx, WithAdapter(adapter), WithReloadInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	defer a.Close()
+
+	// 绕过授权器直接写入存储，模拟丢失的变更通知
+	require.NoError(t, adapter.AddPolicy("p", "p", []string{"admin", "/api/users", "GET", "default", "allow"}))
+	assert.Eventually(t, func() bool {
+		allowed, _ := a.Enforce(ctx, "admin", "/api/users", "GET", "default")
+		return allowed
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,184 @@
+package catalog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	openapi_v3 "github.com/google/gnostic/openapiv3"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Route HTTP 路由
+type Route struct {
+	// Method HTTP 方法
+	Method string
+	// Path 路径模板，如 /admin/v1/users/{id}
+	Path string
+}
+
+// Permission 权限项，对应一个 API 操作
+type Permission struct {
+	// Operation 操作名，即授权对象，如 /avmc.admin.v1.UserService/ListUser
+	Operation string
+	// Service 服务全名
+	Service string
+	// Method 方法名
+	Method string
+	// Routes HTTP 路由，首个为主路由，其余为附加绑定
+	Routes []Route
+	// Summary 摘要
+	Summary string
+	// Description 描述
+	Description string
+	// Tags 标签
+	Tags []string
+}
+
+// Catalog 权限目录，由服务的 proto 描述与 google.api.http、gnostic 注解生成
+type Catalog struct {
+	permissions []Permission
+	index       map[string]int
+}
+
+// Option 权限目录选项
+type Option func(*options)
+
+type options struct {
+	exclude map[string]struct{}
+}
+
+// WithExclude 排除无需授权的操作，如登录等公开接口
+func WithExclude(operations ...string) Option {
+	return func(o *options) {
+		for _, op := range operations {
+			o.exclude[op] = struct{}{}
+		}
+	}
+}
+
+// New 根据服务全名生成权限目录，服务需已注册到全局 proto 注册表
+// services: 服务全名，如 avmc.admin.v1.UserService
+// opts: 配置选项
+// 返回: 按操作名排序的权限目录和可能的错误
+func New(services []string, opts ...Option) (*Catalog, error) {
+	o := &options{exclude: make(map[string]struct{})}
+	for _, opt := range opts {
+		opt(o)
+	}
+	c := &Catalog{index: make(map[string]int)}
+	for _, service := range services {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+		if err != nil {
+			return nil, fmt.Errorf("catalog: service %s not found: %w", service, err)
+		}
+		sd, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("catalog: %s is not a service", service)
+		}
+		methods := sd.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			p := permission(sd, methods.Get(i))
+			if _, ok := o.exclude[p.Operation]; ok {
+				continue
+			}
+			c.permissions = append(c.permissions, p)
+		}
+	}
+	sort.Slice(c.permissions, func(i, j int) bool { return c.permissions[i].Operation < c.permissions[j].Operation })
+	for i, p := range c.permissions {
+		c.index[p.Operation] = i
+	}
+	return c, nil
+}
+
+// Describe 从全局 proto 注册表查找单个操作的权限项，无需预先生成目录
+// operation: 操作名，如 /avmc.admin.v1.UserService/ListUser
+// 返回: 权限项，操作未注册时返回 false
+func Describe(operation string) (Permission, bool) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(operation, "/"), "/")
+	if !ok {
+		return Permission{}, false
+	}
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return Permission{}, false
+	}
+	sd, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return Permission{}, false
+	}
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return Permission{}, false
+	}
+	return permission(sd, md), true
+}
+
+// permission 读取方法的注解生成权限项
+func permission(sd protoreflect.ServiceDescriptor, md protoreflect.MethodDescriptor) Permission {
+	p := Permission{
+		Operation: fmt.Sprintf("/%s/%s", sd.FullName(), md.Name()),
+		Service:   string(sd.FullName()),
+		Method:    string(md.Name()),
+	}
+	opts := md.Options()
+	if opts == nil {
+		return p
+	}
+	if rule, ok := proto.GetExtension(opts, annotations.E_Http).(*annotations.HttpRule); ok && rule != nil {
+		p.Routes = append(p.Routes, routes(rule)...)
+	}
+	if op, ok := proto.GetExtension(opts, openapi_v3.E_Operation).(*openapi_v3.Operation); ok && op != nil {
+		p.Summary = op.GetSummary()
+		p.Description = op.GetDescription()
+		p.Tags = op.GetTags()
+	}
+	return p
+}
+
+// routes 转换 HTTP 规则及其附加绑定为路由
+func routes(rule *annotations.HttpRule) []Route {
+	var result []Route
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		result = append(result, Route{Method: "GET", Path: pattern.Get})
+	case *annotations.HttpRule_Post:
+		result = append(result, Route{Method: "POST", Path: pattern.Post})
+	case *annotations.HttpRule_Put:
+		result = append(result, Route{Method: "PUT", Path: pattern.Put})
+	case *annotations.HttpRule_Delete:
+		result = append(result, Route{Method: "DELETE", Path: pattern.Delete})
+	case *annotations.HttpRule_Patch:
+		result = append(result, Route{Method: "PATCH", Path: pattern.Patch})
+	case *annotations.HttpRule_Custom:
+		result = append(result, Route{Method: strings.ToUpper(pattern.Custom.GetKind()), Path: pattern.Custom.GetPath()})
+	}
+	for _, binding := range rule.GetAdditionalBindings() {
+		result = append(result, routes(binding)...)
+	}
+	return result
+}
+
+// Permissions 返回全部权限项
+func (c *Catalog) Permissions() []Permission {
+	return c.permissions
+}
+
+// Lookup 按操作名查找权限项
+func (c *Catalog) Lookup(operation string) (Permission, bool) {
+	i, ok := c.index[operation]
+	if !ok {
+		return Permission{}, false
+	}
+	return c.permissions[i], true
+}
+
+// Contains 判断操作名是否存在
+func (c *Catalog) Contains(operation string) bool {
+	_, ok := c.index[operation]
+	return ok
+}
@@ -0,0 +1,104 @@
+package authz
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MarshalCSV 将策略与角色绑定编码为 casbin CSV 格式
+// 策略行为 p, sub, obj, act, dom, eft；角色绑定行为 g, user, role, dom
+func MarshalCSV(policies []Policy, bindings []RoleBinding) []byte {
+	var buf bytes.Buffer
+	for _, p := range policies {
+		effect := p.Effect
+		if effect == "" {
+			effect = EffectAllow
+		}
+		writeCSVLine(&buf, "p", string(p.Subject), string(p.Object), string(p.Action), string(p.Domain), string(effect))
+	}
+	for _, b := range bindings {
+		writeCSVLine(&buf, "g", string(b.User), string(b.Role), string(b.Domain))
+	}
+	return buf.Bytes()
+}
+
+// writeCSVLine 写入一行，包含逗号、引号或首尾空白的字段加引号
+func writeCSVLine(buf *bytes.Buffer, fields ...string) {
+	for i, field := range fields {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if strings.ContainsAny(field, ",\"\r\n") || strings.TrimSpace(field) != field {
+			field = `"` + strings.ReplaceAll(field, `"`, `""`) + `"`
+		}
+		buf.WriteString(field)
+	}
+	buf.WriteByte('\n')
+}
+
+// ParseCSV 解析 casbin CSV 格式的策略与角色绑定，忽略空行与 # 开头的注释行
+func ParseCSV(data []byte) ([]Policy, []RoleBinding, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var (
+		policies []Policy
+		bindings []RoleBinding
+	)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return policies, bindings, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		for i := range record {
+			record[i] = strings.TrimSpace(record[i])
+		}
+		switch record[0] {
+		case "p":
+			if len(record) < 4 || len(record) > 6 {
+				return nil, nil, fmt.Errorf("line %d: policy requires p, sub, obj, act[, dom[, eft]]", line)
+			}
+			v := make([]string, 5)
+			copy(v, record[1:])
+			p := Policy{Subject: Subject(v[0]), Object: Object(v[1]), Action: Action(v[2]), Domain: Domain(v[3]), Effect: Effect(v[4])}
+			if p.Subject == "" || p.Object == "" || p.Action == "" {
+				return nil, nil, fmt.Errorf("line %d: subject, object and action are required", line)
+			}
+			switch p.Effect {
+			case "":
+				p.Effect = EffectAllow
+			case EffectAllow, EffectDeny:
+			default:
+				return nil, nil, fmt.Errorf("line %d: invalid effect %q", line, p.Effect)
+			}
+			policies = append(policies, p)
+		case "g":
+			if len(record) < 3 || len(record) > 4 {
+				return nil, nil, fmt.Errorf("line %d: role binding requires g, user, role[, dom]", line)
+			}
+			v := make([]string, 3)
+			copy(v, record[1:])
+			b := RoleBinding{User: Subject(v[0]), Role: Subject(v[1]), Domain: Domain(v[2])}
+			if b.User == "" || b.Role == "" {
+				return nil, nil, fmt.Errorf("line %d: user and role are required", line)
+			}
+			bindings = append(bindings, b)
+		case "":
+			if len(record) > 1 {
+				return nil, nil, fmt.Errorf("line %d: missing policy type", line)
+			}
+		default:
+			return nil, nil, fmt.Errorf("line %d: unsupported policy type %q", line, record[0])
+		}
+	}
+}
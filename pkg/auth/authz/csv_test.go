@@ -0,0 +1,35 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSV(t *testing.T) {
+	policies := []Policy{
+		{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "1", Effect: EffectAllow},
+		{Subject: "guest", Object: "/api/a,b", Action: "GET", Domain: "1", Effect: EffectDeny},
+	}
+	bindings := []RoleBinding{{User: "alice", Role: "admin", Domain: "1"}}
+
+	data := MarshalCSV(policies, bindings)
+	assert.Equal(t, "p, admin, /api/users, GET, 1, allow\np, guest, \"/api/a,b\", GET, 1, deny\ng, alice, admin, 1\n", string(data))
+
+	gotPolicies, gotBindings, err := ParseCSV(data)
+	require.NoError(t, err)
+	assert.Equal(t, policies, gotPolicies)
+	assert.Equal(t, bindings, gotBindings)
+
+	// 省略的域与效果取默认值，忽略注释与空行
+	gotPolicies, gotBindings, err = ParseCSV([]byte("# comment\n\np, admin, /api/posts, GET\ng, bob, admin\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []Policy{{Subject: "admin", Object: "/api/posts", Action: "GET", Effect: EffectAllow}}, gotPolicies)
+	assert.Equal(t, []RoleBinding{{User: "bob", Role: "admin"}}, gotBindings)
+
+	for _, s := range []string{"x, a, b\n", "p, a, b\n", "p, a, b, c, d, maybe\n", "g, a\n", "p, , b, c\n"} {
+		_, _, err := ParseCSV([]byte(s))
+		assert.Error(t, err, s)
+	}
+}
@@ -69,6 +69,8 @@ const (
 	ErrCodeInvalidUser
 	// ErrCodePermissionDenied 权限被拒绝
 	ErrCodePermissionDenied
+	// ErrCodeUnsupportedOperation 授权器不支持的操作
+	ErrCodeUnsupportedOperation
 )
 
 // 预定义错误
@@ -129,6 +131,8 @@ var (
 	ErrInvalidUser = errors.New("invalid user")
 	// ErrPermissionDenied 权限被拒绝
 	ErrPermissionDenied = errors.New("permission denied")
+	// ErrUnsupportedOperation 授权器不支持的操作
+	ErrUnsupportedOperation = errors.New("unsupported operation")
 )
 
 // AuthzError 授权错误类型
@@ -0,0 +1,58 @@
+package authz
+
+import (
+	"context"
+)
+
+// Explanation 授权决策解释
+type Explanation struct {
+	// Allowed 是否授权
+	Allowed bool
+	// MatchedPolicies 命中并决定结果的策略，未命中时为空
+	MatchedPolicies []Policy
+	// RoleChain 主体在域内直接、间接继承的角色绑定，从主体出发排列
+	RoleChain []RoleBinding
+	// Trace 引擎特定的求值轨迹，如关系元组路径
+	Trace []string
+}
+
+// Explainer 授权解释接口，授权器可选实现，用于排查权限被拒绝的原因
+type Explainer interface {
+	// Explain 执行授权检查并返回决策依据，未授权时不返回错误
+	// ctx: 上下文信息
+	// sub: 主体
+	// obj: 对象
+	// act: 操作
+	// domain: 域
+	// 返回: 决策解释和可能的错误
+	Explain(ctx context.Context, sub Subject, obj Object, act Action, domain Domain) (*Explanation, error)
+}
+
+// ResolveRoleChain 广度优先解析主体在域内直接、间接继承的角色
+// ctx: 上下文信息
+// authorizer: 授权器，通过 GetRolesForUser 获取直接角色
+// sub: 主体
+// domain: 域
+// 返回: 经过的角色绑定和可能的错误
+func ResolveRoleChain(ctx context.Context, authorizer Authorizer, sub Subject, domain Domain) ([]RoleBinding, error) {
+	var chain []RoleBinding
+	visited := map[Subject]struct{}{sub: {}}
+	queue := []Subject{sub}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		roles, err := authorizer.GetRolesForUser(ctx, node, domain)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range roles {
+			chain = append(chain, RoleBinding{User: node, Role: role, Domain: domain})
+			if _, ok := visited[role]; ok {
+				continue
+			}
+			visited[role] = struct{}{}
+			queue = append(queue, role)
+		}
+	}
+	return chain, nil
+}
@@ -0,0 +1,160 @@
+package opa
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	fileadapter "github.com/casbin/casbin/v2/persist/file-adapter"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// defaultPolicy 内置的默认 Rego 策略
+//
+//go:embed policy.rego
+var defaultPolicy string
+
+// tupleModel 用于通过 casbin 适配器读取 p/g 规则的模型，字段布局与 casbin 默认模型一致
+const tupleModel = `
+[request_definition]
+r = sub, obj, act, dom
+
+[policy_definition]
+p = sub, obj, act, dom, eft
+
+[role_definition]
+g = _, _, _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = r.sub == p.sub
+`
+
+// loadModules 加载 Rego 策略模块，文件格式时支持单个文件或目录（忽略 _test.rego）
+func loadModules(options authz.Options) (map[string]string, error) {
+	switch options.ModelFormat {
+	case authz.ModelFormatText:
+		text := options.ModelText
+		if text == "" {
+			text = defaultPolicy
+		}
+		return map[string]string{"policy.rego": text}, nil
+	case authz.ModelFormatFile:
+		if options.ModelFile == "" {
+			return nil, authz.NewAuthzError(
+				authz.ErrCodeInvalidConfiguration,
+				"model file path is required for file model format",
+				nil,
+			)
+		}
+		modules := make(map[string]string)
+		err := filepath.WalkDir(options.ModelFile, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !isPolicyFile(path) {
+				return nil
+			}
+			buf, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			modules[path] = string(buf)
+			return nil
+		})
+		if err != nil {
+			return nil, authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to load rego policies", err)
+		}
+		if len(modules) == 0 {
+			return nil, authz.NewAuthzError(authz.ErrCodeInvalidConfiguration, "no rego policy found", nil)
+		}
+		return modules, nil
+	default:
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidConfiguration, "unsupported model format", nil)
+	}
+}
+
+// isPolicyFile 判断是否为 Rego 策略文件
+func isPolicyFile(path string) bool {
+	return strings.HasSuffix(path, ".rego") && !strings.HasSuffix(path, "_test.rego")
+}
+
+// loadDataFiles 加载 JSON 静态数据文件并按顺序合并
+func loadDataFiles(paths []string) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+	for _, path := range paths {
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			return nil, authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to read data file", err)
+		}
+		var doc map[string]interface{}
+		if err := json.Unmarshal(buf, &doc); err != nil {
+			return nil, authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to parse data file "+path, err)
+		}
+		for k, v := range doc {
+			data[k] = v
+		}
+	}
+	return data, nil
+}
+
+// newAdapter 根据配置创建持久化适配器，内存模式返回 nil
+func newAdapter(options authz.Options) (persist.Adapter, error) {
+	if adapter, ok := providerOption[persist.Adapter](options, providerOptionAdapter); ok && adapter != nil {
+		return adapter, nil
+	}
+	switch options.AdapterType {
+	case "", authz.AdapterMemory:
+		return nil, nil
+	case authz.AdapterFile:
+		if options.AdapterDSN == "" {
+			return nil, authz.NewAuthzError(
+				authz.ErrCodeInvalidConfiguration,
+				"adapter DSN (policy file path) is required for file adapter",
+				nil,
+			)
+		}
+		return fileadapter.NewAdapter(options.AdapterDSN), nil
+	default:
+		return nil, authz.NewAuthzError(
+			authz.ErrCodeInvalidConfiguration,
+			"adapter type "+string(options.AdapterType)+" requires WithAdapter for opa engine",
+			nil,
+		)
+	}
+}
+
+// loadTuples 通过适配器读取策略与角色规则
+func loadTuples(ctx context.Context, adapter persist.Adapter) (policies [][]string, roles [][]string, err error) {
+	if adapter == nil {
+		return nil, nil, nil
+	}
+	m, err := model.NewModelFromString(tupleModel)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ctxAdapter, ok := adapter.(persist.ContextAdapter); ok {
+		err = ctxAdapter.LoadPolicyCtx(ctx, m)
+	} else {
+		err = adapter.LoadPolicy(m)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if policies, err = m.GetPolicy("p", "p"); err != nil {
+		return nil, nil, err
+	}
+	if roles, err = m.GetPolicy("g", "g"); err != nil {
+		return nil, nil, err
+	}
+	return policies, roles, nil
+}
@@ -0,0 +1,756 @@
+package opa
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/fsnotify/fsnotify"
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/open-policy-agent/opa/v1/storage"
+	"github.com/open-policy-agent/opa/v1/storage/inmem"
+
+	"backend-service/pkg/auth/authz"
+)
+
+var (
+	_ authz.Authorizer    = (*OPAAuthorizer)(nil)
+	_ authz.PolicyManager = (*OPAAuthorizer)(nil)
+	_ authz.Explainer     = (*OPAAuthorizer)(nil)
+)
+
+// OPAAuthorizer 基于 OPA 的授权器，在进程内执行 Rego 策略
+// 策略与角色关系以 data.policies、data.roles 保存在 OPA 存储中，通过 casbin 适配器持久化
+// 策略模块只在加载时编译一次，规则变更以存储事务原地更新
+type OPAAuthorizer struct {
+	// options 配置选项
+	options authz.Options
+	// adapter 持久化适配器，为 nil 时仅保存在内存中
+	adapter persist.Adapter
+	// query 决策查询
+	query string
+
+	// mu 保护以下状态
+	mu sync.RWMutex
+	// modules Rego 策略模块
+	modules map[string]string
+	// data 静态数据
+	data map[string]interface{}
+	// policies 策略规则：sub, obj, act, dom, eft
+	policies [][]string
+	// roles 角色规则：user, role, dom
+	roles [][]string
+	// store 保存静态数据与规则的 OPA 存储，决策查询执行时读取
+	store storage.Store
+	// prepared 基于 store 预编译的决策查询
+	prepared rego.PreparedEvalQuery
+
+	// fileWatcher 策略文件观察者
+	fileWatcher *fsnotify.Watcher
+	// stop 停止后台重新加载
+	stop chan struct{}
+}
+
+// OPAProvider OPA授权提供者
+type OPAProvider struct{}
+
+// Name 获取提供者名称
+func (p *OPAProvider) Name() string {
+	return "opa"
+}
+
+// NewAuthorizer 创建新的授权器实例
+func (p *OPAProvider) NewAuthorizer(ctx context.Context, opts ...authz.Option) (authz.Authorizer, error) {
+	auth := new(OPAAuthorizer)
+	// 使用默认选项
+	auth.options = authz.DefaultOptions()
+	auth.options.EngineType = authz.EngineOPA
+
+	// 初始化授权器
+	if err := auth.Init(ctx, opts...); err != nil {
+		return nil, err
+	}
+
+	return auth, nil
+}
+
+// NewProvider 创建新的OPA授权提供者
+func NewProvider() authz.AuthzProvider {
+	return &OPAProvider{}
+}
+
+// Init 初始化授权器
+func (a *OPAAuthorizer) Init(ctx context.Context, opts ...authz.Option) error {
+	// 应用选项
+	for _, opt := range opts {
+		opt(&a.options)
+	}
+
+	a.query = defaultQuery
+	if query, ok := providerOption[string](a.options, providerOptionQuery); ok && query != "" {
+		a.query = query
+	}
+
+	var err error
+	if a.adapter, err = newAdapter(a.options); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	err = a.reloadLocked(ctx)
+	a.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	a.stop = make(chan struct{})
+	// 策略来自文件时监听文件变更，热加载策略
+	if err = a.watchFiles(); err != nil {
+		return authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to watch policy files", err)
+	}
+	// 定期全量重新加载，同步其它实例写入的策略
+	if interval, _ := providerOption[time.Duration](a.options, providerOptionReloadInterval); interval > 0 {
+		go a.reloadLoop(interval)
+	}
+	return nil
+}
+
+// Reload 重新加载策略文件、静态数据与持久化的策略规则，失败时保留原有策略
+func (a *OPAAuthorizer) Reload(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.reloadLocked(ctx)
+}
+
+// reloadLocked 重新加载全部策略，调用方需持有写锁
+// 策略模块未变更时只在存储事务中替换数据，不重新编译
+func (a *OPAAuthorizer) reloadLocked(ctx context.Context) error {
+	modules, err := loadModules(a.options)
+	if err != nil {
+		return err
+	}
+	dataFiles, _ := providerOption[[]string](a.options, providerOptionDataFiles)
+	data, err := loadDataFiles(dataFiles)
+	if err != nil {
+		return err
+	}
+	policies, roles, err := loadTuples(ctx, a.adapter)
+	if err != nil {
+		return authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to load policies from adapter", err)
+	}
+
+	doc := document(data, policies, roles)
+	if a.store != nil && maps.Equal(modules, a.modules) {
+		err = storage.Txn(ctx, a.store, storage.WriteParams, func(txn storage.Transaction) error {
+			return a.store.Write(ctx, txn, storage.ReplaceOp, storage.Path{}, doc)
+		})
+		if err != nil {
+			return authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to write rego data", err)
+		}
+	} else {
+		store := inmem.NewFromObject(doc)
+		prepared, err := a.prepare(ctx, modules, store)
+		if err != nil {
+			return err
+		}
+		a.store, a.prepared = store, prepared
+	}
+	a.modules, a.data, a.policies, a.roles = modules, data, policies, roles
+	return nil
+}
+
+// document 构造 OPA 存储的根文档，静态数据合并到根节点，policies、roles 为保留键
+func document(data map[string]interface{}, policies, roles [][]string) map[string]interface{} {
+	doc := make(map[string]interface{}, len(data)+2)
+	for k, v := range data {
+		doc[k] = v
+	}
+	doc["policies"] = ruleDocs("p", policies)
+	doc["roles"] = ruleDocs("g", roles)
+	return doc
+}
+
+// ruleDocs 转换规则为 Rego 数据
+func ruleDocs(sec string, rules [][]string) []interface{} {
+	docs := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		docs = append(docs, ruleDoc(sec, rule))
+	}
+	return docs
+}
+
+// ruleDoc 转换单条规则为 Rego 数据
+func ruleDoc(sec string, rule []string) map[string]interface{} {
+	if sec == "g" {
+		v := ruleValues(rule, 3)
+		return map[string]interface{}{"user": v[0], "role": v[1], "domain": v[2]}
+	}
+	v := ruleValues(rule, 5)
+	return map[string]interface{}{
+		"subject": v[0], "object": v[1], "action": v[2], "domain": v[3], "effect": v[4],
+	}
+}
+
+// ruleKey 返回规则段在 OPA 存储中的键
+func ruleKey(sec string) string {
+	if sec == "g" {
+		return "roles"
+	}
+	return "policies"
+}
+
+// prepare 编译策略模块，生成读取 store 的预编译决策查询
+func (a *OPAAuthorizer) prepare(ctx context.Context, modules map[string]string, store storage.Store) (rego.PreparedEvalQuery, error) {
+	opts := []func(*rego.Rego){
+		rego.Query(a.query),
+		rego.Store(store),
+	}
+	for name, module := range modules {
+		opts = append(opts, rego.Module(name, module))
+	}
+	prepared, err := rego.New(opts...).PrepareForEval(ctx)
+	if err != nil {
+		return rego.PreparedEvalQuery{}, authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to compile rego policies", err)
+	}
+	return prepared, nil
+}
+
+// ruleValues 将规则补齐为指定长度
+func ruleValues(rule []string, n int) []string {
+	v := make([]string, n)
+	copy(v, rule)
+	return v
+}
+
+// Enforce 执行授权检查
+func (a *OPAAuthorizer) Enforce(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (bool, error) {
+	// 检查参数
+	if sub == "" {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidSubject, "subject is required", nil)
+	}
+	if obj == "" {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidObject, "object is required", nil)
+	}
+	if act == "" {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidAction, "action is required", nil)
+	}
+
+	result, err := a.eval(ctx, sub, obj, act, domain)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeEnforceFailed, "enforce check failed", err)
+	}
+
+	// 如果未授权，返回权限被拒绝错误
+	if !result {
+		return false, authz.NewAuthzError(
+			authz.ErrCodePermissionDenied,
+			fmt.Sprintf("permission denied for %s to %s on %s in domain %s", sub, act, obj, domain),
+			nil,
+		)
+	}
+
+	return true, nil
+}
+
+// eval 执行决策查询
+func (a *OPAAuthorizer) eval(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (bool, error) {
+	a.mu.RLock()
+	prepared := a.prepared
+	a.mu.RUnlock()
+
+	rs, err := prepared.Eval(ctx, rego.EvalInput(map[string]interface{}{
+		"subject": string(sub),
+		"object":  string(obj),
+		"action":  string(act),
+		"domain":  string(domain),
+	}))
+	if err != nil {
+		return false, err
+	}
+	return rs.Allowed(), nil
+}
+
+// BatchEnforce 批量执行授权检查
+func (a *OPAAuthorizer) BatchEnforce(ctx context.Context, subjects []authz.Subject, objects []authz.Object, actions []authz.Action, domains []authz.Domain) ([]bool, error) {
+	// 检查参数长度一致性
+	if len(subjects) != len(objects) || len(subjects) != len(actions) || len(subjects) != len(domains) {
+		return nil, authz.NewAuthzError(
+			authz.ErrCodeBatchEnforceFailed,
+			"subjects, objects, actions, and domains must have the same length",
+			nil,
+		)
+	}
+
+	results := make([]bool, len(subjects))
+	for i := range subjects {
+		result, err := a.eval(ctx, subjects[i], objects[i], actions[i], domains[i])
+		if err != nil {
+			return nil, authz.NewAuthzError(authz.ErrCodeBatchEnforceFailed, "batch enforce check failed", err)
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// policyRule 转换策略为规则
+func policyRule(policy authz.Policy) []string {
+	effect := "allow"
+	if policy.Effect == authz.EffectDeny {
+		effect = "deny"
+	}
+	return []string{string(policy.Subject), string(policy.Object), string(policy.Action), string(policy.Domain), effect}
+}
+
+// validatePolicy 检查策略
+func validatePolicy(policy authz.Policy) error {
+	if policy.Subject == "" {
+		return authz.NewAuthzError(authz.ErrCodeInvalidPolicy, "subject is required in policy", nil)
+	}
+	if policy.Object == "" {
+		return authz.NewAuthzError(authz.ErrCodeInvalidPolicy, "object is required in policy", nil)
+	}
+	if policy.Action == "" {
+		return authz.NewAuthzError(authz.ErrCodeInvalidPolicy, "action is required in policy", nil)
+	}
+	return nil
+}
+
+// AddPolicy 添加策略
+func (a *OPAAuthorizer) AddPolicy(ctx context.Context, policy authz.Policy) (bool, error) {
+	if err := validatePolicy(policy); err != nil {
+		return false, err
+	}
+	added, err := a.addRules(ctx, "p", [][]string{policyRule(policy)})
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeAddPolicyFailed, "add policy failed", err)
+	}
+	return added, nil
+}
+
+// RemovePolicy 移除策略
+func (a *OPAAuthorizer) RemovePolicy(ctx context.Context, policy authz.Policy) (bool, error) {
+	if err := validatePolicy(policy); err != nil {
+		return false, err
+	}
+	removed, err := a.removeRules(ctx, "p", [][]string{policyRule(policy)})
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeRemovePolicyFailed, "remove policy failed", err)
+	}
+	return removed, nil
+}
+
+// AddPolicies 批量添加策略
+func (a *OPAAuthorizer) AddPolicies(ctx context.Context, policies []authz.Policy) (bool, error) {
+	if len(policies) == 0 {
+		return false, nil
+	}
+	rules := make([][]string, len(policies))
+	for i, policy := range policies {
+		rules[i] = policyRule(policy)
+	}
+	added, err := a.addRules(ctx, "p", rules)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeAddPoliciesFailed, "add policies failed", err)
+	}
+	return added, nil
+}
+
+// RemovePolicies 批量移除策略
+func (a *OPAAuthorizer) RemovePolicies(ctx context.Context, policies []authz.Policy) (bool, error) {
+	if len(policies) == 0 {
+		return false, nil
+	}
+	rules := make([][]string, len(policies))
+	for i, policy := range policies {
+		rules[i] = policyRule(policy)
+	}
+	removed, err := a.removeRules(ctx, "p", rules)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeRemovePoliciesFailed, "remove policies failed", err)
+	}
+	return removed, nil
+}
+
+// rulesOf 返回规则段对应的规则列表指针，调用方需持有锁
+func (a *OPAAuthorizer) rulesOf(sec string) *[][]string {
+	if sec == "g" {
+		return &a.roles
+	}
+	return &a.policies
+}
+
+// addRules 添加规则并持久化，已存在的规则忽略，返回是否有新增
+// 规则以存储事务追加到 data.policies 或 data.roles，无需重新编译
+func (a *OPAAuthorizer) addRules(ctx context.Context, sec string, rules [][]string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	current := a.rulesOf(sec)
+	seen := ruleSet(*current)
+	var added [][]string
+	for _, rule := range rules {
+		key := joinRule(rule)
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			added = append(added, rule)
+		}
+	}
+	if len(added) == 0 {
+		return false, nil
+	}
+	if err := a.persist(ctx, sec, added, true); err != nil {
+		return false, err
+	}
+	err := storage.Txn(ctx, a.store, storage.WriteParams, func(txn storage.Transaction) error {
+		for _, rule := range added {
+			if err := a.store.Write(ctx, txn, storage.AddOp, storage.Path{ruleKey(sec), "-"}, ruleDoc(sec, rule)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// 已持久化但未写入存储，重新加载以保持一致
+		_ = a.reloadLocked(ctx)
+		return false, err
+	}
+	*current = append(*current, added...)
+	return true, nil
+}
+
+// removeRules 移除规则并持久化，返回是否有规则被移除
+// 以存储事务替换 data.policies 或 data.roles，无需重新编译
+func (a *OPAAuthorizer) removeRules(ctx context.Context, sec string, rules [][]string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	current := a.rulesOf(sec)
+	existing := ruleSet(*current)
+	removed := make(map[string]struct{}, len(rules))
+	var removedRules [][]string
+	for _, rule := range rules {
+		key := joinRule(rule)
+		if _, ok := existing[key]; !ok {
+			continue
+		}
+		if _, ok := removed[key]; !ok {
+			removed[key] = struct{}{}
+			removedRules = append(removedRules, rule)
+		}
+	}
+	if len(removedRules) == 0 {
+		return false, nil
+	}
+	if err := a.persist(ctx, sec, removedRules, false); err != nil {
+		return false, err
+	}
+	kept := slices.DeleteFunc(slices.Clone(*current), func(r []string) bool {
+		_, ok := removed[joinRule(r)]
+		return ok
+	})
+	err := storage.Txn(ctx, a.store, storage.WriteParams, func(txn storage.Transaction) error {
+		return a.store.Write(ctx, txn, storage.ReplaceOp, storage.Path{ruleKey(sec)}, ruleDocs(sec, kept))
+	})
+	if err != nil {
+		_ = a.reloadLocked(ctx)
+		return false, err
+	}
+	*current = kept
+	return true, nil
+}
+
+// persist 通过适配器持久化规则变更，适配器支持上下文时以请求上下文写入，可加入调用方的数据库事务
+func (a *OPAAuthorizer) persist(ctx context.Context, sec string, rules [][]string, add bool) error {
+	switch adapter := a.adapter.(type) {
+	case nil:
+		return nil
+	case persist.ContextBatchAdapter:
+		if add {
+			return adapter.AddPoliciesCtx(ctx, sec, sec, rules)
+		}
+		return adapter.RemovePoliciesCtx(ctx, sec, sec, rules)
+	case persist.BatchAdapter:
+		if add {
+			return adapter.AddPolicies(sec, sec, rules)
+		}
+		return adapter.RemovePolicies(sec, sec, rules)
+	}
+	for _, rule := range rules {
+		var err error
+		if add {
+			err = a.adapter.AddPolicy(sec, sec, rule)
+		} else {
+			err = a.adapter.RemovePolicy(sec, sec, rule)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// containsRule 判断规则列表是否包含规则
+func containsRule(rules [][]string, rule []string) bool {
+	return slices.ContainsFunc(rules, func(r []string) bool { return slices.Equal(r, rule) })
+}
+
+// ruleSet 返回规则列表的集合，批量增删规则时以集合判断规则是否存在
+func ruleSet(rules [][]string) map[string]struct{} {
+	set := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		set[joinRule(rule)] = struct{}{}
+	}
+	return set
+}
+
+// joinRule 返回规则在集合中的键，以 NUL 分隔字段，字段中含逗号时也不会混淆
+func joinRule(rule []string) string {
+	return strings.Join(rule, "\x00")
+}
+
+// column 返回规则列表指定列去重后的值
+func (a *OPAAuthorizer) column(sec string, index int) []string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	var values []string
+	for _, rule := range *a.rulesOf(sec) {
+		if index < len(rule) && !slices.Contains(values, rule[index]) {
+			values = append(values, rule[index])
+		}
+	}
+	return values
+}
+
+// GetAllSubjects 获取所有主体
+func (a *OPAAuthorizer) GetAllSubjects(ctx context.Context) ([]authz.Subject, error) {
+	return convert[authz.Subject](a.column("p", 0)), nil
+}
+
+// GetAllObjects 获取所有对象
+func (a *OPAAuthorizer) GetAllObjects(ctx context.Context) ([]authz.Object, error) {
+	return convert[authz.Object](a.column("p", 1)), nil
+}
+
+// GetAllActions 获取所有操作
+func (a *OPAAuthorizer) GetAllActions(ctx context.Context) ([]authz.Action, error) {
+	return convert[authz.Action](a.column("p", 2)), nil
+}
+
+// GetAllDomains 获取所有域
+func (a *OPAAuthorizer) GetAllDomains(ctx context.Context) ([]authz.Domain, error) {
+	return convert[authz.Domain](a.column("p", 3)), nil
+}
+
+// GetAllRoles 获取所有角色
+func (a *OPAAuthorizer) GetAllRoles(ctx context.Context) ([]authz.Subject, error) {
+	return convert[authz.Subject](a.column("g", 1)), nil
+}
+
+// convert 转换为授权类型
+func convert[T ~string](values []string) []T {
+	result := make([]T, len(values))
+	for i, v := range values {
+		result[i] = T(v)
+	}
+	return result
+}
+
+// GetRolesForUser 获取用户的直接角色
+func (a *OPAAuthorizer) GetRolesForUser(ctx context.Context, user authz.Subject, domain authz.Domain) ([]authz.Subject, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	result := make([]authz.Subject, 0)
+	for _, r := range a.roles {
+		v := ruleValues(r, 3)
+		if v[0] == string(user) && v[2] == string(domain) {
+			result = append(result, authz.Subject(v[1]))
+		}
+	}
+	return result, nil
+}
+
+// GetUsersForRole 获取角色的直接用户
+func (a *OPAAuthorizer) GetUsersForRole(ctx context.Context, role authz.Subject, domain authz.Domain) ([]authz.Subject, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	result := make([]authz.Subject, 0)
+	for _, r := range a.roles {
+		v := ruleValues(r, 3)
+		if v[1] == string(role) && v[2] == string(domain) {
+			result = append(result, authz.Subject(v[0]))
+		}
+	}
+	return result, nil
+}
+
+// HasRoleForUser 检查用户是否直接拥有角色
+func (a *OPAAuthorizer) HasRoleForUser(ctx context.Context, user authz.Subject, role authz.Subject, domain authz.Domain) (bool, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return containsRule(a.roles, []string{string(user), string(role), string(domain)}), nil
+}
+
+// AddRoleForUser 为用户添加角色
+func (a *OPAAuthorizer) AddRoleForUser(ctx context.Context, user authz.Subject, role authz.Subject, domain authz.Domain) (bool, error) {
+	added, err := a.addRules(ctx, "g", [][]string{{string(user), string(role), string(domain)}})
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeAddRoleForUserFailed, "add role for user failed", err)
+	}
+	return added, nil
+}
+
+// DeleteRoleForUser 删除用户的角色
+func (a *OPAAuthorizer) DeleteRoleForUser(ctx context.Context, user authz.Subject, role authz.Subject, domain authz.Domain) (bool, error) {
+	deleted, err := a.removeRules(ctx, "g", [][]string{{string(user), string(role), string(domain)}})
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeDeleteRoleForUserFailed, "delete role for user failed", err)
+	}
+	return deleted, nil
+}
+
+// GetPolicies 获取策略，domain 为空时返回全部域的策略
+func (a *OPAAuthorizer) GetPolicies(ctx context.Context, domain authz.Domain) ([]authz.Policy, error) {
+	return a.GetFilteredPolicies(ctx, authz.Policy{Domain: domain})
+}
+
+// GetFilteredPolicies 按条件获取策略
+func (a *OPAAuthorizer) GetFilteredPolicies(ctx context.Context, filter authz.Policy) ([]authz.Policy, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	result := make([]authz.Policy, 0)
+	for _, p := range a.policies {
+		if policy := policyFromRule(p); filter.Match(policy) {
+			result = append(result, policy)
+		}
+	}
+	return result, nil
+}
+
+// policyFromRule 转换 p 规则为授权策略，字段布局为 sub, obj, act, dom, eft
+func policyFromRule(rule []string) authz.Policy {
+	v := ruleValues(rule, 5)
+	effect := authz.EffectAllow
+	if v[4] == "deny" {
+		effect = authz.EffectDeny
+	}
+	return authz.Policy{
+		Subject: authz.Subject(v[0]),
+		Object:  authz.Object(v[1]),
+		Action:  authz.Action(v[2]),
+		Domain:  authz.Domain(v[3]),
+		Effect:  effect,
+	}
+}
+
+// GetRoleBindings 获取角色绑定，domain 为空时返回全部域的角色绑定
+func (a *OPAAuthorizer) GetRoleBindings(ctx context.Context, domain authz.Domain) ([]authz.RoleBinding, error) {
+	return a.GetFilteredRoleBindings(ctx, authz.RoleBinding{Domain: domain})
+}
+
+// GetFilteredRoleBindings 按条件获取角色绑定
+func (a *OPAAuthorizer) GetFilteredRoleBindings(ctx context.Context, filter authz.RoleBinding) ([]authz.RoleBinding, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	result := make([]authz.RoleBinding, 0)
+	for _, r := range a.roles {
+		v := ruleValues(r, 3)
+		if binding := (authz.RoleBinding{User: authz.Subject(v[0]), Role: authz.Subject(v[1]), Domain: authz.Domain(v[2])}); filter.Match(binding) {
+			result = append(result, binding)
+		}
+	}
+	return result, nil
+}
+
+// AddRoleBindings 批量添加角色绑定，全部新增在一次适配器写入中完成
+func (a *OPAAuthorizer) AddRoleBindings(ctx context.Context, bindings []authz.RoleBinding) (bool, error) {
+	rules := make([][]string, len(bindings))
+	for i, b := range bindings {
+		rules[i] = []string{string(b.User), string(b.Role), string(b.Domain)}
+	}
+	added, err := a.addRules(ctx, "g", rules)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeAddRoleForUserFailed, "add role bindings failed", err)
+	}
+	return added, nil
+}
+
+// RemoveRoleBindings 批量移除角色绑定，全部移除在一次适配器写入中完成
+func (a *OPAAuthorizer) RemoveRoleBindings(ctx context.Context, bindings []authz.RoleBinding) (bool, error) {
+	rules := make([][]string, len(bindings))
+	for i, b := range bindings {
+		rules[i] = []string{string(b.User), string(b.Role), string(b.Domain)}
+	}
+	removed, err := a.removeRules(ctx, "g", rules)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeDeleteRoleForUserFailed, "remove role bindings failed", err)
+	}
+	return removed, nil
+}
+
+// Explain 执行决策查询，并按默认策略的匹配规则（主体或其继承的角色、对象、操作、域均相等）列出命中的策略
+// 自定义 Rego 策略的匹配规则不同时，命中的策略仅供参考，决策结果以查询为准
+func (a *OPAAuthorizer) Explain(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (*authz.Explanation, error) {
+	// 检查参数
+	if sub == "" {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidSubject, "subject is required", nil)
+	}
+	if obj == "" {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidObject, "object is required", nil)
+	}
+	if act == "" {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidAction, "action is required", nil)
+	}
+
+	allowed, err := a.eval(ctx, sub, obj, act, domain)
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeEnforceFailed, "enforce check failed", err)
+	}
+	chain, err := authz.ResolveRoleChain(ctx, a, sub, domain)
+	if err != nil {
+		return nil, err
+	}
+	subjects := map[authz.Subject]struct{}{sub: {}}
+	for _, b := range chain {
+		subjects[b.Role] = struct{}{}
+	}
+
+	explanation := &authz.Explanation{Allowed: allowed, RoleChain: chain}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, p := range a.policies {
+		policy := policyFromRule(p)
+		if _, ok := subjects[policy.Subject]; ok && policy.Object == obj && policy.Action == act && policy.Domain == domain {
+			explanation.MatchedPolicies = append(explanation.MatchedPolicies, policy)
+		}
+	}
+	return explanation, nil
+}
+
+// Name 获取授权器名称
+func (a *OPAAuthorizer) Name() string {
+	return "opa"
+}
+
+// Options implements authz.Authorizer.
+func (a *OPAAuthorizer) Options() authz.Options {
+	return a.options
+}
+
+// Close 关闭授权器，停止热加载
+func (a *OPAAuthorizer) Close() error {
+	if a.stop != nil {
+		close(a.stop)
+		a.stop = nil
+	}
+	if a.fileWatcher != nil {
+		err := a.fileWatcher.Close()
+		a.fileWatcher = nil
+		return err
+	}
+	return nil
+}
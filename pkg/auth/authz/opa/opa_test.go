@@ -0,0 +1,174 @@
+package opa
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"backend-service/pkg/auth/authz"
+	authzCasbin "backend-service/pkg/auth/authz/casbin"
+)
+
+func TestDefaultPolicy(t *testing.T) {
+	ctx := context.Background()
+	adapter := authzCasbin.NewMemoryAdapter()
+	a, err := NewProvider().NewAuthorizer(ctx, WithAdapter(adapter))
+	require.NoError(t, err)
+	defer a.Close()
+
+	_, err = a.AddPolicies(ctx, []authz.Policy{
+		{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "default"},
+		{Subject: "admin", Object: "/api/users", Action: "DELETE", Domain: "default"},
+		{Subject: "auditor", Object: "/api/users", Action: "DELETE", Domain: "default", Effect: authz.EffectDeny},
+	})
+	require.NoError(t, err)
+	_, err = a.AddRoleForUser(ctx, "manager", "admin", "default")
+	require.NoError(t, err)
+	_, err = a.AddRoleForUser(ctx, "alice", "manager", "default")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		sub     authz.Subject
+		act     authz.Action
+		domain  authz.Domain
+		allowed bool
+	}{
+		{name: "inherited role", sub: "alice", act: "GET", domain: "default", allowed: true},
+		{name: "other domain", sub: "alice", act: "GET", domain: "tenant", allowed: false},
+		{name: "no role", sub: "bob", act: "GET", domain: "default", allowed: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := a.Enforce(ctx, tt.sub, "/api/users", tt.act, tt.domain)
+			assert.Equal(t, tt.allowed, allowed)
+			if !tt.allowed {
+				code, _ := authz.GetAuthzErrorCode(err)
+				assert.Equal(t, authz.ErrCodePermissionDenied, code)
+			}
+		})
+	}
+
+	// 拒绝优先
+	_, err = a.AddRoleForUser(ctx, "alice", "auditor", "default")
+	require.NoError(t, err)
+	allowed, _ := a.Enforce(ctx, "alice", "/api/users", "DELETE", "default")
+	assert.False(t, allowed)
+
+	// 策略与角色通过适配器持久化，新实例可加载
+	b, err := NewProvider().NewAuthorizer(ctx, WithAdapter(adapter))
+	require.NoError(t, err)
+	defer b.Close()
+	allowed, _ = b.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	assert.True(t, allowed)
+	roles, _ := b.GetRolesForUser(ctx, "alice", "default")
+	assert.ElementsMatch(t, []authz.Subject{"manager", "auditor"}, roles)
+}
+
+func TestStoreUpdatedInPlace(t *testing.T) {
+	ctx := context.Background()
+	adapter := authzCasbin.NewMemoryAdapter()
+	authorizer, err := NewProvider().NewAuthorizer(ctx, WithAdapter(adapter))
+	require.NoError(t, err)
+	defer authorizer.Close()
+	a := authorizer.(*OPAAuthorizer)
+	store := a.store
+
+	// 规则变更在存储事务中原地更新，不重新编译、不替换存储
+	admin := authz.Policy{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "default"}
+	_, err = a.AddPolicy(ctx, admin)
+	require.NoError(t, err)
+	_, err = a.AddRoleForUser(ctx, "alice", "admin", "default")
+	require.NoError(t, err)
+	allowed, _ := a.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	assert.True(t, allowed)
+	_, err = a.DeleteRoleForUser(ctx, "alice", "admin", "default")
+	require.NoError(t, err)
+	allowed, _ = a.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	assert.False(t, allowed)
+	assert.Same(t, store, a.store)
+
+	// 策略模块未变更时重新加载只替换数据
+	require.NoError(t, adapter.AddPolicy("g", "g", []string{"bob", "admin", "default"}))
+	require.NoError(t, a.Reload(ctx))
+	allowed, _ = a.Enforce(ctx, "bob", "/api/users", "GET", "default")
+	assert.True(t, allowed)
+	assert.Same(t, store, a.store)
+}
+
+func TestRulesDedup(t *testing.T) {
+	ctx := context.Background()
+	authorizer, err := NewProvider().NewAuthorizer(ctx, WithAdapter(authzCasbin.NewMemoryAdapter()))
+	require.NoError(t, err)
+	defer authorizer.Close()
+	a := authorizer.(*OPAAuthorizer)
+
+	// 批量中重复的规则与已存在的规则只保留一条，字段含逗号的规则不混淆
+	users := authz.Policy{Subject: "admin", Object: "/api/users", Action: "GET", Domain: "default"}
+	comma := authz.Policy{Subject: "admin,/api", Object: "users", Action: "GET", Domain: "default"}
+	other := authz.Policy{Subject: "admin", Object: "/api,users", Action: "GET", Domain: "default"}
+	ok, err := a.AddPolicies(ctx, []authz.Policy{users, users})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = a.AddPolicies(ctx, []authz.Policy{users, comma, comma, other})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Len(t, a.policies, 3)
+	ok, err = a.AddPolicies(ctx, []authz.Policy{users, comma, other})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = a.RemovePolicies(ctx, []authz.Policy{users, users, {Subject: "guest", Object: "/api/users", Action: "GET", Domain: "default"}})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Len(t, a.policies, 2)
+	ok, err = a.RemovePolicies(ctx, []authz.Policy{users})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestCustomPolicy(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	policyFile := filepath.Join(dir, "owner.rego")
+	dataFile := filepath.Join(dir, "data.json")
+	require.NoError(t, os.WriteFile(policyFile, []byte(`package authz
+
+default allow := false
+
+allow if data.owners[input.object] == input.subject
+`), 0o600))
+	require.NoError(t, os.WriteFile(dataFile, []byte(`{"owners": {"/api/posts/1": "alice"}}`), 0o600))
+
+	a, err := NewProvider().NewAuthorizer(ctx,
+		authz.WithModelFormat(authz.ModelFormatFile),
+		authz.WithModelFile(dir),
+		WithDataFiles(dataFile),
+	)
+	require.NoError(t, err)
+	defer a.Close()
+
+	allowed, _ := a.Enforce(ctx, "alice", "/api/posts/1", "PUT", "default")
+	assert.True(t, allowed)
+	allowed, _ = a.Enforce(ctx, "bob", "/api/posts/1", "PUT", "default")
+	assert.False(t, allowed)
+
+	// 数据文件变更后热加载
+	require.NoError(t, os.WriteFile(dataFile, []byte(`{"owners": {"/api/posts/1": "bob"}}`), 0o600))
+	assert.Eventually(t, func() bool {
+		allowed, _ := a.Enforce(ctx, "bob", "/api/posts/1", "PUT", "default")
+		return allowed
+	}, 2*time.Second, 20*time.Millisecond)
+
+	// 策略文件编译失败时保留原有策略
+	require.NoError(t, os.WriteFile(policyFile, []byte(`package authz
+allow if {`), 0o600))
+	time.Sleep(100 * time.Millisecond)
+	allowed, _ = a.Enforce(ctx, "bob", "/api/posts/1", "PUT", "default")
+	assert.True(t, allowed)
+}
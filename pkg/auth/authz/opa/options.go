@@ -0,0 +1,56 @@
+package opa
+
+import (
+	"time"
+
+	"github.com/casbin/casbin/v2/persist"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// 提供者特定选项键
+const (
+	// providerOptionAdapter 策略与角色的持久化适配器
+	providerOptionAdapter = "opa.adapter"
+	// providerOptionQuery 决策查询
+	providerOptionQuery = "opa.query"
+	// providerOptionDataFiles 静态数据文件
+	providerOptionDataFiles = "opa.data_files"
+	// providerOptionReloadInterval 定期全量重新加载的间隔
+	providerOptionReloadInterval = "opa.reload_interval"
+)
+
+// defaultQuery 默认决策查询
+const defaultQuery = "data.authz.allow"
+
+// WithAdapter 设置策略与角色的持久化适配器，与 casbin 共用 p/g 规则格式
+// 可直接复用 casbin 的文件、数据库适配器，未设置时策略仅保存在内存中
+func WithAdapter(adapter persist.Adapter) authz.Option {
+	return authz.WithProviderOption(providerOptionAdapter, adapter)
+}
+
+// WithQuery 设置决策查询，结果需为布尔值，默认为 data.authz.allow
+func WithQuery(query string) authz.Option {
+	return authz.WithProviderOption(providerOptionQuery, query)
+}
+
+// WithDataFiles 设置 JSON 格式的静态数据文件，内容合并到 data 根节点，policies、roles 为保留键
+func WithDataFiles(paths ...string) authz.Option {
+	return authz.WithProviderOption(providerOptionDataFiles, paths)
+}
+
+// WithReloadInterval 设置定期全量重新加载策略文件与持久化数据的间隔，0 表示不启用
+func WithReloadInterval(interval time.Duration) authz.Option {
+	return authz.WithProviderOption(providerOptionReloadInterval, interval)
+}
+
+// providerOption 读取提供者特定选项
+func providerOption[T any](options authz.Options, key string) (T, bool) {
+	var zero T
+	values, ok := options.ProviderOptions.(map[string]interface{})
+	if !ok {
+		return zero, false
+	}
+	value, ok := values[key].(T)
+	return value, ok
+}
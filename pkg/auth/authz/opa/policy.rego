@@ -0,0 +1,35 @@
+# 默认策略：带域的 RBAC，拒绝优先，与 casbin 默认模型语义一致
+# data.policies 为 {subject, object, action, domain, effect} 列表
+# data.roles 为 {user, role, domain} 列表
+package authz
+
+default allow := false
+
+allow if {
+	some p in data.policies
+	p.effect == "allow"
+	matched(p)
+	not deny
+}
+
+deny if {
+	some p in data.policies
+	p.effect == "deny"
+	matched(p)
+}
+
+matched(p) if {
+	p.object == input.object
+	p.action == input.action
+	p.domain == input.domain
+	p.subject in subjects
+}
+
+# role_graph 当前域内用户（或角色）到其直接角色的映射，角色本身也需作为节点出现
+role_graph[node] := {r.role | some r in data.roles; r.domain == input.domain; r.user == node} if {
+	some x in data.roles
+	some node in [x.user, x.role]
+}
+
+# subjects 请求主体及其直接、间接继承的全部角色
+subjects := graph.reachable(role_graph, {input.subject}) | {input.subject}
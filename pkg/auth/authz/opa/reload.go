@@ -0,0 +1,88 @@
+package opa
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// watchFiles 监听策略文件与静态数据文件的变更，变更后重新加载
+// 监听文件所在目录，以兼容编辑器先写临时文件再重命名的保存方式
+func (a *OPAAuthorizer) watchFiles() error {
+	var files []string
+	if a.options.ModelFormat == authz.ModelFormatFile {
+		files = append(files, a.options.ModelFile)
+	}
+	dataFiles, _ := providerOption[[]string](a.options, providerOptionDataFiles)
+	files = append(files, dataFiles...)
+	if len(files) == 0 {
+		return nil
+	}
+
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	var dirs, watched []string
+	for _, file := range files {
+		path, err := filepath.Abs(file)
+		if err != nil {
+			_ = w.Close()
+			return err
+		}
+		dir := path
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			dir = filepath.Dir(path)
+			watched = append(watched, path)
+		}
+		if !slices.Contains(dirs, dir) {
+			if err := w.Add(dir); err != nil {
+				_ = w.Close()
+				return err
+			}
+			dirs = append(dirs, dir)
+		}
+	}
+	a.fileWatcher = w
+
+	go func() {
+		for {
+			select {
+			case event, ok := <-w.Events:
+				if !ok {
+					return
+				}
+				// 目录下的 Rego 文件或被监听的数据文件变更时重新加载
+				if isPolicyFile(event.Name) || slices.Contains(watched, event.Name) {
+					_ = a.Reload(context.Background())
+				}
+			case _, ok := <-w.Errors:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// reloadLoop 按固定间隔全量重新加载
+func (a *OPAAuthorizer) reloadLoop(interval time.Duration) {
+	stop := a.stop
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			_ = a.Reload(context.Background())
+		case <-stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package remote
+
+import (
+	"time"
+
+	kratosGrpc "github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// 提供者特定选项键
+const (
+	// providerOptionClient 远程授权服务客户端
+	providerOptionClient = "remote.client"
+	// providerOptionClientFactory 基于 gRPC 连接创建客户端的工厂
+	providerOptionClientFactory = "remote.client_factory"
+	// providerOptionDialOptions 拨号选项
+	providerOptionDialOptions = "remote.dial_options"
+	// providerOptionTimeout 单次调用超时
+	providerOptionTimeout = "remote.timeout"
+	// providerOptionToken 访问令牌
+	providerOptionToken = "remote.token"
+)
+
+// defaultTimeout 默认单次调用超时
+const defaultTimeout = time.Second
+
+// ClientFactory 基于 gRPC 连接创建远程授权服务客户端
+type ClientFactory func(conn grpc.ClientConnInterface) Client
+
+// WithClient 设置远程授权服务客户端，设置后不再按 RemoteURL 拨号
+func WithClient(client Client) authz.Option {
+	return authz.WithProviderOption(providerOptionClient, client)
+}
+
+// WithClientFactory 设置客户端工厂，授权器按 authz.WithRemoteURL 拨号后通过工厂适配具体的 RPC 接口
+func WithClientFactory(factory ClientFactory) authz.Option {
+	return authz.WithProviderOption(providerOptionClientFactory, factory)
+}
+
+// WithDialOptions 设置拨号选项，如 TLS、服务发现与客户端中间件
+func WithDialOptions(opts ...kratosGrpc.ClientOption) authz.Option {
+	return authz.WithProviderOption(providerOptionDialOptions, opts)
+}
+
+// WithTimeout 设置单次调用超时，默认 1s
+func WithTimeout(timeout time.Duration) authz.Option {
+	return authz.WithProviderOption(providerOptionTimeout, timeout)
+}
+
+// WithToken 设置调用授权服务的访问令牌，以 Bearer 方式放入请求元数据
+func WithToken(token string) authz.Option {
+	return authz.WithProviderOption(providerOptionToken, token)
+}
+
+// providerOption 读取提供者特定选项
+func providerOption[T any](options authz.Options, key string) (T, bool) {
+	var zero T
+	values, ok := options.ProviderOptions.(map[string]interface{})
+	if !ok {
+		return zero, false
+	}
+	value, ok := values[key].(T)
+	return value, ok
+}
@@ -0,0 +1,281 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	kratosGrpc "github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"backend-service/pkg/auth/authn"
+	"backend-service/pkg/auth/authz"
+	"backend-service/pkg/auth/authz/cache"
+)
+
+var _ authz.Authorizer = (*RemoteAuthorizer)(nil)
+
+// Client 远程授权服务客户端，由调用方适配具体的 RPC 接口
+type Client interface {
+	// IsAuthorized 查询主体能否在域内对对象执行操作
+	// ctx: 上下文信息
+	// sub: 主体
+	// obj: 对象
+	// act: 操作
+	// domain: 域
+	// 返回: 是否授权和可能的错误，未授权时不返回错误
+	IsAuthorized(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (bool, error)
+}
+
+// RemoteAuthorizer 远程授权器，将授权决策委托给授权服务
+// 只支持授权检查，策略与角色由授权服务管理
+type RemoteAuthorizer struct {
+	// options 配置选项
+	options authz.Options
+	// client 远程授权服务客户端
+	client Client
+	// conn 按 RemoteURL 拨号建立的连接，使用 WithClient 时为 nil
+	conn io.Closer
+	// timeout 单次调用超时
+	timeout time.Duration
+	// token 访问令牌
+	token string
+	// cache 决策缓存，未启用时为 nil
+	cache *cache.DecisionCache
+}
+
+// RemoteProvider 远程授权提供者
+type RemoteProvider struct{}
+
+// Name 获取提供者名称
+func (p *RemoteProvider) Name() string {
+	return "remote"
+}
+
+// NewAuthorizer 创建新的授权器实例
+func (p *RemoteProvider) NewAuthorizer(ctx context.Context, opts ...authz.Option) (authz.Authorizer, error) {
+	auth := new(RemoteAuthorizer)
+	// 使用默认选项
+	auth.options = authz.DefaultOptions()
+	auth.options.Mode = authz.ModeRemote
+
+	// 初始化授权器
+	if err := auth.Init(ctx, opts...); err != nil {
+		return nil, err
+	}
+
+	return auth, nil
+}
+
+// NewProvider 创建新的远程授权提供者
+func NewProvider() authz.AuthzProvider {
+	return &RemoteProvider{}
+}
+
+// Init 初始化授权器
+func (a *RemoteAuthorizer) Init(ctx context.Context, opts ...authz.Option) error {
+	// 应用选项
+	for _, opt := range opts {
+		opt(&a.options)
+	}
+
+	a.timeout = defaultTimeout
+	if timeout, ok := providerOption[time.Duration](a.options, providerOptionTimeout); ok && timeout > 0 {
+		a.timeout = timeout
+	}
+	a.token, _ = providerOption[string](a.options, providerOptionToken)
+
+	var err error
+	if a.cache, err = cache.NewFromOptions(a.options); err != nil {
+		return err
+	}
+
+	if client, ok := providerOption[Client](a.options, providerOptionClient); ok && client != nil {
+		a.client = client
+		return nil
+	}
+	factory, ok := providerOption[ClientFactory](a.options, providerOptionClientFactory)
+	if !ok || factory == nil {
+		return authz.NewAuthzError(authz.ErrCodeInvalidConfiguration, "remote client or client factory is required", nil)
+	}
+	if a.options.RemoteURL == "" {
+		return authz.NewAuthzError(authz.ErrCodeInvalidConfiguration, "remote url is required", nil)
+	}
+	dialOpts, _ := providerOption[[]kratosGrpc.ClientOption](a.options, providerOptionDialOptions)
+	conn, err := kratosGrpc.DialInsecure(ctx, append([]kratosGrpc.ClientOption{kratosGrpc.WithEndpoint(a.options.RemoteURL)}, dialOpts...)...)
+	if err != nil {
+		return authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to dial remote authorizer", err)
+	}
+	a.conn = conn
+	a.client = factory(conn)
+	return nil
+}
+
+// Enforce 执行授权检查，优先使用缓存的决策
+// 调用失败时返回错误且不缓存，由调用方按拒绝处理
+func (a *RemoteAuthorizer) Enforce(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (bool, error) {
+	// 检查参数
+	if sub == "" {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidSubject, "subject is required", nil)
+	}
+	if obj == "" {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidObject, "object is required", nil)
+	}
+	if act == "" {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidAction, "action is required", nil)
+	}
+
+	key := cache.Key{Subject: string(sub), Object: string(obj), Action: string(act), Domain: string(domain)}
+	result, found := false, false
+	if a.cache != nil {
+		result, found = a.cache.Get(ctx, key)
+	}
+	if !found {
+		var epoch cache.Epoch
+		if a.cache != nil {
+			epoch = a.cache.Epoch(ctx)
+		}
+		var err error
+		if result, err = a.isAuthorized(ctx, sub, obj, act, domain); err != nil {
+			return false, authz.NewAuthzError(authz.ErrCodeEnforceFailed, "remote enforce check failed", err)
+		}
+		if a.cache != nil {
+			a.cache.Set(ctx, key, result, epoch)
+		}
+	}
+
+	// 如果未授权，返回权限被拒绝错误
+	if !result {
+		return false, authz.NewAuthzError(
+			authz.ErrCodePermissionDenied,
+			fmt.Sprintf("permission denied for %s to %s on %s in domain %s", sub, act, obj, domain),
+			nil,
+		)
+	}
+
+	return result, nil
+}
+
+// isAuthorized 在超时内调用远程授权服务
+func (a *RemoteAuthorizer) isAuthorized(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+	if a.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, authn.HeaderAuthorize, authn.BearerWord+" "+a.token)
+	}
+	return a.client.IsAuthorized(ctx, sub, obj, act, domain)
+}
+
+// BatchEnforce 批量执行授权检查，未授权的请求结果为 false
+func (a *RemoteAuthorizer) BatchEnforce(ctx context.Context, subjects []authz.Subject, objects []authz.Object, actions []authz.Action, domains []authz.Domain) ([]bool, error) {
+	// 检查参数长度一致性
+	if len(subjects) != len(objects) || len(subjects) != len(actions) || len(subjects) != len(domains) {
+		return nil, authz.NewAuthzError(authz.ErrCodeBatchEnforceFailed, "subjects, objects, actions and domains must have the same length", nil)
+	}
+
+	results := make([]bool, len(subjects))
+	for i := range subjects {
+		allowed, err := a.Enforce(ctx, subjects[i], objects[i], actions[i], domains[i])
+		if err != nil {
+			if code, _ := authz.GetAuthzErrorCode(err); code != authz.ErrCodePermissionDenied {
+				return nil, authz.NewAuthzError(authz.ErrCodeBatchEnforceFailed, "batch enforce check failed", err)
+			}
+		}
+		results[i] = allowed
+	}
+	return results, nil
+}
+
+// unsupported 返回不支持的操作错误，策略与角色由授权服务管理
+func unsupported(operation string) error {
+	return authz.NewAuthzError(authz.ErrCodeUnsupportedOperation, operation+" is not supported by remote authorizer", authz.ErrUnsupportedOperation)
+}
+
+// AddPolicy 添加策略
+func (a *RemoteAuthorizer) AddPolicy(ctx context.Context, policy authz.Policy) (bool, error) {
+	return false, unsupported("AddPolicy")
+}
+
+// RemovePolicy 移除策略
+func (a *RemoteAuthorizer) RemovePolicy(ctx context.Context, policy authz.Policy) (bool, error) {
+	return false, unsupported("RemovePolicy")
+}
+
+// AddPolicies 批量添加策略
+func (a *RemoteAuthorizer) AddPolicies(ctx context.Context, policies []authz.Policy) (bool, error) {
+	return false, unsupported("AddPolicies")
+}
+
+// RemovePolicies 批量移除策略
+func (a *RemoteAuthorizer) RemovePolicies(ctx context.Context, policies []authz.Policy) (bool, error) {
+	return false, unsupported("RemovePolicies")
+}
+
+// GetAllSubjects 获取所有主体
+func (a *RemoteAuthorizer) GetAllSubjects(ctx context.Context) ([]authz.Subject, error) {
+	return nil, unsupported("GetAllSubjects")
+}
+
+// GetAllObjects 获取所有对象
+func (a *RemoteAuthorizer) GetAllObjects(ctx context.Context) ([]authz.Object, error) {
+	return nil, unsupported("GetAllObjects")
+}
+
+// GetAllActions 获取所有操作
+func (a *RemoteAuthorizer) GetAllActions(ctx context.Context) ([]authz.Action, error) {
+	return nil, unsupported("GetAllActions")
+}
+
+// GetAllDomains 获取所有域
+func (a *RemoteAuthorizer) GetAllDomains(ctx context.Context) ([]authz.Domain, error) {
+	return nil, unsupported("GetAllDomains")
+}
+
+// GetAllRoles 获取所有角色
+func (a *RemoteAuthorizer) GetAllRoles(ctx context.Context) ([]authz.Subject, error) {
+	return nil, unsupported("GetAllRoles")
+}
+
+// GetRolesForUser 获取用户的角色
+func (a *RemoteAuthorizer) GetRolesForUser(ctx context.Context, user authz.Subject, domain authz.Domain) ([]authz.Subject, error) {
+	return nil, unsupported("GetRolesForUser")
+}
+
+// GetUsersForRole 获取角色的用户
+func (a *RemoteAuthorizer) GetUsersForRole(ctx context.Context, role authz.Subject, domain authz.Domain) ([]authz.Subject, error) {
+	return nil, unsupported("GetUsersForRole")
+}
+
+// HasRoleForUser 检查用户是否拥有角色
+func (a *RemoteAuthorizer) HasRoleForUser(ctx context.Context, user authz.Subject, role authz.Subject, domain authz.Domain) (bool, error) {
+	return false, unsupported("HasRoleForUser")
+}
+
+// AddRoleForUser 为用户添加角色
+func (a *RemoteAuthorizer) AddRoleForUser(ctx context.Context, user authz.Subject, role authz.Subject, domain authz.Domain) (bool, error) {
+	return false, unsupported("AddRoleForUser")
+}
+
+// DeleteRoleForUser 删除用户的角色
+func (a *RemoteAuthorizer) DeleteRoleForUser(ctx context.Context, user authz.Subject, role authz.Subject, domain authz.Domain) (bool, error) {
+	return false, unsupported("DeleteRoleForUser")
+}
+
+// Name 获取授权器名称
+func (a *RemoteAuthorizer) Name() string {
+	return "remote"
+}
+
+// Options 获取配置选项
+func (a *RemoteAuthorizer) Options() authz.Options {
+	return a.options
+}
+
+// Close 关闭授权器，断开按 RemoteURL 建立的连接
+func (a *RemoteAuthorizer) Close() error {
+	if a.conn != nil {
+		return a.conn.Close()
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package remote
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"backend-service/pkg/auth/authz"
+)
+
+// fakeClient 按预设策略应答的远程授权服务客户端
+type fakeClient struct {
+	allowed map[authz.Subject]bool
+	delay   time.Duration
+	calls   int
+	token   string
+}
+
+func (c *fakeClient) IsAuthorized(ctx context.Context, sub authz.Subject, _ authz.Object, _ authz.Action, _ authz.Domain) (bool, error) {
+	c.calls++
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get("authorization")) > 0 {
+		c.token = md.Get("authorization")[0]
+	}
+	select {
+	case <-time.After(c.delay):
+		return c.allowed[sub], nil
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+}
+
+func TestEnforce(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeClient{allowed: map[authz.Subject]bool{"alice": true}}
+	a, err := NewProvider().NewAuthorizer(ctx, WithClient(client), WithToken("secret"), authz.WithEnableCache(true))
+	require.NoError(t, err)
+	defer a.Close()
+
+	allowed, err := a.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, "Bearer secret", client.token)
+
+	// 命中缓存时不再调用授权服务
+	_, err = a.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.calls)
+
+	allowed, err = a.Enforce(ctx, "bob", "/api/users", "GET", "default")
+	assert.False(t, allowed)
+	code, _ := authz.GetAuthzErrorCode(err)
+	assert.Equal(t, authz.ErrCodePermissionDenied, code)
+
+	results, err := a.BatchEnforce(ctx, []authz.Subject{"alice", "bob"}, []authz.Object{"/api/users", "/api/users"},
+		[]authz.Action{"GET", "GET"}, []authz.Domain{"default", "default"})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, results)
+
+	_, err = a.AddPolicy(ctx, authz.Policy{Subject: "alice", Object: "/api/users", Action: "GET"})
+	code, _ = authz.GetAuthzErrorCode(err)
+	assert.Equal(t, authz.ErrCodeUnsupportedOperation, code)
+}
+
+func TestEnforceTimeout(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeClient{allowed: map[authz.Subject]bool{"alice": true}, delay: time.Second}
+	a, err := NewProvider().NewAuthorizer(ctx, WithClient(client), WithTimeout(10*time.Millisecond), authz.WithEnableCache(true))
+	require.NoError(t, err)
+
+	allowed, err := a.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	assert.False(t, allowed)
+	code, _ := authz.GetAuthzErrorCode(err)
+	assert.Equal(t, authz.ErrCodeEnforceFailed, code)
+
+	// 调用失败的结果不缓存
+	client.delay = 0
+	allowed, err = a.Enforce(ctx, "alice", "/api/users", "GET", "default")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+}
+
+func TestInitRequiresClient(t *testing.T) {
+	_, err := NewProvider().NewAuthorizer(context.Background(), authz.WithRemoteURL("127.0.0.1:9000"))
+	code, _ := authz.GetAuthzErrorCode(err)
+	assert.Equal(t, authz.ErrCodeInvalidConfiguration, code)
+}
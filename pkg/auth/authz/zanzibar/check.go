@@ -0,0 +1,202 @@
+package zanzibar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrMaxDepthExceeded 递归深度超过上限
+var ErrMaxDepthExceeded = errors.New("zanzibar: max recursion depth exceeded")
+
+// NodeType 用户集树节点类型
+type NodeType string
+
+// 用户集树节点类型常量
+const (
+	// NodeUnion 并集，子节点为各改写规则的展开结果
+	NodeUnion NodeType = "union"
+	// NodeThis 直接元组，Subjects 为直接主体，Children 为用户集主体的展开结果
+	NodeThis NodeType = "this"
+	// NodeTupleToUserset 元组到用户集，Children 为各关联对象的展开结果
+	NodeTupleToUserset NodeType = "tuple_to_userset"
+)
+
+// Tree 用户集树，描述对象关系的成员构成
+type Tree struct {
+	Type     NodeType     `json:"type"`
+	Object   ObjectRef    `json:"object"`
+	Relation string       `json:"relation"`
+	Subjects []SubjectRef `json:"subjects,omitempty"`
+	Children []*Tree      `json:"children,omitempty"`
+}
+
+// Check 检查主体是否拥有对象上的关系，递归深度超过上限的分支视为不满足
+func (a *ZanzibarAuthorizer) Check(ctx context.Context, object ObjectRef, relation string, subject SubjectRef) (bool, error) {
+	_, ok, err := a.check(ctx, object, relation, subject, 0)
+	return ok, err
+}
+
+// check 按改写规则递归检查，命中时返回从对象到主体经过的关系元组
+func (a *ZanzibarAuthorizer) check(ctx context.Context, object ObjectRef, relation string, subject SubjectRef, depth int) ([]Tuple, bool, error) {
+	// 超过深度上限的分支（如循环嵌套的用户集）按拒绝处理，不影响其他分支命中
+	if depth > a.maxDepth {
+		return nil, false, nil
+	}
+	// 主体本身即为该用户集
+	if subject.IsUserset() && subject.Object() == object && subject.Relation == relation {
+		return nil, true, nil
+	}
+	for _, us := range a.schema.rewrite(object.Namespace, relation).Union {
+		var (
+			path []Tuple
+			ok   bool
+			err  error
+		)
+		switch {
+		case us.This:
+			path, ok, err = a.checkThis(ctx, object, relation, subject, depth)
+		case us.ComputedUserset != "":
+			path, ok, err = a.check(ctx, object, us.ComputedUserset, subject, depth+1)
+		case us.TupleToUserset != nil:
+			path, ok, err = a.checkTupleToUserset(ctx, object, us.TupleToUserset, subject, depth)
+		}
+		if err != nil || ok {
+			return path, ok, err
+		}
+	}
+	return nil, false, nil
+}
+
+// checkThis 检查直接元组，用户集主体递归展开
+func (a *ZanzibarAuthorizer) checkThis(ctx context.Context, object ObjectRef, relation string, subject SubjectRef, depth int) ([]Tuple, bool, error) {
+	tuples, err := a.store.Read(ctx, Filter{ObjectNamespace: object.Namespace, ObjectID: object.ID, Relation: relation})
+	if err != nil {
+		return nil, false, err
+	}
+	for _, t := range tuples {
+		if t.Subject == subject {
+			return []Tuple{t}, true, nil
+		}
+	}
+	for _, t := range tuples {
+		if !t.Subject.IsUserset() {
+			continue
+		}
+		path, ok, err := a.check(ctx, t.Subject.Object(), t.Subject.Relation, subject, depth+1)
+		if err != nil || ok {
+			return append([]Tuple{t}, path...), ok, err
+		}
+	}
+	return nil, false, nil
+}
+
+// checkTupleToUserset 沿 tupleset 关系找到关联对象，检查其上的关系
+func (a *ZanzibarAuthorizer) checkTupleToUserset(ctx context.Context, object ObjectRef, ttu *TupleToUserset, subject SubjectRef, depth int) ([]Tuple, bool, error) {
+	tuples, err := a.store.Read(ctx, Filter{ObjectNamespace: object.Namespace, ObjectID: object.ID, Relation: ttu.Tupleset})
+	if err != nil {
+		return nil, false, err
+	}
+	for _, t := range tuples {
+		path, ok, err := a.check(ctx, t.Subject.Object(), ttu.ComputedUserset, subject, depth+1)
+		if err != nil || ok {
+			return append([]Tuple{t}, path...), ok, err
+		}
+	}
+	return nil, false, nil
+}
+
+// Expand 展开对象关系的用户集树，递归深度超过上限时返回 ErrMaxDepthExceeded
+func (a *ZanzibarAuthorizer) Expand(ctx context.Context, object ObjectRef, relation string) (*Tree, error) {
+	return a.expand(ctx, object, relation, 0)
+}
+
+// expand 按改写规则递归展开
+func (a *ZanzibarAuthorizer) expand(ctx context.Context, object ObjectRef, relation string, depth int) (*Tree, error) {
+	if depth > a.maxDepth {
+		return nil, fmt.Errorf("%w: %s#%s", ErrMaxDepthExceeded, object, relation)
+	}
+	root := &Tree{Type: NodeUnion, Object: object, Relation: relation}
+	for _, us := range a.schema.rewrite(object.Namespace, relation).Union {
+		var (
+			node *Tree
+			err  error
+		)
+		switch {
+		case us.This:
+			node, err = a.expandThis(ctx, object, relation, depth)
+		case us.ComputedUserset != "":
+			node, err = a.expand(ctx, object, us.ComputedUserset, depth+1)
+		case us.TupleToUserset != nil:
+			node, err = a.expandTupleToUserset(ctx, object, relation, us.TupleToUserset, depth)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		root.Children = append(root.Children, node)
+	}
+	return root, nil
+}
+
+// expandThis 展开直接元组
+func (a *ZanzibarAuthorizer) expandThis(ctx context.Context, object ObjectRef, relation string, depth int) (*Tree, error) {
+	tuples, err := a.store.Read(ctx, Filter{ObjectNamespace: object.Namespace, ObjectID: object.ID, Relation: relation})
+	if err != nil {
+		return nil, err
+	}
+	node := &Tree{Type: NodeThis, Object: object, Relation: relation}
+	for _, t := range tuples {
+		node.Subjects = append(node.Subjects, t.Subject)
+		if !t.Subject.IsUserset() {
+			continue
+		}
+		child, err := a.expand(ctx, t.Subject.Object(), t.Subject.Relation, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, child)
+	}
+	return node, nil
+}
+
+// expandTupleToUserset 展开关联对象上的关系
+func (a *ZanzibarAuthorizer) expandTupleToUserset(ctx context.Context, object ObjectRef, relation string, ttu *TupleToUserset, depth int) (*Tree, error) {
+	tuples, err := a.store.Read(ctx, Filter{ObjectNamespace: object.Namespace, ObjectID: object.ID, Relation: ttu.Tupleset})
+	if err != nil {
+		return nil, err
+	}
+	node := &Tree{Type: NodeTupleToUserset, Object: object, Relation: relation}
+	for _, t := range tuples {
+		child, err := a.expand(ctx, t.Subject.Object(), ttu.ComputedUserset, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, child)
+	}
+	return node, nil
+}
+
+// Leaves 返回用户集树中的全部直接主体（去重）
+func (t *Tree) Leaves() []SubjectRef {
+	var (
+		result []SubjectRef
+		seen   = make(map[SubjectRef]struct{})
+		walk   func(*Tree)
+	)
+	walk = func(n *Tree) {
+		for _, s := range n.Subjects {
+			if _, ok := seen[s]; ok || s.IsUserset() {
+				continue
+			}
+			seen[s] = struct{}{}
+			result = append(result, s)
+		}
+		for _, c := range n.Children {
+			walk(c)
+		}
+	}
+	walk(t)
+	return result
+}
@@ -0,0 +1,77 @@
+package zanzibar
+
+import (
+	"backend-service/pkg/auth/authz"
+)
+
+// 提供者特定选项键
+const (
+	// providerOptionStore 关系元组存储
+	providerOptionStore = "zanzibar.store"
+	// providerOptionSchema 命名空间配置
+	providerOptionSchema = "zanzibar.schema"
+	// providerOptionMaxDepth 最大递归深度
+	providerOptionMaxDepth = "zanzibar.max_depth"
+	// providerOptionSubjectNamespace 主体默认命名空间
+	providerOptionSubjectNamespace = "zanzibar.subject_namespace"
+	// providerOptionOperations 操作到关系检查的映射
+	providerOptionOperations = "zanzibar.operations"
+)
+
+const (
+	// defaultMaxDepth 默认最大递归深度
+	defaultMaxDepth = 25
+	// defaultSubjectNamespace 默认主体命名空间
+	defaultSubjectNamespace = "user"
+	// RoleNamespace 角色命名空间，AddRoleForUser 写入 role:<role>#member@user:<user>
+	RoleNamespace = "role"
+	// RoleRelation 角色成员关系
+	RoleRelation = "member"
+)
+
+// WithStore 设置关系元组存储，未设置时使用内存存储
+func WithStore(store Store) authz.Option {
+	return authz.WithProviderOption(providerOptionStore, store)
+}
+
+// WithNamespaces 设置命名空间配置，优先于模型文本与模型文件
+func WithNamespaces(namespaces ...Namespace) authz.Option {
+	return authz.WithProviderOption(providerOptionSchema, NewSchema(namespaces...))
+}
+
+// WithMaxDepth 设置 Check 与 Expand 的最大递归深度，默认为 25
+func WithMaxDepth(depth int) authz.Option {
+	return authz.WithProviderOption(providerOptionMaxDepth, depth)
+}
+
+// WithSubjectNamespace 设置不带命名空间的主体所属命名空间，默认为 user
+func WithSubjectNamespace(namespace string) authz.Option {
+	return authz.WithProviderOption(providerOptionSubjectNamespace, namespace)
+}
+
+// Operation 操作对应的关系检查
+type Operation struct {
+	// Namespace 对象命名空间
+	Namespace string `json:"namespace"`
+	// Object 对象 ID，非空域时检查 domain/object
+	Object string `json:"object"`
+	// Relation 关系，为空时取授权操作
+	Relation string `json:"relation,omitempty"`
+}
+
+// WithOperations 设置操作名（如 gRPC 的 /pkg.Service/Method）到关系检查的映射，
+// 未映射的对象须为 namespace:id 形式
+func WithOperations(operations map[string]Operation) authz.Option {
+	return authz.WithProviderOption(providerOptionOperations, operations)
+}
+
+// providerOption 读取提供者特定选项
+func providerOption[T any](options authz.Options, key string) (T, bool) {
+	var zero T
+	values, ok := options.ProviderOptions.(map[string]interface{})
+	if !ok {
+		return zero, false
+	}
+	value, ok := values[key].(T)
+	return value, ok
+}
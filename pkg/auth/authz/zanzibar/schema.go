@@ -0,0 +1,94 @@
+package zanzibar
+
+import "encoding/json"
+
+// Namespace 命名空间配置，定义对象类型上的关系及其改写规则
+type Namespace struct {
+	Name      string     `json:"name"`
+	Relations []Relation `json:"relations"`
+}
+
+// Relation 关系定义，Rewrite 为空时仅包含直接写入的元组
+type Relation struct {
+	Name    string   `json:"name"`
+	Rewrite *Rewrite `json:"rewrite,omitempty"`
+}
+
+// Rewrite 用户集改写规则，结果为各子用户集的并集
+type Rewrite struct {
+	Union []Userset `json:"union"`
+}
+
+// Userset 子用户集
+type Userset struct {
+	// This 直接写入该关系的元组
+	This bool `json:"this,omitempty"`
+	// ComputedUserset 同一对象上的另一关系
+	ComputedUserset string `json:"computed_userset,omitempty"`
+	// TupleToUserset 沿关系找到关联对象，再取其上的关系
+	TupleToUserset *TupleToUserset `json:"tuple_to_userset,omitempty"`
+}
+
+// TupleToUserset 元组到用户集的改写
+type TupleToUserset struct {
+	// Tupleset 当前对象上指向关联对象的关系
+	Tupleset string `json:"tupleset"`
+	// ComputedUserset 关联对象上的关系
+	ComputedUserset string `json:"computed_userset"`
+}
+
+// This 直接元组
+func This() Userset {
+	return Userset{This: true}
+}
+
+// Computed 同一对象上的关系
+func Computed(relation string) Userset {
+	return Userset{ComputedUserset: relation}
+}
+
+// TupleTo 关联对象上的关系
+func TupleTo(tupleset, computed string) Userset {
+	return Userset{TupleToUserset: &TupleToUserset{Tupleset: tupleset, ComputedUserset: computed}}
+}
+
+// Union 子用户集的并集
+func Union(usersets ...Userset) *Rewrite {
+	return &Rewrite{Union: usersets}
+}
+
+// directRewrite 未配置改写规则时的默认规则
+var directRewrite = Union(This())
+
+// Schema 命名空间配置集合
+type Schema map[string]map[string]*Rewrite
+
+// NewSchema 创建命名空间配置集合
+func NewSchema(namespaces ...Namespace) Schema {
+	schema := make(Schema, len(namespaces))
+	for _, ns := range namespaces {
+		relations := make(map[string]*Rewrite, len(ns.Relations))
+		for _, r := range ns.Relations {
+			relations[r.Name] = r.Rewrite
+		}
+		schema[ns.Name] = relations
+	}
+	return schema
+}
+
+// ParseSchema 解析 JSON 格式的命名空间配置列表
+func ParseSchema(data []byte) (Schema, error) {
+	var namespaces []Namespace
+	if err := json.Unmarshal(data, &namespaces); err != nil {
+		return nil, err
+	}
+	return NewSchema(namespaces...), nil
+}
+
+// rewrite 返回关系的改写规则
+func (s Schema) rewrite(namespace, relation string) *Rewrite {
+	if rw := s[namespace][relation]; rw != nil {
+		return rw
+	}
+	return directRewrite
+}
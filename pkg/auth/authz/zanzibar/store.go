@@ -0,0 +1,81 @@
+package zanzibar
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// Filter 元组查询条件，空字段表示不限制
+type Filter struct {
+	ObjectNamespace string
+	ObjectID        string
+	Relation        string
+	Subject         *SubjectRef
+}
+
+// match 判断元组是否匹配查询条件
+func (f Filter) match(t Tuple) bool {
+	return (f.ObjectNamespace == "" || f.ObjectNamespace == t.Object.Namespace) &&
+		(f.ObjectID == "" || f.ObjectID == t.Object.ID) &&
+		(f.Relation == "" || f.Relation == t.Relation) &&
+		(f.Subject == nil || *f.Subject == t.Subject)
+}
+
+// Store 关系元组存储
+type Store interface {
+	// Write 写入元组，已存在的元组忽略
+	Write(ctx context.Context, tuples ...Tuple) error
+	// Delete 删除元组，不存在的元组忽略
+	Delete(ctx context.Context, tuples ...Tuple) error
+	// Read 按条件查询元组
+	Read(ctx context.Context, filter Filter) ([]Tuple, error)
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// MemoryStore 内存元组存储
+type MemoryStore struct {
+	mu     sync.RWMutex
+	tuples []Tuple
+}
+
+// NewMemoryStore 创建内存元组存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Write 写入元组
+func (s *MemoryStore) Write(ctx context.Context, tuples ...Tuple) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range tuples {
+		if !slices.Contains(s.tuples, t) {
+			s.tuples = append(s.tuples, t)
+		}
+	}
+	return nil
+}
+
+// Delete 删除元组
+func (s *MemoryStore) Delete(ctx context.Context, tuples ...Tuple) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tuples = slices.DeleteFunc(s.tuples, func(t Tuple) bool {
+		return slices.Contains(tuples, t)
+	})
+	return nil
+}
+
+// Read 按条件查询元组
+func (s *MemoryStore) Read(ctx context.Context, filter Filter) ([]Tuple, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var result []Tuple
+	for _, t := range s.tuples {
+		if filter.match(t) {
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
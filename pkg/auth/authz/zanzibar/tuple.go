@@ -0,0 +1,95 @@
+package zanzibar
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ObjectRef 对象引用，格式为 namespace:id
+type ObjectRef struct {
+	Namespace string `json:"namespace"`
+	ID        string `json:"id"`
+}
+
+// String 返回 namespace:id 格式的对象
+func (o ObjectRef) String() string {
+	return o.Namespace + ":" + o.ID
+}
+
+// SubjectRef 主体引用，格式为 namespace:id（直接主体）或 namespace:id#relation（用户集）
+type SubjectRef struct {
+	Namespace string `json:"namespace"`
+	ID        string `json:"id"`
+	// Relation 用户集关系，为空表示直接主体
+	Relation string `json:"relation,omitempty"`
+}
+
+// Object 返回主体对应的对象
+func (s SubjectRef) Object() ObjectRef {
+	return ObjectRef{Namespace: s.Namespace, ID: s.ID}
+}
+
+// IsUserset 是否为用户集
+func (s SubjectRef) IsUserset() bool {
+	return s.Relation != ""
+}
+
+// String 返回主体字符串
+func (s SubjectRef) String() string {
+	if s.Relation == "" {
+		return s.Object().String()
+	}
+	return s.Object().String() + "#" + s.Relation
+}
+
+// Tuple 关系元组，格式为 object#relation@subject
+type Tuple struct {
+	Object   ObjectRef  `json:"object"`
+	Relation string     `json:"relation"`
+	Subject  SubjectRef `json:"subject"`
+}
+
+// String 返回 object#relation@subject 格式的元组
+func (t Tuple) String() string {
+	return t.Object.String() + "#" + t.Relation + "@" + t.Subject.String()
+}
+
+// ParseObject 解析 namespace:id 格式的对象
+func ParseObject(s string) (ObjectRef, error) {
+	namespace, id, ok := strings.Cut(s, ":")
+	if !ok || namespace == "" || id == "" {
+		return ObjectRef{}, fmt.Errorf("invalid object %q, expected namespace:id", s)
+	}
+	return ObjectRef{Namespace: namespace, ID: id}, nil
+}
+
+// ParseSubject 解析 namespace:id 或 namespace:id#relation 格式的主体
+func ParseSubject(s string) (SubjectRef, error) {
+	object, relation, _ := strings.Cut(s, "#")
+	o, err := ParseObject(object)
+	if err != nil {
+		return SubjectRef{}, err
+	}
+	return SubjectRef{Namespace: o.Namespace, ID: o.ID, Relation: relation}, nil
+}
+
+// ParseTuple 解析 object#relation@subject 格式的元组
+func ParseTuple(s string) (Tuple, error) {
+	left, subject, ok := strings.Cut(s, "@")
+	if !ok {
+		return Tuple{}, fmt.Errorf("invalid tuple %q, expected object#relation@subject", s)
+	}
+	object, relation, ok := strings.Cut(left, "#")
+	if !ok || relation == "" {
+		return Tuple{}, fmt.Errorf("invalid tuple %q, expected object#relation@subject", s)
+	}
+	o, err := ParseObject(object)
+	if err != nil {
+		return Tuple{}, err
+	}
+	sub, err := ParseSubject(subject)
+	if err != nil {
+		return Tuple{}, err
+	}
+	return Tuple{Object: o, Relation: relation, Subject: sub}, nil
+}
@@ -0,0 +1,629 @@
+package zanzibar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"backend-service/pkg/auth/authz"
+)
+
+var (
+	_ authz.Authorizer = (*ZanzibarAuthorizer)(nil)
+	_ authz.Explainer  = (*ZanzibarAuthorizer)(nil)
+)
+
+// ZanzibarAuthorizer 基于关系元组的授权器（ReBAC）
+// 权限由 object#relation@subject 形式的关系元组与命名空间改写规则计算得出：
+// Enforce 中 obj 为已映射的操作名或 namespace:id 形式的对象，不带命名空间的 sub 归入默认主体命名空间：
+// 已映射的操作按映射检查关系，非空域时对象 ID 为 domain/object；
+// namespace:id 形式的对象以 act 为关系，域通过对象间的关系建模（如 post:1#dept@dept:1）
+type ZanzibarAuthorizer struct {
+	// options 配置选项
+	options authz.Options
+	// store 关系元组存储
+	store Store
+	// schema 命名空间配置
+	schema Schema
+	// maxDepth 最大递归深度
+	maxDepth int
+	// subjectNamespace 主体默认命名空间
+	subjectNamespace string
+	// operations 操作到关系检查的映射
+	operations map[string]Operation
+}
+
+// ZanzibarProvider Zanzibar授权提供者
+type ZanzibarProvider struct{}
+
+// Name 获取提供者名称
+func (p *ZanzibarProvider) Name() string {
+	return "zanzibar"
+}
+
+// NewAuthorizer 创建新的授权器实例
+func (p *ZanzibarProvider) NewAuthorizer(ctx context.Context, opts ...authz.Option) (authz.Authorizer, error) {
+	auth := new(ZanzibarAuthorizer)
+	// 使用默认选项
+	auth.options = authz.DefaultOptions()
+	auth.options.EngineType = authz.EngineZanzibar
+	auth.options.EnableRBAC = false
+	auth.options.EnableREBAC = true
+
+	// 初始化授权器
+	if err := auth.Init(ctx, opts...); err != nil {
+		return nil, err
+	}
+
+	return auth, nil
+}
+
+// NewProvider 创建新的Zanzibar授权提供者
+func NewProvider() authz.AuthzProvider {
+	return &ZanzibarProvider{}
+}
+
+// Init 初始化授权器
+func (a *ZanzibarAuthorizer) Init(ctx context.Context, opts ...authz.Option) error {
+	// 应用选项
+	for _, opt := range opts {
+		opt(&a.options)
+	}
+
+	var err error
+	if a.schema, err = loadSchema(a.options); err != nil {
+		return err
+	}
+	a.store = NewMemoryStore()
+	if store, ok := providerOption[Store](a.options, providerOptionStore); ok && store != nil {
+		a.store = store
+	}
+	a.maxDepth = defaultMaxDepth
+	if depth, ok := providerOption[int](a.options, providerOptionMaxDepth); ok && depth > 0 {
+		a.maxDepth = depth
+	}
+	a.subjectNamespace = defaultSubjectNamespace
+	if namespace, ok := providerOption[string](a.options, providerOptionSubjectNamespace); ok && namespace != "" {
+		a.subjectNamespace = namespace
+	}
+	a.operations, _ = providerOption[map[string]Operation](a.options, providerOptionOperations)
+	return nil
+}
+
+// loadSchema 加载命名空间配置，模型文本与模型文件为 JSON 格式的命名空间列表，均未设置时所有关系仅包含直接元组
+func loadSchema(options authz.Options) (Schema, error) {
+	if schema, ok := providerOption[Schema](options, providerOptionSchema); ok {
+		return schema, nil
+	}
+	var data []byte
+	switch options.ModelFormat {
+	case authz.ModelFormatText:
+		data = []byte(options.ModelText)
+	case authz.ModelFormatFile:
+		if options.ModelFile == "" {
+			return nil, authz.NewAuthzError(
+				authz.ErrCodeInvalidConfiguration,
+				"model file path is required for file model format",
+				nil,
+			)
+		}
+		buf, err := os.ReadFile(options.ModelFile)
+		if err != nil {
+			return nil, authz.NewAuthzError(authz.ErrCodeInitializationFailed, "failed to read namespace schema", err)
+		}
+		data = buf
+	default:
+		return nil, authz.NewAuthzError(
+			authz.ErrCodeInvalidConfiguration,
+			fmt.Sprintf("unsupported model format: %s", options.ModelFormat),
+			nil,
+		)
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return NewSchema(), nil
+	}
+	schema, err := ParseSchema(data)
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidConfiguration, "failed to parse namespace schema", err)
+	}
+	return schema, nil
+}
+
+// subjectRef 转换主体，不带命名空间的主体归入默认主体命名空间
+func (a *ZanzibarAuthorizer) subjectRef(sub authz.Subject) (SubjectRef, error) {
+	if !strings.Contains(string(sub), ":") {
+		return SubjectRef{Namespace: a.subjectNamespace, ID: string(sub)}, nil
+	}
+	return ParseSubject(string(sub))
+}
+
+// subjectName 转换主体为授权主体，默认主体命名空间的直接主体省略命名空间
+func (a *ZanzibarAuthorizer) subjectName(s SubjectRef) authz.Subject {
+	if s.Namespace == a.subjectNamespace && !s.IsUserset() {
+		return authz.Subject(s.ID)
+	}
+	return authz.Subject(s.String())
+}
+
+// roleObject 转换角色为角色对象，非空域时对象 ID 为 domain/role
+func roleObject(role authz.Subject, domain authz.Domain) ObjectRef {
+	id := string(role)
+	if domain != "" {
+		id = string(domain) + "/" + id
+	}
+	return ObjectRef{Namespace: RoleNamespace, ID: id}
+}
+
+// resolve 转换授权对象与操作为关系检查的对象与关系
+// 已映射的操作按映射取对象与关系，非空域时对象 ID 为 domain/object；其余对象须为 namespace:id 形式，操作即关系
+func (a *ZanzibarAuthorizer) resolve(obj authz.Object, act authz.Action, domain authz.Domain) (ObjectRef, string, error) {
+	if op, ok := a.operations[string(obj)]; ok {
+		id := op.Object
+		if domain != "" {
+			id = string(domain) + "/" + id
+		}
+		relation := op.Relation
+		if relation == "" {
+			relation = string(act)
+		}
+		return ObjectRef{Namespace: op.Namespace, ID: id}, relation, nil
+	}
+	object, err := ParseObject(string(obj))
+	if err != nil {
+		return ObjectRef{}, "", err
+	}
+	return object, string(act), nil
+}
+
+// roleName 从角色对象 ID 中解析指定域下的角色名
+func roleName(id string, domain authz.Domain) (authz.Subject, bool) {
+	if domain == "" {
+		return authz.Subject(id), !strings.Contains(id, "/")
+	}
+	name, ok := strings.CutPrefix(id, string(domain)+"/")
+	return authz.Subject(name), ok
+}
+
+// roleSubject 从角色对象 ID 中解析角色名，不属于指定域时返回完整 ID
+func roleSubject(id string, domain authz.Domain) authz.Subject {
+	if name, ok := roleName(id, domain); ok {
+		return name
+	}
+	return authz.Subject(id)
+}
+
+// Enforce 执行授权检查
+func (a *ZanzibarAuthorizer) Enforce(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (bool, error) {
+	// 检查参数
+	if sub == "" {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidSubject, "subject is required", nil)
+	}
+	if obj == "" {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidObject, "object is required", nil)
+	}
+	if act == "" {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidAction, "action is required", nil)
+	}
+
+	result, err := a.enforce(ctx, sub, obj, act, domain)
+	if err != nil {
+		return false, err
+	}
+
+	// 如果未授权，返回权限被拒绝错误
+	if !result {
+		return false, authz.NewAuthzError(
+			authz.ErrCodePermissionDenied,
+			fmt.Sprintf("permission denied for %s to %s on %s in domain %s", sub, act, obj, domain),
+			nil,
+		)
+	}
+
+	return true, nil
+}
+
+// enforce 转换参数并执行关系检查
+func (a *ZanzibarAuthorizer) enforce(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (bool, error) {
+	subject, err := a.subjectRef(sub)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidSubject, err.Error(), err)
+	}
+	object, relation, err := a.resolve(obj, act, domain)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidObject, err.Error(), err)
+	}
+	result, err := a.Check(ctx, object, relation, subject)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeEnforceFailed, "enforce check failed", err)
+	}
+	return result, nil
+}
+
+// BatchEnforce 批量执行授权检查
+func (a *ZanzibarAuthorizer) BatchEnforce(ctx context.Context, subjects []authz.Subject, objects []authz.Object, actions []authz.Action, domains []authz.Domain) ([]bool, error) {
+	// 检查参数长度一致性
+	if len(subjects) != len(objects) || len(subjects) != len(actions) || len(subjects) != len(domains) {
+		return nil, authz.NewAuthzError(
+			authz.ErrCodeBatchEnforceFailed,
+			"subjects, objects, actions, and domains must have the same length",
+			nil,
+		)
+	}
+
+	results := make([]bool, len(subjects))
+	for i := range subjects {
+		result, err := a.enforce(ctx, subjects[i], objects[i], actions[i], domains[i])
+		if err != nil {
+			return nil, authz.NewAuthzError(authz.ErrCodeBatchEnforceFailed, "batch enforce check failed", err)
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// policyTuple 转换策略为关系元组：object#action@subject，已映射的操作按映射转换
+func (a *ZanzibarAuthorizer) policyTuple(policy authz.Policy) (Tuple, error) {
+	if policy.Subject == "" {
+		return Tuple{}, authz.NewAuthzError(authz.ErrCodeInvalidPolicy, "subject is required in policy", nil)
+	}
+	if policy.Object == "" {
+		return Tuple{}, authz.NewAuthzError(authz.ErrCodeInvalidPolicy, "object is required in policy", nil)
+	}
+	if policy.Action == "" {
+		return Tuple{}, authz.NewAuthzError(authz.ErrCodeInvalidPolicy, "action is required in policy", nil)
+	}
+	if policy.Effect == authz.EffectDeny {
+		return Tuple{}, authz.NewAuthzError(authz.ErrCodeInvalidPolicy, "deny effect is not supported by zanzibar", nil)
+	}
+	subject, err := a.subjectRef(policy.Subject)
+	if err != nil {
+		return Tuple{}, authz.NewAuthzError(authz.ErrCodeInvalidPolicy, err.Error(), err)
+	}
+	object, relation, err := a.resolve(policy.Object, policy.Action, policy.Domain)
+	if err != nil {
+		return Tuple{}, authz.NewAuthzError(authz.ErrCodeInvalidPolicy, err.Error(), err)
+	}
+	return Tuple{Object: object, Relation: relation, Subject: subject}, nil
+}
+
+// policyTuples 批量转换策略为关系元组
+func (a *ZanzibarAuthorizer) policyTuples(policies []authz.Policy) ([]Tuple, error) {
+	tuples := make([]Tuple, len(policies))
+	for i, policy := range policies {
+		t, err := a.policyTuple(policy)
+		if err != nil {
+			return nil, err
+		}
+		tuples[i] = t
+	}
+	return tuples, nil
+}
+
+// exists 判断元组是否已存在
+func (a *ZanzibarAuthorizer) exists(ctx context.Context, t Tuple) (bool, error) {
+	tuples, err := a.store.Read(ctx, Filter{
+		ObjectNamespace: t.Object.Namespace,
+		ObjectID:        t.Object.ID,
+		Relation:        t.Relation,
+		Subject:         &t.Subject,
+	})
+	return len(tuples) > 0, err
+}
+
+// partition 按是否已存在拆分元组
+func (a *ZanzibarAuthorizer) partition(ctx context.Context, tuples []Tuple, existing bool) ([]Tuple, error) {
+	var result []Tuple
+	for _, t := range tuples {
+		ok, err := a.exists(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		if ok == existing && !slices.Contains(result, t) {
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+// write 写入不存在的元组，返回是否有新增
+func (a *ZanzibarAuthorizer) write(ctx context.Context, tuples []Tuple) (bool, error) {
+	added, err := a.partition(ctx, tuples, false)
+	if err != nil || len(added) == 0 {
+		return false, err
+	}
+	return true, a.store.Write(ctx, added...)
+}
+
+// delete 删除已存在的元组，返回是否有元组被删除
+func (a *ZanzibarAuthorizer) delete(ctx context.Context, tuples []Tuple) (bool, error) {
+	removed, err := a.partition(ctx, tuples, true)
+	if err != nil || len(removed) == 0 {
+		return false, err
+	}
+	return true, a.store.Delete(ctx, removed...)
+}
+
+// validateTuple 检查元组
+func validateTuple(t Tuple) error {
+	if t.Object.Namespace == "" || t.Object.ID == "" || t.Relation == "" || t.Subject.Namespace == "" || t.Subject.ID == "" {
+		return fmt.Errorf("invalid tuple %q", t)
+	}
+	return nil
+}
+
+// WriteTuples 写入关系元组，已存在的元组忽略
+func (a *ZanzibarAuthorizer) WriteTuples(ctx context.Context, tuples ...Tuple) error {
+	for _, t := range tuples {
+		if err := validateTuple(t); err != nil {
+			return err
+		}
+	}
+	return a.store.Write(ctx, tuples...)
+}
+
+// DeleteTuples 删除关系元组
+func (a *ZanzibarAuthorizer) DeleteTuples(ctx context.Context, tuples ...Tuple) error {
+	return a.store.Delete(ctx, tuples...)
+}
+
+// ReadTuples 按条件查询关系元组
+func (a *ZanzibarAuthorizer) ReadTuples(ctx context.Context, filter Filter) ([]Tuple, error) {
+	return a.store.Read(ctx, filter)
+}
+
+// AddPolicy 添加策略
+func (a *ZanzibarAuthorizer) AddPolicy(ctx context.Context, policy authz.Policy) (bool, error) {
+	return a.AddPolicies(ctx, []authz.Policy{policy})
+}
+
+// RemovePolicy 移除策略
+func (a *ZanzibarAuthorizer) RemovePolicy(ctx context.Context, policy authz.Policy) (bool, error) {
+	return a.RemovePolicies(ctx, []authz.Policy{policy})
+}
+
+// AddPolicies 批量添加策略
+func (a *ZanzibarAuthorizer) AddPolicies(ctx context.Context, policies []authz.Policy) (bool, error) {
+	tuples, err := a.policyTuples(policies)
+	if err != nil {
+		return false, err
+	}
+	added, err := a.write(ctx, tuples)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeAddPoliciesFailed, "add policies failed", err)
+	}
+	return added, nil
+}
+
+// RemovePolicies 批量移除策略
+func (a *ZanzibarAuthorizer) RemovePolicies(ctx context.Context, policies []authz.Policy) (bool, error) {
+	tuples, err := a.policyTuples(policies)
+	if err != nil {
+		return false, err
+	}
+	removed, err := a.delete(ctx, tuples)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeRemovePoliciesFailed, "remove policies failed", err)
+	}
+	return removed, nil
+}
+
+// distinct 读取全部元组并返回指定字段去重后的值
+func distinct[T ~string](ctx context.Context, store Store, filter Filter, field func(Tuple) (string, bool)) ([]T, error) {
+	tuples, err := store.Read(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]T, 0)
+	for _, t := range tuples {
+		if v, ok := field(t); ok && !slices.Contains(result, T(v)) {
+			result = append(result, T(v))
+		}
+	}
+	return result, nil
+}
+
+// GetAllSubjects 获取所有主体
+func (a *ZanzibarAuthorizer) GetAllSubjects(ctx context.Context) ([]authz.Subject, error) {
+	result, err := distinct[authz.Subject](ctx, a.store, Filter{}, func(t Tuple) (string, bool) {
+		return t.Subject.String(), true
+	})
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeGetAllSubjectsFailed, "get all subjects failed", err)
+	}
+	return result, nil
+}
+
+// GetAllObjects 获取所有对象
+func (a *ZanzibarAuthorizer) GetAllObjects(ctx context.Context) ([]authz.Object, error) {
+	result, err := distinct[authz.Object](ctx, a.store, Filter{}, func(t Tuple) (string, bool) {
+		return t.Object.String(), true
+	})
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeGetAllObjectsFailed, "get all objects failed", err)
+	}
+	return result, nil
+}
+
+// GetAllActions 获取所有操作，即全部关系
+func (a *ZanzibarAuthorizer) GetAllActions(ctx context.Context) ([]authz.Action, error) {
+	result, err := distinct[authz.Action](ctx, a.store, Filter{}, func(t Tuple) (string, bool) {
+		return t.Relation, true
+	})
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeGetAllActionsFailed, "get all actions failed", err)
+	}
+	return result, nil
+}
+
+// GetAllDomains 获取所有域，域由对象关系建模，始终为空
+func (a *ZanzibarAuthorizer) GetAllDomains(ctx context.Context) ([]authz.Domain, error) {
+	return []authz.Domain{}, nil
+}
+
+// GetAllRoles 获取所有角色
+func (a *ZanzibarAuthorizer) GetAllRoles(ctx context.Context) ([]authz.Subject, error) {
+	result, err := distinct[authz.Subject](ctx, a.store, Filter{ObjectNamespace: RoleNamespace, Relation: RoleRelation}, func(t Tuple) (string, bool) {
+		return t.Object.ID, true
+	})
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeGetAllRolesFailed, "get all roles failed", err)
+	}
+	return result, nil
+}
+
+// GetRolesForUser 获取用户的直接角色
+func (a *ZanzibarAuthorizer) GetRolesForUser(ctx context.Context, user authz.Subject, domain authz.Domain) ([]authz.Subject, error) {
+	subject, err := a.subjectRef(user)
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidUser, err.Error(), err)
+	}
+	result, err := distinct[authz.Subject](ctx, a.store, Filter{ObjectNamespace: RoleNamespace, Relation: RoleRelation, Subject: &subject}, func(t Tuple) (string, bool) {
+		name, ok := roleName(t.Object.ID, domain)
+		return string(name), ok
+	})
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeGetRolesForUserFailed, "get roles for user failed", err)
+	}
+	return result, nil
+}
+
+// GetUsersForRole 获取角色的直接用户
+func (a *ZanzibarAuthorizer) GetUsersForRole(ctx context.Context, role authz.Subject, domain authz.Domain) ([]authz.Subject, error) {
+	object := roleObject(role, domain)
+	result, err := distinct[authz.Subject](ctx, a.store, Filter{ObjectNamespace: object.Namespace, ObjectID: object.ID, Relation: RoleRelation}, func(t Tuple) (string, bool) {
+		return string(a.subjectName(t.Subject)), true
+	})
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeGetUsersForRoleFailed, "get users for role failed", err)
+	}
+	return result, nil
+}
+
+// HasRoleForUser 检查用户是否拥有角色，包含经由嵌套用户集获得的角色
+func (a *ZanzibarAuthorizer) HasRoleForUser(ctx context.Context, user authz.Subject, role authz.Subject, domain authz.Domain) (bool, error) {
+	subject, err := a.subjectRef(user)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeInvalidUser, err.Error(), err)
+	}
+	result, err := a.Check(ctx, roleObject(role, domain), RoleRelation, subject)
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeHasRoleForUserFailed, "has role for user failed", err)
+	}
+	return result, nil
+}
+
+// roleTuple 转换用户角色为关系元组：role:<role>#member@user
+func (a *ZanzibarAuthorizer) roleTuple(user authz.Subject, role authz.Subject, domain authz.Domain) (Tuple, error) {
+	if user == "" {
+		return Tuple{}, authz.NewAuthzError(authz.ErrCodeInvalidUser, "user is required", nil)
+	}
+	if role == "" {
+		return Tuple{}, authz.NewAuthzError(authz.ErrCodeInvalidRole, "role is required", nil)
+	}
+	subject, err := a.subjectRef(user)
+	if err != nil {
+		return Tuple{}, authz.NewAuthzError(authz.ErrCodeInvalidUser, err.Error(), err)
+	}
+	return Tuple{Object: roleObject(role, domain), Relation: RoleRelation, Subject: subject}, nil
+}
+
+// AddRoleForUser 为用户添加角色
+func (a *ZanzibarAuthorizer) AddRoleForUser(ctx context.Context, user authz.Subject, role authz.Subject, domain authz.Domain) (bool, error) {
+	t, err := a.roleTuple(user, role, domain)
+	if err != nil {
+		return false, err
+	}
+	added, err := a.write(ctx, []Tuple{t})
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeAddRoleForUserFailed, "add role for user failed", err)
+	}
+	return added, nil
+}
+
+// DeleteRoleForUser 删除用户的角色
+func (a *ZanzibarAuthorizer) DeleteRoleForUser(ctx context.Context, user authz.Subject, role authz.Subject, domain authz.Domain) (bool, error) {
+	t, err := a.roleTuple(user, role, domain)
+	if err != nil {
+		return false, err
+	}
+	deleted, err := a.delete(ctx, []Tuple{t})
+	if err != nil {
+		return false, authz.NewAuthzError(authz.ErrCodeDeleteRoleForUserFailed, "delete role for user failed", err)
+	}
+	return deleted, nil
+}
+
+// Explain 执行关系检查并返回命中的元组路径：角色成员元组（role:<dom/role>#member）归入角色链，
+// 其余元组按 object#relation@subject 转换为策略，Trace 为完整路径
+func (a *ZanzibarAuthorizer) Explain(ctx context.Context, sub authz.Subject, obj authz.Object, act authz.Action, domain authz.Domain) (*authz.Explanation, error) {
+	// 检查参数
+	if sub == "" {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidSubject, "subject is required", nil)
+	}
+	if obj == "" {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidObject, "object is required", nil)
+	}
+	if act == "" {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidAction, "action is required", nil)
+	}
+
+	subject, err := a.subjectRef(sub)
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidSubject, err.Error(), err)
+	}
+	object, relation, err := a.resolve(obj, act, domain)
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeInvalidObject, err.Error(), err)
+	}
+	path, allowed, err := a.check(ctx, object, relation, subject, 0)
+	if err != nil {
+		return nil, authz.NewAuthzError(authz.ErrCodeEnforceFailed, "enforce check failed", err)
+	}
+
+	explanation := &authz.Explanation{Allowed: allowed}
+	for _, t := range path {
+		explanation.Trace = append(explanation.Trace, t.String())
+		if t.Object.Namespace == RoleNamespace && t.Relation == RoleRelation {
+			// 嵌套角色的成员为 role:<dom/role>#member 用户集，按角色名展示
+			user := a.subjectName(t.Subject)
+			if t.Subject.Namespace == RoleNamespace && t.Subject.Relation == RoleRelation {
+				user = roleSubject(t.Subject.ID, domain)
+			}
+			explanation.RoleChain = append(explanation.RoleChain, authz.RoleBinding{User: user, Role: roleSubject(t.Object.ID, domain), Domain: domain})
+			continue
+		}
+		explanation.MatchedPolicies = append(explanation.MatchedPolicies, authz.Policy{
+			Subject: a.subjectName(t.Subject),
+			Object:  authz.Object(t.Object.String()),
+			Action:  authz.Action(t.Relation),
+			Domain:  domain,
+			Effect:  authz.EffectAllow,
+		})
+	}
+	// 路径从对象指向主体，角色链按从主体出发的顺序排列
+	slices.Reverse(explanation.RoleChain)
+	return explanation, nil
+}
+
+// Name 获取授权器名称
+func (a *ZanzibarAuthorizer) Name() string {
+	return "zanzibar"
+}
+
+// Options implements authz.Authorizer.
+func (a *ZanzibarAuthorizer) Options() authz.Options {
+	return a.options
+}
+
+// Close 关闭授权器
+func (a *ZanzibarAuthorizer) Close() error {
+	return nil
+}
+
+// IsMaxDepthExceeded 判断错误是否由递归深度超限引起
+func IsMaxDepthExceeded(err error) bool {
+	return errors.Is(err, ErrMaxDepthExceeded)
+}
@@ -0,0 +1,283 @@
+package zanzibar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"backend-service/pkg/auth/authn"
+	"backend-service/pkg/auth/authz"
+	authMiddleware "backend-service/pkg/auth/middleware"
+)
+
+// postSchema 部门成员可编辑本部门岗位
+var postSchema = []Namespace{
+	{Name: "dept", Relations: []Relation{
+		{Name: "manager"},
+		{Name: "member", Rewrite: Union(This(), Computed("manager"))},
+	}},
+	{Name: "post", Relations: []Relation{
+		{Name: "owner"},
+		{Name: "dept"},
+		{Name: "editor", Rewrite: Union(This(), Computed("owner"), TupleTo("dept", "member"))},
+		{Name: "viewer", Rewrite: Union(This(), Computed("editor"))},
+	}},
+}
+
+func mustTuples(t *testing.T, lines ...string) []Tuple {
+	t.Helper()
+	tuples := make([]Tuple, len(lines))
+	for i, line := range lines {
+		tuple, err := ParseTuple(line)
+		require.NoError(t, err)
+		tuples[i] = tuple
+	}
+	return tuples
+}
+
+func newTestAuthorizer(t *testing.T, opts ...authz.Option) *ZanzibarAuthorizer {
+	t.Helper()
+	a, err := NewProvider().NewAuthorizer(context.Background(), opts...)
+	require.NoError(t, err)
+	return a.(*ZanzibarAuthorizer)
+}
+
+func TestParseTuple(t *testing.T) {
+	tuple, err := ParseTuple("group:eng#member@group:ops#member")
+	require.NoError(t, err)
+	assert.Equal(t, ObjectRef{Namespace: "group", ID: "eng"}, tuple.Object)
+	assert.Equal(t, SubjectRef{Namespace: "group", ID: "ops", Relation: "member"}, tuple.Subject)
+	assert.Equal(t, "group:eng#member@group:ops#member", tuple.String())
+
+	for _, s := range []string{"", "doc:1", "doc:1#@user:1", "doc#viewer@user:1", "doc:1#viewer@alice"} {
+		_, err := ParseTuple(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAuthorizer(t, WithNamespaces(postSchema...))
+	require.NoError(t, a.WriteTuples(ctx, mustTuples(t,
+		"dept:1#member@user:alice",
+		"dept:1#manager@user:carol",
+		"dept:2#member@user:bob",
+		"post:10#dept@dept:1",
+		"post:20#dept@dept:2",
+		"post:20#owner@user:dave",
+		"post:30#viewer@group:audit#member",
+		"group:audit#member@user:erin",
+	)...))
+
+	cases := []struct {
+		tuple string
+		want  bool
+	}{
+		{"post:10#editor@user:alice", true},
+		{"post:10#editor@user:carol", true},
+		{"post:10#viewer@user:alice", true},
+		{"post:10#editor@user:bob", false},
+		{"post:20#editor@user:bob", true},
+		{"post:20#editor@user:dave", true},
+		{"post:10#editor@user:dave", false},
+		{"post:30#viewer@user:erin", true},
+		{"post:30#editor@user:erin", false},
+		{"post:10#editor@dept:1#member", true},
+	}
+	for _, c := range cases {
+		tuple := mustTuples(t, c.tuple)[0]
+		ok, err := a.Check(ctx, tuple.Object, tuple.Relation, tuple.Subject)
+		require.NoError(t, err, c.tuple)
+		assert.Equal(t, c.want, ok, c.tuple)
+	}
+
+	// Enforce 不带命名空间的主体归入 user
+	ok, err := a.Enforce(ctx, "alice", "post:10", "editor", "")
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	ok, err = a.Enforce(ctx, "bob", "post:10", "editor", "")
+	assert.False(t, ok)
+	code, _ := authz.GetAuthzErrorCode(err)
+	assert.Equal(t, authz.ErrCodePermissionDenied, code)
+
+	// 移出部门后立即失去编辑权限
+	require.NoError(t, a.DeleteTuples(ctx, mustTuples(t, "dept:1#member@user:alice")...))
+	ok, err = a.Check(ctx, ObjectRef{"post", "10"}, "editor", SubjectRef{Namespace: "user", ID: "alice"})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestCheckMaxDepth(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAuthorizer(t, WithMaxDepth(3))
+	// 循环嵌套的用户集
+	require.NoError(t, a.WriteTuples(ctx, mustTuples(t,
+		"group:a#member@group:b#member",
+		"group:b#member@group:a#member",
+	)...))
+	// 超过深度上限的分支按拒绝处理
+	ok, err := a.Check(ctx, ObjectRef{"group", "a"}, "member", SubjectRef{Namespace: "user", ID: "alice"})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = a.Enforce(ctx, "alice", "group:a", "member", "")
+	code, _ := authz.GetAuthzErrorCode(err)
+	assert.Equal(t, authz.ErrCodePermissionDenied, code)
+
+	// 其他分支命中时不受循环影响
+	require.NoError(t, a.WriteTuples(ctx, mustTuples(t, "group:b#member@user:alice")...))
+	ok, err = a.Check(ctx, ObjectRef{"group", "a"}, "member", SubjectRef{Namespace: "user", ID: "alice"})
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = a.Expand(ctx, ObjectRef{"group", "a"}, "member")
+	assert.True(t, IsMaxDepthExceeded(err))
+}
+
+func TestExpand(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAuthorizer(t, WithNamespaces(postSchema...))
+	require.NoError(t, a.WriteTuples(ctx, mustTuples(t,
+		"dept:1#member@user:alice",
+		"dept:1#manager@user:carol",
+		"post:10#dept@dept:1",
+		"post:10#owner@user:dave",
+		"post:10#editor@group:audit#member",
+		"group:audit#member@user:erin",
+	)...))
+
+	tree, err := a.Expand(ctx, ObjectRef{"post", "10"}, "editor")
+	require.NoError(t, err)
+	assert.Equal(t, NodeUnion, tree.Type)
+	require.Len(t, tree.Children, 3)
+	assert.Equal(t, NodeThis, tree.Children[0].Type)
+	assert.Equal(t, NodeTupleToUserset, tree.Children[2].Type)
+	assert.ElementsMatch(t, []SubjectRef{
+		{Namespace: "user", ID: "erin"},
+		{Namespace: "user", ID: "dave"},
+		{Namespace: "user", ID: "alice"},
+		{Namespace: "user", ID: "carol"},
+	}, tree.Leaves())
+}
+
+func TestRoles(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAuthorizer(t)
+
+	added, err := a.AddRoleForUser(ctx, "alice", "admin", "")
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = a.AddRoleForUser(ctx, "alice", "admin", "")
+	require.NoError(t, err)
+	assert.False(t, added)
+	_, err = a.AddRoleForUser(ctx, "bob", "admin", "tenant1")
+	require.NoError(t, err)
+
+	// 授权给角色成员
+	_, err = a.AddPolicy(ctx, authz.Policy{Subject: "role:admin#member", Object: "menu:1", Action: "view"})
+	require.NoError(t, err)
+	ok, err := a.Enforce(ctx, "alice", "menu:1", "view", "")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	roles, err := a.GetRolesForUser(ctx, "alice", "")
+	require.NoError(t, err)
+	assert.Equal(t, []authz.Subject{"admin"}, roles)
+	roles, err = a.GetRolesForUser(ctx, "bob", "tenant1")
+	require.NoError(t, err)
+	assert.Equal(t, []authz.Subject{"admin"}, roles)
+	users, err := a.GetUsersForRole(ctx, "admin", "")
+	require.NoError(t, err)
+	assert.Equal(t, []authz.Subject{"alice"}, users)
+	has, err := a.HasRoleForUser(ctx, "bob", "admin", "")
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	_, err = a.AddPolicy(ctx, authz.Policy{Subject: "alice", Object: "menu:1", Action: "view", Effect: authz.EffectDeny})
+	code, _ := authz.GetAuthzErrorCode(err)
+	assert.Equal(t, authz.ErrCodeInvalidPolicy, code)
+}
+
+func TestExplain(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAuthorizer(t)
+
+	_, err := a.AddRoleForUser(ctx, "alice", "editor", "1")
+	require.NoError(t, err)
+	_, err = a.AddRoleForUser(ctx, "role:1/editor#member", "admin", "1")
+	require.NoError(t, err)
+	_, err = a.AddPolicy(ctx, authz.Policy{Subject: "role:1/admin#member", Object: "menu:1", Action: "view"})
+	require.NoError(t, err)
+
+	e, err := a.Explain(ctx, "alice", "menu:1", "view", "1")
+	require.NoError(t, err)
+	assert.True(t, e.Allowed)
+	assert.Equal(t, []authz.Policy{{Subject: "role:1/admin#member", Object: "menu:1", Action: "view", Domain: "1", Effect: authz.EffectAllow}}, e.MatchedPolicies)
+	assert.Equal(t, []authz.RoleBinding{{User: "alice", Role: "editor", Domain: "1"}, {User: "editor", Role: "admin", Domain: "1"}}, e.RoleChain)
+	assert.Equal(t, []string{"menu:1#view@role:1/admin#member", "role:1/admin#member@role:1/editor#member", "role:1/editor#member@user:alice"}, e.Trace)
+
+	e, err = a.Explain(ctx, "bob", "menu:1", "view", "1")
+	require.NoError(t, err)
+	assert.False(t, e.Allowed)
+	assert.Empty(t, e.Trace)
+}
+
+func TestParseSchema(t *testing.T) {
+	schema, err := ParseSchema([]byte(`[
+		{"name": "post", "relations": [
+			{"name": "dept"},
+			{"name": "editor", "rewrite": {"union": [{"this": true}, {"tuple_to_userset": {"tupleset": "dept", "computed_userset": "member"}}]}}
+		]}
+	]`))
+	require.NoError(t, err)
+	assert.Equal(t, Union(This(), TupleTo("dept", "member")), schema.rewrite("post", "editor"))
+	assert.Equal(t, directRewrite, schema.rewrite("post", "dept"))
+}
+
+type grpcTransport struct{ operation string }
+
+func (tr *grpcTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (tr *grpcTransport) Endpoint() string                { return "" }
+func (tr *grpcTransport) Operation() string               { return tr.operation }
+func (tr *grpcTransport) RequestHeader() transport.Header { return nil }
+func (tr *grpcTransport) ReplyHeader() transport.Header   { return nil }
+
+func TestAuthzMiddlewareOperation(t *testing.T) {
+	const operation = "/test.v1.UserService/ListUser"
+	ctx := context.Background()
+	a := newTestAuthorizer(t, WithOperations(map[string]Operation{
+		operation: {Namespace: "api", Object: "user", Relation: "viewer"},
+	}))
+	// 策略按操作映射写入域内对象，角色同样按域隔离
+	_, err := a.AddPolicy(ctx, authz.Policy{Subject: "role:1/admin#member", Object: operation, Action: "GET", Domain: "1"})
+	require.NoError(t, err)
+	_, err = a.AddRoleForUser(ctx, "alice", "admin", "1")
+	require.NoError(t, err)
+	tuples, err := a.ReadTuples(ctx, Filter{ObjectNamespace: "api"})
+	require.NoError(t, err)
+	assert.Equal(t, mustTuples(t, "api:1/user#viewer@role:1/admin#member"), tuples)
+
+	tests := []struct {
+		name    string
+		claims  *authn.AuthClaims
+		allowed bool
+	}{
+		{name: "role in domain", claims: &authn.AuthClaims{"sub": "alice", "dom": "1"}, allowed: true},
+		{name: "other domain", claims: &authn.AuthClaims{"sub": "alice", "dom": "2"}},
+		{name: "other user", claims: &authn.AuthClaims{"sub": "bob", "dom": "1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := transport.NewServerContext(authn.ContextWithAuthClaims(ctx, tt.claims), &grpcTransport{operation})
+			_, err := authMiddleware.AuthzMiddleware(a)(func(context.Context, interface{}) (interface{}, error) { return nil, nil })(ctx, nil)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, authMiddleware.ErrPermissionDenied)
+			}
+		})
+	}
+}
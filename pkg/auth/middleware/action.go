@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"context"
+	nethttp "net/http"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	"backend-service/pkg/auth/authz"
+	"backend-service/pkg/auth/authz/catalog"
+)
+
+// CRUD 操作定义
+const (
+	// ActionCreate 创建
+	ActionCreate authz.Action = "create"
+	// ActionRead 读取
+	ActionRead authz.Action = "read"
+	// ActionUpdate 更新
+	ActionUpdate authz.Action = "update"
+	// ActionDelete 删除
+	ActionDelete authz.Action = "delete"
+)
+
+// ActionResolver 定义从请求中推导授权操作的函数类型，无法推导时返回空字符串
+type ActionResolver func(ctx context.Context, tr transport.Transporter) authz.Action
+
+// DefaultActionResolver 默认的授权操作解析器，取实际请求方法，不信任客户端可控的请求头
+var DefaultActionResolver ActionResolver = HTTPMethodAction
+
+// crudPrefixes 方法名前缀与 CRUD 操作的对应关系，按顺序匹配
+var crudPrefixes = []struct {
+	prefix string
+	action authz.Action
+}{
+	{"Get", ActionRead}, {"List", ActionRead}, {"Query", ActionRead}, {"Find", ActionRead},
+	{"Search", ActionRead}, {"Count", ActionRead}, {"Exist", ActionRead}, {"Export", ActionRead},
+	{"Create", ActionCreate}, {"Add", ActionCreate}, {"Insert", ActionCreate}, {"Import", ActionCreate},
+	{"Update", ActionUpdate}, {"Set", ActionUpdate}, {"Modify", ActionUpdate}, {"Patch", ActionUpdate}, {"Restore", ActionUpdate}, {"Move", ActionUpdate},
+	{"Delete", ActionDelete}, {"Remove", ActionDelete}, {"Destroy", ActionDelete}, {"Purge", ActionDelete},
+}
+
+// operationMethods 操作名到 HTTP 方法的缓存
+var operationMethods sync.Map
+
+// OperationAction 以操作的方法名作为授权操作，如 /pkg.Service/ListUser 为 ListUser
+// 需按接口方法名配置策略的服务通过 WithActionResolver 显式选用
+func OperationAction(_ context.Context, tr transport.Transporter) authz.Action {
+	return OperationMethod(tr.Operation())
+}
+
+// OperationMethod 返回操作名中的方法名
+func OperationMethod(operation string) authz.Action {
+	return authz.Action(operation[strings.LastIndex(operation, "/")+1:])
+}
+
+// HTTPMethodAction 以 HTTP 方法作为授权操作
+// HTTP 请求取实际请求方法；gRPC 请求取方法 google.api.http 注解的主路由方法，
+// 使同一接口经两种传输层访问时命中相同策略
+func HTTPMethodAction(_ context.Context, tr transport.Transporter) authz.Action {
+	if ht, ok := tr.(http.Transporter); ok && ht.Request() != nil {
+		return authz.Action(ht.Request().Method)
+	}
+	return authz.Action(OperationHTTPMethod(tr.Operation()))
+}
+
+// CRUDAction 以 CRUD 动词作为授权操作
+// 优先按操作方法名前缀推导，如 ListUser 为 read、RemovePolicy 为 delete；
+// 无法识别时按 HTTPMethodAction 的结果映射
+func CRUDAction(ctx context.Context, tr transport.Transporter) authz.Action {
+	if act := OperationCRUD(tr.Operation()); act != "" {
+		return act
+	}
+	return HTTPMethodCRUD(string(HTTPMethodAction(ctx, tr)))
+}
+
+// OperationHTTPMethod 查找操作注解的主路由 HTTP 方法
+// gRPC 请求均以 POST 承载，未注解或未注册的操作返回 POST
+func OperationHTTPMethod(operation string) string {
+	if method, ok := operationMethods.Load(operation); ok {
+		return method.(string)
+	}
+	method := nethttp.MethodPost
+	if p, ok := catalog.Describe(operation); ok && len(p.Routes) > 0 {
+		method = p.Routes[0].Method
+	}
+	operationMethods.Store(operation, method)
+	return method
+}
+
+// OperationCRUD 按操作方法名前缀推导 CRUD 操作，无法识别时返回空字符串
+func OperationCRUD(operation string) authz.Action {
+	method := string(OperationMethod(operation))
+	for _, p := range crudPrefixes {
+		// 前缀须为完整单词，避免 Address、Settle 等误判
+		if rest, ok := strings.CutPrefix(method, p.prefix); ok && (rest == "" || unicode.IsUpper(rune(rest[0]))) {
+			return p.action
+		}
+	}
+	return ""
+}
+
+// HTTPMethodCRUD 将 HTTP 方法映射为 CRUD 操作，无法识别时返回空字符串
+func HTTPMethodCRUD(method string) authz.Action {
+	switch strings.ToUpper(method) {
+	case nethttp.MethodGet, nethttp.MethodHead:
+		return ActionRead
+	case nethttp.MethodPost:
+		return ActionCreate
+	case nethttp.MethodPut, nethttp.MethodPatch:
+		return ActionUpdate
+	case nethttp.MethodDelete:
+		return ActionDelete
+	default:
+		return ""
+	}
+}
@@ -57,7 +57,7 @@ func DefaultGRPCAuthExtractor(ctx context.Context) (string, error) {
 type GRPCAuthzInfoExtractor func(ctx context.Context, fullMethod string) (authz.Subject, authz.Object, authz.Action, authz.Domain, error)
 
 // DefaultGRPCAuthzInfoExtractor 默认的gRPC授权信息提取器
-// 从请求方法和认证声明中提取授权信息
+// 从请求方法和认证声明中提取授权信息，操作为方法注解的 HTTP 方法，与 DefaultActionResolver 一致
 func DefaultGRPCAuthzInfoExtractor(ctx context.Context, fullMethod string) (authz.Subject, authz.Object, authz.Action, authz.Domain, error) {
 	// 从认证声明中提取主体和域
 	claims, ok := authn.AuthClaimsFromContext(ctx)
@@ -72,12 +72,8 @@ func DefaultGRPCAuthzInfoExtractor(ctx context.Context, fullMethod string) (auth
 	// 从请求方法中提取对象和操作
 	obj := authz.Object(fullMethod)
 
-	// 从方法名中提取操作
-	parts := strings.Split(fullMethod, "/")
-	act := authz.Action("")
-	if len(parts) > 0 {
-		act = authz.Action(parts[len(parts)-1])
-	}
+	// 从方法注解中提取操作
+	act := authz.Action(OperationHTTPMethod(fullMethod))
 
 	return sub, obj, act, dom, nil
 }
@@ -128,6 +124,18 @@ func GRPCAuthnMiddleware(authenticator authn.Authenticator, extractor GRPCAuthEx
 
 					// 将认证声明注入上下文
 					ctx = authn.ContextWithAuthClaims(ctx, claims)
+
+					// 配置了用户工厂时，同步注入认证用户，保持与HTTP一致
+					if factory := authenticator.Options().UserFactory; factory != nil {
+						securityUser := factory(claims)
+						if securityUser == nil {
+							return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", "security user parse fail")
+						}
+						if err := securityUser.ParseFromContext(ctx); err != nil {
+							return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", err.Error())
+						}
+						ctx = authn.ContextWithAuthUser(ctx, securityUser)
+					}
 				}
 			}
 
@@ -145,52 +153,28 @@ func GRPCAuthzMiddleware(authorizer authz.Authorizer, extractor GRPCAuthzInfoExt
 
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			if tr, ok := transport.FromServerContext(ctx); ok {
-				if tr.Kind() == transport.KindGRPC {
-					// 获取完整方法名
-					fullMethod := tr.Operation()
-
-					// 提取授权信息
-					sub, obj, act, dom, err := extractor(ctx, fullMethod)
-					if err != nil {
-						// 处理提取错误
-						var authErr *authn.AuthError
-						if errors.As(err, &authErr) {
-							switch authErr.Code {
-							case authn.ErrCodeInvalidToken:
-								return nil, ErrInvalidToken
-							default:
-								return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
-							}
-						}
-						return nil, ErrInvalidToken
-					}
-
-					// 执行授权检查
-					if sub != "" && obj != "" && act != "" {
-						allowed, err := authorizer.Enforce(ctx, sub, obj, act, dom)
-						if err != nil {
-							// 处理授权错误
-							var authzErr *authz.AuthzError
-							if errors.As(err, &authzErr) {
-								switch authzErr.Code {
-								case authz.ErrCodePermissionDenied:
-									return nil, ErrPermissionDenied
-								default:
-									return nil, errors.New(ErrForbidden, "FORBIDDEN", authzErr.Error())
-								}
-							}
-							return nil, ErrPermissionDenied
-						}
+			// 缺少传输层信息时无法确定授权对象，拒绝访问
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, ErrIncompleteAuthzInfo
+			}
+			// 其它传输层无法提取授权信息，拒绝访问而非跳过检查
+			if tr.Kind() != transport.KindGRPC {
+				return nil, ErrUnsupportedTransport
+			}
+			// 获取完整方法名
+			fullMethod := tr.Operation()
 
-						if !allowed {
-							return nil, ErrPermissionDenied
-						}
+			// 提取授权信息
+			sub, obj, act, dom, err := extractor(ctx, fullMethod)
+			if err != nil {
+				return nil, extractorError(err)
+			}
 
-						// 将授权结果注入上下文
-						ctx = authz.ContextWithAuthzResult(ctx, true)
-					}
-				}
+			// 执行授权检查
+			ctx, err = enforce(ctx, authorizer, sub, obj, act, dom)
+			if err != nil {
+				return nil, err
 			}
 
 			// 继续处理请求
@@ -215,89 +199,65 @@ func GRPCCombinedAuthMiddleware(
 
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			if tr, ok := transport.FromServerContext(ctx); ok {
-				if tr.Kind() == transport.KindGRPC {
-					// 提取令牌
-					token, err := authExtractor(ctx)
-					if err != nil {
-						// 处理提取错误
-						var authErr *authn.AuthError
-						if errors.As(err, &authErr) {
-							switch authErr.Code {
-							case authn.ErrCodeMissingToken:
-								return nil, ErrMissingToken
-							default:
-								return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
-							}
-						}
+			// 缺少传输层信息时无法确定授权对象，拒绝访问
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, ErrIncompleteAuthzInfo
+			}
+			// 其它传输层无法提取授权信息，拒绝访问而非跳过检查
+			if tr.Kind() != transport.KindGRPC {
+				return nil, ErrUnsupportedTransport
+			}
+			// 提取令牌
+			token, err := authExtractor(ctx)
+			if err != nil {
+				// 处理提取错误
+				var authErr *authn.AuthError
+				if errors.As(err, &authErr) {
+					switch authErr.Code {
+					case authn.ErrCodeMissingToken:
 						return nil, ErrMissingToken
+					default:
+						return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
 					}
+				}
+				return nil, ErrMissingToken
+			}
 
-					// 验证令牌
-					claims, err := authenticator.ValidateToken(ctx, token)
-					if err != nil {
-						// 处理验证错误
-						var authErr *authn.AuthError
-						if errors.As(err, &authErr) {
-							switch authErr.Code {
-							case authn.ErrCodeExpiredToken:
-								return nil, ErrExpiredToken
-							case authn.ErrCodeInvalidToken, authn.ErrCodeInvalidSignature, authn.ErrCodeInvalidClaims:
-								return nil, ErrInvalidToken
-							default:
-								return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
-							}
-						}
+			// 验证令牌
+			claims, err := authenticator.ValidateToken(ctx, token)
+			if err != nil {
+				// 处理验证错误
+				var authErr *authn.AuthError
+				if errors.As(err, &authErr) {
+					switch authErr.Code {
+					case authn.ErrCodeExpiredToken:
+						return nil, ErrExpiredToken
+					case authn.ErrCodeInvalidToken, authn.ErrCodeInvalidSignature, authn.ErrCodeInvalidClaims:
 						return nil, ErrInvalidToken
+					default:
+						return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
 					}
+				}
+				return nil, ErrInvalidToken
+			}
 
-					// 将认证声明注入上下文
-					ctx = authn.ContextWithAuthClaims(ctx, claims)
-
-					// 获取完整方法名
-					fullMethod := tr.Operation()
-
-					// 提取授权信息
-					sub, obj, act, dom, err := authzExtractor(ctx, fullMethod)
-					if err != nil {
-						// 处理提取错误
-						var authErr *authn.AuthError
-						if errors.As(err, &authErr) {
-							switch authErr.Code {
-							case authn.ErrCodeInvalidToken:
-								return nil, ErrInvalidToken
-							default:
-								return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
-							}
-						}
-						return nil, ErrInvalidToken
-					}
+			// 将认证声明注入上下文
+			ctx = authn.ContextWithAuthClaims(ctx, claims)
 
-					// 执行授权检查
-					if sub != "" && obj != "" && act != "" {
-						allowed, err := authorizer.Enforce(ctx, sub, obj, act, dom)
-						if err != nil {
-							// 处理授权错误
-							var authzErr *authz.AuthzError
-							if errors.As(err, &authzErr) {
-								switch authzErr.Code {
-								case authz.ErrCodePermissionDenied:
-									return nil, ErrPermissionDenied
-								default:
-									return nil, errors.New(ErrForbidden, "FORBIDDEN", authzErr.Error())
-								}
-							}
-							return nil, ErrPermissionDenied
-						}
+			// 获取完整方法名
+			fullMethod := tr.Operation()
 
-						if !allowed {
-							return nil, ErrPermissionDenied
-						}
+			// 提取授权信息
+			sub, obj, act, dom, err := authzExtractor(ctx, fullMethod)
+			if err != nil {
+				return nil, extractorError(err)
+			}
 
-						// 将授权结果注入上下文
-						ctx = authz.ContextWithAuthzResult(ctx, true)
-					}
-				}
+			// 执行授权检查
+			ctx, err = enforce(ctx, authorizer, sub, obj, act, dom)
+			if err != nil {
+				return nil, err
 			}
 
 			// 继续处理请求
@@ -136,54 +136,32 @@ func HTTPAuthzMiddleware(authorizer authz.Authorizer, extractor HTTPAuthzInfoExt
 
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			if tr, ok := transport.FromServerContext(ctx); ok {
-				if tr.Kind() == transport.KindHTTP {
-
-					httpCtx, ok := ctx.(http.Context)
-					if ok {
-						httpReq := httpCtx.Request()
-						// 提取授权信息
-						sub, obj, act, dom, err := extractor(ctx, httpReq)
-						if err != nil {
-							// 处理提取错误
-							var authErr *authn.AuthError
-							if errors.As(err, &authErr) {
-								switch authErr.Code {
-								case authn.ErrCodeInvalidToken:
-									return nil, ErrInvalidToken
-								default:
-									return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
-								}
-							}
-							return nil, ErrInvalidToken
-						}
-
-						// 执行授权检查
-						if sub != "" && obj != "" && act != "" {
-							allowed, err := authorizer.Enforce(ctx, sub, obj, act, dom)
-							if err != nil {
-								// 处理授权错误
-								var authzErr *authz.AuthzError
-								if errors.As(err, &authzErr) {
-									switch authzErr.Code {
-									case authz.ErrCodePermissionDenied:
-										return nil, ErrPermissionDenied
-									default:
-										return nil, errors.New(ErrForbidden, "FORBIDDEN", authzErr.Error())
-									}
-								}
-								return nil, ErrPermissionDenied
-							}
+			// 缺少传输层信息时无法确定授权对象，拒绝访问
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, ErrIncompleteAuthzInfo
+			}
+			// 其它传输层无法提取授权信息，拒绝访问而非跳过检查
+			if tr.Kind() != transport.KindHTTP {
+				return nil, ErrUnsupportedTransport
+			}
+			// 无法获取 HTTP 请求时拒绝访问而非跳过检查
+			ht, ok := tr.(http.Transporter)
+			if !ok || ht.Request() == nil {
+				return nil, ErrIncompleteAuthzInfo
+			}
+			httpReq := ht.Request()
 
-							if !allowed {
-								return nil, ErrPermissionDenied
-							}
+			// 提取授权信息
+			sub, obj, act, dom, err := extractor(ctx, httpReq)
+			if err != nil {
+				return nil, extractorError(err)
+			}
 
-							// 将授权结果注入上下文
-							ctx = authz.ContextWithAuthzResult(ctx, true)
-						}
-					}
-				}
+			// 执行授权检查
+			ctx, err = enforce(ctx, authorizer, sub, obj, act, dom)
+			if err != nil {
+				return nil, err
 			}
 
 			// 继续处理请求
@@ -208,90 +186,69 @@ func HTTPCombinedAuthMiddleware(
 
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			if tr, ok := transport.FromServerContext(ctx); ok {
-				if tr.Kind() == transport.KindHTTP {
-					httpCtx, ok := ctx.(http.Context)
-					if ok {
-						httpReq := httpCtx.Request()
-						// 提取令牌
-						token, err := authExtractor(ctx, httpReq)
-						if err != nil {
-							// 处理提取错误
-							var authErr *authn.AuthError
-							if errors.As(err, &authErr) {
-								switch authErr.Code {
-								case authn.ErrCodeMissingToken:
-									return nil, ErrMissingToken
-								default:
-									return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
-								}
-							}
-							return nil, ErrMissingToken
-						}
-
-						// 验证令牌
-						claims, err := authenticator.ValidateToken(ctx, token)
-						if err != nil {
-							// 处理验证错误
-							var authErr *authn.AuthError
-							if errors.As(err, &authErr) {
-								switch authErr.Code {
-								case authn.ErrCodeExpiredToken:
-									return nil, ErrExpiredToken
-								case authn.ErrCodeInvalidToken, authn.ErrCodeInvalidSignature, authn.ErrCodeInvalidClaims:
-									return nil, ErrInvalidToken
-								default:
-									return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
-								}
-							}
-							return nil, ErrInvalidToken
-						}
-
-						// 将认证声明注入上下文
-						ctx = authn.ContextWithAuthClaims(ctx, claims)
+			// 缺少传输层信息时无法确定授权对象，拒绝访问
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, ErrIncompleteAuthzInfo
+			}
+			// 其它传输层无法提取授权信息，拒绝访问而非跳过检查
+			if tr.Kind() != transport.KindHTTP {
+				return nil, ErrUnsupportedTransport
+			}
+			// 无法获取 HTTP 请求时拒绝访问而非跳过检查
+			ht, ok := tr.(http.Transporter)
+			if !ok || ht.Request() == nil {
+				return nil, ErrIncompleteAuthzInfo
+			}
+			httpReq := ht.Request()
+
+			// 提取令牌
+			token, err := authExtractor(ctx, httpReq)
+			if err != nil {
+				// 处理提取错误
+				var authErr *authn.AuthError
+				if errors.As(err, &authErr) {
+					switch authErr.Code {
+					case authn.ErrCodeMissingToken:
+						return nil, ErrMissingToken
+					default:
+						return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
+					}
+				}
+				return nil, ErrMissingToken
+			}
 
-						// 提取授权信息
-						sub, obj, act, dom, err := authzExtractor(ctx, httpReq)
-						if err != nil {
-							// 处理提取错误
-							var authErr *authn.AuthError
-							if errors.As(err, &authErr) {
-								switch authErr.Code {
-								case authn.ErrCodeInvalidToken:
-									return nil, ErrInvalidToken
-								default:
-									return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
-								}
-							}
-							return nil, ErrInvalidToken
-						}
+			// 验证令牌
+			claims, err := authenticator.ValidateToken(ctx, token)
+			if err != nil {
+				// 处理验证错误
+				var authErr *authn.AuthError
+				if errors.As(err, &authErr) {
+					switch authErr.Code {
+					case authn.ErrCodeExpiredToken:
+						return nil, ErrExpiredToken
+					case authn.ErrCodeInvalidToken, authn.ErrCodeInvalidSignature, authn.ErrCodeInvalidClaims:
+						return nil, ErrInvalidToken
+					default:
+						return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
+					}
+				}
+				return nil, ErrInvalidToken
+			}
 
-						// 执行授权检查
-						if sub != "" && obj != "" && act != "" {
-							allowed, err := authorizer.Enforce(ctx, sub, obj, act, dom)
-							if err != nil {
-								// 处理授权错误
-								var authzErr *authz.AuthzError
-								if errors.As(err, &authzErr) {
-									switch authzErr.Code {
-									case authz.ErrCodePermissionDenied:
-										return nil, ErrPermissionDenied
-									default:
-										return nil, errors.New(ErrForbidden, "FORBIDDEN", authzErr.Error())
-									}
-								}
-								return nil, ErrPermissionDenied
-							}
+			// 将认证声明注入上下文
+			ctx = authn.ContextWithAuthClaims(ctx, claims)
 
-							if !allowed {
-								return nil, ErrPermissionDenied
-							}
+			// 提取授权信息
+			sub, obj, act, dom, err := authzExtractor(ctx, httpReq)
+			if err != nil {
+				return nil, extractorError(err)
+			}
 
-							// 将授权结果注入上下文
-							ctx = authz.ContextWithAuthzResult(ctx, true)
-						}
-					}
-				}
+			// 执行授权检查
+			ctx, err = enforce(ctx, authorizer, sub, obj, act, dom)
+			if err != nil {
+				return nil, err
 			}
 
 			// 继续处理请求
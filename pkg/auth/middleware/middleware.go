@@ -21,17 +21,95 @@ const (
 )
 
 // 错误信息定义
+// 同类错误的错误码与原因相同，以认证、鉴权包的预定义错误为原因，调用方可用 errors.Is 区分
 var (
 	// ErrMissingToken 缺少令牌错误
-	ErrMissingToken = errors.New(ErrUnauthorized, "UNAUTHORIZED", "missing token")
+	ErrMissingToken = errors.New(ErrUnauthorized, "UNAUTHORIZED", "missing token").WithCause(authn.ErrMissingToken)
 	// ErrInvalidToken 无效令牌错误
-	ErrInvalidToken = errors.New(ErrUnauthorized, "UNAUTHORIZED", "invalid token")
+	ErrInvalidToken = errors.New(ErrUnauthorized, "UNAUTHORIZED", "invalid token").WithCause(authn.ErrInvalidToken)
 	// ErrExpiredToken 令牌过期错误
-	ErrExpiredToken = errors.New(ErrUnauthorized, "UNAUTHORIZED", "token has expired")
+	ErrExpiredToken = errors.New(ErrUnauthorized, "UNAUTHORIZED", "token has expired").WithCause(authn.ErrExpiredToken)
 	// ErrPermissionDenied 权限被拒绝错误
-	ErrPermissionDenied = errors.New(ErrForbidden, "FORBIDDEN", "permission denied")
+	ErrPermissionDenied = errors.New(ErrForbidden, "FORBIDDEN", "permission denied").WithCause(authz.ErrPermissionDenied)
+	// ErrIncompleteAuthzInfo 授权信息不完整错误，主体、对象或操作缺失时拒绝访问
+	ErrIncompleteAuthzInfo = errors.New(ErrForbidden, "FORBIDDEN", "incomplete authorization info")
+	// ErrUnsupportedTransport 传输层不受支持错误，中间件无法从该传输层提取授权信息时拒绝访问
+	ErrUnsupportedTransport = errors.New(ErrForbidden, "FORBIDDEN", "unsupported transport")
 )
 
+// AuthzInfoExtractor 定义从请求上下文中提取授权信息的函数类型，调用时认证声明已注入上下文
+type AuthzInfoExtractor func(ctx context.Context) (authz.Subject, authz.Object, authz.Action, authz.Domain, error)
+
+// AuthzOption 身份鉴权中间件选项
+type AuthzOption func(*authzOptions)
+
+type authzOptions struct {
+	extractor      AuthzInfoExtractor
+	actionResolver ActionResolver
+}
+
+// WithAuthzInfoExtractor 设置授权信息提取器，替换默认的提取逻辑
+func WithAuthzInfoExtractor(extractor AuthzInfoExtractor) AuthzOption {
+	return func(o *authzOptions) {
+		o.extractor = extractor
+	}
+}
+
+// WithActionResolver 设置授权操作解析器，默认为 DefaultActionResolver
+func WithActionResolver(resolver ActionResolver) AuthzOption {
+	return func(o *authzOptions) {
+		o.actionResolver = resolver
+	}
+}
+
+// newAuthzOptions 应用身份鉴权中间件选项
+func newAuthzOptions(opts []AuthzOption) *authzOptions {
+	o := &authzOptions{actionResolver: DefaultActionResolver}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// extract 提取授权信息：上下文中已注入时直接使用，否则由提取器或传输层与认证声明推导
+// domain: 默认推导时从认证声明中获取域的方法
+func (o *authzOptions) extract(ctx context.Context, domain func(*authn.AuthClaims) string) (authz.Subject, authz.Object, authz.Action, authz.Domain, error) {
+	if sub, obj, act, dom, ok := authz.ExtractAuthzInfo(ctx); ok {
+		return sub, obj, act, dom, nil
+	}
+	if o.extractor != nil {
+		sub, obj, act, dom, err := o.extractor(ctx)
+		if err != nil {
+			return "", "", "", "", extractorError(err)
+		}
+		return sub, obj, act, dom, nil
+	}
+
+	// 从认证声明中提取主体和域
+	claims, ok := authn.AuthClaimsFromContext(ctx)
+	if !ok || claims == nil {
+		return "", "", "", "", ErrInvalidToken
+	}
+	// 以操作名为对象，操作由解析器推导
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return "", "", "", "", ErrIncompleteAuthzInfo
+	}
+	return authz.Subject(claims.GetSubject()), authz.Object(tr.Operation()), o.actionResolver(ctx, tr), authz.Domain(domain(claims)), nil
+}
+
+// authnSentinelError 转换认证器直接返回的预定义错误，未识别的错误视为无效令牌
+func authnSentinelError(err error) error {
+	switch {
+	case errors.Is(err, authn.ErrMissingToken):
+		return ErrMissingToken
+	case errors.Is(err, authn.ErrExpiredToken):
+		return ErrExpiredToken
+	default:
+		return ErrInvalidToken
+	}
+}
+
 // AuthnMiddleware 创建身份验证中间件
 func AuthnMiddleware(authenticator authn.Authenticator) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
@@ -50,10 +128,10 @@ func AuthnMiddleware(authenticator authn.Authenticator) middleware.Middleware {
 					case authn.ErrCodeInvalidToken, authn.ErrCodeInvalidSignature, authn.ErrCodeInvalidClaims:
 						return nil, ErrInvalidToken
 					default:
-						return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
+						return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error()).WithCause(authErr)
 					}
 				}
-				return nil, ErrInvalidToken
+				return nil, authnSentinelError(err)
 			}
 
 			// 将认证声明注入上下文
@@ -76,65 +154,21 @@ func AuthnMiddleware(authenticator authn.Authenticator) middleware.Middleware {
 }
 
 // AuthzMiddleware 创建身份鉴权中间件
-func AuthzMiddleware(authorizer authz.Authorizer) middleware.Middleware {
+// 授权信息优先取自上下文，否则由提取器或传输层与认证声明推导，域取自认证声明中的 dom
+func AuthzMiddleware(authorizer authz.Authorizer, opts ...AuthzOption) middleware.Middleware {
+	o := newAuthzOptions(opts)
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			// 从上下文中提取授权信息
-			sub, obj, act, dom, ok := authz.ExtractAuthzInfo(ctx)
-			if !ok {
-				// 如果上下文中没有授权信息，尝试从请求中提取
-				if tr, ok := transport.FromServerContext(ctx); ok {
-					// 从请求路径和方法中提取授权信息
-					path := tr.Operation()
-					method := ""
-					switch tr.Kind() {
-					case transport.KindHTTP:
-						method = tr.RequestHeader().Get("X-HTTP-Method")
-					case transport.KindGRPC:
-						// 从gRPC方法中提取操作
-						parts := strings.Split(path, "/")
-						if len(parts) > 0 {
-							method = parts[len(parts)-1]
-						}
-					}
-
-					// 从认证声明中提取主体
-					claims, ok := authn.AuthClaimsFromContext(ctx)
-					if !ok || claims == nil {
-						return nil, ErrInvalidToken
-					}
-
-					// 设置授权信息
-					sub = authz.Subject(claims.GetSubject())
-					obj = authz.Object(path)
-					act = authz.Action(method)
-					dom = authz.Domain(claims.GetDomain())
-				}
+			// 提取授权信息
+			sub, obj, act, dom, err := o.extract(ctx, (*authn.AuthClaims).GetDomain)
+			if err != nil {
+				return nil, err
 			}
 
 			// 执行授权检查
-			if sub != "" && obj != "" && act != "" {
-				allowed, err := authorizer.Enforce(ctx, sub, obj, act, dom)
-				if err != nil {
-					// 处理授权错误
-					var authzErr *authz.AuthzError
-					if errors.As(err, &authzErr) {
-						switch authzErr.Code {
-						case authz.ErrCodePermissionDenied:
-							return nil, ErrPermissionDenied
-						default:
-							return nil, errors.New(ErrForbidden, "FORBIDDEN", authzErr.Error())
-						}
-					}
-					return nil, ErrPermissionDenied
-				}
-
-				if !allowed {
-					return nil, ErrPermissionDenied
-				}
-
-				// 将授权结果注入上下文
-				ctx = authz.ContextWithAuthzResult(ctx, true)
+			ctx, err = enforce(ctx, authorizer, sub, obj, act, dom)
+			if err != nil {
+				return nil, err
 			}
 
 			// 继续处理请求
@@ -144,7 +178,8 @@ func AuthzMiddleware(authorizer authz.Authorizer) middleware.Middleware {
 }
 
 // CombinedAuthMiddleware 创建组合身份验证和身份鉴权中间件
-func CombinedAuthMiddleware(authenticator authn.Authenticator, authorizer authz.Authorizer) middleware.Middleware {
+func CombinedAuthMiddleware(authenticator authn.Authenticator, authorizer authz.Authorizer, opts ...AuthzOption) middleware.Middleware {
+	o := newAuthzOptions(opts)
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
 			// 执行身份验证
@@ -161,71 +196,25 @@ func CombinedAuthMiddleware(authenticator authn.Authenticator, authorizer authz.
 					case authn.ErrCodeInvalidToken, authn.ErrCodeInvalidSignature, authn.ErrCodeInvalidClaims:
 						return nil, ErrInvalidToken
 					default:
-						return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
+						return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error()).WithCause(authErr)
 					}
 				}
-				return nil, ErrInvalidToken
+				return nil, authnSentinelError(err)
 			}
 
 			// 将认证声明注入上下文
 			ctx = authn.ContextWithAuthClaims(ctx, claims)
 
-			// 从请求中提取授权信息
-			var sub authz.Subject
-			var obj authz.Object
-			var act authz.Action
-			var dom authz.Domain
-
-			// 从上下文中提取授权信息
-			sub, obj, act, dom, ok := authz.ExtractAuthzInfo(ctx)
-			if !ok {
-				// 如果上下文中没有授权信息，尝试从请求中提取
-				if tr, ok := transport.FromServerContext(ctx); ok {
-					// 从请求路径和方法中提取授权信息
-					path := tr.Operation()
-					method := ""
-					switch tr.Kind() {
-					case transport.KindHTTP:
-						method = tr.RequestHeader().Get("X-HTTP-Method")
-					case transport.KindGRPC:
-						// 从gRPC方法中提取操作
-						parts := strings.Split(path, "/")
-						if len(parts) > 0 {
-							method = parts[len(parts)-1]
-						}
-					}
-
-					// 设置授权信息
-					sub = authz.Subject(claims.GetSubject())
-					obj = authz.Object(path)
-					act = authz.Action(method)
-					dom = authz.Domain(claims.GetIssuer())
-				}
+			// 提取授权信息
+			sub, obj, act, dom, err := o.extract(ctx, (*authn.AuthClaims).GetIssuer)
+			if err != nil {
+				return nil, err
 			}
 
 			// 执行授权检查
-			if sub != "" && obj != "" && act != "" {
-				allowed, err := authorizer.Enforce(ctx, sub, obj, act, dom)
-				if err != nil {
-					// 处理授权错误
-					var authzErr *authz.AuthzError
-					if errors.As(err, &authzErr) {
-						switch authzErr.Code {
-						case authz.ErrCodePermissionDenied:
-							return nil, ErrPermissionDenied
-						default:
-							return nil, errors.New(ErrForbidden, "FORBIDDEN", authzErr.Error())
-						}
-					}
-					return nil, ErrPermissionDenied
-				}
-
-				if !allowed {
-					return nil, ErrPermissionDenied
-				}
-
-				// 将授权结果注入上下文
-				ctx = authz.ContextWithAuthzResult(ctx, true)
+			ctx, err = enforce(ctx, authorizer, sub, obj, act, dom)
+			if err != nil {
+				return nil, err
 			}
 
 			// 继续处理请求
@@ -263,10 +252,10 @@ func SkipAuthPathMiddleware(authenticator authn.Authenticator, skipPaths []strin
 					case authn.ErrCodeInvalidToken, authn.ErrCodeInvalidSignature, authn.ErrCodeInvalidClaims:
 						return nil, ErrInvalidToken
 					default:
-						return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error())
+						return nil, errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error()).WithCause(authErr)
 					}
 				}
-				return nil, ErrInvalidToken
+				return nil, authnSentinelError(err)
 			}
 
 			// 将认证声明注入上下文
@@ -279,40 +268,14 @@ func SkipAuthPathMiddleware(authenticator authn.Authenticator, skipPaths []strin
 }
 
 // SkipAuthRoleMiddleware 创建基于角色跳过身份鉴权的中间件
-func SkipAuthRoleMiddleware(authorizer authz.Authorizer, skipRoles []string) middleware.Middleware {
+func SkipAuthRoleMiddleware(authorizer authz.Authorizer, skipRoles []string, opts ...AuthzOption) middleware.Middleware {
+	o := newAuthzOptions(opts)
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			// 从上下文中提取授权信息
-			sub, obj, act, dom, ok := authz.ExtractAuthzInfo(ctx)
-			if !ok {
-				// 如果上下文中没有授权信息，尝试从请求中提取
-				if tr, ok := transport.FromServerContext(ctx); ok {
-					// 从请求路径和方法中提取授权信息
-					path := tr.Operation()
-					method := ""
-					switch tr.Kind() {
-					case transport.KindHTTP:
-						method = tr.RequestHeader().Get("X-HTTP-Method")
-					case transport.KindGRPC:
-						// 从gRPC方法中提取操作
-						parts := strings.Split(path, "/")
-						if len(parts) > 0 {
-							method = parts[len(parts)-1]
-						}
-					}
-
-					// 从认证声明中提取主体
-					claims, ok := authn.AuthClaimsFromContext(ctx)
-					if !ok || claims == nil {
-						return nil, ErrInvalidToken
-					}
-
-					// 设置授权信息
-					sub = authz.Subject(claims.GetSubject())
-					obj = authz.Object(path)
-					act = authz.Action(method)
-					dom = authz.Domain(claims.GetIssuer())
-				}
+			// 提取授权信息
+			sub, obj, act, dom, err := o.extract(ctx, (*authn.AuthClaims).GetIssuer)
+			if err != nil {
+				return nil, err
 			}
 
 			// 检查用户角色
@@ -331,28 +294,9 @@ func SkipAuthRoleMiddleware(authorizer authz.Authorizer, skipRoles []string) mid
 			}
 
 			// 执行授权检查
-			if sub != "" && obj != "" && act != "" {
-				allowed, err := authorizer.Enforce(ctx, sub, obj, act, dom)
-				if err != nil {
-					// 处理授权错误
-					var authzErr *authz.AuthzError
-					if errors.As(err, &authzErr) {
-						switch authzErr.Code {
-						case authz.ErrCodePermissionDenied:
-							return nil, ErrPermissionDenied
-						default:
-							return nil, errors.New(ErrForbidden, "FORBIDDEN", authzErr.Error())
-						}
-					}
-					return nil, ErrPermissionDenied
-				}
-
-				if !allowed {
-					return nil, ErrPermissionDenied
-				}
-
-				// 将授权结果注入上下文
-				ctx = authz.ContextWithAuthzResult(ctx, true)
+			ctx, err = enforce(ctx, authorizer, sub, obj, act, dom)
+			if err != nil {
+				return nil, err
 			}
 
 			// 继续处理请求
@@ -360,3 +304,51 @@ func SkipAuthRoleMiddleware(authorizer authz.Authorizer, skipRoles []string) mid
 		}
 	}
 }
+
+// enforce 执行授权检查，主体、对象或操作缺失时拒绝访问而非跳过检查
+// 返回: 注入授权结果的上下文和可能的错误
+func enforce(ctx context.Context, authorizer authz.Authorizer, sub authz.Subject, obj authz.Object, act authz.Action, dom authz.Domain) (context.Context, error) {
+	if sub == "" || obj == "" || act == "" {
+		return ctx, ErrIncompleteAuthzInfo
+	}
+
+	allowed, err := authorizer.Enforce(ctx, sub, obj, act, dom)
+	if err != nil {
+		// 处理授权错误
+		var authzErr *authz.AuthzError
+		if errors.As(err, &authzErr) {
+			switch authzErr.Code {
+			case authz.ErrCodePermissionDenied:
+				return ctx, ErrPermissionDenied
+			default:
+				return ctx, errors.New(ErrForbidden, "FORBIDDEN", authzErr.Error()).WithCause(authzErr)
+			}
+		}
+		return ctx, ErrPermissionDenied
+	}
+
+	if !allowed {
+		return ctx, ErrPermissionDenied
+	}
+
+	// 将授权结果注入上下文
+	return authz.ContextWithAuthzResult(ctx, true), nil
+}
+
+// extractorError 转换授权信息提取器返回的错误
+func extractorError(err error) error {
+	var se *errors.Error
+	if errors.As(err, &se) {
+		return se
+	}
+	var authErr *authn.AuthError
+	if errors.As(err, &authErr) {
+		switch authErr.Code {
+		case authn.ErrCodeInvalidToken:
+			return ErrInvalidToken
+		default:
+			return errors.New(ErrUnauthorized, "UNAUTHORIZED", authErr.Error()).WithCause(authErr)
+		}
+	}
+	return ErrInvalidToken
+}
@@ -0,0 +1,217 @@
+package middleware
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+
+	"backend-service/pkg/auth/authn"
+	"backend-service/pkg/auth/authz"
+)
+
+type testTransport struct {
+	kind      transport.Kind
+	operation string
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return tr.kind }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return tr.operation }
+func (tr *testTransport) RequestHeader() transport.Header { return nil }
+func (tr *testTransport) ReplyHeader() transport.Header   { return nil }
+
+type testHTTPTransport struct {
+	testTransport
+	request *nethttp.Request
+}
+
+func (tr *testHTTPTransport) Request() *nethttp.Request        { return tr.request }
+func (tr *testHTTPTransport) PathTemplate() string             { return "" }
+func (tr *testHTTPTransport) Response() nethttp.ResponseWriter { return nil }
+
+type testAuthorizer struct {
+	authz.Authorizer
+	actions []authz.Action
+}
+
+func (a *testAuthorizer) Enforce(_ context.Context, _ authz.Subject, _ authz.Object, act authz.Action, _ authz.Domain) (bool, error) {
+	a.actions = append(a.actions, act)
+	return true, nil
+}
+
+func TestOperationCRUD(t *testing.T) {
+	tests := map[string]authz.Action{
+		"/test.v1.UserService/ListUser":       ActionRead,
+		"/test.v1.UserService/GetUser":        ActionRead,
+		"/test.v1.UserService/CreateUser":     ActionCreate,
+		"/test.v1.UserService/UpdateUser":     ActionUpdate,
+		"/test.v1.PolicyService/RemovePolicy": ActionDelete,
+		"/test.v1.TrashService/RestoreTrash":  ActionUpdate,
+		"/test.v1.TrashService/PurgeTrash":    ActionDelete,
+		"/test.v1.DeptService/MoveDept":       ActionUpdate,
+		"/test.v1.UserService/AddressBook":    "",
+		"/test.v1.AuthService/Logout":         "",
+	}
+	for operation, want := range tests {
+		assert.Equal(t, want, OperationCRUD(operation), operation)
+	}
+}
+
+func methodOverrideRequest(method, override string) *nethttp.Request {
+	req := httptest.NewRequest(method, "/users", nil)
+	req.Header.Set("X-HTTP-Method", override)
+	return req
+}
+
+func TestAuthzMiddlewareAction(t *testing.T) {
+	claims := &authn.AuthClaims{"sub": "1", "dom": "1"}
+	tests := []struct {
+		name string
+		tr   transport.Transporter
+		opts []AuthzOption
+		want authz.Action
+	}{
+		{
+			name: "default",
+			tr:   &testHTTPTransport{testTransport{transport.KindHTTP, "/test.v1.UserService/CreateUser"}, httptest.NewRequest(nethttp.MethodPut, "/users", nil)},
+			want: nethttp.MethodPut,
+		},
+		{
+			name: "method override header ignored",
+			tr:   &testHTTPTransport{testTransport{transport.KindHTTP, "/test.v1.UserService/ListUser"}, methodOverrideRequest(nethttp.MethodGet, nethttp.MethodDelete)},
+			want: nethttp.MethodGet,
+		},
+		{
+			name: "grpc without http binding",
+			tr:   &testTransport{transport.KindGRPC, "/test.v1.UserService/ListUser"},
+			want: nethttp.MethodPost,
+		},
+		{
+			name: "operation",
+			tr:   &testHTTPTransport{testTransport{transport.KindHTTP, "/test.v1.UserService/ListUser"}, methodOverrideRequest(nethttp.MethodGet, nethttp.MethodDelete)},
+			opts: []AuthzOption{WithActionResolver(OperationAction)},
+			want: "ListUser",
+		},
+		{
+			name: "crud",
+			tr:   &testTransport{transport.KindGRPC, "/test.v1.UserService/ListUser"},
+			opts: []AuthzOption{WithActionResolver(CRUDAction)},
+			want: ActionRead,
+		},
+		{
+			name: "crud fallback to http method",
+			tr:   &testHTTPTransport{testTransport{transport.KindHTTP, "/test.v1.AuthService/Logout"}, httptest.NewRequest(nethttp.MethodDelete, "/logout", nil)},
+			opts: []AuthzOption{WithActionResolver(CRUDAction)},
+			want: ActionDelete,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorizer := &testAuthorizer{}
+			ctx := transport.NewServerContext(authn.ContextWithAuthClaims(context.Background(), claims), tt.tr)
+			_, err := AuthzMiddleware(authorizer, tt.opts...)(func(context.Context, interface{}) (interface{}, error) { return nil, nil })(ctx, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, []authz.Action{tt.want}, authorizer.actions)
+		})
+	}
+}
+
+func TestAuthzMiddlewareFailClosed(t *testing.T) {
+	tr := &testTransport{transport.KindGRPC, "/test.v1.UserService/ListUser"}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		opts []AuthzOption
+		want *errors.Error
+	}{
+		{
+			name: "missing claims",
+			ctx:  transport.NewServerContext(context.Background(), tr),
+			want: ErrInvalidToken,
+		},
+		{
+			name: "missing transport",
+			ctx:  authn.ContextWithAuthClaims(context.Background(), &authn.AuthClaims{"sub": "1"}),
+			want: ErrIncompleteAuthzInfo,
+		},
+		{
+			name: "missing subject",
+			ctx:  transport.NewServerContext(authn.ContextWithAuthClaims(context.Background(), &authn.AuthClaims{"dom": "1"}), tr),
+			want: ErrIncompleteAuthzInfo,
+		},
+		{
+			name: "missing action",
+			ctx:  transport.NewServerContext(authn.ContextWithAuthClaims(context.Background(), &authn.AuthClaims{"sub": "1"}), tr),
+			opts: []AuthzOption{WithActionResolver(func(context.Context, transport.Transporter) authz.Action { return "" })},
+			want: ErrIncompleteAuthzInfo,
+		},
+		{
+			name: "extractor",
+			ctx:  context.Background(),
+			opts: []AuthzOption{WithAuthzInfoExtractor(func(context.Context) (authz.Subject, authz.Object, authz.Action, authz.Domain, error) {
+				return "1", "", "read", "", nil
+			})},
+			want: ErrIncompleteAuthzInfo,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorizer := &testAuthorizer{}
+			_, err := AuthzMiddleware(authorizer, tt.opts...)(func(context.Context, interface{}) (interface{}, error) { return nil, nil })(tt.ctx, nil)
+			assert.Equal(t, tt.want, err)
+			assert.Empty(t, authorizer.actions)
+		})
+	}
+}
+
+func TestTransportAuthzMiddlewareRejectsOtherTransport(t *testing.T) {
+	claims := &authn.AuthClaims{"sub": "1", "dom": "1"}
+	httpTr := &testHTTPTransport{testTransport{transport.KindHTTP, "/test.v1.UserService/ListUser"}, httptest.NewRequest(nethttp.MethodGet, "/users", nil)}
+	grpcTr := &testTransport{transport.KindGRPC, "/test.v1.UserService/ListUser"}
+	tests := []struct {
+		name string
+		m    func(authz.Authorizer) middleware.Middleware
+		tr   transport.Transporter
+	}{
+		{
+			name: "http authz with grpc",
+			m:    func(a authz.Authorizer) middleware.Middleware { return HTTPAuthzMiddleware(a, nil) },
+			tr:   grpcTr,
+		},
+		{
+			name: "http combined with grpc",
+			m:    func(a authz.Authorizer) middleware.Middleware { return HTTPCombinedAuthMiddleware(nil, a, nil, nil) },
+			tr:   grpcTr,
+		},
+		{
+			name: "grpc authz with http",
+			m:    func(a authz.Authorizer) middleware.Middleware { return GRPCAuthzMiddleware(a, nil) },
+			tr:   httpTr,
+		},
+		{
+			name: "grpc combined with http",
+			m:    func(a authz.Authorizer) middleware.Middleware { return GRPCCombinedAuthMiddleware(nil, a, nil, nil) },
+			tr:   httpTr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorizer := &testAuthorizer{}
+			called := false
+			ctx := transport.NewServerContext(authn.ContextWithAuthClaims(context.Background(), claims), tt.tr)
+			_, err := tt.m(authorizer)(func(context.Context, interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			})(ctx, nil)
+			assert.Equal(t, ErrUnsupportedTransport, err)
+			assert.False(t, called)
+			assert.Empty(t, authorizer.actions)
+		})
+	}
+}
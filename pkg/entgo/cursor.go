@@ -0,0 +1,255 @@
+package entgo
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/iancoleman/strcase"
+)
+
+// CursorRequest 支持游标分页的分页请求，由 pagination.PagingRequest 实现
+type CursorRequest interface {
+	GetCursor() string
+	GetUseCursor() bool
+	GetWithCount() bool
+}
+
+// cursorToken 游标内容，编码为 base64 的 JSON，对客户端不透明
+type cursorToken struct {
+	// Order 排序条件，格式与 order_by 相同
+	Order string `json:"o"`
+	// Value 排序字段的值
+	Value string `json:"v"`
+	// ID 唯一键的值
+	ID string `json:"i"`
+	// Backward 是否向前翻页
+	Backward bool `json:"b,omitempty"`
+}
+
+// encode 编码游标
+func (t cursorToken) encode() string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor 解码游标
+func decodeCursor(cursor string) (*cursorToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidQuery)
+	}
+	t := new(cursorToken)
+	if err := json.Unmarshal(data, t); err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidQuery)
+	}
+	return t, nil
+}
+
+// Cursor 游标分页状态，按 (排序字段, 唯一键) 定位
+type Cursor struct {
+	// order 规范化后的排序条件
+	order string
+	// key 排序字段
+	key Field
+	// id 唯一键字段
+	id Field
+	// desc 是否降序
+	desc bool
+	// backward 是否向前翻页
+	backward bool
+	// resumed 请求是否携带游标
+	resumed bool
+	// size 每页行数
+	size int
+}
+
+// CursorPage 游标分页的前后页游标
+type CursorPage struct {
+	// Next 下一页游标，没有下一页时为空
+	Next string
+	// Prev 上一页游标，没有上一页时为空
+	Prev string
+}
+
+// cursor 解析游标分页请求，排序只支持一个不可为空的字段，唯一键自动作为补充排序
+// 请求未指定排序时使用游标中的排序，均未指定时使用默认排序的第一项
+func (s *Schema) cursor(req CursorRequest, orderBy []string, size int) (*Cursor, []func(*sql.Selector), error) {
+	idName := s.UniqueKey
+	if idName == "" {
+		idName = "id"
+	}
+	id, ok := s.lookup(idName)
+	if !s.Cursor || !ok || id.Column == "" {
+		return nil, nil, fmt.Errorf("%w: cursor pagination is not supported", ErrInvalidQuery)
+	}
+
+	var token *cursorToken
+	if req.GetCursor() != "" {
+		var err error
+		if token, err = decodeCursor(req.GetCursor()); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var terms []string
+	for _, term := range orderBy {
+		if term = strings.TrimSpace(term); term != "" {
+			terms = append(terms, term)
+		}
+	}
+	switch {
+	case len(terms) > 1:
+		return nil, nil, fmt.Errorf("%w: cursor pagination supports a single sort field", ErrInvalidQuery)
+	case len(terms) == 0 && token != nil:
+		terms = []string{token.Order}
+	case len(terms) == 0 && len(s.DefaultOrder) > 0:
+		terms = s.DefaultOrder[:1]
+	case len(terms) == 0:
+		terms = []string{idName}
+	}
+
+	desc := strings.HasPrefix(terms[0], "-")
+	name := strings.TrimPrefix(terms[0], "-")
+	key, ok := s.lookup(name)
+	if !ok || !key.Sortable || key.Column == "" {
+		return nil, nil, fmt.Errorf("%w: field %q is not sortable", ErrInvalidQuery, name)
+	}
+	if key.Nullable {
+		return nil, nil, fmt.Errorf("%w: nullable field %q cannot be used for cursor pagination", ErrInvalidQuery, name)
+	}
+	order := strcase.ToSnake(name)
+	if desc {
+		order = "-" + order
+	}
+	if token != nil && token.Order != order {
+		return nil, nil, fmt.Errorf("%w: cursor does not match order_by", ErrInvalidQuery)
+	}
+
+	c := &Cursor{order: order, key: key, id: id, desc: desc, resumed: token != nil, size: size}
+	if token == nil {
+		return c, nil, nil
+	}
+	c.backward = token.Backward
+
+	value, err := convert(key.Type, token.Value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: malformed cursor", ErrInvalidQuery)
+	}
+	idValue, err := convert(id.Type, token.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: malformed cursor", ErrInvalidQuery)
+	}
+	cmp := sql.GT
+	if c.desc != c.backward {
+		cmp = sql.LT
+	}
+	seek := func(sel *sql.Selector) {
+		if key.Column == id.Column {
+			sel.Where(cmp(sel.C(id.Column), idValue))
+			return
+		}
+		sel.Where(sql.Or(
+			cmp(sel.C(key.Column), value),
+			sql.And(sql.EQ(sel.C(key.Column), value), cmp(sel.C(id.Column), idValue)),
+		))
+	}
+	return c, []func(*sql.Selector){seek}, nil
+}
+
+// orders 游标分页的排序条件，向前翻页时反转排序方向
+func (c *Cursor) orders() []func(*sql.Selector) {
+	desc := c.desc != c.backward
+	columns := []string{c.key.Column}
+	if c.key.Column != c.id.Column {
+		columns = append(columns, c.id.Column)
+	}
+	orders := make([]func(*sql.Selector), 0, len(columns))
+	for _, column := range columns {
+		column := column
+		if desc {
+			orders = append(orders, func(sel *sql.Selector) { sel.OrderBy(sql.Desc(sel.C(column))) })
+		} else {
+			orders = append(orders, func(sel *sql.Selector) { sel.OrderBy(sql.Asc(sel.C(column))) })
+		}
+	}
+	return orders
+}
+
+// Paginate 截取游标分页的查询结果并生成前后页游标，非游标分页时原样返回 items
+// items 为按 PagingQuery 查询到的 ent 实体，排序字段与唯一键按实体字段的 json 标签读取
+func Paginate[T any](q *PagingQuery, items []T) ([]T, *CursorPage, error) {
+	c := q.Cursor
+	if c == nil {
+		return items, nil, nil
+	}
+	more := len(items) > c.size
+	if more {
+		items = items[:c.size]
+	}
+	if c.backward {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	page := new(CursorPage)
+	if len(items) == 0 {
+		return items, page, nil
+	}
+	var err error
+	if more || c.backward {
+		if page.Next, err = c.token(items[len(items)-1], false); err != nil {
+			return nil, nil, err
+		}
+	}
+	if (more && c.backward) || (!c.backward && c.resumed) {
+		if page.Prev, err = c.token(items[0], true); err != nil {
+			return nil, nil, err
+		}
+	}
+	return items, page, nil
+}
+
+// token 根据实体生成游标
+func (c *Cursor) token(item interface{}, backward bool) (string, error) {
+	value, err := entityValue(item, c.key.Column)
+	if err != nil {
+		return "", err
+	}
+	id, err := entityValue(item, c.id.Column)
+	if err != nil {
+		return "", err
+	}
+	return cursorToken{Order: c.order, Value: value, ID: id, Backward: backward}.encode(), nil
+}
+
+// entityValue 按 json 标签读取 ent 实体字段的值并格式化为字符串，空值无法用于定位
+func entityValue(item interface{}, column string) (string, error) {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	if v.Kind() != reflect.Struct {
+		return "", fmt.Errorf("entgo: unsupported cursor entity %T", item)
+	}
+	for i := 0; i < v.NumField(); i++ {
+		name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+		if name != column {
+			continue
+		}
+		f := v.Field(i)
+		for f.Kind() == reflect.Ptr {
+			if f.IsNil() {
+				return "", fmt.Errorf("%w: sort field %q is null", ErrInvalidQuery, column)
+			}
+			f = f.Elem()
+		}
+		if t, ok := f.Interface().(time.Time); ok {
+			return t.Format(time.RFC3339Nano), nil
+		}
+		return fmt.Sprint(f.Interface()), nil
+	}
+	return "", fmt.Errorf("entgo: field %q not found in %T", column, item)
+}
@@ -0,0 +1,134 @@
+package entgo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testItem 测试数据实体，json 标签与列名一致
+type testItem struct {
+	ID        int       `json:"id,omitempty"`
+	Status    *int32    `json:"status,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// queryPage 按游标分页请求查询一页测试数据
+func queryPage(t *testing.T, drv *sql.Driver, req *testRequest) ([]int, *CursorPage, error) {
+	pq, err := testSchema.Translate(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	sel := sql.Dialect(dialect.SQLite).Select("id", "status", "created_at").From(sql.Table("items"))
+	for _, p := range append(pq.Predicates, pq.Seek...) {
+		p(sel)
+	}
+	for _, o := range pq.Orders {
+		o(sel)
+	}
+	query, args := sel.Limit(pq.Limit).Query()
+
+	rows := &sql.Rows{}
+	require.NoError(t, drv.Query(context.Background(), query, args, rows))
+	defer rows.Close()
+	var items []*testItem
+	for rows.Next() {
+		item := new(testItem)
+		require.NoError(t, rows.Scan(&item.ID, &item.Status, &item.CreatedAt))
+		items = append(items, item)
+	}
+	require.NoError(t, rows.Err())
+
+	items, page, err := Paginate(pq, items)
+	if err != nil {
+		return nil, nil, err
+	}
+	ids := make([]int, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids, page, nil
+}
+
+func TestCursorPagination(t *testing.T) {
+	drv := newTestDB(t)
+	tests := []struct {
+		name    string
+		orderBy []string
+		query   string
+		pages   [][]int
+	}{
+		{name: "default order", pages: [][]int{{1, 2}, {3, 4}}},
+		{name: "time desc", orderBy: []string{"-createdAt"}, pages: [][]int{{4, 3}, {2, 1}}},
+		{name: "ties resolved by id", orderBy: []string{"status"}, pages: [][]int{{1, 3}, {2, 4}}},
+		{name: "with filter", orderBy: []string{"-status"}, query: `{"id__gt":1}`, pages: [][]int{{4, 2}, {3}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 向后翻页
+			req := &testRequest{orderBy: tt.orderBy, query: tt.query, size: 2, useCursor: true}
+			var pages []*CursorPage
+			for i, want := range tt.pages {
+				ids, page, err := queryPage(t, drv, req)
+				require.NoError(t, err)
+				assert.Equal(t, want, ids)
+				assert.Equal(t, i > 0, page.Prev != "")
+				assert.Equal(t, i < len(tt.pages)-1, page.Next != "")
+				pages = append(pages, page)
+				req = &testRequest{query: tt.query, size: 2, cursor: page.Next}
+			}
+
+			// 从最后一页向前翻页
+			ids, page, err := queryPage(t, drv, &testRequest{query: tt.query, size: 2, cursor: pages[len(pages)-1].Prev})
+			require.NoError(t, err)
+			assert.Equal(t, tt.pages[0], ids)
+			assert.Empty(t, page.Prev)
+			assert.NotEmpty(t, page.Next)
+		})
+	}
+}
+
+func TestCursorInvalid(t *testing.T) {
+	drv := newTestDB(t)
+	_, page, err := queryPage(t, drv, &testRequest{orderBy: []string{"-createdAt"}, size: 1, useCursor: true})
+	require.NoError(t, err)
+
+	for _, req := range []*testRequest{
+		{cursor: "not-a-cursor"},
+		{cursor: page.Next, orderBy: []string{"id"}},
+		{useCursor: true, orderBy: []string{"status", "id"}},
+		{useCursor: true, orderBy: []string{"score"}},
+		{cursor: cursorToken{Order: "-created_at", Value: "yesterday", ID: "1"}.encode()},
+		// 可为空的列不能作为游标分页的排序字段
+		{useCursor: true, orderBy: []string{"parentId"}},
+	} {
+		_, _, err := queryPage(t, drv, req)
+		assert.True(t, errors.Is(err, ErrInvalidQuery), req)
+	}
+
+	// 排序字段为空值的数据无法生成游标
+	_, _, err = Paginate(&PagingQuery{Cursor: &Cursor{key: Field{Column: "status"}, id: Field{Column: "id"}, size: 1}},
+		[]*testItem{{ID: 1}, {ID: 2}})
+	assert.True(t, errors.Is(err, ErrInvalidQuery))
+}
+
+func TestCursorColumns(t *testing.T) {
+	// 游标分页时查询的列始终包含排序字段与唯一键
+	pq, err := testSchema.Translate(&testRequest{orderBy: []string{"-createdAt"}, size: 2, useCursor: true})
+	require.NoError(t, err)
+	defaults := []string{"id", "name"}
+	assert.Equal(t, []string{"id", "name", "created_at"}, pq.Columns(defaults...))
+	assert.Equal(t, []string{"id", "name"}, defaults)
+
+	// 可为空的列仍可用于普通分页的排序
+	pq, err = testSchema.Translate(&testRequest{orderBy: []string{"parentId"}})
+	require.NoError(t, err)
+	assert.Nil(t, pq.Cursor)
+	assert.Equal(t, []string{"name"}, pq.Columns("name"))
+}
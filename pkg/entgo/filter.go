@@ -0,0 +1,202 @@
+package entgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"backend-service/pkg/utils/pagination"
+)
+
+// OperatorSeparator 过滤字段名与操作符之间的分隔符，如 name__contains
+const OperatorSeparator = "__"
+
+// Operator 过滤操作符
+type Operator string
+
+const (
+	// OpEQ 等于，未指定操作符时使用
+	OpEQ Operator = "eq"
+	// OpNEQ 不等于
+	OpNEQ Operator = "not"
+	// OpIn 在列表中
+	OpIn Operator = "in"
+	// OpNotIn 不在列表中
+	OpNotIn Operator = "not_in"
+	// OpGT 大于
+	OpGT Operator = "gt"
+	// OpGTE 大于等于
+	OpGTE Operator = "gte"
+	// OpLT 小于
+	OpLT Operator = "lt"
+	// OpLTE 小于等于
+	OpLTE Operator = "lte"
+	// OpContains 包含子串
+	OpContains Operator = "contains"
+	// OpContainsFold 包含子串，不区分大小写
+	OpContainsFold Operator = "icontains"
+	// OpHasPrefix 以子串开头
+	OpHasPrefix Operator = "startswith"
+	// OpHasSuffix 以子串结尾
+	OpHasSuffix Operator = "endswith"
+	// OpIsNull 是否为空，值为 true 时匹配空值，为 false 时匹配非空值
+	OpIsNull Operator = "isnull"
+)
+
+// timeLayouts 时间字段支持的格式，按 time.Local 解析
+var timeLayouts = []string{time.DateTime, time.DateOnly, time.RFC3339}
+
+// filter 解析单个过滤条件，键的格式为 字段名[__操作符]
+// 值按字段的 ent 类型校验与转换，生成的 SQL 均使用参数占位符
+func (s *Schema) filter(key string, value interface{}) (condition, error) {
+	name, op, _ := strings.Cut(key, OperatorSeparator)
+	if op == "" {
+		op = string(OpEQ)
+	}
+	f, ok := s.lookup(name)
+	if !ok || !f.Filterable || f.Column == "" {
+		return nil, fmt.Errorf("%w: field %q is not filterable", ErrInvalidQuery, name)
+	}
+	column, typ := f.Column, f.Type
+
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%w: %s: %s", ErrInvalidQuery, key, fmt.Sprintf(format, args...))
+	}
+
+	switch Operator(op) {
+	case OpEQ, OpNEQ:
+		if value == nil {
+			// 兼容 {"field": null} 的写法
+			if Operator(op) == OpEQ {
+				return func(sel *sql.Selector) *sql.Predicate { return sql.IsNull(sel.C(column)) }, nil
+			}
+			return func(sel *sql.Selector) *sql.Predicate { return sql.NotNull(sel.C(column)) }, nil
+		}
+		v, err := convert(typ, value)
+		if err != nil {
+			return nil, invalid("%v", err)
+		}
+		if Operator(op) == OpEQ {
+			return func(sel *sql.Selector) *sql.Predicate { return sql.EQ(sel.C(column), v) }, nil
+		}
+		return func(sel *sql.Selector) *sql.Predicate { return sql.NEQ(sel.C(column), v) }, nil
+
+	case OpIn, OpNotIn:
+		list, ok := value.([]interface{})
+		if !ok || len(list) == 0 {
+			return nil, invalid("a non-empty array is required")
+		}
+		if len(list) > pagination.MAX_PAGE_SIZE {
+			return nil, invalid("at most %d values are allowed", pagination.MAX_PAGE_SIZE)
+		}
+		vs := make([]interface{}, 0, len(list))
+		for _, item := range list {
+			v, err := convert(typ, item)
+			if err != nil {
+				return nil, invalid("%v", err)
+			}
+			vs = append(vs, v)
+		}
+		if Operator(op) == OpIn {
+			return func(sel *sql.Selector) *sql.Predicate { return sql.In(sel.C(column), vs...) }, nil
+		}
+		return func(sel *sql.Selector) *sql.Predicate { return sql.NotIn(sel.C(column), vs...) }, nil
+
+	case OpGT, OpGTE, OpLT, OpLTE:
+		if !typ.Numeric() && typ != field.TypeTime {
+			return nil, invalid("operator is not supported by %s field", typ)
+		}
+		v, err := convert(typ, value)
+		if err != nil {
+			return nil, invalid("%v", err)
+		}
+		cmp := map[Operator]func(string, interface{}) *sql.Predicate{
+			OpGT: sql.GT, OpGTE: sql.GTE, OpLT: sql.LT, OpLTE: sql.LTE,
+		}[Operator(op)]
+		return func(sel *sql.Selector) *sql.Predicate { return cmp(sel.C(column), v) }, nil
+
+	case OpContains, OpContainsFold, OpHasPrefix, OpHasSuffix:
+		if typ != field.TypeString && typ != field.TypeEnum {
+			return nil, invalid("operator is not supported by %s field", typ)
+		}
+		sub, ok := value.(string)
+		if !ok || sub == "" {
+			return nil, invalid("a non-empty string is required")
+		}
+		match := map[Operator]func(string, string) *sql.Predicate{
+			OpContains: sql.Contains, OpContainsFold: sql.ContainsFold, OpHasPrefix: sql.HasPrefix, OpHasSuffix: sql.HasSuffix,
+		}[Operator(op)]
+		return func(sel *sql.Selector) *sql.Predicate { return match(sel.C(column), sub) }, nil
+
+	case OpIsNull:
+		null, err := convert(field.TypeBool, value)
+		if err != nil {
+			return nil, invalid("%v", err)
+		}
+		if null.(bool) {
+			return func(sel *sql.Selector) *sql.Predicate { return sql.IsNull(sel.C(column)) }, nil
+		}
+		return func(sel *sql.Selector) *sql.Predicate { return sql.NotNull(sel.C(column)) }, nil
+	}
+	return nil, invalid("unknown operator %q", op)
+}
+
+// convert 按字段类型转换 JSON 值，字符串形式的数字与布尔值同样接受
+func convert(typ field.Type, value interface{}) (interface{}, error) {
+	var text string
+	switch v := value.(type) {
+	case string:
+		text = v
+	case json.Number:
+		text = v.String()
+	case bool:
+		if typ != field.TypeBool {
+			return nil, fmt.Errorf("boolean value is not allowed for %s field", typ)
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unsupported value %v", value)
+	}
+	if _, ok := value.(json.Number); ok && (typ == field.TypeString || typ == field.TypeEnum || typ == field.TypeTime) {
+		return nil, fmt.Errorf("number value is not allowed for %s field", typ)
+	}
+
+	switch typ {
+	case field.TypeString, field.TypeEnum:
+		return text, nil
+	case field.TypeBool:
+		return strconv.ParseBool(text)
+	case field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt, field.TypeInt64:
+		return strconv.ParseInt(text, 10, bitSize(typ))
+	case field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint, field.TypeUint64:
+		return strconv.ParseUint(text, 10, bitSize(typ))
+	case field.TypeFloat32, field.TypeFloat64:
+		return strconv.ParseFloat(text, bitSize(typ))
+	case field.TypeTime:
+		for _, layout := range timeLayouts {
+			if t, err := time.ParseInLocation(layout, text, time.Local); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("invalid time %q, expected format %q", text, time.DateTime)
+	}
+	return nil, fmt.Errorf("%s field is not filterable", typ)
+}
+
+// bitSize 数值类型的位数
+func bitSize(typ field.Type) int {
+	switch typ {
+	case field.TypeInt8, field.TypeUint8:
+		return 8
+	case field.TypeInt16, field.TypeUint16:
+		return 16
+	case field.TypeInt32, field.TypeUint32, field.TypeFloat32:
+		return 32
+	}
+	return 64
+}
@@ -0,0 +1,173 @@
+package entgo
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	_ "github.com/glebarez/go-sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// testRequest 测试用分页请求
+type testRequest struct {
+	query, or string
+	orderBy   []string
+	size      int32
+	cursor    string
+	useCursor bool
+}
+
+func (r *testRequest) GetPage() int32                       { return 0 }
+func (r *testRequest) GetPageSize() int32                   { return r.size }
+func (r *testRequest) GetQuery() string                     { return r.query }
+func (r *testRequest) GetOrQuery() string                   { return r.or }
+func (r *testRequest) GetOrderBy() []string                 { return r.orderBy }
+func (r *testRequest) GetNoPaging() bool                    { return true }
+func (r *testRequest) GetFieldMask() *fieldmaskpb.FieldMask { return nil }
+func (r *testRequest) GetCursor() string                    { return r.cursor }
+func (r *testRequest) GetUseCursor() bool                   { return r.useCursor }
+func (r *testRequest) GetWithCount() bool                   { return false }
+
+var testSchema = &Schema{
+	Fields: map[string]Field{
+		"id":         {Column: "id", Type: field.TypeUint32, Filterable: true, Sortable: true},
+		"name":       {Column: "name", Type: field.TypeString, Filterable: true, Sortable: true},
+		"score":      {Column: "score", Type: field.TypeFloat64, Filterable: true},
+		"status":     {Column: "status", Type: field.TypeInt32, Filterable: true, Sortable: true},
+		"active":     {Column: "active", Type: field.TypeBool, Filterable: true},
+		"parent_id":  {Column: "parent_id", Type: field.TypeUint32, Filterable: true, Sortable: true, Nullable: true},
+		"created_at": {Column: "created_at", Type: field.TypeTime, Filterable: true, Sortable: true},
+		"secret":     {Column: "secret", Type: field.TypeString},
+	},
+	DefaultOrder: []string{"id"},
+	Cursor:       true,
+}
+
+// newTestDB 创建内存 sqlite 数据库并写入测试数据
+func newTestDB(t *testing.T) *sql.Driver {
+	db, err := stdsql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	drv := sql.OpenDB(dialect.SQLite, db)
+	t.Cleanup(func() { _ = drv.Close() })
+
+	ctx := context.Background()
+	require.NoError(t, drv.Exec(ctx, `CREATE TABLE items (id INTEGER PRIMARY KEY, name TEXT, score REAL, status INTEGER,
+		active BOOLEAN, parent_id INTEGER NULL, created_at DATETIME, secret TEXT)`, []interface{}{}, nil))
+
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 8, 0, 0, 0, time.Local) }
+	rows := [][]interface{}{
+		{1, "Alice", 9.5, 1, true, nil, day(1), "a"},
+		{2, "bob", 7.0, 2, false, 1, day(2), "b"},
+		{3, "carol_50%", 8.0, 1, true, 1, day(3), "c"},
+		{4, "dave", 6.5, 3, false, 2, day(4), "d"},
+	}
+	for _, row := range rows {
+		query, args := sql.Dialect(dialect.SQLite).Insert("items").
+			Columns("id", "name", "score", "status", "active", "parent_id", "created_at", "secret").
+			Values(row...).Query()
+		require.NoError(t, drv.Exec(ctx, query, args, nil))
+	}
+	return drv
+}
+
+// queryIDs 按分页请求查询测试数据的 ID
+func queryIDs(t *testing.T, drv *sql.Driver, req PagingRequest) ([]int, error) {
+	pq, err := testSchema.Translate(req)
+	if err != nil {
+		return nil, err
+	}
+	sel := sql.Dialect(dialect.SQLite).Select("id").From(sql.Table("items"))
+	for _, p := range pq.Predicates {
+		p(sel)
+	}
+	for _, o := range pq.Orders {
+		o(sel)
+	}
+	query, args := sel.Query()
+
+	rows := &sql.Rows{}
+	require.NoError(t, drv.Query(context.Background(), query, args, rows))
+	defer rows.Close()
+	var ids []int
+	for rows.Next() {
+		var id int
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func TestFilterOperators(t *testing.T) {
+	drv := newTestDB(t)
+	tests := []struct {
+		name  string
+		query string
+		or    string
+		ids   []int
+	}{
+		{name: "eq", query: `{"name":"bob"}`, ids: []int{2}},
+		{name: "eq string number", query: `{"status":"1"}`, ids: []int{1, 3}},
+		{name: "eq null", query: `{"parent_id":null}`, ids: []int{1}},
+		{name: "eq bool", query: `{"active":true}`, ids: []int{1, 3}},
+		{name: "not", query: `{"status__not":1}`, ids: []int{2, 4}},
+		{name: "in", query: `{"status__in":[2,3]}`, ids: []int{2, 4}},
+		{name: "not in", query: `{"id__not_in":[1,2]}`, ids: []int{3, 4}},
+		{name: "gt", query: `{"score__gt":7}`, ids: []int{1, 3}},
+		{name: "gte", query: `{"score__gte":7}`, ids: []int{1, 2, 3}},
+		{name: "lt", query: `{"id__lt":2}`, ids: []int{1}},
+		{name: "lte", query: `{"id__lte":2}`, ids: []int{1, 2}},
+		{name: "time gte", query: `{"created_at__gte":"2024-01-03 00:00:00"}`, ids: []int{3, 4}},
+		{name: "time range", query: `{"createdAt__gt":"2024-01-01 08:00:00","createdAt__lt":"2024-01-04"}`, ids: []int{2, 3}},
+		{name: "contains", query: `{"name__contains":"o"}`, ids: []int{2, 3}},
+		{name: "contains escapes wildcards", query: `{"name__contains":"_50%"}`, ids: []int{3}},
+		{name: "icontains", query: `{"name__icontains":"ALI"}`, ids: []int{1}},
+		{name: "startswith", query: `{"name__startswith":"da"}`, ids: []int{4}},
+		{name: "endswith", query: `{"name__endswith":"ob"}`, ids: []int{2}},
+		{name: "isnull", query: `{"parent_id__isnull":true}`, ids: []int{1}},
+		{name: "not null", query: `{"parent_id__isnull":false}`, ids: []int{2, 3, 4}},
+		{name: "or", query: `{"active":true}`, or: `{"name":"Alice","status__in":[2,3]}`, ids: []int{1}},
+		{name: "injection", query: `{"name":"x' OR '1'='1"}`, ids: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := queryIDs(t, drv, &testRequest{query: tt.query, or: tt.or})
+			require.NoError(t, err)
+			assert.Equal(t, tt.ids, ids)
+		})
+	}
+}
+
+func TestFilterInvalid(t *testing.T) {
+	drv := newTestDB(t)
+	for _, query := range []string{
+		`{"secret":"a"}`,
+		`{"unknown":"a"}`,
+		`{"name__like":"a"}`,
+		`{"name":1}`,
+		`{"status":"on"}`,
+		`{"status":true}`,
+		`{"status":2147483648}`,
+		`{"id":-1}`,
+		`{"status__in":[]}`,
+		`{"status__in":1}`,
+		`{"status__contains":"1"}`,
+		`{"name__gt":"a"}`,
+		`{"created_at__gte":"2024/01/01"}`,
+		`{"parent_id__isnull":"maybe"}`,
+		`{"name":{"a":1}}`,
+	} {
+		_, err := queryIDs(t, drv, &testRequest{query: query})
+		assert.True(t, errors.Is(err, ErrInvalidQuery), query)
+	}
+
+	_, err := queryIDs(t, drv, &testRequest{orderBy: []string{"score"}})
+	assert.True(t, errors.Is(err, ErrInvalidQuery))
+}
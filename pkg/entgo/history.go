@@ -0,0 +1,81 @@
+package entgo
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SensitiveMask 敏感字段在变更历史中的替代值
+const SensitiveMask = "******"
+
+// FieldChange 字段变更，Old/New 为空表示字段值为空
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+// Snapshot 将 ent 生成的实体转换为以字段名为键的快照
+// 字段名取自 json 标签，json 标签为 "-" 的字段（Sensitive 字段）与关联边不包含在快照中；
+// 指针解引用，空指针为 nil，时间格式化为 time.DateTime
+func Snapshot(entity any) map[string]any {
+	v := reflect.Indirect(reflect.ValueOf(entity))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	t := v.Type()
+	snapshot := make(map[string]any, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() || f.Anonymous || f.Name == "Edges" {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		snapshot[name] = snapshotValue(v.Field(i))
+	}
+	return snapshot
+}
+
+// snapshotValue 解引用指针并格式化时间
+func snapshotValue(v reflect.Value) any {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.DateTime)
+	}
+	return v.Interface()
+}
+
+// Diff 比较两个快照，返回按字段名排序的变更，ignore 中的字段不参与比较
+// old 为空表示创建，new 为空表示删除
+func Diff(old, new map[string]any, ignore ...string) []FieldChange {
+	names := make(map[string]struct{}, len(old)+len(new))
+	for name := range old {
+		names[name] = struct{}{}
+	}
+	for name := range new {
+		names[name] = struct{}{}
+	}
+	for _, name := range ignore {
+		delete(names, name)
+	}
+	var changes []FieldChange
+	for name := range names {
+		o, n := old[name], new[name]
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: name, Old: o, New: n})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
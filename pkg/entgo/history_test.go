@@ -0,0 +1,45 @@
+package entgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testEntity 模拟 ent 生成的实体
+type testEntity struct {
+	config    `json:"-"`
+	ID        uint32    `json:"id,omitempty"`
+	Name      *string   `json:"name,omitempty"`
+	Password  *string   `json:"-"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	Edges     struct{}  `json:"edges"`
+}
+
+type config struct{}
+
+func TestSnapshot(t *testing.T) {
+	name, password := "alice", "secret"
+	at := time.Date(2024, 5, 1, 8, 30, 0, 0, time.Local)
+	snapshot := Snapshot(&testEntity{ID: 1, Name: &name, Password: &password, UpdatedAt: at})
+	assert.Equal(t, map[string]any{"id": uint32(1), "name": "alice", "updated_at": "2024-05-01 08:30:00"}, snapshot)
+
+	snapshot = Snapshot(&testEntity{ID: 2})
+	assert.Contains(t, snapshot, "name")
+	assert.Nil(t, snapshot["name"])
+	assert.Nil(t, Snapshot("not a struct"))
+}
+
+func TestDiff(t *testing.T) {
+	old := map[string]any{"id": uint32(1), "name": "alice", "remark": nil, "updated_at": "2024-05-01 08:30:00"}
+	new := map[string]any{"id": uint32(1), "name": "bob", "remark": "vip", "updated_at": "2024-05-02 08:30:00"}
+	assert.Equal(t, []FieldChange{
+		{Field: "name", Old: "alice", New: "bob"},
+		{Field: "remark", Old: nil, New: "vip"},
+	}, Diff(old, new, "updated_at"))
+
+	// 创建时旧值为空
+	assert.Equal(t, []FieldChange{{Field: "id", New: uint32(1)}, {Field: "name", New: "alice"}}, Diff(nil, map[string]any{"id": uint32(1), "name": "alice", "remark": nil}))
+	assert.Empty(t, Diff(old, old))
+}
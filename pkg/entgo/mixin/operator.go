@@ -0,0 +1,133 @@
+package mixin
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+
+	"backend-service/pkg/auth/authn"
+)
+
+// 操作人字段由钩子从认证用户中填充，不信任请求体中的操作人ID
+// 上下文中没有认证用户（系统任务、数据初始化）时不填充
+// DeletedBy 须放在软删除 Mixin 之前，使删除人在删除转换为更新前写入
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var _ ent.Mixin = (*CreatedBy)(nil)
+
+type CreatedBy struct{ mixin.Schema }
+
+func (CreatedBy) Fields() []ent.Field {
+	return []ent.Field{
+		// 创建人
+		field.Uint32("created_by").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("创建人ID"),
+	}
+}
+
+// Hooks 创建时填充创建人
+func (CreatedBy) Hooks() []ent.Hook {
+	return []ent.Hook{
+		operatorHook(ent.OpCreate, func(m ent.Mutation, id uint32) {
+			if mx, ok := m.(interface{ SetCreatedBy(uint32) }); ok {
+				mx.SetCreatedBy(id)
+			}
+		}),
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var _ ent.Mixin = (*UpdatedBy)(nil)
+
+type UpdatedBy struct{ mixin.Schema }
+
+func (UpdatedBy) Fields() []ent.Field {
+	return []ent.Field{
+		// 更新人
+		field.Uint32("updated_by").
+			Optional().
+			Nillable().
+			Comment("更新人ID"),
+	}
+}
+
+// Hooks 更新时填充更新人
+func (UpdatedBy) Hooks() []ent.Hook {
+	return []ent.Hook{
+		operatorHook(ent.OpUpdate|ent.OpUpdateOne, func(m ent.Mutation, id uint32) {
+			if mx, ok := m.(interface{ SetUpdatedBy(uint32) }); ok {
+				mx.SetUpdatedBy(id)
+			}
+		}),
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var _ ent.Mixin = (*DeletedBy)(nil)
+
+type DeletedBy struct{ mixin.Schema }
+
+func (DeletedBy) Fields() []ent.Field {
+	return []ent.Field{
+		// 删除人
+		field.Uint32("deleted_by").
+			Optional().
+			Nillable().
+			Comment("删除人ID"),
+	}
+}
+
+// Hooks 删除时填充删除人，硬删除时该字段被忽略
+func (DeletedBy) Hooks() []ent.Hook {
+	return []ent.Hook{
+		operatorHook(ent.OpDelete|ent.OpDeleteOne, func(m ent.Mutation, id uint32) {
+			if mx, ok := m.(interface{ SetDeletedBy(uint32) }); ok {
+				mx.SetDeletedBy(id)
+			}
+		}),
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var _ ent.Mixin = (*Operator)(nil)
+
+type Operator struct{ mixin.Schema }
+
+func (Operator) Fields() []ent.Field {
+	var fields []ent.Field
+	fields = append(fields, CreatedBy{}.Fields()...)
+	fields = append(fields, UpdatedBy{}.Fields()...)
+	fields = append(fields, DeletedBy{}.Fields()...)
+	return fields
+}
+
+func (Operator) Hooks() []ent.Hook {
+	var hooks []ent.Hook
+	hooks = append(hooks, CreatedBy{}.Hooks()...)
+	hooks = append(hooks, UpdatedBy{}.Hooks()...)
+	hooks = append(hooks, DeletedBy{}.Hooks()...)
+	return hooks
+}
+
+// operatorHook 在指定操作中以认证用户ID调用 set
+func operatorHook(op ent.Op, set func(ent.Mutation, uint32)) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Op().Is(op) {
+				if id := authn.GetAuthUserID(ctx); id > 0 {
+					set(m, id)
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
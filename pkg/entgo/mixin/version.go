@@ -0,0 +1,23 @@
+package mixin
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+var _ ent.Mixin = (*Version)(nil)
+
+// Version 乐观锁版本号，创建时为 1
+// 条件更新时以读取到的版本号作为更新条件并将版本号加 1，未命中说明数据已被修改
+type Version struct {
+	mixin.Schema
+}
+
+func (Version) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("version").
+			Comment("版本号，乐观锁").
+			Default(1),
+	}
+}
@@ -0,0 +1,294 @@
+package entgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"backend-service/pkg/utils/pagination"
+)
+
+// ErrInvalidQuery 分页请求中的过滤、排序或字段掩码不合法
+var ErrInvalidQuery = errors.New("invalid paging query")
+
+// PagingRequest 通用分页请求，由 pagination.PagingRequest 实现
+type PagingRequest interface {
+	GetPage() int32
+	GetPageSize() int32
+	GetQuery() string
+	GetOrQuery() string
+	GetOrderBy() []string
+	GetNoPaging() bool
+	GetFieldMask() *fieldmaskpb.FieldMask
+}
+
+// Field 实体对外开放查询的字段
+type Field struct {
+	// Column 数据库列名，为空表示非数据库字段，仅可出现在字段掩码中
+	Column string
+	// Type ent 字段类型，过滤值按该类型校验与转换
+	Type field.Type
+	// Select 字段掩码选中该字段时需要查询的列，为空时查询 Column
+	Select []string
+	// Filterable 是否允许过滤
+	Filterable bool
+	// Sortable 是否允许排序
+	Sortable bool
+	// Nullable 列是否可为空，可为空的列无法定位游标，不能作为游标分页的排序字段
+	Nullable bool
+}
+
+// Schema 实体的查询白名单
+type Schema struct {
+	// Fields 开放查询的字段，键为 proto 字段名（snake_case），请求中的 camelCase 字段名会先转换为 snake_case
+	Fields map[string]Field
+	// DefaultOrder 默认排序，格式与 order_by 相同，同时作为请求排序的补充排序以保证分页稳定
+	DefaultOrder []string
+	// Required 字段掩码生效时始终查询的列，如主键
+	Required []string
+	// Cursor 是否支持游标分页，支持时查询需应用 PagingQuery.Seek 并由 Paginate 处理结果
+	Cursor bool
+	// UniqueKey 游标分页的唯一键字段，为空时使用 id
+	UniqueKey string
+}
+
+// PagingQuery 由分页请求翻译得到的 ent 查询条件
+type PagingQuery struct {
+	// Predicates 过滤条件
+	Predicates []func(*sql.Selector)
+	// Seek 游标分页的定位条件，统计总数时不使用
+	Seek []func(*sql.Selector)
+	// Orders 排序条件
+	Orders []func(*sql.Selector)
+	// Fields 字段掩码选中的列，为空表示未指定字段掩码
+	Fields []string
+	// Offset 偏移量
+	Offset int
+	// Limit 行数，游标分页时多查询一行用于判断是否有更多数据
+	Limit int
+	// Count 是否需要统计总数
+	Count bool
+	// Cursor 游标分页状态，非游标分页时为空
+	Cursor *Cursor
+}
+
+// Translate 将分页请求翻译为 ent 查询条件
+// query 中的条件以 AND 连接，or_query 中的条件以 OR 连接后再与 query 以 AND 连接；
+// 条件键支持 字段名__操作符 的形式，见 Operator。不在白名单中的字段或不合法的值返回 ErrInvalidQuery
+func (s *Schema) Translate(req PagingRequest) (*PagingQuery, error) {
+	q := new(PagingQuery)
+
+	and, err := s.conditions(req.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range and {
+		p := p
+		q.Predicates = append(q.Predicates, func(sel *sql.Selector) { sel.Where(p(sel)) })
+	}
+	or, err := s.conditions(req.GetOrQuery())
+	if err != nil {
+		return nil, err
+	}
+	if len(or) > 0 {
+		q.Predicates = append(q.Predicates, func(sel *sql.Selector) {
+			ps := make([]*sql.Predicate, 0, len(or))
+			for _, p := range or {
+				ps = append(ps, p(sel))
+			}
+			sel.Where(sql.Or(ps...))
+		})
+	}
+
+	if q.Orders, err = s.orders(req.GetOrderBy()); err != nil {
+		return nil, err
+	}
+	if q.Fields, err = s.fields(req.GetFieldMask().GetPaths()); err != nil {
+		return nil, err
+	}
+
+	page, size := req.GetPage(), req.GetPageSize()
+	if page <= 0 {
+		page = pagination.PAGE
+	}
+	if size <= 0 {
+		size = pagination.PAGE_SIZE
+	}
+	if size > pagination.MAX_PAGE_SIZE {
+		size = pagination.MAX_PAGE_SIZE
+	}
+
+	if cr, ok := req.(CursorRequest); ok && (cr.GetCursor() != "" || cr.GetUseCursor()) {
+		if q.Cursor, q.Seek, err = s.cursor(cr, req.GetOrderBy(), int(size)); err != nil {
+			return nil, err
+		}
+		q.Orders = q.Cursor.orders()
+		q.Offset, q.Limit, q.Count = 0, int(size)+1, cr.GetWithCount()
+		return q, nil
+	}
+
+	q.Offset, q.Limit, q.Count = 0, pagination.MAX_PAGE_SIZE, true
+	if !req.GetNoPaging() {
+		q.Offset, q.Limit = pagination.GetPageOffset(page, size), int(size)
+	}
+	return q, nil
+}
+
+// Columns 返回需要查询的列，未指定字段掩码时返回 defaults；
+// 游标分页时始终包含排序字段与唯一键，Paginate 据此生成游标
+func (q *PagingQuery) Columns(defaults ...string) []string {
+	columns := q.Fields
+	if len(columns) == 0 {
+		columns = defaults
+	}
+	if q.Cursor != nil && len(columns) > 0 {
+		columns = appendColumns(slices.Clip(columns), q.Cursor.key.Column, q.Cursor.id.Column)
+	}
+	return columns
+}
+
+// Predicates 将过滤条件转换为实体的谓词类型，如 predicate.User
+func Predicates[P ~func(*sql.Selector)](q *PagingQuery) []P {
+	ps := make([]P, 0, len(q.Predicates))
+	for _, p := range q.Predicates {
+		ps = append(ps, p)
+	}
+	return ps
+}
+
+// Seek 将游标分页的定位条件转换为实体的谓词类型
+func Seek[P ~func(*sql.Selector)](q *PagingQuery) []P {
+	ps := make([]P, 0, len(q.Seek))
+	for _, p := range q.Seek {
+		ps = append(ps, p)
+	}
+	return ps
+}
+
+// Orders 将排序条件转换为实体的排序类型，如 user.OrderOption
+func Orders[O ~func(*sql.Selector)](q *PagingQuery) []O {
+	os := make([]O, 0, len(q.Orders))
+	for _, o := range q.Orders {
+		os = append(os, o)
+	}
+	return os
+}
+
+// lookup 按请求中的字段名查找白名单字段
+func (s *Schema) lookup(name string) (Field, bool) {
+	f, ok := s.Fields[strcase.ToSnake(strings.TrimSpace(name))]
+	return f, ok
+}
+
+// condition 基于查询选择器生成的条件
+type condition func(*sql.Selector) *sql.Predicate
+
+// conditions 解析 JSON 对象形式的过滤参数
+func (s *Schema) conditions(query string) ([]condition, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, nil
+	}
+	var filters map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader([]byte(query)))
+	dec.UseNumber()
+	if err := dec.Decode(&filters); err != nil {
+		return nil, fmt.Errorf("%w: malformed query %q", ErrInvalidQuery, query)
+	}
+
+	conds := make([]condition, 0, len(filters))
+	for key, value := range filters {
+		cond, err := s.filter(key, value)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+	}
+	return conds, nil
+}
+
+// orders 解析排序条件，字段名前加 - 为降序，默认排序作为补充排序
+func (s *Schema) orders(orderBy []string) ([]func(*sql.Selector), error) {
+	var orders []func(*sql.Selector)
+	seen := make(map[string]struct{})
+	add := func(term string, strict bool) error {
+		term = strings.TrimSpace(term)
+		desc := strings.HasPrefix(term, "-")
+		name := strings.TrimPrefix(term, "-")
+		f, ok := s.lookup(name)
+		if !ok || !f.Sortable || f.Column == "" {
+			if strict {
+				return fmt.Errorf("%w: field %q is not sortable", ErrInvalidQuery, name)
+			}
+			return nil
+		}
+		if _, ok := seen[f.Column]; ok {
+			return nil
+		}
+		seen[f.Column] = struct{}{}
+		column := f.Column
+		if desc {
+			orders = append(orders, func(sel *sql.Selector) { sel.OrderBy(sql.Desc(sel.C(column))) })
+		} else {
+			orders = append(orders, func(sel *sql.Selector) { sel.OrderBy(sql.Asc(sel.C(column))) })
+		}
+		return nil
+	}
+	for _, term := range orderBy {
+		if strings.TrimSpace(term) == "" {
+			continue
+		}
+		if err := add(term, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, term := range s.DefaultOrder {
+		_ = add(term, false)
+	}
+	return orders, nil
+}
+
+// fields 解析字段掩码，嵌套路径按第一级字段处理
+func (s *Schema) fields(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	columns := appendColumns(nil, s.Required...)
+	for _, path := range paths {
+		name, _, _ := strings.Cut(path, ".")
+		f, ok := s.lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q in field mask", ErrInvalidQuery, path)
+		}
+		selects := f.Select
+		if len(selects) == 0 && f.Column != "" {
+			selects = []string{f.Column}
+		}
+		columns = appendColumns(columns, selects...)
+	}
+	return columns, nil
+}
+
+// appendColumns 追加尚未包含的列
+func appendColumns(columns []string, add ...string) []string {
+	for _, c := range add {
+		found := false
+		for _, exist := range columns {
+			if exist == c {
+				found = true
+				break
+			}
+		}
+		if !found {
+			columns = append(columns, c)
+		}
+	}
+	return columns
+}
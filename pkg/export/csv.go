@@ -0,0 +1,53 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// utf8BOM UTF-8 字节顺序标记，Excel 据此识别 UTF-8 编码
+const utf8BOM = "\ufeff"
+
+// csvWriter CSV 文件写入器
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// newCSVWriter 创建 CSV 文件写入器并写入 BOM
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return nil, err
+	}
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+// Write 写入一行，可能被电子表格软件当作公式执行的单元格加 ' 前缀
+func (c *csvWriter) Write(record []string) error {
+	escaped := make([]string, len(record))
+	for i, cell := range record {
+		escaped[i] = escapeFormula(cell)
+	}
+	return c.w.Write(escaped)
+}
+
+// Close 刷新缓冲区
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// escapeFormula 防止 CSV 注入：以 = + - @ 制表符或回车开头的非数字单元格加 ' 前缀
+func escapeFormula(cell string) string {
+	if cell == "" {
+		return cell
+	}
+	switch cell[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		if _, err := strconv.ParseFloat(cell, 64); err == nil {
+			return cell
+		}
+		return "'" + cell
+	}
+	return cell
+}
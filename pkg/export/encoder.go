@@ -0,0 +1,133 @@
+package export
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrUnknownField 导出的字段不存在
+var ErrUnknownField = errors.New("export: unknown field")
+
+// EnumFormatter 格式化枚举值，如翻译为当前语言
+type EnumFormatter func(protoreflect.EnumValueDescriptor) string
+
+// Encoder 按导出列将 proto 消息编码为一行
+type Encoder struct {
+	fields []protoreflect.FieldDescriptor
+	enum   EnumFormatter
+}
+
+// EncoderOption 编码器选项
+type EncoderOption func(*Encoder)
+
+// WithEnumFormatter 设置枚举值的格式化方式，默认使用枚举值名称
+func WithEnumFormatter(fn EnumFormatter) EncoderOption {
+	return func(e *Encoder) {
+		e.enum = fn
+	}
+}
+
+// NewEncoder 创建编码器，columns 为导出列的字段名，可使用 proto 字段名或 JSON 名
+func NewEncoder(md protoreflect.MessageDescriptor, columns []string, opts ...EncoderOption) (*Encoder, error) {
+	e := &Encoder{fields: make([]protoreflect.FieldDescriptor, 0, len(columns))}
+	for _, column := range columns {
+		fd := md.Fields().ByName(protoreflect.Name(column))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(column)
+		}
+		if fd == nil {
+			return nil, fmt.Errorf("%w: %s.%s", ErrUnknownField, md.FullName(), column)
+		}
+		e.fields = append(e.fields, fd)
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e, nil
+}
+
+// Fields 导出列对应的字段
+func (e *Encoder) Fields() []protoreflect.FieldDescriptor {
+	return e.fields
+}
+
+// Encode 编码一行，未设置的可选字段为空字符串
+func (e *Encoder) Encode(m protoreflect.Message) []string {
+	record := make([]string, len(e.fields))
+	for i, fd := range e.fields {
+		if fd.HasPresence() && !m.Has(fd) {
+			continue
+		}
+		record[i] = e.field(fd, m.Get(fd))
+	}
+	return record
+}
+
+// field 格式化字段值，列表与映射的元素以逗号分隔
+func (e *Encoder) field(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		items := make([]string, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			items = append(items, e.value(fd, list.Get(i)))
+		}
+		return strings.Join(items, ",")
+	case fd.IsMap():
+		var items []string
+		v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+			items = append(items, k.String()+"="+e.value(fd.MapValue(), mv))
+			return true
+		})
+		return strings.Join(items, ",")
+	}
+	return e.value(fd, v)
+}
+
+// value 格式化单个值，时间戳按本地时区格式化，其它消息编码为 JSON
+func (e *Encoder) value(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool())
+	case protoreflect.EnumKind:
+		ev := fd.Enum().Values().ByNumber(v.Enum())
+		if ev == nil {
+			return strconv.Itoa(int(v.Enum()))
+		}
+		if e.enum != nil {
+			return e.enum(ev)
+		}
+		return string(ev.Name())
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	case protoreflect.FloatKind:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch msg := v.Message().Interface().(type) {
+		case *timestamppb.Timestamp:
+			return msg.AsTime().In(time.Local).Format(time.DateTime)
+		case *durationpb.Duration:
+			return msg.AsDuration().String()
+		default:
+			data, err := protojson.Marshal(msg)
+			if err != nil {
+				return ""
+			}
+			return string(data)
+		}
+	}
+	return v.String()
+}
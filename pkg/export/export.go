@@ -0,0 +1,68 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format 导出文件格式
+type Format string
+
+const (
+	// FormatCSV CSV 文件，UTF-8 编码并带 BOM，便于 Excel 识别中文
+	FormatCSV Format = "csv"
+	// FormatXLSX Excel 工作簿
+	FormatXLSX Format = "xlsx"
+)
+
+var (
+	// ErrUnsupportedFormat 不支持的文件格式
+	ErrUnsupportedFormat = errors.New("export: unsupported format")
+	// ErrTooManyRows 行数超过文件格式的上限
+	ErrTooManyRows = errors.New("export: too many rows")
+)
+
+// ParseFormat 解析文件格式，为空时使用 CSV
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(s))); f {
+	case "":
+		return FormatCSV, nil
+	case FormatCSV, FormatXLSX:
+		return f, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnsupportedFormat, s)
+}
+
+// ContentType 文件的 MIME 类型
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Extension 文件扩展名，不含 .
+func (f Format) Extension() string {
+	return string(f)
+}
+
+// Writer 逐行写入导出文件，第一行为表头；写入的数据在 Close 前可能仍在缓冲区中
+type Writer interface {
+	// Write 写入一行
+	Write(record []string) error
+	// Close 写入文件尾并刷新缓冲区，不关闭底层的 io.Writer
+	Close() error
+}
+
+// NewWriter 创建指定格式的导出文件写入器
+func NewWriter(w io.Writer, f Format) (Writer, error) {
+	switch f {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnsupportedFormat, f)
+}
@@ -0,0 +1,131 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("")
+	require.NoError(t, err)
+	assert.Equal(t, FormatCSV, f)
+
+	f, err = ParseFormat(" XLSX ")
+	require.NoError(t, err)
+	assert.Equal(t, FormatXLSX, f)
+
+	_, err = ParseFormat("pdf")
+	assert.True(t, errors.Is(err, ErrUnsupportedFormat))
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, FormatCSV)
+	require.NoError(t, err)
+	require.NoError(t, w.Write([]string{"名称", "备注"}))
+	require.NoError(t, w.Write([]string{"a,b", "=SUM(A1)"}))
+	require.NoError(t, w.Write([]string{"-1.5", "@cmd"}))
+	require.NoError(t, w.Close())
+
+	data := buf.String()
+	assert.True(t, strings.HasPrefix(data, utf8BOM))
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(data, utf8BOM))).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"名称", "备注"},
+		{"a,b", "'=SUM(A1)"},
+		{"-1.5", "'@cmd"},
+	}, records)
+}
+
+// xlsxSheet 测试用工作表结构
+type xlsxSheet struct {
+	Rows []struct {
+		R     string `xml:"r,attr"`
+		Cells []struct {
+			R    string `xml:"r,attr"`
+			S    string `xml:"s,attr"`
+			Text string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func TestXLSXWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, FormatXLSX)
+	require.NoError(t, err)
+	require.NoError(t, w.Write([]string{"ID", "名称"}))
+	require.NoError(t, w.Write([]string{"1", "<a & b>\n"}))
+	require.NoError(t, w.Write([]string{"2", ""}))
+	require.NoError(t, w.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	files := map[string]*zip.File{}
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	for _, part := range xlsxParts {
+		assert.Contains(t, files, part.name)
+	}
+	require.Contains(t, files, "xl/worksheets/sheet1.xml")
+
+	rc, err := files["xl/worksheets/sheet1.xml"].Open()
+	require.NoError(t, err)
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+
+	var sheet xlsxSheet
+	require.NoError(t, xml.Unmarshal(data, &sheet))
+	require.Len(t, sheet.Rows, 3)
+	assert.Equal(t, "1", sheet.Rows[0].R)
+	assert.Equal(t, "B1", sheet.Rows[0].Cells[1].R)
+	assert.Equal(t, "1", sheet.Rows[0].Cells[1].S)
+	assert.Equal(t, "名称", sheet.Rows[0].Cells[1].Text)
+	assert.Equal(t, "", sheet.Rows[1].Cells[1].S)
+	assert.Equal(t, "<a & b>\n", sheet.Rows[1].Cells[1].Text)
+	assert.Len(t, sheet.Rows[2].Cells, 1)
+}
+
+func TestColumnName(t *testing.T) {
+	for i, name := range map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"} {
+		assert.Equal(t, name, columnName(i), i)
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	md := (&descriptorpb.FieldDescriptorProto{}).ProtoReflect().Descriptor()
+	_, err := NewEncoder(md, []string{"name", "unknown"})
+	assert.True(t, errors.Is(err, ErrUnknownField))
+
+	e, err := NewEncoder(md, []string{"name", "number", "type", "jsonName", "default_value", "options"},
+		WithEnumFormatter(func(v protoreflect.EnumValueDescriptor) string {
+			return strings.ToLower(strings.TrimPrefix(string(v.Name()), "TYPE_"))
+		}))
+	require.NoError(t, err)
+	assert.Len(t, e.Fields(), 6)
+
+	m := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String("id"),
+		Number:   proto.Int32(1),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_UINT32.Enum(),
+		JsonName: proto.String("id"),
+		Options:  &descriptorpb.FieldOptions{Deprecated: proto.Bool(true)},
+	}
+	record := e.Encode(m.ProtoReflect())
+	assert.Equal(t, []string{"id", "1", "uint32", "id", ""}, record[:5])
+	assert.JSONEq(t, `{"deprecated":true}`, record[5])
+}
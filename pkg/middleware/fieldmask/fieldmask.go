@@ -0,0 +1,382 @@
+package fieldmask
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ItemsField 列表响应中承载数据项的字段名，客户端字段掩码作用于其中的每一项
+const ItemsField protoreflect.Name = "items"
+
+// Action 字段可见性处理方式
+type Action string
+
+const (
+	// ActionHide 清空字段
+	ActionHide Action = "hide"
+	// ActionMask 脱敏字符串字段，其它类型的字段清空
+	ActionMask Action = "mask"
+)
+
+// Rule 字段可见性规则，调用者不具备 Roles 中任一角色时按 Action 处理字段
+type Rule struct {
+	// Message 消息全名，如 core.service.v1.User
+	Message protoreflect.FullName
+	// Fields 字段名（proto 名称）
+	Fields []protoreflect.Name
+	// Action 处理方式
+	Action Action
+	// Masker 脱敏方式，为空时使用 MaskerDefault
+	Masker string
+	// Roles 可查看原值的角色，为空时对所有调用者生效
+	Roles []string
+}
+
+// RoleResolver 获取调用者的角色
+type RoleResolver func(ctx context.Context) ([]string, error)
+
+type fieldMasker interface {
+	GetFieldMask() *fieldmaskpb.FieldMask
+}
+
+type options struct {
+	rules   map[protoreflect.FullName][]Rule
+	maskers map[string]Masker
+	roles   RoleResolver
+}
+
+// Option 响应整形选项
+type Option func(*options)
+
+// WithRules 设置字段可见性规则
+func WithRules(rules ...Rule) Option {
+	return func(o *options) {
+		for _, r := range rules {
+			o.rules[r.Message] = append(o.rules[r.Message], r)
+		}
+	}
+}
+
+// WithMasker 注册脱敏方式，同名时覆盖内置的脱敏方式
+func WithMasker(name string, masker Masker) Option {
+	return func(o *options) {
+		o.maskers[name] = masker
+	}
+}
+
+// WithRoleResolver 设置调用者角色来源，未设置或获取失败时视为不具备任何角色
+func WithRoleResolver(resolver RoleResolver) Option {
+	return func(o *options) {
+		o.roles = resolver
+	}
+}
+
+// Validate 检查规则引用的消息与字段已注册到全局 proto 注册表
+func Validate(rules []Rule) error {
+	for _, r := range rules {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(r.Message)
+		if err != nil {
+			return fmt.Errorf("fieldmask: message %s not found: %w", r.Message, err)
+		}
+		md, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return fmt.Errorf("fieldmask: %s is not a message", r.Message)
+		}
+		for _, name := range r.Fields {
+			if md.Fields().ByName(name) == nil {
+				return fmt.Errorf("fieldmask: field %s not found in %s", name, r.Message)
+			}
+		}
+		switch r.Action {
+		case ActionHide, ActionMask:
+		default:
+			return fmt.Errorf("fieldmask: unsupported action %q for %s", r.Action, r.Message)
+		}
+	}
+	return nil
+}
+
+// Server 响应整形中间件，在序列化前处理响应，HTTP 与 gRPC 通用
+// 请求携带字段掩码时只保留选中的字段，列表响应的掩码作用于 items 中的每一项；
+// 随后按调用者角色对命中规则的字段隐藏或脱敏。响应会被复制后再修改，不影响业务层缓存的对象
+func Server(opts ...Option) middleware.Middleware {
+	o := newOptions(opts...)
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			msg, ok := reply.(proto.Message)
+			if !ok || msg == nil {
+				return reply, nil
+			}
+
+			var paths []string
+			if fm, ok := req.(fieldMasker); ok {
+				paths = fm.GetFieldMask().GetPaths()
+			}
+			if len(paths) == 0 && len(o.rules) == 0 {
+				return reply, nil
+			}
+
+			msg = proto.Clone(msg)
+			if len(paths) > 0 {
+				pruneReply(msg.ProtoReflect(), paths)
+			}
+			if len(o.rules) > 0 {
+				o.apply(ctx, msg.ProtoReflect())
+			}
+			return msg, nil
+		}
+	}
+}
+
+// ValueShaper 按消息的字段可见性规则处理以 proto 字段名表示的单个字段值，返回 false 表示隐藏该字段
+type ValueShaper func(field string, value *structpb.Value) (*structpb.Value, bool)
+
+// Values 创建字段值整形函数，用于无法以消息表示的数据，如变更历史的快照与字段变更，规则与 Server 相同
+// 返回的函数按消息全名获取值整形函数：命中脱敏规则的字符串值替换为脱敏值，其余命中规则的非空值隐藏
+func Values(opts ...Option) func(ctx context.Context, message protoreflect.FullName) ValueShaper {
+	o := newOptions(opts...)
+	return func(ctx context.Context, message protoreflect.FullName) ValueShaper {
+		var hidden map[string]Rule
+		var resolved bool
+		return func(field string, value *structpb.Value) (*structpb.Value, bool) {
+			if len(o.rules[message]) == 0 || value == nil {
+				return value, true
+			}
+			// 空值不含需要保护的数据
+			if _, null := value.GetKind().(*structpb.Value_NullValue); null {
+				return value, true
+			}
+			// 仅在命中规则时获取调用者角色，同一消息只获取一次
+			if !resolved {
+				resolved = true
+				hidden = o.hidden(ctx, message)
+			}
+			r, ok := hidden[field]
+			if !ok {
+				return value, true
+			}
+			if s, isString := value.GetKind().(*structpb.Value_StringValue); isString && r.Action == ActionMask {
+				return structpb.NewStringValue(o.masker(r.Masker)(s.StringValue)), true
+			}
+			return nil, false
+		}
+	}
+}
+
+// hidden 调用者不可见的字段及其命中的规则
+func (o *options) hidden(ctx context.Context, message protoreflect.FullName) map[string]Rule {
+	hidden := make(map[string]Rule)
+	var roles map[string]struct{}
+	for _, r := range o.rules[message] {
+		if roles == nil {
+			roles = o.resolveRoles(ctx)
+		}
+		if visible(r, roles) {
+			continue
+		}
+		for _, name := range r.Fields {
+			hidden[string(name)] = r
+		}
+	}
+	return hidden
+}
+
+// newOptions 创建响应整形选项
+func newOptions(opts ...Option) *options {
+	o := &options{
+		rules:   make(map[protoreflect.FullName][]Rule),
+		maskers: defaultMaskers(),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// pruneReply 按字段掩码裁剪响应，响应含 items 列表时裁剪其中每一项
+func pruneReply(m protoreflect.Message, paths []string) {
+	if fd := m.Descriptor().Fields().ByName(ItemsField); fd != nil && fd.IsList() && fd.Message() != nil {
+		list := m.Get(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			forEachMessage(list.Get(i).Message(), func(item protoreflect.Message) {
+				Prune(item, paths)
+			})
+		}
+		return
+	}
+	Prune(m, paths)
+}
+
+// Prune 按字段掩码保留消息字段，路径可使用 proto 字段名或 JSON 名，嵌套字段以 . 分隔，未知路径忽略
+func Prune(m protoreflect.Message, paths []string) {
+	prune(m, newPathTree(paths))
+}
+
+// pathTree 字段掩码路径树，子树为空表示保留整个字段
+type pathTree map[string]pathTree
+
+// newPathTree 构造字段掩码路径树
+func newPathTree(paths []string) pathTree {
+	tree := pathTree{}
+	for _, path := range paths {
+		node := tree
+		for _, name := range splitPath(path) {
+			child, ok := node[name]
+			if !ok {
+				child = pathTree{}
+				node[name] = child
+			} else if len(child) == 0 {
+				// 已保留整个字段
+				break
+			}
+			node = child
+		}
+	}
+	return tree
+}
+
+// lookup 按 proto 名称或 JSON 名查找字段对应的子树
+func (t pathTree) lookup(fd protoreflect.FieldDescriptor) (pathTree, bool) {
+	if sub, ok := t[string(fd.Name())]; ok {
+		return sub, true
+	}
+	sub, ok := t[fd.JSONName()]
+	return sub, ok
+}
+
+// prune 裁剪未选中的字段
+func prune(m protoreflect.Message, tree pathTree) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sub, ok := tree.lookup(fd)
+		switch {
+		case !ok:
+			m.Clear(fd)
+		case len(sub) > 0 && fd.Message() != nil:
+			forEachValue(fd, v, func(child protoreflect.Message) {
+				forEachMessage(child, func(msg protoreflect.Message) { prune(msg, sub) })
+			})
+		}
+		return true
+	})
+}
+
+// apply 对消息及其嵌套消息应用字段可见性规则，仅在命中规则时获取调用者角色
+func (o *options) apply(ctx context.Context, m protoreflect.Message) {
+	var roles map[string]struct{}
+	resolve := func() map[string]struct{} {
+		if roles == nil {
+			roles = o.resolveRoles(ctx)
+		}
+		return roles
+	}
+	forEachMessage(m, func(msg protoreflect.Message) { o.walk(msg, resolve) })
+}
+
+// resolveRoles 获取调用者的角色，获取失败时视为不具备任何角色，按最严格的规则处理
+func (o *options) resolveRoles(ctx context.Context) map[string]struct{} {
+	roles := map[string]struct{}{}
+	if o.roles != nil {
+		names, _ := o.roles(ctx)
+		for _, name := range names {
+			roles[name] = struct{}{}
+		}
+	}
+	return roles
+}
+
+// walk 递归处理消息
+func (o *options) walk(m protoreflect.Message, roles func() map[string]struct{}) {
+	for _, r := range o.rules[m.Descriptor().FullName()] {
+		if visible(r, roles()) {
+			continue
+		}
+		for _, name := range r.Fields {
+			fd := m.Descriptor().Fields().ByName(name)
+			if fd == nil || !m.Has(fd) {
+				continue
+			}
+			if r.Action == ActionMask && fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+				m.Set(fd, protoreflect.ValueOfString(o.masker(r.Masker)(m.Get(fd).String())))
+				continue
+			}
+			m.Clear(fd)
+		}
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() != nil || (fd.IsMap() && fd.MapValue().Message() != nil) {
+			forEachValue(fd, v, func(child protoreflect.Message) {
+				forEachMessage(child, func(msg protoreflect.Message) { o.walk(msg, roles) })
+			})
+		}
+		return true
+	})
+}
+
+// visible 判断调用者是否具备查看原值的角色
+func visible(r Rule, roles map[string]struct{}) bool {
+	for _, role := range r.Roles {
+		if _, ok := roles[role]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// masker 获取脱敏方式，未注册时使用默认脱敏方式
+func (o *options) masker(name string) Masker {
+	if m, ok := o.maskers[name]; ok {
+		return m
+	}
+	return o.maskers[MaskerDefault]
+}
+
+// forEachValue 遍历字段中的消息值，包括单值、列表与映射
+func forEachValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, fn func(protoreflect.Message)) {
+	switch {
+	case fd.IsList():
+		if fd.Message() == nil {
+			return
+		}
+		list := v.List()
+		for i := 0; i < list.Len(); i++ {
+			fn(list.Get(i).Message())
+		}
+	case fd.IsMap():
+		if fd.MapValue().Message() == nil {
+			return
+		}
+		v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+			fn(mv.Message())
+			return true
+		})
+	default:
+		fn(v.Message())
+	}
+}
+
+// forEachMessage 处理消息，google.protobuf.Any 先解包处理后重新打包，未注册的类型跳过
+func forEachMessage(m protoreflect.Message, fn func(protoreflect.Message)) {
+	a, ok := m.Interface().(*anypb.Any)
+	if !ok {
+		fn(m)
+		return
+	}
+	inner, err := a.UnmarshalNew()
+	if err != nil {
+		return
+	}
+	fn(inner.ProtoReflect())
+	_ = a.MarshalFrom(inner)
+}
@@ -0,0 +1,162 @@
+package fieldmask
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// maskedRequest 携带字段掩码的请求
+type maskedRequest struct {
+	mask *fieldmaskpb.FieldMask
+}
+
+func (r *maskedRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	return r.mask
+}
+
+func newMessage() *descriptorpb.DescriptorProto {
+	return &descriptorpb.DescriptorProto{
+		Name: proto.String("User"),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{Name: proto.String("phone"), JsonName: proto.String("13812345678"), Number: proto.Int32(8)},
+		},
+		ReservedName: []string{"email"},
+	}
+}
+
+func TestMaskers(t *testing.T) {
+	assert.Equal(t, "138****5678", MaskPhone("13812345678"))
+	assert.Equal(t, "a***@example.com", MaskEmail("alice@example.com"))
+	assert.Equal(t, "张*三", MaskDefault("张小三"))
+	assert.Equal(t, "**", MaskDefault("ab"))
+	assert.Equal(t, "1***5", MaskPhone("12345"))
+}
+
+func TestPrune(t *testing.T) {
+	msg := newMessage()
+	Prune(msg.ProtoReflect(), []string{"name", "field.jsonName", "unknown"})
+
+	assert.Equal(t, "User", msg.GetName())
+	assert.Empty(t, msg.GetReservedName())
+	require.Len(t, msg.GetField(), 1)
+	assert.Equal(t, "13812345678", msg.GetField()[0].GetJsonName())
+	assert.Empty(t, msg.GetField()[0].GetName())
+	assert.Zero(t, msg.GetField()[0].GetNumber())
+}
+
+func TestServer(t *testing.T) {
+	rules := []Rule{{
+		Message: "google.protobuf.FieldDescriptorProto",
+		Fields:  []protoreflect.Name{"json_name", "number"},
+		Action:  ActionMask,
+		Masker:  MaskerPhone,
+		Roles:   []string{"admin"},
+	}}
+	require.NoError(t, Validate(rules))
+
+	original := newMessage()
+	packed, err := anypb.New(original)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		roles  []string
+		err    error
+		reply  proto.Message
+		json   string
+		number int32
+	}{
+		{name: "admin", roles: []string{"admin"}, reply: original, json: "13812345678", number: 8},
+		{name: "guest", roles: []string{"guest"}, reply: original, json: "138****5678"},
+		{name: "resolver error", err: errors.New("unavailable"), reply: original, json: "138****5678"},
+		{name: "any", reply: packed, json: "138****5678"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mw := Server(WithRules(rules...), WithRoleResolver(func(context.Context) ([]string, error) {
+				return tt.roles, tt.err
+			}))
+			reply, err := mw(func(context.Context, interface{}) (interface{}, error) {
+				return tt.reply, nil
+			})(context.Background(), &maskedRequest{})
+			require.NoError(t, err)
+
+			msg, ok := reply.(*descriptorpb.DescriptorProto)
+			if !ok {
+				msg = new(descriptorpb.DescriptorProto)
+				require.NoError(t, reply.(*anypb.Any).UnmarshalTo(msg))
+			}
+			assert.Equal(t, tt.json, msg.GetField()[0].GetJsonName())
+			assert.Equal(t, tt.number, msg.GetField()[0].GetNumber())
+			assert.Equal(t, "phone", msg.GetField()[0].GetName())
+		})
+	}
+
+	// 原响应不被修改
+	assert.Equal(t, "13812345678", original.GetField()[0].GetJsonName())
+}
+
+func TestValues(t *testing.T) {
+	const message = "google.protobuf.FieldDescriptorProto"
+	rules := []Rule{
+		{Message: message, Fields: []protoreflect.Name{"json_name", "number"}, Action: ActionMask, Masker: MaskerPhone, Roles: []string{"admin"}},
+		{Message: message, Fields: []protoreflect.Name{"type_name"}, Action: ActionHide, Roles: []string{"admin"}},
+	}
+	calls := 0
+	values := Values(WithRules(rules...), WithRoleResolver(func(context.Context) ([]string, error) {
+		calls++
+		return []string{"guest"}, nil
+	}))
+
+	shape := values(context.Background(), message)
+	v, ok := shape("json_name", structpb.NewStringValue("13812345678"))
+	assert.True(t, ok)
+	assert.Equal(t, "138****5678", v.GetStringValue())
+	// 非字符串的脱敏字段与隐藏字段不可见，空值与未命中规则的字段不变
+	_, ok = shape("number", structpb.NewNumberValue(8))
+	assert.False(t, ok)
+	_, ok = shape("type_name", structpb.NewStringValue(".pkg.Type"))
+	assert.False(t, ok)
+	v, ok = shape("type_name", structpb.NewNullValue())
+	assert.True(t, ok)
+	assert.NotNil(t, v.GetKind())
+	v, ok = shape("name", structpb.NewStringValue("phone"))
+	assert.True(t, ok)
+	assert.Equal(t, "phone", v.GetStringValue())
+	assert.Equal(t, 1, calls)
+
+	// 未配置规则的消息不获取角色
+	v, ok = values(context.Background(), "google.protobuf.DescriptorProto")("name", structpb.NewStringValue("x"))
+	assert.True(t, ok)
+	assert.Equal(t, "x", v.GetStringValue())
+	assert.Equal(t, 1, calls)
+}
+
+func TestServerFieldMask(t *testing.T) {
+	mw := Server()
+	reply, err := mw(func(context.Context, interface{}) (interface{}, error) {
+		return newMessage(), nil
+	})(context.Background(), &maskedRequest{mask: &fieldmaskpb.FieldMask{Paths: []string{"reserved_name"}}})
+	require.NoError(t, err)
+
+	msg := reply.(*descriptorpb.DescriptorProto)
+	assert.Empty(t, msg.GetName())
+	assert.Empty(t, msg.GetField())
+	assert.Equal(t, []string{"email"}, msg.GetReservedName())
+}
+
+func TestValidate(t *testing.T) {
+	assert.Error(t, Validate([]Rule{{Message: "unknown.Message", Action: ActionHide}}))
+	assert.Error(t, Validate([]Rule{{Message: "google.protobuf.FieldDescriptorProto", Fields: []protoreflect.Name{"unknown"}, Action: ActionHide}}))
+	assert.Error(t, Validate([]Rule{{Message: "google.protobuf.FieldDescriptorProto", Action: "drop"}}))
+}
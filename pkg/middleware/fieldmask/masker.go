@@ -0,0 +1,67 @@
+package fieldmask
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaskerDefault 默认脱敏方式，保留首尾各一个字符
+	MaskerDefault = "default"
+	// MaskerPhone 手机号脱敏，保留前三位与后四位
+	MaskerPhone = "phone"
+	// MaskerEmail 邮箱脱敏，保留用户名首字符与域名
+	MaskerEmail = "email"
+)
+
+// maskChar 脱敏替换字符
+const maskChar = "*"
+
+// Masker 字符串脱敏函数
+type Masker func(string) string
+
+// defaultMaskers 内置脱敏方式
+func defaultMaskers() map[string]Masker {
+	return map[string]Masker{
+		MaskerDefault: MaskDefault,
+		MaskerPhone:   MaskPhone,
+		MaskerEmail:   MaskEmail,
+	}
+}
+
+// MaskDefault 保留首尾各一个字符，其余替换为 *，不超过两个字符时全部替换
+func MaskDefault(s string) string {
+	return maskMiddle(s, 1, 1)
+}
+
+// MaskPhone 保留前三位与后四位，如 138****5678，长度不足时按默认方式脱敏
+func MaskPhone(s string) string {
+	if utf8.RuneCountInString(s) < 8 {
+		return MaskDefault(s)
+	}
+	return maskMiddle(s, 3, 4)
+}
+
+// MaskEmail 保留用户名首字符与域名，如 a***@example.com，不是邮箱时按默认方式脱敏
+func MaskEmail(s string) string {
+	at := strings.LastIndex(s, "@")
+	if at <= 0 {
+		return MaskDefault(s)
+	}
+	first, _ := utf8.DecodeRuneInString(s)
+	return string(first) + strings.Repeat(maskChar, 3) + s[at:]
+}
+
+// maskMiddle 保留前 head 个与后 tail 个字符，其余替换为 *
+func maskMiddle(s string, head, tail int) string {
+	runes := []rune(s)
+	if len(runes) <= head+tail {
+		return strings.Repeat(maskChar, len(runes))
+	}
+	return string(runes[:head]) + strings.Repeat(maskChar, len(runes)-head-tail) + string(runes[len(runes)-tail:])
+}
+
+// splitPath 拆分字段掩码路径
+func splitPath(path string) []string {
+	return strings.Split(strings.TrimSpace(path), ".")
+}
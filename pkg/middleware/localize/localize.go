@@ -2,8 +2,8 @@ package localize
 
 import (
 	"context"
+	"io/fs"
 
-	"github.com/BurntSushi/toml"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
@@ -11,65 +11,108 @@ import (
 	"golang.org/x/text/language"
 )
 
-type validator interface {
-	Validate() error
-}
+const (
+	// LanguageQueryKey 查询参数中的语言键，如 ?lang=en
+	LanguageQueryKey = "lang"
+	// LanguageCookieKey Cookie 中保存的用户语言偏好键
+	LanguageCookieKey = "lang"
+)
 
 type localizerKey struct{}
 
+type messageFS struct {
+	fsys     fs.FS
+	patterns []string
+}
+
 type options struct {
 	defaultLanguage language.Tag
 	messagePath     string
+	messageFS       []messageFS
+	preference      func(ctx context.Context) string
 }
 
 type Option func(o *options)
 
+// WithMessagePath 从磁盘加载额外的消息文件，文件不存在时忽略
 func WithMessagePath(path string) Option {
 	return func(o *options) {
 		o.messagePath = path
 	}
 }
 
+// WithMessageFS 加载嵌入的消息文件，文件名需符合 xxx.<语言>.toml，后加载的同名消息覆盖先加载的
+func WithMessageFS(fsys fs.FS, patterns ...string) Option {
+	return func(o *options) {
+		o.messageFS = append(o.messageFS, messageFS{fsys: fsys, patterns: patterns})
+	}
+}
+
+// WithDefaultLanguage 设置默认语言，请求未指定或不支持所请求的语言时使用
 func WithDefaultLanguage(language language.Tag) Option {
 	return func(o *options) {
 		o.defaultLanguage = language
 	}
 }
 
-func I18N(opts ...Option) middleware.Middleware {
-	o := &options{
-		defaultLanguage: language.English,
-		messagePath:     "../../i18n/active.es.toml",
+// WithPreference 设置用户语言偏好来源，默认读取 Cookie 中的 lang
+func WithPreference(fn func(ctx context.Context) string) Option {
+	return func(o *options) {
+		o.preference = fn
 	}
-	for _, opt := range opts {
-		opt(o)
+}
+
+// cookiePreference 从 Cookie 读取用户语言偏好
+func cookiePreference(ctx context.Context) string {
+	if ht, ok := httpTransporter(ctx); ok {
+		if cookie, err := ht.Request().Cookie(LanguageCookieKey); err == nil {
+			return cookie.Value
+		}
+	}
+	return ""
+}
+
+// httpTransporter 获取 HTTP 传输层
+func httpTransporter(ctx context.Context) (http.Transporter, bool) {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if ht, ok := tr.(http.Transporter); ok && ht.Request() != nil {
+			return ht, true
+		}
 	}
-	bundle := i18n.NewBundle(o.defaultLanguage)
-	bundle.RegisterUnmarshalFunc("toml", toml.Unmarshal)
-	bundle.MustLoadMessageFile(o.messagePath)
+	return nil, false
+}
 
+// I18N 本地化中间件，将协商后的本地化器注入上下文并翻译返回的错误信息
+// HTTP 的错误由 ErrorEncoder 统一翻译，这里只翻译其它传输层的错误，避免重复翻译
+func I18N(t *Translator) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
-			lang := o.defaultLanguage.String()
-			if tr, ok := transport.FromServerContext(ctx); ok {
-				if ht, ok := tr.(http.Transporter); ok {
-					lang = ht.Request().FormValue("lang")
+			localizer := t.Localizer(ctx)
+			ctx = context.WithValue(ctx, localizerKey{}, localizer)
+			reply, err = handler(ctx, req)
+			if err != nil {
+				if tr, ok := transport.FromServerContext(ctx); ok && tr.Kind() == transport.KindHTTP {
+					return nil, err
 				}
-				accept := tr.RequestHeader().Get("Accept-Language")
-				localizer := i18n.NewLocalizer(bundle, lang, accept)
-				ctx = context.WithValue(ctx, localizerKey{}, localizer)
+				return nil, t.translate(localizer, err)
 			}
-			// 请求验证（处理请求验证翻译）
-			// if v, ok := req.(validator); ok {
-			// 	if err := v.Validate(); err != nil {
-			// 		return nil, errors.BadRequest("VALIDATOR", err.Error()).WithCause(err)
-			// 	}
-			// }
-			return handler(ctx, req)
+			return reply, nil
 		}
 	}
 }
 
+// ErrorEncoder 创建 HTTP 错误编码器，翻译错误信息后交由 next 编码，next 为空时使用 Kratos 默认错误编码器
+func ErrorEncoder(t *Translator, next http.EncodeErrorFunc) http.EncodeErrorFunc {
+	if next == nil {
+		next = http.DefaultErrorEncoder
+	}
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		next(w, r, t.Translate(r.Context(), err))
+	}
+}
+
+// FromContext 获取上下文中的本地化器，未安装 I18N 中间件时返回 nil
 func FromContext(ctx context.Context) *i18n.Localizer {
-	return ctx.Value(localizerKey{}).(*i18n.Localizer)
+	localizer, _ := ctx.Value(localizerKey{}).(*i18n.Localizer)
+	return localizer
 }
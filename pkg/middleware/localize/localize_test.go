@@ -0,0 +1,89 @@
+package localize
+
+import (
+	"context"
+	stderrors "errors"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/stretchr/testify/assert"
+)
+
+type testTransport struct {
+	request *nethttp.Request
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return "" }
+func (tr *testTransport) RequestHeader() transport.Header { return headerCarrier(tr.request.Header) }
+func (tr *testTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+func (tr *testTransport) Request() *nethttp.Request       { return tr.request }
+func (tr *testTransport) PathTemplate() string            { return "" }
+
+var _ http.Transporter = (*testTransport)(nil)
+
+type headerCarrier nethttp.Header
+
+func (hc headerCarrier) Get(key string) string      { return nethttp.Header(hc).Get(key) }
+func (hc headerCarrier) Set(key, value string)      { nethttp.Header(hc).Set(key, value) }
+func (hc headerCarrier) Add(key, value string)      { nethttp.Header(hc).Add(key, value) }
+func (hc headerCarrier) Keys() []string             { return nil }
+func (hc headerCarrier) Values(key string) []string { return nethttp.Header(hc).Values(key) }
+
+func newTestContext(target, accept, cookie string) context.Context {
+	r := httptest.NewRequest(nethttp.MethodGet, target, nil)
+	if accept != "" {
+		r.Header.Set("Accept-Language", accept)
+	}
+	if cookie != "" {
+		r.AddCookie(&nethttp.Cookie{Name: LanguageCookieKey, Value: cookie})
+	}
+	return transport.NewServerContext(context.Background(), &testTransport{request: r})
+}
+
+func TestTranslate(t *testing.T) {
+	translator, err := NewTranslator(
+		WithMessageFS(fstest.MapFS{
+			"active.zh.toml": {Data: []byte("NOT_FOUND = \"资源不存在\"\nBAD_REQUEST = \"请求格式错误\"")},
+			"active.en.toml": {Data: []byte("NOT_FOUND = \"Resource not found\"\nCONFLICT = \"Latest version is {{.Metadata.version}}\"\n" +
+				"BAD_REQUEST = \"Bad request\"\n\"BAD_REQUEST:页码不能为空\" = \"Page is required\"")},
+		}, "*.toml"),
+		WithMessagePath("testdata/missing.toml"),
+	)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		err     error
+		message string
+	}{
+		{name: "default language", ctx: newTestContext("/", "", ""), err: errors.NotFound("NOT_FOUND", "资源不存在"), message: "资源不存在"},
+		{name: "accept language", ctx: newTestContext("/", "en-US", ""), err: errors.NotFound("NOT_FOUND", "资源不存在"), message: "Resource not found"},
+		{name: "cookie before accept language", ctx: newTestContext("/", "zh-CN", "en"), err: errors.NotFound("NOT_FOUND", "资源不存在"), message: "Resource not found"},
+		{name: "query before cookie", ctx: newTestContext("/?lang=zh", "en", "en"), err: errors.NotFound("NOT_FOUND", "资源不存在"), message: "资源不存在"},
+		{name: "builtin validator message", ctx: newTestContext("/?lang=en", "", ""), err: errors.BadRequest("VALIDATOR", "name is required"), message: "Invalid request parameters: name is required"},
+		{name: "metadata", ctx: newTestContext("/?lang=en", "", ""), err: errors.Conflict("CONFLICT", "conflict").WithMetadata(map[string]string{"version": "3"}), message: "Latest version is 3"},
+		{name: "standard message", ctx: newTestContext("/?lang=en", "", ""), err: errors.BadRequest("BAD_REQUEST", "请求格式错误"), message: "Bad request"},
+		{name: "detailed message", ctx: newTestContext("/?lang=en", "", ""), err: errors.BadRequest("BAD_REQUEST", "页码超出范围"), message: "页码超出范围"},
+		{name: "reason and message", ctx: newTestContext("/?lang=en", "", ""), err: errors.BadRequest("BAD_REQUEST", "页码不能为空"), message: "Page is required"},
+		{name: "non-standard message", ctx: newTestContext("/?lang=zh", "", ""), err: errors.NotFound("NOT_FOUND", "resource not found"), message: "resource not found"},
+		{name: "missing in default language", ctx: newTestContext("/?lang=zh", "", ""), err: errors.Conflict("CONFLICT", "conflict"), message: "conflict"},
+		{name: "unknown reason", ctx: newTestContext("/?lang=en", "", ""), err: errors.BadRequest("UNKNOWN_REASON", "原始信息"), message: "原始信息"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.message, errors.FromError(translator.Translate(tt.ctx, tt.err)).Message)
+		})
+	}
+
+	plain := stderrors.New("plain error")
+	assert.Equal(t, plain, translator.Translate(newTestContext("/", "", ""), plain))
+	assert.NoError(t, translator.Translate(context.Background(), nil))
+}
@@ -0,0 +1,155 @@
+package localize
+
+import (
+	"context"
+	"embed"
+	"io/fs"
+	"os"
+	"path"
+
+	"github.com/BurntSushi/toml"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// defaultMessages 内置的通用错误消息（参数校验、编解码等）
+//
+//go:embed locales/*.toml
+var defaultMessages embed.FS
+
+// Translator 错误信息翻译器，消息以 Kratos 错误原因（reason）为键
+type Translator struct {
+	bundle *i18n.Bundle
+	opts   *options
+}
+
+// NewTranslator 创建翻译器，先加载内置消息，再依次加载 WithMessageFS、WithMessagePath 指定的消息
+func NewTranslator(opts ...Option) (*Translator, error) {
+	o := &options{
+		defaultLanguage: language.Chinese,
+		preference:      cookiePreference,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	bundle := i18n.NewBundle(o.defaultLanguage)
+	bundle.RegisterUnmarshalFunc("toml", toml.Unmarshal)
+	files := append([]messageFS{{fsys: defaultMessages, patterns: []string{"locales/*.toml"}}}, o.messageFS...)
+	for _, f := range files {
+		if err := loadMessageFS(bundle, f); err != nil {
+			return nil, err
+		}
+	}
+	if o.messagePath != "" {
+		if _, err := os.Stat(o.messagePath); err == nil {
+			if _, err := bundle.LoadMessageFile(o.messagePath); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &Translator{bundle: bundle, opts: o}, nil
+}
+
+// loadMessageFS 加载文件系统中匹配的消息文件
+func loadMessageFS(bundle *i18n.Bundle, f messageFS) error {
+	for _, pattern := range f.patterns {
+		names, err := fs.Glob(f.fsys, pattern)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			buf, err := fs.ReadFile(f.fsys, name)
+			if err != nil {
+				return err
+			}
+			if _, err := bundle.ParseMessageFileBytes(buf, path.Base(name)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Languages 协商请求语言，优先级：查询参数 lang > 用户偏好 > Accept-Language，均未命中时使用默认语言
+func (t *Translator) Languages(ctx context.Context) []string {
+	var langs []string
+	if ht, ok := httpTransporter(ctx); ok {
+		if lang := ht.Request().URL.Query().Get(LanguageQueryKey); lang != "" {
+			langs = append(langs, lang)
+		}
+	}
+	if t.opts.preference != nil {
+		if lang := t.opts.preference(ctx); lang != "" {
+			langs = append(langs, lang)
+		}
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if accept := tr.RequestHeader().Get("Accept-Language"); accept != "" {
+			langs = append(langs, accept)
+		}
+	}
+	return langs
+}
+
+// Localizer 创建当前请求的本地化器
+func (t *Translator) Localizer(ctx context.Context) *i18n.Localizer {
+	return i18n.NewLocalizer(t.bundle, t.Languages(ctx)...)
+}
+
+// Translate 按当前请求的语言翻译错误信息
+func (t *Translator) Translate(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	return t.translate(t.Localizer(ctx), err)
+}
+
+// translate 翻译 Kratos 错误信息，模板中可通过 {{.Message}} 引用原始信息，通过 {{.Metadata.key}} 引用错误元数据
+// 优先使用以 "原因:原始信息" 为键的消息；以原因为键的消息只用于翻译该原因在默认语言下的标准信息，
+// 或引用了原始信息、元数据的模板，避免同一原因下携带细节的信息被通用文案覆盖
+// 非 Kratos 错误或未找到对应消息时原样返回
+func (t *Translator) translate(localizer *i18n.Localizer, err error) error {
+	se := new(errors.Error)
+	if !errors.As(err, &se) || se.Reason == "" {
+		return err
+	}
+	data := map[string]interface{}{"Message": se.Message, "Metadata": se.Metadata}
+	message, ok := localize(localizer, se.Reason+":"+se.Message, data)
+	if !ok {
+		if !t.translatable(localizer, se.Reason, se.Message, data) {
+			return err
+		}
+		if message, ok = localize(localizer, se.Reason, data); !ok {
+			return err
+		}
+	}
+	localized := errors.Clone(se)
+	localized.Message = message
+	return localized
+}
+
+// translatable 判断以原因为键的消息能否用于翻译原始信息：
+// 原始信息与默认语言的消息一致，或当前语言的消息模板引用了原始信息、元数据
+func (t *Translator) translatable(localizer *i18n.Localizer, reason, message string, data map[string]interface{}) bool {
+	if standard, ok := localize(i18n.NewLocalizer(t.bundle, t.opts.defaultLanguage.String()), reason, data); ok && standard == message {
+		return true
+	}
+	localized, ok := localize(localizer, reason, data)
+	if !ok {
+		return false
+	}
+	empty, _ := localize(localizer, reason, map[string]interface{}{"Message": "", "Metadata": map[string]string{}})
+	return empty != localized
+}
+
+// localize 查找并渲染消息，未找到消息时返回 false
+func localize(localizer *i18n.Localizer, id string, data map[string]interface{}) (string, bool) {
+	message, err := localizer.Localize(&i18n.LocalizeConfig{MessageID: id, TemplateData: data})
+	if err != nil || message == "" {
+		return "", false
+	}
+	return message, true
+}
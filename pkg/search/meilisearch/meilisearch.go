@@ -0,0 +1,209 @@
+// Package meilisearch 基于 Meilisearch 的搜索索引，通过 REST API 访问
+// Meilisearch 的写操作为异步任务，写入后需要短暂时间才能被搜索到
+package meilisearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"backend-service/pkg/search"
+)
+
+const (
+	// defaultTimeout 请求默认超时时间
+	defaultTimeout = 5 * time.Second
+	// primaryKey 文档主键字段
+	primaryKey = "id"
+	// codeIndexNotFound 索引不存在的错误码
+	codeIndexNotFound = "index_not_found"
+)
+
+var _ search.Indexer = (*Indexer)(nil)
+
+// Option Meilisearch 搜索索引选项
+type Option func(*Indexer)
+
+// WithAPIKey 设置 API 密钥
+func WithAPIKey(key string) Option {
+	return func(i *Indexer) {
+		i.apiKey = key
+	}
+}
+
+// WithTimeout 设置请求超时时间，默认 5 秒
+func WithTimeout(timeout time.Duration) Option {
+	return func(i *Indexer) {
+		if timeout > 0 {
+			i.client.Timeout = timeout
+		}
+	}
+}
+
+// WithHTTPClient 设置 HTTP 客户端
+func WithHTTPClient(client *http.Client) Option {
+	return func(i *Indexer) {
+		i.client = client
+	}
+}
+
+// Indexer Meilisearch 搜索索引
+type Indexer struct {
+	host   string
+	apiKey string
+	client *http.Client
+}
+
+// New 创建 Meilisearch 搜索索引，host 为服务地址，如 http://127.0.0.1:7700
+func New(host string, opts ...Option) *Indexer {
+	i := &Indexer{
+		host:   strings.TrimRight(host, "/"),
+		client: &http.Client{Timeout: defaultTimeout},
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// Error Meilisearch 返回的错误
+type Error struct {
+	// Status HTTP 状态码
+	Status int `json:"-"`
+	// Code 错误码
+	Code string `json:"code"`
+	// Message 错误信息
+	Message string `json:"message"`
+}
+
+// Error 实现 error 接口
+func (e *Error) Error() string {
+	return fmt.Sprintf("meilisearch: %s (%s, status %d)", e.Message, e.Code, e.Status)
+}
+
+// Is 索引不存在的错误与 search.ErrIndexNotFound 相同
+func (e *Error) Is(target error) bool {
+	return target == search.ErrIndexNotFound && e.Code == codeIndexNotFound
+}
+
+// EnsureIndex 创建索引并设置搜索字段，按搜索字段的顺序决定字段权重
+func (i *Indexer) EnsureIndex(ctx context.Context, index search.Index) error {
+	// 索引已存在时创建任务失败，不影响后续的设置
+	body := map[string]string{"uid": index.Name, "primaryKey": primaryKey}
+	if err := i.do(ctx, http.MethodPost, "/indexes", body, nil); err != nil {
+		return err
+	}
+	searchable := index.Searchable
+	if len(searchable) == 0 {
+		searchable = []string{"*"}
+	}
+	settings := map[string][]string{"searchableAttributes": searchable}
+	return i.do(ctx, http.MethodPatch, "/indexes/"+url.PathEscape(index.Name)+"/settings", settings, nil)
+}
+
+// Upsert 写入文档，ID 相同的文档整体替换
+func (i *Indexer) Upsert(ctx context.Context, index string, docs ...search.Document) error {
+	if len(docs) == 0 {
+		return nil
+	}
+	body := make([]map[string]string, 0, len(docs))
+	for _, doc := range docs {
+		fields := make(map[string]string, len(doc.Fields)+1)
+		for k, v := range doc.Fields {
+			fields[k] = v
+		}
+		fields[primaryKey] = doc.ID
+		body = append(body, fields)
+	}
+	return i.do(ctx, http.MethodPost, "/indexes/"+url.PathEscape(index)+"/documents?primaryKey="+primaryKey, body, nil)
+}
+
+// Delete 删除文档
+func (i *Indexer) Delete(ctx context.Context, index string, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return i.do(ctx, http.MethodPost, "/indexes/"+url.PathEscape(index)+"/documents/delete-batch", ids, nil)
+}
+
+// DeleteAll 删除索引中的全部文档
+func (i *Indexer) DeleteAll(ctx context.Context, index string) error {
+	return i.do(ctx, http.MethodDelete, "/indexes/"+url.PathEscape(index)+"/documents", nil, nil)
+}
+
+// searchResponse 搜索响应
+type searchResponse struct {
+	Hits []struct {
+		ID    string  `json:"id"`
+		Score float64 `json:"_rankingScore"`
+	} `json:"hits"`
+}
+
+// Search 搜索文档，关键字为空时没有结果
+func (i *Indexer) Search(ctx context.Context, index string, query search.Query) ([]search.Hit, error) {
+	if strings.TrimSpace(query.Text) == "" {
+		return nil, nil
+	}
+	body := map[string]any{
+		"q":                    query.Text,
+		"limit":                query.Size(),
+		"attributesToRetrieve": []string{primaryKey},
+		"showRankingScore":     true,
+	}
+	var reply searchResponse
+	if err := i.do(ctx, http.MethodPost, "/indexes/"+url.PathEscape(index)+"/search", body, &reply); err != nil {
+		return nil, err
+	}
+	hits := make([]search.Hit, 0, len(reply.Hits))
+	for _, h := range reply.Hits {
+		hits = append(hits, search.Hit{ID: h.ID, Score: h.Score})
+	}
+	return hits, nil
+}
+
+// do 发送请求，body 编码为 JSON，响应解码到 reply，非 2xx 响应返回 *Error
+func (i *Indexer) do(ctx context.Context, method, path string, body, reply any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, i.host+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if i.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+i.apiKey)
+	}
+
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("meilisearch: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		e := &Error{Status: resp.StatusCode}
+		if err := json.NewDecoder(resp.Body).Decode(e); err != nil || e.Message == "" {
+			e.Message = http.StatusText(resp.StatusCode)
+		}
+		return e
+	}
+	if reply == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(reply)
+}
@@ -0,0 +1,90 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"backend-service/pkg/search"
+)
+
+// request 测试服务器收到的请求
+type request struct {
+	Method string
+	Path   string
+	Body   string
+}
+
+func newTestServer(t *testing.T, handle func(w http.ResponseWriter, r *http.Request)) (*Indexer, *[]request) {
+	var requests []request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, request{Method: r.Method, Path: r.URL.RequestURI(), Body: string(body)})
+		handle(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return New(srv.URL+"/", WithAPIKey("key")), &requests
+}
+
+func TestIndexerWrite(t *testing.T) {
+	ctx := context.Background()
+	i, requests := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":1}`))
+	})
+
+	require.NoError(t, i.EnsureIndex(ctx, search.Index{Name: "users", Searchable: []string{"name", "email"}}))
+	require.NoError(t, i.Upsert(ctx, "users", search.Document{ID: "1", Fields: map[string]string{"name": "alice"}}))
+	require.NoError(t, i.Delete(ctx, "users", "1", "2"))
+	require.NoError(t, i.DeleteAll(ctx, "users"))
+	require.NoError(t, i.Upsert(ctx, "users"))
+
+	require.Len(t, *requests, 5)
+	r := *requests
+	assert.Equal(t, request{http.MethodPost, "/indexes", `{"primaryKey":"id","uid":"users"}`}, r[0])
+	assert.Equal(t, request{http.MethodPatch, "/indexes/users/settings", `{"searchableAttributes":["name","email"]}`}, r[1])
+	assert.Equal(t, request{http.MethodPost, "/indexes/users/documents?primaryKey=id", `[{"id":"1","name":"alice"}]`}, r[2])
+	assert.Equal(t, request{http.MethodPost, "/indexes/users/documents/delete-batch", `["1","2"]`}, r[3])
+	assert.Equal(t, request{http.MethodDelete, "/indexes/users/documents", ""}, r[4])
+}
+
+func TestIndexerSearch(t *testing.T) {
+	ctx := context.Background()
+	i, requests := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/indexes/menus/search" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index ` + "`menus`" + ` not found.","code":"index_not_found","type":"invalid_request"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"hits":[{"id":"2","_rankingScore":0.9},{"id":"1","_rankingScore":0.5}]}`))
+	})
+
+	hits, err := i.Search(ctx, "users", search.Query{Text: "alice", Limit: 5})
+	require.NoError(t, err)
+	assert.Equal(t, []search.Hit{{ID: "2", Score: 0.9}, {ID: "1", Score: 0.5}}, hits)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal([]byte((*requests)[0].Body), &body))
+	assert.Equal(t, "alice", body["q"])
+	assert.Equal(t, float64(5), body["limit"])
+	assert.Equal(t, true, body["showRankingScore"])
+
+	hits, err = i.Search(ctx, "users", search.Query{Text: " "})
+	require.NoError(t, err)
+	assert.Empty(t, hits)
+	assert.Len(t, *requests, 1)
+
+	_, err = i.Search(ctx, "menus", search.Query{Text: "alice"})
+	assert.True(t, errors.Is(err, search.ErrIndexNotFound))
+	var e *Error
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, http.StatusNotFound, e.Status)
+}
@@ -0,0 +1,179 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// 单个字段与搜索词的匹配程度，按匹配方式从高到低
+const (
+	// scoreExact 字段值与搜索词完全相同
+	scoreExact = 1.0
+	// scoreWord 字段中的某个词与搜索词相同
+	scoreWord = 0.9
+	// scorePrefix 字段中的某个词以搜索词开头
+	scorePrefix = 0.75
+	// scoreContains 字段包含搜索词
+	scoreContains = 0.5
+)
+
+var _ Indexer = (*Memory)(nil)
+
+// Memory 进程内搜索索引，数据保存在内存中，进程重启后丢失，用于测试与本地开发
+// 搜索词之间为与关系，不支持拼写容错；相关度由匹配方式与字段权重决定
+type Memory struct {
+	mu      sync.RWMutex
+	indexes map[string]*memoryIndex
+}
+
+// memoryIndex 进程内索引，文档字段已转为小写
+type memoryIndex struct {
+	searchable []string
+	docs       map[string]map[string]string
+}
+
+// NewMemory 创建进程内搜索索引
+func NewMemory() *Memory {
+	return &Memory{indexes: make(map[string]*memoryIndex)}
+}
+
+// EnsureIndex 创建索引并设置搜索字段
+func (m *Memory) EnsureIndex(_ context.Context, index Index) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	idx := m.index(index.Name)
+	idx.searchable = append([]string(nil), index.Searchable...)
+	return nil
+}
+
+// Upsert 写入文档，索引不存在时自动创建，未设置搜索字段时全部字段参与搜索
+func (m *Memory) Upsert(_ context.Context, index string, docs ...Document) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	idx := m.index(index)
+	for _, doc := range docs {
+		fields := make(map[string]string, len(doc.Fields))
+		for k, v := range doc.Fields {
+			fields[k] = strings.ToLower(v)
+		}
+		idx.docs[doc.ID] = fields
+	}
+	return nil
+}
+
+// Delete 删除文档
+func (m *Memory) Delete(_ context.Context, index string, ids ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if idx, ok := m.indexes[index]; ok {
+		for _, id := range ids {
+			delete(idx.docs, id)
+		}
+	}
+	return nil
+}
+
+// DeleteAll 删除索引中的全部文档
+func (m *Memory) DeleteAll(_ context.Context, index string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if idx, ok := m.indexes[index]; ok {
+		idx.docs = make(map[string]map[string]string)
+	}
+	return nil
+}
+
+// Search 搜索文档，关键字为空时没有结果，相关度相同时按文档ID排列
+func (m *Memory) Search(_ context.Context, index string, query Query) ([]Hit, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	idx, ok := m.indexes[index]
+	if !ok {
+		return nil, ErrIndexNotFound
+	}
+	terms := strings.Fields(strings.ToLower(query.Text))
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	var hits []Hit
+	for id, fields := range idx.docs {
+		if score := idx.score(fields, terms); score > 0 {
+			hits = append(hits, Hit{ID: id, Score: score})
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	if len(hits) > query.Size() {
+		hits = hits[:query.Size()]
+	}
+	return hits, nil
+}
+
+// index 获取索引，不存在时创建，调用方需持有写锁
+func (m *Memory) index(name string) *memoryIndex {
+	idx, ok := m.indexes[name]
+	if !ok {
+		idx = &memoryIndex{docs: make(map[string]map[string]string)}
+		m.indexes[name] = idx
+	}
+	return idx
+}
+
+// score 文档的相关度，每个搜索词取最佳匹配字段的得分后求平均，任一搜索词未匹配时为 0
+// 第 i 个搜索字段的权重为 1 - 0.5*i/n，排在前面的字段权重更高
+func (idx *memoryIndex) score(fields map[string]string, terms []string) float64 {
+	searchable := idx.searchable
+	if len(searchable) == 0 {
+		searchable = make([]string, 0, len(fields))
+		for k := range fields {
+			searchable = append(searchable, k)
+		}
+		sort.Strings(searchable)
+	}
+
+	total := 0.0
+	for _, term := range terms {
+		best := 0.0
+		for i, name := range searchable {
+			s := match(fields[name], term) * (1 - 0.5*float64(i)/float64(len(searchable)))
+			if s > best {
+				best = s
+			}
+		}
+		if best == 0 {
+			return 0
+		}
+		total += best
+	}
+	return total / float64(len(terms))
+}
+
+// match 字段值与搜索词的匹配程度，未匹配时为 0
+func match(value, term string) float64 {
+	if value == "" || !strings.Contains(value, term) {
+		return 0
+	}
+	if value == term {
+		return scoreExact
+	}
+	score := scoreContains
+	for _, word := range strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		switch {
+		case word == term:
+			return scoreWord
+		case strings.HasPrefix(word, term):
+			score = scorePrefix
+		}
+	}
+	return score
+}
@@ -0,0 +1,70 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func hitIDs(hits []Hit) []string {
+	ids := make([]string, 0, len(hits))
+	for _, h := range hits {
+		ids = append(ids, h.ID)
+	}
+	return ids
+}
+
+func TestMemorySearch(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory()
+	require.NoError(t, m.EnsureIndex(ctx, Index{Name: "users", Searchable: []string{"name", "nickname", "email", "phone"}}))
+	require.NoError(t, m.Upsert(ctx, "users",
+		Document{ID: "1", Fields: map[string]string{"name": "alice", "nickname": "Ally", "email": "alice@example.com", "phone": "13800000001"}},
+		Document{ID: "2", Fields: map[string]string{"name": "bob", "nickname": "Alice Fan", "email": "bob@example.com", "phone": "13900000002"}},
+		Document{ID: "3", Fields: map[string]string{"name": "malice", "nickname": "张三", "email": "m@example.com", "phone": "13700000003"}},
+	))
+
+	// 完全相同 > 词相同 > 词前缀 > 包含，前面的字段权重更高
+	hits, err := m.Search(ctx, "users", Query{Text: "Alice"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, hitIDs(hits))
+	assert.Greater(t, hits[0].Score, hits[1].Score)
+	assert.Greater(t, hits[1].Score, hits[2].Score)
+
+	hits, err = m.Search(ctx, "users", Query{Text: "139"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2"}, hitIDs(hits))
+
+	hits, err = m.Search(ctx, "users", Query{Text: "张"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3"}, hitIDs(hits))
+
+	// 搜索词之间为与关系
+	hits, err = m.Search(ctx, "users", Query{Text: "alice fan"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2"}, hitIDs(hits))
+
+	hits, err = m.Search(ctx, "users", Query{Text: "example", Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, hits, 2)
+
+	hits, err = m.Search(ctx, "users", Query{Text: "  "})
+	require.NoError(t, err)
+	assert.Empty(t, hits)
+
+	require.NoError(t, m.Delete(ctx, "users", "1"))
+	hits, err = m.Search(ctx, "users", Query{Text: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2", "3"}, hitIDs(hits))
+
+	require.NoError(t, m.DeleteAll(ctx, "users"))
+	hits, err = m.Search(ctx, "users", Query{Text: "alice"})
+	require.NoError(t, err)
+	assert.Empty(t, hits)
+
+	_, err = m.Search(ctx, "menus", Query{Text: "alice"})
+	assert.True(t, errors.Is(err, ErrIndexNotFound))
+}
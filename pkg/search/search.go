@@ -0,0 +1,69 @@
+// Package search 全文搜索索引抽象
+// 业务数据变更时写入索引，搜索返回按相关度排序的文档ID，由调用方回表查询完整数据
+// 提供 Meilisearch 与进程内两种实现，进程内实现用于测试与本地开发
+package search
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrIndexNotFound 索引不存在
+var ErrIndexNotFound = errors.New("search: index not found")
+
+// defaultLimit 搜索默认返回的结果数
+const defaultLimit = 20
+
+// Index 索引定义
+type Index struct {
+	// Name 索引名称
+	Name string
+	// Searchable 参与搜索的字段，按权重从高到低排列，排在前面的字段匹配时相关度更高
+	Searchable []string
+}
+
+// Document 索引文档
+type Document struct {
+	// ID 文档ID，通常为业务数据的主键
+	ID string
+	// Fields 文档字段，值为空的字段不参与搜索
+	Fields map[string]string
+}
+
+// Query 搜索条件
+type Query struct {
+	// Text 搜索关键字，多个词以空白分隔
+	Text string
+	// Limit 返回的结果数，不大于 0 时为 20
+	Limit int
+}
+
+// Hit 搜索结果
+type Hit struct {
+	// ID 文档ID
+	ID string
+	// Score 相关度，取值范围 [0, 1]，越大越相关
+	Score float64
+}
+
+// Indexer 搜索索引
+type Indexer interface {
+	// EnsureIndex 创建索引并设置搜索字段，索引已存在时更新搜索字段
+	EnsureIndex(ctx context.Context, index Index) error
+	// Upsert 写入文档，ID 相同的文档整体替换
+	Upsert(ctx context.Context, index string, docs ...Document) error
+	// Delete 删除文档，文档不存在时忽略
+	Delete(ctx context.Context, index string, ids ...string) error
+	// DeleteAll 删除索引中的全部文档，用于重建索引
+	DeleteAll(ctx context.Context, index string) error
+	// Search 搜索文档，结果按相关度从高到低排列
+	Search(ctx context.Context, index string, query Query) ([]Hit, error)
+}
+
+// Size 返回的结果数，Limit 不大于 0 时为默认值
+func (q Query) Size() int {
+	if q.Limit <= 0 {
+		return defaultLimit
+	}
+	return q.Limit
+}
@@ -21,25 +21,14 @@ type TreeData[T Pid] interface {
 // addChildren: 用于为节点添加子节点的函数
 func ToTree[T TreeData[I], I Pid](source []T, pid I, addChildren func(T, ...T) error) ([]T, error) {
 	result := make([]T, 0)
-	// 缓存所有节点，方便快速查找
-	nodeMap := make(map[I]T)
 	for _, item := range source {
-		id := item.GetId()
-		nodeMap[id] = item
-	}
-
-	for _, item := range source {
-		parentId := item.GetParentId()
-		if parentId == pid {
+		// 父 ID 为自身的节点无法挂载，跳过以免无限递归
+		if item.GetParentId() == pid && item.GetId() != pid {
 			result = append(result, item)
-		} else if parent, exists := nodeMap[parentId]; exists {
-			if err := addChildren(parent, item); err != nil {
-				return nil, errors.New("failed to add child to parent: " + err.Error())
-			}
 		}
 	}
 
-	// 递归处理子节点
+	// 递归处理子节点，每个节点只在其父节点下挂载一次
 	for _, item := range result {
 		children, err := ToTree(source, item.GetId(), addChildren)
 		if err != nil {
@@ -91,3 +91,29 @@ func Test_TreeProto(t *testing.T) {
 	t.Logf("%v", data)
 	t.Log("测试泛型树形结构结束")
 }
+
+func Test_TreeChildrenOnce(t *testing.T) {
+	source := []*Domain{
+		{Id: 1, ParentId: 0},
+		{Id: 2, ParentId: 1},
+		{Id: 3, ParentId: 2},
+		{Id: 4, ParentId: 1},
+		{Id: 5, ParentId: 5},
+	}
+	data, err := ToTree(source, 0, func(t *Domain, ts ...*Domain) error {
+		t.Children = append(t.Children, ts...)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 1 || data[0].Id != 1 {
+		t.Fatalf("根节点错误：%v", data)
+	}
+	if len(data[0].Children) != 2 {
+		t.Fatalf("子节点数量错误：%d", len(data[0].Children))
+	}
+	if len(data[0].Children[0].Children) != 1 || data[0].Children[0].Children[0].Id != 3 {
+		t.Fatalf("孙节点错误：%v", data[0].Children[0].Children)
+	}
+}
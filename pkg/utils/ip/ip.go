@@ -3,16 +3,20 @@ package ip
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"strings"
 
 	"github.com/go-kratos/kratos/v2/transport"
+	kratosHttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
 )
 
 const (
-	XRealIP     = "X-Real-IP"
-	XForwardFor = "X-Forward-For"
+	XRealIP       = "X-Real-IP"
+	XForwardFor   = "X-Forward-For"
+	XForwardedFor = "X-Forwarded-For"
 )
 
 // GetIP returns request real ip.
@@ -57,6 +61,108 @@ func FormContext(ctx context.Context) string {
 		if net.ParseIP(ip) != nil {
 			return ip
 		}
+
+		// 无代理头时取连接对端地址
+		if ht, ok := header.(kratosHttp.Transporter); ok {
+			if ip, err := GetIP(ht.Request()); err == nil {
+				return ip
+			}
+		}
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			if ip, _, err := net.SplitHostPort(p.Addr.String()); err == nil && net.ParseIP(ip) != nil {
+				return ip
+			}
+		}
 	}
 	return "0.0.0.0"
 }
+
+// ParseTrustedProxies 解析可信代理列表，每项为单个 IP 或 CIDR
+func ParseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy: %s", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %w", err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// ClientIP 返回请求的客户端 IP，以传输层对端地址（HTTP 的 RemoteAddr、gRPC 的 peer）为准
+// 仅当对端属于可信代理时采信转发头：X-Forwarded-For 自右向左跳过可信代理取第一个地址，缺失时取 X-Real-IP
+// 无法获取对端地址时返回 0.0.0.0
+func ClientIP(ctx context.Context, trusted []*net.IPNet) string {
+	remote := peerIP(ctx)
+	if remote == nil {
+		return "0.0.0.0"
+	}
+	if !isTrusted(remote, trusted) {
+		return remote.String()
+	}
+	tr, _ := transport.FromServerContext(ctx)
+	header := tr.RequestHeader()
+	if header == nil {
+		return remote.String()
+	}
+	if forwarded := header.Get(XForwardedFor); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				// 无法解析的地址之前的内容不可信
+				break
+			}
+			if !isTrusted(ip, trusted) {
+				return ip.String()
+			}
+		}
+		return remote.String()
+	}
+	if ip := net.ParseIP(strings.TrimSpace(header.Get(XRealIP))); ip != nil {
+		return ip.String()
+	}
+	return remote.String()
+}
+
+// peerIP 返回传输层对端地址
+func peerIP(ctx context.Context) net.IP {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return nil
+	}
+	var addr string
+	if ht, ok := tr.(kratosHttp.Transporter); ok && ht.Request() != nil {
+		addr = ht.Request().RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return net.ParseIP(addr)
+}
+
+// isTrusted 检查地址是否属于可信代理
+func isTrusted(ip net.IP, trusted []*net.IPNet) bool {
+	for _, n := range trusted {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
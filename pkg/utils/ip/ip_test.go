@@ -0,0 +1,105 @@
+package ip
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+)
+
+type headerCarrier http.Header
+
+func (hc headerCarrier) Get(key string) string { return http.Header(hc).Get(key) }
+func (hc headerCarrier) Set(key, value string) { http.Header(hc).Set(key, value) }
+func (hc headerCarrier) Add(key, value string) { http.Header(hc).Add(key, value) }
+func (hc headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(hc))
+	for k := range hc {
+		keys = append(keys, k)
+	}
+	return keys
+}
+func (hc headerCarrier) Values(key string) []string { return http.Header(hc).Values(key) }
+
+type testTransport struct {
+	kind    transport.Kind
+	request *http.Request
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return tr.kind }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return "" }
+func (tr *testTransport) RequestHeader() transport.Header { return headerCarrier(tr.request.Header) }
+func (tr *testTransport) ReplyHeader() transport.Header   { return nil }
+
+type testHTTPTransport struct {
+	testTransport
+}
+
+func (tr *testHTTPTransport) Request() *http.Request        { return tr.request }
+func (tr *testHTTPTransport) PathTemplate() string          { return "" }
+func (tr *testHTTPTransport) Response() http.ResponseWriter { return nil }
+
+func TestClientIP(t *testing.T) {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.1", "192.168.0.0/16"})
+	require.NoError(t, err)
+	_, err = ParseTrustedProxies([]string{"proxy"})
+	assert.Error(t, err)
+
+	tests := []struct {
+		name    string
+		remote  string
+		headers map[string]string
+		want    string
+	}{
+		{
+			name:    "untrusted peer ignores headers",
+			remote:  "203.0.113.7:4321",
+			headers: map[string]string{XForwardedFor: "198.51.100.1", XRealIP: "198.51.100.2"},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "trusted peer uses forwarded for",
+			remote:  "10.0.0.1:4321",
+			headers: map[string]string{XForwardedFor: "1.2.3.4, 198.51.100.1, 192.168.1.1"},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "trusted peer uses real ip",
+			remote:  "10.0.0.1:4321",
+			headers: map[string]string{XRealIP: "198.51.100.2"},
+			want:    "198.51.100.2",
+		},
+		{
+			name:   "trusted peer without headers",
+			remote: "10.0.0.1:4321",
+			want:   "10.0.0.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/login", nil)
+			req.RemoteAddr = tt.remote
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			ctx := transport.NewServerContext(context.Background(), &testHTTPTransport{testTransport{transport.KindHTTP, req}})
+			assert.Equal(t, tt.want, ClientIP(ctx, trusted))
+		})
+	}
+
+	// gRPC 取连接对端地址
+	req := httptest.NewRequest(http.MethodPost, "/login", nil)
+	req.Header.Set(XForwardedFor, "198.51.100.1")
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.9"), Port: 4321}})
+	ctx = transport.NewServerContext(ctx, &testTransport{transport.KindGRPC, req})
+	assert.Equal(t, "203.0.113.9", ClientIP(ctx, trusted))
+
+	assert.Equal(t, "0.0.0.0", ClientIP(context.Background(), trusted))
+}
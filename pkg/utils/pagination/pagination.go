@@ -10,6 +10,18 @@ func GetPageOffset(page, pageSize int32) int {
 	return int((page - 1) * pageSize)
 }
 
+// Slice 对内存中的列表分页，page 或 pageSize 不大于 0 时返回全部
+func Slice[T any](items []T, page, pageSize int) []T {
+	if page <= 0 || pageSize <= 0 {
+		return items
+	}
+	start := (page - 1) * pageSize
+	if start >= len(items) {
+		return []T{}
+	}
+	return items[start:min(start+pageSize, len(items))]
+}
+
 type Query struct {
 	Key       string
 	Condition string